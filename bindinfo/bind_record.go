@@ -56,6 +56,8 @@ const (
 	Builtin = "builtin"
 	// History indicate the binding is created from statement summary by plan digest
 	History = "history"
+	// Regression indicates the binding is captured by TiDB automatically when the plan of the statement regresses.
+	Regression = "regression"
 )
 
 // Binding stores the basic bind hint info.
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb/bindinfo"
 	"github.com/pingcap/tidb/config"
@@ -1022,3 +1023,50 @@ func TestCaptureHints(t *testing.T) {
 		require.Equal(t, res[0][9], sqlDigestWithDB.String())
 	}
 }
+
+func TestCaptureBindingOnPlanRegression(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index idx_a(a))")
+
+	bindHandle := dom.BindHandle()
+	query := "select * from t where a > 10"
+	feedback := func(planDigest, planHint string, latency time.Duration) *bindinfo.PlanFeedback {
+		return &bindinfo.PlanFeedback{
+			SQLDigest:   "sql_digest",
+			PlanDigest:  planDigest,
+			Query:       query,
+			Schema:      "test",
+			Charset:     "utf8mb4",
+			Collation:   "utf8mb4_bin",
+			Latency:     latency,
+			EstRows:     10,
+			ActRows:     1000,
+			PlanHintGen: func() string { return planHint },
+		}
+	}
+	for i := 0; i < 3; i++ {
+		require.False(t, bindHandle.ObservePlanFeedback(feedback("plan_good", "use_index(@`sel_1` `test`.`t` `idx_a`)", time.Millisecond), 2))
+	}
+	require.False(t, bindHandle.ObservePlanFeedback(feedback("plan_bad", "use_index(@`sel_1` `test`.`t` )", 10*time.Millisecond), 2))
+	require.False(t, bindHandle.ObservePlanFeedback(feedback("plan_bad", "use_index(@`sel_1` `test`.`t` )", 10*time.Millisecond), 2))
+	require.True(t, bindHandle.ObservePlanFeedback(feedback("plan_bad", "use_index(@`sel_1` `test`.`t` )", 10*time.Millisecond), 2))
+	// The regression of the statement is only captured once.
+	require.False(t, bindHandle.ObservePlanFeedback(feedback("plan_bad", "use_index(@`sel_1` `test`.`t` )", 10*time.Millisecond), 2))
+
+	bindHandle.CaptureRegressedPlans()
+	sql, hash := utilNormalizeWithDefaultDB(t, "select * from t where a > ?", "test")
+	bindData := bindHandle.GetBindRecord(hash, sql, "test")
+	require.NotNil(t, bindData)
+	require.Len(t, bindData.Bindings, 1)
+	require.Equal(t, bindinfo.Regression, bindData.Bindings[0].Source)
+	require.Equal(t, "plan_good", bindData.Bindings[0].PlanDigest)
+	require.Equal(t, "SELECT /*+ use_index(@`sel_1` `test`.`t` `idx_a`)*/ * FROM `test`.`t` WHERE `a` > 10", bindData.Bindings[0].BindSQL)
+	tk.MustQuery("select original_sql, default_db, regressed_plan_digest, regressed_avg_latency, regressed_avg_est_rows, regressed_avg_act_rows, " +
+		"baseline_plan_digest, baseline_avg_latency from mysql.plan_regressions").Check(testkit.Rows(
+		"select * from `test` . `t` where `a` > ? test plan_bad 10000000 10 1000 plan_good 1000000"))
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("0"))
+	tk.MustExec(query)
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+}
@@ -86,6 +86,9 @@ type BindHandle struct {
 
 	// pendingVerifyBindRecordMap indicates the pending verify bind records that found during query.
 	pendingVerifyBindRecordMap tmpBindRecordMap
+
+	// regressionDetector finds out the plan regressions from the execution feedback of the plans.
+	regressionDetector *planRegressionDetector
 }
 
 // Lease influences the duration of loading bind info and handling invalid bind.
@@ -129,6 +132,7 @@ func (h *BindHandle) Reset(ctx sessionctx.Context) {
 		// BindSQL has already been validated when coming here, so we use nil sctx parameter.
 		return h.AddBindRecord(nil, record)
 	}
+	h.regressionDetector = newPlanRegressionDetector()
 	variable.RegisterStatistics(h)
 }
 
@@ -621,3 +621,48 @@ func TestReloadBindings(t *testing.T) {
 	rows = tk.MustQuery("show global bindings").Rows()
 	require.Equal(t, 0, len(rows))
 }
+
+func TestRenameTablesWithBindings(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	utilCleanBindingEnv(tk, dom)
+	tk.MustExec("create database db1")
+	tk.MustExec("create database db2")
+	tk.MustExec("create table db1.t1 (a int, b int, index ia(a), index ib(b))")
+	tk.MustExec("create table db1.t2 (a int, b int, index ia(a))")
+	tk.MustExec("use db1")
+	tk.MustExec("create global binding for select * from t1 where a > 1 and b > 1 using select /*+ use_index(t1, ib) */ * from t1 where a > 1 and b > 1")
+	tk.MustExec("create global binding for select * from t2 where a > 1 using select /*+ use_index(t2, ia) */ * from t2 where a > 1")
+
+	// db1.t1 and db1.t2 are swapped and moved to db2.
+	tk.MustExec("rename table db1.t1 to db1.tmp, db1.t2 to db2.t1, db1.tmp to db2.t2")
+	rows := tk.MustQuery("show global bindings").Sort().Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, "select * from `db2` . `t1` where `a` > ?", rows[0][0])
+	require.Contains(t, rows[0][1], "`db2`.`t1`")
+	require.NotContains(t, rows[0][1], "`db1`")
+	require.Equal(t, "select * from `db2` . `t2` where `a` > ? and `b` > ?", rows[1][0])
+	require.Contains(t, rows[1][1], "`db2`.`t2`")
+	require.NotContains(t, rows[1][1], "`db1`")
+	tk.MustQuery("select original_sql from mysql.bind_info where status = 'deleted'").Sort().Check(testkit.Rows(
+		"select * from `db1` . `t1` where `a` > ? and `b` > ?",
+		"select * from `db1` . `t2` where `a` > ?",
+	))
+
+	// The bindings are applied to the renamed tables, but not the tables created with the old names.
+	tk.MustExec("use db2")
+	tk.MustQuery("select * from t2 where a > 1 and b > 1")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+	require.Equal(t, "t2:ib", tk.Session().GetSessionVars().StmtCtx.IndexNames[0])
+	tk.MustQuery("select * from t1 where a > 1")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+	tk.MustExec("create table db1.t1 (a int, b int, index ia(a), index ib(b))")
+	tk.MustExec("use db1")
+	tk.MustQuery("select * from t1 where a > 1 and b > 1")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("0"))
+
+	// The bindings are loaded from the storage by the other instances.
+	dom.BindHandle().Clear()
+	require.NoError(t, dom.BindHandle().Update(true))
+	require.Len(t, tk.MustQuery("show global bindings").Rows(), 2)
+}
@@ -0,0 +1,225 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bindinfo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/logutil"
+	utilparser "github.com/pingcap/tidb/util/parser"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+const (
+	// planRegressionMinExecCount is the execution count of a plan before its average latency is compared.
+	planRegressionMinExecCount = 3
+	// planRegressionMaxSQLDigests is the max number of the SQL digests recorded by the regression detector.
+	planRegressionMaxSQLDigests = 4096
+)
+
+// PlanFeedback is the execution feedback of a plan of a bindable statement.
+type PlanFeedback struct {
+	// SQLDigest and PlanDigest are the digests in the statement summary, the plans are compared by the SQL digest.
+	SQLDigest  string
+	PlanDigest string
+	// Query is the text of the statement, which the binding is generated from.
+	Query string
+	// Schema is the current database when the statement is executed.
+	Schema    string
+	Charset   string
+	Collation string
+	Latency   time.Duration
+	// EstRows and ActRows are the estimated and actual rows of the root operator of the plan.
+	EstRows float64
+	ActRows int64
+	// PlanHintGen generates the hints of the plan, it's only called when the plan is seen for the first time.
+	PlanHintGen func() string
+}
+
+// planFeedbackStats is the accumulated execution feedback of a plan.
+type planFeedbackStats struct {
+	planDigest   string
+	planHint     string
+	query        string
+	schema       string
+	charset      string
+	collation    string
+	count        int64
+	totalLatency time.Duration
+	totalEstRows float64
+	totalActRows float64
+}
+
+func (s *planFeedbackStats) avgLatency() time.Duration {
+	return s.totalLatency / time.Duration(s.count)
+}
+
+func (s *planFeedbackStats) avgEstRows() float64 {
+	return s.totalEstRows / float64(s.count)
+}
+
+func (s *planFeedbackStats) avgActRows() float64 {
+	return s.totalActRows / float64(s.count)
+}
+
+type stmtPlanFeedback struct {
+	plans map[string]*planFeedbackStats
+	// captured indicates the binding has been captured for the statement, so it's not captured again.
+	captured bool
+}
+
+// planRegression is a plan regression found by the detector, the baseline plan is the fastest other plan of the
+// statement, which the binding is captured for.
+type planRegression struct {
+	regressed planFeedbackStats
+	baseline  planFeedbackStats
+}
+
+// planRegressionDetector compares the average latencies of the plans of each statement in order to find out the plans
+// which are much slower than the other plans of the same statement.
+type planRegressionDetector struct {
+	sync.Mutex
+	stmts   map[string]*stmtPlanFeedback
+	pending []*planRegression
+}
+
+func newPlanRegressionDetector() *planRegressionDetector {
+	return &planRegressionDetector{stmts: make(map[string]*stmtPlanFeedback)}
+}
+
+// observe records the feedback, and returns true if the plan is regarded as regressed for the first time.
+func (d *planRegressionDetector) observe(fb *PlanFeedback, threshold float64) bool {
+	d.Lock()
+	defer d.Unlock()
+	stmt, ok := d.stmts[fb.SQLDigest]
+	if !ok {
+		if len(d.stmts) >= planRegressionMaxSQLDigests {
+			d.stmts = make(map[string]*stmtPlanFeedback)
+		}
+		stmt = &stmtPlanFeedback{plans: make(map[string]*planFeedbackStats)}
+		d.stmts[fb.SQLDigest] = stmt
+	}
+	cur, ok := stmt.plans[fb.PlanDigest]
+	if !ok {
+		cur = &planFeedbackStats{
+			planDigest: fb.PlanDigest,
+			planHint:   fb.PlanHintGen(),
+			query:      fb.Query,
+			schema:     fb.Schema,
+			charset:    fb.Charset,
+			collation:  fb.Collation,
+		}
+		stmt.plans[fb.PlanDigest] = cur
+	}
+	cur.count++
+	cur.totalLatency += fb.Latency
+	cur.totalEstRows += fb.EstRows
+	cur.totalActRows += float64(fb.ActRows)
+	if stmt.captured || cur.count < planRegressionMinExecCount {
+		return false
+	}
+	var baseline *planFeedbackStats
+	for planDigest, other := range stmt.plans {
+		if planDigest == fb.PlanDigest || other.count < planRegressionMinExecCount || other.planHint == "" {
+			continue
+		}
+		if baseline == nil || other.avgLatency() < baseline.avgLatency() {
+			baseline = other
+		}
+	}
+	if baseline == nil || float64(cur.avgLatency()) <= float64(baseline.avgLatency())*threshold {
+		return false
+	}
+	stmt.captured = true
+	d.pending = append(d.pending, &planRegression{regressed: *cur, baseline: *baseline})
+	return true
+}
+
+func (d *planRegressionDetector) takePending() []*planRegression {
+	d.Lock()
+	defer d.Unlock()
+	pending := d.pending
+	d.pending = nil
+	return pending
+}
+
+// ObservePlanFeedback records the execution feedback of the plan. If the plan regresses beyond the threshold, the
+// binding of the fastest other plan of the statement is captured by CaptureRegressedPlans later.
+func (h *BindHandle) ObservePlanFeedback(fb *PlanFeedback, threshold float64) bool {
+	return h.regressionDetector.observe(fb, threshold)
+}
+
+// CaptureRegressedPlans creates the bindings of the baseline plans for the plan regressions found since the last time,
+// and records the regressions in mysql.plan_regressions.
+func (h *BindHandle) CaptureRegressedPlans() {
+	parser4Capture := parser.New()
+	for _, r := range h.regressionDetector.takePending() {
+		stmt, err := parser4Capture.ParseOneStmt(r.baseline.query, r.baseline.charset, r.baseline.collation)
+		if err != nil {
+			logutil.BgLogger().Debug("[sql-bind] parse SQL failed in regression capture", zap.String("SQL", r.baseline.query), zap.Error(err))
+			continue
+		}
+		dbName := utilparser.GetDefaultDB(stmt, r.baseline.schema)
+		normalizedSQL, digest := parser.NormalizeDigest(utilparser.RestoreWithDefaultDB(stmt, dbName, r.baseline.query))
+		if br := h.GetBindRecord(digest.String(), normalizedSQL, dbName); br != nil && br.HasAvailableBinding() {
+			continue
+		}
+		bindSQL := GenerateBindSQL(context.TODO(), stmt, r.baseline.planHint, true, dbName)
+		if bindSQL == "" {
+			continue
+		}
+		binding := Binding{
+			BindSQL:    bindSQL,
+			Status:     Enabled,
+			Charset:    r.baseline.charset,
+			Collation:  r.baseline.collation,
+			Source:     Regression,
+			SQLDigest:  digest.String(),
+			PlanDigest: r.baseline.planDigest,
+		}
+		// We don't need to pass the `sctx` because the BindSQL has been validated already.
+		err = h.CreateBindRecord(nil, &BindRecord{OriginalSQL: normalizedSQL, Db: dbName, Bindings: []Binding{binding}})
+		if err != nil {
+			logutil.BgLogger().Warn("[sql-bind] create bind record failed in regression capture", zap.String("SQL", normalizedSQL), zap.Error(err))
+			continue
+		}
+		logutil.BgLogger().Info("[sql-bind] capture binding for regressed plan", zap.String("SQL", normalizedSQL),
+			zap.String("regressedPlanDigest", r.regressed.planDigest), zap.Duration("regressedLatency", r.regressed.avgLatency()),
+			zap.String("baselinePlanDigest", r.baseline.planDigest), zap.Duration("baselineLatency", r.baseline.avgLatency()))
+		if err = h.recordPlanRegression(r, digest.String(), normalizedSQL, dbName, bindSQL); err != nil {
+			logutil.BgLogger().Warn("[sql-bind] record plan regression failed", zap.String("SQL", normalizedSQL), zap.Error(err))
+		}
+	}
+}
+
+func (h *BindHandle) recordPlanRegression(r *planRegression, sqlDigest, originalSQL, db, bindSQL string) error {
+	h.sctx.Lock()
+	defer h.sctx.Unlock()
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnBindInfo)
+	exec, _ := h.sctx.Context.(sqlexec.SQLExecutor)
+	_, err := exec.ExecuteInternal(ctx, `INSERT INTO mysql.plan_regressions (sql_digest, original_sql, default_db,
+		regressed_plan_digest, regressed_avg_latency, regressed_avg_est_rows, regressed_avg_act_rows,
+		baseline_plan_digest, baseline_avg_latency, baseline_avg_est_rows, baseline_avg_act_rows, bind_sql)
+		VALUES (%?, %?, %?, %?, %?, %?, %?, %?, %?, %?, %?, %?)`,
+		sqlDigest, originalSQL, db,
+		r.regressed.planDigest, int64(r.regressed.avgLatency()), r.regressed.avgEstRows(), r.regressed.avgActRows(),
+		r.baseline.planDigest, int64(r.baseline.avgLatency()), r.baseline.avgEstRows(), r.baseline.avgActRows(), bindSQL)
+	return err
+}
@@ -0,0 +1,239 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bindinfo
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/logutil"
+	utilparser "github.com/pingcap/tidb/util/parser"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+// RenameTables rewrites the global bindings which refer to the renamed tables, so that the bindings follow the
+// tables instead of being applied to the tables created later with the old names. oldTables[i] is renamed to
+// newTables[i] in order, like `RENAME TABLE`, and the rewritten bindings replace the old ones in one transaction.
+func (h *BindHandle) RenameTables(oldTables, newTables []ast.Ident) (err error) {
+	renamed := finalTableNames(oldTables, newTables)
+	var oldRecords, newRecords []*BindRecord
+	for _, record := range h.GetAllBindRecord() {
+		newRecord, err := renameTablesInBindRecord(record, renamed)
+		if err != nil {
+			// The binding is left as it is, like the bindings which fail to be loaded.
+			logutil.BgLogger().Warn("[sql-bind] failed to rename the tables in the binding",
+				zap.String("originalSQL", record.OriginalSQL), zap.Error(err))
+			continue
+		}
+		if newRecord != nil {
+			oldRecords = append(oldRecords, record)
+			newRecords = append(newRecords, newRecord)
+		}
+	}
+	if len(newRecords) == 0 {
+		return nil
+	}
+
+	h.bindInfo.Lock()
+	h.sctx.Lock()
+	defer func() {
+		h.sctx.Unlock()
+		h.bindInfo.Unlock()
+	}()
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnBindInfo)
+	exec, _ := h.sctx.Context.(sqlexec.SQLExecutor)
+	_, err = exec.ExecuteInternal(ctx, "BEGIN PESSIMISTIC")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_, err1 := exec.ExecuteInternal(ctx, "ROLLBACK")
+			terror.Log(err1)
+			return
+		}
+
+		_, err = exec.ExecuteInternal(ctx, "COMMIT")
+		if err != nil {
+			return
+		}
+
+		for i, record := range oldRecords {
+			h.removeBindRecord(parser.DigestNormalized(record.OriginalSQL).String(), &BindRecord{OriginalSQL: record.OriginalSQL, Db: record.Db})
+			h.setBindRecord(parser.DigestNormalized(newRecords[i].OriginalSQL).String(), newRecords[i])
+		}
+	}()
+
+	// Lock mysql.bind_info to synchronize with CreateBindRecord / AddBindRecord / DropBindRecord on other tidb instances.
+	if err = h.lockBindInfoTable(); err != nil {
+		return err
+	}
+
+	now := types.NewTime(types.FromGoTime(time.Now()), mysql.TypeTimestamp, 3)
+	updateTs := now.String()
+	for i, record := range oldRecords {
+		_, err = exec.ExecuteInternal(ctx, `UPDATE mysql.bind_info SET status = %?, update_time = %? WHERE original_sql = %? AND update_time < %? AND status != %?`,
+			deleted, updateTs, record.OriginalSQL, updateTs, deleted)
+		if err != nil {
+			return err
+		}
+		newRecord := newRecords[i]
+		for j := range newRecord.Bindings {
+			newRecord.Bindings[j].UpdateTime = now
+			_, err = exec.ExecuteInternal(ctx, `INSERT INTO mysql.bind_info VALUES (%?, %?, %?, %?, %?, %?, %?, %?, %?, %?, %?)`,
+				newRecord.OriginalSQL,
+				newRecord.Bindings[j].BindSQL,
+				newRecord.Db,
+				newRecord.Bindings[j].Status,
+				newRecord.Bindings[j].CreateTime.String(),
+				newRecord.Bindings[j].UpdateTime.String(),
+				newRecord.Bindings[j].Charset,
+				newRecord.Bindings[j].Collation,
+				newRecord.Bindings[j].Source,
+				newRecord.Bindings[j].SQLDigest,
+				newRecord.Bindings[j].PlanDigest,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// finalTableNames maps the original names of the renamed tables to their final names, a table may be renamed more
+// than once by one statement, e.g. `RENAME TABLE t1 TO tmp, t2 TO t1, tmp TO t2`.
+func finalTableNames(oldTables, newTables []ast.Ident) map[ast.Ident]ast.Ident {
+	// origins maps the current names of the renamed tables to their original names.
+	origins := make(map[ast.Ident]ast.Ident, len(oldTables))
+	for i := range oldTables {
+		oldName, newName := lowerIdent(oldTables[i]), lowerIdent(newTables[i])
+		origin, ok := origins[oldName]
+		if ok {
+			delete(origins, oldName)
+		} else {
+			origin = oldName
+		}
+		origins[newName] = origin
+	}
+	renamed := make(map[ast.Ident]ast.Ident, len(origins))
+	for name, origin := range origins {
+		if name != origin {
+			renamed[origin] = name
+		}
+	}
+	return renamed
+}
+
+func lowerIdent(ident ast.Ident) ast.Ident {
+	return ast.Ident{Schema: model.NewCIStr(ident.Schema.L), Name: model.NewCIStr(ident.Name.L)}
+}
+
+// renameTablesInBindRecord returns the copy of the BindRecord whose tables are renamed, or nil if it doesn't refer to
+// any renamed table.
+func renameTablesInBindRecord(record *BindRecord, renamed map[ast.Ident]ast.Ident) (*BindRecord, error) {
+	if len(record.Bindings) == 0 {
+		return nil, nil
+	}
+	newRecord := &BindRecord{Db: record.Db, Bindings: make([]Binding, 0, len(record.Bindings))}
+	p := parser.New()
+	for _, binding := range record.Bindings {
+		stmt, err := p.ParseOneStmt(binding.BindSQL, binding.Charset, binding.Collation)
+		if err != nil {
+			return nil, err
+		}
+		renamer := &tableRenamer{renamed: renamed, unaliased: make(map[string]ast.Ident)}
+		stmt.Accept(renamer)
+		if !renamer.changed {
+			return nil, nil
+		}
+		renamer.renameHintTables()
+
+		bindSQL := utilparser.RestoreWithDefaultDB(stmt, record.Db, "")
+		// The original SQL is the hinted SQL without the hints, which is checked when the binding is created.
+		originalSQL, sqlDigest := parser.NormalizeDigest(bindSQL)
+		newRecord.OriginalSQL = originalSQL
+		binding.BindSQL = bindSQL
+		binding.SQLDigest = sqlDigest.String()
+		// The hints are parsed again from the new BindSQL.
+		binding.Hint, binding.ID = nil, ""
+		newRecord.Bindings = append(newRecord.Bindings, binding)
+	}
+	if err := newRecord.prepareHints(nil); err != nil {
+		return nil, err
+	}
+	return newRecord, nil
+}
+
+// tableRenamer renames the tables in the BindSQL. The table names are always qualified in the BindSQL, so the
+// unqualified ones are the names of the CTEs, which are not renamed.
+type tableRenamer struct {
+	renamed map[ast.Ident]ast.Ident
+	// unaliased maps the old names of the renamed tables without aliases to their new names, which are used to rename
+	// the tables in the hints.
+	unaliased map[string]ast.Ident
+	hints     []*ast.TableOptimizerHint
+	changed   bool
+}
+
+func (r *tableRenamer) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.TableSource:
+		if tn, ok := x.Source.(*ast.TableName); ok && x.AsName.L == "" {
+			if newName, ok := r.renamed[lowerIdent(ast.Ident{Schema: tn.Schema, Name: tn.Name})]; ok {
+				r.unaliased[tn.Name.L] = newName
+			}
+		}
+	case *ast.TableName:
+		if newName, ok := r.renamed[lowerIdent(ast.Ident{Schema: x.Schema, Name: x.Name})]; ok {
+			x.Schema, x.Name = newName.Schema, newName.Name
+			r.changed = true
+		}
+	case *ast.TableOptimizerHint:
+		r.hints = append(r.hints, x)
+	}
+	return in, false
+}
+
+func (*tableRenamer) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// renameHintTables renames the tables in the hints after all the tables in the statement are visited.
+func (r *tableRenamer) renameHintTables() {
+	for _, hint := range r.hints {
+		for i := range hint.Tables {
+			ht := &hint.Tables[i]
+			if ht.DBName.L != "" {
+				if newName, ok := r.renamed[lowerIdent(ast.Ident{Schema: ht.DBName, Name: ht.TableName})]; ok {
+					ht.DBName, ht.TableName = newName.Schema, newName.Name
+				}
+				continue
+			}
+			if newName, ok := r.unaliased[ht.TableName.L]; ok {
+				// The table may be moved to another schema, so the schema is specified explicitly.
+				ht.DBName, ht.TableName = newName.Schema, newName.Name
+			}
+		}
+	}
+}
@@ -166,11 +166,45 @@ var (
 	TikvConfigLock sync.Mutex
 )
 
+const (
+	// TempPathUsageIngest means the temporary path is used by the ingest engines of DDL.
+	TempPathUsageIngest = "ingest"
+	// TempPathUsageSpill means the temporary path is used by the spilled data of queries.
+	TempPathUsageSpill = "spill"
+)
+
+// TempPath is a temporary directory with its quota.
+type TempPath struct {
+	Path string `toml:"path" json:"path"`
+	// Quota is the max bytes used in the path, 0 means no limitation.
+	Quota int64 `toml:"quota" json:"quota"`
+	// Usage is the purpose of the path, it's "ingest", "spill" or empty for both of them.
+	Usage string `toml:"usage" json:"usage"`
+}
+
+// Valid checks if the temporary path is valid.
+func (p *TempPath) Valid() error {
+	if p.Path == "" {
+		return fmt.Errorf("path of [[temp-paths]] can't be empty")
+	}
+	if p.Quota < 0 {
+		return fmt.Errorf("quota of [[temp-paths]] %s should be greater than or equal to 0", p.Path)
+	}
+	switch p.Usage {
+	case "", TempPathUsageIngest, TempPathUsageSpill:
+	default:
+		return fmt.Errorf("usage of [[temp-paths]] %s should be one of \"%s\", \"%s\" or empty, got %s",
+			p.Path, TempPathUsageIngest, TempPathUsageSpill, p.Usage)
+	}
+	return nil
+}
+
 // Config contains configuration options.
 type Config struct {
 	Host             string `toml:"host" json:"host"`
 	AdvertiseAddress string `toml:"advertise-address" json:"advertise-address"`
 	Port             uint   `toml:"port" json:"port"`
+	XProtocolPort    uint   `toml:"xprotocol-port" json:"xprotocol-port"`
 	Cors             string `toml:"cors" json:"cors"`
 	Store            string `toml:"store" json:"store"`
 	Path             string `toml:"path" json:"path"`
@@ -253,6 +287,9 @@ type Config struct {
 	// EnableTCP4Only enables net.Listen("tcp4",...)
 	// Note that: it can make lvs with toa work and thus tidb can get real client ip.
 	EnableTCP4Only bool `toml:"enable-tcp4-only" json:"enable-tcp4-only"`
+	// EnableSessionTrack advertises the CLIENT_SESSION_TRACK capability to the clients, then the resource usage
+	// of each statement can be returned in the OK packet when tidb_session_track_resource_usage is on.
+	EnableSessionTrack bool `toml:"enable-session-track" json:"enable-session-track"`
 	// The client will forward the requests through the follower
 	// if one of the following conditions happens:
 	// 1. there is a network partition problem between TiDB and PD leader.
@@ -303,6 +340,10 @@ type Config struct {
 	TiDBMaxReuseColumn uint32 `toml:"tidb-max-reuse-column" json:"tidb-max-reuse-column"`
 	// TiDBEnableExitCheck indicates whether exit-checking in domain for background process
 	TiDBEnableExitCheck bool `toml:"tidb-enable-exit-check" json:"tidb-enable-exit-check"`
+
+	// TempPaths are the temporary directories dedicated to the ingest engines of DDL and the spilled data of queries,
+	// so that the I/O can be directed to the dedicated disks instead of contending with the data directory.
+	TempPaths []TempPath `toml:"temp-paths" json:"temp-paths"`
 }
 
 // UpdateTempStoragePath is to update the `TempStoragePath` if port/statusPort was changed
@@ -1319,6 +1360,16 @@ func (c *Config) Valid() error {
 	if err := c.TrxSummary.Valid(); err != nil {
 		return err
 	}
+	paths := make(map[string]struct{}, len(c.TempPaths))
+	for i := range c.TempPaths {
+		if err := c.TempPaths[i].Valid(); err != nil {
+			return err
+		}
+		if _, ok := paths[c.TempPaths[i].Path]; ok {
+			return fmt.Errorf("duplicated path %s in [[temp-paths]]", c.TempPaths[i].Path)
+		}
+		paths[c.TempPaths[i].Path] = struct{}{}
+	}
 
 	if c.Performance.TxnTotalSizeLimit > 1<<40 {
 		return fmt.Errorf("txn-total-size-limit should be less than %d", 1<<40)
@@ -1159,6 +1159,21 @@ func TestTableColumnCountLimit(t *testing.T) {
 	checkValid(DefMaxOfTableColumnCountLimit+1, false)
 }
 
+func TestTempPathsValid(t *testing.T) {
+	conf := NewConfig()
+	checkValid := func(paths []TempPath, shouldBeValid bool) {
+		conf.TempPaths = paths
+		require.Equal(t, shouldBeValid, conf.Valid() == nil)
+	}
+	checkValid(nil, true)
+	checkValid([]TempPath{{Path: "/nvme0/tmp", Quota: 1024, Usage: TempPathUsageIngest}, {Path: "/nvme1/tmp"}}, true)
+	checkValid([]TempPath{{Path: "/nvme0/tmp", Usage: TempPathUsageSpill}}, true)
+	checkValid([]TempPath{{Path: ""}}, false)
+	checkValid([]TempPath{{Path: "/nvme0/tmp", Quota: -1}}, false)
+	checkValid([]TempPath{{Path: "/nvme0/tmp", Usage: "backup"}}, false)
+	checkValid([]TempPath{{Path: "/nvme0/tmp"}, {Path: "/nvme0/tmp", Usage: TempPathUsageIngest}}, false)
+}
+
 func TestEncodeDefTempStorageDir(t *testing.T) {
 	tests := []struct {
 		host       string
@@ -36,12 +36,17 @@ import (
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/store/copr"
 	"github.com/pingcap/tidb/store/driver/backoff"
+	storeerr "github.com/pingcap/tidb/store/driver/error"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/bgworker"
 	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/gcutil"
 	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tidb/util/memory"
 	decoder "github.com/pingcap/tidb/util/rowDecoder"
 	"github.com/pingcap/tidb/util/timeutil"
 	"github.com/pingcap/tidb/util/topsql"
@@ -50,6 +55,8 @@ import (
 	"go.uber.org/zap"
 )
 
+// backfillerType is the type of the backfill workers. The value is persisted in the backfill jobs,
+// so the existing values must not be changed.
 type backfillerType byte
 
 const (
@@ -58,23 +65,17 @@ const (
 	typeCleanUpIndexWorker     backfillerType = 2
 	typeAddIndexMergeTmpWorker backfillerType = 3
 	typeReorgPartitionWorker   backfillerType = 4
+	typeAddFulltextIndexWorker backfillerType = 5
+	typeVerifyIndexWorker      backfillerType = 6
+	typeCheckConstraintWorker  backfillerType = 7
+	typeAddStoredColumnWorker  backfillerType = 8
 )
 
 func (bT backfillerType) String() string {
-	switch bT {
-	case typeAddIndexWorker:
-		return "add index"
-	case typeUpdateColumnWorker:
-		return "update column"
-	case typeCleanUpIndexWorker:
-		return "clean up index"
-	case typeAddIndexMergeTmpWorker:
-		return "merge temporary index"
-	case typeReorgPartitionWorker:
-		return "reorganize partition"
-	default:
-		return "unknown"
+	if desc, ok := getBackfiller(bT); ok {
+		return desc.name
 	}
+	return "unknown"
 }
 
 // By now the DDL jobs that need backfilling include:
@@ -133,10 +134,12 @@ func (bT backfillerType) String() string {
 // backfillTaskContext is the context of the batch adding indices or updating column values.
 // After finishing the batch adding indices or updating column values, result in backfillTaskContext will be merged into backfillResult.
 type backfillTaskContext struct {
-	nextKey       kv.Key
-	done          bool
-	addedCount    int
-	scanCount     int
+	nextKey    kv.Key
+	done       bool
+	addedCount int
+	scanCount  int
+	// addedBytes is the size of the added records, it's used to show the merge progress and limit the reorg rate.
+	addedBytes    int
 	warnings      map[errors.ErrorID]*terror.Error
 	warningsCount map[errors.ErrorID]int64
 	finishTS      uint64
@@ -151,6 +154,69 @@ type backfillCtx struct {
 	batchCnt      int
 	jobContext    *JobContext
 	metricCounter prometheus.Counter
+	// memConsumed is the memory usage of the records in the current batch.
+	memConsumed int64
+	// shrunkBatchCnt is the batch size shrunk by the memory quota, it's kept across the tasks of the worker.
+	// 0 means the batch size isn't shrunk.
+	shrunkBatchCnt int
+}
+
+// backfillMemTracker tracks the memory usage of the records fetched by all the backfill workers in this node,
+// including the txn, ingest and merge workers. It is a child of the background worker quota, which is attached
+// to the global memory tracker of the server, so the backfill memory is counted in the server memory usage.
+var backfillMemTracker = func() *memory.Tracker {
+	t := memory.NewTracker(memory.LabelForDDLBackfill, -1)
+	t.AttachTo(bgworker.GlobalQuota.MemTracker())
+	return t
+}()
+
+// consumeBatchMemory tracks the memory usage of a record fetched in the current batch.
+func (b *backfillCtx) consumeBatchMemory(bytes int64) {
+	b.memConsumed += bytes
+	backfillMemTracker.Consume(bytes)
+}
+
+// releaseBatchMemory releases the memory usage of the current batch after it is handled.
+func (b *backfillCtx) releaseBatchMemory() {
+	backfillMemTracker.Consume(-b.memConsumed)
+	b.memConsumed = 0
+}
+
+// shrinkBatchIfMemoryExceeded reduces the batch size when the memory usage of the backfill workers exceeds
+// tidb_ddl_reorg_max_memory, so that huge rows or wide indexes can't make the DDL owner OOM.
+// It returns true if the quota is exceeded, and the caller should stop fetching records for the current batch.
+func (b *backfillCtx) shrinkBatchIfMemoryExceeded() bool {
+	quota := variable.DDLReorgMaxMemory.Load()
+	if quota <= 0 || backfillMemTracker.BytesConsumed() <= quota {
+		return false
+	}
+	if minBatchCnt := int(variable.MinDDLReorgBatchSize); b.batchCnt > minBatchCnt {
+		b.batchCnt = mathutil.Max(b.batchCnt/2, minBatchCnt)
+		logutil.BgLogger().Info("[ddl] backfill memory quota exceeded, reduce the batch size",
+			zap.Int("worker ID", b.id), zap.Int("batch size", b.batchCnt),
+			zap.Int64("memory usage", backfillMemTracker.BytesConsumed()), zap.Int64("memory quota", quota))
+	}
+	b.shrunkBatchCnt = b.batchCnt
+	return true
+}
+
+// adjustBatchSize sets the batch size before handling a task. It follows tidb_ddl_reorg_batch_size to change the
+// batch size dynamically, but a batch size shrunk by the memory quota is kept, and it's doubled for each task only
+// when the memory usage of the backfill workers drops below half of the quota.
+func (b *backfillCtx) adjustBatchSize() {
+	batchCnt := int(variable.GetDDLReorgBatchSize())
+	if b.shrunkBatchCnt > 0 {
+		quota := variable.DDLReorgMaxMemory.Load()
+		if quota <= 0 || backfillMemTracker.BytesConsumed() <= quota/2 {
+			b.shrunkBatchCnt *= 2
+		}
+		if b.shrunkBatchCnt < batchCnt {
+			batchCnt = b.shrunkBatchCnt
+		} else {
+			b.shrunkBatchCnt = 0
+		}
+	}
+	b.batchCnt = batchCnt
 }
 
 func newBackfillCtx(ctx *ddlCtx, id int, sessCtx sessionctx.Context,
@@ -243,6 +309,9 @@ type backfillWorker struct {
 	resultCh chan *backfillResult
 	ctx      context.Context
 	cancel   func()
+	job      *model.Job
+	// procID is the ID of the worker session tracked by the sysProcTracker, it's 0 if the session isn't tracked.
+	procID uint64
 }
 
 func newBackfillWorker(ctx context.Context, bf backfiller) *backfillWorker {
@@ -280,6 +349,39 @@ func (w *backfillWorker) Close() {
 		w.cancel()
 		w.cancel = nil
 	}
+	if w.procID != 0 {
+		w.GetCtx().sysProcTracker.UnTrack(w.procID)
+		w.procID = 0
+	}
+}
+
+// trackSession tracks the session of the worker, so it's shown in the processlist and can be killed.
+func (w *backfillWorker) trackSession(job *model.Job) {
+	tracker := w.GetCtx().sysProcTracker
+	if tracker == nil {
+		return
+	}
+	id, err := tracker.TrackInternal(w.GetCtx().sessCtx, "backfill", strconv.FormatInt(job.ID, 10), job.Query)
+	if err != nil {
+		logutil.BgLogger().Warn("[ddl] track backfill worker session failed", zap.Stringer("worker", w), zap.Error(err))
+		return
+	}
+	w.procID = id
+}
+
+// isKilled checks whether the session of the worker is killed by the KILL statement.
+func (w *backfillWorker) isKilled() bool {
+	return atomic.LoadUint32(&w.GetCtx().sessCtx.GetSessionVars().Killed) == 1
+}
+
+// Start implements the bgworker.Worker interface.
+func (w *backfillWorker) Start() {
+	go w.run(w.GetCtx().ddlCtx, w.backfiller, w.job)
+}
+
+// Stop implements the bgworker.Worker interface.
+func (w *backfillWorker) Stop() {
+	w.Close()
 }
 
 func closeBackfillWorkers(workers []*backfillWorker) {
@@ -291,6 +393,38 @@ func closeBackfillWorkers(workers []*backfillWorker) {
 // ResultCounterForTest is used for test.
 var ResultCounterForTest *atomic.Int32
 
+const (
+	// backfillTaskMaxRetryCnt is the max retry count of a backfill task when it meets transient errors.
+	backfillTaskMaxRetryCnt = 5
+	// backfillTaskRetryBaseInterval is the backoff before the first retry, and it is doubled for each retry.
+	backfillTaskRetryBaseInterval = 200 * time.Millisecond
+	// backfillTaskRetryMaxInterval is the max backoff between two retries.
+	backfillTaskRetryMaxInterval = 10 * time.Second
+)
+
+// isRetryableBackfillError checks whether the error is a transient error of TiKV or PD,
+// which may disappear if the backfill task is retried later.
+func isRetryableBackfillError(err error) bool {
+	return kv.IsTxnRetryableError(err) ||
+		storeerr.ErrTiKVServerBusy.Equal(err) ||
+		storeerr.ErrTiKVServerTimeout.Equal(err) ||
+		storeerr.ErrTiKVStaleCommand.Equal(err) ||
+		storeerr.ErrRegionUnavailable.Equal(err) ||
+		storeerr.ErrPDServerTimeout.Equal(err)
+}
+
+// backfillTaskRetryInterval returns the exponential backoff before the retryCnt-th retry.
+func backfillTaskRetryInterval(retryCnt int) time.Duration {
+	interval := backfillTaskRetryBaseInterval
+	for i := 1; i < retryCnt && interval < backfillTaskRetryMaxInterval; i++ {
+		interval *= 2
+	}
+	if interval > backfillTaskRetryMaxInterval {
+		interval = backfillTaskRetryMaxInterval
+	}
+	return interval
+}
+
 // handleBackfillTask backfills range [task.startHandle, task.endHandle) handle's index to table.
 func (w *backfillWorker) handleBackfillTask(d *ddlCtx, task *reorgBackfillTask, bf backfiller) *backfillResult {
 	handleRange := *task
@@ -312,6 +446,11 @@ func (w *backfillWorker) handleBackfillTask(d *ddlCtx, task *reorgBackfillTask,
 		w.initPartitionIndexInfo(task)
 		jobID = genBackfillJobReorgCtxID(jobID)
 	}
+	// The ingest worker fetches the rows of the task from the cop request sender pool,
+	// which can't be read again, so only the other backfill workers can retry the task.
+	_, isIngest := bf.(*addIndexIngestWorker)
+	_, isMerge := bf.(*mergeIndexWorker)
+	retryCnt := 0
 	for {
 		// Give job chance to be canceled, if we not check it here,
 		// if there is panic in bf.BackfillData we will never cancel the job.
@@ -322,11 +461,44 @@ func (w *backfillWorker) handleBackfillTask(d *ddlCtx, task *reorgBackfillTask,
 			result.err = err
 			return result
 		}
+		// Killing a worker cancels the job, because the rest of its tasks can't be skipped.
+		if w.isKilled() {
+			logutil.BgLogger().Info("[ddl] backfill worker is killed", zap.Stringer("worker", w), zap.Stringer("task", task))
+			result.err = dbterror.ErrCancelledDDLJob
+			return result
+		}
 
-		taskCtx, err := bf.BackfillData(handleRange)
+		var taskCtx backfillTaskContext
+		failpoint.Inject("mockBackfillRetryableErr", func(val failpoint.Value) {
+			//nolint:forcetypeassert
+			if val.(bool) && retryCnt == 0 {
+				err = storeerr.ErrTiKVServerBusy
+			}
+		})
+		if err == nil {
+			taskCtx, err = bf.BackfillData(handleRange)
+			bf.GetCtx().releaseBatchMemory()
+		}
 		if err != nil {
-			result.err = err
-			return result
+			if isIngest || retryCnt >= backfillTaskMaxRetryCnt || !isRetryableBackfillError(err) {
+				result.err = err
+				return result
+			}
+			// The batches committed before the error are skipped by handleRange.startKey,
+			// so the retry starts from the first uncommitted batch of the task.
+			retryCnt++
+			metrics.BackfillRetryCounter.WithLabelValues(bf.String()).Inc()
+			interval := backfillTaskRetryInterval(retryCnt)
+			logutil.BgLogger().Warn("[ddl] backfill worker meets retryable error, retry the task",
+				zap.Stringer("worker", w), zap.Stringer("task", task), zap.Int("retryCnt", retryCnt),
+				zap.Duration("backoff", interval), zap.Error(err))
+			select {
+			case <-w.ctx.Done():
+				result.err = err
+				return result
+			case <-time.After(interval):
+			}
+			continue
 		}
 
 		bf.AddMetricInfo(float64(taskCtx.addedCount))
@@ -341,7 +513,11 @@ func (w *backfillWorker) handleBackfillTask(d *ddlCtx, task *reorgBackfillTask,
 		// So for added count and warnings collection, it is recommended to collect the statistics in every
 		// successfully committed small ranges rather than fetching it in the total result.
 		rc.increaseRowCount(int64(taskCtx.addedCount))
+		if isMerge {
+			rc.increaseMergeProgress(int64(taskCtx.addedCount), int64(taskCtx.addedBytes))
+		}
 		rc.mergeWarnings(taskCtx.warnings, taskCtx.warningsCount)
+		rc.throttle(w.ctx, taskCtx.addedBytes)
 
 		if num := result.scanCount - lastLogCount; num >= 90000 {
 			lastLogCount = result.scanCount
@@ -418,7 +594,7 @@ func (w *backfillWorker) runTask(task *reorgBackfillTask) (result *backfillResul
 	})
 
 	// Change the batch size dynamically.
-	w.GetCtx().batchCnt = int(variable.GetDDLReorgBatchSize())
+	w.GetCtx().adjustBatchSize()
 	result = w.handleBackfillTask(w.GetCtx().ddlCtx, task, w.backfiller)
 	task.bfJob.Meta.RowCount = int64(result.addedCount)
 	if result.err != nil {
@@ -480,7 +656,7 @@ func (w *backfillWorker) run(d *ddlCtx, bf backfiller, job *model.Job) {
 		})
 
 		// Change the batch size dynamically.
-		w.GetCtx().batchCnt = int(variable.GetDDLReorgBatchSize())
+		w.GetCtx().adjustBatchSize()
 		result := w.handleBackfillTask(d, task, bf)
 		w.resultCh <- result
 		if result.err != nil {
@@ -520,6 +696,98 @@ func splitTableRanges(t table.PhysicalTable, store kv.Storage, startKey, endKey
 	return ranges, nil
 }
 
+// preSplitSampleStep is the number of rows between two sampled handles when pre-splitting the table regions.
+const preSplitSampleStep = 10000
+
+// preSplitTableRegions splits the table regions by the sampled handles if the key range of the table lies in too
+// few regions to run all the backfill workers in parallel. Since a range is processed by a worker, a table in a
+// handful of huge regions would otherwise be backfilled by a handful of workers at the start of the job.
+// It's best-effort, the errors are only logged.
+func (dc *ddlCtx) preSplitTableRegions(sessCtx sessionctx.Context, t table.PhysicalTable, reorgInfo *reorgInfo, startKey, endKey kv.Key) {
+	if !variable.EnableDDLReorgPreSplit.Load() {
+		return
+	}
+	s, ok := reorgInfo.d.store.(kv.SplittableStore)
+	if !ok {
+		return
+	}
+	workerCnt := int(variable.GetDDLReorgWorkerCounter())
+	kvRanges, err := splitTableRanges(t, reorgInfo.d.store, startKey, endKey, workerCnt)
+	if err != nil || len(kvRanges) >= workerCnt {
+		return
+	}
+
+	jc := dc.jobContext(reorgInfo.Job.ID)
+	samples, err := sampleRecordKeys(jc, reorgInfo.d.store, t.RecordPrefix(), reorgInfo.getSnapshotVer(), startKey, endKey)
+	if err != nil {
+		logutil.BgLogger().Warn("[ddl] sample the record keys to pre-split the table failed",
+			zap.Int64("physicalTableID", t.GetPhysicalID()), zap.Error(err))
+		return
+	}
+	splitKeys := pickPreSplitKeys(samples, workerCnt)
+	if len(splitKeys) == 0 {
+		return
+	}
+
+	physicalID := t.GetPhysicalID()
+	ctx, cancel := context.WithTimeout(dc.ctx, sessCtx.GetSessionVars().GetSplitRegionTimeout())
+	defer cancel()
+	ctx = kv.WithInternalSourceType(ctx, jc.ddlJobSourceType())
+	regionIDs, err := s.SplitRegions(ctx, splitKeys, true, &physicalID)
+	if err != nil {
+		logutil.BgLogger().Warn("[ddl] pre-split the table regions before backfilling failed",
+			zap.Int64("physicalTableID", physicalID), zap.Int("successful region count", len(regionIDs)), zap.Error(err))
+	}
+	WaitScatterRegionFinish(ctx, s, regionIDs...)
+	logutil.BgLogger().Info("[ddl] pre-split the table regions before backfilling",
+		zap.Int64("physicalTableID", physicalID), zap.Int("origin region count", len(kvRanges)),
+		zap.Int("sample count", len(samples)), zap.Int("split region count", len(regionIDs)))
+}
+
+// sampleRecordKeys returns a record key every preSplitSampleStep rows in the range [startKey, endKey].
+func sampleRecordKeys(ctx *JobContext, store kv.Storage, recordPrefix kv.Key, version uint64, startKey, endKey kv.Key) ([]kv.Key, error) {
+	ver := kv.MaxVersion
+	if version != 0 {
+		ver = kv.Version{Ver: version}
+	}
+	snap := store.GetSnapshot(ver)
+	snap.SetOption(kv.SampleStep, uint32(preSplitSampleStep))
+	snap.SetOption(kv.Priority, kv.PriorityLow)
+	snap.SetOption(kv.NotFillCache, true)
+	snap.SetOption(kv.RequestSourceInternal, true)
+	snap.SetOption(kv.RequestSourceType, ctx.ddlJobSourceType())
+
+	it, err := snap.Iter(startKey, endKey.Next())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer it.Close()
+	var samples []kv.Key
+	for it.Valid() && it.Key().HasPrefix(recordPrefix) {
+		samples = append(samples, it.Key().Clone())
+		if err = it.Next(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return samples, nil
+}
+
+// pickPreSplitKeys picks the keys from the sorted samples to split the range into at most regionCnt regions of
+// similar sizes.
+func pickPreSplitKeys(samples []kv.Key, regionCnt int) [][]byte {
+	if regionCnt > len(samples) {
+		regionCnt = len(samples)
+	}
+	if regionCnt < 2 {
+		return nil
+	}
+	splitKeys := make([][]byte, 0, regionCnt-1)
+	for i := 1; i < regionCnt; i++ {
+		splitKeys = append(splitKeys, samples[i*len(samples)/regionCnt])
+	}
+	return splitKeys
+}
+
 func waitTaskResults(scheduler *backfillScheduler, batchTasks []*reorgBackfillTask,
 	totalAddedCount *int64) (kv.Key, int64, error) {
 	var (
@@ -589,6 +857,7 @@ func (dc *ddlCtx) sendTasksAndWait(scheduler *backfillScheduler, totalAddedCount
 	}
 
 	// Update the reorg handle that has been processed.
+	nextKey = scheduler.capNextKeyByDeferred(nextKey)
 	err1 := reorgInfo.UpdateReorgMeta(nextKey, scheduler.sessPool)
 
 	if err != nil {
@@ -681,9 +950,12 @@ func (dc *ddlCtx) handleRangeTasks(scheduler *backfillScheduler, t table.Physica
 	}
 
 	// Wait tasks finish.
-	err := dc.sendTasksAndWait(scheduler, totalAddedCount, batchTasks)
-	if err != nil {
-		return nil, errors.Trace(err)
+	runTasks := scheduler.pickTasks(batchTasks, dc.newLockCounter(scheduler.jobCtx))
+	if len(runTasks) > 0 {
+		err := dc.sendTasksAndWait(scheduler, totalAddedCount, runTasks)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
 	}
 
 	if len(batchTasks) < len(kvRanges) {
@@ -808,7 +1080,17 @@ func (dc *ddlCtx) writePhysicalTableRecord(sessPool *sessionPool, t table.Physic
 		}
 	}
 
+	if !reorgInfo.mergingTmpIdx {
+		dc.preSplitTableRegions(sessCtx, t, reorgInfo, startKey, endKey)
+	}
+
 	for {
+		if !reorgInfo.mergingTmpIdx {
+			startKey, endKey, err = dc.refreshReorgSnapshotIfNeeded(sessPool, t, reorgInfo, startKey)
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
 		kvRanges, err := splitTableRanges(t, reorgInfo.d.store, startKey, endKey, backfillTaskChanSize)
 		if err != nil {
 			return errors.Trace(err)
@@ -831,7 +1113,7 @@ func (dc *ddlCtx) writePhysicalTableRecord(sessPool *sessionPool, t table.Physic
 			zap.String("endKey", hex.EncodeToString(endKey)))
 
 		if ingestBeCtx != nil {
-			err := ingestBeCtx.Flush(reorgInfo.currElement.ID)
+			err := ingestBeCtx.Flush(reorgInfo.currElement.ID, t.GetPhysicalID())
 			if err != nil {
 				return errors.Trace(err)
 			}
@@ -850,12 +1132,91 @@ func (dc *ddlCtx) writePhysicalTableRecord(sessPool *sessionPool, t table.Physic
 			break
 		}
 	}
+	if err := dc.handleDeferredTasks(scheduler, &totalAddedCount); err != nil {
+		return errors.Trace(err)
+	}
 	if ingestBeCtx != nil {
-		ingestBeCtx.EngMgr.ResetWorkers(ingestBeCtx, job.ID, reorgInfo.currElement.ID)
+		ingestBeCtx.EngMgr.ResetWorkers(ingestBeCtx, job.ID, reorgInfo.currElement.ID, t.GetPhysicalID())
 	}
 	return nil
 }
 
+// refreshReorgSnapshotIfNeeded advances the snapshot version of the reorg job when it falls behind the GC safe point.
+// The key range of the reorganization is calculated with the snapshot taken when the job started. For a long-running
+// job, the records written after this snapshot may locate beyond the original end key, so the end key is extended by
+// the range at the new snapshot, and the backfill goes on from the processed key.
+//
+// The records in the processed range modified between the two snapshots are the delta to re-apply. The element is
+// writable since before the original snapshot is taken, so the delta is already applied by the DMLs themselves, or
+// by the merge of the temporary index in the ingest mode, and the processed range is not scanned again. The new end
+// key is persisted in tidb_ddl_reorg before it is used.
+func (dc *ddlCtx) refreshReorgSnapshotIfNeeded(sessPool *sessionPool, t table.PhysicalTable,
+	reorgInfo *reorgInfo, startKey kv.Key) (kv.Key, kv.Key, error) {
+	job := reorgInfo.Job
+	snapshotVer := reorgInfo.getSnapshotVer()
+	if snapshotVer == 0 {
+		return startKey, reorgInfo.EndKey, nil
+	}
+	safePoint, err := getReorgGCSafePoint(sessPool)
+	mockExpired := false
+	failpoint.Inject("mockReorgSnapshotExpired", func(val failpoint.Value) {
+		//nolint:forcetypeassert
+		if val.(bool) {
+			safePoint, err = snapshotVer, nil
+			mockExpired = true
+		}
+	})
+	if err != nil {
+		// The safe point may be absent if GC has never run, it's safe to keep the current snapshot.
+		logutil.BgLogger().Warn("[ddl] cannot get GC safe point, skip refreshing reorg snapshot",
+			zap.Int64("jobID", job.ID), zap.Error(err))
+		return startKey, reorgInfo.EndKey, nil
+	}
+	if snapshotVer > safePoint {
+		return startKey, reorgInfo.EndKey, nil
+	}
+	ver, err := getValidCurrentVersion(dc.store)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	_, newEndKey, err := getTableRange(dc.jobContext(job.ID), dc, t, ver.Ver, job.Priority)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	oldEndKey := reorgInfo.EndKey
+	if newEndKey.Cmp(oldEndKey) > 0 {
+		reorgInfo.EndKey = newEndKey
+	}
+	if err := reorgInfo.UpdateReorgMeta(startKey, sessPool); err != nil {
+		reorgInfo.EndKey = oldEndKey
+		return nil, nil, errors.Trace(err)
+	}
+	logutil.BgLogger().Info("[ddl] refresh reorg snapshot",
+		zap.Int64("jobID", job.ID), zap.Int64("physicalTableID", t.GetPhysicalID()),
+		zap.Uint64("old snapshot", snapshotVer), zap.Uint64("new snapshot", ver.Ver),
+		zap.Uint64("GC safe point", safePoint),
+		zap.String("processed to", hex.EncodeToString(startKey)),
+		zap.String("old end key", hex.EncodeToString(oldEndKey)),
+		zap.String("new end key", hex.EncodeToString(reorgInfo.EndKey)))
+	if mockExpired && MockReorgSnapshotRefreshed != nil {
+		MockReorgSnapshotRefreshed(snapshotVer, ver.Ver)
+	}
+	reorgInfo.setSnapshotVer(ver.Ver)
+	return startKey, reorgInfo.EndKey, nil
+}
+
+// MockReorgSnapshotRefreshed is only used for test.
+var MockReorgSnapshotRefreshed func(oldVer, newVer uint64)
+
+func getReorgGCSafePoint(sessPool *sessionPool) (uint64, error) {
+	sCtx, err := sessPool.get()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer sessPool.put(sCtx)
+	return gcutil.GetGCSafePoint(sCtx)
+}
+
 func injectCheckBackfillWorkerNum(curWorkerSize int, isMergeWorker bool) error {
 	if isMergeWorker {
 		return nil
@@ -0,0 +1,214 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/tikv/client-go/v2/tikv"
+	"github.com/tikv/client-go/v2/tikvrpc"
+	"go.uber.org/zap"
+)
+
+const (
+	// backfillLockedRangeThreshold is the number of the pessimistic locks in a range from which the range is deferred.
+	backfillLockedRangeThreshold = 8
+	// maxBackfillRangeDeferrals is the number of the rounds a range can be deferred, after which it's backfilled even
+	// if it's still locked.
+	maxBackfillRangeDeferrals = 3
+	// deferredBackfillRetryInterval is the interval to probe the deferred ranges again after all the other ranges are
+	// backfilled.
+	deferredBackfillRetryInterval = time.Second
+	// probeLockMaxBackoff is the max backoff time in milliseconds to probe the locks of a range.
+	probeLockMaxBackoff = 2000
+)
+
+// deferredBackfillTask is a backfill task deferred because its range has many pessimistic locks, which are usually
+// held by the transactions updating a few hot rows. Backfilling the range later avoids retrying on the lock conflicts.
+type deferredBackfillTask struct {
+	task      *reorgBackfillTask
+	deferrals int
+}
+
+// countPessimisticLocks returns the number of the pessimistic locks in [startKey, endKey) whose start ts is not larger
+// than maxVersion. The locks are scanned by a ScanLock request of at most limit locks on each region, it stops once
+// limit pessimistic locks are found.
+func countPessimisticLocks(ctx context.Context, s tikv.Storage, startKey, endKey kv.Key, maxVersion uint64, limit int) (int, error) {
+	req := tikvrpc.NewRequest(tikvrpc.CmdScanLock, &kvrpcpb.ScanLockRequest{
+		MaxVersion: maxVersion,
+		Limit:      uint32(limit),
+	})
+	bo := tikv.NewBackofferWithVars(ctx, probeLockMaxBackoff, nil)
+	count := 0
+	key := startKey
+	for count < limit && bytes.Compare(key, endKey) < 0 {
+		loc, err := s.GetRegionCache().LocateKey(bo, key)
+		if err != nil {
+			return count, errors.Trace(err)
+		}
+		regionEnd := kv.Key(loc.EndKey)
+		isLast := len(regionEnd) == 0 || regionEnd.Cmp(endKey) >= 0
+		if isLast {
+			regionEnd = endKey
+		}
+		req.ScanLock().StartKey, req.ScanLock().EndKey = key, regionEnd
+		resp, err := s.SendReq(bo, req, loc.Region, tikv.ReadTimeoutShort)
+		if err != nil {
+			return count, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return count, errors.Trace(err)
+		}
+		if regionErr != nil {
+			if err = bo.Backoff(tikv.BoRegionMiss(), errors.New(regionErr.String())); err != nil {
+				return count, errors.Trace(err)
+			}
+			continue
+		}
+		locksResp, ok := resp.Resp.(*kvrpcpb.ScanLockResponse)
+		if !ok {
+			return count, errors.New("scan lock response body is missing")
+		}
+		if locksResp.GetError() != nil {
+			return count, errors.Errorf("unexpected scan lock error: %s", locksResp.GetError())
+		}
+		for _, lock := range locksResp.GetLocks() {
+			if lock.LockType == kvrpcpb.Op_PessimisticLock {
+				count++
+			}
+		}
+		if isLast {
+			break
+		}
+		key = regionEnd
+	}
+	return count, nil
+}
+
+// newLockCounter returns the function counting the pessimistic locks in the range of a backfill task, or nil if the
+// locked ranges aren't deferred.
+func (dc *ddlCtx) newLockCounter(jobCtx *JobContext) func(task *reorgBackfillTask) (int, error) {
+	if !variable.DDLReorgDeferLockedRanges.Load() {
+		return nil
+	}
+	s, ok := dc.store.(tikv.Storage)
+	if !ok {
+		return nil
+	}
+	ver, err := dc.store.CurrentVersion(kv.GlobalTxnScope)
+	if err != nil {
+		logutil.BgLogger().Warn("[ddl] get the current version to probe the locks failed", zap.Error(err))
+		return nil
+	}
+	ctx := kv.WithInternalSourceType(dc.ctx, jobCtx.ddlJobSourceType())
+	return func(task *reorgBackfillTask) (int, error) {
+		return countPessimisticLocks(ctx, s, task.startKey, task.excludedEndKey(), ver.Ver, backfillLockedRangeThreshold)
+	}
+}
+
+// pickTasks returns the tasks to run in this round. The deferred tasks of the previous rounds run if they aren't locked
+// any more or have been deferred too many times, and the new tasks whose ranges are locked are deferred. The tasks to
+// run are in the order of their keys and are renumbered for the done task keeper. The ranges are probed best-effort,
+// the range which fails to be probed is taken as not locked.
+func (b *backfillScheduler) pickTasks(tasks []*reorgBackfillTask, countLocks func(task *reorgBackfillTask) (int, error)) []*reorgBackfillTask {
+	if countLocks == nil {
+		runTasks := make([]*reorgBackfillTask, 0, len(b.deferredTasks)+len(tasks))
+		for _, d := range b.deferredTasks {
+			runTasks = append(runTasks, d.task)
+		}
+		b.deferredTasks = nil
+		return renumberTasks(append(runTasks, tasks...))
+	}
+	isLocked := func(task *reorgBackfillTask) bool {
+		count, err := countLocks(task)
+		if err != nil {
+			logutil.BgLogger().Warn("[ddl] probe the locks of the backfill range failed", zap.Stringer("task", task), zap.Error(err))
+			return false
+		}
+		return count >= backfillLockedRangeThreshold
+	}
+	runTasks := make([]*reorgBackfillTask, 0, len(b.deferredTasks)+len(tasks))
+	deferred := b.deferredTasks[:0]
+	for _, d := range b.deferredTasks {
+		if d.deferrals < maxBackfillRangeDeferrals && isLocked(d.task) {
+			d.deferrals++
+			deferred = append(deferred, d)
+			continue
+		}
+		runTasks = append(runTasks, d.task)
+	}
+	for _, task := range tasks {
+		if isLocked(task) {
+			logutil.BgLogger().Info("[ddl] defer backfilling the range with many pessimistic locks", zap.Stringer("task", task))
+			deferred = append(deferred, &deferredBackfillTask{task: task, deferrals: 1})
+			continue
+		}
+		runTasks = append(runTasks, task)
+	}
+	b.deferredTasks = deferred
+	return renumberTasks(runTasks)
+}
+
+func renumberTasks(tasks []*reorgBackfillTask) []*reorgBackfillTask {
+	for i, task := range tasks {
+		task.id = i
+	}
+	return tasks
+}
+
+// capNextKeyByDeferred returns the next key to persist as the reorg handle, which is before the first deferred range
+// since the ranges before the handle are taken as done when the job resumes.
+func (b *backfillScheduler) capNextKeyByDeferred(nextKey kv.Key) kv.Key {
+	for _, d := range b.deferredTasks {
+		if d.task.startKey.Cmp(nextKey) < 0 {
+			nextKey = d.task.startKey
+		}
+	}
+	return nextKey
+}
+
+// handleDeferredTasks backfills the deferred ranges after all the other ranges are backfilled. The ranges which are
+// still locked are probed again after a while until they're deferred too many times.
+func (dc *ddlCtx) handleDeferredTasks(scheduler *backfillScheduler, totalAddedCount *int64) error {
+	for len(scheduler.deferredTasks) > 0 {
+		runTasks := scheduler.pickTasks(nil, dc.newLockCounter(scheduler.jobCtx))
+		if len(runTasks) > 0 {
+			if err := dc.sendTasksAndWait(scheduler, totalAddedCount, runTasks); err != nil {
+				return errors.Trace(err)
+			}
+			continue
+		}
+		logutil.BgLogger().Info("[ddl] wait for the locks of the deferred backfill ranges",
+			zap.Int("range count", len(scheduler.deferredTasks)),
+			zap.String("first start key", hex.EncodeToString(scheduler.deferredTasks[0].task.startKey)))
+		select {
+		case <-dc.ctx.Done():
+		case <-time.After(deferredBackfillRetryInterval):
+		}
+		if err := dc.isReorgRunnable(scheduler.reorgInfo.Job.ID, false); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pingcap/tidb/sessionctx"
+)
+
+// backfillerConstructor creates the backfiller with the given worker ID for the scheduler.
+// It returns a nil backfiller without error if the worker can be skipped, e.g. the other
+// workers are able to handle the tasks.
+type backfillerConstructor func(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error)
+
+// backfillerDesc describes a kind of backfiller registered by registerBackfiller.
+type backfillerDesc struct {
+	tp        backfillerType
+	name      string
+	newWorker backfillerConstructor
+}
+
+var backfillerRegistry = struct {
+	sync.RWMutex
+	descs map[backfillerType]*backfillerDesc
+}{descs: make(map[backfillerType]*backfillerDesc)}
+
+// registerBackfiller registers a kind of backfiller, so that the backfill scheduler is able to create
+// its workers without knowing the implementation. It's expected to be called in init functions,
+// and panics if the type has been registered.
+func registerBackfiller(tp backfillerType, name string, newWorker backfillerConstructor) {
+	backfillerRegistry.Lock()
+	defer backfillerRegistry.Unlock()
+	if desc, ok := backfillerRegistry.descs[tp]; ok {
+		panic(fmt.Sprintf("backfiller type %d is already registered by %s", tp, desc.name))
+	}
+	backfillerRegistry.descs[tp] = &backfillerDesc{tp: tp, name: name, newWorker: newWorker}
+}
+
+// getBackfiller returns the registered backfiller of the type.
+func getBackfiller(tp backfillerType) (*backfillerDesc, bool) {
+	backfillerRegistry.RLock()
+	defer backfillerRegistry.RUnlock()
+	desc, ok := backfillerRegistry.descs[tp]
+	return desc, ok
+}
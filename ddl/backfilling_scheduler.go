@@ -16,6 +16,7 @@ package ddl
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -24,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/util/bgworker"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/mathutil"
 	decoder "github.com/pingcap/tidb/util/rowDecoder"
@@ -42,11 +44,16 @@ type backfillScheduler struct {
 
 	workers []*backfillWorker
 	maxSize int
+	// quotaOwner is the owner of the worker slots in bgworker.GlobalQuota.
+	quotaOwner string
 
 	taskCh   chan *reorgBackfillTask
 	resultCh chan *backfillResult
 
 	copReqSenderPool *copReqSenderPool // for add index in ingest way.
+
+	// deferredTasks are the tasks whose ranges have many pessimistic locks, which are backfilled in the later rounds.
+	deferredTasks []*deferredBackfillTask
 }
 
 func newBackfillScheduler(ctx context.Context, info *reorgInfo, sessPool *sessionPool,
@@ -61,6 +68,7 @@ func newBackfillScheduler(ctx context.Context, info *reorgInfo, sessPool *sessio
 		decodeColMap: decColMap,
 		jobCtx:       jobCtx,
 		workers:      make([]*backfillWorker, 0, variable.GetDDLReorgWorkerCounter()),
+		quotaOwner:   fmt.Sprintf("ddl-backfill-%d-%d", info.Job.ID, tbl.GetPhysicalID()),
 		taskCh:       make(chan *reorgBackfillTask, backfillTaskChanSize),
 		resultCh:     make(chan *backfillResult, backfillTaskChanSize),
 	}
@@ -109,6 +117,10 @@ func (b *backfillScheduler) setMaxWorkerSize(maxSize int) {
 
 func (b *backfillScheduler) expectedWorkerSize() (readerSize int, writerSize int) {
 	workerCnt := int(variable.GetDDLReorgWorkerCounter())
+	if n := b.reorgInfo.concurrentPartitions; n > 1 {
+		// The partitions backfilled concurrently share the workers of the job.
+		workerCnt = mathutil.Max(workerCnt/n, 1)
+	}
 	if b.tp == typeAddIndexWorker && b.reorgInfo.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge {
 		readerSize = mathutil.Min(workerCnt/2, b.maxSize)
 		readerSize = mathutil.Max(readerSize, 1)
@@ -132,78 +144,35 @@ func (b *backfillScheduler) adjustWorkerSize() error {
 		logutil.BgLogger().Error("[ddl] load DDL reorganization variable failed", zap.Error(err))
 	}
 	readerCnt, writerCnt := b.expectedWorkerSize()
-	// Increase the worker.
-	for i := len(b.workers); i < writerCnt; i++ {
-		sessCtx, err := b.newSessCtx()
-		if err != nil {
-			return err
-		}
-		var (
-			runner *backfillWorker
-			worker backfiller
-		)
-		switch b.tp {
-		case typeAddIndexWorker:
-			backfillCtx := newBackfillCtx(reorgInfo.d, i, sessCtx, job.SchemaName, b.tbl, jc, "add_idx_rate", false)
-			if reorgInfo.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge {
-				idxWorker, err := newAddIndexIngestWorker(b.tbl, backfillCtx,
-					job.ID, reorgInfo.currElement.ID, reorgInfo.currElement.TypeKey)
-				if err != nil {
-					if canSkipError(b.reorgInfo.ID, len(b.workers), err) {
-						continue
-					}
-					return err
-				}
-				idxWorker.copReqSenderPool = b.copReqSenderPool
-				runner = newBackfillWorker(jc.ddlJobCtx, idxWorker)
-				worker = idxWorker
-			} else {
-				idxWorker, err := newAddIndexTxnWorker(b.decodeColMap, b.tbl, backfillCtx,
-					job.ID, reorgInfo.currElement.ID, reorgInfo.currElement.TypeKey)
-				if err != nil {
-					return err
-				}
-				runner = newBackfillWorker(jc.ddlJobCtx, idxWorker)
-				worker = idxWorker
-			}
-		case typeAddIndexMergeTmpWorker:
-			backfillCtx := newBackfillCtx(reorgInfo.d, i, sessCtx, job.SchemaName, b.tbl, jc, "merge_tmp_idx_rate", false)
-			tmpIdxWorker := newMergeTempIndexWorker(backfillCtx, b.tbl, reorgInfo.currElement.ID)
-			runner = newBackfillWorker(jc.ddlJobCtx, tmpIdxWorker)
-			worker = tmpIdxWorker
-		case typeUpdateColumnWorker:
-			// Setting InCreateOrAlterStmt tells the difference between SELECT casting and ALTER COLUMN casting.
-			sessCtx.GetSessionVars().StmtCtx.InCreateOrAlterStmt = true
-			updateWorker := newUpdateColumnWorker(sessCtx, i, b.tbl, b.decodeColMap, reorgInfo, jc)
-			runner = newBackfillWorker(jc.ddlJobCtx, updateWorker)
-			worker = updateWorker
-		case typeCleanUpIndexWorker:
-			idxWorker := newCleanUpIndexWorker(sessCtx, i, b.tbl, b.decodeColMap, reorgInfo, jc)
-			runner = newBackfillWorker(jc.ddlJobCtx, idxWorker)
-			worker = idxWorker
-		case typeReorgPartitionWorker:
-			partWorker, err := newReorgPartitionWorker(sessCtx, i, b.tbl, b.decodeColMap, reorgInfo, jc)
+	desc, ok := getBackfiller(b.tp)
+	if !ok {
+		return errors.Errorf("unknown backfill type %d", b.tp)
+	}
+	// The backfill workers share the concurrency quota with the other background workers, such as the TTL workers.
+	// The stopped workers exit after finishing their current tasks, whose results are still handled by the scheduler.
+	var err error
+	b.workers, _, err = bgworker.Resize(bgworker.GlobalQuota, b.quotaOwner, b.workers, writerCnt,
+		func(id int) (*backfillWorker, bool, error) {
+			sessCtx, err := b.newSessCtx()
 			if err != nil {
-				return err
+				return nil, false, err
+			}
+			worker, err := desc.newWorker(b, sessCtx, id)
+			if err != nil || worker == nil {
+				return nil, false, err
 			}
-			runner = newBackfillWorker(jc.ddlJobCtx, partWorker)
-			worker = partWorker
-		default:
-			return errors.New("unknown backfill type")
-		}
-		runner.taskCh = b.taskCh
-		runner.resultCh = b.resultCh
-		b.workers = append(b.workers, runner)
-		go runner.run(reorgInfo.d, worker, job)
-	}
-	// Decrease the worker.
-	if len(b.workers) > writerCnt {
-		workers := b.workers[writerCnt:]
-		b.workers = b.workers[:writerCnt]
-		closeBackfillWorkers(workers)
+			runner := newBackfillWorker(jc.ddlJobCtx, worker)
+			runner.taskCh = b.taskCh
+			runner.resultCh = b.resultCh
+			runner.job = job
+			runner.trackSession(job)
+			return runner, true, nil
+		})
+	if err != nil {
+		return err
 	}
 	if b.copReqSenderPool != nil {
-		b.copReqSenderPool.adjustSize(readerCnt)
+		b.copReqSenderPool.adjustSize(mathutil.Min(readerCnt, len(b.workers)))
 	}
 	return injectCheckBackfillWorkerNum(len(b.workers), b.tp == typeAddIndexMergeTmpWorker)
 }
@@ -250,4 +219,5 @@ func (b *backfillScheduler) Close() {
 	closeBackfillWorkers(b.workers)
 	close(b.taskCh)
 	close(b.resultCh)
+	bgworker.GlobalQuota.Release(b.quotaOwner)
 }
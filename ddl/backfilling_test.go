@@ -16,9 +16,19 @@ package ddl
 
 import (
 	"bytes"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/ddl/ingest"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	storeerr "github.com/pingcap/tidb/store/driver/error"
+	"github.com/pingcap/tidb/util/bgworker"
+	"github.com/pingcap/tidb/util/memory"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,3 +53,228 @@ func TestDoneTaskKeeper(t *testing.T) {
 	n.updateNextKey(6, kv.Key("h"))
 	require.True(t, bytes.Equal(n.nextKey, kv.Key("h")))
 }
+
+func TestBackfillTaskRetry(t *testing.T) {
+	require.True(t, isRetryableBackfillError(storeerr.ErrTiKVServerBusy))
+	require.True(t, isRetryableBackfillError(errors.Trace(storeerr.ErrRegionUnavailable)))
+	require.True(t, isRetryableBackfillError(kv.ErrWriteConflict))
+	require.False(t, isRetryableBackfillError(kv.ErrKeyExists))
+	require.False(t, isRetryableBackfillError(errors.New("mock error")))
+
+	require.Equal(t, backfillTaskRetryBaseInterval, backfillTaskRetryInterval(1))
+	require.Equal(t, 2*backfillTaskRetryBaseInterval, backfillTaskRetryInterval(2))
+	require.Equal(t, 4*backfillTaskRetryBaseInterval, backfillTaskRetryInterval(3))
+	require.Equal(t, backfillTaskRetryMaxInterval, backfillTaskRetryInterval(100))
+	require.LessOrEqual(t, backfillTaskRetryInterval(backfillTaskMaxRetryCnt), 10*time.Second)
+}
+
+func TestBackfillMemoryQuota(t *testing.T) {
+	origin := variable.DDLReorgMaxMemory.Load()
+	defer variable.DDLReorgMaxMemory.Store(origin)
+
+	// The backfill memory is counted in the global memory tracker of the server.
+	globalTracker := memory.NewGlobalTracker(memory.LabelForGlobalMemory, -1)
+	bgworker.GlobalQuota.MemTracker().AttachToGlobalTracker(globalTracker)
+	defer bgworker.GlobalQuota.MemTracker().DetachFromGlobalTracker()
+	consumed := globalTracker.BytesConsumed()
+
+	bfCtx := &backfillCtx{batchCnt: 256}
+	bfCtx.consumeBatchMemory(1024)
+	require.Equal(t, consumed+1024, globalTracker.BytesConsumed())
+	// The memory quota is unlimited.
+	variable.DDLReorgMaxMemory.Store(0)
+	require.False(t, bfCtx.shrinkBatchIfMemoryExceeded())
+	require.Equal(t, 256, bfCtx.batchCnt)
+
+	variable.DDLReorgMaxMemory.Store(512)
+	require.True(t, bfCtx.shrinkBatchIfMemoryExceeded())
+	require.Equal(t, 128, bfCtx.batchCnt)
+	for i := 0; i < 10; i++ {
+		require.True(t, bfCtx.shrinkBatchIfMemoryExceeded())
+	}
+	require.Equal(t, int(variable.MinDDLReorgBatchSize), bfCtx.batchCnt)
+
+	bfCtx.releaseBatchMemory()
+	require.Equal(t, int64(0), bfCtx.memConsumed)
+	require.Equal(t, consumed, globalTracker.BytesConsumed())
+	require.False(t, bfCtx.shrinkBatchIfMemoryExceeded())
+}
+
+func TestBackfillBatchSizeAcrossTasks(t *testing.T) {
+	originQuota := variable.DDLReorgMaxMemory.Load()
+	defer variable.DDLReorgMaxMemory.Store(originQuota)
+	originBatchSize := variable.GetDDLReorgBatchSize()
+	defer variable.SetDDLReorgBatchSize(originBatchSize)
+	variable.SetDDLReorgBatchSize(256)
+	variable.DDLReorgMaxMemory.Store(1024)
+
+	bfCtx := &backfillCtx{}
+	// The first task exceeds the quota.
+	bfCtx.adjustBatchSize()
+	require.Equal(t, 256, bfCtx.batchCnt)
+	bfCtx.consumeBatchMemory(2048)
+	require.True(t, bfCtx.shrinkBatchIfMemoryExceeded())
+	require.Equal(t, 128, bfCtx.batchCnt)
+	// The shrunk batch size is kept by the next task while the memory usage is still high.
+	bfCtx.adjustBatchSize()
+	require.Equal(t, 128, bfCtx.batchCnt)
+	require.True(t, bfCtx.shrinkBatchIfMemoryExceeded())
+	require.Equal(t, 64, bfCtx.batchCnt)
+	bfCtx.adjustBatchSize()
+	require.Equal(t, 64, bfCtx.batchCnt)
+
+	// The batch size grows back step by step after the memory usage drops.
+	bfCtx.releaseBatchMemory()
+	bfCtx.adjustBatchSize()
+	require.Equal(t, 128, bfCtx.batchCnt)
+	bfCtx.adjustBatchSize()
+	require.Equal(t, 256, bfCtx.batchCnt)
+	require.Equal(t, 0, bfCtx.shrunkBatchCnt)
+	bfCtx.adjustBatchSize()
+	require.Equal(t, 256, bfCtx.batchCnt)
+
+	// The batch size still follows tidb_ddl_reorg_batch_size when it isn't shrunk.
+	variable.SetDDLReorgBatchSize(512)
+	bfCtx.adjustBatchSize()
+	require.Equal(t, 512, bfCtx.batchCnt)
+}
+
+func TestBackfillerRegistry(t *testing.T) {
+	for tp, name := range map[backfillerType]string{
+		typeAddIndexWorker:         "add index",
+		typeUpdateColumnWorker:     "update column",
+		typeCleanUpIndexWorker:     "clean up index",
+		typeAddIndexMergeTmpWorker: "merge temporary index",
+		typeReorgPartitionWorker:   "reorganize partition",
+		typeVerifyIndexWorker:      "verify index",
+		typeCheckConstraintWorker:  "check constraint",
+		typeAddStoredColumnWorker:  "add stored column",
+	} {
+		desc, ok := getBackfiller(tp)
+		require.True(t, ok)
+		require.Equal(t, name, desc.name)
+		require.Equal(t, name, tp.String())
+	}
+	require.Equal(t, "unknown", backfillerType(255).String())
+
+	newWorker := func(*backfillScheduler, sessionctx.Context, int) (backfiller, error) { return nil, nil }
+	require.Panics(t, func() { registerBackfiller(typeAddIndexWorker, "mock", newWorker) })
+}
+
+func TestIngestFallbackPolicy(t *testing.T) {
+	origin := variable.DDLIngestFallbackThreshold.Load()
+	defer variable.DDLIngestFallbackThreshold.Store(origin)
+	variable.DDLIngestFallbackThreshold.Store(2)
+
+	require.True(t, isIngestEnvErr(errors.New(ingest.LitErrGetBackendFail)))
+	require.True(t, isIngestEnvErr(errors.Annotate(syscall.ENOSPC, "write sst")))
+	require.False(t, isIngestEnvErr(kv.ErrKeyExists))
+	require.False(t, isIngestEnvErr(nil))
+
+	job := &model.Job{ReorgMeta: &model.DDLReorgMeta{ReorgTp: model.ReorgTypeLitMerge}, SnapshotVer: 1, RowCount: 10}
+	// The data errors aren't counted.
+	require.NoError(t, tryFallbackToTxnMerge(job, kv.ErrKeyExists))
+	require.Equal(t, model.ReorgTypeTxnMerge, job.ReorgMeta.ReorgTp)
+	require.Equal(t, uint64(0), job.SnapshotVer)
+	require.Equal(t, int64(0), job.RowCount)
+	require.Equal(t, 0, job.ReorgMeta.IngestEnvErrCount)
+	require.False(t, ingestDisabledByEnvErrs(job))
+
+	require.NoError(t, tryFallbackToTxnMerge(job, errors.New(ingest.LitErrCreateBackendFail)))
+	require.False(t, ingestDisabledByEnvErrs(job))
+	require.NoError(t, tryFallbackToTxnMerge(job, errors.New(ingest.LitErrExceedConcurrency)))
+	require.Equal(t, 2, job.ReorgMeta.IngestEnvErrCount)
+	require.True(t, ingestDisabledByEnvErrs(job))
+
+	// 0 means the job never falls back permanently.
+	variable.DDLIngestFallbackThreshold.Store(0)
+	require.False(t, ingestDisabledByEnvErrs(job))
+
+	// Only the ingest environment errors switch the backfill process at the checkpoint.
+	job.ReorgMeta.ReorgTp = model.ReorgTypeLitMerge
+	job.SnapshotVer = 1
+	require.False(t, switchToTxnMergeAtCheckpoint(job, nil, nil, nil, kv.ErrKeyExists))
+	require.Equal(t, model.ReorgTypeLitMerge, job.ReorgMeta.ReorgTp)
+	require.Equal(t, 2, job.ReorgMeta.IngestEnvErrCount)
+
+	// The rolling back job isn't changed.
+	job.State = model.JobStateRollingback
+	err := errors.New(ingest.LitErrGetBackendFail)
+	require.Equal(t, err, tryFallbackToTxnMerge(job, err))
+	require.Equal(t, 2, job.ReorgMeta.IngestEnvErrCount)
+}
+
+func TestReorgWorkerLabel(t *testing.T) {
+	labels := map[string]string{"role": "ddl-worker", "zone": "z1"}
+	require.True(t, hasReorgWorkerLabel(labels, ""))
+	require.True(t, hasReorgWorkerLabel(nil, ""))
+	require.True(t, hasReorgWorkerLabel(labels, "role=ddl-worker"))
+	require.True(t, hasReorgWorkerLabel(labels, " zone = z1 "))
+	require.False(t, hasReorgWorkerLabel(labels, "role=tp"))
+	require.False(t, hasReorgWorkerLabel(labels, "dc=ddl-worker"))
+	require.False(t, hasReorgWorkerLabel(nil, "role=ddl-worker"))
+}
+
+func TestPickPreSplitKeys(t *testing.T) {
+	samples := []kv.Key{kv.Key("a"), kv.Key("b"), kv.Key("c"), kv.Key("d"), kv.Key("e"), kv.Key("f")}
+	require.Nil(t, pickPreSplitKeys(nil, 4))
+	require.Nil(t, pickPreSplitKeys(samples[:1], 4))
+	require.Nil(t, pickPreSplitKeys(samples, 1))
+	require.Equal(t, [][]byte{kv.Key("d")}, pickPreSplitKeys(samples, 2))
+	require.Equal(t, [][]byte{kv.Key("c"), kv.Key("e")}, pickPreSplitKeys(samples, 3))
+	// The region count is capped by the sample count.
+	require.Equal(t, [][]byte{kv.Key("b"), kv.Key("c"), kv.Key("d"), kv.Key("e"), kv.Key("f")}, pickPreSplitKeys(samples, 16))
+}
+
+func TestPickLockedBackfillTasks(t *testing.T) {
+	newTasks := func(keys ...string) []*reorgBackfillTask {
+		tasks := make([]*reorgBackfillTask, 0, len(keys))
+		for i, k := range keys {
+			tasks = append(tasks, &reorgBackfillTask{id: i, startKey: kv.Key(k), endKey: kv.Key(k + "z")})
+		}
+		return tasks
+	}
+	locks := map[string]int{"b": backfillLockedRangeThreshold, "d": backfillLockedRangeThreshold - 1}
+	countLocks := func(task *reorgBackfillTask) (int, error) {
+		if string(task.startKey) == "c" {
+			return 0, errors.New("mock probe error")
+		}
+		return locks[string(task.startKey)], nil
+	}
+	startKeys := func(tasks []*reorgBackfillTask) []string {
+		keys := make([]string, 0, len(tasks))
+		for i, task := range tasks {
+			require.Equal(t, i, task.id)
+			keys = append(keys, string(task.startKey))
+		}
+		return keys
+	}
+
+	s := &backfillScheduler{}
+	// The range which fails to be probed is taken as not locked.
+	require.Equal(t, []string{"a", "c", "d"}, startKeys(s.pickTasks(newTasks("a", "b", "c", "d"), countLocks)))
+	require.Len(t, s.deferredTasks, 1)
+	// The reorg handle doesn't pass the deferred range.
+	require.Equal(t, kv.Key("b"), s.capNextKeyByDeferred(kv.Key("dz")))
+	require.Equal(t, kv.Key("az"), s.capNextKeyByDeferred(kv.Key("az")))
+
+	// The deferred range runs once it isn't locked.
+	require.Equal(t, []string{"e"}, startKeys(s.pickTasks(newTasks("e"), countLocks)))
+	delete(locks, "b")
+	require.Equal(t, []string{"b", "f"}, startKeys(s.pickTasks(newTasks("f"), countLocks)))
+	require.Len(t, s.deferredTasks, 0)
+	require.Equal(t, kv.Key("fz"), s.capNextKeyByDeferred(kv.Key("fz")))
+
+	// The range deferred too many times runs even if it's locked.
+	locks["g"] = backfillLockedRangeThreshold
+	require.Len(t, s.pickTasks(newTasks("g"), countLocks), 0)
+	for i := 1; i < maxBackfillRangeDeferrals; i++ {
+		require.Len(t, s.pickTasks(nil, countLocks), 0)
+	}
+	require.Equal(t, []string{"g"}, startKeys(s.pickTasks(nil, countLocks)))
+
+	// All the deferred ranges run if the locks aren't probed.
+	require.Len(t, s.pickTasks(newTasks("g"), countLocks), 0)
+	require.Equal(t, []string{"g", "h"}, startKeys(s.pickTasks(newTasks("h"), nil)))
+	require.Len(t, s.deferredTasks, 0)
+}
@@ -206,6 +206,12 @@ var allTestCase = []testCancelJob{
 	{"alter table t_partition drop partition p6", false, model.StateDeleteOnly, false, true, []string{"alter table t_partition add partition (partition p6 values less than (8192))"}},
 	{"alter table t_partition drop partition p6", false, model.StateDeleteReorganization, true, true, []string{"alter table t_partition add partition (partition p6 values less than (8192))"}},
 	{"alter table t_partition drop partition p6", false, model.StateNone, true, true, []string{"alter table t_partition add partition (partition p6 values less than (8192))"}},
+	// Reorganize partition.
+	{"alter table t_partition reorganize partition p4 into (partition p4a values less than (5000), partition p4b values less than (7096))", true, model.StateNone, true, false, nil},
+	{"alter table t_partition reorganize partition p4 into (partition p4a values less than (5000), partition p4b values less than (7096))", true, model.StateDeleteOnly, true, true, nil},
+	{"alter table t_partition reorganize partition p4 into (partition p4a values less than (5000), partition p4b values less than (7096))", true, model.StateWriteOnly, true, true, nil},
+	{"alter table t_partition reorganize partition p4 into (partition p4a values less than (5000), partition p4b values less than (7096))", true, model.StateWriteReorganization, true, true, nil},
+	{"alter table t_partition reorganize partition p4 into (partition p4a values less than (5000), partition p4b values less than (7096))", false, model.StateDeleteReorganization, true, false, nil},
 	// Drop indexes.
 	{"alter table t drop index mul_idx1, drop index mul_idx2", true, subStates{model.StatePublic, model.StatePublic}, true, false, []string{"alter table t add index mul_idx1(c1)", "alter table t add index mul_idx2(c1)"}},
 	{"alter table t drop index mul_idx1, drop index mul_idx2", false, subStates{model.StateWriteOnly, model.StateWriteOnly}, true, false, nil},
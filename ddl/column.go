@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tidb/meta/autoid"
 	"github.com/pingcap/tidb/metrics"
 	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/terror"
@@ -42,9 +43,11 @@ import (
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/dbterror"
 	"github.com/pingcap/tidb/util/logutil"
 	decoder "github.com/pingcap/tidb/util/rowDecoder"
@@ -101,7 +104,7 @@ func checkAddColumn(t *meta.Meta, job *model.Job) (*model.TableInfo, *model.Colu
 	return tblInfo, columnInfo, col, pos, false, nil
 }
 
-func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+func (w *worker) onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
 	// Handle the rolling back job.
 	if job.IsRollingback() {
 		ver, err = onDropColumn(d, t, job)
@@ -167,6 +170,14 @@ func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error)
 		job.MarkNonRevertible()
 	case model.StateWriteReorganization:
 		// reorganization -> public
+		if isStoredGeneratedColumn(columnInfo) {
+			// The stored generated column is generated by the writes from the write only state, fill the existing rows.
+			var done bool
+			done, ver, err = w.runAddStoredColumnReorg(d, t, job, tblInfo, columnInfo)
+			if err != nil || !done {
+				return ver, errors.Trace(err)
+			}
+		}
 		// Adjust table column offset.
 		offset, err := LocateOffsetToMove(columnInfo.Offset, pos, tblInfo)
 		if err != nil {
@@ -359,7 +370,31 @@ func onSetDefaultValue(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ er
 	return updateColumnDefaultValue(d, t, job, newCol, &newCol.Name)
 }
 
+// needConvertCharset returns true if the values of the string column need to be converted to the new charset,
+// which validates every value against the new charset in the reorg.
+func needConvertCharset(origin, to *types.FieldType) bool {
+	if !types.IsString(origin.GetType()) || !types.IsString(to.GetType()) {
+		return false
+	}
+	fromChs, toChs := origin.GetCharset(), to.GetCharset()
+	if fromChs == toChs || fromChs == charset.CharsetBin || toChs == charset.CharsetBin {
+		return false
+	}
+	// The values of utf8 and latin1 are always valid in utf8mb4, only the collation-aware data needs to be rewritten.
+	if toChs == charset.CharsetUTF8MB4 && (fromChs == charset.CharsetUTF8 || fromChs == charset.CharsetLatin1) {
+		return collate.NewCollationEnabled() && !collate.CompatibleCollate(origin.GetCollate(), to.GetCollate())
+	}
+	return true
+}
+
 func needChangeColumnData(oldCol, newCol *model.ColumnInfo) bool {
+	if needConvertCharset(&oldCol.FieldType, &newCol.FieldType) {
+		return true
+	}
+	// The values are compressed or decompressed.
+	if oldCol.Compression != newCol.Compression {
+		return true
+	}
 	toUnsigned := mysql.HasUnsignedFlag(newCol.GetFlag())
 	originUnsigned := mysql.HasUnsignedFlag(oldCol.GetFlag())
 	needTruncationOrToggleSign := func() bool {
@@ -552,7 +587,7 @@ func (w *worker) onModifyColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver in
 		job.State = model.JobStateCancelled
 		return ver, errors.Trace(err)
 	}
-	if tblInfo.Partition != nil {
+	if tblInfo.Partition != nil && !job.ReorgMeta.PartitionByPartition {
 		job.State = model.JobStateCancelled
 		return ver, errors.Trace(dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("table is partition table"))
 	}
@@ -1062,7 +1097,7 @@ func (w *worker) updatePhysicalTableRow(t table.Table, reorgInfo *reorgInfo) err
 				return dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", reorgInfo.PhysicalTableID, t.Meta().ID)
 			}
 			workType := typeReorgPartitionWorker
-			if reorgInfo.Job.Type != model.ActionReorganizePartition {
+			if reorgInfo.Job.Type != model.ActionReorganizePartition && reorgInfo.Job.Type != model.ActionAlterTablePartitioning {
 				// workType = typeUpdateColumnWorker
 				// TODO: Support Modify Column on partitioned table
 				// https://github.com/pingcap/tidb/issues/38297
@@ -1104,7 +1139,12 @@ func (w *worker) updateCurrentElement(t table.Table, reorgInfo *reorgInfo) error
 			}
 		}
 	})
-	// TODO: Support partition tables.
+	if tbl, ok := t.(table.PartitionedTable); ok {
+		if !reorgInfo.ReorgMeta.PartitionByPartition {
+			return dbterror.ErrCancelledDDLJob.GenWithStack("Modify Column on partitioned table / typeUpdateColumnWorker not yet supported.")
+		}
+		return w.updatePartitionsByPartition(tbl, reorgInfo)
+	}
 	if bytes.Equal(reorgInfo.currElement.TypeKey, meta.ColumnElementKey) {
 		//nolint:forcetypeassert
 		err := w.updatePhysicalTableRow(t.(table.PhysicalTable), reorgInfo)
@@ -1113,11 +1153,6 @@ func (w *worker) updateCurrentElement(t table.Table, reorgInfo *reorgInfo) error
 		}
 	}
 
-	if _, ok := t.(table.PartitionedTable); ok {
-		// TODO: remove when modify column of partitioned table is supported
-		// https://github.com/pingcap/tidb/issues/38297
-		return dbterror.ErrCancelledDDLJob.GenWithStack("Modify Column on partitioned table / typeUpdateColumnWorker not yet supported.")
-	}
 	// Get the original start handle and end handle.
 	currentVer, err := getValidCurrentVersion(reorgInfo.d.store)
 	if err != nil {
@@ -1172,10 +1207,84 @@ func (w *worker) updateCurrentElement(t table.Table, reorgInfo *reorgInfo) error
 	return nil
 }
 
+// updatePartitionsByPartition updates the changing column and backfills the changing indexes of a partitioned table
+// one partition at a time. All the elements of a partition are done before the next partition is started, so only
+// one partition is double-written at any moment, and the partitions finished before an interruption are not
+// reorganized again.
+func (w *worker) updatePartitionsByPartition(t table.PartitionedTable, reorgInfo *reorgInfo) error {
+	for {
+		p := t.GetPartition(reorgInfo.PhysicalTableID)
+		if p == nil {
+			return dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", reorgInfo.PhysicalTableID, t.Meta().ID)
+		}
+		// Get the range of the whole partition, the elements after the current one are started from the beginning.
+		currentVer, err := getValidCurrentVersion(reorgInfo.d.store)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		startKey, endKey, err := getTableRange(reorgInfo.d.jobContext(reorgInfo.Job.ID), reorgInfo.d, p, currentVer.Ver, reorgInfo.Job.Priority)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		startElementOffset := 0
+		for i, element := range reorgInfo.elements {
+			if bytes.Equal(element.TypeKey, reorgInfo.currElement.TypeKey) && element.ID == reorgInfo.currElement.ID {
+				startElementOffset = i
+				break
+			}
+		}
+		for i := startElementOffset; i < len(reorgInfo.elements); i++ {
+			if i > startElementOffset {
+				w.getReorgCtx(reorgInfo.Job.ID).setCurrentElement(reorgInfo.elements[i])
+				reorgInfo.currElement = reorgInfo.elements[i]
+				reorgInfo.StartKey, reorgInfo.EndKey = startKey, endKey
+				// Write the reorg info to store so the whole reorganize process can recover from panic.
+				err = reorgInfo.UpdateReorgMeta(reorgInfo.StartKey, w.sessPool)
+				logutil.BgLogger().Info("[ddl] update column and indexes by partition",
+					zap.Int64("job ID", reorgInfo.Job.ID),
+					zap.Int64("partition ID", reorgInfo.PhysicalTableID),
+					zap.Stringer("element", reorgInfo.currElement),
+					zap.String("start key", hex.EncodeToString(reorgInfo.StartKey)),
+					zap.String("end key", hex.EncodeToString(reorgInfo.EndKey)))
+				if err != nil {
+					return errors.Trace(err)
+				}
+			}
+			workType := typeUpdateColumnWorker
+			if bytes.Equal(reorgInfo.currElement.TypeKey, meta.IndexElementKey) {
+				workType = typeAddIndexWorker
+			}
+			err = w.writePhysicalTableRecord(w.sessPool, p, workType, reorgInfo)
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
+
+		// The next partition starts from the column element.
+		w.getReorgCtx(reorgInfo.Job.ID).setCurrentElement(reorgInfo.elements[0])
+		reorgInfo.currElement = reorgInfo.elements[0]
+		done, err := updateReorgInfo(w.sessPool, t, reorgInfo)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
 type updateColumnWorker struct {
 	*backfillCtx
 	oldColInfo *model.ColumnInfo
 	newColInfo *model.ColumnInfo
+	// convertCharset indicates whether the values need to be validated against the charset of the new column.
+	convertCharset bool
+	// replaceInvalidChars indicates whether to replace the invalid characters with '?' instead of returning an error.
+	replaceInvalidChars bool
+
+	// compressedCols are the compressed columns, whose values are compressed again when the rows are written back.
+	compressedCols map[int64]*table.Column
 
 	// The following attributes are used to reduce memory allocation.
 	rowRecords []*rowRecord
@@ -1184,6 +1293,16 @@ type updateColumnWorker struct {
 	rowMap map[int64]types.Datum
 }
 
+func init() {
+	registerBackfiller(typeUpdateColumnWorker, "update column", newUpdateColumnBackfiller)
+}
+
+func newUpdateColumnBackfiller(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error) {
+	// Setting InCreateOrAlterStmt tells the difference between SELECT casting and ALTER COLUMN casting.
+	sessCtx.GetSessionVars().StmtCtx.InCreateOrAlterStmt = true
+	return newUpdateColumnWorker(sessCtx, id, b.tbl, b.decodeColMap, b.reorgInfo, b.jobCtx), nil
+}
+
 func newUpdateColumnWorker(sessCtx sessionctx.Context, id int, t table.PhysicalTable, decodeColMap map[int64]decoder.Column, reorgInfo *reorgInfo, jc *JobContext) *updateColumnWorker {
 	if !bytes.Equal(reorgInfo.currElement.TypeKey, meta.ColumnElementKey) {
 		logutil.BgLogger().Error("Element type for updateColumnWorker incorrect", zap.String("jobQuery", reorgInfo.Query),
@@ -1191,20 +1310,26 @@ func newUpdateColumnWorker(sessCtx sessionctx.Context, id int, t table.PhysicalT
 		return nil
 	}
 	var oldCol, newCol *model.ColumnInfo
+	compressedCols := make(map[int64]*table.Column)
 	for _, col := range t.WritableCols() {
 		if col.ID == reorgInfo.currElement.ID {
 			newCol = col.ColumnInfo
 			oldCol = table.FindCol(t.Cols(), getChangingColumnOriginName(newCol)).ColumnInfo
-			break
+		}
+		if mysql.HasCompressedFlag(col.GetFlag()) {
+			compressedCols[col.ID] = col
 		}
 	}
 	rowDecoder := decoder.NewRowDecoder(t, t.WritableCols(), decodeColMap)
 	return &updateColumnWorker{
-		backfillCtx: newBackfillCtx(reorgInfo.d, id, sessCtx, reorgInfo.SchemaName, t, jc, "update_col_rate", false),
-		oldColInfo:  oldCol,
-		newColInfo:  newCol,
-		rowDecoder:  rowDecoder,
-		rowMap:      make(map[int64]types.Datum, len(decodeColMap)),
+		backfillCtx:         newBackfillCtx(reorgInfo.d, id, sessCtx, reorgInfo.SchemaName, t, jc, "update_col_rate", false),
+		oldColInfo:          oldCol,
+		newColInfo:          newCol,
+		convertCharset:      oldCol != nil && newCol != nil && needConvertCharset(&oldCol.FieldType, &newCol.FieldType),
+		replaceInvalidChars: reorgInfo.ReorgMeta.CharsetConvertPolicy == variable.CharsetConvertPolicyReplace,
+		compressedCols:      compressedCols,
+		rowDecoder:          rowDecoder,
+		rowMap:              make(map[int64]types.Datum, len(decodeColMap)),
 	}
 }
 
@@ -1272,10 +1397,15 @@ func (w *updateColumnWorker) fetchRowColVals(txn kv.Transaction, taskRange reorg
 			if taskDone || len(w.rowRecords) >= w.batchCnt {
 				return false, nil
 			}
+			if len(w.rowRecords) > 0 && w.shrinkBatchIfMemoryExceeded() {
+				return false, nil
+			}
 
 			if err1 := w.getRowRecord(handle, recordKey, rawRow); err1 != nil {
 				return false, errors.Trace(err1)
 			}
+			// The raw row is decoded and encoded again to the new row.
+			w.consumeBatchMemory(int64(len(recordKey) + 2*len(rawRow)))
 			lastAccessedHandle = recordKey
 			if recordKey.Cmp(taskRange.endKey) == 0 {
 				taskDone = true
@@ -1331,6 +1461,16 @@ func (w *updateColumnWorker) getRowRecord(handle kv.Handle, recordKey []byte, ra
 		//nolint:forcetypeassert
 		recordWarning = errors.Cause(w.reformatErrors(warn[0].Err)).(*terror.Error)
 	}
+	if w.convertCharset && newColVal.Kind() == types.KindString {
+		var charsetWarning *terror.Error
+		newColVal, charsetWarning, err = w.convertToNewCharset(newColVal)
+		if err != nil {
+			return err
+		}
+		if recordWarning == nil {
+			recordWarning = charsetWarning
+		}
+	}
 
 	failpoint.Inject("MockReorgTimeoutInOneRegion", func(val failpoint.Value) {
 		//nolint:forcetypeassert
@@ -1351,6 +1491,9 @@ func (w *updateColumnWorker) getRowRecord(handle kv.Handle, recordKey []byte, ra
 	newRow := make([]types.Datum, 0, len(w.rowMap))
 	for colID, val := range w.rowMap {
 		newColumnIDs = append(newColumnIDs, colID)
+		if col, ok := w.compressedCols[colID]; ok {
+			val = tables.CompressColumnValue(w.sessCtx, col, val)
+		}
 		newRow = append(newRow, val)
 	}
 	sctx, rd := w.sessCtx.GetSessionVars().StmtCtx, &w.sessCtx.GetSessionVars().RowEncoder
@@ -1364,6 +1507,22 @@ func (w *updateColumnWorker) getRowRecord(handle kv.Handle, recordKey []byte, ra
 	return nil
 }
 
+// convertToNewCharset validates the value against the charset of the new column. If the policy is REPLACE, the
+// invalid characters are replaced with '?' and a warning is returned, otherwise an error is returned.
+func (w *updateColumnWorker) convertToNewCharset(val types.Datum) (types.Datum, *terror.Error, error) {
+	str, err := val.GetStringWithCheck(w.sessCtx.GetSessionVars().StmtCtx, w.newColInfo.GetCharset())
+	if err == nil {
+		return val, nil, nil
+	}
+	err = w.reformatErrors(err)
+	if !w.replaceInvalidChars {
+		return val, nil, errors.Trace(err)
+	}
+	val.SetString(str, val.Collation())
+	//nolint:forcetypeassert
+	return val, errors.Cause(err).(*terror.Error), nil
+}
+
 // reformatErrors casted error because `convertTo` function couldn't package column name and datum value for some errors.
 func (w *updateColumnWorker) reformatErrors(err error) error {
 	// Since row count is not precious in concurrent reorganization, here we substitute row count with datum value.
@@ -1376,6 +1535,11 @@ func (w *updateColumnWorker) reformatErrors(err error) error {
 		dStr := datumToStringNoErr(w.rowMap[w.oldColInfo.ID])
 		err = types.ErrWarnDataOutOfRange.GenWithStack("Out of range value for column '%s', the value is '%s'", w.oldColInfo.Name, dStr)
 	}
+
+	if charset.ErrInvalidCharacterString.Equal(err) {
+		dStr := datumToStringNoErr(w.rowMap[w.oldColInfo.ID])
+		err = table.ErrTruncatedWrongValueForField.GenWithStack("Incorrect string value '%s' for column '%s'", dStr, w.oldColInfo.Name)
+	}
 	return err
 }
 
@@ -1434,6 +1598,7 @@ func (w *updateColumnWorker) BackfillData(handleRange reorgBackfillTask) (taskCt
 
 		// Collect the warnings.
 		taskCtx.warnings, taskCtx.warningsCount = warningsMap, warningsCountMap
+		taskCtx.addedBytes = txn.Size()
 
 		return nil
 	})
@@ -1496,6 +1661,14 @@ func (w *worker) doModifyColumn(
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
+	if newCol.Name.L != oldCol.Name.L {
+		viewInfos, err := renameColumnDependents(d, t, dbInfo, tblInfo, oldCol.Name, newCol.Name)
+		if err != nil {
+			job.State = model.JobStateRollingback
+			return ver, errors.Trace(err)
+		}
+		childTableInfos = append(childTableInfos, viewInfos...)
+	}
 	ver, err = updateVersionAndTableInfoWithCheck(d, t, job, tblInfo, true, childTableInfos...)
 	if err != nil {
 		// Modified the type definition of 'null' to 'not null' before this, so rollBack the job when an error occurs.
@@ -1892,6 +2065,27 @@ func generateOriginDefaultValue(col *model.ColumnInfo, ctx sessionctx.Context) (
 	return odValue, nil
 }
 
+// generateOriginDefaultExprValue evaluates the default expression of the column as its origin default value.
+func generateOriginDefaultExprValue(ctx sessionctx.Context, col *model.ColumnInfo) (interface{}, error) {
+	d, err := table.GetColDefaultValue(ctx, col)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if d.IsNull() {
+		return nil, nil
+	}
+	// The origin default value of the timestamp column is stored in UTC.
+	if col.GetType() == mysql.TypeTimestamp {
+		t := d.GetMysqlTime()
+		if err = t.ConvertTimeZone(ctx.GetSessionVars().Location(), time.UTC); err != nil {
+			return nil, errors.Trace(err)
+		}
+		d.SetMysqlTime(t)
+	}
+	odValue, err := d.ToString()
+	return odValue, errors.Trace(err)
+}
+
 // isVirtualGeneratedColumn checks the column if it is virtual.
 func isVirtualGeneratedColumn(col *model.ColumnInfo) bool {
 	if col.IsGenerated() && !col.GeneratedStored {
@@ -0,0 +1,136 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+// resolveLossyModifyConfirmation removes the `WITH VALIDATION` and `FORCE` specs from the specs which modify columns,
+// and returns whether the lossy conversions of the modified columns are confirmed by them.
+func resolveLossyModifyConfirmation(specs []*ast.AlterTableSpec) ([]*ast.AlterTableSpec, bool) {
+	hasModifyColumn := false
+	for _, spec := range specs {
+		if spec.Tp == ast.AlterTableModifyColumn || spec.Tp == ast.AlterTableChangeColumn {
+			hasModifyColumn = true
+			break
+		}
+	}
+	if !hasModifyColumn {
+		return specs, false
+	}
+	confirmed := false
+	resolvedSpecs := make([]*ast.AlterTableSpec, 0, len(specs))
+	for _, spec := range specs {
+		if spec.Tp == ast.AlterTableWithValidation || spec.Tp == ast.AlterTableForce {
+			confirmed = true
+			continue
+		}
+		resolvedSpecs = append(resolvedSpecs, spec)
+	}
+	return resolvedSpecs, confirmed
+}
+
+func integerColumnRange(ft *types.FieldType) (lower int64, upper uint64) {
+	if mysql.HasUnsignedFlag(ft.GetFlag()) {
+		return 0, types.IntergerUnsignedUpperBound(ft.GetType())
+	}
+	return types.IntergerSignedLowerBound(ft.GetType()), uint64(types.IntergerSignedUpperBound(ft.GetType()))
+}
+
+// lossyModifyColumnCondition returns the condition which selects the rows whose values would overflow or be truncated
+// by converting the column from oldCol to newCol, and the parameters of the condition. The condition is empty if the
+// conversion is lossless, or the lossy rows can't be selected in advance.
+func lossyModifyColumnCondition(oldCol, newCol *model.ColumnInfo) (string, []interface{}) {
+	name := oldCol.Name.L
+	oldTp, newTp := oldCol.GetType(), newCol.GetType()
+	var conds []string
+	var params []interface{}
+	switch {
+	case mysql.IsIntegerType(oldTp) && mysql.IsIntegerType(newTp):
+		oldLower, oldUpper := integerColumnRange(&oldCol.FieldType)
+		newLower, newUpper := integerColumnRange(&newCol.FieldType)
+		if newLower > oldLower {
+			conds = append(conds, "%n < %?")
+			params = append(params, name, newLower)
+		}
+		if newUpper < oldUpper {
+			conds = append(conds, "%n > %?")
+			params = append(params, name, newUpper)
+		}
+	case types.IsString(oldTp) && types.IsString(newTp):
+		if newCol.GetFlen() < 0 || (oldCol.GetFlen() >= 0 && newCol.GetFlen() >= oldCol.GetFlen()) {
+			break
+		}
+		// The length of the binary strings and blobs is limited by bytes, while the others are limited by characters.
+		if types.IsBinaryStr(&newCol.FieldType) || types.IsTypeBlob(newTp) {
+			conds = append(conds, "length(%n) > %?")
+		} else {
+			conds = append(conds, "char_length(%n) > %?")
+		}
+		params = append(params, name, newCol.GetFlen())
+	case oldTp == mysql.TypeNewDecimal && newTp == mysql.TypeNewDecimal:
+		if newCol.GetFlen() < oldCol.GetFlen() || newCol.GetDecimal() < oldCol.GetDecimal() {
+			// The values which are rounded or out of range are changed by the cast.
+			conds = append(conds, fmt.Sprintf("%%n <> cast(%%n as decimal(%d, %d))", newCol.GetFlen(), newCol.GetDecimal()))
+			params = append(params, name, name)
+		}
+		if mysql.HasUnsignedFlag(newCol.GetFlag()) && !mysql.HasUnsignedFlag(oldCol.GetFlag()) {
+			conds = append(conds, "%n < 0")
+			params = append(params, name)
+		}
+	}
+	return strings.Join(conds, " or "), params
+}
+
+// checkLossyModifyColumn counts the rows whose values would overflow or be truncated by modifying the column in the
+// non-strict SQL mode before the job is submitted, otherwise the data loss is only found by the warnings after the whole
+// table is backfilled. The lossy conversion should be confirmed by `WITH VALIDATION` or `FORCE`. In strict SQL mode,
+// the job fails and rolls back on the lossy rows, so it's not checked in advance.
+func checkLossyModifyColumn(ctx context.Context, sctx sessionctx.Context, schema, table model.CIStr, oldCol, newCol *model.ColumnInfo, confirmed bool) error {
+	if confirmed || sctx.GetSessionVars().SQLMode.HasStrictMode() {
+		return nil
+	}
+	cond, params := lossyModifyColumnCondition(oldCol, newCol)
+	if cond == "" {
+		return nil
+	}
+	paramsList := make([]interface{}, 0, 2+len(params))
+	paramsList = append(paramsList, schema.L, table.L)
+	paramsList = append(paramsList, params...)
+	//nolint:forcetypeassert
+	rows, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil, "select count(1) from %n.%n where "+cond, paramsList...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	lossyRows := rows[0].GetInt64(0)
+	if lossyRows > 0 {
+		return dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs(fmt.Sprintf(
+			"%d rows of column '%s' would overflow or be truncated, use WITH VALIDATION or FORCE to confirm the lossy conversion",
+			lossyRows, oldCol.Name.O))
+	}
+	return nil
+}
@@ -559,8 +559,8 @@ func TestGeneratedColumnDDL(t *testing.T) {
 		{`create table test_gv_ddl_bad (a int, b int, c int as (a+b), primary key(c))`, errno.ErrUnsupportedOnGeneratedColumn},
 		{`create table test_gv_ddl_bad (a int, b int, c int as (a+b), primary key(a, c))`, errno.ErrUnsupportedOnGeneratedColumn},
 
-		// Add stored generated column through alter table.
-		{`alter table test_gv_ddl add column d int as (b+2) stored`, errno.ErrUnsupportedOnGeneratedColumn},
+		// Add stored generated column in multi-schema change.
+		{`alter table test_gv_ddl add column d int as (b+2) stored, add column e int`, errno.ErrUnsupportedOnGeneratedColumn},
 		{`alter table test_gv_ddl modify column b int as (a + 8) stored`, errno.ErrUnsupportedOnGeneratedColumn},
 
 		// Add generated column with incorrect parameter count.
@@ -601,6 +601,71 @@ func TestGeneratedColumnDDL(t *testing.T) {
 	tk.MustQuery("select * from t").Check(testkit.Rows("a\\b\\c\\"))
 }
 
+func TestAddStoredGeneratedColumn(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomainWithSchemaLease(t, columnModifyLease)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b varchar(20), c int as (a * 2))")
+	tk.MustExec("insert into t (a, b) values (1, 'a'), (2, 'bb'), (3, null)")
+
+	// The rows written during the job are generated by the DML.
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec("use test")
+	hook := &callback.TestDDLCallback{Do: dom}
+	var checkErr error
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if checkErr != nil || job.Type != model.ActionAddColumn {
+			return
+		}
+		var sql string
+		switch job.SchemaState {
+		case model.StateWriteOnly:
+			sql = "insert into t (a, b) values (4, 'dddd')"
+		case model.StateWriteReorganization:
+			sql = "update t set b = 'ccc' where a = 3"
+		default:
+			return
+		}
+		if _, err := tk1.Exec(sql); err != nil {
+			checkErr = errors.Errorf("err: %s, sql: %s, job schema state: %s", err.Error(), sql, job.SchemaState)
+		}
+	}
+	dom.DDL().SetHook(hook)
+	tk.MustExec("alter table t add column d int as (a + length(b)) stored")
+	require.NoError(t, checkErr)
+	tk.MustQuery("select a, b, d from t").Check(testkit.Rows("1 a 2", "2 bb 4", "3 ccc 6", "4 dddd 8"))
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `a` int(11) NOT NULL,\n" +
+		"  `b` varchar(20) DEFAULT NULL,\n" +
+		"  `c` int(11) GENERATED ALWAYS AS (`a` * 2) VIRTUAL,\n" +
+		"  `d` int(11) GENERATED ALWAYS AS (`a` + length(`b`)) STORED,\n" +
+		"  PRIMARY KEY (`a`) /*T![clustered_index] CLUSTERED */\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	dom.DDL().SetHook(&callback.TestDDLCallback{Do: dom})
+
+	// The index is built from the stored values.
+	tk.MustExec("alter table t add index idx_d(d)")
+	tk.MustQuery("select a from t use index(idx_d) where d = 6").Check(testkit.Rows("3"))
+	tk.MustExec("admin check table t")
+
+	// The expression referring to the virtual generated column is evaluated locally.
+	tk.MustExec("alter table t add column e int as (c + 1) stored")
+	tk.MustQuery("select a, e from t").Check(testkit.Rows("1 3", "2 5", "3 7", "4 9"))
+
+	// The job is rolled back if a value can't be written.
+	tk.MustExec("insert into t (a) values (5)")
+	tk.MustGetErrCode("alter table t add column f int not null as (length(b)) stored", errno.ErrBadNull)
+	tk.MustQuery("select count(*) from information_schema.columns where table_schema = 'test' and table_name = 't' and column_name = 'f'").Check(testkit.Rows("0"))
+	tk.MustExec("admin check table t")
+
+	// The rows without clustered index.
+	tk.MustExec("create table t1 (a int, b int, index idx_a(a))")
+	tk.MustExec("insert into t1 values (1, 10), (2, 20), (1, 30)")
+	tk.MustExec("alter table t1 add column c int as (a + b) stored")
+	tk.MustQuery("select a, b, c from t1 order by b").Check(testkit.Rows("1 10 11", "2 20 22", "1 30 31"))
+	tk.MustExec("admin check table t1")
+}
+
 func TestColumnModifyingDefinition(t *testing.T) {
 	store := testkit.CreateMockStoreWithSchemaLease(t, columnModifyLease)
 	tk := testkit.NewTestKit(t, store)
@@ -1029,3 +1094,47 @@ func TestColumnTypeChangeGenUniqueChangingName(t *testing.T) {
 
 	tk.MustExec("drop table if exists t")
 }
+
+func TestColumnCompression(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b text compression='ZSTD', c blob)")
+	tbl := external.GetTableByName(t, tk, "test", "t")
+	require.Equal(t, "zstd", tbl.Meta().Columns[1].Compression)
+	require.True(t, mysql.HasCompressedFlag(tbl.Meta().Columns[1].GetFlag()))
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `a` int(11) NOT NULL,\n" +
+		"  `b` text DEFAULT NULL /*T![column_compression] COMPRESSION='zstd' */,\n" +
+		"  `c` blob DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`a`) /*T![clustered_index] CLUSTERED */\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+
+	large := strings.Repeat("tidb", 1000)
+	tk.MustExec(fmt.Sprintf("insert into t values (1, '%s', '%s'), (2, 'a', 'b'), (3, null, null)", large, large))
+	tk.MustExec("update t set b = concat(b, 'x') where a = 2")
+	check := func() {
+		tk.MustQuery("select a, length(b), md5(b) = md5(c) from t order by a").Check(testkit.Rows("1 4000 1", "2 2 0", "3 <nil> <nil>"))
+		tk.MustQuery("select a from t where b = c").Check(testkit.Rows("1"))
+	}
+	check()
+
+	// The existing values are compressed and decompressed by the backfill.
+	tk.MustExec("alter table t modify c blob compression='zstd'")
+	tbl = external.GetTableByName(t, tk, "test", "t")
+	require.Equal(t, "zstd", tbl.Meta().Columns[2].Compression)
+	check()
+	tk.MustExec("alter table t modify b text")
+	tbl = external.GetTableByName(t, tk, "test", "t")
+	require.Equal(t, "", tbl.Meta().Columns[1].Compression)
+	require.False(t, mysql.HasCompressedFlag(tbl.Meta().Columns[1].GetFlag()))
+	check()
+	tk.MustExec("alter table t add column d longtext compression='zstd' default null")
+	tk.MustExec(fmt.Sprintf("update t set d = '%s' where a = 1", large))
+	tk.MustQuery("select length(d) from t where a = 1").Check(testkit.Rows("4000"))
+
+	tk.MustGetErrCode("create table t1 (a int compression='zstd')", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create table t1 (a text compression='lz4')", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create table t1 (a text, b text as (a) virtual compression='zstd')", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t modify a int compression='zstd'", errno.ErrUnsupportedDDLOperation)
+}
@@ -2437,7 +2437,70 @@ func TestFixDDLTxnWillConflictWithReorgTxn(t *testing.T) {
 	tk.MustExec("alter table t add index(a)")
 	tk.MustExec("set @@sql_mode=''")
 	tk.MustExec("insert into t values(128),(129)")
-	tk.MustExec("alter table t modify column a tinyint")
+	tk.MustExec("alter table t modify column a tinyint, force")
 
 	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1690 2 warnings with this error code, first warning: constant 128 overflows tinyint"))
 }
+
+func TestColumnTypeChangeConvertCharset(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustExec("create table t (id int primary key, a varchar(10) charset utf8mb4 collate utf8mb4_bin, index idx(a))")
+	tk.MustExec("insert into t values (1, 'abc'), (2, '中文'), (3, '😀x')")
+	// The conversion is aborted by the invalid characters by default.
+	tk.MustGetErrMsg("alter table t modify column a varchar(10) charset gbk collate gbk_chinese_ci",
+		"[table:1366]Incorrect string value '😀x' for column 'a'")
+	tk.MustQuery("select a from t order by id").Check(testkit.Rows("abc", "中文", "😀x"))
+
+	// The invalid characters are replaced with '?'.
+	tk.MustExec("set @@tidb_ddl_charset_convert_policy = 'replace'")
+	tk.MustExec("alter table t modify column a varchar(10) charset gbk collate gbk_chinese_ci")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1366 Incorrect string value '😀x' for column 'a'"))
+	tk.MustQuery("select a from t order by id").Check(testkit.Rows("abc", "中文", "?x"))
+	tk.MustQuery("select id from t use index(idx) where a = '中文'").Check(testkit.Rows("2"))
+	tk.MustExec("admin check table t")
+
+	// The latin1 values are converted to utf8 with the collation change on the indexed column.
+	tk.MustExec("create table t1 (id int primary key, a varchar(10) charset latin1 collate latin1_bin, index idx(a))")
+	tk.MustExec("insert into t1 values (1, 'abc'), (2, 'ABC')")
+	tk.MustExec("set @@tidb_ddl_charset_convert_policy = default")
+	tk.MustExec("alter table t1 modify column a varchar(10) charset utf8 collate utf8_general_ci")
+	tk.MustQuery("select id from t1 use index(idx) where a = 'abc' order by id").Check(testkit.Rows("1", "2"))
+	tk.MustExec("admin check table t1")
+}
+
+func TestColumnTypeChangeLossyConversionCheck(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustExec("create table t (id int primary key, a int, b varchar(10), c decimal(10, 2))")
+	tk.MustExec("insert into t values (1, 1, 'a', 1.5), (2, 128, 'abcd', 1.25), (3, -129, 'ab', -1)")
+	// In strict SQL mode, the job fails and rolls back on the lossy rows.
+	tk.MustGetErrCode("alter table t modify column a tinyint", errno.ErrDataOutOfRange)
+
+	tk.MustExec("set @@sql_mode = ''")
+	tk.MustGetErrMsg("alter table t modify column a tinyint",
+		"[ddl:8200]Unsupported modify column: 2 rows of column 'a' would overflow or be truncated, use WITH VALIDATION or FORCE to confirm the lossy conversion")
+	tk.MustGetErrMsg("alter table t modify column a int unsigned",
+		"[ddl:8200]Unsupported modify column: 1 rows of column 'a' would overflow or be truncated, use WITH VALIDATION or FORCE to confirm the lossy conversion")
+	tk.MustGetErrMsg("alter table t change column b b varchar(3)",
+		"[ddl:8200]Unsupported modify column: 1 rows of column 'b' would overflow or be truncated, use WITH VALIDATION or FORCE to confirm the lossy conversion")
+	tk.MustGetErrMsg("alter table t modify column c decimal(10, 1)",
+		"[ddl:8200]Unsupported modify column: 1 rows of column 'c' would overflow or be truncated, use WITH VALIDATION or FORCE to confirm the lossy conversion")
+	tk.MustQuery("select a, b, c from t order by id").Check(testkit.Rows("1 a 1.50", "128 abcd 1.25", "-129 ab -1.00"))
+
+	// The conversions without the lossy rows are not blocked.
+	tk.MustExec("alter table t modify column a smallint")
+	tk.MustExec("alter table t modify column b varchar(4)")
+	tk.MustExec("alter table t modify column c decimal(5, 2)")
+
+	// The lossy conversions are confirmed by `WITH VALIDATION` or `FORCE`.
+	tk.MustExec("alter table t modify column a tinyint, force")
+	tk.MustExec("alter table t change column b b varchar(3), with validation")
+	tk.MustExec("alter table t modify column c decimal(5, 1), modify column b varchar(2), force")
+	tk.MustQuery("select a, b, c from t order by id").Check(testkit.Rows("1 a 1.5", "127 ab 1.3", "-128 ab -1.0"))
+	tk.MustExec("admin check table t")
+}
@@ -29,6 +29,9 @@ const (
 	BackgroundSubtaskTable = "tidb_background_subtask"
 	// BackgroundSubtaskHistoryTable stores the information of history backfill jobs.
 	BackgroundSubtaskHistoryTable = "tidb_background_subtask_history"
+	// BackgroundSubtaskGCNamespace is the namespace of the subtasks in tidb_background_subtask which resolve the
+	// locks for GC. They are run by the GC workers of all the TiDB servers instead of the backfill workers.
+	BackgroundSubtaskGCNamespace = "gc_resolve_locks"
 
 	// JobTableID is the table ID of `tidb_ddl_job`.
 	JobTableID = meta.MaxInt48 - 1
@@ -0,0 +1,391 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/logutil"
+	decoder "github.com/pingcap/tidb/util/rowDecoder"
+	"go.uber.org/zap"
+)
+
+func init() {
+	registerBackfiller(typeCheckConstraintWorker, "check constraint", newCheckConstraintBackfiller)
+}
+
+// checkConstraintWorker validates the existing rows against a check constraint being added. It only reads the
+// rows, the first row evaluating the constraint to false fails the backfill.
+type checkConstraintWorker struct {
+	*backfillCtx
+	constraintInfo *model.ConstraintInfo
+	expr           expression.Expression
+	jsonSchema     *expression.JSONSchemaCheck
+
+	rowDecoder *decoder.RowDecoder
+	rowMap     map[int64]types.Datum
+}
+
+func newCheckConstraintBackfiller(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error) {
+	reorgInfo := b.reorgInfo
+	tblInfo := b.tbl.Meta()
+	constraintInfo := findConstraintInfoByID(tblInfo, reorgInfo.currElement.ID)
+	if constraintInfo == nil {
+		return nil, dbterror.ErrCancelledDDLJob.GenWithStack("check constraint %d of table %s is not found",
+			reorgInfo.currElement.ID, tblInfo.Name)
+	}
+	expr, err := expression.ParseSimpleExprWithTableInfo(sessCtx, constraintInfo.ExprString, tblInfo)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &checkConstraintWorker{
+		backfillCtx:    newBackfillCtx(reorgInfo.d, id, sessCtx, reorgInfo.SchemaName, b.tbl, b.jobCtx, "check_constraint_rate", false),
+		constraintInfo: constraintInfo,
+		expr:           expr,
+		jsonSchema:     expression.NewJSONSchemaCheck(expr),
+		rowDecoder:     decoder.NewRowDecoder(b.tbl, b.tbl.WritableCols(), b.decodeColMap),
+		rowMap:         make(map[int64]types.Datum, len(b.decodeColMap)),
+	}, nil
+}
+
+func (w *checkConstraintWorker) AddMetricInfo(cnt float64) {
+	w.metricCounter.Add(cnt)
+}
+
+func (*checkConstraintWorker) String() string {
+	return typeCheckConstraintWorker.String()
+}
+
+func (*checkConstraintWorker) GetTasks() ([]*BackfillJob, error) {
+	panic("[ddl] check constraint worker GetTask function doesn't implement")
+}
+
+func (*checkConstraintWorker) UpdateTask(*BackfillJob) error {
+	panic("[ddl] check constraint worker UpdateTask function doesn't implement")
+}
+
+func (*checkConstraintWorker) FinishTask(*BackfillJob) error {
+	panic("[ddl] check constraint worker FinishTask function doesn't implement")
+}
+
+func (w *checkConstraintWorker) GetCtx() *backfillCtx {
+	return w.backfillCtx
+}
+
+// BackfillData checks a batch of rows against the constraint. Nothing is written, so the rows are read from a
+// snapshot instead of a transaction.
+func (w *checkConstraintWorker) BackfillData(handleRange reorgBackfillTask) (taskCtx backfillTaskContext, err error) {
+	oprStartTime := time.Now()
+	ver, err := w.sessCtx.GetStore().CurrentVersion(kv.GlobalTxnScope)
+	if err != nil {
+		return taskCtx, errors.Trace(err)
+	}
+	sysTZ := w.sessCtx.GetSessionVars().StmtCtx.TimeZone
+	taskDone := false
+	var lastAccessedHandle kv.Key
+	err = iterateSnapshotKeys(w.jobContext, w.sessCtx.GetStore(), handleRange.priority, handleRange.physicalTable.RecordPrefix(),
+		ver.Ver, handleRange.startKey, handleRange.endKey, func(handle kv.Handle, recordKey kv.Key, rawRow []byte) (bool, error) {
+			if handleRange.endInclude {
+				taskDone = recordKey.Cmp(handleRange.endKey) > 0
+			} else {
+				taskDone = recordKey.Cmp(handleRange.endKey) >= 0
+			}
+			if taskDone || taskCtx.scanCount >= w.batchCnt {
+				return false, nil
+			}
+
+			taskCtx.scanCount++
+			_, err := w.rowDecoder.DecodeAndEvalRowWithMap(w.sessCtx, handle, rawRow, sysTZ, w.rowMap)
+			if err != nil {
+				return false, errors.Trace(dbterror.ErrCantDecodeRecord.GenWithStackByArgs("constraint", err))
+			}
+			err = w.checkRow(handle)
+			for id := range w.rowMap {
+				delete(w.rowMap, id)
+			}
+			if err != nil {
+				return false, errors.Trace(err)
+			}
+			taskCtx.addedCount++
+			// The rate of the validation is limited by the size of the checked rows.
+			taskCtx.addedBytes += len(rawRow)
+			lastAccessedHandle = recordKey
+			if recordKey.Cmp(handleRange.endKey) == 0 {
+				taskDone = true
+				return false, nil
+			}
+			return true, nil
+		})
+	if err != nil {
+		return taskCtx, errors.Trace(err)
+	}
+	if taskCtx.scanCount == 0 {
+		taskDone = true
+	}
+	taskCtx.nextKey = getNextHandleKey(handleRange, taskDone, lastAccessedHandle)
+	taskCtx.done = taskDone
+	logSlowOperations(time.Since(oprStartTime), "CheckConstraintBackfillData", 3000)
+	return taskCtx, nil
+}
+
+// checkRow checks the decoded row against the constraint. The JSON Schema constraint validates the document
+// directly, and the error tells which part of the document violates the schema.
+func (w *checkConstraintWorker) checkRow(handle kv.Handle) error {
+	row := w.rowDecoder.CurrentRowWithDefaultVal()
+	if w.jsonSchema != nil {
+		report, err := w.jsonSchema.Check(w.sessCtx, row)
+		if err != nil || report == nil {
+			return err
+		}
+		logutil.BgLogger().Info("[ddl] the row violates the check constraint", zap.Stringer("worker", w),
+			zap.String("constraint", w.constraintInfo.Name.O), zap.Stringer("handle", handle), zap.Stringer("report", report))
+		return table.ErrCheckConstraintViolated.GenWithStack("Check constraint '%s' is violated by the row with handle %s: %s.",
+			w.constraintInfo.Name.O, handle, report)
+	}
+	val, isNull, err := w.expr.EvalInt(w.sessCtx, row)
+	if err != nil {
+		return err
+	}
+	// The row satisfies the constraint unless it's evaluated to false, the same as the DML.
+	if !isNull && val == 0 {
+		logutil.BgLogger().Info("[ddl] the row violates the check constraint", zap.Stringer("worker", w),
+			zap.String("constraint", w.constraintInfo.Name.O), zap.Stringer("handle", handle))
+		return table.ErrCheckConstraintViolated.GenWithStackByArgs(w.constraintInfo.Name.O)
+	}
+	return nil
+}
+
+func findConstraintInfoByID(tblInfo *model.TableInfo, id int64) *model.ConstraintInfo {
+	for _, constraintInfo := range tblInfo.Constraints {
+		if constraintInfo.ID == id {
+			return constraintInfo
+		}
+	}
+	return nil
+}
+
+// checkDropColumnWithCheckConstraint returns an error if the dropped or renamed column is used by a check constraint.
+func checkDropColumnWithCheckConstraint(tblInfo *model.TableInfo, colName model.CIStr) error {
+	for _, constraintInfo := range tblInfo.Constraints {
+		if containsColumnName(constraintInfo.ConstraintCols, colName) {
+			return dbterror.ErrDependentByCheckConstraint.GenWithStackByArgs(constraintInfo.Name.O, colName.O)
+		}
+	}
+	return nil
+}
+
+// onAddCheckConstraint adds a check constraint. The constraint is enforced on the writes in the write only state,
+// then the existing rows are validated in the write reorganization state, the job is rolled back if any of them
+// violates the constraint. The constraint isn't validated if it's not enforced.
+func (w *worker) onAddCheckConstraint(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	// Handle the rolling back job.
+	if job.IsRollingback() {
+		return onDropCheckConstraint(d, t, job)
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	constraintInfo := &model.ConstraintInfo{}
+	if err = job.DecodeArgs(constraintInfo); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	if existing := tblInfo.FindConstraintInfoByName(constraintInfo.Name.L); existing != nil {
+		if existing.State == model.StatePublic {
+			job.State = model.JobStateCancelled
+			return ver, dbterror.ErrCheckConstraintDupName.GenWithStackByArgs(constraintInfo.Name.O)
+		}
+		constraintInfo = existing
+	} else {
+		tblInfo.MaxConstraintID++
+		constraintInfo.ID = tblInfo.MaxConstraintID
+		constraintInfo.State = model.StateNone
+		tblInfo.Constraints = append(tblInfo.Constraints, constraintInfo)
+	}
+
+	originalState := constraintInfo.State
+	switch constraintInfo.State {
+	case model.StateNone:
+		// none -> write only
+		job.SchemaState = model.StateWriteOnly
+		constraintInfo.State = model.StateWriteOnly
+		ver, err = updateVersionAndTableInfoWithCheck(d, t, job, tblInfo, originalState != constraintInfo.State)
+	case model.StateWriteOnly:
+		// write only -> reorganization
+		job.SchemaState = model.StateWriteReorganization
+		constraintInfo.State = model.StateWriteReorganization
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != constraintInfo.State)
+	case model.StateWriteReorganization:
+		// reorganization -> public
+		if constraintInfo.Enforced {
+			var done bool
+			done, ver, err = w.runCheckConstraintReorg(d, t, job, tblInfo, constraintInfo)
+			if err != nil || !done {
+				return ver, errors.Trace(err)
+			}
+		}
+		constraintInfo.State = model.StatePublic
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != constraintInfo.State)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	default:
+		err = dbterror.ErrInvalidDDLState.GenWithStackByArgs("constraint", constraintInfo.State)
+	}
+	return ver, errors.Trace(err)
+}
+
+func (w *worker) runCheckConstraintReorg(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo,
+	constraintInfo *model.ConstraintInfo) (done bool, ver int64, err error) {
+	tbl, err := getTable(d.store, job.SchemaID, tblInfo)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	sctx, err := w.sessPool.get()
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	defer w.sessPool.put(sctx)
+	rh := newReorgHandler(newSession(sctx))
+	dbInfo, err := t.GetDatabase(job.SchemaID)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	elements := []*meta.Element{{ID: constraintInfo.ID, TypeKey: meta.ConstraintElementKey}}
+	reorgInfo, err := getReorgInfo(d.jobContext(job.ID), d, rh, job, dbInfo, tbl, elements, false)
+	if err != nil || reorgInfo == nil || reorgInfo.first {
+		return false, ver, errors.Trace(err)
+	}
+	err = w.runReorgJob(rh, reorgInfo, tbl.Meta(), d.lease, func() (checkErr error) {
+		defer util.Recover(metrics.LabelDDL, "onAddCheckConstraint",
+			func() {
+				checkErr = dbterror.ErrCancelledDDLJob.GenWithStack("check table `%v` constraint `%v` panic", tbl.Meta().Name, constraintInfo.Name)
+			}, false)
+		return w.checkTableConstraint(tbl, reorgInfo)
+	})
+	if err != nil {
+		if dbterror.ErrWaitReorgTimeout.Equal(err) {
+			// If timeout, we should return, check for the owner and re-wait job done.
+			return false, ver, nil
+		}
+		if table.ErrCheckConstraintViolated.Equal(err) || dbterror.ErrCancelledDDLJob.Equal(err) || dbterror.ErrCantDecodeRecord.Equal(err) {
+			logutil.BgLogger().Warn("[ddl] run add check constraint job failed, convert job to rollback", zap.String("job", job.String()), zap.Error(err))
+			ver, err = convertAddCheckConstraintJob2RollbackJob(d, t, job, tblInfo, constraintInfo, err)
+			if err1 := rh.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
+				logutil.BgLogger().Warn("[ddl] run add check constraint job failed, convert job to rollback, RemoveDDLReorgHandle failed", zap.String("job", job.String()), zap.Error(err1))
+			}
+		}
+		return false, ver, errors.Trace(err)
+	}
+	return true, ver, nil
+}
+
+// checkTableConstraint validates the rows of a table, the partitions are validated one by one.
+func (w *worker) checkTableConstraint(t table.Table, reorgInfo *reorgInfo) error {
+	tbl, ok := t.(table.PartitionedTable)
+	if !ok {
+		//nolint:forcetypeassert
+		return w.checkPhysicalTableConstraint(t.(table.PhysicalTable), reorgInfo)
+	}
+	for {
+		p := tbl.GetPartition(reorgInfo.PhysicalTableID)
+		if p == nil {
+			return dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", reorgInfo.PhysicalTableID, t.Meta().ID)
+		}
+		if err := w.checkPhysicalTableConstraint(p, reorgInfo); err != nil {
+			return errors.Trace(err)
+		}
+		finish, err := updateReorgInfo(w.sessPool, tbl, reorgInfo)
+		if err != nil || finish {
+			return errors.Trace(err)
+		}
+	}
+}
+
+func (w *worker) checkPhysicalTableConstraint(t table.PhysicalTable, reorgInfo *reorgInfo) error {
+	logutil.BgLogger().Info("[ddl] start to check table constraint", zap.String("job", reorgInfo.Job.String()), zap.String("reorgInfo", reorgInfo.String()))
+	return w.writePhysicalTableRecord(w.sessPool, t, typeCheckConstraintWorker, reorgInfo)
+}
+
+// convertAddCheckConstraintJob2RollbackJob converts the add check constraint job to a rolling back job, which drops
+// the constraint.
+func convertAddCheckConstraintJob2RollbackJob(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo,
+	constraintInfo *model.ConstraintInfo, err error) (int64, error) {
+	job.Args = []interface{}{constraintInfo.Name}
+	originalState := constraintInfo.State
+	constraintInfo.State = model.StateDeleteOnly
+	job.SchemaState = model.StateDeleteOnly
+	ver, err1 := updateVersionAndTableInfo(d, t, job, tblInfo, originalState != constraintInfo.State)
+	if err1 != nil {
+		return ver, errors.Trace(err1)
+	}
+	job.State = model.JobStateRollingback
+	return ver, errors.Trace(err)
+}
+
+// onDropCheckConstraint drops a check constraint, it's also used to roll back the add check constraint job. The
+// constraint is removed in one step, since enforcing it on some of the servers for a while is harmless.
+func onDropCheckConstraint(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	var constrName model.CIStr
+	if err = job.DecodeArgs(&constrName); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	idx := -1
+	for i, constraintInfo := range tblInfo.Constraints {
+		if constraintInfo.Name.L == constrName.L {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		if job.IsRollingback() {
+			// The constraint isn't added yet.
+			job.FinishTableJob(model.JobStateRollbackDone, model.StateNone, ver, tblInfo)
+			return ver, nil
+		}
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrConstraintNotFound.GenWithStackByArgs(constrName.O)
+	}
+	tblInfo.Constraints = append(tblInfo.Constraints[:idx], tblInfo.Constraints[idx+1:]...)
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if job.IsRollingback() {
+		job.FinishTableJob(model.JobStateRollbackDone, model.StateNone, ver, tblInfo)
+	} else {
+		job.FinishTableJob(model.JobStateDone, model.StateNone, ver, tblInfo)
+	}
+	return ver, nil
+}
@@ -0,0 +1,499 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
+	litkv "github.com/pingcap/tidb/br/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/ddl/ingest"
+	"github.com/pingcap/tidb/ddl/util"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/meta/autoid"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	tidbutil "github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+// createTableSelect creates the table of 'CREATE TABLE ... SELECT'. The selected rows are written into the table
+// in the reorganization of the DDL job, and the table becomes public after all the rows are written, so the job
+// can be cancelled and shows its progress like adding index.
+func (d *ddl) createTableSelect(ctx sessionctx.Context, dbName model.CIStr, tbInfo *model.TableInfo,
+	s *ast.CreateTableStmt, onExist OnExist) error {
+	if len(tbInfo.ForeignKeys) > 0 {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("CREATE TABLE ... SELECT with foreign keys")
+	}
+	if tbInfo.TempTableType != model.TempTableNone {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("CREATE TEMPORARY TABLE ... SELECT")
+	}
+	// The table names in the SELECT have been qualified by the schema names in preprocessing.
+	var sb strings.Builder
+	if err := s.Select.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return errors.Trace(err)
+	}
+
+	job, err := d.createTableWithInfoJob(ctx, dbName, tbInfo, onExist, false)
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		return nil
+	}
+	tzName, tzOffset := util.GetTimeZone(ctx)
+	job.Type = model.ActionCreateTableSelect
+	job.Args = []interface{}{tbInfo, sb.String(), false, []int64{}}
+	job.ReorgMeta = &model.DDLReorgMeta{
+		SQLMode:       ctx.GetSessionVars().SQLMode,
+		Warnings:      make(map[errors.ErrorID]*terror.Error),
+		WarningsCount: make(map[errors.ErrorID]int64),
+		Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+	}
+	job.Priority = ctx.GetSessionVars().DDLReorgPriority
+	d.preSplitAndScatter(ctx, tbInfo, tbInfo.GetPartitionInfo())
+
+	err = d.DoDDLJob(ctx, job)
+	if err != nil && onExist == OnExistIgnore && infoschema.ErrTableExists.Equal(err) {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		err = nil
+	}
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// onCreateTableSelect runs the job of 'CREATE TABLE ... SELECT'. The job writes the selected rows in the
+// StateWriteReorganization state before creating the table, the written rows are deleted by GC if the job
+// is rolled back. If the writing is interrupted, the rows are written again into new physical tables, and
+// the abandoned physical tables are deleted by GC when the job finishes.
+func (w *worker) onCreateTableSelect(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	tbInfo := &model.TableInfo{}
+	var selectSQL string
+	var written bool
+	var abandonedIDs []int64
+	if err = job.DecodeArgs(tbInfo, &selectSQL, &written, &abandonedIDs); err != nil {
+		// Invalid arguments, cancel this job.
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	switch job.SchemaState {
+	case model.StateNone:
+		err = checkTableNotExists(d, t, job.SchemaID, tbInfo.Name.L)
+		if err != nil {
+			if infoschema.ErrDatabaseNotExists.Equal(err) || infoschema.ErrTableExists.Equal(err) {
+				job.State = model.JobStateCancelled
+			}
+			return ver, errors.Trace(err)
+		}
+		job.SchemaState = model.StateWriteReorganization
+		return ver, nil
+	case model.StateWriteReorganization:
+		if written {
+			return finishCreateTableSelect(d, t, job, tbInfo, abandonedIDs)
+		}
+		if job.SnapshotVer != 0 && w.getReorgCtx(job.ID) == nil {
+			// The SELECT can't be resumed from the rows written before the owner changes, and writing the rows
+			// again with the same row IDs meets duplicate keys, so write them into new physical tables.
+			abandonedIDs, err = restartCreateTableSelect(t, job, tbInfo, abandonedIDs)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			job.Args = []interface{}{tbInfo, selectSQL, false, abandonedIDs}
+			return ver, nil
+		}
+		if job.SnapshotVer == 0 {
+			job.ReorgMeta.ReorgTp = pickCreateTableSelectWriteType(w, job, tbInfo)
+			currVer, err := getValidCurrentVersion(d.store)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			job.SnapshotVer = currVer.Ver
+		}
+
+		err = w.runCreateTableSelectReorg(d, job, tbInfo, selectSQL)
+		if err != nil {
+			if dbterror.ErrWaitReorgTimeout.Equal(err) {
+				// If timeout, we should return, check for the owner and re-wait job done.
+				return ver, nil
+			}
+			logutil.BgLogger().Warn("[ddl] write the selected rows failed, convert job to rollback",
+				zap.String("job", job.String()), zap.Error(err))
+			return convertCreateTableSelectJob2RollbackJob(t, job, tbInfo, abandonedIDs, err)
+		}
+		// Create the table in the next round, so the rows aren't written again if the table creation meets errors.
+		job.Args = []interface{}{tbInfo, selectSQL, true, abandonedIDs}
+		return ver, nil
+	default:
+		return ver, dbterror.ErrInvalidDDLState.GenWithStackByArgs("table", job.SchemaState)
+	}
+}
+
+// restartCreateTableSelect allocates new physical table IDs for the table, so the selected rows are written again
+// from scratch in the next round. It returns the physical table IDs which are abandoned by the job.
+func restartCreateTableSelect(t *meta.Meta, job *model.Job, tbInfo *model.TableInfo, abandonedIDs []int64) ([]int64, error) {
+	// The local engine of the previous writing is useless.
+	ingest.LitBackCtxMgr.Unregister(job.ID)
+	if err := t.GetAutoIDAccessors(job.SchemaID, tbInfo.ID).Del(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	oldIDs := append([]int64{tbInfo.ID}, getPartitionIDs(tbInfo)...)
+	newIDs, err := t.GenGlobalIDs(len(oldIDs))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	tbInfo.ID = newIDs[0]
+	if pi := tbInfo.GetPartitionInfo(); pi != nil {
+		for i := range pi.Definitions {
+			pi.Definitions[i].ID = newIDs[i+1]
+		}
+	}
+	logutil.BgLogger().Info("[ddl] the writing of the selected rows is interrupted, restart it with new physical tables",
+		zap.Int64("jobID", job.ID), zap.Int64s("abandoned physical table IDs", oldIDs), zap.Int64("table ID", tbInfo.ID))
+	job.TableID = tbInfo.ID
+	job.SnapshotVer = 0
+	job.SetRowCount(0)
+	job.ReorgMeta.ReorgTp = model.ReorgTypeNone
+	return append(abandonedIDs, oldIDs...), nil
+}
+
+// pickCreateTableSelectWriteType returns ReorgTypeLitMerge if the rows can be ingested, otherwise the rows are
+// written in transactions.
+func pickCreateTableSelectWriteType(w *worker, job *model.Job, tbInfo *model.TableInfo) model.ReorgType {
+	if !IsEnableFastReorg() || !ingest.LitInitialized || !canUseIngest() {
+		return model.ReorgTypeTxn
+	}
+	_, err := ingest.LitBackCtxMgr.Register(w.ctx, hasUniqueKey(tbInfo), job.ID, job.ReorgMeta.SQLMode)
+	if err != nil {
+		logutil.BgLogger().Warn("[ddl] lightning register error, fallback to write the selected rows in transactions",
+			zap.Int64("jobID", job.ID), zap.Error(err))
+		return model.ReorgTypeTxn
+	}
+	return model.ReorgTypeLitMerge
+}
+
+func hasUniqueKey(tbInfo *model.TableInfo) bool {
+	if tbInfo.PKIsHandle || tbInfo.IsCommonHandle {
+		return true
+	}
+	for _, idx := range tbInfo.Indices {
+		if idx.Unique {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *worker) runCreateTableSelectReorg(d *ddlCtx, job *model.Job, tbInfo *model.TableInfo, selectSQL string) error {
+	sctx, err := w.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer w.sessPool.put(sctx)
+	rh := newReorgHandler(newSession(sctx))
+	reorgInfo := &reorgInfo{Job: job, d: d, PhysicalTableID: tbInfo.ID}
+	useIngest := job.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge
+	err = w.runReorgJob(rh, reorgInfo, nil, d.lease, func() (writeErr error) {
+		defer tidbutil.Recover(metrics.LabelDDL, "runCreateTableSelectReorg",
+			func() {
+				writeErr = dbterror.ErrCancelledDDLJob.GenWithStack("create table `%v` select panic", tbInfo.Name)
+			}, false)
+		return w.writeSelectedRows(d, job, tbInfo, selectSQL, useIngest)
+	})
+	if err != nil || !useIngest {
+		return err
+	}
+	bc, ok := ingest.LitBackCtxMgr.Load(job.ID)
+	if !ok {
+		return errors.New(ingest.LitErrGetBackendFail)
+	}
+	defer ingest.LitBackCtxMgr.Unregister(job.ID)
+	tbl, err := tables.TableFromMeta(autoid.NewAllocators(false), tbInfo)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = bc.FinishImport(0, hasUniqueKey(tbInfo), tbl)
+	if err != nil {
+		if common.ErrFoundDuplicateKeys.Equal(err) {
+			err = convertToCreateTableSelectKeyExistsErr(err, tbInfo)
+		}
+		return errors.Trace(err)
+	}
+	job.ReorgMeta.Progress = bc.Progress()
+	return nil
+}
+
+// writeSelectedRows executes the SELECT and writes the encoded rows, it runs in the reorganization goroutine.
+func (w *worker) writeSelectedRows(d *ddlCtx, job *model.Job, tbInfo *model.TableInfo, selectSQL string, useIngest bool) error {
+	rc := w.getReorgCtx(job.ID)
+	if rc == nil {
+		return dbterror.ErrCancelledDDLJob
+	}
+	ctx := kv.WithInternalSourceType(w.ctx, getDDLRequestSource(job.Type))
+	sctx, err := w.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer w.sessPool.put(sctx)
+	exec, ok := sctx.(sqlexec.SQLExecutor)
+	if !ok {
+		return errors.New("the session can't execute the SELECT of CREATE TABLE ... SELECT")
+	}
+	// The SELECT is evaluated with the SQL mode and the time zone of the statement.
+	vars := sctx.GetSessionVars()
+	originSQLMode, originTimeZone := vars.SQLMode, vars.TimeZone
+	defer func() {
+		vars.SQLMode, vars.TimeZone = originSQLMode, originTimeZone
+	}()
+	vars.SQLMode = job.ReorgMeta.SQLMode
+	if vars.TimeZone, err = job.ReorgMeta.Location.GetLocation(); err != nil {
+		return errors.Trace(err)
+	}
+
+	alloc := litkv.NewPanickingAllocators(mathutil.Max(tbInfo.AutoIncID-1, 0))
+	tbl, err := tables.TableFromMeta(alloc, tbInfo)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	logger := log.Logger{Logger: logutil.BgLogger()}
+	encoder, err := litkv.NewTableKVEncoder(tbl, &litkv.SessionOptions{
+		SQLMode:   job.ReorgMeta.SQLMode,
+		Timestamp: time.Now().Unix(),
+	}, nil, logger)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer encoder.Close()
+
+	var writer *ingest.WriterContext
+	var bc *ingest.BackendContext
+	if useIngest {
+		bc, ok = ingest.LitBackCtxMgr.Load(job.ID)
+		if !ok {
+			return errors.New(ingest.LitErrGetBackendFail)
+		}
+		ei, err := bc.EngMgr.Register(bc, job.ID, 0, tbInfo.ID, job.SchemaName, tbInfo.Name.O)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if writer, err = ei.NewWriterCtx(0, hasUniqueKey(tbInfo)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	rs, err := exec.ExecuteInternal(ctx, selectSQL)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if rs == nil {
+		return errors.New("the SELECT of CREATE TABLE ... SELECT returns no result set")
+	}
+	defer terror.Call(rs.Close)
+	fields := rs.Fields()
+	fieldTypes := make([]*types.FieldType, 0, len(fields))
+	offsets := make(map[string]int, len(fields))
+	for i, f := range fields {
+		fieldTypes = append(fieldTypes, &f.Column.FieldType)
+		offsets[f.ColumnAsName.L] = i
+	}
+	// The selected columns are mapped to the table columns by names, and the last one is the _tidb_rowid.
+	cols := tbl.Cols()
+	permutation := make([]int, len(cols)+1)
+	for i, col := range cols {
+		permutation[i] = -1
+		if offset, ok := offsets[col.Name.L]; ok {
+			permutation[i] = offset
+		}
+	}
+	permutation[len(cols)] = -1
+
+	rowID := alloc.Get(autoid.RowIDAllocType).Base()
+	chk := rs.NewChunk(nil)
+	for {
+		if rc.isReorgCanceled() {
+			return dbterror.ErrCancelledDDLJob
+		}
+		if err = rs.Next(ctx, chk); err != nil {
+			return errors.Trace(err)
+		}
+		if chk.NumRows() == 0 {
+			break
+		}
+
+		kvs := make([]common.KvPair, 0, chk.NumRows()*(len(tbInfo.Indices)+1))
+		size := 0
+		for i := 0; i < chk.NumRows(); i++ {
+			rowID++
+			row, err := encoder.Encode(logger, chk.GetRow(i).GetDatumRow(fieldTypes), rowID, permutation, "", 0)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			for _, pair := range litkv.KvPairsFromRow(row) {
+				size += len(pair.Key) + len(pair.Val)
+				if writer == nil {
+					kvs = append(kvs, pair)
+					continue
+				}
+				if err = writer.WriteRow(pair.Key, pair.Val, kv.IntHandle(rowID)); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+		if writer != nil {
+			err = bc.Flush(0, tbInfo.ID)
+		} else {
+			err = writeSelectedKVsInTxn(ctx, d.store, tbInfo, kvs)
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+		rc.increaseRowCount(int64(chk.NumRows()))
+		rc.throttle(ctx, size)
+		failpoint.Inject("mockCreateTableSelectOwnerChange", func(val failpoint.Value) {
+			if val.(bool) {
+				// Quit like the owner changes after some rows are written.
+				failpoint.Return(dbterror.ErrWaitReorgTimeout)
+			}
+		})
+	}
+
+	// Save the auto IDs which have been used, they're loaded by the allocators after the table is created.
+	base := alloc.Get(autoid.RowIDAllocType).Base()
+	return kv.RunInNewTxn(ctx, d.store, true, func(_ context.Context, txn kv.Transaction) error {
+		return meta.NewMeta(txn).GetAutoIDAccessors(job.SchemaID, tbInfo.ID).Put(
+			meta.AutoIDGroup{RowID: base, IncrementID: base, RandomID: base})
+	})
+}
+
+// writeSelectedKVsInTxn writes the key-values in a transaction, it returns the duplicate key error if any key exists.
+func writeSelectedKVsInTxn(ctx context.Context, store kv.Storage, tbInfo *model.TableInfo, kvs []common.KvPair) error {
+	return kv.RunInNewTxn(ctx, store, true, func(ctx context.Context, txn kv.Transaction) error {
+		keys := make([]kv.Key, 0, len(kvs))
+		for _, pair := range kvs {
+			keys = append(keys, pair.Key)
+		}
+		existing, err := txn.BatchGet(ctx, keys)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		memBuffer := txn.GetMemBuffer()
+		for _, pair := range kvs {
+			val, ok := existing[string(pair.Key)]
+			if !ok {
+				if val, err = memBuffer.Get(ctx, pair.Key); err == nil {
+					ok = true
+				} else if !kv.IsErrNotFound(err) {
+					return errors.Trace(err)
+				}
+			}
+			if ok {
+				return genCreateTableSelectKeyExistsErr(pair.Key, val, tbInfo)
+			}
+			if err = txn.Set(pair.Key, pair.Val); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	})
+}
+
+func convertToCreateTableSelectKeyExistsErr(originErr error, tbInfo *model.TableInfo) error {
+	tErr, ok := errors.Cause(originErr).(*terror.Error)
+	if !ok || len(tErr.Args()) != 2 {
+		return originErr
+	}
+	key, keyIsByte := tErr.Args()[0].([]byte)
+	value, valIsByte := tErr.Args()[1].([]byte)
+	if !keyIsByte || !valIsByte {
+		return originErr
+	}
+	return genCreateTableSelectKeyExistsErr(key, value, tbInfo)
+}
+
+func genCreateTableSelectKeyExistsErr(key, value []byte, tbInfo *model.TableInfo) error {
+	if tablecodec.IsIndexKey(key) {
+		_, idxID, _, err := tablecodec.DecodeIndexKey(key)
+		if err == nil {
+			if idxInfo := model.FindIndexInfoByID(tbInfo.Indices, idxID); idxInfo != nil {
+				return genKeyExistsErr(key, value, idxInfo, tbInfo)
+			}
+		}
+		return kv.ErrKeyExists.FastGenByArgs(key, tbInfo.Name.String())
+	}
+	_, handle, err := tablecodec.DecodeRecordKey(key)
+	if err != nil {
+		return kv.ErrKeyExists.FastGenByArgs(key, tbInfo.Name.String())
+	}
+	return kv.ErrKeyExists.FastGenByArgs(handle.String(), tbInfo.Name.String()+".PRIMARY")
+}
+
+// finishCreateTableSelect creates the table after all the selected rows are written. The abandoned physical
+// tables are deleted by GC with the IDs in the job arguments.
+func finishCreateTableSelect(d *ddlCtx, t *meta.Meta, job *model.Job, tbInfo *model.TableInfo, abandonedIDs []int64) (ver int64, err error) {
+	err = checkTableNotExists(d, t, job.SchemaID, tbInfo.Name.L)
+	if err != nil {
+		if infoschema.ErrTableExists.Equal(err) {
+			return convertCreateTableSelectJob2RollbackJob(t, job, tbInfo, abandonedIDs, err)
+		}
+		return ver, errors.Trace(err)
+	}
+	tbInfo, err = createTable(d, t, job, false)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	ver, err = updateSchemaVersion(d, t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.Args = []interface{}{abandonedIDs}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tbInfo)
+	asyncNotifyEvent(d, &util.Event{Tp: model.ActionCreateTable, TableInfo: tbInfo})
+	return ver, nil
+}
+
+// convertCreateTableSelectJob2RollbackJob finishes the job as rolled back, the written rows are deleted by GC
+// with the physical table IDs in the job arguments, including the abandoned ones.
+func convertCreateTableSelectJob2RollbackJob(t *meta.Meta, job *model.Job, tbInfo *model.TableInfo, abandonedIDs []int64, err error) (int64, error) {
+	ingest.LitBackCtxMgr.Unregister(job.ID)
+	if err1 := t.GetAutoIDAccessors(job.SchemaID, tbInfo.ID).Del(); err1 != nil {
+		return 0, errors.Trace(err1)
+	}
+	physicalTableIDs := getPartitionIDs(tbInfo)
+	if len(physicalTableIDs) == 0 {
+		physicalTableIDs = []int64{tbInfo.ID}
+	}
+	job.Args = []interface{}{append(physicalTableIDs, abandonedIDs...)}
+	job.State = model.JobStateRollbackDone
+	job.SchemaState = model.StateNone
+	return 0, errors.Trace(err)
+}
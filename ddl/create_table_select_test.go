@@ -0,0 +1,88 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableSelect(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_ddl_enable_fast_reorg = 0")
+	tk.MustExec("create table src (a int not null, b varchar(10), c decimal(10, 2))")
+	tk.MustExec("insert into src values (1, 'a', 1.5), (2, 'b', 2.5), (3, null, null)")
+
+	// The columns are derived from the output of the SELECT.
+	tk.MustExec("create table t1 select a, b, c * 2 as d from src")
+	tk.MustQuery("select * from t1 order by a").Check(testkit.Rows("1 a 3.00", "2 b 5.00", "3 <nil> <nil>"))
+	tk.MustQuery("select column_name, column_type, is_nullable from information_schema.columns " +
+		"where table_schema = 'test' and table_name = 't1' and column_name in ('a', 'b') order by ordinal_position").Check(testkit.Rows(
+		"a int(11) NO", "b varchar(10) YES"))
+
+	// The defined columns come before the selected ones, and the rows are written into the matching columns.
+	tk.MustExec("create table t2 (id int auto_increment primary key, a int) select a, b from src")
+	tk.MustQuery("select * from t2 order by id").Check(testkit.Rows("1 1 a", "2 2 b", "3 3 <nil>"))
+	tk.MustExec("insert into t2 (a) values (4)")
+	tk.MustQuery("select id from t2 where a = 4").Check(testkit.Rows("4"))
+	tk.MustExec("admin check table t2")
+
+	// The table isn't created if the selected rows are duplicated.
+	tk.MustGetErrCode("create table t3 (b varchar(10), unique key(b)) select b from src union all select 'a'", errno.ErrDupEntry)
+	tk.MustGetErrCode("select * from t3", errno.ErrNoSuchTable)
+
+	tk.MustExec("create table if not exists t1 select 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1050 Table 'test.t1' already exists"))
+	tk.MustQuery("select count(*) from t1").Check(testkit.Rows("3"))
+
+	tk.MustGetErrCode("create table t4 select a, a from src", errno.ErrDupFieldName)
+	tk.MustGetErrCode("create temporary table t4 select a from src", errno.ErrUnsupportedDDLOperation)
+}
+
+func TestCreateTableSelectOwnerChange(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_ddl_enable_fast_reorg = 0")
+	tk.MustExec("create table src (a int primary key, b int)")
+	for i := 0; i < 10; i++ {
+		tk.MustExec(fmt.Sprintf("insert into src values (%d, %d)", i, i))
+	}
+
+	// The writing quits after the first chunk is written, the rows are written again into a new physical table
+	// without meeting the duplicate keys of the written rows.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockCreateTableSelectOwnerChange", "1*return(true)"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockCreateTableSelectOwnerChange"))
+	}()
+	tk.MustExec("create table t (a int primary key, b int) select a, b from src")
+	tk.MustQuery("select count(*), sum(a), sum(b) from t").Check(testkit.Rows("10 45 45"))
+	tk.MustExec("admin check table t")
+	tk.MustExec("insert into t values (10, 10)")
+	tk.MustGetErrCode("insert into t values (9, 9)", errno.ErrDupEntry)
+
+	// The rows written into the abandoned physical table are deleted by GC.
+	rows := tk.MustQuery("admin show ddl jobs 1").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "10", rows[0][7])
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.gc_delete_range where job_id = %s", rows[0][0])).Check(testkit.Rows("1"))
+}
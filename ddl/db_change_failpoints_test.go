@@ -23,15 +23,19 @@ import (
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/ddl/ingest"
 	ddlutil "github.com/pingcap/tidb/ddl/util"
 	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/errno"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/testkit/external"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/gcutil"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 )
 
 // TestModifyColumnTypeArgs test job raw args won't be updated when error occurs in `updateVersionAndTableInfo`.
@@ -168,3 +172,191 @@ func TestParallelFlashbackTable(t *testing.T) {
 	sql2 := "flashback table t_flashback to t_flashback2"
 	testControlParallelExecSQL(t, tk, store, dom, "", sql1, sql2, f)
 }
+
+func TestAddIndexRefreshReorgSnapshot(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+
+	var refreshed int
+	var oldSnapshot, newSnapshot uint64
+	ddl.MockReorgSnapshotRefreshed = func(oldVer, newVer uint64) {
+		refreshed++
+		oldSnapshot, newSnapshot = oldVer, newVer
+	}
+	defer func() { ddl.MockReorgSnapshotRefreshed = nil }()
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockReorgSnapshotExpired", `1*return(true)`))
+	tk.MustExec("alter table t add index idx(b)")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockReorgSnapshotExpired"))
+	require.Equal(t, 1, refreshed)
+	require.Greater(t, newSnapshot, oldSnapshot)
+	tk.MustExec("admin check table t")
+	tk.MustQuery("select b from t use index(idx) where b > 1").Check(testkit.Rows("2", "3"))
+
+	refreshed = 0
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockReorgSnapshotExpired", `1*return(true)`))
+	tk.MustExec("alter table t modify column b bigint")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockReorgSnapshotExpired"))
+	require.Equal(t, 1, refreshed)
+	tk.MustExec("admin check table t")
+
+	// The backfill goes on from the processed key after the refresh instead of scanning the table again.
+	tk.MustExec("set global tidb_ddl_enable_fast_reorg = 0")
+	defer tk.MustExec("set global tidb_ddl_enable_fast_reorg = default")
+	tk.MustExec("create table t1 (a int primary key, b int)")
+	for i := 0; i < 8; i++ {
+		tk.MustExec("insert into t1 values (?, ?)", i*10, i*10)
+	}
+	tk.MustQuery("split table t1 between (0) and (80) regions 8").Check(testkit.Rows("7 1"))
+	ddl.SetBackfillTaskChanSizeForTest(2)
+	defer ddl.SetBackfillTaskChanSizeForTest(1024)
+	refreshed = 0
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockReorgSnapshotExpired", `1*off->1*return(true)`))
+	tk.MustExec("alter table t1 add index idx(b)")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockReorgSnapshotExpired"))
+	require.Equal(t, 1, refreshed)
+	rows := tk.MustQuery("admin show ddl jobs 1").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "8", rows[0][7])
+	tk.MustExec("admin check table t1")
+}
+
+func TestAddIndexRefreshReorgSnapshotForPartitionsConcurrently(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_ddl_enable_fast_reorg = 0")
+	defer tk.MustExec("set global tidb_ddl_enable_fast_reorg = default")
+	tk.MustExec("set global tidb_ddl_reorg_partition_concurrency = 2")
+	defer tk.MustExec("set global tidb_ddl_reorg_partition_concurrency = default")
+	tk.MustExec("create table t (a int primary key, b int) partition by hash(a) partitions 4")
+	for i := 0; i < 20; i++ {
+		tk.MustExec("insert into t values (?, ?)", i, i)
+	}
+
+	// The partitions backfilled concurrently refresh their snapshots separately.
+	var refreshed atomic.Int32
+	ddl.MockReorgSnapshotRefreshed = func(oldVer, newVer uint64) {
+		refreshed.Add(1)
+		require.Greater(t, newVer, oldVer)
+	}
+	defer func() { ddl.MockReorgSnapshotRefreshed = nil }()
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockReorgSnapshotExpired", `2*return(true)`))
+	tk.MustExec("alter table t add index idx(b)")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockReorgSnapshotExpired"))
+	require.Equal(t, int32(2), refreshed.Load())
+	tk.MustExec("admin check table t")
+	tk.MustQuery("select count(*) from t use index(idx) where b >= 10").Check(testkit.Rows("10"))
+}
+
+func TestBackfillRetryOnTransientError(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockBackfillRetryableErr", `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockBackfillRetryableErr"))
+	}()
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_ddl_enable_fast_reorg = 0")
+	defer tk.MustExec("set global tidb_ddl_enable_fast_reorg = default")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("alter table t add index idx(b)")
+	tk.MustExec("admin check table t")
+	tk.MustExec("alter table t modify column b varchar(10)")
+	tk.MustExec("admin check table t")
+	tk.MustQuery("select b from t use index(idx) order by b").Check(testkit.Rows("1", "2", "3"))
+}
+
+func TestBackfillVerifyChecksum(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_ddl_enable_fast_reorg = 0")
+	defer tk.MustExec("set global tidb_ddl_enable_fast_reorg = default")
+	tk.MustExec("set global tidb_ddl_reorg_verify_checksum = on")
+	defer tk.MustExec("set global tidb_ddl_reorg_verify_checksum = default")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+
+	// The checksum of each batch is computed by the coprocessor of the mock store.
+	tk.MustExec("alter table t add index idx(b)")
+	tk.MustExec("admin check table t")
+	// The backfill batches can still be committed with async commit or 1PC.
+	tk.MustExec("set global tidb_enable_async_commit = on")
+	defer tk.MustExec("set global tidb_enable_async_commit = default")
+	tk.MustExec("set global tidb_enable_1pc = on")
+	defer tk.MustExec("set global tidb_enable_1pc = default")
+	tk.MustExec("alter table t add unique index idx1(b)")
+	tk.MustExec("admin check table t")
+
+	// The index is rolled back if the checksum of a committed batch doesn't match.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockBackfillChecksumMismatch", `return`))
+	tk.MustGetErrCode("alter table t add index idx2(b)", errno.ErrBackfillChecksumMismatch)
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockBackfillChecksumMismatch"))
+	tk.MustQuery("select count(*) from information_schema.tidb_indexes where table_name = 't' and key_name = 'idx2'").Check(testkit.Rows("0"))
+	tk.MustExec("admin check table t")
+}
+
+func TestAddIndexSwitchToIngestErr(t *testing.T) {
+	if variable.DDLEnableDistributeReorg.Load() {
+		t.Skip("the distributed reorg doesn't switch the backfill process at the checkpoint")
+	}
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@global.tidb_ddl_enable_fast_reorg = on")
+	defer tk.MustExec("set @@global.tidb_ddl_enable_fast_reorg = default")
+	originalVal := variable.GetDDLErrorCountLimit()
+	tk.MustExec("set @@global.tidb_ddl_error_count_limit = 1")
+	defer tk.MustExec(fmt.Sprintf("set @@global.tidb_ddl_error_count_limit = %d", originalVal))
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+
+	// The ingest environment errors are ignored, and the txn-merge backfill goes on.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockSwitchToIngestErr", `return("`+ingest.LitErrCreateBackendFail+`")`))
+	tk.MustExec("alter table t add index idx(b)")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockSwitchToIngestErr"))
+	tk.MustExec("admin check table t")
+	rows := tk.MustQuery("admin show ddl jobs 1").Rows()
+	require.Len(t, rows, 1)
+	require.Contains(t, rows[0][3].(string), "txn-merge")
+
+	// The other errors are returned instead of being swallowed.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockSwitchToIngestErr", `return("mock switch to ingest error")`))
+	err := tk.ExecToErr("alter table t add index idx2(b)")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockSwitchToIngestErr"))
+	require.ErrorContains(t, err, "mock switch to ingest error")
+	tk.MustGetErrCode("select * from t use index(idx2)", errno.ErrKeyDoesNotExist)
+	tk.MustExec("admin check table t")
+}
+
+func TestAddIndexWithDDLJobLabels(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_ddl_enable_fast_reorg = 0")
+	defer tk.MustExec("set global tidb_ddl_enable_fast_reorg = default")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+
+	// The labels of the session are attached to the request source of the backfill.
+	tk.MustExec("set @@tidb_ddl_job_labels = 'ticket=DB-1234, team=payment'")
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/checkBackfillRequestSource", `return("ddl_add_index{ticket=DB-1234,team=payment}")`))
+	tk.MustExec("alter table t add index idx(b)")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/checkBackfillRequestSource"))
+	// The sub-jobs of a multi-schema change are run with the labels of their parent job.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/checkBackfillRequestSource", `return("ddl{ticket=DB-1234,team=payment}")`))
+	tk.MustExec("alter table t add index idx1(b), add column c int")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/checkBackfillRequestSource"))
+	tk.MustExec("admin check table t")
+
+	tk.MustExec("set @@tidb_ddl_job_labels = ''")
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/checkBackfillRequestSource", `return("ddl_add_index")`))
+	tk.MustExec("alter table t add index idx2(b)")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/checkBackfillRequestSource"))
+	tk.MustExec("admin check table t")
+}
@@ -1898,8 +1898,9 @@ func TestExpressionIndexDDLError(t *testing.T) {
 	tk := testkit.NewTestKit(t, store)
 	tk.MustExec("use test")
 	tk.MustExec("create table t(a int, b int, index idx((a+b)))")
-	tk.MustGetErrCode("alter table t rename column b to b2", errno.ErrDependentByFunctionalIndex)
 	tk.MustGetErrCode("alter table t drop column b", errno.ErrDependentByFunctionalIndex)
+	tk.MustExec("alter table t rename column b to b2")
+	tk.MustGetErrCode("alter table t drop column b2", errno.ErrDependentByFunctionalIndex)
 }
 
 func TestParallelRenameTable(t *testing.T) {
@@ -1872,21 +1872,66 @@ func TestAlterTableAddUniqueOnPartionRangeColumn(t *testing.T) {
 	tk.MustGetErrCode("alter table t add unique index idx_b(b)", errno.ErrUniqueKeyNeedAllFieldsInPf)
 }
 
-func TestFulltextIndexIgnore(t *testing.T) {
+func TestFulltextIndex(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
 	tk.MustExec("use test")
 	tk.MustExec("drop table if exists t_ft")
 	defer tk.MustExec("drop table if exists t_ft")
-	// Make sure that creating and altering to add a fulltext key gives the correct warning
-	assertWarningExec(tk, t, "create table t_ft (a text, fulltext key (a))", dbterror.ErrTableCantHandleFt)
-	assertWarningExec(tk, t, "alter table t_ft add fulltext key (a)", dbterror.ErrTableCantHandleFt)
-
-	// Make sure table t_ft still has no indexes even after it was created and altered
-	r := tk.MustQuery("show index from t_ft")
-	require.Len(t, r.Rows(), 0)
-	r = tk.MustQuery("select * from information_schema.statistics where table_schema='test' and table_name='t_ft'")
-	require.Len(t, r.Rows(), 0)
+
+	tk.MustExec("create table t_ft (id int primary key, a varchar(100), b text, fulltext key fa (a))")
+	tk.MustExec("insert into t_ft values (1, 'MySQL Tutorial', 'DBMS stands for DataBase'), " +
+		"(2, 'How To Use MySQL Well', 'After you went through a tutorial'), (3, 'Optimizing MySQL', 'In this tutorial'), " +
+		"(4, 'MySQL vs. YourSQL', 'In the following database comparison'), (5, NULL, 'Security tips')")
+	tk.MustQuery("show index from t_ft where key_name = 'fa'").CheckAt([]int{2, 4, 10}, testkit.Rows("fa a FULLTEXT"))
+	tk.MustExec("admin check table t_ft")
+
+	// Backfill a fulltext index on two columns.
+	tk.MustExec("alter table t_ft add fulltext key fab (a, b)")
+	tk.MustQuery("show create table t_ft").Check(testkit.Rows("t_ft CREATE TABLE `t_ft` (\n" +
+		"  `id` int(11) NOT NULL,\n" +
+		"  `a` varchar(100) DEFAULT NULL,\n" +
+		"  `b` text DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  FULLTEXT KEY `fa` (`a`),\n" +
+		"  FULLTEXT KEY `fab` (`a`,`b`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustExec("admin check table t_ft")
+
+	tk.MustQuery("select id from t_ft where match (a) against ('mysql tutorial') order by id").Check(testkit.Rows("1", "2", "3", "4"))
+	tk.MustQuery("select id, match (a, b) against ('tutorial') from t_ft order by id").Check(testkit.Rows("1 1", "2 1", "3 1", "4 0", "5 0"))
+	tk.MustQuery("select id from t_ft where match (b, a) against ('+mysql -yoursql' in boolean mode) order by id").Check(testkit.Rows("1", "2", "3"))
+	tk.MustQuery("select id from t_ft where match (a, b) against ('data*' in boolean mode) order by id").Check(testkit.Rows("1", "4"))
+	tk.MustQuery("select id from t_ft where match (a, b) against ('\"went through\"' in boolean mode)").Check(testkit.Rows("2"))
+
+	// MATCH reads the rows located by the entries of the fulltext index.
+	require.True(t, tk.MustUseIndex("select id from t_ft where match (a) against ('mysql tutorial')", "fa(a)"))
+	sql := "select id from t_ft where match (b, a) against ('+mysql +tutorial' in boolean mode)"
+	require.True(t, tk.HasPlan(sql, "IndexMerge"))
+	require.True(t, tk.MustUseIndex(sql, "fab(a)"))
+	tk.MustQuery(sql + " order by id").Check(testkit.Rows("1", "2", "3"))
+	sql = "select id from t_ft where match (a, b) against ('yoursql comparison \"went through\"' in boolean mode)"
+	require.True(t, tk.HasPlan(sql, "IndexMerge"))
+	tk.MustQuery(sql + " order by id").Check(testkit.Rows("2", "4"))
+
+	// The matched columns must be the columns of a fulltext index.
+	tk.MustGetErrCode("select * from t_ft where match (b) against ('tutorial')", errno.ErrFtMatchingKeyNotFound)
+	tk.MustGetErrCode("select * from t_ft where match (a) against (b)", errno.ErrWrongArguments)
+	tk.MustGetErrCode("select * from t_ft where match (a) against ('tutorial' with query expansion)", errno.ErrNotSupportedYet)
+
+	// DML maintains the fulltext index entries.
+	tk.MustExec("update t_ft set a = 'Learning TiDB' where id = 1")
+	tk.MustExec("delete from t_ft where id = 2")
+	tk.MustExec("insert into t_ft values (6, 'TiDB Tutorial', NULL)")
+	tk.MustExec("admin check table t_ft")
+	tk.MustQuery("select id from t_ft where match (a) against ('tidb') order by id").Check(testkit.Rows("1", "6"))
+
+	// Only the non-binary string columns can be in a fulltext index.
+	tk.MustGetErrCode("alter table t_ft add fulltext key (id)", errno.ErrBadFtColumn)
+	tk.MustGetErrCode("create table t_ft2 (a blob, fulltext key (a))", errno.ErrBadFtColumn)
+	tk.MustGetErrCode("alter table t_ft add fulltext key (a(10))", errno.ErrWrongSubKey)
+	tk.MustExec("drop index fa on t_ft")
+	tk.MustExec("admin check table t_ft")
 }
 
 func TestTreatOldVersionUTF8AsUTF8MB4(t *testing.T) {
@@ -2108,6 +2153,36 @@ func TestDefaultColumnWithRand(t *testing.T) {
 	tk.MustGetErrCode("CREATE TABLE t3 (c int, c1 int default a_function_not_supported_yet());", errno.ErrDefValGeneratedNamedFunctionIsNotAllowed)
 }
 
+func TestAddColumnWithDefaultExpr(t *testing.T) {
+	store := testkit.CreateMockStoreWithSchemaLease(t, testLease)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+
+	tk.MustExec("alter table t add column c varchar(32) default (date_format(now(), '%Y-%m-%d %H:%i:%s'))")
+	tk.MustExec("alter table t add column d varchar(8) default (upper(substring('abcd', 2, 2)))")
+	tbl := external.GetTableByName(t, tk, "test", "t")
+	// The default expression is evaluated once when the column is added.
+	originDefVal := tbl.Meta().Columns[2].GetOriginDefaultValue()
+	_, err := time.Parse("2006-01-02 15:04:05", originDefVal.(string))
+	require.NoError(t, err)
+	require.Equal(t, "BC", tbl.Meta().Columns[3].GetOriginDefaultValue())
+
+	// All the existing rows read the same value.
+	rows := testkit.Rows(fmt.Sprintf("%s BC", originDefVal), fmt.Sprintf("%s BC", originDefVal), fmt.Sprintf("%s BC", originDefVal))
+	tk.MustQuery("select c, d from t order by a").Check(rows)
+	time.Sleep(time.Second)
+	tk.MustQuery("select c, d from t order by a").Check(rows)
+	tk.MustExec("update t set b = b + 1 where a = 1")
+	tk.MustQuery("select c, d from t order by a").Check(rows)
+	tk.MustExec("admin check table t")
+
+	tk.MustExec("insert into t(a, b) values (4, 4)")
+	tk.MustQuery("select count(*) from t where c is null or d is null").Check(testkit.Rows("0"))
+}
+
 func TestDefaultColumnWithUUID(t *testing.T) {
 	store := testkit.CreateMockStoreWithSchemaLease(t, testLease)
 	tk := testkit.NewTestKit(t, store)
@@ -2248,6 +2323,43 @@ func TestChangingDBCharset(t *testing.T) {
 	tk.MustExec("alter database TEST_UPPERCASE_DB_CHARSET default character set utf8mb4;")
 }
 
+func TestAlterDatabaseCharsetCascade(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create database cascade_db character set utf8 collate utf8_bin")
+	tk.MustExec("use cascade_db")
+	tk.MustExec("create table t1 (a varchar(10))")
+	tk.MustExec("create table t2 (a varchar(10)) charset ascii")
+	tk.MustExec("create table t3 (a char(10)) charset utf8mb4 collate utf8mb4_bin")
+	tk.MustExec("create view v as select * from t1")
+	collationOfT1 := func() []string {
+		return []string{
+			tk.MustQuery("select table_collation from information_schema.tables where table_schema = 'cascade_db' and table_name = 't1'").Rows()[0][0].(string),
+			tk.MustQuery("select collation_name from information_schema.columns where table_schema = 'cascade_db' and table_name = 't1'").Rows()[0][0].(string),
+		}
+	}
+
+	// The tables are not converted by default.
+	tk.MustQuery("select @@tidb_alter_database_charset_cascade").Check(testkit.Rows("OFF"))
+	tk.MustExec("alter database cascade_db character set utf8mb4 collate utf8mb4_bin")
+	require.Equal(t, []string{"utf8_bin", "utf8_bin"}, collationOfT1())
+
+	tk.MustExec("set @@tidb_alter_database_charset_cascade = 'stop_on_error'")
+	err := tk.ExecToErr("alter database cascade_db character set utf8mb4 collate utf8mb4_bin")
+	require.True(t, dbterror.ErrUnsupportedModifyCharset.Equal(err), err.Error())
+	require.Equal(t, []string{"utf8mb4_bin", "utf8mb4_bin"}, collationOfT1())
+
+	tk.MustExec("set @@tidb_alter_database_charset_cascade = 'continue_on_error'")
+	tk.MustExec("alter database cascade_db character set utf8mb4 collate utf8mb4_bin")
+	require.Equal(t, "In total 4 tables: 0 succeed, 1 failed, 3 skipped, 0 not processed (failed tables: t2)", tk.Session().GetSessionVars().StmtCtx.GetMessage())
+
+	tk.MustExec("drop table t2")
+	tk.MustExec("alter database cascade_db character set utf8mb4 collate utf8mb4_general_ci")
+	require.Equal(t, "In total 3 tables: 2 succeed, 0 failed, 1 skipped, 0 not processed", tk.Session().GetSessionVars().StmtCtx.GetMessage())
+	require.Equal(t, []string{"utf8mb4_general_ci", "utf8mb4_general_ci"}, collationOfT1())
+	tk.MustQuery("select table_collation from information_schema.tables where table_schema = 'cascade_db' and table_name = 't3'").Check(testkit.Rows("utf8mb4_general_ci"))
+}
+
 func TestDropAutoIncrementIndex(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -1952,6 +1952,146 @@ func TestDropPartitionWithGlobalIndex(t *testing.T) {
 	require.Equal(t, 2, cnt)
 }
 
+func TestDropPartitionWithGlobalIndexConcurrently(t *testing.T) {
+	defer config.RestoreFunc()()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.EnableGlobalIndex = true
+	})
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists test_global")
+	tk.MustExec(`create table test_global ( a int, b int, c int)
+	partition by range( a ) (
+		partition p1 values less than (10),
+		partition p2 values less than (20),
+		partition p3 values less than (30),
+		partition p4 values less than (40)
+	);`)
+	tk.MustExec("Alter Table test_global Add Unique Index idx_b (b);")
+	tk.MustExec(`INSERT INTO test_global VALUES (1, 1, 1), (11, 2, 2), (21, 3, 3), (31, 4, 4), (32, 5, 5)`)
+	tt := external.GetTableByName(t, tk, "test", "test_global")
+	pids := []int64{tt.Meta().Partition.Definitions[1].ID, tt.Meta().Partition.Definitions[2].ID, tt.Meta().Partition.Definitions[3].ID}
+
+	tk.MustExec("set @@global.tidb_ddl_reorg_partition_concurrency = 2")
+	defer tk.MustExec("set @@global.tidb_ddl_reorg_partition_concurrency = default")
+	tk.MustExec("alter table test_global drop partition p2, p3, p4")
+	tk.MustQuery("select * from test_global").Check(testkit.Rows("1 1 1"))
+
+	tt = external.GetTableByName(t, tk, "test", "test_global")
+	idxInfo := tt.Meta().FindIndexByName("idx_b")
+	require.NotNil(t, idxInfo)
+	for _, pid := range pids {
+		require.Equal(t, 1, checkGlobalIndexCleanUpDone(t, tk.Session(), tt.Meta(), idxInfo, pid))
+	}
+	tk.MustExec("admin check global index test_global idx_b")
+	tk.MustExec("insert into test_global values (2, 2, 2)")
+}
+
+func TestTruncatePartitionWithGlobalIndex(t *testing.T) {
+	defer config.RestoreFunc()()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.EnableGlobalIndex = true
+	})
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists test_global")
+	tk.MustExec(`create table test_global ( a int, b int, c int)
+	partition by range( a ) (
+		partition p1 values less than (10),
+		partition p2 values less than (20),
+		partition p3 values less than (30)
+	);`)
+	tk.MustExec("Alter Table test_global Add Unique Index idx_b (b);")
+	tk.MustExec(`INSERT INTO test_global VALUES (1, 1, 1), (2, 2, 2), (11, 3, 3), (12, 4, 4), (21, 5, 5)`)
+	tt := external.GetTableByName(t, tk, "test", "test_global")
+	pids := []int64{tt.Meta().Partition.Definitions[1].ID, tt.Meta().Partition.Definitions[2].ID}
+
+	tk.MustExec("set @@global.tidb_ddl_reorg_partition_concurrency = 2")
+	defer tk.MustExec("set @@global.tidb_ddl_reorg_partition_concurrency = default")
+	tk.MustExec("alter table test_global truncate partition p2, p3")
+	tk.MustQuery("select * from test_global").Sort().Check(testkit.Rows("1 1 1", "2 2 2"))
+
+	tt = external.GetTableByName(t, tk, "test", "test_global")
+	require.Nil(t, tt.Meta().Partition.DroppingDefinitions)
+	idxInfo := tt.Meta().FindIndexByName("idx_b")
+	require.NotNil(t, idxInfo)
+	for _, pid := range pids {
+		require.Equal(t, 2, checkGlobalIndexCleanUpDone(t, tk.Session(), tt.Meta(), idxInfo, pid))
+	}
+	tk.MustExec("admin check global index test_global idx_b")
+
+	// The values of the truncated partitions can be inserted again.
+	tk.MustExec("insert into test_global values (13, 3, 3), (22, 5, 5)")
+	tk.MustGetErrCode("insert into test_global values (14, 1, 1)", errno.ErrDupEntry)
+	tk.MustQuery("select * from test_global where b = 3").Check(testkit.Rows("13 3 3"))
+	tk.MustExec("admin check global index test_global idx_b")
+	tk.MustExec("admin check table test_global")
+}
+
+func TestExchangePartitionWithGlobalIndex(t *testing.T) {
+	defer config.RestoreFunc()()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.EnableGlobalIndex = true
+	})
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_enable_exchange_partition=1")
+	tk.MustExec("drop table if exists test_global, test_nt")
+	tk.MustExec(`create table test_global ( a int, b int, c int)
+	partition by range( a ) (
+		partition p1 values less than (10),
+		partition p2 values less than (20)
+	);`)
+	tk.MustExec("Alter Table test_global Add Unique Index idx_b (b);")
+	tk.MustExec(`INSERT INTO test_global VALUES (1, 1, 1), (2, 2, 2), (11, 3, 3), (12, 4, 4)`)
+	tk.MustExec("create table test_nt (a int, b int, c int, unique index idx_b(b))")
+	tt := external.GetTableByName(t, tk, "test", "test_global")
+	pid := tt.Meta().Partition.Definitions[1].ID
+
+	// The records of the non-partitioned table have no global index entries.
+	tk.MustExec("insert into test_nt values (13, 13, 13)")
+	tk.MustGetErrCode("alter table test_global exchange partition p2 with table test_nt", errno.ErrUnsupportedDDLOperation)
+	tk.MustExec("delete from test_nt")
+
+	// The exchanged out records are changed by the non-partitioned table while purging the global index.
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec("use test")
+	dom := domain.GetDomain(tk.Session())
+	originHook := dom.DDL().GetHook()
+	defer dom.DDL().SetHook(originHook)
+	hook := &callback.TestDDLCallback{Do: dom}
+	var states []model.SchemaState
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if job.Type != model.ActionExchangeTablePartition {
+			return
+		}
+		if len(states) > 0 && states[len(states)-1] == job.SchemaState {
+			return
+		}
+		states = append(states, job.SchemaState)
+		if job.SchemaState == model.StateDeleteReorganization {
+			tk2.MustExec("update test_nt set b = b + 10 where a = 11")
+		}
+	}
+	dom.DDL().SetHook(hook)
+	tk.MustExec("alter table test_global exchange partition p2 with table test_nt")
+	require.Equal(t, []model.SchemaState{model.StateNone, model.StateDeleteOnly, model.StateDeleteReorganization}, states)
+	tk.MustQuery("select * from test_global").Sort().Check(testkit.Rows("1 1 1", "2 2 2"))
+	tk.MustQuery("select * from test_nt").Sort().Check(testkit.Rows("11 13 3", "12 4 4"))
+
+	tt = external.GetTableByName(t, tk, "test", "test_global")
+	idxInfo := tt.Meta().FindIndexByName("idx_b")
+	require.NotNil(t, idxInfo)
+	require.Equal(t, 2, checkGlobalIndexCleanUpDone(t, tk.Session(), tt.Meta(), idxInfo, pid))
+	tk.MustExec("admin check global index test_global idx_b")
+	tk.MustExec("admin check table test_nt")
+	tk.MustExec("insert into test_global values (11, 3, 3), (12, 4, 4)")
+	tk.MustExec("admin check global index test_global idx_b")
+}
+
 func TestAlterTableExchangePartition(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -2008,6 +2148,14 @@ func TestAlterTableExchangePartition(t *testing.T) {
 	tk.MustQuery("select * from e3 partition(p0)").Check(testkit.Rows("1", "5"))
 	tk.MustQuery("select * from e2").Check(testkit.Rows())
 
+	// without validation test for range partition, the rows out of the range are exchanged in as they are
+	tk.MustExec("insert into e2 values (1669)")
+	tk.MustExec("ALTER TABLE e EXCHANGE PARTITION p0 WITH TABLE e2 WITHOUT VALIDATION")
+	tk.MustQuery("select * from e partition(p0)").Check(testkit.Rows("1669"))
+	tk.MustExec("ALTER TABLE e EXCHANGE PARTITION p0 WITH TABLE e2 WITHOUT VALIDATION")
+	tk.MustQuery("select * from e2").Check(testkit.Rows("1669"))
+	tk.MustExec("truncate table e2")
+
 	// more boundary test of range partition
 	// for partition p0
 	tk.MustExec(`create table e4 (a int) partition by range(a) (
@@ -4621,7 +4769,7 @@ func TestAlterModifyPartitionColTruncateWarning(t *testing.T) {
 	tk.MustExec(`insert into t values ("123456"),(" 654321")`)
 	tk.MustContainErrMsg(`alter table t modify a varchar(5)`, "[types:1265]Data truncated for column 'a', value is '")
 	tk.MustExec(`set sql_mode = ''`)
-	tk.MustExec(`alter table t modify a varchar(5)`)
+	tk.MustExec(`alter table t modify a varchar(5), force`)
 	// Fix the duplicate warning, see https://github.com/pingcap/tidb/issues/38699
 	tk.MustQuery(`show warnings`).Check(testkit.Rows(""+
 		"Warning 1265 Data truncated for column 'a', value is ' 654321'",
@@ -4701,3 +4849,50 @@ partition p1 values less than maxvalue)`)
 		"(PARTITION `p0` VALUES LESS THAN (1998),\n" +
 		" PARTITION `p1` VALUES LESS THAN (MAXVALUE))"))
 }
+
+func TestDetachAndAttachPartition(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, c varchar(10), key idx_c(c)) partition by range (id) " +
+		"(partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))")
+	tk.MustExec("insert into t values (1, 'a'), (2, NULL), (11, 'b'), (12, 'c'), (21, 'd')")
+
+	tk.MustExec("alter table t detach partition p1 into table t_p1")
+	tk.MustQuery("select * from t_p1 order by id").Check(testkit.Rows("11 b", "12 c"))
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 a", "2 <nil>", "21 d"))
+	tk.MustQuery("select partition_name from information_schema.partitions where table_name = 't' order by partition_ordinal_position").
+		Check(testkit.Rows("p0", "p2"))
+	tk.MustQuery("show create table t_p1").Check(testkit.Rows("t_p1 CREATE TABLE `t_p1` (\n" +
+		"  `id` int(11) NOT NULL,\n" +
+		"  `c` varchar(10) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  KEY `idx_c` (`c`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustGetErrCode("alter table t detach partition p1 into table t_p1_2", errno.ErrUnknownPartition)
+	tk.MustGetErrCode("alter table t detach partition p0 into table t_p1", errno.ErrTableExists)
+	tk.MustQuery("select count(*) from t partition(p0)").Check(testkit.Rows("2"))
+
+	// The rows out of the boundaries of the partition are rejected by the validation.
+	tk.MustGetErrCode("alter table t attach partition p3 values less than (40) from table t_p1", errno.ErrRowDoesNotMatchPartition)
+	tk.MustQuery("select partition_name from information_schema.partitions where table_name = 't' order by partition_ordinal_position").
+		Check(testkit.Rows("p0", "p2"))
+	tk.MustExec("create table t_p3 like t_p1")
+	tk.MustExec("insert into t_p3 values (31, 'e'), (32, 'f')")
+	tk.MustExec("alter table t attach partition p3 values less than (40) from table t_p3")
+	tk.MustQuery("select * from t partition(p3) order by id").Check(testkit.Rows("31 e", "32 f"))
+	tk.MustGetErrCode("select * from t_p3", errno.ErrNoSuchTable)
+	tk.MustExec("admin check table t")
+
+	tk.MustExec("create table t_p4 like t_p1")
+	tk.MustExec("insert into t_p4 values (45, 'g')")
+	tk.MustExec("alter table t attach partition p4 values less than (50) from table t_p4 without validation")
+	tk.MustQuery("select partition_name from information_schema.partitions where table_name = 't' order by partition_ordinal_position").
+		Check(testkit.Rows("p0", "p2", "p3", "p4"))
+
+	tk.MustExec("create table t_hash (id int) partition by hash (id) partitions 4")
+	tk.MustGetErrCode("alter table t_hash detach partition p0 into table t_hash_p0", errno.ErrUnsupportedDDLOperation)
+	tk.MustExec("create table t_normal (id int)")
+	tk.MustGetErrCode("alter table t_normal detach partition p0 into table t_normal_p0", errno.ErrPartitionMgmtOnNonpartitioned)
+	tk.MustGetErrCode("alter table t detach partition p0 into table t_p0, add column d int", errno.ErrUnsupportedDDLOperation)
+}
@@ -293,3 +293,58 @@ func TestRenameMultiTables(t *testing.T) {
 	tk.MustExec("drop database test1")
 	tk.MustExec("drop database test")
 }
+
+func TestRenameMultiTablesAcrossSchemas(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create database db1")
+	tk.MustExec("create database db2")
+	tk.MustExec("create database db3")
+	tk.MustExec("create placement policy p1 followers=1")
+	tk.MustExec("create table db1.t1 (a int) placement policy p1")
+	tk.MustExec("create table db2.t2 (a int)")
+	tk.MustExec("insert into db1.t1 values (1)")
+	tk.MustExec("insert into db2.t2 values (2)")
+	is := dom.InfoSchema()
+	tbl1, err := is.TableByName(model.NewCIStr("db1"), model.NewCIStr("t1"))
+	require.NoError(t, err)
+	tbl2, err := is.TableByName(model.NewCIStr("db2"), model.NewCIStr("t2"))
+	require.NoError(t, err)
+	ver := is.SchemaMetaVersion()
+
+	// Swap the tables across the schemas, db1.t1 is renamed twice.
+	tk.MustExec("rename table db1.t1 to db3.tmp, db2.t2 to db1.t1, db3.tmp to db2.t2")
+	is = dom.InfoSchema()
+	require.Equal(t, ver+1, is.SchemaMetaVersion())
+	newTbl, err := is.TableByName(model.NewCIStr("db2"), model.NewCIStr("t2"))
+	require.NoError(t, err)
+	require.Equal(t, tbl1.Meta().ID, newTbl.Meta().ID)
+	newTbl, err = is.TableByName(model.NewCIStr("db1"), model.NewCIStr("t1"))
+	require.NoError(t, err)
+	require.Equal(t, tbl2.Meta().ID, newTbl.Meta().ID)
+	require.False(t, is.TableExists(model.NewCIStr("db3"), model.NewCIStr("tmp")))
+	tk.MustQuery("select * from db1.t1").Check(testkit.Rows("2"))
+	tk.MustQuery("select * from db2.t2").Check(testkit.Rows("1"))
+	tk.MustQuery("show tables from db3").Check(testkit.Rows())
+
+	// The placement policy follows the renamed table.
+	tk.MustQuery("show create table db2.t2").Check(testkit.Rows("t2 CREATE TABLE `t2` (\n" +
+		"  `a` int(11) DEFAULT NULL\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin /*T![placement] PLACEMENT POLICY=`p1` */"))
+	_, ok := is.PlacementBundleByPhysicalTableID(tbl1.Meta().ID)
+	require.True(t, ok)
+	_, ok = is.PlacementBundleByPhysicalTableID(tbl2.Meta().ID)
+	require.False(t, ok)
+
+	// Move a table through a chain of schemas.
+	tk.MustExec("rename table db2.t2 to db3.t3, db3.t3 to db1.t3")
+	is = dom.InfoSchema()
+	newTbl, err = is.TableByName(model.NewCIStr("db1"), model.NewCIStr("t3"))
+	require.NoError(t, err)
+	require.Equal(t, tbl1.Meta().ID, newTbl.Meta().ID)
+	tk.MustQuery("show tables from db1").Sort().Check(testkit.Rows("t1", "t3"))
+	tk.MustQuery("show tables from db2").Check(testkit.Rows())
+	tk.MustQuery("show tables from db3").Check(testkit.Rows())
+	tk.MustExec("insert into db1.t3 values (3)")
+	tk.MustQuery("select * from db1.t3").Sort().Check(testkit.Rows("1", "3"))
+}
@@ -504,6 +504,38 @@ func TestSelectInViewFromAnotherDB(t *testing.T) {
 	tk.MustExec("select test_db2.v.a from test_db2.v")
 }
 
+func TestRenameDatabase(t *testing.T) {
+	store := testkit.CreateMockStoreWithSchemaLease(t, dbTestLease)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create database rename_db1")
+	tk.MustExec("create database rename_db2")
+	tk.MustExec("use rename_db1")
+	tk.MustExec("create table t(a int primary key auto_increment, b int)")
+	tk.MustExec("insert into t(b) values (1), (2)")
+	tk.MustExec("create view v as select rename_db1.t.b from rename_db1.t")
+	tk.MustExec("create table rename_db2.child(a int, foreign key (a) references rename_db1.t(a))")
+	tk.MustExec("create view rename_db2.v as select b from rename_db1.t")
+
+	tk.MustGetErrCode("rename database rename_db1 to rename_db2", errno.ErrDBCreateExists)
+	tk.MustGetErrCode("rename database rename_db3 to rename_db4", errno.ErrBadDB)
+	tk.MustExec("rename database rename_db1 to rename_db3")
+	tk.MustQuery("select database()").Check(testkit.Rows("rename_db3"))
+	tk.MustGetErrCode("use rename_db1", errno.ErrBadDB)
+
+	// The auto IDs go on, and the references to the old name are rewritten.
+	tk.MustExec("insert into t(b) values (3)")
+	tk.MustQuery("select a, b from rename_db3.t order by a").Check(testkit.Rows("1 1", "2 2", "3 3"))
+	tk.MustQuery("select b from v order by b").Check(testkit.Rows("1", "2", "3"))
+	tk.MustQuery("select b from rename_db2.v order by b").Check(testkit.Rows("1", "2", "3"))
+	tk.MustQuery("show create view rename_db2.v").CheckContain("FROM `rename_db3`.`t`")
+	tk.MustQuery("show create table rename_db2.child").CheckContain("REFERENCES `rename_db3`.`t` (`a`)")
+	tk.MustGetErrCode("insert into rename_db2.child values (4)", errno.ErrNoReferencedRow2)
+	tk.MustExec("insert into rename_db2.child values (3)")
+
+	tk.MustGetErrCode("rename database mysql to mysql2", errno.ErrUnsupportedDDLOperation)
+}
+
 func TestAddConstraintCheck(t *testing.T) {
 	store := testkit.CreateMockStoreWithSchemaLease(t, dbTestLease)
 
@@ -517,6 +549,78 @@ func TestAddConstraintCheck(t *testing.T) {
 	tk.MustQuery("show warnings").Check(testkit.RowsWithSep("|", "Warning|8231|ADD CONSTRAINT CHECK is not supported"))
 }
 
+func TestAddCheckConstraintValidateRows(t *testing.T) {
+	store := testkit.CreateMockStoreWithSchemaLease(t, dbTestLease)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_enable_check_constraint = on")
+	defer tk.MustExec("set global tidb_enable_check_constraint = off")
+	tk.MustExec("create table t (pk int primary key, a int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, null)")
+
+	// The existing rows violate the constraint, the job is rolled back.
+	tk.MustGetErrCode("alter table t add constraint c1 check (a > 1)", errno.ErrCheckConstraintViolated)
+	tk.MustExec("insert into t values (5, 0)")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `pk` int(11) NOT NULL,\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`pk`) /*T![clustered_index] CLUSTERED */\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+
+	// The not enforced constraint isn't validated.
+	tk.MustExec("alter table t add constraint c2 check (a > 1) not enforced")
+	tk.MustExec("insert into t values (6, 0)")
+	tk.MustExec("alter table t drop check c2")
+	tk.MustGetErrCode("alter table t drop check c2", errno.ErrConstraintNotFound)
+
+	tk.MustExec("delete from t where a <= 1")
+	tk.MustExec("alter table t add constraint c3 check (a > 1)")
+	tk.MustGetErrCode("alter table t add constraint c3 check (a > 2)", errno.ErrCheckConstraintDupName)
+	tk.MustGetErrCode("insert into t values (7, 1)", errno.ErrCheckConstraintViolated)
+	tk.MustGetErrCode("update t set a = 0 where pk = 2", errno.ErrCheckConstraintViolated)
+	tk.MustExec("insert into t values (7, null)")
+	tk.MustGetErrCode("alter table t drop column a", errno.ErrDependentByCheckConstraint)
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `pk` int(11) NOT NULL,\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`pk`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  CONSTRAINT `c3` CHECK ((`a` > 1))\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+}
+
+func TestAddJSONSchemaCheckConstraint(t *testing.T) {
+	store := testkit.CreateMockStoreWithSchemaLease(t, dbTestLease)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_enable_check_constraint = on")
+	defer tk.MustExec("set global tidb_enable_check_constraint = off")
+	tk.MustExec("create table t (pk int primary key, doc json)")
+	tk.MustExec(`insert into t values (1, '{"latitude": 10}'), (2, '{"latitude": 100}'), (3, null)`)
+	tk.MustQuery(`select pk, json_schema_valid('{"properties": {"latitude": {"maximum": 90}}}', doc) from t order by pk`).
+		Check(testkit.Rows("1 1", "2 0", "3 <nil>"))
+
+	// The invalid schema is rejected when the constraint is added.
+	tk.MustGetErrCode(`alter table t add constraint c1 check (json_schema_valid('{"type": "int"}', doc))`, errno.ErrInvalidJSONData)
+
+	// The violation tells which row and which part of the document fails the schema.
+	schema := `{"type": "object", "properties": {"latitude": {"type": "number", "minimum": -90, "maximum": 90}}, "required": ["latitude"]}`
+	err := tk.ExecToErr(fmt.Sprintf("alter table t add constraint c1 check (json_schema_valid('%s', doc))", schema))
+	require.ErrorContains(t, err, "Check constraint 'c1' is violated by the row with handle 2: "+
+		"The JSON document location '#/latitude' failed requirement 'maximum' at JSON Schema location '#/properties/latitude'.")
+
+	tk.MustExec("delete from t where pk = 2")
+	tk.MustExec(fmt.Sprintf("alter table t add constraint c1 check (json_schema_valid('%s', doc))", schema))
+	tk.MustExec(`insert into t values (4, '{"latitude": -90}')`)
+	tk.MustGetErrCode(`insert into t values (5, '{"longitude": 10}')`, errno.ErrCheckConstraintViolated)
+	warnings := tk.Session().GetSessionVars().StmtCtx.GetWarnings()
+	require.Len(t, warnings, 1)
+	require.ErrorContains(t, warnings[0].Err, "The JSON document location '#' failed requirement 'required' at JSON Schema location '#'.")
+	tk.MustGetErrCode(`update t set doc = '{"latitude": "north"}' where pk = 1`, errno.ErrCheckConstraintViolated)
+	tk.MustQuery("select pk from t order by pk").Check(testkit.Rows("1", "3", "4"))
+}
+
 func TestCreateTableIgnoreCheckConstraint(t *testing.T) {
 	store := testkit.CreateMockStore(t, mockstore.WithDDLChecker())
 
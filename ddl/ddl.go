@@ -169,6 +169,7 @@ type DDL interface {
 	CreateSchema(ctx sessionctx.Context, stmt *ast.CreateDatabaseStmt) error
 	AlterSchema(sctx sessionctx.Context, stmt *ast.AlterDatabaseStmt) error
 	DropSchema(ctx sessionctx.Context, stmt *ast.DropDatabaseStmt) error
+	RenameSchema(ctx sessionctx.Context, stmt *ast.RenameDatabaseStmt) error
 	CreateTable(ctx sessionctx.Context, stmt *ast.CreateTableStmt) error
 	CreateView(ctx sessionctx.Context, stmt *ast.CreateViewStmt) error
 	DropTable(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error)
@@ -195,6 +196,8 @@ type DDL interface {
 	AlterResourceGroup(ctx sessionctx.Context, stmt *ast.AlterResourceGroupStmt) error
 	DropResourceGroup(ctx sessionctx.Context, stmt *ast.DropResourceGroupStmt) error
 	FlashbackCluster(ctx sessionctx.Context, flashbackTS uint64) error
+	VerifyIndex(ctx sessionctx.Context, tableIdent ast.Ident, indexName model.CIStr, rate string, repair bool) (*model.IndexVerifyInfo, error)
+	UndropIndex(ctx sessionctx.Context, stmt *ast.UndropIndexStmt) error
 
 	// CreateSchemaWithInfo creates a database (schema) given its database info.
 	//
@@ -335,6 +338,8 @@ type ddlCtx struct {
 	statsHandle  *handle.Handle
 	tableLockCkr util.DeadTableLockChecker
 	etcdCli      *clientv3.Client
+	// sysProcTracker tracks the sessions of the backfill workers, so they're shown in the processlist and can be killed.
+	sysProcTracker sessionctx.SysProcTracker
 	// backfillJobCh gets notification if any backfill jobs coming.
 	backfillJobCh chan struct{}
 
@@ -345,8 +350,6 @@ type ddlCtx struct {
 		sync.RWMutex
 		ids map[int64]struct{}
 	}
-	// It holds the running DDL jobs ID.
-	runningJobIDs []string
 	// reorgCtx is used for reorganization.
 	reorgCtx reorgContexts
 	// backfillCtx is used for backfill workers.
@@ -437,7 +440,7 @@ func (dc *ddlCtx) setDDLLabelForTopSQL(jobID int64, jobQuery string) {
 	ctx.setDDLLabelForTopSQL(jobQuery)
 }
 
-func (dc *ddlCtx) setDDLSourceForDiagnosis(jobID int64, jobType model.ActionType) {
+func (dc *ddlCtx) setDDLSourceForDiagnosis(jobID int64, jobType model.ActionType, jobLabels string) {
 	dc.jobCtx.Lock()
 	defer dc.jobCtx.Unlock()
 	ctx, exists := dc.jobCtx.jobCtxMap[jobID]
@@ -445,7 +448,7 @@ func (dc *ddlCtx) setDDLSourceForDiagnosis(jobID int64, jobType model.ActionType
 		ctx = NewJobContext()
 		dc.jobCtx.jobCtxMap[jobID] = ctx
 	}
-	ctx.setDDLLabelForDiagnosis(jobType)
+	ctx.setDDLLabelForDiagnosis(jobType, jobLabels)
 }
 
 func (dc *ddlCtx) getResourceGroupTaggerForTopSQL(jobID int64) tikvrpc.ResourceGroupTagger {
@@ -490,14 +493,14 @@ func (dc *ddlCtx) backfillCtxJobIDs() []int64 {
 	return runningJobIDs
 }
 
-func (dc *ddlCtx) setBackfillCtxJobContext(jobID int64, jobQuery string, jobType model.ActionType) (*JobContext, bool) {
+func (dc *ddlCtx) setBackfillCtxJobContext(jobID int64, jobQuery string, jobType model.ActionType, jobLabels string) (*JobContext, bool) {
 	dc.backfillCtx.Lock()
 	defer dc.backfillCtx.Unlock()
 
 	jobCtx, existent := dc.backfillCtx.jobCtxMap[jobID]
 	if !existent {
 		dc.setDDLLabelForTopSQL(jobID, jobQuery)
-		dc.setDDLSourceForDiagnosis(jobID, jobType)
+		dc.setDDLSourceForDiagnosis(jobID, jobType, jobLabels)
 		jobCtx = dc.jobContext(jobID)
 		dc.backfillCtx.jobCtxMap[jobID] = jobCtx
 	}
@@ -664,9 +667,9 @@ func newDDL(ctx context.Context, options ...Option) *ddl {
 		infoCache:                  opt.InfoCache,
 		tableLockCkr:               deadLockCkr,
 		etcdCli:                    opt.EtcdCli,
+		sysProcTracker:             opt.SysProcTracker,
 		schemaVersionManager:       newSchemaVersionManager(),
 		waitSchemaSyncedController: newWaitSchemaSyncedController(),
-		runningJobIDs:              make([]string, 0, jobRecordCapacity),
 	}
 	ddlCtx.reorgCtx.reorgCtxMap = make(map[int64]*reorgCtx)
 	ddlCtx.jobCtx.jobCtxMap = make(map[int64]*JobContext)
@@ -780,6 +783,8 @@ func (d *ddl) Start(ctxPool *pools.ResourcePool) error {
 		d.wg.Add(1)
 		go d.startCleanDeadTableLock()
 	}
+	d.wg.Run(d.startCleanDDLEventsLoop)
+	d.wg.Run(d.startPurgeDeferredDroppedIndexLoop)
 
 	// If tidb_enable_ddl is true, we need campaign owner and do DDL jobs. Besides, we also can do backfill jobs.
 	// Otherwise, we needn't do that.
@@ -977,7 +982,8 @@ func getIntervalFromPolicy(policy []time.Duration, i int) (time.Duration, bool)
 func getJobCheckInterval(job *model.Job, i int) (time.Duration, bool) {
 	switch job.Type {
 	case model.ActionAddIndex, model.ActionAddPrimaryKey, model.ActionModifyColumn,
-		model.ActionReorganizePartition:
+		model.ActionReorganizePartition, model.ActionCreateTableSelect, model.ActionAlterTablePartitioning,
+		model.ActionVerifyIndex, model.ActionAddCheckConstraint:
 		return getIntervalFromPolicy(slowDDLIntervalPolicy, i)
 	case model.ActionCreateTable, model.ActionCreateSchema:
 		return getIntervalFromPolicy(fastDDLIntervalPolicy, i)
@@ -1036,6 +1042,8 @@ func (d *ddl) DoDDLJob(ctx sessionctx.Context, job *model.Job) error {
 		// Instead, we merge all the jobs into one pending job.
 		return appendToSubJobs(mci, job)
 	}
+	job.MaintenanceWindow = ctx.GetSessionVars().StmtCtx.MaintenanceWindow
+	job.Labels = ctx.GetSessionVars().DDLJobLabels
 	// Get a global job ID and put the DDL job in the queue.
 	setDDLJobQuery(ctx, job)
 	task := &limitJobTask{job, make(chan error)}
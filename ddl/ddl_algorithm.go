@@ -75,8 +75,22 @@ func ResolveAlterAlgorithm(alterSpec *ast.AlterTableSpec, specify ast.AlgorithmT
 	switch alterSpec.Tp {
 	// For now, TiDB only support inplace algorithm and instant algorithm.
 	case ast.AlterTableAddConstraint:
+		// The index can be added instantly if the table is empty, which is checked when the index is created.
+		if specify == ast.AlgorithmTypeInstant && alterSpec.Constraint != nil && supportInstantAddIndex(alterSpec.Constraint.Tp) {
+			return ast.AlgorithmTypeInstant, nil
+		}
 		return getProperAlgorithm(specify, inplaceAlgorithm)
 	default:
 		return getProperAlgorithm(specify, instantAlgorithm)
 	}
 }
+
+// supportInstantAddIndex returns whether the index of the constraint can be added by ALGORITHM=INSTANT.
+func supportInstantAddIndex(tp ast.ConstraintType) bool {
+	switch tp {
+	case ast.ConstraintKey, ast.ConstraintIndex, ast.ConstraintUniq, ast.ConstraintUniqIndex, ast.ConstraintUniqKey:
+		return true
+	default:
+		return false
+	}
+}
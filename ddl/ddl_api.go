@@ -70,6 +70,7 @@ import (
 	"github.com/pingcap/tidb/util/stringutil"
 	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -248,6 +249,78 @@ func (d *ddl) ModifySchemaCharsetAndCollate(ctx sessionctx.Context, stmt *ast.Al
 	return errors.Trace(err)
 }
 
+// ModifySchemaTablesCharsetAndCollate converts the tables of the schema to the charset and collation one by one, like
+// `ALTER TABLE ... CONVERT TO CHARACTER SET`. Each table is converted by its own DDL job, so the progress can be seen by
+// `ADMIN SHOW DDL JOBS`, and the summary of the tables is reported as the message of the statement.
+// If stopOnError is true, the remaining tables are not converted once a table fails, and the error is returned.
+func (d *ddl) ModifySchemaTablesCharsetAndCollate(sctx sessionctx.Context, stmt *ast.AlterDatabaseStmt, toCharset, toCollate string, stopOnError bool) (err error) {
+	if toCollate == "" {
+		if toCollate, err = charset.GetDefaultCollation(toCharset); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	dbName := stmt.Name
+	is := d.GetInfoSchemaWithInterceptor(sctx)
+	dbInfo, ok := is.SchemaByName(dbName)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(dbName.O)
+	}
+	tbls := make([]*model.TableInfo, len(dbInfo.Tables))
+	copy(tbls, dbInfo.Tables)
+	slices.SortFunc(tbls, func(i, j *model.TableInfo) bool {
+		return i.Name.L < j.Name.L
+	})
+
+	total := len(tbls)
+	succ := 0
+	skip := 0
+	fail := 0
+	failTables := make([]string, 0)
+	var firstErr error
+	logutil.BgLogger().Info("[ddl] start converting charset of schema tables", zap.Int("total", total), zap.Int64("schemaID", dbInfo.ID),
+		zap.String("charset", toCharset), zap.String("collate", toCollate), zap.Bool("stopOnError", stopOnError))
+	for _, tbl := range tbls {
+		if done, killed := isSessionDone(sctx); done {
+			logutil.BgLogger().Info("[ddl] abort converting charset of schema tables", zap.Int64("schemaID", dbInfo.ID), zap.Uint32("isKilled", killed))
+			break
+		}
+		if tbl.IsView() || tbl.IsSequence() || tbl.TempTableType != model.TempTableNone {
+			skip++
+			continue
+		}
+		if doNothing, err := checkAlterTableCharset(tbl, dbInfo, toCharset, toCollate, true); err == nil && doNothing {
+			skip++
+			continue
+		}
+		err := d.AlterTableCharsetAndCollate(sctx, ast.Ident{Schema: dbInfo.Name, Name: tbl.Name}, toCharset, toCollate, true)
+		if err != nil {
+			fail++
+			failTables = append(failTables, tbl.Name.O)
+			logutil.BgLogger().Warn("[ddl] convert charset of schema table failed", zap.Int64("tableID", tbl.ID), zap.Int64("schemaID", dbInfo.ID),
+				zap.String("tableName", tbl.Name.O), zap.String("schemaName", dbInfo.Name.O), zap.Error(err))
+			if firstErr == nil {
+				firstErr = err
+			}
+			if stopOnError {
+				break
+			}
+			continue
+		}
+		succ++
+	}
+	msg := fmt.Sprintf("In total %v tables: %v succeed, %v failed, %v skipped, %v not processed", total, succ, fail, skip, total-succ-fail-skip)
+	if fail > 0 {
+		msg += fmt.Sprintf(" (failed tables: %v)", strings.Join(failTables, ", "))
+	}
+	logutil.BgLogger().Info("[ddl] finish converting charset of schema tables", zap.Int64("schemaID", dbInfo.ID), zap.String("summary", msg))
+	if stopOnError && firstErr != nil {
+		sctx.GetSessionVars().StmtCtx.AppendWarning(errors.New(msg))
+		return errors.Trace(firstErr)
+	}
+	sctx.GetSessionVars().StmtCtx.SetMessage(msg)
+	return nil
+}
+
 func (d *ddl) ModifySchemaDefaultPlacement(ctx sessionctx.Context, stmt *ast.AlterDatabaseStmt, placementPolicyRef *model.PolicyRefInfo) (err error) {
 	dbName := stmt.Name
 	is := d.GetInfoSchemaWithInterceptor(ctx)
@@ -575,6 +648,12 @@ func (d *ddl) AlterSchema(sctx sessionctx.Context, stmt *ast.AlterDatabaseStmt)
 		if err = d.ModifySchemaCharsetAndCollate(sctx, stmt, toCharset, toCollate); err != nil {
 			return err
 		}
+		if cascade := sctx.GetSessionVars().AlterDatabaseCharsetCascade; cascade != "" && cascade != variable.Off {
+			err = d.ModifySchemaTablesCharsetAndCollate(sctx, stmt, toCharset, toCollate, cascade == variable.CharsetCascadeStopOnError)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	if isAlterPlacement {
 		if err = d.ModifySchemaDefaultPlacement(sctx, stmt, placementPolicyRef); err != nil {
@@ -638,6 +717,40 @@ func (d *ddl) DropSchema(ctx sessionctx.Context, stmt *ast.DropDatabaseStmt) (er
 	return nil
 }
 
+func (d *ddl) RenameSchema(ctx sessionctx.Context, stmt *ast.RenameDatabaseStmt) error {
+	is := d.GetInfoSchemaWithInterceptor(ctx)
+	old, ok := is.SchemaByName(stmt.OldName)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(stmt.OldName)
+	}
+	if util.IsMemOrSysDB(old.Name.L) || util.IsMemOrSysDB(stmt.NewName.L) {
+		return errors.Trace(dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("RENAME DATABASE of the system databases"))
+	}
+	if err := checkTooLongSchema(stmt.NewName); err != nil {
+		return errors.Trace(err)
+	}
+	if old.Name.L != stmt.NewName.L && is.SchemaExists(stmt.NewName) {
+		return infoschema.ErrDatabaseExists.GenWithStackByArgs(stmt.NewName)
+	}
+	for _, tb := range is.SchemaTables(old.Name) {
+		if tb.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
+			return errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Rename Database"))
+		}
+	}
+
+	// SchemaName is the new name, the label rules of the tables are moved to it.
+	job := &model.Job{
+		SchemaID:   old.ID,
+		SchemaName: stmt.NewName.L,
+		Type:       model.ActionRenameSchema,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{stmt.NewName},
+	}
+	err := d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
 func (d *ddl) RecoverSchema(ctx sessionctx.Context, recoverSchemaInfo *RecoverSchemaInfo) error {
 	recoverSchemaInfo.State = model.StateNone
 	job := &model.Job{
@@ -1159,6 +1272,8 @@ func columnDefToCol(ctx sessionctx.Context, offset int, colDef *ast.ColumnDef, o
 				ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt.GenWithStackByArgs())
 			case ast.ColumnOptionCheck:
 				ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedConstraintCheck.GenWithStackByArgs("CONSTRAINT CHECK"))
+			case ast.ColumnOptionCompression:
+				setColumnCompression(col, v.StrValue)
 			}
 		}
 	}
@@ -1918,10 +2033,6 @@ func BuildTableInfo(
 			}
 		}
 
-		if constr.Tp == ast.ConstraintFulltext {
-			ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt.GenWithStackByArgs())
-			continue
-		}
 		if constr.Tp == ast.ConstraintCheck {
 			ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedConstraintCheck.GenWithStackByArgs("CONSTRAINT CHECK"))
 			continue
@@ -1942,6 +2053,19 @@ func BuildTableInfo(
 			unique = true
 		}
 
+		indexOption := constr.Option
+		if constr.Tp == ast.ConstraintFulltext {
+			if indexOption, err = fulltextIndexOption(indexOption); err != nil {
+				return nil, err
+			}
+		}
+		if isColumnarIndexOption(indexOption) {
+			// The new table has no TiFlash replicas to maintain the columnar index.
+			if err = checkColumnarIndexReplica(tbInfo); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+
 		// build index info.
 		idxInfo, err := BuildIndexInfo(
 			ctx,
@@ -1951,7 +2075,7 @@ func BuildTableInfo(
 			unique,
 			false,
 			constr.Keys,
-			constr.Option,
+			indexOption,
 			model.StatePublic,
 		)
 		if err != nil {
@@ -2120,7 +2244,7 @@ func checkTableInfoValidWithStmt(ctx sessionctx.Context, tbInfo *model.TableInfo
 			if err := checkPartitionFuncType(ctx, s.Partition.Expr, tbInfo); err != nil {
 				return errors.Trace(err)
 			}
-			if err := checkPartitioningKeysConstraints(ctx, s, tbInfo); err != nil {
+			if err := checkPartitioningKeysConstraints(ctx, s.Partition, tbInfo); err != nil {
 				return errors.Trace(err)
 			}
 		}
@@ -2388,6 +2512,9 @@ func (d *ddl) CreateTable(ctx sessionctx.Context, s *ast.CreateTableStmt) (err e
 		onExist = OnExistIgnore
 	}
 
+	if s.Select != nil {
+		return d.createTableSelect(ctx, schema.Name, tbInfo, s, onExist)
+	}
 	return d.CreateTableWithInfo(ctx, schema.Name, tbInfo, onExist)
 }
 
@@ -3315,6 +3442,7 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 	if err != nil {
 		return errors.Trace(err)
 	}
+	validSpecs, lossyConfirmed := resolveLossyModifyConfirmation(validSpecs)
 
 	is := d.infoCache.GetLatest()
 	tb, err := is.TableByName(ident.Schema, ident.Name)
@@ -3356,6 +3484,10 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			err = d.CoalescePartitions(sctx, ident, spec)
 		case ast.AlterTableReorganizePartition:
 			err = d.ReorganizePartitions(sctx, ident, spec)
+		case ast.AlterTableSplitPartition:
+			err = d.SplitPartition(sctx, ident, spec)
+		case ast.AlterTableMergePartitions:
+			err = d.MergePartitions(sctx, ident, spec)
 		case ast.AlterTableReorganizeFirstPartition:
 			err = dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("MERGE FIRST PARTITION")
 		case ast.AlterTableReorganizeLastPartition:
@@ -3373,9 +3505,9 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 		case ast.AlterTableDropColumn:
 			err = d.DropColumn(sctx, ident, spec)
 		case ast.AlterTableDropIndex:
-			err = d.dropIndex(sctx, ident, model.NewCIStr(spec.Name), spec.IfExists)
+			err = d.dropIndex(sctx, ident, model.NewCIStr(spec.Name), spec.IfExists, spec.Deferred)
 		case ast.AlterTableDropPrimaryKey:
-			err = d.dropIndex(sctx, ident, model.NewCIStr(mysql.PrimaryKeyName), spec.IfExists)
+			err = d.dropIndex(sctx, ident, model.NewCIStr(mysql.PrimaryKeyName), spec.IfExists, false)
 		case ast.AlterTableRenameIndex:
 			err = d.RenameIndex(sctx, ident, spec)
 		case ast.AlterTableDropPartition, ast.AlterTableDropFirstPartition:
@@ -3402,15 +3534,19 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			}
 		case ast.AlterTableExchangePartition:
 			err = d.ExchangeTablePartition(sctx, ident, spec)
+		case ast.AlterTableDetachPartition:
+			err = d.DetachTablePartition(sctx, ident, spec)
+		case ast.AlterTableAttachPartition:
+			err = d.AttachTablePartition(sctx, ident, spec)
 		case ast.AlterTableAddConstraint:
 			constr := spec.Constraint
 			switch spec.Constraint.Tp {
 			case ast.ConstraintKey, ast.ConstraintIndex:
 				err = d.createIndex(sctx, ident, ast.IndexKeyTypeNone, model.NewCIStr(constr.Name),
-					spec.Constraint.Keys, constr.Option, constr.IfNotExists)
+					spec.Constraint.Keys, constr.Option, constr.IfNotExists, spec.Algorithm)
 			case ast.ConstraintUniq, ast.ConstraintUniqIndex, ast.ConstraintUniqKey:
 				err = d.createIndex(sctx, ident, ast.IndexKeyTypeUnique, model.NewCIStr(constr.Name),
-					spec.Constraint.Keys, constr.Option, false) // IfNotExists should be not applied
+					spec.Constraint.Keys, constr.Option, false, spec.Algorithm) // IfNotExists should be not applied
 			case ast.ConstraintForeignKey:
 				// NOTE: we do not handle `symbol` and `index_name` well in the parser and we do not check ForeignKey already exists,
 				// so we just also ignore the `if not exists` check.
@@ -3418,9 +3554,14 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			case ast.ConstraintPrimaryKey:
 				err = d.CreatePrimaryKey(sctx, ident, model.NewCIStr(constr.Name), spec.Constraint.Keys, constr.Option)
 			case ast.ConstraintFulltext:
-				sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt)
+				err = d.createIndex(sctx, ident, ast.IndexKeyTypeFullText, model.NewCIStr(constr.Name),
+					spec.Constraint.Keys, constr.Option, constr.IfNotExists, spec.Algorithm)
 			case ast.ConstraintCheck:
-				sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedConstraintCheck.GenWithStackByArgs("ADD CONSTRAINT CHECK"))
+				if !variable.EnableCheckConstraint.Load() {
+					sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedConstraintCheck.GenWithStackByArgs("ADD CONSTRAINT CHECK"))
+					break
+				}
+				err = d.CreateCheckConstraint(sctx, ident, constr)
 			default:
 				// Nothing to do now.
 			}
@@ -3428,9 +3569,9 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			// NOTE: we do not check `if not exists` and `if exists` for ForeignKey now.
 			err = d.DropForeignKey(sctx, ident, model.NewCIStr(spec.Name))
 		case ast.AlterTableModifyColumn:
-			err = d.ModifyColumn(ctx, sctx, ident, spec)
+			err = d.ModifyColumn(ctx, sctx, ident, spec, lossyConfirmed)
 		case ast.AlterTableChangeColumn:
-			err = d.ChangeColumn(ctx, sctx, ident, spec)
+			err = d.ChangeColumn(ctx, sctx, ident, spec, lossyConfirmed)
 		case ast.AlterTableRenameColumn:
 			err = d.RenameColumn(sctx, ident, spec)
 		case ast.AlterTableAlterColumn:
@@ -3440,8 +3581,7 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			isAlterTable := true
 			err = d.renameTable(sctx, ident, newIdent, isAlterTable)
 		case ast.AlterTablePartition:
-			// Prevent silent succeed if user executes ALTER TABLE x PARTITION BY ...
-			err = errors.New("alter table partition is unsupported")
+			err = d.AlterTablePartitioning(sctx, ident, spec)
 		case ast.AlterTableOption:
 			var placementPolicyRef *model.PolicyRefInfo
 			for i, opt := range spec.Options {
@@ -3519,7 +3659,11 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 		case ast.AlterTableAlterCheck:
 			sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedConstraintCheck.GenWithStackByArgs("ALTER CHECK"))
 		case ast.AlterTableDropCheck:
-			sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedConstraintCheck.GenWithStackByArgs("DROP CHECK"))
+			if !variable.EnableCheckConstraint.Load() {
+				sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedConstraintCheck.GenWithStackByArgs("DROP CHECK"))
+				break
+			}
+			err = d.DropCheckConstraint(sctx, ident, model.NewCIStr(spec.Constraint.Name))
 		case ast.AlterTableWithValidation:
 			sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrUnsupportedAlterTableWithValidation)
 		case ast.AlterTableWithoutValidation:
@@ -3737,8 +3881,8 @@ func CreateNewColumn(ctx sessionctx.Context, ti ast.Ident, schema *model.DBInfo,
 				return nil, errors.Trace(err)
 			}
 
-			if option.Stored {
-				return nil, dbterror.ErrUnsupportedOnGeneratedColumn.GenWithStackByArgs("Adding generated stored column through ALTER TABLE")
+			if option.Stored && ctx.GetSessionVars().StmtCtx.MultiSchemaInfo != nil {
+				return nil, dbterror.ErrUnsupportedOnGeneratedColumn.GenWithStackByArgs("Adding generated stored column in multi-schema change")
 			}
 
 			_, dependColNames, err := findDependedColumnNames(schema.Name, t.Meta().Name, specNewColumn)
@@ -3804,7 +3948,15 @@ func CreateNewColumn(ctx sessionctx.Context, ti ast.Ident, schema *model.DBInfo,
 		return nil, errors.Trace(err)
 	}
 
-	originDefVal, err := generateOriginDefaultValue(col.ToInfo(), ctx)
+	var originDefVal interface{}
+	colInfo := col.ToInfo()
+	if colInfo.DefaultIsExpr {
+		// The default expression is evaluated once here, so all the existing rows read the same value
+		// and the column is added without backfilling them.
+		originDefVal, err = generateOriginDefaultExprValue(ctx, colInfo)
+	} else {
+		originDefVal, err = generateOriginDefaultValue(colInfo, ctx)
+	}
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -3845,6 +3997,19 @@ func (d *ddl) AddColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTab
 		BinlogInfo: &model.HistoryInfo{},
 		Args:       []interface{}{col, spec.Position, 0, spec.IfNotExists},
 	}
+	if isStoredGeneratedColumn(col.ColumnInfo) {
+		// The existing rows are filled with the generated values in the reorganization.
+		tzName, tzOffset := ddlutil.GetTimeZone(ctx)
+		job.ReorgMeta = &model.DDLReorgMeta{
+			SQLMode:       ctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+			ReorgTp:       model.ReorgTypeTxn,
+		}
+		job.CtxVars = []interface{}{true}
+		job.Priority = ctx.GetSessionVars().DDLReorgPriority
+	}
 
 	err = d.DoDDLJob(ctx, job)
 	err = d.callHookOnChanged(job, err)
@@ -4052,6 +4217,77 @@ func (d *ddl) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident, spec
 	return errors.Trace(err)
 }
 
+// AlterTablePartitioning partitions a non-partitioned table by ALTER TABLE ... PARTITION BY. The table is
+// handled as a table with a single partition holding all the rows, which is reorganized into the new partitions.
+func (d *ddl) AlterTablePartitioning(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(infoschema.ErrTableNotExists.FastGenByArgs(ident.Schema, ident.Name))
+	}
+
+	meta := t.Meta()
+	if meta.GetPartitionInfo() != nil {
+		// Prevent silent succeed if user executes ALTER TABLE x PARTITION BY ...
+		return errors.New("alter table partition is unsupported")
+	}
+	switch spec.Partition.Tp {
+	case model.PartitionTypeRange, model.PartitionTypeList:
+	default:
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(fmt.Sprintf("ALTER TABLE ... PARTITION BY %s", spec.Partition.Tp))
+	}
+	newMeta := meta.Clone()
+	if err = buildTablePartitionInfo(ctx, spec.Partition, newMeta); err != nil {
+		return errors.Trace(err)
+	}
+	partInfo := newMeta.GetPartitionInfo()
+	if partInfo == nil {
+		// The partitioning is disabled, the warning is appended by buildTablePartitionInfo.
+		return nil
+	}
+	if err = checkPartitionDefinitionConstraints(ctx, newMeta); err != nil {
+		return errors.Trace(err)
+	}
+	if err = checkPartitionFuncType(ctx, spec.Partition.Expr, newMeta); err != nil {
+		return errors.Trace(err)
+	}
+	if err = checkPartitioningKeysConstraints(ctx, spec.Partition, newMeta); err != nil {
+		return errors.Trace(err)
+	}
+	for _, idx := range newMeta.Indices {
+		if idx.Global {
+			return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("ALTER TABLE ... PARTITION BY with global index")
+		}
+	}
+	if err = d.assignPartitionIDs(partInfo.Definitions); err != nil {
+		return errors.Trace(err)
+	}
+	if err = handlePartitionPlacement(ctx, partInfo); err != nil {
+		return errors.Trace(err)
+	}
+
+	tzName, tzOffset := ddlutil.GetTimeZone(ctx)
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    meta.ID,
+		SchemaName: schema.Name.L,
+		TableName:  meta.Name.L,
+		Type:       model.ActionAlterTablePartitioning,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{[]model.CIStr{fullTablePartitionName}, partInfo},
+		ReorgMeta: &model.DDLReorgMeta{
+			SQLMode:       ctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+		},
+	}
+
+	// No preSplitAndScatter here, it will be done by the worker in onReorganizePartition instead.
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
 func checkReorgPartitionDefs(ctx sessionctx.Context, tblInfo *model.TableInfo, partInfo *model.PartitionInfo, firstPartIdx, lastPartIdx int, idMap map[int]struct{}) error {
 	// partInfo contains only the new added partition, we have to combine it with the
 	// old partitions to check all partitions is strictly increasing.
@@ -4106,6 +4342,62 @@ func checkReorgPartitionDefs(ctx sessionctx.Context, tblInfo *model.TableInfo, p
 	return nil
 }
 
+// SplitPartition splits one partition of a RANGE partitioned table into several partitions, it is done by
+// REORGANIZE PARTITION, so the last new partition must end at the same range as the split one.
+func (d *ddl) SplitPartition(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	if _, err := d.getRangePartitionInfo(ctx, ident, "SPLIT PARTITION"); err != nil {
+		return errors.Trace(err)
+	}
+	if len(spec.PartDefinitions) < 2 {
+		return errors.Trace(dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("SPLIT PARTITION into less than 2 partitions"))
+	}
+	return d.ReorganizePartitions(ctx, ident, spec)
+}
+
+// MergePartitions merges adjacent partitions of a RANGE partitioned table into one partition, which ends at
+// the range of the last merged partition. It is done by REORGANIZE PARTITION.
+func (d *ddl) MergePartitions(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	pi, err := d.getRangePartitionInfo(ctx, ident, "MERGE PARTITIONS")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(spec.PartitionNames) < 2 {
+		return errors.Trace(dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("MERGE PARTITIONS of less than 2 partitions"))
+	}
+	_, lastPartIdx, _, err := getReplacedPartitionIDs(spec.PartitionNames, pi)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Build the definition of the merged partition from the LESS THAN of the last merged partition.
+	stmt, _, err := parser.New().ParseSQL(fmt.Sprintf("ALTER TABLE t ADD PARTITION (PARTITION p VALUES LESS THAN (%s))",
+		strings.Join(pi.Definitions[lastPartIdx].LessThan, ",")))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	def := stmt[0].(*ast.AlterTableStmt).Specs[0].PartDefinitions[0]
+	def.Name = model.NewCIStr(spec.Name)
+	reorgSpec := *spec
+	reorgSpec.Tp = ast.AlterTableReorganizePartition
+	reorgSpec.PartDefinitions = []*ast.PartitionDefinition{def}
+	return d.ReorganizePartitions(ctx, ident, &reorgSpec)
+}
+
+// getRangePartitionInfo returns the partition info of the table, which must be RANGE partitioned for op.
+func (d *ddl) getRangePartitionInfo(ctx sessionctx.Context, ident ast.Ident, op string) (*model.PartitionInfo, error) {
+	_, t, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return nil, errors.Trace(infoschema.ErrTableNotExists.FastGenByArgs(ident.Schema, ident.Name))
+	}
+	pi := t.Meta().GetPartitionInfo()
+	if pi == nil {
+		return nil, dbterror.ErrPartitionMgmtOnNonpartitioned
+	}
+	if pi.Type != model.PartitionTypeRange {
+		return nil, dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(op + " of non-RANGE partitioned table")
+	}
+	return pi, nil
+}
+
 // CoalescePartitions coalesce partitions can be used with a table that is partitioned by hash or key to reduce the number of partitions by number.
 func (d *ddl) CoalescePartitions(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
 	is := d.infoCache.GetLatest()
@@ -4505,8 +4797,23 @@ func (d *ddl) DropColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTa
 	if err != nil {
 		return errors.Trace(err)
 	}
+	var dependents []*dependentObject
+	if table.FindCol(t.VisibleCols(), spec.OldColumnName.Name.L) != nil {
+		dependents, err = d.checkColumnDependents(ctx, schema, t.Meta(), spec.OldColumnName.Name, spec.Cascade, false)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
 
-	isDropable, err := checkIsDroppableColumn(ctx, d.infoCache.GetLatest(), schema, t, spec)
+	// Check the column as if the dependents have been dropped, so nothing is dropped if the column can't be dropped.
+	checkedTbl, is := t, d.infoCache.GetLatest()
+	if len(dependents) > 0 {
+		if checkedTbl, err = d.tableWithoutDependents(schema, t, dependents); err != nil {
+			return errors.Trace(err)
+		}
+		is = cascadedInfoSchema{InfoSchema: is, dependents: dependents}
+	}
+	isDropable, err := checkIsDroppableColumn(ctx, is, schema, checkedTbl, spec)
 	if err != nil {
 		return err
 	}
@@ -4518,6 +4825,16 @@ func (d *ddl) DropColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTa
 	if err != nil {
 		return err
 	}
+	if len(dependents) > 0 {
+		if err = d.dropColumnDependents(ctx, t.Meta(), colName, dependents); err != nil {
+			return errors.Trace(err)
+		}
+		// The dependent objects, such as the expression indexes, may change the table.
+		schema, t, err = d.getSchemaAndTableByIdent(ctx, ti)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
 
 	job := &model.Job{
 		SchemaID:    schema.ID,
@@ -4555,6 +4872,12 @@ func checkIsDroppableColumn(ctx sessionctx.Context, is infoschema.InfoSchema, sc
 	if err = checkDropColumnWithPartitionConstraint(t, colName); err != nil {
 		return false, errors.Trace(err)
 	}
+	if err = checkDropColumnWithCheckConstraint(tblInfo, colName); err != nil {
+		return false, errors.Trace(err)
+	}
+	if err = checkColumnWithDeferredDroppedIndex(tblInfo, colName); err != nil {
+		return false, errors.Trace(err)
+	}
 	// Check the column with foreign key.
 	err = checkDropColumnWithForeignKeyConstraint(is, schema.Name.L, tblInfo, colName.L)
 	if err != nil {
@@ -4660,6 +4983,12 @@ func checkModifyTypes(ctx sessionctx.Context, origin *types.FieldType, to *types
 	err = checkModifyCharsetAndCollation(to.GetCharset(), to.GetCollate(), origin.GetCharset(), origin.GetCollate(), needRewriteCollationData)
 
 	if err != nil {
+		// The values of the string column are converted to the new charset in the process of the reorg, and the
+		// indexes on the column are rebuilt with the new collation as well.
+		if (dbterror.ErrUnsupportedModifyCharset.Equal(err) || dbterror.ErrUnsupportedModifyCollation.Equal(err)) &&
+			needConvertCharset(origin, to) {
+			return nil
+		}
 		if to.GetCharset() == charset.CharsetGBK || origin.GetCharset() == charset.CharsetGBK {
 			return errors.Trace(err)
 		}
@@ -4792,6 +5121,8 @@ func processColumnOptions(ctx sessionctx.Context, col *table.Column, options []*
 			return errors.Trace(dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("can't modify with full text"))
 		case ast.ColumnOptionCheck:
 			return errors.Trace(dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("can't modify with check"))
+		case ast.ColumnOptionCompression:
+			setColumnCompression(col, opt.StrValue)
 		// Ignore ColumnOptionAutoRandom. It will be handled later.
 		case ast.ColumnOptionAutoRandom:
 		default:
@@ -4823,11 +5154,41 @@ func processAndCheckDefaultValueAndColumn(ctx sessionctx.Context, col *table.Col
 	if err = checkColumnFieldLength(col); err != nil {
 		return errors.Trace(err)
 	}
+	if err = checkColumnCompression(col); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// columnCompressionZstd is the only supported algorithm to compress the values of the columns.
+const columnCompressionZstd = "zstd"
+
+// setColumnCompression sets the compression of the column, the values of the column are compressed in the rows.
+func setColumnCompression(col *table.Column, compression string) {
+	col.Compression = strings.ToLower(compression)
+	col.AddFlag(mysql.CompressedFlag)
+}
+
+// checkColumnCompression checks the compression of the column. Only the values of the stored BLOB and TEXT columns
+// can be compressed, and zstd is the only supported algorithm.
+func checkColumnCompression(col *table.Column) error {
+	if col.Compression == "" {
+		return nil
+	}
+	if col.Compression != columnCompressionZstd {
+		return dbterror.ErrUnsupportedDDLOperation.GenWithStackByArgs(fmt.Sprintf("compression algorithm '%s'", col.Compression))
+	}
+	if !types.IsTypeBlob(col.GetType()) {
+		return dbterror.ErrUnsupportedDDLOperation.GenWithStackByArgs(fmt.Sprintf("compression of the column '%s' which is not BLOB or TEXT", col.Name.O))
+	}
+	if col.IsGenerated() && !col.GeneratedStored {
+		return dbterror.ErrUnsupportedDDLOperation.GenWithStackByArgs(fmt.Sprintf("compression of the virtual generated column '%s'", col.Name.O))
+	}
 	return nil
 }
 
 func (d *ddl) getModifiableColumnJob(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, originalColName model.CIStr,
-	spec *ast.AlterTableSpec) (*model.Job, error) {
+	spec *ast.AlterTableSpec, lossyConfirmed bool) (*model.Job, error) {
 	is := d.infoCache.GetLatest()
 	schema, ok := is.SchemaByName(ident.Schema)
 	if !ok {
@@ -4838,7 +5199,18 @@ func (d *ddl) getModifiableColumnJob(ctx context.Context, sctx sessionctx.Contex
 		return nil, errors.Trace(infoschema.ErrTableNotExists.GenWithStackByArgs(ident.Schema, ident.Name))
 	}
 
-	return GetModifiableColumnJob(ctx, sctx, is, ident, originalColName, schema, t, spec)
+	job, err := GetModifiableColumnJob(ctx, sctx, is, ident, originalColName, schema, t, spec)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if needChangeColData := job.CtxVars[0].(bool); needChangeColData {
+		oldCol := table.FindCol(t.Cols(), originalColName.L)
+		newCol := job.Args[0].(*model.ColumnInfo)
+		if err = checkLossyModifyColumn(ctx, sctx, ident.Schema, ident.Name, oldCol.ColumnInfo, newCol, lossyConfirmed); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return job, nil
 }
 
 // GetModifiableColumnJob returns a DDL job of model.ActionModifyColumn.
@@ -4859,6 +5231,9 @@ func GetModifiableColumnJob(
 	if col == nil {
 		return nil, infoschema.ErrColumnNotExists.GenWithStackByArgs(originalColName, ident.Name)
 	}
+	if err = checkColumnWithDeferredDroppedIndex(t.Meta(), originalColName); err != nil {
+		return nil, errors.Trace(err)
+	}
 	newColName := specNewColumn.Name.Name
 	if newColName.L == model.ExtraHandleName.L {
 		return nil, dbterror.ErrWrongColumnName.GenWithStackByArgs(newColName.L)
@@ -4869,6 +5244,9 @@ func GetModifiableColumnJob(
 		if c != nil {
 			return nil, infoschema.ErrColumnExists.GenWithStackByArgs(newColName)
 		}
+		if err = checkDropColumnWithCheckConstraint(t.Meta(), originalColName); err != nil {
+			return nil, errors.Trace(err)
+		}
 	}
 
 	// Constraints in the new column means adding new constraints. Errors should thrown,
@@ -4956,7 +5334,7 @@ func GetModifiableColumnJob(
 		if err = isGeneratedRelatedColumn(t.Meta(), newCol.ColumnInfo, col.ColumnInfo); err != nil {
 			return nil, errors.Trace(err)
 		}
-		if t.Meta().Partition != nil {
+		if t.Meta().Partition != nil && !variable.DDLEnableRollingModifyColumn.Load() {
 			return nil, dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("table is partition table")
 		}
 	}
@@ -5100,10 +5478,12 @@ func GetModifiableColumnJob(
 		Type:       model.ActionModifyColumn,
 		BinlogInfo: &model.HistoryInfo{},
 		ReorgMeta: &model.DDLReorgMeta{
-			SQLMode:       sctx.GetSessionVars().SQLMode,
-			Warnings:      make(map[errors.ErrorID]*terror.Error),
-			WarningsCount: make(map[errors.ErrorID]int64),
-			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+			SQLMode:              sctx.GetSessionVars().SQLMode,
+			Warnings:             make(map[errors.ErrorID]*terror.Error),
+			WarningsCount:        make(map[errors.ErrorID]int64),
+			Location:             &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+			CharsetConvertPolicy: sctx.GetSessionVars().DDLCharsetConvertPolicy,
+			PartitionByPartition: needChangeColData && t.Meta().Partition != nil,
 		},
 		CtxVars: []interface{}{needChangeColData},
 		Args:    []interface{}{&newCol.ColumnInfo, originalColName, spec.Position, modifyColumnTp, newAutoRandBits},
@@ -5280,7 +5660,7 @@ func rangeBitsIsChanged(oldBits, newBits uint64) bool {
 // ChangeColumn renames an existing column and modifies the column's definition,
 // currently we only support limited kind of changes
 // that do not need to change or check data on the table.
-func (d *ddl) ChangeColumn(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+func (d *ddl) ChangeColumn(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec, lossyConfirmed bool) error {
 	specNewColumn := spec.NewColumns[0]
 	if len(specNewColumn.Name.Schema.O) != 0 && ident.Schema.L != specNewColumn.Name.Schema.L {
 		return dbterror.ErrWrongDBName.GenWithStackByArgs(specNewColumn.Name.Schema.O)
@@ -5294,8 +5674,19 @@ func (d *ddl) ChangeColumn(ctx context.Context, sctx sessionctx.Context, ident a
 	if len(spec.OldColumnName.Table.O) != 0 && ident.Name.L != spec.OldColumnName.Table.L {
 		return dbterror.ErrWrongTableName.GenWithStackByArgs(spec.OldColumnName.Table.O)
 	}
+	if specNewColumn.Name.Name.L != spec.OldColumnName.Name.L && sctx.GetSessionVars().EnableDDLDependencyCheck {
+		schema, t, err := d.getSchemaAndTableByIdent(sctx, ident)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if table.FindCol(t.Cols(), spec.OldColumnName.Name.L) != nil {
+			if _, err = d.checkColumnDependents(sctx, schema, t.Meta(), spec.OldColumnName.Name, false, false); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
 
-	job, err := d.getModifiableColumnJob(ctx, sctx, ident, spec.OldColumnName.Name, spec)
+	job, err := d.getModifiableColumnJob(ctx, sctx, ident, spec.OldColumnName.Name, spec, lossyConfirmed)
 	if err != nil {
 		if infoschema.ErrColumnNotExists.Equal(err) && spec.IfExists {
 			sctx.GetSessionVars().StmtCtx.AppendNote(infoschema.ErrColumnNotExists.GenWithStackByArgs(spec.OldColumnName.Name, ident.Name))
@@ -5342,17 +5733,15 @@ func (d *ddl) RenameColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.Al
 		return infoschema.ErrColumnExists.GenWithStackByArgs(newColName)
 	}
 
-	// Check generated expression.
+	// Check generated expression. The expression indexes, the check constraints and the views which depend on the
+	// column are rewritten with the column in the DDL job.
 	for _, col := range allCols {
-		if col.GeneratedExpr == nil {
+		if col.GeneratedExpr == nil || col.Hidden {
 			continue
 		}
 		dependedColNames := FindColumnNamesInExpr(col.GeneratedExpr)
 		for _, name := range dependedColNames {
 			if name.Name.L == oldColName.L {
-				if col.Hidden {
-					return dbterror.ErrDependentByFunctionalIndex.GenWithStackByArgs(oldColName.O)
-				}
 				return dbterror.ErrDependentByGeneratedColumn.GenWithStackByArgs(oldColName.O)
 			}
 		}
@@ -5362,6 +5751,10 @@ func (d *ddl) RenameColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.Al
 	if err != nil {
 		return errors.Trace(err)
 	}
+	_, err = d.checkColumnDependents(ctx, schema, tbl.Meta(), oldColName, false, true)
+	if err != nil {
+		return errors.Trace(err)
+	}
 
 	tzName, tzOffset := ddlutil.GetTimeZone(ctx)
 
@@ -5389,7 +5782,7 @@ func (d *ddl) RenameColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.Al
 
 // ModifyColumn does modification on an existing column, currently we only support limited kind of changes
 // that do not need to change or check data on the table.
-func (d *ddl) ModifyColumn(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+func (d *ddl) ModifyColumn(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec, lossyConfirmed bool) error {
 	specNewColumn := spec.NewColumns[0]
 	if len(specNewColumn.Name.Schema.O) != 0 && ident.Schema.L != specNewColumn.Name.Schema.L {
 		return dbterror.ErrWrongDBName.GenWithStackByArgs(specNewColumn.Name.Schema.O)
@@ -5399,7 +5792,7 @@ func (d *ddl) ModifyColumn(ctx context.Context, sctx sessionctx.Context, ident a
 	}
 
 	originalColName := specNewColumn.Name.Name
-	job, err := d.getModifiableColumnJob(ctx, sctx, ident, originalColName, spec)
+	job, err := d.getModifiableColumnJob(ctx, sctx, ident, originalColName, spec, lossyConfirmed)
 	if err != nil {
 		if infoschema.ErrColumnNotExists.Equal(err) && spec.IfExists {
 			sctx.GetSessionVars().StmtCtx.AppendNote(infoschema.ErrColumnNotExists.GenWithStackByArgs(originalColName, ident.Name))
@@ -5614,12 +6007,17 @@ func (d *ddl) AlterTableSetTiFlashReplica(ctx sessionctx.Context, ident ast.Iden
 	if !shouldModifyTiFlashReplica(tbReplicaInfo, replicaInfo) {
 		return nil
 	}
+	if err = checkTiFlashReplicaRemoval(tb.Meta(), replicaInfo); err != nil {
+		return errors.Trace(err)
+	}
 
 	err = checkTiFlashReplicaCount(ctx, replicaInfo.Count)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
+	// The tracked job runs until the replicas are available, so it's run by the reorg workers.
+	trackProgress := replicaInfo.Count > 0 && variable.DDLEnableTiFlashReplicaProgress.Load()
 	job := &model.Job{
 		SchemaID:   schema.ID,
 		TableID:    tb.Meta().ID,
@@ -5627,7 +6025,11 @@ func (d *ddl) AlterTableSetTiFlashReplica(ctx sessionctx.Context, ident ast.Iden
 		TableName:  tb.Meta().Name.L,
 		Type:       model.ActionSetTiFlashReplica,
 		BinlogInfo: &model.HistoryInfo{},
-		Args:       []interface{}{*replicaInfo},
+		Args:       []interface{}{*replicaInfo, trackProgress},
+		CtxVars:    []interface{}{trackProgress},
+	}
+	if trackProgress {
+		job.ReorgMeta = &model.DDLReorgMeta{}
 	}
 	err = d.DoDDLJob(ctx, job)
 	err = d.callHookOnChanged(job, err)
@@ -5796,6 +6198,9 @@ func (d *ddl) AlterTableAddStatistics(ctx sessionctx.Context, ident ast.Ident, s
 	if len(colIDs) < 1 && stats.StatsType == ast.StatsTypeCardinality {
 		return errors.New("Only support Cardinality statistics type on at least 2 columns")
 	}
+	if len(colIDs) < 2 && stats.StatsType == ast.StatsTypeSketch {
+		return errors.New("Only support Sketch statistics type on at least 2 columns")
+	}
 	// TODO: check whether covering index exists for cardinality / dependency types.
 
 	// Call utilities of statistics.Handle to modify system tables instead of doing DML directly,
@@ -6417,6 +6822,9 @@ func (d *ddl) CreatePrimaryKey(ctx sessionctx.Context, ti ast.Ident, indexName m
 		return dbterror.ErrUnsupportedModifyPrimaryKey.GenWithStack("Adding clustered primary key is not supported. " +
 			"Please consider adding NONCLUSTERED primary key instead")
 	}
+	if isColumnarIndexOption(indexOption) {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("PRIMARY or UNIQUE index with USING COLUMNAR is not supported")
+	}
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
 	if err != nil {
 		return errors.Trace(err)
@@ -6605,15 +7013,127 @@ func BuildHiddenColumnInfo(ctx sessionctx.Context, indexPartSpecifications []*as
 
 func (d *ddl) CreateIndex(ctx sessionctx.Context, stmt *ast.CreateIndexStmt) error {
 	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	algorithm := ast.AlgorithmTypeDefault
+	if stmt.LockAlg != nil {
+		algorithm = stmt.LockAlg.AlgorithmTp
+	}
 	return d.createIndex(ctx, ident, stmt.KeyType, model.NewCIStr(stmt.IndexName),
-		stmt.IndexPartSpecifications, stmt.IndexOption, stmt.IfNotExists)
+		stmt.IndexPartSpecifications, stmt.IndexOption, stmt.IfNotExists, algorithm)
+}
+
+// BuildIndexForLocalTemporaryTable checks the index to create on the local temporary table, and returns the new
+// table info with the public index. The local temporary table is only visible to the session, so the index is
+// created in the session without a DDL job. It returns nil if the index exists and IF NOT EXISTS is specified.
+func BuildIndexForLocalTemporaryTable(ctx sessionctx.Context, t table.Table, stmt *ast.CreateIndexStmt) (*model.TableInfo, *model.IndexInfo, error) {
+	switch stmt.KeyType {
+	case ast.IndexKeyTypeSpatial:
+		return nil, nil, dbterror.ErrUnsupportedIndexType.GenWithStack("SPATIAL index is not supported")
+	case ast.IndexKeyTypeFullText:
+		return nil, nil, dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("fulltext index")
+	}
+	if isColumnarIndexOption(stmt.IndexOption) {
+		return nil, nil, dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("columnar index")
+	}
+	// The hidden columns of the expression index would have to be evaluated for the existing rows.
+	for _, idxPart := range stmt.IndexPartSpecifications {
+		if idxPart.Expr != nil {
+			return nil, nil, dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("expression index")
+		}
+	}
+	indexName := model.NewCIStr(stmt.IndexName)
+	if len(indexName.L) == 0 {
+		indexName = GetName4AnonymousIndex(t, stmt.IndexPartSpecifications[0].Column.Name, model.NewCIStr(""))
+	}
+	if t.Meta().FindIndexByName(indexName.L) != nil {
+		err := dbterror.ErrDupKeyName.GenWithStack("index already exist %s", indexName)
+		if stmt.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if stmt.IndexOption != nil {
+		if _, err := validateCommentLength(ctx.GetSessionVars(), indexName.String(), &stmt.IndexOption.Comment, dbterror.ErrTooLongIndexComment); err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+	}
+
+	tblInfo := t.Meta().Clone()
+	unique := stmt.KeyType == ast.IndexKeyTypeUnique
+	indexInfo, err := BuildIndexInfo(ctx, tblInfo.Columns, indexName, false, unique, false,
+		stmt.IndexPartSpecifications, stmt.IndexOption, model.StatePublic)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	for _, idxCol := range indexInfo.Columns {
+		if col := tblInfo.Columns[idxCol.Offset]; col.IsGenerated() && !col.GeneratedStored {
+			return nil, nil, dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("index on virtual generated column")
+		}
+	}
+	indexInfo.ID = AllocateIndexID(tblInfo)
+	tblInfo.Indices = append(tblInfo.Indices, indexInfo)
+	if err = checkTooManyIndexes(tblInfo.Indices); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	AddIndexColumnFlag(tblInfo, indexInfo)
+	return tblInfo, indexInfo, nil
+}
+
+// BuildDropIndexForLocalTemporaryTable checks the index to drop on the local temporary table, and returns the new
+// table info without the index. It returns nil if the index doesn't exist and IF EXISTS is specified.
+func BuildDropIndexForLocalTemporaryTable(ctx sessionctx.Context, t table.Table, stmt *ast.DropIndexStmt) (*model.TableInfo, *model.IndexInfo, error) {
+	indexName := model.NewCIStr(stmt.IndexName)
+	indexInfo := t.Meta().FindIndexByName(indexName.L)
+	isPK, err := CheckIsDropPrimaryKey(indexName, indexInfo, t)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ctx.GetSessionVars().InRestrictedSQL && ctx.GetSessionVars().PrimaryKeyRequired && isPK {
+		return nil, nil, infoschema.ErrTableWithoutPrimaryKey
+	}
+	if indexInfo == nil {
+		err = dbterror.ErrCantDropFieldOrKey.GenWithStack("index %s doesn't exist", indexName)
+		if stmt.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	tblInfo := t.Meta().Clone()
+	indexInfo = tblInfo.FindIndexByName(indexName.L)
+	DropIndexColumnFlag(tblInfo, indexInfo)
+	removeIndexInfo(tblInfo, indexInfo)
+	return tblInfo, indexInfo, nil
 }
 
 func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.IndexKeyType, indexName model.CIStr,
-	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool) error {
-	// not support Spatial and FullText index
-	if keyType == ast.IndexKeyTypeFullText || keyType == ast.IndexKeyTypeSpatial {
-		return dbterror.ErrUnsupportedIndexType.GenWithStack("FULLTEXT and SPATIAL index is not supported")
+	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool, algorithm ast.AlgorithmType) error {
+	// not support Spatial index
+	if keyType == ast.IndexKeyTypeSpatial {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("SPATIAL index is not supported")
+	}
+	instant := algorithm == ast.AlgorithmTypeInstant
+	if instant && keyType == ast.IndexKeyTypeFullText {
+		return dbterror.ErrAlterOperationNotSupported.GenWithStackByArgs("ALGORITHM=INSTANT",
+			"Cannot add FULLTEXT index instantly", "ALGORITHM=INPLACE")
+	}
+	fulltext := keyType == ast.IndexKeyTypeFullText
+	if fulltext {
+		var err error
+		if indexOption, err = fulltextIndexOption(indexOption); err != nil {
+			return err
+		}
+	}
+	columnar := isColumnarIndexOption(indexOption)
+	if columnar {
+		if instant {
+			return dbterror.ErrAlterOperationNotSupported.GenWithStackByArgs("ALGORITHM=INSTANT",
+				"Cannot add COLUMNAR index instantly", "ALGORITHM=INPLACE")
+		}
+		if ctx.GetSessionVars().StmtCtx.MultiSchemaInfo != nil {
+			return dbterror.ErrRunMultiSchemaChanges.FastGenByArgs("add COLUMNAR index")
+		}
 	}
 	unique := keyType == ast.IndexKeyTypeUnique
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
@@ -6654,6 +7174,11 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	}
 
 	tblInfo := t.Meta()
+	if columnar {
+		if err = checkColumnarIndexReplica(tblInfo); err != nil {
+			return errors.Trace(err)
+		}
+	}
 
 	// Build hidden columns if necessary.
 	hiddenCols, err := buildHiddenColumnInfoWithCheck(ctx, indexPartSpecifications, indexName, t.Meta(), t.Cols())
@@ -6664,16 +7189,25 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 		return errors.Trace(err)
 	}
 
-	finalColumns := make([]*model.ColumnInfo, len(tblInfo.Columns), len(tblInfo.Columns)+len(hiddenCols))
+	addingCols := addingColumnsOfMultiSchemaChange(ctx.GetSessionVars().StmtCtx.MultiSchemaInfo)
+	finalColumns := make([]*model.ColumnInfo, len(tblInfo.Columns), len(tblInfo.Columns)+len(hiddenCols)+len(addingCols))
 	copy(finalColumns, tblInfo.Columns)
 	finalColumns = append(finalColumns, hiddenCols...)
+	finalColumns = append(finalColumns, addingCols...)
 	// Check before the job is put to the queue.
 	// This check is redundant, but useful. If DDL check fail before the job is put
 	// to job queue, the fail path logic is super fast.
 	// After DDL job is put to the queue, and if the check fail, TiDB will run the DDL cancel logic.
 	// The recover step causes DDL wait a few seconds, makes the unit test painfully slow.
 	// For same reason, decide whether index is global here.
-	indexColumns, _, err := buildIndexColumns(ctx, finalColumns, indexPartSpecifications)
+	var indexColumns []*model.IndexColumn
+	if fulltext {
+		indexColumns, err = buildFulltextIndexColumns(finalColumns, indexPartSpecifications)
+	} else if columnar {
+		indexColumns, err = buildColumnarIndexColumns(finalColumns, indexPartSpecifications, false, unique)
+	} else {
+		indexColumns, _, err = buildIndexColumns(ctx, finalColumns, indexPartSpecifications)
+	}
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -6699,6 +7233,18 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 		}
 	}
 
+	if instant {
+		// Check before the job is put to the queue to fail fast, the job checks it again before the index is public.
+		empty, err := isTableEmpty(d.store, tblInfo)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !empty {
+			return dbterror.ErrAlterOperationNotSupported.GenWithStackByArgs("ALGORITHM=INSTANT",
+				"Cannot add index instantly to a non-empty table", "ALGORITHM=INPLACE")
+		}
+	}
+
 	tzName, tzOffset := ddlutil.GetTimeZone(ctx)
 	job := &model.Job{
 		SchemaID:   schema.ID,
@@ -6712,6 +7258,7 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 			Warnings:      make(map[errors.ErrorID]*terror.Error),
 			WarningsCount: make(map[errors.ErrorID]int64),
 			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+			Instant:       instant,
 		},
 		Args:     []interface{}{unique, indexName, indexPartSpecifications, indexOption, hiddenCols, global},
 		Priority: ctx.GetSessionVars().DDLReorgPriority,
@@ -6867,7 +7414,7 @@ func (d *ddl) CreateForeignKey(ctx sessionctx.Context, ti ast.Ident, fkName mode
 			})
 		}
 		indexOption := &ast.IndexOption{}
-		err = d.createIndex(ctx, ti, ast.IndexKeyTypeNone, fkInfo.Name, indexPartSpecifications, indexOption, false)
+		err = d.createIndex(ctx, ti, ast.IndexKeyTypeNone, fkInfo.Name, indexPartSpecifications, indexOption, false, ast.AlgorithmTypeDefault)
 		if err != nil {
 			return err
 		}
@@ -6916,16 +7463,122 @@ func (d *ddl) DropForeignKey(ctx sessionctx.Context, ti ast.Ident, fkName model.
 	return errors.Trace(err)
 }
 
+// CreateCheckConstraint adds a CHECK constraint to the table. The existing rows are validated before the constraint
+// becomes public, the job is rolled back if any of them violates the constraint.
+func (d *ddl) CreateCheckConstraint(ctx sessionctx.Context, ti ast.Ident, constr *ast.Constraint) error {
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := t.Meta()
+	if tblInfo.TempTableType != model.TempTableNone {
+		return errors.Trace(dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("check constraint"))
+	}
+	constrName := model.NewCIStr(constr.Name)
+	if constrName.L == "" {
+		constrName = model.NewCIStr(fmt.Sprintf("%s_chk_%d", tblInfo.Name.O, tblInfo.MaxConstraintID+1))
+	}
+	if tblInfo.FindConstraintInfoByName(constrName.L) != nil {
+		return dbterror.ErrCheckConstraintDupName.GenWithStackByArgs(constrName.O)
+	}
+	constraintInfo, err := buildCheckConstraintInfo(ctx, tblInfo, constrName, constr)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	tzName, tzOffset := ddlutil.GetTimeZone(ctx)
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tblInfo.ID,
+		SchemaName: schema.Name.L,
+		TableName:  tblInfo.Name.L,
+		Type:       model.ActionAddCheckConstraint,
+		BinlogInfo: &model.HistoryInfo{},
+		ReorgMeta: &model.DDLReorgMeta{
+			SQLMode:       ctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+			ReorgTp:       model.ReorgTypeTxn,
+		},
+		Args:     []interface{}{constraintInfo},
+		Priority: ctx.GetSessionVars().DDLReorgPriority,
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// buildCheckConstraintInfo builds the meta of a CHECK constraint, the expression is validated against the table.
+func buildCheckConstraintInfo(ctx sessionctx.Context, tblInfo *model.TableInfo, constrName model.CIStr, constr *ast.Constraint) (*model.ConstraintInfo, error) {
+	if err := checkIllegalFn4Generated(constrName.O, typeColumn, constr.Expr); err != nil {
+		return nil, errors.Trace(err)
+	}
+	// Rewrite the expression to make sure it only refers to the columns of the table.
+	if _, err := expression.RewriteSimpleExprWithTableInfo(ctx, tblInfo, constr.Expr, false); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var sb strings.Builder
+	restoreFlags := format.RestoreStringSingleQuotes | format.RestoreKeyWordLowercase | format.RestoreNameBackQuotes |
+		format.RestoreSpacesAroundBinaryOperation
+	if err := constr.Expr.Restore(format.NewRestoreCtx(restoreFlags, &sb)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	colNames := FindColumnNamesInExpr(constr.Expr)
+	cols := make([]model.CIStr, 0, len(colNames))
+	for _, name := range colNames {
+		if !containsColumnName(cols, name.Name) {
+			cols = append(cols, name.Name)
+		}
+	}
+	return &model.ConstraintInfo{
+		Name:           constrName,
+		Table:          tblInfo.Name,
+		ConstraintCols: cols,
+		Enforced:       constr.Enforced,
+		InColumn:       constr.InColumn,
+		ExprString:     sb.String(),
+		State:          model.StateNone,
+	}, nil
+}
+
+// DropCheckConstraint drops a CHECK constraint of the table.
+func (d *ddl) DropCheckConstraint(ctx sessionctx.Context, ti ast.Ident, constrName model.CIStr) error {
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if t.Meta().FindConstraintInfoByName(constrName.L) == nil {
+		return dbterror.ErrConstraintNotFound.GenWithStackByArgs(constrName.O)
+	}
+
+	job := &model.Job{
+		SchemaID:    schema.ID,
+		TableID:     t.Meta().ID,
+		SchemaName:  schema.Name.L,
+		SchemaState: model.StatePublic,
+		TableName:   t.Meta().Name.L,
+		Type:        model.ActionDropCheckConstraint,
+		BinlogInfo:  &model.HistoryInfo{},
+		Args:        []interface{}{constrName},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
 func (d *ddl) DropIndex(ctx sessionctx.Context, stmt *ast.DropIndexStmt) error {
 	ti := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
-	err := d.dropIndex(ctx, ti, model.NewCIStr(stmt.IndexName), stmt.IfExists)
+	err := d.dropIndex(ctx, ti, model.NewCIStr(stmt.IndexName), stmt.IfExists, stmt.Deferred)
 	if (infoschema.ErrDatabaseNotExists.Equal(err) || infoschema.ErrTableNotExists.Equal(err)) && stmt.IfExists {
 		err = nil
 	}
 	return err
 }
 
-func (d *ddl) dropIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CIStr, ifExists bool) error {
+// dropIndex drops the index. If deferred is true, the index is only dropped logically and can be restored by
+// UNDROP INDEX before it's purged. Dropping a deferred dropped index without DEFERRED purges it at once.
+func (d *ddl) dropIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CIStr, ifExists, deferred bool) error {
 	is := d.infoCache.GetLatest()
 	schema, ok := is.SchemaByName(ti.Schema)
 	if !ok {
@@ -6968,6 +7621,12 @@ func (d *ddl) dropIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CI
 	if isPK {
 		jobTp = model.ActionDropPrimaryKey
 	}
+	if deferred {
+		if err := checkDeferredDropIndex(ctx, t.Meta(), indexInfo, isPK); err != nil {
+			return errors.Trace(err)
+		}
+		jobTp = model.ActionDeferredDropIndex
+	}
 
 	job := &model.Job{
 		SchemaID:    schema.ID,
@@ -7549,6 +8208,99 @@ func (d *ddl) AlterIndexVisibility(ctx sessionctx.Context, ident ast.Ident, inde
 	return errors.Trace(err)
 }
 
+// VerifyIndex verifies a public index against the table data in a DDL job, and returns the result of the job.
+func (d *ddl) VerifyIndex(ctx sessionctx.Context, ident ast.Ident, indexName model.CIStr, rate string, repair bool) (*model.IndexVerifyInfo, error) {
+	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	tblInfo := tb.Meta()
+	if tblInfo.TempTableType != model.TempTableNone {
+		return nil, errors.Trace(dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("admin verify index"))
+	}
+	idx := tblInfo.FindIndexByName(indexName.L)
+	if idx == nil || idx.State != model.StatePublic {
+		return nil, errors.Trace(infoschema.ErrKeyNotExists.GenWithStackByArgs(indexName.O, tblInfo.Name))
+	}
+	if idx.Global || idx.Tp == model.IndexTypeFulltext || idx.Tp == model.IndexTypeColumnar {
+		return nil, errors.Trace(dbterror.ErrUnsupportedIndexType.GenWithStack("ADMIN VERIFY INDEX on the global, fulltext or columnar index is not supported"))
+	}
+	bytes, err := parseMaxReorgRate(rate)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	tzName, tzOffset := ddlutil.GetTimeZone(ctx)
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    tblInfo.ID,
+		SchemaName: schema.Name.L,
+		TableName:  tblInfo.Name.L,
+		Type:       model.ActionVerifyIndex,
+		BinlogInfo: &model.HistoryInfo{},
+		ReorgMeta: &model.DDLReorgMeta{
+			SQLMode:       ctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+			ReorgTp:       model.ReorgTypeTxn,
+			IndexVerify:   &model.IndexVerifyInfo{Rate: bytes, Repair: repair},
+		},
+		Args:     []interface{}{idx.ID},
+		Priority: ctx.GetSessionVars().DDLReorgPriority,
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	se, err := d.sessPool.get()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer d.sessPool.put(se)
+	historyJob, err := GetHistoryJobByID(se, job.ID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if historyJob == nil || historyJob.ReorgMeta == nil || historyJob.ReorgMeta.IndexVerify == nil {
+		return nil, errors.Errorf("the result of the verify index job %d is not found", job.ID)
+	}
+	return historyJob.ReorgMeta.IndexVerify, nil
+}
+
+// UndropIndex restores the index dropped by `DROP INDEX ... DEFERRED`.
+func (d *ddl) UndropIndex(ctx sessionctx.Context, stmt *ast.UndropIndexStmt) error {
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := tb.Meta()
+	idx := tblInfo.FindIndexByName(strings.ToLower(stmt.IndexName))
+	if idx == nil {
+		return errors.Trace(infoschema.ErrKeyNotExists.GenWithStackByArgs(stmt.IndexName, tblInfo.Name))
+	}
+	if !idx.IsDeferredDropped() {
+		return errors.Trace(dbterror.ErrUndropIndex.GenWithStackByArgs(stmt.IndexName, "it isn't dropped by DROP INDEX ... DEFERRED"))
+	}
+
+	job := &model.Job{
+		SchemaID:    schema.ID,
+		TableID:     tblInfo.ID,
+		SchemaName:  schema.Name.L,
+		SchemaState: idx.State,
+		TableName:   tblInfo.Name.L,
+		Type:        model.ActionUndropIndex,
+		BinlogInfo:  &model.HistoryInfo{},
+		Args:        []interface{}{idx.ID},
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
 func (d *ddl) AlterTableAttributes(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
 	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ident)
 	if err != nil {
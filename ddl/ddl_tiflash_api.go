@@ -32,11 +32,15 @@ import (
 	ddlutil "github.com/pingcap/tidb/ddl/util"
 	"github.com/pingcap/tidb/domain/infosync"
 	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/store/helper"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/dbterror"
 	"github.com/pingcap/tidb/util/intest"
 	"github.com/pingcap/tidb/util/logutil"
 	atomicutil "go.uber.org/atomic"
@@ -331,24 +335,33 @@ func (d *ddl) UpdateTiFlashHTTPAddress(store *helper.StoreStat) error {
 }
 
 func updateTiFlashStores(pollTiFlashContext *TiFlashManagementContext) error {
+	stores, err := getTiFlashStores()
+	if err != nil {
+		return err
+	}
+	pollTiFlashContext.TiFlashStores = stores
+	logutil.BgLogger().Debug("updateTiFlashStores finished", zap.Int("TiFlash store count", len(pollTiFlashContext.TiFlashStores)))
+	return nil
+}
+
+func getTiFlashStores() (map[int64]helper.StoreStat, error) {
 	// We need the up-to-date information about TiFlash stores.
 	// Since TiFlash Replica synchronize may happen immediately after new TiFlash stores are added.
 	tikvStats, err := infosync.GetTiFlashStoresStat(context.Background())
 	// If MockTiFlash is not set, will issue a MockTiFlashError here.
 	if err != nil {
-		return err
+		return nil, err
 	}
-	pollTiFlashContext.TiFlashStores = make(map[int64]helper.StoreStat)
+	stores := make(map[int64]helper.StoreStat)
 	for _, store := range tikvStats.Stores {
 		for _, l := range store.Store.Labels {
 			if l.Key == "engine" && l.Value == "tiflash" {
-				pollTiFlashContext.TiFlashStores[store.Store.ID] = store
+				stores[store.Store.ID] = store
 				logutil.BgLogger().Debug("Found tiflash store", zap.Int64("id", store.Store.ID), zap.String("Address", store.Store.Address), zap.String("StatusAddress", store.Store.StatusAddress))
 			}
 		}
 	}
-	logutil.BgLogger().Debug("updateTiFlashStores finished", zap.Int("TiFlash store count", len(pollTiFlashContext.TiFlashStores)))
-	return nil
+	return stores, nil
 }
 
 func pollAvailableTableProgress(schemas infoschema.InfoSchema, ctx sessionctx.Context, pollTiFlashContext *TiFlashManagementContext) {
@@ -457,6 +470,10 @@ func (d *ddl) refreshTiFlashTicker(ctx sessionctx.Context, pollTiFlashContext *T
 		tbls := schema.SchemaTables(db.Name)
 		for _, tbl := range tbls {
 			tblInfo := tbl.Meta()
+			if tblInfo.TiFlashReplica != nil && tblInfo.TiFlashReplica.SyncJobID != 0 {
+				// The replica status is updated by the DDL job which tracks the creation of the replicas.
+				continue
+			}
 			LoadTiFlashReplicaInfo(tblInfo, &tableList)
 		}
 	}
@@ -550,6 +567,135 @@ func (d *ddl) refreshTiFlashTicker(ctx sessionctx.Context, pollTiFlashContext *T
 	return nil
 }
 
+// initTiFlashReplicaProgress initializes the progress of the TiFlash replica of each partition in the job, the
+// replicas are created and tracked by onTrackTiFlashReplica later.
+func initTiFlashReplicaProgress(job *model.Job, tblInfo *model.TableInfo, replicaInfo *ast.TiFlashReplicaSpec) error {
+	if job.ReorgMeta == nil {
+		job.ReorgMeta = &model.DDLReorgMeta{}
+	}
+	progress := make([]*model.ReorgElementProgress, 0, 1)
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		for _, def := range pi.Definitions {
+			progress = append(progress, &model.ReorgElementProgress{Name: "partition `" + def.Name.O + "`", ID: def.ID})
+		}
+		// Partitions that in adding mid-state have high priorities, so they aren't throttled.
+		if e := infosync.ConfigureTiFlashPDForPartitions(true, &pi.AddingDefinitions, replicaInfo.Count, &replicaInfo.Labels, tblInfo.ID); e != nil {
+			return errors.Trace(e)
+		}
+	} else {
+		progress = append(progress, &model.ReorgElementProgress{Name: "table", ID: tblInfo.ID})
+	}
+	job.ReorgMeta.ElementProgress = progress
+	return nil
+}
+
+// startTiFlashReplicas sets the placement rules of the TiFlash replicas of the partitions not started yet, so that no
+// more than concurrency partitions are being replicated at the same time. 0 means unlimited.
+func startTiFlashReplicas(replica *model.TiFlashReplicaInfo, progress []*model.ReorgElementProgress, concurrency int) error {
+	running := 0
+	for _, p := range progress {
+		if p.Started && !p.Done {
+			running++
+		}
+	}
+	for _, p := range progress {
+		if p.Started {
+			continue
+		}
+		if concurrency > 0 && running >= concurrency {
+			break
+		}
+		if err := infosync.ConfigureTiFlashPDForTable(p.ID, replica.Count, &replica.LocationLabels); err != nil {
+			return errors.Trace(err)
+		}
+		p.Started = true
+		running++
+	}
+	return nil
+}
+
+// onTrackTiFlashReplica creates the TiFlash replicas of the partitions throttled by tidb_ddl_tiflash_replica_concurrency,
+// and updates the progress of each partition in the job until all the replicas are available.
+func (w *worker) onTrackTiFlashReplica(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo) (ver int64, _ error) {
+	replica := tblInfo.TiFlashReplica
+	if replica == nil || replica.SyncJobID != job.ID || job.ReorgMeta == nil {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrInvalidDDLState.GenWithStackByArgs("tiflash replica", "not tracked by the job")
+	}
+	progress := job.ReorgMeta.ElementProgress
+	if !variable.DDLEnableTiFlashReplicaProgress.Load() {
+		// The tracking is disabled, the replicas of all the partitions are created at once and their status is
+		// polled by PollTiFlashRoutine.
+		if err := startTiFlashReplicas(replica, progress, 0); err != nil {
+			return ver, errors.Trace(err)
+		}
+		replica.SyncJobID = 0
+		ver, err := updateVersionAndTableInfo(d, t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+		return ver, nil
+	}
+
+	stores, err := getTiFlashStores()
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	availableChanged := false
+	for _, p := range progress {
+		if !p.Started || p.Done {
+			continue
+		}
+		p.Progress, err = infosync.CalculateTiFlashProgress(p.ID, replica.Count, stores)
+		if err != nil {
+			logutil.BgLogger().Warn("[ddl] get tiflash sync progress failed", zap.Int64("jobID", job.ID), zap.Int64("physicalTableID", p.ID), zap.Error(err))
+			continue
+		}
+		if err := infosync.UpdateTiFlashProgressCache(p.ID, p.Progress); err != nil {
+			logutil.BgLogger().Warn("[ddl] update tiflash sync progress cache failed", zap.Int64("jobID", job.ID), zap.Int64("physicalTableID", p.ID), zap.Error(err))
+		}
+		if p.Progress == 1 {
+			p.Done, availableChanged = true, true
+			if p.ID != tblInfo.ID {
+				replica.AvailablePartitionIDs = append(replica.AvailablePartitionIDs, p.ID)
+			}
+		}
+	}
+	if err := startTiFlashReplicas(replica, progress, int(variable.DDLTiFlashReplicaConcurrency.Load())); err != nil {
+		return ver, errors.Trace(err)
+	}
+
+	allDone := true
+	for _, p := range progress {
+		allDone = allDone && p.Done
+	}
+	if allDone {
+		logutil.BgLogger().Info("[ddl] TiFlash replica available", zap.Int64("jobID", job.ID), zap.Int64("tableID", tblInfo.ID))
+		replica.Available = true
+		replica.SyncJobID = 0
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+		return ver, nil
+	}
+	if availableChanged {
+		// The available partitions can be read from TiFlash before the whole table is available.
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+	}
+	// Wait for a while before checking the progress again, the progress is saved in the job when it returns.
+	select {
+	case <-w.ctx.Done():
+	case <-time.After(PollTiFlashInterval):
+	}
+	return ver, nil
+}
+
 func (d *ddl) PollTiFlashRoutine() {
 	pollTiflashContext, err := NewTiFlashManagementContext()
 	if err != nil {
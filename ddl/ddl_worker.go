@@ -522,7 +522,10 @@ func jobNeedGC(job *model.Job) bool {
 		case model.ActionDropSchema, model.ActionDropTable, model.ActionTruncateTable, model.ActionDropIndex, model.ActionDropPrimaryKey,
 			model.ActionDropTablePartition, model.ActionTruncateTablePartition, model.ActionDropColumn, model.ActionModifyColumn,
 			model.ActionAddIndex, model.ActionAddPrimaryKey,
-			model.ActionReorganizePartition:
+			model.ActionReorganizePartition, model.ActionAlterTablePartitioning:
+			return true
+		case model.ActionCreateTableSelect:
+			// The rows written by the rolled back job, or into the physical tables abandoned by the job, are deleted.
 			return true
 		case model.ActionMultiSchemaChange:
 			for _, sub := range job.MultiSchemaInfo.SubJobs {
@@ -584,6 +587,12 @@ func (w *worker) finishDDLJob(t *meta.Meta, job *model.Job) (err error) {
 		updateRawArgs = false
 	}
 	w.writeDDLSeqNum(job)
+	if variable.EnableDDLNotifier.Load() {
+		err = w.finishDDLEvent(t, job)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
 	w.removeJobCtx(job)
 	err = AddHistoryDDLJob(w.sess, t, job, updateRawArgs)
 	return errors.Trace(err)
@@ -676,10 +685,16 @@ func (w *worker) unlockSeqNum(err error) {
 
 // DDLBackfillers contains the DDL need backfill step.
 var DDLBackfillers = map[model.ActionType]string{
-	model.ActionAddIndex:            "add_index",
-	model.ActionModifyColumn:        "modify_column",
-	model.ActionDropIndex:           "drop_index",
-	model.ActionReorganizePartition: "reorganize_partition",
+	model.ActionAddIndex:               "add_index",
+	model.ActionModifyColumn:           "modify_column",
+	model.ActionDropIndex:              "drop_index",
+	model.ActionReorganizePartition:    "reorganize_partition",
+	model.ActionCreateTableSelect:      "create_table_select",
+	model.ActionAlterTablePartitioning: "alter_table_partitioning",
+	model.ActionVerifyIndex:            "verify_index",
+	model.ActionAddCheckConstraint:     "add_check_constraint",
+	model.ActionAddColumn:              "add_column",
+	model.ActionUndropIndex:            "undrop_index",
 }
 
 func getDDLRequestSource(jobType model.ActionType) string {
@@ -689,11 +704,22 @@ func getDDLRequestSource(jobType model.ActionType) string {
 	return kv.InternalTxnDDL
 }
 
-func (w *JobContext) setDDLLabelForDiagnosis(jobType model.ActionType) {
+// formatDDLJobLabels returns the labels of the job in braces, such as "{ticket=DB-1234,team=payment}", or empty if
+// the job has no labels.
+func formatDDLJobLabels(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	return "{" + labels + "}"
+}
+
+func (w *JobContext) setDDLLabelForDiagnosis(jobType model.ActionType, jobLabels string) {
 	if w.tp != "" {
 		return
 	}
-	w.tp = getDDLRequestSource(jobType)
+	// The labels are only attached to the request source, the metrics are labeled by the job type to keep
+	// their cardinality bounded.
+	w.tp = getDDLRequestSource(jobType) + formatDDLJobLabels(jobLabels)
 	w.ddlJobCtx = kv.WithInternalSourceType(w.ddlJobCtx, w.ddlJobSourceType())
 }
 
@@ -742,7 +768,7 @@ func (w *worker) HandleDDLJobTable(d *ddlCtx, job *model.Job) (int64, error) {
 		txn.SetDiskFullOpt(kvrpcpb.DiskFullOpt_NotAllowedOnFull)
 	}
 	w.setDDLLabelForTopSQL(job.ID, job.Query)
-	w.setDDLSourceForDiagnosis(job.ID, job.Type)
+	w.setDDLSourceForDiagnosis(job.ID, job.Type, job.Labels)
 	jobContext := w.jobContext(job.ID)
 	if tagger := w.getResourceGroupTaggerForTopSQL(job.ID); tagger != nil {
 		txn.SetOption(kv.ResourceGroupTagger, tagger)
@@ -763,6 +789,17 @@ func (w *worker) HandleDDLJobTable(d *ddlCtx, job *model.Job) (int64, error) {
 	// set request source type to DDL type
 	txn.SetOption(kv.RequestSourceType, jobContext.ddlJobSourceType())
 
+	// The tables before the job are read before its first step changes them.
+	var beforeTables string
+	notifyEvent := job.RealStartTS == 0 && variable.EnableDDLNotifier.Load()
+	if notifyEvent {
+		beforeTables, err = getBeforeEventTables(t, job)
+		if err != nil {
+			w.sess.rollback()
+			return 0, err
+		}
+	}
+
 	// If running job meets error, we will save this error in job Error
 	// and retry later if the job is not cancelled.
 	schemaVer, runJobErr = w.runDDLJob(d, t, job)
@@ -791,6 +828,15 @@ func (w *worker) HandleDDLJobTable(d *ddlCtx, job *model.Job) (int64, error) {
 		schemaVer = 0
 	}
 
+	if notifyEvent {
+		err = w.insertPendingDDLEvent(job, beforeTables)
+		if err != nil {
+			w.sess.rollback()
+			d.unlockSchemaVersion(job.ID)
+			return 0, err
+		}
+	}
+
 	err = w.registerMDLInfo(job, schemaVer)
 	if err != nil {
 		w.sess.rollback()
@@ -992,6 +1038,8 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 		ver, err = onModifySchemaCharsetAndCollate(d, t, job)
 	case model.ActionDropSchema:
 		ver, err = onDropSchema(d, t, job)
+	case model.ActionRenameSchema:
+		ver, err = onRenameSchema(d, t, job)
 	case model.ActionRecoverSchema:
 		ver, err = w.onRecoverSchema(d, t, job)
 	case model.ActionModifySchemaDefaultPlacement:
@@ -1009,11 +1057,11 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 	case model.ActionDropTablePartition:
 		ver, err = w.onDropTablePartition(d, t, job)
 	case model.ActionTruncateTablePartition:
-		ver, err = onTruncateTablePartition(d, t, job)
+		ver, err = w.onTruncateTablePartition(d, t, job)
 	case model.ActionExchangeTablePartition:
 		ver, err = w.onExchangeTablePartition(d, t, job)
 	case model.ActionAddColumn:
-		ver, err = onAddColumn(d, t, job)
+		ver, err = w.onAddColumn(d, t, job)
 	case model.ActionDropColumn:
 		ver, err = onDropColumn(d, t, job)
 	case model.ActionModifyColumn:
@@ -1026,6 +1074,10 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 		ver, err = w.onCreateIndex(d, t, job, true)
 	case model.ActionDropIndex, model.ActionDropPrimaryKey:
 		ver, err = onDropIndex(d, t, job)
+	case model.ActionDeferredDropIndex:
+		ver, err = onDeferredDropIndex(d, t, job)
+	case model.ActionUndropIndex:
+		ver, err = onUndropIndex(d, t, job)
 	case model.ActionRenameIndex:
 		ver, err = onRenameIndex(d, t, job)
 	case model.ActionAddForeignKey:
@@ -1096,8 +1148,16 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 		ver, err = w.onFlashbackCluster(d, t, job)
 	case model.ActionMultiSchemaChange:
 		ver, err = onMultiSchemaChange(w, d, t, job)
-	case model.ActionReorganizePartition:
+	case model.ActionReorganizePartition, model.ActionAlterTablePartitioning:
 		ver, err = w.onReorganizePartition(d, t, job)
+	case model.ActionCreateTableSelect:
+		ver, err = w.onCreateTableSelect(d, t, job)
+	case model.ActionVerifyIndex:
+		ver, err = w.onVerifyIndex(d, t, job)
+	case model.ActionAddCheckConstraint:
+		ver, err = w.onAddCheckConstraint(d, t, job)
+	case model.ActionDropCheckConstraint:
+		ver, err = onDropCheckConstraint(d, t, job)
 	case model.ActionAlterTTLInfo:
 		ver, err = onTTLInfoChange(d, t, job)
 	case model.ActionAlterTTLRemove:
@@ -1371,7 +1431,7 @@ func updateSchemaVersion(d *ddlCtx, t *meta.Meta, job *model.Job, multiInfos ...
 				diff.AffectedOpts = buildPlacementAffects(oldIDs, oldIDs)
 			}
 		}
-	case model.ActionReorganizePartition:
+	case model.ActionReorganizePartition, model.ActionAlterTablePartitioning:
 		diff.TableID = job.TableID
 		if len(job.CtxVars) > 0 {
 			if droppedIDs, ok := job.CtxVars[0].([]int64); ok {
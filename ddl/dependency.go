@@ -0,0 +1,566 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/generatedexpr"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+)
+
+// dependentObjectType is the type of the object which depends on a column.
+type dependentObjectType byte
+
+const (
+	dependentView dependentObjectType = iota
+	dependentForeignKey
+	dependentExpressionIndex
+	dependentBinding
+)
+
+// dependentObject is an object which depends on a column. It's broken silently if the column is dropped or
+// renamed, unless it's dropped together with the column.
+type dependentObject struct {
+	tp dependentObjectType
+	// schema and table are the owner of the foreign key or the expression index, or the name of the view.
+	schema model.CIStr
+	table  model.CIStr
+	// name is the name of the foreign key or the expression index.
+	name model.CIStr
+	// originalSQL and defaultDB identify the SQL binding.
+	originalSQL string
+	defaultDB   string
+}
+
+func (o *dependentObject) String() string {
+	switch o.tp {
+	case dependentView:
+		return fmt.Sprintf("view %s.%s", o.schema.O, o.table.O)
+	case dependentForeignKey:
+		return fmt.Sprintf("foreign key %s on %s.%s", o.name.O, o.schema.O, o.table.O)
+	case dependentExpressionIndex:
+		return fmt.Sprintf("expression index %s on %s.%s", o.name.O, o.schema.O, o.table.O)
+	default:
+		return fmt.Sprintf("binding '%s'", o.originalSQL)
+	}
+}
+
+func dependentObjectsToString(objs []*dependentObject) string {
+	strs := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		strs = append(strs, obj.String())
+	}
+	return strings.Join(strs, ", ")
+}
+
+// collectColumnDependents collects the views, foreign keys, expression indexes and SQL bindings which depend on
+// the column.
+func collectColumnDependents(sctx sessionctx.Context, is infoschema.InfoSchema, schema *model.DBInfo,
+	tblInfo *model.TableInfo, colName model.CIStr) ([]*dependentObject, error) {
+	var objs []*dependentObject
+	for _, fk := range tblInfo.ForeignKeys {
+		for _, col := range fk.Cols {
+			if col.L == colName.L {
+				objs = append(objs, &dependentObject{tp: dependentForeignKey, schema: schema.Name, table: tblInfo.Name, name: fk.Name})
+				break
+			}
+		}
+	}
+	for _, referredFK := range is.GetTableReferredForeignKeys(schema.Name.L, tblInfo.Name.L) {
+		for _, col := range referredFK.Cols {
+			if col.L == colName.L {
+				objs = append(objs, &dependentObject{tp: dependentForeignKey,
+					schema: referredFK.ChildSchema, table: referredFK.ChildTable, name: referredFK.ChildFKName})
+				break
+			}
+		}
+	}
+	for _, idx := range tblInfo.Indices {
+		if isExpressionIndexOnColumn(tblInfo, idx, colName) {
+			objs = append(objs, &dependentObject{tp: dependentExpressionIndex, schema: schema.Name, table: tblInfo.Name, name: idx.Name})
+		}
+	}
+	var views []*dependentObject
+	for _, db := range is.AllSchemas() {
+		for _, view := range is.SchemaTables(db.Name) {
+			viewInfo := view.Meta()
+			if !viewInfo.IsView() {
+				continue
+			}
+			if isColumnReferredBySQL(viewInfo.View.SelectStmt, viewInfo.Charset, viewInfo.Collate, db.Name.L, schema.Name.L, tblInfo.Name.L, colName.L) {
+				views = append(views, &dependentObject{tp: dependentView, schema: db.Name, table: viewInfo.Name})
+			}
+		}
+	}
+	slices.SortFunc(views, func(a, b *dependentObject) bool {
+		if a.schema.L != b.schema.L {
+			return a.schema.L < b.schema.L
+		}
+		return a.table.L < b.table.L
+	})
+	objs = append(objs, views...)
+	bindings, err := collectColumnDependentBindings(sctx, schema.Name.L, tblInfo.Name.L, colName.L)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return append(objs, bindings...), nil
+}
+
+func isExpressionIndexOnColumn(tblInfo *model.TableInfo, idx *model.IndexInfo, colName model.CIStr) bool {
+	for _, idxCol := range idx.Columns {
+		col := tblInfo.Columns[idxCol.Offset]
+		if !col.Hidden {
+			continue
+		}
+		if _, ok := col.Dependences[colName.L]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// collectColumnDependentBindings collects the global SQL bindings which refer to the column.
+func collectColumnDependentBindings(sctx sessionctx.Context, schema, table, colName string) ([]*dependentObject, error) {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+	rows, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil,
+		"SELECT original_sql, bind_sql, default_db, charset, collation FROM mysql.bind_info WHERE status IN ('enabled', 'using') AND source != 'builtin'")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var objs []*dependentObject
+	for _, row := range rows {
+		originalSQL, bindSQL, defaultDB := row.GetString(0), row.GetString(1), row.GetString(2)
+		if isColumnReferredBySQL(bindSQL, row.GetString(3), row.GetString(4), strings.ToLower(defaultDB), schema, table, colName) {
+			objs = append(objs, &dependentObject{tp: dependentBinding, originalSQL: originalSQL, defaultDB: defaultDB})
+		}
+	}
+	return objs, nil
+}
+
+// isColumnReferredBySQL checks whether the SQL refers to the column of the table. It's conservative, the SQL
+// which refers to the table and a column with the same name or a wildcard is treated as a reference.
+func isColumnReferredBySQL(sql, charset, collation, defaultDB, schema, table, colName string) bool {
+	stmt, err := parser.New().ParseOneStmt(sql, charset, collation)
+	if err != nil {
+		logutil.BgLogger().Warn("[ddl] cannot parse the SQL to check the column dependency", zap.String("SQL", sql), zap.Error(err))
+		return false
+	}
+	checker := &columnRefChecker{defaultDB: defaultDB, schema: schema, table: table, colName: colName}
+	stmt.Accept(checker)
+	return checker.refTable && checker.refColumn
+}
+
+type columnRefChecker struct {
+	defaultDB string
+	schema    string
+	table     string
+	colName   string
+
+	refTable  bool
+	refColumn bool
+}
+
+// Enter implements ast.Visitor interface.
+func (c *columnRefChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.TableName:
+		schema := x.Schema.L
+		if schema == "" {
+			schema = c.defaultDB
+		}
+		if schema == c.schema && x.Name.L == c.table {
+			c.refTable = true
+		}
+	case *ast.ColumnName:
+		if x.Name.L == c.colName {
+			c.refColumn = true
+		}
+	case *ast.SelectField:
+		if x.WildCard != nil {
+			c.refColumn = true
+		}
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (c *columnRefChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// checkColumnDependents checks the objects which depend on the dropped or renamed column. It does nothing unless
+// tidb_enable_ddl_dependency_check is on. If cascade is true, the dependent objects are returned to be dropped
+// together with the column, otherwise an error with the dependent objects is returned. If rename is true, the views
+// and the expression indexes are not checked, since they're rewritten together with the renamed column.
+func (d *ddl) checkColumnDependents(sctx sessionctx.Context, schema *model.DBInfo, tblInfo *model.TableInfo,
+	colName model.CIStr, cascade, rename bool) ([]*dependentObject, error) {
+	if !sctx.GetSessionVars().EnableDDLDependencyCheck {
+		return nil, nil
+	}
+	if cascade && sctx.GetSessionVars().StmtCtx.MultiSchemaInfo != nil {
+		return nil, dbterror.ErrRunMultiSchemaChanges.GenWithStackByArgs("drop column with CASCADE")
+	}
+	objs, err := collectColumnDependents(sctx, d.infoCache.GetLatest(), schema, tblInfo, colName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if rename {
+		checked := objs[:0]
+		for _, obj := range objs {
+			if obj.tp != dependentView && obj.tp != dependentExpressionIndex {
+				checked = append(checked, obj)
+			}
+		}
+		objs = checked
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	if !cascade {
+		return nil, dbterror.ErrColumnHasDependents.GenWithStackByArgs(colName.O, dependentObjectsToString(objs))
+	}
+	return objs, nil
+}
+
+// cascadedInfoSchema hides the foreign keys which are dropped by CASCADE together with the column.
+type cascadedInfoSchema struct {
+	infoschema.InfoSchema
+	dependents []*dependentObject
+}
+
+// GetTableReferredForeignKeys implements the infoschema.InfoSchema interface.
+func (is cascadedInfoSchema) GetTableReferredForeignKeys(schema, table string) []*model.ReferredFKInfo {
+	fks := is.InfoSchema.GetTableReferredForeignKeys(schema, table)
+	result := make([]*model.ReferredFKInfo, 0, len(fks))
+	for _, fk := range fks {
+		if !isDependentOfTable(is.dependents, dependentForeignKey, fk.ChildSchema, fk.ChildTable, fk.ChildFKName) {
+			result = append(result, fk)
+		}
+	}
+	return result
+}
+
+// tableWithoutDependents returns the table as if the expression indexes and the foreign keys which depend on the
+// dropped column have been dropped, it's used to check whether the column can be dropped before dropping anything.
+func (d *ddl) tableWithoutDependents(schema *model.DBInfo, t table.Table, dependents []*dependentObject) (table.Table, error) {
+	tblInfo := t.Meta().Clone()
+	droppedHiddenCols := make(map[string]struct{})
+	indices := tblInfo.Indices[:0]
+	for _, idx := range tblInfo.Indices {
+		if isDependentOfTable(dependents, dependentExpressionIndex, schema.Name, tblInfo.Name, idx.Name) {
+			for _, idxCol := range idx.Columns {
+				droppedHiddenCols[idxCol.Name.L] = struct{}{}
+			}
+			continue
+		}
+		indices = append(indices, idx)
+	}
+	tblInfo.Indices = indices
+	for _, col := range tblInfo.Columns {
+		if _, ok := droppedHiddenCols[col.Name.L]; ok && col.Hidden {
+			// Keep the column offsets unchanged, the hidden column doesn't depend on anything once its index is dropped.
+			col.Dependences = nil
+		}
+	}
+	fks := tblInfo.ForeignKeys[:0]
+	for _, fk := range tblInfo.ForeignKeys {
+		if !isDependentOfTable(dependents, dependentForeignKey, schema.Name, tblInfo.Name, fk.Name) {
+			fks = append(fks, fk)
+		}
+	}
+	tblInfo.ForeignKeys = fks
+	return getTable(d.store, schema.ID, tblInfo)
+}
+
+func isDependentOfTable(dependents []*dependentObject, tp dependentObjectType, schema, table, name model.CIStr) bool {
+	for _, obj := range dependents {
+		if obj.tp == tp && obj.schema.L == schema.L && obj.table.L == table.L && obj.name.L == name.L {
+			return true
+		}
+	}
+	return false
+}
+
+// dropColumnDependents drops the objects which depend on the column. It's called after the column is checked
+// droppable, so the dependent objects aren't dropped if the column can't be dropped.
+func (d *ddl) dropColumnDependents(sctx sessionctx.Context, tblInfo *model.TableInfo, colName model.CIStr,
+	objs []*dependentObject) error {
+	logutil.BgLogger().Info("[ddl] drop the objects which depend on the column", zap.String("table", tblInfo.Name.O),
+		zap.String("column", colName.O), zap.String("dependents", dependentObjectsToString(objs)))
+	ddlQuery, _ := sctx.Value(sessionctx.QueryString).(string)
+	defer sctx.SetValue(sessionctx.QueryString, ddlQuery)
+	for _, obj := range objs {
+		if err := d.dropDependentObject(sctx, obj); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (d *ddl) dropDependentObject(sctx sessionctx.Context, obj *dependentObject) error {
+	ident := ast.Ident{Schema: obj.schema, Name: obj.table}
+	switch obj.tp {
+	case dependentView:
+		sctx.SetValue(sessionctx.QueryString, fmt.Sprintf("DROP VIEW IF EXISTS `%s`.`%s`", obj.schema.O, obj.table.O))
+		tn := &ast.TableName{Schema: obj.schema, Name: obj.table}
+		return d.dropTableObject(sctx, []*ast.TableName{tn}, true, viewObject)
+	case dependentForeignKey:
+		sctx.SetValue(sessionctx.QueryString, fmt.Sprintf("ALTER TABLE `%s`.`%s` DROP FOREIGN KEY `%s`", obj.schema.O, obj.table.O, obj.name.O))
+		return d.DropForeignKey(sctx, ident, obj.name)
+	case dependentExpressionIndex:
+		sctx.SetValue(sessionctx.QueryString, fmt.Sprintf("ALTER TABLE `%s`.`%s` DROP INDEX `%s`", obj.schema.O, obj.table.O, obj.name.O))
+		return d.dropIndex(sctx, ident, obj.name, true, false)
+	default:
+		// The binding handle of each TiDB removes the deleted bindings when it loads the updated bindings.
+		ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+		_, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil,
+			"UPDATE mysql.bind_info SET status = 'deleted', update_time = NOW(3) WHERE original_sql = %? AND default_db = %? AND status IN ('enabled', 'using')",
+			obj.originalSQL, obj.defaultDB)
+		return errors.Trace(err)
+	}
+}
+
+// renameColumnDependents renames the column in the expression indexes and the check constraints of the table, and in
+// the definitions of the views which select from the table. It returns the rewritten views, which are updated
+// together with the table in the same DDL job.
+func renameColumnDependents(d *ddlCtx, t *meta.Meta, dbInfo *model.DBInfo, tblInfo *model.TableInfo,
+	oldName, newName model.CIStr) ([]schemaIDAndTableInfo, error) {
+	// The expressions are restored in the same way as they're created.
+	exprIdxFlags := format.RestoreStringSingleQuotes | format.RestoreKeyWordLowercase | format.RestoreNameBackQuotes |
+		format.RestoreSpacesAroundBinaryOperation | format.RestoreWithoutSchemaName | format.RestoreWithoutTableName
+	for _, col := range tblInfo.Columns {
+		if _, ok := col.Dependences[oldName.L]; !ok || !col.Hidden {
+			continue
+		}
+		exprStr, err := renameColumnInExpr(col.GeneratedExprString, oldName, newName, exprIdxFlags)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		col.GeneratedExprString = exprStr
+		delete(col.Dependences, oldName.L)
+		col.Dependences[newName.L] = struct{}{}
+	}
+	constraintFlags := format.RestoreStringSingleQuotes | format.RestoreKeyWordLowercase | format.RestoreNameBackQuotes |
+		format.RestoreSpacesAroundBinaryOperation
+	for _, constraintInfo := range tblInfo.Constraints {
+		if !containsColumnName(constraintInfo.ConstraintCols, oldName) {
+			continue
+		}
+		exprStr, err := renameColumnInExpr(constraintInfo.ExprString, oldName, newName, constraintFlags)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		constraintInfo.ExprString = exprStr
+		for i, colName := range constraintInfo.ConstraintCols {
+			if colName.L == oldName.L {
+				constraintInfo.ConstraintCols[i] = newName
+			}
+		}
+	}
+
+	is, err := getAndCheckLatestInfoSchema(d, t)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var views []schemaIDAndTableInfo
+	for _, db := range is.AllSchemas() {
+		for _, view := range is.SchemaTables(db.Name) {
+			if !view.Meta().IsView() {
+				continue
+			}
+			viewInfo, err := t.GetTable(db.ID, view.Meta().ID)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if viewInfo == nil {
+				continue
+			}
+			selectStmt, ok := rewriteViewColumnName(viewInfo, db.Name, dbInfo.Name, tblInfo.Name, oldName, newName)
+			if ok {
+				viewInfo.View.SelectStmt = selectStmt
+				views = append(views, schemaIDAndTableInfo{schemaID: db.ID, tblInfo: viewInfo})
+			}
+		}
+	}
+	return views, nil
+}
+
+// renameColumnInExpr renames the column in the expression of an expression index or a check constraint.
+func renameColumnInExpr(exprStr string, oldName, newName model.CIStr, restoreFlags format.RestoreFlags) (string, error) {
+	expr, err := generatedexpr.ParseExpression(exprStr)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	expr.Accept(&exprColumnRenamer{oldName: oldName, newName: newName})
+	var sb strings.Builder
+	if err = expr.Restore(format.NewRestoreCtx(restoreFlags, &sb)); err != nil {
+		return "", errors.Trace(err)
+	}
+	return sb.String(), nil
+}
+
+// exprColumnRenamer renames the column in an expression of the table, which can't refer to the other tables.
+type exprColumnRenamer struct {
+	oldName model.CIStr
+	newName model.CIStr
+}
+
+// Enter implements ast.Visitor interface.
+func (r *exprColumnRenamer) Enter(in ast.Node) (ast.Node, bool) {
+	if x, ok := in.(*ast.ColumnName); ok && x.Name.L == r.oldName.L {
+		x.Name = r.newName
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (*exprColumnRenamer) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// rewriteViewColumnName renames the column of the table in the definition of the view. The view which can't be
+// parsed is left as it is, like the view which refers to a dropped column.
+func rewriteViewColumnName(viewInfo *model.TableInfo, viewSchema, schema, table, oldName, newName model.CIStr) (string, bool) {
+	stmt, err := parser.New().ParseOneStmt(viewInfo.View.SelectStmt, viewInfo.Charset, viewInfo.Collate)
+	if err != nil {
+		logutil.BgLogger().Warn("[ddl] cannot parse the view to rename the column", zap.String("view", viewInfo.Name.O),
+			zap.String("SQL", viewInfo.View.SelectStmt), zap.Error(err))
+		return "", false
+	}
+	renamer := &viewColumnRenamer{defaultDB: viewSchema.L, schema: schema.L, table: table.L, oldName: oldName, newName: newName}
+	stmt.Accept(renamer)
+	if !renamer.changed {
+		return "", false
+	}
+	selectStmt, err := restoreViewSelectStmt(stmt)
+	if err != nil {
+		logutil.BgLogger().Warn("[ddl] cannot restore the view to rename the column", zap.String("view", viewInfo.Name.O),
+			zap.String("SQL", viewInfo.View.SelectStmt), zap.Error(err))
+		return "", false
+	}
+	return selectStmt, true
+}
+
+// viewColumnRenamer renames the column of the table in the definition of a view. An unqualified column name is
+// renamed in the query blocks which select from the table directly, and a qualified one is renamed if its qualifier
+// refers to the table in the innermost query block which has the qualifier. The output names of the view are kept
+// by aliasing the renamed columns in the select fields.
+type viewColumnRenamer struct {
+	defaultDB string
+	schema    string
+	table     string
+	oldName   model.CIStr
+	newName   model.CIStr
+	// scopes map the names of the tables in the query blocks being visited to whether they're the table.
+	scopes  []map[string]bool
+	changed bool
+}
+
+// Enter implements ast.Visitor interface.
+func (r *viewColumnRenamer) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.SelectStmt:
+		scope := make(map[string]bool)
+		if x.From != nil {
+			r.collectTables(x.From.TableRefs, scope)
+		}
+		r.scopes = append(r.scopes, scope)
+	case *ast.SelectField:
+		if colExpr, ok := x.Expr.(*ast.ColumnNameExpr); ok && x.AsName.L == "" && r.refersToColumn(colExpr.Name) {
+			x.AsName = colExpr.Name.Name
+		}
+	case *ast.ColumnName:
+		if r.refersToColumn(x) {
+			x.Name = r.newName
+			r.changed = true
+		}
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (r *viewColumnRenamer) Leave(in ast.Node) (ast.Node, bool) {
+	if _, ok := in.(*ast.SelectStmt); ok {
+		r.scopes = r.scopes[:len(r.scopes)-1]
+	}
+	return in, true
+}
+
+func (r *viewColumnRenamer) collectTables(node ast.ResultSetNode, scope map[string]bool) {
+	switch x := node.(type) {
+	case *ast.Join:
+		r.collectTables(x.Left, scope)
+		if x.Right != nil {
+			r.collectTables(x.Right, scope)
+		}
+	case *ast.TableSource:
+		tn, isTable := x.Source.(*ast.TableName)
+		name := x.AsName.L
+		if name == "" && isTable {
+			name = tn.Name.L
+		}
+		if name != "" {
+			scope[name] = isTable && r.isTable(tn)
+		}
+	}
+}
+
+func (r *viewColumnRenamer) isTable(tn *ast.TableName) bool {
+	schema := tn.Schema.L
+	if schema == "" {
+		schema = r.defaultDB
+	}
+	return schema == r.schema && tn.Name.L == r.table
+}
+
+func (r *viewColumnRenamer) refersToColumn(col *ast.ColumnName) bool {
+	if col.Name.L != r.oldName.L || len(r.scopes) == 0 {
+		return false
+	}
+	if col.Table.L == "" {
+		for _, isTable := range r.scopes[len(r.scopes)-1] {
+			if isTable {
+				return true
+			}
+		}
+		return false
+	}
+	if col.Schema.L != "" && col.Schema.L != r.schema {
+		return false
+	}
+	for i := len(r.scopes) - 1; i >= 0; i-- {
+		if isTable, ok := r.scopes[i][col.Table.L]; ok {
+			return isTable
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/testkit"
+)
+
+func TestDropColumnWithDependents(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int, key idx((b + 1)))")
+	tk.MustExec("create view v1 as select a, b from t")
+	tk.MustExec("create view v2 as select * from t")
+	tk.MustExec("create view v3 as select a from t")
+	tk.MustExec("create global binding for select a from t where c = 1 using select a from t ignore index(idx) where c = 1")
+
+	// The dependency check is disabled by default, and CASCADE drops nothing.
+	tk.MustGetErrCode("alter table t drop column b", errno.ErrDependentByFunctionalIndex)
+	tk.MustGetErrCode("alter table t drop column b cascade", errno.ErrDependentByFunctionalIndex)
+	tk.MustQuery("select count(*) from information_schema.views where table_schema = 'test'").Check(testkit.Rows("3"))
+
+	tk.MustExec("set @@tidb_enable_ddl_dependency_check = on")
+	tk.MustGetErrMsg("alter table t drop column b",
+		"[ddl:8253]Column 'b' is referenced by expression index idx on test.t, view test.v1, view test.v2, drop the dependent objects first or use CASCADE")
+	tk.MustGetErrCode("alter table t rename column a to d", errno.ErrColumnHasDependents)
+	tk.MustGetErrCode("alter table t change column c d int", errno.ErrColumnHasDependents)
+
+	// Nothing is dropped if the column can't be dropped for the other reasons.
+	tk.MustExec("create table t2 (a int, b int, key idx_ab(a, b))")
+	tk.MustExec("create view v4 as select b from t2")
+	tk.MustGetErrCode("alter table t2 drop column b cascade", errno.ErrUnsupportedDDLOperation)
+	tk.MustQuery("select table_name from information_schema.views where table_schema = 'test' and table_name = 'v4'").Check(testkit.Rows("v4"))
+
+	tk.MustExec("alter table t drop column b cascade")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  `c` int(11) DEFAULT NULL\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustQuery("select table_name from information_schema.views where table_schema = 'test' order by table_name").Check(testkit.Rows("v3", "v4"))
+	tk.MustQuery("select * from v3").Check(testkit.Rows())
+
+	tk.MustExec("alter table t drop column c cascade")
+	tk.MustQuery("select status from mysql.bind_info where source != 'builtin'").Check(testkit.Rows("deleted"))
+
+	// The foreign keys which refer to the column are dropped with CASCADE.
+	tk.MustExec("create table parent (id int primary key, x int)")
+	tk.MustExec("create table child (id int, pid int, foreign key fk_pid (pid) references parent(id))")
+	tk.MustGetErrCode("alter table parent drop column id", errno.ErrColumnHasDependents)
+	tk.MustGetErrCode("alter table parent drop column id cascade, drop column x", errno.ErrUnsupportedDDLOperation)
+	tk.MustExec("alter table child drop column pid cascade")
+	tk.MustQuery("select count(*) from information_schema.referential_constraints where table_name = 'child'").Check(testkit.Rows("0"))
+}
+
+func TestRenameColumnWithDependents(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_enable_check_constraint = on")
+	defer tk.MustExec("set global tidb_enable_check_constraint = off")
+	tk.MustExec("create table t (a int, b int, key idx((a + b)))")
+	tk.MustExec("alter table t add constraint chk check (a > 0)")
+	tk.MustExec("create table t2 (a int)")
+	tk.MustExec("insert into t values (1, 10), (2, 20)")
+	tk.MustExec("insert into t2 values (1)")
+	tk.MustExec("create view v1 as select a, b from t where a > 1")
+	tk.MustExec("create view v2 as select t.a, t2.a as a2 from t join t2 on t.a = t2.a")
+	tk.MustExec("create view v3 as select a from (select a + 1 as a from t) s")
+	tk.MustExec("create table t3 (b int, c int generated always as (b + 1))")
+
+	// The views, the expression indexes and the check constraints are rewritten with the renamed column.
+	tk.MustExec("set @@tidb_enable_ddl_dependency_check = on")
+	tk.MustExec("alter table t rename column a to x")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `x` int(11) DEFAULT NULL,\n" +
+		"  `b` int(11) DEFAULT NULL,\n" +
+		"  KEY `idx` ((`x` + `b`)),\n" +
+		"  CONSTRAINT `chk` CHECK ((`x` > 0))\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustGetErrCode("insert into t values (0, 1)", errno.ErrCheckConstraintViolated)
+	tk.MustExec("insert into t values (3, 30)")
+	tk.MustQuery("select x + b from t use index(idx) where x + b > 20 order by x").Check(testkit.Rows("22", "33"))
+	tk.MustExec("admin check table t")
+	tk.MustQuery("select * from v1 order by a").Check(testkit.Rows("2 20", "3 30"))
+	tk.MustQuery("select * from v2").Check(testkit.Rows("1 1"))
+	tk.MustQuery("select * from v3 order by a").Check(testkit.Rows("2", "3", "4"))
+	tk.MustQuery("select count(*) from information_schema.views where view_definition like '%`x`%'").Check(testkit.Rows("3"))
+
+	// The generated columns which depend on the column still block the rename.
+	tk.MustGetErrCode("alter table t3 rename column b to d", errno.ErrDependentByGeneratedColumn)
+}
@@ -15,13 +15,17 @@
 package ddl
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/ddl/ingest"
+	"github.com/pingcap/tidb/domain/infosync"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
@@ -58,6 +62,39 @@ const (
 // RetrySQLInterval is export for test.
 var RetrySQLInterval = 300 * time.Millisecond
 
+// matchReorgWorkerLabel checks whether the labels of this TiDB server contain the worker label of the backfill jobs.
+// An empty worker label matches all the servers.
+func matchReorgWorkerLabel(workerLabel string) bool {
+	return hasReorgWorkerLabel(config.GetGlobalConfig().Labels, workerLabel)
+}
+
+func hasReorgWorkerLabel(labels map[string]string, workerLabel string) bool {
+	if workerLabel == "" {
+		return true
+	}
+	key, value, _ := strings.Cut(workerLabel, "=")
+	v, ok := labels[strings.TrimSpace(key)]
+	return ok && v == strings.TrimSpace(value)
+}
+
+// checkReorgWorkerLabel checks that there is at least one TiDB server which can run the backfill jobs with the
+// worker label, otherwise the backfill jobs are never run.
+func checkReorgWorkerLabel(workerLabel string) error {
+	if workerLabel == "" {
+		return nil
+	}
+	serverInfos, err := infosync.GetAllServerInfo(context.Background())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, info := range serverInfos {
+		if hasReorgWorkerLabel(info.Labels, workerLabel) {
+			return nil
+		}
+	}
+	return dbterror.ErrCancelledDDLJob.GenWithStack("no TiDB server has the reorg worker label %s", workerLabel)
+}
+
 func backfillJobPrefixKeyString(ddlJobID int64, eleKey kv.Key, eleID int64) string {
 	return fmt.Sprintf(backfillJobPrefixKey, ddlJobID, hex.EncodeToString(eleKey), eleID)
 }
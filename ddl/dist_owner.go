@@ -53,6 +53,7 @@ func initDistReorg(reorgMeta *model.DDLReorgMeta) {
 	isDistReorg := variable.DDLEnableDistributeReorg.Load()
 	reorgMeta.IsDistReorg = isDistReorg
 	if isDistReorg {
+		reorgMeta.WorkerLabel = variable.DDLReorgWorkerLabel.Load()
 		metrics.TelemetryDistReorgCnt.Inc()
 	}
 }
@@ -207,6 +208,9 @@ func (dc *ddlCtx) controlWriteTableRecord(sessPool *sessionPool, t table.Table,
 	if err := dc.isReorgRunnable(ddlJobID, true); err != nil {
 		return errors.Trace(err)
 	}
+	if err := checkReorgWorkerLabel(reorgInfo.ReorgMeta.WorkerLabel); err != nil {
+		return errors.Trace(err)
+	}
 	var isUnique bool
 	if bfWorkerType == typeAddIndexWorker {
 		idxInfo := model.FindIndexInfoByID(t.Meta().Indices, currEle.ID)
@@ -289,16 +293,18 @@ func addBatchBackfillJobs(sess *session, reorgInfo *reorgInfo, sJobCtx *splitJob
 	// TODO: Adjust the number of ranges(region) for each task.
 	for _, task := range batchTasks {
 		bm := &model.BackfillMeta{
-			IsUnique:   sJobCtx.isUnique,
-			EndInclude: task.endInclude,
-			ReorgTp:    reorgInfo.Job.ReorgMeta.ReorgTp,
-			SQLMode:    reorgInfo.ReorgMeta.SQLMode,
-			Location:   reorgInfo.ReorgMeta.Location,
+			IsUnique:    sJobCtx.isUnique,
+			EndInclude:  task.endInclude,
+			ReorgTp:     reorgInfo.Job.ReorgMeta.ReorgTp,
+			SQLMode:     reorgInfo.ReorgMeta.SQLMode,
+			Location:    reorgInfo.ReorgMeta.Location,
+			WorkerLabel: reorgInfo.ReorgMeta.WorkerLabel,
 			JobMeta: &model.JobMeta{
 				SchemaID: reorgInfo.Job.SchemaID,
 				TableID:  reorgInfo.Job.TableID,
 				Type:     reorgInfo.Job.Type,
 				Query:    reorgInfo.Job.Query,
+				Labels:   reorgInfo.Job.Labels,
 			},
 			StartKey: task.startKey,
 			EndKey:   task.endKey,
@@ -587,7 +587,7 @@ func TestIssue38699(t *testing.T) {
 	tk.MustExec("DROP TABLE IF EXISTS t;")
 	tk.MustExec("CREATE TABLE t (a int)")
 	tk.MustExec("insert into t values (1000000000), (2000000)")
-	tk.MustExec("alter table t modify a tinyint")
+	tk.MustExec("alter table t modify a tinyint, force")
 	result := tk.MustQuery("show warnings")
 	require.Len(t, result.Rows(), 1)
 	result.CheckWithFunc(testkit.Rows("Warning 1690 2 warnings with this error code"), func(actual []string, expected []interface{}) bool {
@@ -599,7 +599,7 @@ func TestIssue38699(t *testing.T) {
 	tk.MustExec("DROP TABLE IF EXISTS t;")
 	tk.MustExec("CREATE TABLE t (a int)")
 	tk.MustExec("insert into t values (1000000000)")
-	tk.MustExec("alter table t modify a tinyint")
+	tk.MustExec("alter table t modify a tinyint, force")
 	result = tk.MustQuery("show warnings")
 	require.Len(t, result.Rows(), 1)
 	result.Check(testkit.Rows("Warning 1690 constant 1000000000 overflows tinyint"))
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -29,6 +30,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/ddl/ingest"
+	ddlutil "github.com/pingcap/tidb/ddl/util"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/meta"
@@ -272,7 +274,18 @@ func BuildIndexInfo(
 		return nil, errors.Trace(err)
 	}
 
-	idxColumns, mvIndex, err := buildIndexColumns(ctx, allTableColumns, indexPartSpecifications)
+	var (
+		idxColumns []*model.IndexColumn
+		mvIndex    bool
+		err        error
+	)
+	if indexOption != nil && indexOption.Tp == model.IndexTypeFulltext {
+		idxColumns, err = buildFulltextIndexColumns(allTableColumns, indexPartSpecifications)
+	} else if isColumnarIndexOption(indexOption) {
+		idxColumns, err = buildColumnarIndexColumns(allTableColumns, indexPartSpecifications, isPrimary, isUnique)
+	} else {
+		idxColumns, mvIndex, err = buildIndexColumns(ctx, allTableColumns, indexPartSpecifications)
+	}
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -616,7 +629,12 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 	switch indexInfo.State {
 	case model.StateNone:
 		// none -> delete only
-		reorgTp := pickBackfillType(job)
+		if (job.ReorgMeta.Instant || tblInfo.TempTableType == model.TempTableGlobal) && job.ReorgMeta.ReorgTp == model.ReorgTypeNone {
+			// The DML writes the index directly instead of the temporary index, so the index
+			// needs nothing to be merged if it's added instantly or has nothing to backfill.
+			job.ReorgMeta.ReorgTp = model.ReorgTypeTxn
+		}
+		reorgTp := pickBackfillType(job, indexInfo)
 		if reorgTp.NeedMergeProcess() {
 			// Increase telemetryAddIndexIngestUsage
 			telemetryAddIndexIngestUsage.Inc()
@@ -642,6 +660,24 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 		}
 		job.SchemaState = model.StateWriteOnly
 	case model.StateWriteOnly:
+		if tblInfo.TempTableType == model.TempTableGlobal && job.MultiSchemaInfo == nil {
+			// The rows of a global temporary table only live in the transactions, and they aren't stored in TiKV.
+			// The transactions write the index from now on, so there is nothing to backfill.
+			// write only -> public
+			return finishCreateIndex(d, t, job, tblInfo, indexInfo, isPK)
+		}
+		if job.ReorgMeta.Instant && job.MultiSchemaInfo == nil {
+			// All the servers write the index now, so the index needs no backfill if the table is empty.
+			empty, err := isTableEmpty(d.store, tblInfo)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			if empty {
+				// write only -> public
+				return finishCreateIndex(d, t, job, tblInfo, indexInfo, isPK)
+			}
+			logutil.BgLogger().Info("[ddl] the table is not empty, fallback to backfill the index", zap.Int64("jobID", job.ID))
+		}
 		// write only -> reorganization
 		indexInfo.State = model.StateWriteReorganization
 		_, err = checkPrimaryKeyNotNull(d, w, t, job, tblInfo, indexInfo)
@@ -656,11 +692,14 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 		job.SnapshotVer = 0
 		job.SchemaState = model.StateWriteReorganization
 
-		if job.MultiSchemaInfo == nil {
+		if job.MultiSchemaInfo == nil && indexInfo.Tp != model.IndexTypeFulltext && indexInfo.Tp != model.IndexTypeColumnar {
 			initDistReorg(job.ReorgMeta)
 		}
 	case model.StateWriteReorganization:
 		// reorganization -> public
+		if indexInfo.Tp == model.IndexTypeColumnar {
+			return w.populateColumnarIndex(d, t, job, tblInfo, indexInfo, isPK)
+		}
 		tbl, err := getTable(d.store, schemaID, tblInfo)
 		if err != nil {
 			return ver, errors.Trace(err)
@@ -679,40 +718,91 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 		if !done {
 			return ver, err
 		}
+		return finishCreateIndex(d, t, job, tblInfo, indexInfo, isPK)
+	default:
+		err = dbterror.ErrInvalidDDLState.GenWithStackByArgs("index", tblInfo.State)
+	}
 
-		// Set column index flag.
-		AddIndexColumnFlag(tblInfo, indexInfo)
-		if isPK {
-			if err = UpdateColsNull2NotNull(tblInfo, indexInfo); err != nil {
-				return ver, errors.Trace(err)
-			}
+	return ver, errors.Trace(err)
+}
+
+// finishCreateIndex makes the index public and finishes the job.
+func finishCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo, indexInfo *model.IndexInfo, isPK bool) (ver int64, err error) {
+	// Set column index flag.
+	AddIndexColumnFlag(tblInfo, indexInfo)
+	if isPK {
+		if err = UpdateColsNull2NotNull(tblInfo, indexInfo); err != nil {
+			return ver, errors.Trace(err)
 		}
-		indexInfo.State = model.StatePublic
-		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != indexInfo.State)
+	}
+	indexInfo.State = model.StatePublic
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.Args = []interface{}{indexInfo.ID, false /*if exists*/, getPartitionIDs(tblInfo)}
+	// Finish this job.
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	if job.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge {
+		ingest.LitBackCtxMgr.Unregister(job.ID)
+	}
+	if isExpressionIndex(tblInfo, indexInfo) {
+		// The statistics of the hidden expression columns can't be collected without the index,
+		// so notify the statistics handle to analyze the new index.
+		asyncNotifyEvent(d, &ddlutil.Event{Tp: model.ActionAddIndex, SchemaName: model.NewCIStr(job.SchemaName), TableInfo: tblInfo, IndexInfo: indexInfo})
+	}
+	return ver, nil
+}
+
+// isTableEmpty returns whether all the physical tables of the table have no records at the latest version.
+func isTableEmpty(store kv.Storage, tblInfo *model.TableInfo) (bool, error) {
+	ver, err := store.CurrentVersion(kv.GlobalTxnScope)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	snap := store.GetSnapshot(ver)
+	snap.SetOption(kv.RequestSourceInternal, true)
+	snap.SetOption(kv.RequestSourceType, kv.InternalTxnDDL)
+	physicalIDs := getPartitionIDs(tblInfo)
+	if len(physicalIDs) == 0 {
+		physicalIDs = []int64{tblInfo.ID}
+	}
+	for _, pid := range physicalIDs {
+		prefix := tablecodec.GenTableRecordPrefix(pid)
+		it, err := snap.Iter(prefix, prefix.PrefixNext())
 		if err != nil {
-			return ver, errors.Trace(err)
+			return false, errors.Trace(err)
 		}
-		job.Args = []interface{}{indexInfo.ID, false /*if exists*/, getPartitionIDs(tbl.Meta())}
-		// Finish this job.
-		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
-		if job.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge {
-			ingest.LitBackCtxMgr.Unregister(job.ID)
+		empty := !it.Valid() || !it.Key().HasPrefix(prefix)
+		it.Close()
+		if !empty {
+			return false, nil
 		}
-	default:
-		err = dbterror.ErrInvalidDDLState.GenWithStackByArgs("index", tblInfo.State)
 	}
+	return true, nil
+}
 
-	return ver, errors.Trace(err)
+// isExpressionIndex checks whether the index contains hidden columns which are built from expressions.
+func isExpressionIndex(tblInfo *model.TableInfo, indexInfo *model.IndexInfo) bool {
+	for _, col := range indexInfo.Columns {
+		if tblInfo.Columns[col.Offset].Hidden {
+			return true
+		}
+	}
+	return false
 }
 
 // pickBackfillType determines which backfill process will be used.
-func pickBackfillType(job *model.Job) model.ReorgType {
+func pickBackfillType(job *model.Job, indexInfo *model.IndexInfo) model.ReorgType {
 	if job.ReorgMeta.ReorgTp != model.ReorgTypeNone {
-		// The backfill task has been started.
-		// Don't switch the backfill process.
+		// The backfill task has been started. The backfill process can only be
+		// switched between ingest and txn-merge at the reorg checkpoint.
 		return job.ReorgMeta.ReorgTp
 	}
-	if IsEnableFastReorg() {
+	// The fulltext index is backfilled by its dedicated backfiller, which writes the index entries
+	// in transactions directly, and the columnar index is populated by the TiFlash replicas, so
+	// neither ingest nor txn-merge is used.
+	if IsEnableFastReorg() && indexInfo.Tp != model.IndexTypeFulltext && indexInfo.Tp != model.IndexTypeColumnar {
 		var useIngest bool
 		if ingest.LitInitialized {
 			useIngest = canUseIngest()
@@ -771,18 +861,129 @@ func IngestJobsNotExisted(ctx sessionctx.Context) bool {
 	return true
 }
 
+// ingestEnvErrs are the errors caused by the ingest environment of the TiDB node rather than the data,
+// retrying the ingest backfill is likely to meet them again.
+var ingestEnvErrs = []string{
+	ingest.LitErrAllocMemFail,
+	ingest.LitErrCreateDirFail,
+	ingest.LitErrStatDirFail,
+	ingest.LitErrCreateBackendFail,
+	ingest.LitErrGetBackendFail,
+	ingest.LitErrCreateEngineFail,
+	ingest.LitErrCreateContextFail,
+	ingest.LitErrGetStorageQuota,
+	ingest.LitErrExceedConcurrency,
+	ingest.LitErrUpdateDiskStats,
+	syscall.ENOSPC.Error(),
+}
+
+func isIngestEnvErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, envErr := range ingestEnvErrs {
+		if strings.Contains(msg, envErr) {
+			return true
+		}
+	}
+	return false
+}
+
+// countIngestEnvErr counts the ingest environment errors met by the job.
+func countIngestEnvErr(job *model.Job, err error) {
+	if isIngestEnvErr(err) {
+		job.ReorgMeta.IngestEnvErrCount++
+	}
+}
+
+// ingestDisabledByEnvErrs indicates whether the job has met too many ingest environment errors,
+// then it keeps using the txn-merge backfill instead of switching back to ingest again and again.
+func ingestDisabledByEnvErrs(job *model.Job) bool {
+	threshold := int(variable.DDLIngestFallbackThreshold.Load())
+	return threshold > 0 && job.ReorgMeta.IngestEnvErrCount >= threshold
+}
+
 // tryFallbackToTxnMerge changes the reorg type to txn-merge if the lightning backfill meets something wrong.
 func tryFallbackToTxnMerge(job *model.Job, err error) error {
 	if job.State != model.JobStateRollingback {
-		logutil.BgLogger().Info("[ddl] fallback to txn-merge backfill process", zap.Error(err))
-		job.ReorgMeta.ReorgTp = model.ReorgTypeTxnMerge
-		job.SnapshotVer = 0
-		job.RowCount = 0
+		countIngestEnvErr(job, err)
+		fallbackToTxnMerge(job, err)
 		return nil
 	}
 	return err
 }
 
+// fallbackToTxnMerge restarts the backfill with the txn-merge backfill process.
+func fallbackToTxnMerge(job *model.Job, err error) {
+	logutil.BgLogger().Info("[ddl] fallback to txn-merge backfill process", zap.Error(err),
+		zap.Int("ingest environment error count", job.ReorgMeta.IngestEnvErrCount))
+	job.ReorgMeta.ReorgTp = model.ReorgTypeTxnMerge
+	job.ReorgMeta.Progress = nil
+	job.SnapshotVer = 0
+	job.RowCount = 0
+}
+
+// switchToTxnMergeAtCheckpoint imports the index records written to the ingest engine so far, and then switches
+// the backfill process to txn-merge. Both processes write the backfilled records to the index directly, so the
+// txn-merge backfill can resume from the reorg checkpoint instead of restarting from the beginning.
+// It only switches on the ingest environment errors, and returns false for the other errors or if the records
+// cannot be imported, then the caller should handle the error as before.
+func switchToTxnMergeAtCheckpoint(job *model.Job, bc *ingest.BackendContext, tbl table.Table,
+	indexInfo *model.IndexInfo, err error) bool {
+	if job.State == model.JobStateRollingback || job.SnapshotVer == 0 || !isIngestEnvErr(err) {
+		return false
+	}
+	if importErr := bc.FinishImport(indexInfo.ID, indexInfo.Unique, tbl); importErr != nil {
+		logutil.BgLogger().Warn("[ddl] cannot import the ingested index records, restart the backfill",
+			zap.Int64("job ID", job.ID), zap.Error(importErr))
+		return false
+	}
+	countIngestEnvErr(job, err)
+	logutil.BgLogger().Info("[ddl] switch to txn-merge backfill process at the checkpoint",
+		zap.Int64("job ID", job.ID), zap.Error(err), zap.Int("ingest environment error count", job.ReorgMeta.IngestEnvErrCount))
+	job.ReorgMeta.ReorgTp = model.ReorgTypeTxnMerge
+	return true
+}
+
+// trySwitchToIngestAtCheckpoint switches the txn-merge backfill process to ingest when the lightning environment
+// becomes available again. The switch only happens before the reorg goroutine runs, and the ingest backfill
+// resumes from the reorg checkpoint because the records before it have been committed by transactions.
+// The job keeps using txn-merge on the ingest environment errors, and the other errors are returned.
+func trySwitchToIngestAtCheckpoint(w *worker, job *model.Job, indexInfo *model.IndexInfo) (bool, error) {
+	failpoint.Inject("mockSwitchToIngestErr", func(val failpoint.Value) {
+		//nolint:forcetypeassert
+		failpoint.Return(false, checkSwitchToIngestErr(job, errors.New(val.(string))))
+	})
+	if !IsEnableFastReorg() || !ingest.LitInitialized || job.SnapshotVer == 0 || w.getReorgCtx(job.ID) != nil ||
+		indexInfo.Tp == model.IndexTypeFulltext {
+		return false, nil
+	}
+	if ingestDisabledByEnvErrs(job) {
+		return false, nil
+	}
+	if !canUseIngest() || !ingest.LitBackCtxMgr.DiskAvailable() {
+		return false, nil
+	}
+	// Register the backend context before switching, otherwise the ingest backfill is restarted.
+	if _, err := ingest.LitBackCtxMgr.Register(w.ctx, indexInfo.Unique, job.ID, job.ReorgMeta.SQLMode); err != nil {
+		return false, checkSwitchToIngestErr(job, err)
+	}
+	logutil.BgLogger().Info("[ddl] switch to ingest backfill process at the checkpoint", zap.Int64("job ID", job.ID))
+	job.ReorgMeta.ReorgTp = model.ReorgTypeLitMerge
+	return true, nil
+}
+
+// checkSwitchToIngestErr ignores the ingest environment errors met when switching to ingest,
+// the txn-merge backfill can go on without the ingest environment.
+func checkSwitchToIngestErr(job *model.Job, err error) error {
+	if !isIngestEnvErr(err) {
+		return errors.Trace(err)
+	}
+	logutil.BgLogger().Warn("[ddl] cannot switch to ingest backfill process", zap.Int64("job ID", job.ID), zap.Error(err))
+	return nil
+}
+
 func doReorgWorkForCreateIndexMultiSchema(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
 	tbl table.Table, indexInfo *model.IndexInfo) (done bool, ver int64, err error) {
 	if job.MultiSchemaInfo.Revertible {
@@ -801,7 +1002,7 @@ func doReorgWorkForCreateIndexMultiSchema(w *worker, d *ddlCtx, t *meta.Meta, jo
 
 func doReorgWorkForCreateIndex(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
 	tbl table.Table, indexInfo *model.IndexInfo) (done bool, ver int64, err error) {
-	bfProcess := pickBackfillType(job)
+	bfProcess := pickBackfillType(job, indexInfo)
 	if !bfProcess.NeedMergeProcess() {
 		return runReorgJobAndHandleErr(w, d, t, job, tbl, indexInfo, false)
 	}
@@ -815,6 +1016,15 @@ func doReorgWorkForCreateIndex(w *worker, d *ddlCtx, t *meta.Meta, job *model.Jo
 		case model.ReorgTypeLitMerge:
 			done, ver, err = runIngestReorgJob(w, d, t, job, tbl, indexInfo)
 		case model.ReorgTypeTxnMerge:
+			var switched bool
+			switched, err = trySwitchToIngestAtCheckpoint(w, job, indexInfo)
+			if err != nil {
+				return false, ver, errors.Trace(err)
+			}
+			if switched {
+				// Resume the backfill with ingest in the next round.
+				return false, ver, nil
+			}
 			done, ver, err = runReorgJobAndHandleErr(w, d, t, job, tbl, indexInfo, false)
 		}
 		if err != nil || !done {
@@ -864,6 +1074,10 @@ func runIngestReorgJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
 	}
 	done, ver, err = runReorgJobAndHandleErr(w, d, t, job, tbl, indexInfo, false)
 	if err != nil {
+		if switchToTxnMergeAtCheckpoint(job, bc, tbl, indexInfo, err) {
+			ingest.LitBackCtxMgr.Unregister(job.ID)
+			return false, ver, nil
+		}
 		ingest.LitBackCtxMgr.Unregister(job.ID)
 		err = tryFallbackToTxnMerge(job, err)
 		return false, ver, errors.Trace(err)
@@ -886,13 +1100,14 @@ func runIngestReorgJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
 		ingest.LitBackCtxMgr.Unregister(job.ID)
 		return false, ver, errors.Trace(err)
 	}
+	job.ReorgMeta.Progress = bc.Progress()
 	bc.SetDone()
 	return true, ver, nil
 }
 
 func doReorgWorkForCreateIndexWithDistReorg(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
 	tbl table.Table, indexInfo *model.IndexInfo) (done bool, ver int64, err error) {
-	bfProcess := pickBackfillType(job)
+	bfProcess := pickBackfillType(job, indexInfo)
 	if !bfProcess.NeedMergeProcess() {
 		return runReorgJobAndHandleErr(w, d, t, job, tbl, indexInfo, false)
 	}
@@ -907,6 +1122,14 @@ func doReorgWorkForCreateIndexWithDistReorg(w *worker, d *ddlCtx, t *meta.Meta,
 			done, ver, err = runReorgJobAndHandleErr(w, d, t, job, tbl, indexInfo, false)
 			if err != nil {
 				logutil.BgLogger().Warn("[ddl] dist lightning import error", zap.Error(err))
+				if isIngestEnvErr(err) && job.State != model.JobStateRollingback {
+					// Retry the ingest backfill until the job meets too many environment errors.
+					countIngestEnvErr(job, err)
+					if ingestDisabledByEnvErrs(job) {
+						fallbackToTxnMerge(job, err)
+						return false, ver, nil
+					}
+				}
 				return false, ver, errors.Trace(err)
 			}
 			if !done {
@@ -1311,6 +1534,36 @@ type addIndexTxnWorker struct {
 	recordIdx          []int
 }
 
+func init() {
+	registerBackfiller(typeAddIndexWorker, "add index", newAddIndexBackfiller)
+	registerBackfiller(typeCleanUpIndexWorker, "clean up index", newCleanUpIndexBackfiller)
+}
+
+// newAddIndexBackfiller creates the add index worker in the ingest way or the txn way by the reorg type.
+func newAddIndexBackfiller(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error) {
+	reorgInfo, jc := b.reorgInfo, b.jobCtx
+	job := reorgInfo.Job
+	backfillCtx := newBackfillCtx(reorgInfo.d, id, sessCtx, job.SchemaName, b.tbl, jc, "add_idx_rate", false)
+	if reorgInfo.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge {
+		idxWorker, err := newAddIndexIngestWorker(b.tbl, backfillCtx,
+			job.ID, reorgInfo.currElement.ID, reorgInfo.currElement.TypeKey)
+		if err != nil {
+			if canSkipError(job.ID, len(b.workers), err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		idxWorker.copReqSenderPool = b.copReqSenderPool
+		return idxWorker, nil
+	}
+	idxWorker, err := newAddIndexTxnWorker(b.decodeColMap, b.tbl, backfillCtx,
+		job.ID, reorgInfo.currElement.ID, reorgInfo.currElement.TypeKey)
+	if err != nil {
+		return nil, err
+	}
+	return idxWorker, nil
+}
+
 func newAddIndexTxnWorker(decodeColMap map[int64]decoder.Column, t table.PhysicalTable, bfCtx *backfillCtx, jobID, eleID int64, eleTypeKey []byte) (*addIndexTxnWorker, error) {
 	if !bytes.Equal(eleTypeKey, meta.IndexElementKey) {
 		logutil.BgLogger().Error("Element type for addIndexTxnWorker incorrect",
@@ -1510,6 +1763,9 @@ func (w *baseIndexWorker) fetchRowColVals(txn kv.Transaction, taskRange reorgBac
 			if taskDone || len(w.idxRecords) >= w.batchCnt {
 				return false, nil
 			}
+			if len(w.idxRecords) > 0 && w.shrinkBatchIfMemoryExceeded() {
+				return false, nil
+			}
 
 			// Decode one row, generate records of this row.
 			err := w.updateRowDecoder(handle, rawRow)
@@ -1522,6 +1778,8 @@ func (w *baseIndexWorker) fetchRowColVals(txn kv.Transaction, taskRange reorgBac
 					return false, errors.Trace(err1)
 				}
 				w.idxRecords = append(w.idxRecords, idxRecord)
+				w.consumeBatchMemory(int64(len(idxRecord.key)) +
+					types.EstimatedMemUsage(idxRecord.vals, 1) + types.EstimatedMemUsage(idxRecord.rsData, 1))
 			}
 			// If there are generated column, rowDecoder will use column value that not in idxInfo.Columns to calculate
 			// the generated value, so we need to clear up the reusing map.
@@ -1686,7 +1944,7 @@ func newAddIndexIngestWorker(t table.PhysicalTable, bfCtx *backfillCtx, jobID, e
 	if !ok {
 		return nil, errors.Trace(errors.New(ingest.LitErrGetBackendFail))
 	}
-	ei, err := bc.EngMgr.Register(bc, jobID, eleID, bfCtx.schemaName, t.Meta().Name.O)
+	ei, err := bc.EngMgr.Register(bc, jobID, eleID, t.GetPhysicalID(), bfCtx.schemaName, t.Meta().Name.O)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -1772,6 +2030,8 @@ func (w *addIndexIngestWorker) BackfillData(handleRange reorgBackfillTask) (back
 		return taskCtx, nil
 	}
 	defer w.copReqSenderPool.recycleChunk(copChunk)
+	// The chunk is released after the task is handled.
+	w.consumeBatchMemory(copChunk.MemoryUsage())
 
 	copCtx := w.copReqSenderPool.copCtx
 	vars := w.sessCtx.GetSessionVars()
@@ -1781,6 +2041,8 @@ func (w *addIndexIngestWorker) BackfillData(handleRange reorgBackfillTask) (back
 	}
 	taskCtx.scanCount = count
 	taskCtx.addedCount = count
+	// The index records are written to the local engine, use the size of the rows to estimate their size.
+	taskCtx.addedBytes = int(copChunk.MemoryUsage())
 	return taskCtx, nil
 }
 
@@ -1841,15 +2103,29 @@ func (w *addIndexTxnWorker) BackfillData(handleRange reorgBackfillTask) (taskCtx
 
 	oprStartTime := time.Now()
 	jobID := handleRange.getJobID()
+	verifyChecksum := variable.DDLReorgVerifyChecksum.Load()
+	var (
+		checksum *batchChecksum
+		commitTS uint64
+	)
 	ctx := kv.WithInternalSourceType(context.Background(), w.jobContext.ddlJobSourceType())
 	errInTxn = kv.RunInNewTxn(ctx, w.sessCtx.GetStore(), true, func(ctx context.Context, txn kv.Transaction) (err error) {
 		taskCtx.finishTS = txn.StartTS()
 		taskCtx.addedCount = 0
 		taskCtx.scanCount = 0
 		txn.SetOption(kv.Priority, handleRange.priority)
+		if verifyChecksum {
+			captureCommitTS(txn, &commitTS)
+		}
 		if tagger := w.GetCtx().getResourceGroupTaggerForTopSQL(jobID); tagger != nil {
 			txn.SetOption(kv.ResourceGroupTagger, tagger)
 		}
+		failpoint.Inject("checkBackfillRequestSource", func(val failpoint.Value) {
+			//nolint:forcetypeassert
+			if source := txn.GetOption(kv.RequestSourceType); source != val.(string) {
+				failpoint.Return(errors.Errorf("expected backfill request source: %v, actual: %v", val, source))
+			}
+		})
 
 		idxRecords, nextKey, taskDone, err := w.fetchRowColVals(txn, handleRange)
 		if err != nil {
@@ -1888,9 +2164,19 @@ func (w *addIndexTxnWorker) BackfillData(handleRange reorgBackfillTask) (taskCtx
 			}
 			taskCtx.addedCount++
 		}
+		taskCtx.addedBytes = txn.Size()
 
+		if verifyChecksum {
+			checksum, err = collectBatchChecksum(w.sessCtx.GetStore(), txn, handleRange.physicalTable.GetPhysicalID(), w.index.Meta().ID)
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
 		return nil
 	})
+	if errInTxn == nil && checksum != nil {
+		errInTxn = verifyBatchChecksum(ctx, w.sessCtx, w.index.Meta(), checksum, commitTS)
+	}
 	logSlowOperations(time.Since(oprStartTime), "AddIndexBackfillData", 3000)
 	failpoint.Inject("mockDMLExecution", func(val failpoint.Value) {
 		//nolint:forcetypeassert
@@ -1916,7 +2202,11 @@ func (w *worker) addPhysicalTableIndex(t table.PhysicalTable, reorgInfo *reorgIn
 		return w.writePhysicalTableRecord(w.sessPool, t, typeAddIndexMergeTmpWorker, reorgInfo)
 	}
 	logutil.BgLogger().Info("[ddl] start to add table index", zap.String("job", reorgInfo.Job.String()), zap.String("reorgInfo", reorgInfo.String()))
-	return w.writePhysicalTableRecord(w.sessPool, t, typeAddIndexWorker, reorgInfo)
+	bfWorkerType := typeAddIndexWorker
+	if idxInfo := model.FindIndexInfoByID(t.Meta().Indices, reorgInfo.currElement.ID); idxInfo != nil && idxInfo.Tp == model.IndexTypeFulltext {
+		bfWorkerType = typeAddFulltextIndexWorker
+	}
+	return w.writePhysicalTableRecord(w.sessPool, t, bfWorkerType, reorgInfo)
 }
 
 // addTableIndex handles the add index reorganization state for a table.
@@ -1928,6 +2218,9 @@ func (w *worker) addTableIndex(t table.Table, reorgInfo *reorgInfo) error {
 
 	var err error
 	if tbl, ok := t.(table.PartitionedTable); ok {
+		if concurrency := partitionBackfillConcurrency(reorgInfo); concurrency > 1 {
+			return errors.Trace(w.addPartitionsIndexConcurrently(tbl, reorgInfo, concurrency))
+		}
 		var finish bool
 		for !finish {
 			p := tbl.GetPartition(reorgInfo.PhysicalTableID)
@@ -1951,6 +2244,95 @@ func (w *worker) addTableIndex(t table.Table, reorgInfo *reorgInfo) error {
 	return errors.Trace(err)
 }
 
+// partitionBackfillConcurrency returns the number of partitions backfilled concurrently.
+func partitionBackfillConcurrency(reorgInfo *reorgInfo) int {
+	if reorgInfo.mergingTmpIdx {
+		// The temporary index merging keeps the progress of the whole index, so the partitions are handled one by one.
+		return 1
+	}
+	return int(variable.DDLReorgPartitionConcurrency.Load())
+}
+
+// addPartitionsIndexConcurrently backfills the partitions in batches, the partitions in a batch are backfilled
+// concurrently by separate schedulers, which share the worker quota of the job. The checkpoint is the first
+// partition of the running batch, so the whole batch is redone if the job is interrupted.
+func (w *worker) addPartitionsIndexConcurrently(t table.PartitionedTable, reorg *reorgInfo, concurrency int) error {
+	for {
+		batch := []*reorgInfo{reorg}
+		for len(batch) < concurrency {
+			last := batch[len(batch)-1]
+			pid, startKey, endKey, err := getNextPartitionInfo(reorg, t, last.PhysicalTableID)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if pid == 0 {
+				break
+			}
+			next := *reorg
+			next.PhysicalTableID, next.StartKey, next.EndKey = pid, startKey, endKey
+			batch = append(batch, &next)
+		}
+		subInfos := make([]*reorgInfo, 0, len(batch))
+		for _, info := range batch {
+			sub := *info
+			sub.concurrentPartitions = len(batch)
+			subInfos = append(subInfos, &sub)
+		}
+		logutil.BgLogger().Info("[ddl] start to add index for partitions concurrently",
+			zap.Int64("jobID", reorg.Job.ID), zap.Int64("first partition", reorg.PhysicalTableID),
+			zap.Int("partition count", len(subInfos)))
+		if err := runPartitionsConcurrently(t, subInfos, w.addPhysicalTableIndex); err != nil {
+			return errors.Trace(err)
+		}
+
+		// Move the checkpoint to the partition after the batch.
+		reorg.PhysicalTableID = batch[len(batch)-1].PhysicalTableID
+		finish, err := updateReorgInfo(w.sessPool, t, reorg)
+		if err != nil || finish {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// runPartitionsConcurrently runs fn for the partitions of subInfos concurrently, and returns the first error.
+func runPartitionsConcurrently(t table.PartitionedTable, subInfos []*reorgInfo, fn func(table.PhysicalTable, *reorgInfo) error) error {
+	job := subInfos[0].Job
+	for _, sub := range subInfos {
+		sub.snapshotVer = job.SnapshotVer
+	}
+	var wg util.WaitGroupWrapper
+	errs := make([]error, len(subInfos))
+	for i, sub := range subInfos {
+		i, sub := i, sub
+		wg.Run(func() {
+			defer util.Recover(metrics.LabelDDL, "runPartitionsConcurrently", func() {
+				errs[i] = dbterror.ErrReorgPanic
+			}, false)
+			p := t.GetPartition(sub.PhysicalTableID)
+			if p == nil {
+				errs[i] = dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", sub.PhysicalTableID, t.Meta().ID)
+				return
+			}
+			errs[i] = fn(p, sub)
+		})
+	}
+	wg.Wait()
+	// The key ranges of the following partitions are calculated with the latest snapshot.
+	for _, sub := range subInfos {
+		if sub.snapshotVer > job.SnapshotVer {
+			job.Mu.Lock()
+			job.SnapshotVer = sub.snapshotVer
+			job.Mu.Unlock()
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 func getNextPartitionInfo(reorg *reorgInfo, t table.PartitionedTable, currPhysicalTableID int64) (int64, kv.Key, kv.Key, error) {
 	pi := t.Meta().GetPartitionInfo()
 	if pi == nil {
@@ -2081,6 +2463,10 @@ type cleanUpIndexWorker struct {
 	baseIndexWorker
 }
 
+func newCleanUpIndexBackfiller(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error) {
+	return newCleanUpIndexWorker(sessCtx, id, b.tbl, b.decodeColMap, b.reorgInfo, b.jobCtx), nil
+}
+
 func newCleanUpIndexWorker(sessCtx sessionctx.Context, id int, t table.PhysicalTable, decodeColMap map[int64]decoder.Column, reorgInfo *reorgInfo, jc *JobContext) *cleanUpIndexWorker {
 	indexes := make([]table.Index, 0, len(t.Indices()))
 	rowDecoder := decoder.NewRowDecoder(t, t.WritableCols(), decodeColMap)
@@ -2139,6 +2525,7 @@ func (w *cleanUpIndexWorker) BackfillData(handleRange reorgBackfillTask) (taskCt
 			}
 			taskCtx.addedCount++
 		}
+		taskCtx.addedBytes = txn.Size()
 		return nil
 	})
 	logSlowOperations(time.Since(oprStartTime), "cleanUpIndexBackfillDataInTxn", 3000)
@@ -2152,26 +2539,80 @@ func (w *worker) cleanupPhysicalTableIndex(t table.PhysicalTable, reorgInfo *reo
 	return w.writePhysicalTableRecord(w.sessPool, t, typeCleanUpIndexWorker, reorgInfo)
 }
 
-// cleanupGlobalIndex handles the drop partition reorganization state to clean up index entries of partitions.
-func (w *worker) cleanupGlobalIndexes(tbl table.PartitionedTable, partitionIDs []int64, reorgInfo *reorgInfo) error {
-	var err error
-	var finish bool
-	for !finish {
-		p := tbl.GetPartition(reorgInfo.PhysicalTableID)
-		if p == nil {
-			return dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", reorgInfo.PhysicalTableID, tbl.Meta().ID)
+// cleanupGlobalIndexes handles the drop partition reorganization state to clean up index entries of partitions.
+// The partitions are cleaned up in batches of tidb_ddl_reorg_partition_concurrency, the partitions in a batch
+// are cleaned up concurrently by separate schedulers, which share the worker quota of the job. The checkpoint
+// is the first partition of the running batch, so the whole batch is redone if the job is interrupted.
+func (w *worker) cleanupGlobalIndexes(tbl table.PartitionedTable, partitionIDs []int64, reorg *reorgInfo) error {
+	concurrency := int(variable.DDLReorgPartitionConcurrency.Load())
+	for {
+		batch := []*reorgInfo{reorg}
+		for len(batch) < concurrency {
+			pid := nextPartitionIDInList(partitionIDs, batch[len(batch)-1].PhysicalTableID)
+			if pid == 0 {
+				break
+			}
+			startKey, endKey, err := getPartitionTableRange(reorg, tbl, pid)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			next := *reorg
+			next.PhysicalTableID, next.StartKey, next.EndKey = pid, startKey, endKey
+			batch = append(batch, &next)
 		}
-		err = w.cleanupPhysicalTableIndex(p, reorgInfo)
-		if err != nil {
-			break
+		if len(batch) == 1 {
+			p := tbl.GetPartition(reorg.PhysicalTableID)
+			if p == nil {
+				return dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", reorg.PhysicalTableID, tbl.Meta().ID)
+			}
+			if err := w.cleanupPhysicalTableIndex(p, reorg); err != nil {
+				return errors.Trace(err)
+			}
+		} else {
+			subInfos := make([]*reorgInfo, 0, len(batch))
+			for _, info := range batch {
+				sub := *info
+				sub.concurrentPartitions = len(batch)
+				subInfos = append(subInfos, &sub)
+			}
+			logutil.BgLogger().Info("[ddl] start to clean up global index for partitions concurrently",
+				zap.Int64("jobID", reorg.Job.ID), zap.Int64("first partition", reorg.PhysicalTableID),
+				zap.Int("partition count", len(subInfos)))
+			if err := runPartitionsConcurrently(tbl, subInfos, w.cleanupPhysicalTableIndex); err != nil {
+				return errors.Trace(err)
+			}
 		}
-		finish, err = w.updateReorgInfoForPartitions(tbl, reorgInfo, partitionIDs)
-		if err != nil {
+
+		// Move the checkpoint to the partition after the batch.
+		reorg.PhysicalTableID = batch[len(batch)-1].PhysicalTableID
+		finish, err := w.updateReorgInfoForPartitions(tbl, reorg, partitionIDs)
+		if err != nil || finish {
 			return errors.Trace(err)
 		}
 	}
+}
+
+// nextPartitionIDInList returns the partition ID after pid in partitionIDs, or 0 if there is no more partition.
+func nextPartitionIDInList(partitionIDs []int64, pid int64) int64 {
+	for i, id := range partitionIDs {
+		if id == pid && i+1 < len(partitionIDs) {
+			return partitionIDs[i+1]
+		}
+	}
+	return 0
+}
 
-	return errors.Trace(err)
+func getPartitionTableRange(reorg *reorgInfo, t table.PartitionedTable, pid int64) (kv.Key, kv.Key, error) {
+	p := t.GetPartition(pid)
+	if p == nil {
+		return nil, nil, dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", pid, t.Meta().ID)
+	}
+	currentVer, err := getValidCurrentVersion(reorg.d.store)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	start, end, err := getTableRange(reorg.d.jobContext(reorg.Job.ID), reorg.d, p, currentVer.Ver, reorg.Job.Priority)
+	return start, end, errors.Trace(err)
 }
 
 // updateReorgInfoForPartitions will find the next partition in partitionIDs according to current reorgInfo.
@@ -2183,21 +2624,11 @@ func (w *worker) updateReorgInfoForPartitions(t table.PartitionedTable, reorg *r
 		return true, nil
 	}
 
-	var pid int64
-	for i, pi := range partitionIDs {
-		if pi == reorg.PhysicalTableID {
-			if i == len(partitionIDs)-1 {
-				return true, nil
-			}
-		}
-		pid = partitionIDs[i+1]
-	}
-
-	currentVer, err := getValidCurrentVersion(reorg.d.store)
-	if err != nil {
-		return false, errors.Trace(err)
+	pid := nextPartitionIDInList(partitionIDs, reorg.PhysicalTableID)
+	if pid == 0 {
+		return true, nil
 	}
-	start, end, err := getTableRange(reorg.d.jobContext(reorg.Job.ID), reorg.d, t.GetPartition(pid), currentVer.Ver, reorg.Job.Priority)
+	start, end, err := getPartitionTableRange(reorg, t, pid)
 	if err != nil {
 		return false, errors.Trace(err)
 	}
@@ -2226,7 +2657,7 @@ func runBackfillJobsWithLightning(d *ddl, sess *session, bfJob *BackfillJob, job
 		return err
 	}
 
-	bc.EngMgr.ResetWorkers(bc, bfJob.JobID, bfJob.EleID)
+	bc.EngMgr.ResetIndexWorkers(bc, bfJob.JobID, bfJob.EleID)
 	err = bc.FinishImport(bfJob.EleID, bfJob.Meta.IsUnique, tbl)
 	if err != nil {
 		logutil.BgLogger().Warn("[ddl] lightning import error", zap.String("first backfill job", bfJob.AbbrStr()), zap.Error(err))
@@ -0,0 +1,163 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/verification"
+	"github.com/pingcap/tidb/distsql"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/hack"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tipb/go-tipb"
+	"go.uber.org/zap"
+)
+
+// batchChecksum is the checksum of the index records written by a backfill batch.
+type batchChecksum struct {
+	local *verification.KVChecksum
+	// keyRanges are the point ranges of the written index keys.
+	keyRanges []kv.KeyRange
+}
+
+// collectBatchChecksum computes the checksum of the index records buffered in the transaction before it's committed.
+// The checksum is computed in the same way as the coprocessor, so it can be compared with the one returned by TiKV.
+func collectBatchChecksum(store kv.Storage, txn kv.Transaction, physicalID, indexID int64) (*batchChecksum, error) {
+	prefix := tablecodec.EncodeTableIndexPrefix(physicalID, indexID)
+	iter, err := txn.GetMemBuffer().Iter(prefix, prefix.PrefixNext())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer iter.Close()
+	cs := &batchChecksum{local: verification.NewKVChecksumWithKeyspace(store.GetCodec())}
+	for iter.Valid() && iter.Key().HasPrefix(prefix) {
+		// The deleted keys are skipped, the value of an index record is never empty.
+		if len(iter.Value()) > 0 {
+			key := iter.Key().Clone()
+			cs.local.UpdateOne(common.KvPair{Key: key, Val: iter.Value()})
+			cs.keyRanges = append(cs.keyRanges, kv.KeyRange{StartKey: key, EndKey: key.Next()})
+		}
+		if err := iter.Next(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return cs, nil
+}
+
+// captureCommitTS records the commit timestamp of the transaction through the commit hook. Unlike the commit ts
+// checks, the hook doesn't change how the transaction is committed, so async commit and 1PC are still used.
+func captureCommitTS(txn kv.Transaction, commitTS *uint64) {
+	txn.SetOption(kv.CommitHook, func(info string, err error) {
+		if err != nil {
+			return
+		}
+		var txnInfo struct {
+			CommitTS uint64 `json:"commit_ts"`
+		}
+		if err := json.Unmarshal(hack.Slice(info), &txnInfo); err != nil {
+			logutil.BgLogger().Warn("[ddl] cannot get the commit ts of the backfill batch", zap.String("info", info), zap.Error(err))
+			return
+		}
+		*commitTS = txnInfo.CommitTS
+	})
+}
+
+// verifyBatchChecksum issues a coprocessor checksum request over the index keys written by the batch at the commit
+// timestamp of the batch, and compares the result with the local checksum. Reading at the commit timestamp makes
+// sure the concurrent DMLs committed after the batch don't affect the result.
+func verifyBatchChecksum(ctx context.Context, sctx sessionctx.Context, idxInfo *model.IndexInfo,
+	cs *batchChecksum, commitTS uint64) error {
+	if len(cs.keyRanges) == 0 {
+		return nil
+	}
+	if commitTS == 0 {
+		logutil.BgLogger().Warn("[ddl] skip verifying the checksum of the backfill batch without the commit ts",
+			zap.String("index", idxInfo.Name.O))
+		return nil
+	}
+	remote, err := checksumIndexKeys(ctx, sctx, cs.keyRanges, commitTS)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	failpoint.Inject("mockBackfillChecksumMismatch", func() {
+		remote.Checksum ^= 1
+	})
+	if remote.Checksum != cs.local.Sum() || remote.TotalKvs != cs.local.SumKVS() || remote.TotalBytes != cs.local.SumSize() {
+		logutil.BgLogger().Error("[ddl] checksum mismatch of the backfilled index records", zap.String("index", idxInfo.Name.O),
+			zap.Uint64("commitTS", commitTS), zap.Object("local", cs.local),
+			zap.Uint64("remote checksum", remote.Checksum), zap.Uint64("remote kvs", remote.TotalKvs),
+			zap.Uint64("remote bytes", remote.TotalBytes))
+		return dbterror.ErrBackfillChecksumMismatch.GenWithStackByArgs(idxInfo.Name.O,
+			cs.local.Sum(), cs.local.SumKVS(), cs.local.SumSize(), remote.Checksum, remote.TotalKvs, remote.TotalBytes)
+	}
+	return nil
+}
+
+func checksumIndexKeys(ctx context.Context, sctx sessionctx.Context, keyRanges []kv.KeyRange,
+	ts uint64) (resp *tipb.ChecksumResponse, err error) {
+	checksum := &tipb.ChecksumRequest{
+		ScanOn:    tipb.ChecksumScanOn_Index,
+		Algorithm: tipb.ChecksumAlgorithm_Crc64_Xor,
+	}
+	var builder distsql.RequestBuilder
+	kvReq, err := builder.
+		SetKeyRanges(keyRanges).
+		SetChecksumRequest(checksum).
+		SetStartTS(ts).
+		SetConcurrency(1).
+		Build()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	kvReq.RequestSource.RequestSourceInternal = true
+	kvReq.RequestSource.RequestSourceType = getDDLRequestSource(model.ActionAddIndex)
+	res, err := distsql.Checksum(ctx, sctx.GetClient(), kvReq, sctx.GetSessionVars().KVVars)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() {
+		if err1 := res.Close(); err1 != nil && err == nil {
+			err = errors.Trace(err1)
+		}
+	}()
+
+	resp = &tipb.ChecksumResponse{}
+	for {
+		data, err := res.NextRaw(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if data == nil {
+			break
+		}
+		partial := &tipb.ChecksumResponse{}
+		if err = partial.Unmarshal(data); err != nil {
+			return nil, errors.Trace(err)
+		}
+		resp.Checksum ^= partial.Checksum
+		resp.TotalKvs += partial.TotalKvs
+		resp.TotalBytes += partial.TotalBytes
+	}
+	return resp, nil
+}
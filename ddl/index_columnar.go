@@ -0,0 +1,159 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/domain/infosync"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// isColumnarIndexOption returns whether the index option defines a columnar index. A columnar index is a projection
+// of the selected columns in the given order maintained by the TiFlash replicas of the table. It has no entries in
+// TiKV, so it's neither backfilled by TiDB nor written by the transactions.
+func isColumnarIndexOption(indexOption *ast.IndexOption) bool {
+	return indexOption != nil && indexOption.Tp == model.IndexTypeColumnar
+}
+
+// checkColumnarIndexReplica checks the table has available TiFlash replicas to maintain the columnar index.
+func checkColumnarIndexReplica(tblInfo *model.TableInfo) error {
+	replica := tblInfo.TiFlashReplica
+	if replica == nil || replica.Count == 0 {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("COLUMNAR index needs the TiFlash replicas of table %s", tblInfo.Name.O)
+	}
+	if !replica.Available {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("COLUMNAR index needs the TiFlash replicas of table %s to be available", tblInfo.Name.O)
+	}
+	return nil
+}
+
+// hasColumnarIndex returns whether the table has any columnar index, which depends on the TiFlash replicas.
+func hasColumnarIndex(tblInfo *model.TableInfo) bool {
+	for _, idx := range tblInfo.Indices {
+		if idx.Tp == model.IndexTypeColumnar {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTiFlashReplicaRemoval checks the TiFlash replicas aren't removed from the table with columnar indexes.
+func checkTiFlashReplicaRemoval(tblInfo *model.TableInfo, replicaInfo *ast.TiFlashReplicaSpec) error {
+	if replicaInfo.Count == 0 && hasColumnarIndex(tblInfo) {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(
+			"setting TiFlash replica to 0 on table " + tblInfo.Name.O + " with COLUMNAR indexes, drop the indexes at first")
+	}
+	return nil
+}
+
+// buildColumnarIndexColumns builds the columns of a columnar index. The columnar index can't be primary or unique
+// since it isn't a constraint, and the prefix length isn't allowed since the whole values are projected.
+func buildColumnarIndexColumns(columns []*model.ColumnInfo, indexPartSpecifications []*ast.IndexPartSpecification, isPrimary, isUnique bool) ([]*model.IndexColumn, error) {
+	if isPrimary || isUnique {
+		return nil, dbterror.ErrUnsupportedIndexType.GenWithStack("PRIMARY or UNIQUE index with USING COLUMNAR is not supported")
+	}
+	idxParts := make([]*model.IndexColumn, 0, len(indexPartSpecifications))
+	for _, ip := range indexPartSpecifications {
+		if ip.Column == nil {
+			return nil, dbterror.ErrUnsupportedIndexType.GenWithStack("COLUMNAR index on expressions is not supported")
+		}
+		col := model.FindColumnInfo(columns, ip.Column.Name.L)
+		if col == nil {
+			return nil, dbterror.ErrKeyColumnDoesNotExits.GenWithStack("column does not exist: %s", ip.Column.Name)
+		}
+		if ip.Length != types.UnspecifiedLength {
+			return nil, errors.Trace(dbterror.ErrIncorrectPrefixKey)
+		}
+		idxParts = append(idxParts, &model.IndexColumn{
+			Name:   col.Name,
+			Offset: col.Offset,
+			Length: types.UnspecifiedLength,
+		})
+	}
+	return idxParts, nil
+}
+
+// initColumnarIndexProgress initializes the progress of the columnar index on each partition in the job.
+func initColumnarIndexProgress(job *model.Job, tblInfo *model.TableInfo) {
+	progress := make([]*model.ReorgElementProgress, 0, 1)
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		for _, def := range pi.Definitions {
+			progress = append(progress, &model.ReorgElementProgress{Name: "partition `" + def.Name.O + "`", ID: def.ID, Started: true})
+		}
+	} else {
+		progress = append(progress, &model.ReorgElementProgress{Name: "table", ID: tblInfo.ID, Started: true})
+	}
+	job.ReorgMeta.ElementProgress = progress
+}
+
+// populateColumnarIndex populates the columnar index in the write reorganization state instead of the backfill.
+// It records the snapshot from which the TiFlash replicas build the index at first, then waits until the replicas of
+// all the partitions catch up, and makes the index public at last. All the servers write the table in the write
+// reorganization state, so the rows changed after the snapshot reach the replicas with the index in the schema.
+func (w *worker) populateColumnarIndex(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo, indexInfo *model.IndexInfo, isPK bool) (ver int64, err error) {
+	if err = checkColumnarIndexReplica(tblInfo); err != nil {
+		logutil.BgLogger().Warn("[ddl] the TiFlash replicas are unavailable, convert job to rollback", zap.String("job", job.String()), zap.Error(err))
+		ver, err = convertAddIdxJob2RollbackJob(d, t, job, tblInfo, indexInfo, err)
+		return ver, errors.Trace(err)
+	}
+	if job.SnapshotVer == 0 {
+		currVer, err := getValidCurrentVersion(d.store)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.SnapshotVer = currVer.Ver
+		indexInfo.ColumnarSnapshotTS = currVer.Ver
+		initColumnarIndexProgress(job, tblInfo)
+		// The replicas are checked in the next rounds.
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, false)
+		return ver, errors.Trace(err)
+	}
+
+	stores, err := getTiFlashStores()
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	allDone := true
+	for _, p := range job.ReorgMeta.ElementProgress {
+		if p.Done {
+			continue
+		}
+		p.Progress, err = infosync.CalculateTiFlashProgress(p.ID, tblInfo.TiFlashReplica.Count, stores)
+		if err != nil {
+			logutil.BgLogger().Warn("[ddl] get tiflash sync progress failed", zap.Int64("jobID", job.ID), zap.Int64("physicalTableID", p.ID), zap.Error(err))
+		}
+		p.Done = err == nil && p.Progress == 1
+		allDone = allDone && p.Done
+	}
+	if allDone {
+		logutil.BgLogger().Info("[ddl] columnar index populated", zap.Int64("jobID", job.ID),
+			zap.String("index", indexInfo.Name.O), zap.Uint64("snapshotTS", indexInfo.ColumnarSnapshotTS))
+		return finishCreateIndex(d, t, job, tblInfo, indexInfo, isPK)
+	}
+	// Wait for a while before checking the replicas again, the progress is saved in the job when it returns.
+	select {
+	case <-w.ctx.Done():
+	case <-time.After(PollTiFlashInterval):
+	}
+	return ver, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	tidbutil "github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+// purgeDeferredDroppedIndexInterval is the interval to look for the deferred dropped indexes to purge.
+var purgeDeferredDroppedIndexInterval = time.Minute
+
+// checkDeferredDropIndex checks whether the index can be dropped by `DROP INDEX ... DEFERRED`.
+func checkDeferredDropIndex(ctx sessionctx.Context, tblInfo *model.TableInfo, indexInfo *model.IndexInfo, isPK bool) error {
+	if ctx.GetSessionVars().StmtCtx.MultiSchemaInfo != nil {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("DROP INDEX ... DEFERRED in multi-schema change")
+	}
+	if tblInfo.TempTableType != model.TempTableNone {
+		return dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("drop index deferred")
+	}
+	if indexInfo.IsDeferredDropped() {
+		return dbterror.ErrCantDropFieldOrKey.GenWithStack("index %s has been dropped by DROP INDEX ... DEFERRED", indexInfo.Name)
+	}
+	if isPK || indexInfo.Primary {
+		return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("DROP INDEX ... DEFERRED on the primary key")
+	}
+	if indexInfo.Global || indexInfo.MVIndex || indexInfo.Tp == model.IndexTypeFulltext || indexInfo.Tp == model.IndexTypeColumnar {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("DROP INDEX ... DEFERRED on the global, multi-valued, fulltext or columnar index is not supported")
+	}
+	return nil
+}
+
+// checkColumnWithDeferredDroppedIndex checks whether the column is covered by a deferred dropped index. Such a column
+// can't be dropped or modified, otherwise the retained index data doesn't match the column anymore.
+func checkColumnWithDeferredDroppedIndex(tblInfo *model.TableInfo, colName model.CIStr) error {
+	col := model.FindColumnInfo(tblInfo.Columns, colName.L)
+	if col == nil {
+		return nil
+	}
+	for _, idx := range tblInfo.Indices {
+		if idx.IsDeferredDropped() && idx.HasColumnInIndexColumns(tblInfo, col.ID) {
+			return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(fmt.Sprintf(
+				"changing the column %s covered by the index %s dropped by DROP INDEX ... DEFERRED, purge it by DROP INDEX or restore it by UNDROP INDEX first",
+				colName, idx.Name))
+		}
+	}
+	return nil
+}
+
+// onDeferredDropIndex drops the index logically. The index becomes write-only, so it's not read anymore but still
+// maintained by DML, and the job finishes there with the index data retained. Keeping the index maintained costs
+// the writes during the grace period, but the index can be restored by UNDROP INDEX without checking the rows.
+// A unique index still rejects the duplicated rows until it's purged.
+func onDeferredDropIndex(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	tblInfo, indexInfo, ifExists, err := checkDropIndex(d, t, job)
+	if err != nil {
+		if ifExists && dbterror.ErrCantDropFieldOrKey.Equal(err) {
+			job.Warning = toTError(err)
+			job.State = model.JobStateDone
+			return ver, nil
+		}
+		return ver, errors.Trace(err)
+	}
+
+	switch indexInfo.State {
+	case model.StatePublic:
+		// public -> write only, the index stays in the state until it's restored or purged.
+		indexInfo.State = model.StateWriteOnly
+		indexInfo.DeferredDropTS = t.StartTS
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StateWriteOnly, ver, tblInfo)
+	default:
+		return ver, errors.Trace(dbterror.ErrInvalidDDLState.GenWithStackByArgs("index", indexInfo.State))
+	}
+	return ver, nil
+}
+
+// onUndropIndex restores the index dropped by `DROP INDEX ... DEFERRED`. The index is maintained by DML since it
+// was dropped, so it's consistent with the table data and becomes public at once.
+func onUndropIndex(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	var indexID int64
+	if err = job.DecodeArgs(&indexID); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	indexInfo := model.FindIndexInfoByID(tblInfo.Indices, indexID)
+	if indexInfo == nil {
+		job.State = model.JobStateCancelled
+		return ver, infoschema.ErrKeyNotExists.GenWithStackByArgs(strconv.FormatInt(indexID, 10), tblInfo.Name)
+	}
+	if !indexInfo.IsDeferredDropped() {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrUndropIndex.GenWithStackByArgs(indexInfo.Name, "it isn't dropped by DROP INDEX ... DEFERRED")
+	}
+
+	// write only -> public
+	indexInfo.State = model.StatePublic
+	indexInfo.DeferredDropTS = 0
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+// startPurgeDeferredDroppedIndexLoop purges the indexes dropped by `DROP INDEX ... DEFERRED` longer than
+// tidb_deferred_drop_index_grace_period ago on the owner.
+func (d *ddl) startPurgeDeferredDroppedIndexLoop() {
+	defer tidbutil.Recover(metrics.LabelDDL, "startPurgeDeferredDroppedIndexLoop", nil, false)
+
+	ticker := time.NewTicker(purgeDeferredDroppedIndexInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !d.ownerManager.IsOwner() {
+				continue
+			}
+			d.purgeDeferredDroppedIndexes(variable.DeferredDropIndexGracePeriod.Load())
+		case <-d.ctx.Done():
+			return
+		}
+	}
+}
+
+// purgeDeferredDroppedIndexes drops the deferred dropped indexes whose grace period is over, the drop index jobs
+// continue from the write-only state and delete the index data at last. Only the tables which have deferred dropped
+// indexes are checked, they're tracked by the information schema.
+func (d *ddl) purgeDeferredDroppedIndexes(gracePeriod time.Duration) {
+	is := d.infoCache.GetLatest()
+	now := time.Now()
+	for _, tblID := range is.TableIDsWithDeferredDroppedIndexes() {
+		tbl, ok := is.TableByID(tblID)
+		if !ok {
+			continue
+		}
+		tblInfo := tbl.Meta()
+		db, ok := is.SchemaByTable(tblInfo)
+		if !ok {
+			continue
+		}
+		for _, idx := range tblInfo.Indices {
+			if !idx.IsDeferredDropped() || now.Sub(oracle.GetTimeFromTS(idx.DeferredDropTS)) < gracePeriod {
+				continue
+			}
+			if err := d.purgeDeferredDroppedIndex(db.Name, tblInfo.Name, idx.Name); err != nil {
+				logutil.BgLogger().Warn("[ddl] purge deferred dropped index failed", zap.String("table", tblInfo.Name.O),
+					zap.String("index", idx.Name.O), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (d *ddl) purgeDeferredDroppedIndex(schema, table, index model.CIStr) error {
+	sctx, err := d.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer d.sessPool.put(sctx)
+	sctx.SetValue(sessionctx.QueryString, fmt.Sprintf("DROP INDEX `%s` ON `%s`.`%s`", index.O, schema.O, table.O))
+	return d.dropIndex(sctx, ast.Ident{Schema: schema, Name: table}, index, true, false)
+}
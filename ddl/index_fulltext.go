@@ -0,0 +1,179 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/mathutil"
+	"go.uber.org/zap"
+)
+
+// fulltextBatchEntryLimit is the max number of fulltext index entries written in one backfill transaction.
+// A row may produce many entries, so the batch size is shrunk when a batch writes more entries than it.
+const fulltextBatchEntryLimit = 10240
+
+func init() {
+	registerBackfiller(typeAddFulltextIndexWorker, "add fulltext index", newAddFulltextIndexBackfiller)
+}
+
+// fulltextIndexOption returns the index option of a fulltext index, which has the FULLTEXT index type.
+func fulltextIndexOption(indexOption *ast.IndexOption) (*ast.IndexOption, error) {
+	opt := &ast.IndexOption{}
+	if indexOption != nil {
+		if indexOption.Tp != model.IndexTypeInvalid {
+			return nil, dbterror.ErrUnsupportedIndexType.GenWithStack("FULLTEXT index with USING %s is not supported", indexOption.Tp)
+		}
+		*opt = *indexOption
+	}
+	opt.Tp = model.IndexTypeFulltext
+	return opt, nil
+}
+
+func isFulltextColumnType(tp *types.FieldType) bool {
+	return (types.IsTypeChar(tp.GetType()) || types.IsTypeVarchar(tp.GetType()) || types.IsTypeBlob(tp.GetType())) &&
+		tp.GetCharset() != charset.CharsetBin
+}
+
+// buildFulltextIndexColumns builds the columns of a fulltext index. Only the non-binary string
+// columns can be in a fulltext index, and the prefix length isn't allowed since the whole values
+// are tokenized.
+func buildFulltextIndexColumns(columns []*model.ColumnInfo, indexPartSpecifications []*ast.IndexPartSpecification) ([]*model.IndexColumn, error) {
+	idxParts := make([]*model.IndexColumn, 0, len(indexPartSpecifications))
+	for _, ip := range indexPartSpecifications {
+		if ip.Column == nil {
+			return nil, dbterror.ErrUnsupportedIndexType.GenWithStack("FULLTEXT index on expressions is not supported")
+		}
+		col := model.FindColumnInfo(columns, ip.Column.Name.L)
+		if col == nil {
+			return nil, dbterror.ErrKeyColumnDoesNotExits.GenWithStack("column does not exist: %s", ip.Column.Name)
+		}
+		if !isFulltextColumnType(&col.FieldType) {
+			return nil, dbterror.ErrBadFtColumn.GenWithStackByArgs(col.Name.O)
+		}
+		if ip.Length != types.UnspecifiedLength {
+			return nil, errors.Trace(dbterror.ErrIncorrectPrefixKey)
+		}
+		idxParts = append(idxParts, &model.IndexColumn{
+			Name:   col.Name,
+			Offset: col.Offset,
+			Length: types.UnspecifiedLength,
+		})
+	}
+	return idxParts, nil
+}
+
+// addFulltextIndexWorker backfills a fulltext index. It writes an index entry for each distinct
+// token of the indexed columns of a row, and adjusts the batch size by the number of entries.
+type addFulltextIndexWorker struct {
+	*addIndexTxnWorker
+}
+
+func newAddFulltextIndexBackfiller(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error) {
+	reorgInfo, jc := b.reorgInfo, b.jobCtx
+	job := reorgInfo.Job
+	backfillCtx := newBackfillCtx(reorgInfo.d, id, sessCtx, job.SchemaName, b.tbl, jc, "add_idx_rate", false)
+	txnWorker, err := newAddIndexTxnWorker(b.decodeColMap, b.tbl, backfillCtx,
+		job.ID, reorgInfo.currElement.ID, reorgInfo.currElement.TypeKey)
+	if err != nil {
+		return nil, err
+	}
+	return &addFulltextIndexWorker{addIndexTxnWorker: txnWorker}, nil
+}
+
+// BackfillData implements the backfiller interface.
+func (w *addFulltextIndexWorker) BackfillData(handleRange reorgBackfillTask) (taskCtx backfillTaskContext, errInTxn error) {
+	oprStartTime := time.Now()
+	verifyChecksum := variable.DDLReorgVerifyChecksum.Load()
+	var (
+		checksum   *batchChecksum
+		commitTS   uint64
+		entryCount int
+	)
+	ctx := kv.WithInternalSourceType(context.Background(), w.jobContext.ddlJobSourceType())
+	errInTxn = kv.RunInNewTxn(ctx, w.sessCtx.GetStore(), true, func(ctx context.Context, txn kv.Transaction) (err error) {
+		taskCtx.finishTS = txn.StartTS()
+		taskCtx.addedCount = 0
+		taskCtx.scanCount = 0
+		entryCount = 0
+		txn.SetOption(kv.Priority, handleRange.priority)
+		if verifyChecksum {
+			captureCommitTS(txn, &commitTS)
+		}
+		if tagger := w.GetCtx().getResourceGroupTaggerForTopSQL(handleRange.getJobID()); tagger != nil {
+			txn.SetOption(kv.ResourceGroupTagger, tagger)
+		}
+
+		idxRecords, nextKey, taskDone, err := w.fetchRowColVals(txn, handleRange)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		taskCtx.nextKey = nextKey
+		taskCtx.done = taskDone
+
+		sc := w.sessCtx.GetSessionVars().StmtCtx
+		for _, idxRecord := range idxRecords {
+			taskCtx.scanCount++
+			// Lock the row, so that the concurrent updates of the row conflict with the backfill.
+			if err := txn.LockKeys(context.Background(), new(kv.LockCtx), idxRecord.key); err != nil {
+				return errors.Trace(err)
+			}
+			iter := w.index.GenIndexKVIter(sc, idxRecord.vals, idxRecord.handle, nil)
+			for iter.Valid() {
+				key, val, _, err := iter.Next(nil)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				if err := txn.Set(key, val); err != nil {
+					return errors.Trace(err)
+				}
+				entryCount++
+			}
+			taskCtx.addedCount++
+		}
+		taskCtx.addedBytes = txn.Size()
+
+		if verifyChecksum {
+			checksum, err = collectBatchChecksum(w.sessCtx.GetStore(), txn, handleRange.physicalTable.GetPhysicalID(), w.index.Meta().ID)
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	})
+	if errInTxn == nil && checksum != nil {
+		errInTxn = verifyBatchChecksum(ctx, w.sessCtx, w.index.Meta(), checksum, commitTS)
+	}
+	if errInTxn == nil && entryCount > fulltextBatchEntryLimit {
+		if minBatchCnt := int(variable.MinDDLReorgBatchSize); w.batchCnt > minBatchCnt {
+			w.batchCnt = mathutil.Max(w.batchCnt*fulltextBatchEntryLimit/entryCount, minBatchCnt)
+			logutil.BgLogger().Info("[ddl] shrink the batch size of the fulltext index backfill",
+				zap.Int("worker ID", w.id), zap.Int("entry count", entryCount), zap.Int("batch size", w.batchCnt))
+		}
+	}
+	logSlowOperations(time.Since(oprStartTime), "AddFulltextIndexBackfillData", 3000)
+	return
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/table/tables"
@@ -44,25 +45,28 @@ func (w *mergeIndexWorker) batchCheckTemporaryUniqueKey(txn kv.Transaction, idxR
 		return nil
 	}
 
-	batchVals, err := txn.BatchGet(context.Background(), w.originIdxKeys)
-	if err != nil {
-		return errors.Trace(err)
-	}
-
-	for i, key := range w.originIdxKeys {
-		if val, found := batchVals[string(key)]; found {
+	// The keys in w.originIdxKeys also maybe duplicate, so the values of the not found keys
+	// are backfilled into `backfilledVals` map.
+	var backfilledVals map[string][]byte
+	err := kv.VisitBatchGet(context.Background(), txn, w.originIdxKeys, func(i int, val []byte) error {
+		key := w.originIdxKeys[i]
+		found := len(val) > 0
+		if !found {
+			val, found = backfilledVals[string(key)]
+		}
+		if found {
 			// Found a value in the original index key.
-			err := checkTempIndexKey(txn, idxRecords[i], val, w.table)
-			if err != nil {
-				return errors.Trace(err)
+			return checkTempIndexKey(txn, idxRecords[i], val, w.table)
+		}
+		if idxRecords[i].distinct {
+			if backfilledVals == nil {
+				backfilledVals = make(map[string][]byte)
 			}
-		} else if idxRecords[i].distinct {
-			// The keys in w.batchCheckKeys also maybe duplicate,
-			// so we need to backfill the not found key into `batchVals` map.
-			batchVals[string(key)] = idxRecords[i].vals
+			backfilledVals[string(key)] = idxRecords[i].vals
 		}
-	}
-	return nil
+		return nil
+	})
+	return errors.Trace(err)
 }
 
 func checkTempIndexKey(txn kv.Transaction, tmpRec *temporaryIndexRecord, originIdxVal []byte, tblInfo table.Table) error {
@@ -128,6 +132,16 @@ type mergeIndexWorker struct {
 	tmpIdxKeys    []kv.Key
 }
 
+func init() {
+	registerBackfiller(typeAddIndexMergeTmpWorker, "merge temporary index", newMergeTempIndexBackfiller)
+}
+
+func newMergeTempIndexBackfiller(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error) {
+	reorgInfo := b.reorgInfo
+	backfillCtx := newBackfillCtx(reorgInfo.d, id, sessCtx, reorgInfo.Job.SchemaName, b.tbl, b.jobCtx, "merge_tmp_idx_rate", false)
+	return newMergeTempIndexWorker(backfillCtx, b.tbl, reorgInfo.currElement.ID), nil
+}
+
 func newMergeTempIndexWorker(bfCtx *backfillCtx, t table.PhysicalTable, eleID int64) *mergeIndexWorker {
 	indexInfo := model.FindIndexInfoByID(t.Meta().Indices, eleID)
 
@@ -146,6 +160,7 @@ func (w *mergeIndexWorker) BackfillData(taskRange reorgBackfillTask) (taskCtx ba
 	errInTxn = kv.RunInNewTxn(ctx, w.sessCtx.GetStore(), true, func(ctx context.Context, txn kv.Transaction) error {
 		taskCtx.addedCount = 0
 		taskCtx.scanCount = 0
+		taskCtx.addedBytes = 0
 		txn.SetOption(kv.Priority, taskRange.priority)
 		if tagger := w.GetCtx().getResourceGroupTaggerForTopSQL(taskRange.getJobID()); tagger != nil {
 			txn.SetOption(kv.ResourceGroupTagger, tagger)
@@ -193,6 +208,7 @@ func (w *mergeIndexWorker) BackfillData(taskRange reorgBackfillTask) (taskCtx ba
 				return err
 			}
 			taskCtx.addedCount++
+			taskCtx.addedBytes += len(w.originIdxKeys[i]) + len(idxRecord.vals)
 		}
 		return nil
 	})
@@ -255,6 +271,9 @@ func (w *mergeIndexWorker) fetchTempIndexVals(txn kv.Transaction, taskRange reor
 			if taskDone || len(w.tmpIdxRecords) >= w.batchCnt {
 				return false, nil
 			}
+			if len(w.tmpIdxRecords) > 0 && w.shrinkBatchIfMemoryExceeded() {
+				return false, nil
+			}
 
 			tempIdxVal, err := tablecodec.DecodeTempIndexValue(rawValue)
 			if err != nil {
@@ -292,6 +311,7 @@ func (w *mergeIndexWorker) fetchTempIndexVals(txn kv.Transaction, taskRange reor
 				w.tmpIdxRecords = append(w.tmpIdxRecords, idxRecord)
 				w.originIdxKeys = append(w.originIdxKeys, originIdxKey)
 				w.tmpIdxKeys = append(w.tmpIdxKeys, indexKey)
+				w.consumeBatchMemory(int64(2*len(indexKey) + len(idxRecord.vals)))
 			}
 
 			lastKey = indexKey
@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/ddl/internal/callback"
 	testddlutil "github.com/pingcap/tidb/ddl/testutil"
 	"github.com/pingcap/tidb/errno"
 	"github.com/pingcap/tidb/infoschema"
@@ -370,6 +371,29 @@ func TestAddIndexForGeneratedColumn(t *testing.T) {
 	tk.MustExec("admin check table gcai_table")
 }
 
+func TestAddIndexForPartitionsConcurrently(t *testing.T) {
+	store := testkit.CreateMockStoreWithSchemaLease(t, indexModifyLease, mockstore.WithDDLChecker())
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set global tidb_ddl_enable_fast_reorg = 0")
+	defer tk.MustExec("set global tidb_ddl_enable_fast_reorg = default")
+	tk.MustExec("set global tidb_ddl_reorg_partition_concurrency = 3")
+	defer tk.MustExec("set global tidb_ddl_reorg_partition_concurrency = default")
+	tk.MustExec("create table t (a int, b int) partition by hash(a) partitions 8")
+	for i := 0; i < 100; i++ {
+		tk.MustExec("insert into t values (?, ?)", i, i*2)
+	}
+	tk.MustExec("alter table t add index idx_b(b)")
+	tk.MustExec("alter table t add unique index idx_a(a)")
+	tk.MustExec("admin check table t")
+	tk.MustQuery("select count(*) from t use index(idx_b) where b >= 100").Check(testkit.Rows("50"))
+
+	tk.MustExec("insert into t values (100, 0)")
+	tk.MustGetErrCode("alter table t add unique index idx_b2(b)", errno.ErrDupEntry)
+	tk.MustExec("admin check table t")
+}
+
 // TestAddPrimaryKeyRollback1 is used to test scenarios that will roll back when a duplicate primary key is encountered.
 func TestAddPrimaryKeyRollback1(t *testing.T) {
 	idxName := "PRIMARY"
@@ -987,6 +1011,54 @@ LOOP:
 	tk.MustExec("drop table test_drop_index")
 }
 
+func TestDropIndexDeferred(t *testing.T) {
+	store := testkit.CreateMockStoreWithSchemaLease(t, indexModifyLease)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int, key idx_b (b), unique key idx_c (c))")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3)")
+
+	tk.MustExec("drop index idx_b on t deferred")
+	tk.MustExec("alter table t drop index idx_c deferred")
+	require.Len(t, tk.MustQuery("show index from t").Rows(), 0)
+	tbl := external.GetTableByName(t, tk, "test", "t")
+	is := tk.Session().GetDomainInfoSchema().(infoschema.InfoSchema)
+	require.Equal(t, []int64{tbl.Meta().ID}, is.TableIDsWithDeferredDroppedIndexes())
+	tk.MustGetErrCode("drop index idx_b on t deferred", errno.ErrCantDropFieldOrKey)
+	tk.MustGetErrCode("alter table t drop column b", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t modify column b bigint", errno.ErrUnsupportedDDLOperation)
+
+	// The deferred dropped index is still maintained by DML, so it's restored without checking the rows.
+	tk.MustExec("insert into t values (4, 4, 4), (5, 5, 5)")
+	tk.MustExec("update t set b = 10 where a = 1")
+	tk.MustExec("delete from t where a = 2")
+	tk.MustExec("undrop index idx_b on t")
+	require.Len(t, tk.MustQuery("show index from t where key_name = 'idx_b'").Rows(), 1)
+	tk.MustExec("admin check index t idx_b")
+	tk.MustQuery("select a from t use index (idx_b) where b >= 4 order by b").Check(testkit.Rows("4", "5", "1"))
+	tk.MustGetErrCode("undrop index idx_b on t", errno.ErrUnsupportedDDLOperation)
+	tk.MustQuery("admin show ddl jobs 1").CheckAt([]int{3, 4}, testkit.Rows("undrop index public"))
+
+	// The deferred dropped unique index still rejects the duplicated rows.
+	tk.MustGetErrCode("insert into t values (6, 6, 1)", errno.ErrDupEntry)
+	require.Len(t, tk.MustQuery("show index from t where key_name = 'idx_c'").Rows(), 0)
+	tk.MustExec("undrop index idx_c on t")
+	tk.MustExec("admin check table t")
+	is = tk.Session().GetDomainInfoSchema().(infoschema.InfoSchema)
+	require.Len(t, is.TableIDsWithDeferredDroppedIndexes(), 0)
+
+	// Dropping the deferred dropped index without DEFERRED purges it.
+	tk.MustExec("drop index idx_c on t deferred")
+	tk.MustExec("drop index idx_c on t")
+	tk.MustGetErrCode("undrop index idx_c on t", errno.ErrKeyDoesNotExist)
+	tk.MustExec("alter table t add unique index idx_c (c)")
+	tk.MustExec("admin check table t")
+
+	tk.MustExec("create table t_pk (a int primary key nonclustered, b int)")
+	tk.MustGetErrCode("alter table t_pk drop index `primary` deferred", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t drop index idx_b deferred, add column d int", errno.ErrUnsupportedDDLOperation)
+}
+
 func TestAddMultiColumnsIndexClusterIndex(t *testing.T) {
 	store := testkit.CreateMockStoreWithSchemaLease(t, indexModifyLease)
 	tk := testkit.NewTestKit(t, store)
@@ -1084,3 +1156,45 @@ func TestAddIndexUniqueFailOnDuplicate(t *testing.T) {
 	require.Less(t, int(ddl.ResultCounterForTest.Load()), 6)
 	ddl.ResultCounterForTest = nil
 }
+
+func TestAddIndexInstantly(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomainWithSchemaLease(t, indexModifyLease)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c text)")
+	tk.MustExec("create table pt (a int, b int) partition by hash(a) partitions 4")
+
+	var reorgStates atomic.Int32
+	originHook := dom.DDL().GetHook()
+	defer dom.DDL().SetHook(originHook)
+	hook := &callback.TestDDLCallback{Do: dom}
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if job.Type == model.ActionAddIndex && job.SchemaState == model.StateWriteReorganization {
+			reorgStates.Add(1)
+		}
+	}
+	dom.DDL().SetHook(hook)
+
+	// The index of the empty table is added without the reorganization.
+	tk.MustExec("alter table t add index idx_a (a), algorithm=instant")
+	tk.MustExec("create unique index idx_b on t (b) algorithm=instant")
+	tk.MustExec("alter table pt add index idx_a (a), algorithm=instant")
+	require.Equal(t, int32(0), reorgStates.Load())
+	tk.MustExec("insert into t values (1, 1, 'a')")
+	tk.MustExec("admin check table t")
+	tk.MustGetDBError("insert into t values (2, 1, 'b')", kv.ErrKeyExists)
+
+	// The index of the non-empty table can't be added instantly.
+	tk.MustGetDBError("alter table t add index idx_ab (a, b), algorithm=instant", dbterror.ErrAlterOperationNotSupported)
+	tk.MustGetDBError("create index idx_ab on t (a, b) algorithm=instant", dbterror.ErrAlterOperationNotSupported)
+	tk.MustExec("insert into pt values (3, 3)")
+	tk.MustGetDBError("alter table pt add index idx_b (b), algorithm=instant", dbterror.ErrAlterOperationNotSupported)
+	tk.MustExec("alter table pt add index idx_b (b), algorithm=inplace")
+	require.Equal(t, int32(1), reorgStates.Load())
+	tk.MustExec("admin check table pt")
+
+	// Only the normal and unique indexes can be added instantly.
+	tk.MustExec("create table t1 (a int, b text)")
+	tk.MustGetDBError("alter table t1 add primary key (a), algorithm=instant", dbterror.ErrAlterOperationNotSupported)
+	tk.MustGetDBError("create fulltext index idx_b on t1 (b) algorithm=instant", dbterror.ErrAlterOperationNotSupported)
+}
@@ -0,0 +1,243 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// maxVerifyIndexInconsistentHandles is the max number of the inconsistent handles recorded by a verify index job.
+const maxVerifyIndexInconsistentHandles = 1000
+
+func init() {
+	registerBackfiller(typeVerifyIndexWorker, "verify index", newVerifyIndexBackfiller)
+}
+
+// verifyIndexWorker checks that each row has the expected entry in the index. The rows without the entry are
+// recorded as inconsistent, and the missing entries are written back if the job repairs the index. The unique
+// entries pointing to the other rows are only recorded, since the right one can't be told.
+type verifyIndexWorker struct {
+	*addIndexTxnWorker
+	repair bool
+}
+
+func newVerifyIndexBackfiller(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error) {
+	reorgInfo, jc := b.reorgInfo, b.jobCtx
+	job := reorgInfo.Job
+	backfillCtx := newBackfillCtx(reorgInfo.d, id, sessCtx, job.SchemaName, b.tbl, jc, "verify_idx_rate", false)
+	txnWorker, err := newAddIndexTxnWorker(b.decodeColMap, b.tbl, backfillCtx,
+		job.ID, reorgInfo.currElement.ID, reorgInfo.currElement.TypeKey)
+	if err != nil {
+		return nil, err
+	}
+	txnWorker.tp = typeVerifyIndexWorker
+	repair := job.ReorgMeta.IndexVerify != nil && job.ReorgMeta.IndexVerify.Repair
+	return &verifyIndexWorker{addIndexTxnWorker: txnWorker, repair: repair}, nil
+}
+
+// BackfillData implements the backfiller interface.
+func (w *verifyIndexWorker) BackfillData(handleRange reorgBackfillTask) (taskCtx backfillTaskContext, errInTxn error) {
+	oprStartTime := time.Now()
+	var (
+		inconsistentHandles []string
+		repairedRows        int64
+	)
+	ctx := kv.WithInternalSourceType(context.Background(), w.jobContext.ddlJobSourceType())
+	errInTxn = kv.RunInNewTxn(ctx, w.sessCtx.GetStore(), true, func(ctx context.Context, txn kv.Transaction) error {
+		taskCtx.addedCount = 0
+		taskCtx.scanCount = 0
+		inconsistentHandles = inconsistentHandles[:0]
+		repairedRows = 0
+		txn.SetOption(kv.Priority, handleRange.priority)
+		if tagger := w.GetCtx().getResourceGroupTaggerForTopSQL(handleRange.getJobID()); tagger != nil {
+			txn.SetOption(kv.ResourceGroupTagger, tagger)
+		}
+
+		idxRecords, nextKey, taskDone, err := w.fetchRowColVals(txn, handleRange)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		taskCtx.nextKey = nextKey
+		taskCtx.done = taskDone
+
+		sc := w.sessCtx.GetSessionVars().StmtCtx
+		tblInfo := w.table.Meta()
+		for _, idxRecord := range idxRecords {
+			taskCtx.scanCount++
+			taskCtx.addedCount++
+			key, distinct, err := w.index.GenIndexKey(sc, idxRecord.vals, idxRecord.handle, nil)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			found, dupHandle, err := tables.FetchDuplicatedHandle(ctx, key, distinct, txn, tblInfo.ID, tblInfo.IsCommonHandle)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if found && (dupHandle == nil || dupHandle.Equal(idxRecord.handle)) {
+				continue
+			}
+			inconsistentHandles = append(inconsistentHandles, idxRecord.handle.String())
+			if !w.repair || found {
+				continue
+			}
+			// Lock the row, so that the concurrent updates of the row conflict with the repair.
+			if err := txn.LockKeys(context.Background(), new(kv.LockCtx), idxRecord.key); err != nil {
+				return errors.Trace(err)
+			}
+			if _, err := w.index.Create(w.sessCtx, txn, idxRecord.vals, idxRecord.handle, idxRecord.rsData); err != nil {
+				return errors.Trace(err)
+			}
+			repairedRows++
+		}
+		// The rate of the verification is limited by the size of the checked records.
+		taskCtx.addedBytes = int(w.memConsumed)
+		return nil
+	})
+	if errInTxn == nil && len(inconsistentHandles) > 0 {
+		logutil.BgLogger().Warn("[ddl] verify index found inconsistent rows", zap.Stringer("worker", w),
+			zap.String("index", w.index.Meta().Name.O), zap.Int("count", len(inconsistentHandles)),
+			zap.Int64("repaired", repairedRows), zap.String("first handle", inconsistentHandles[0]))
+		if rc := w.getReorgCtx(handleRange.getJobID()); rc != nil {
+			rc.addIndexVerifyResult(inconsistentHandles, repairedRows)
+		}
+	}
+	logSlowOperations(time.Since(oprStartTime), "VerifyIndexBackfillData", 3000)
+	return
+}
+
+// onVerifyIndex verifies a public index against the table data. The index isn't changed, so the job finishes
+// without a new schema version.
+func (w *worker) onVerifyIndex(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	var indexID int64
+	if err = job.DecodeArgs(&indexID); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	indexInfo := model.FindIndexInfoByID(tblInfo.Indices, indexID)
+	if indexInfo == nil || indexInfo.State != model.StatePublic {
+		job.State = model.JobStateCancelled
+		return ver, infoschema.ErrKeyNotExists.GenWithStackByArgs(strconv.FormatInt(indexID, 10), tblInfo.Name)
+	}
+	if job.ReorgMeta.IndexVerify == nil {
+		job.ReorgMeta.IndexVerify = &model.IndexVerifyInfo{}
+	}
+
+	switch job.SchemaState {
+	case model.StateNone:
+		// The state only shows the rows are being verified.
+		job.SchemaState = model.StateWriteReorganization
+		return ver, nil
+	case model.StateWriteReorganization:
+		tbl, err := getTable(d.store, job.SchemaID, tblInfo)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		done, err := w.runVerifyIndexReorg(d, t, job, tbl, indexInfo)
+		if err != nil {
+			if dbterror.ErrCancelledDDLJob.Equal(err) || dbterror.ErrCantDecodeRecord.Equal(err) {
+				job.State = model.JobStateCancelled
+			}
+			return ver, errors.Trace(err)
+		}
+		if !done {
+			return ver, nil
+		}
+		job.ReorgMeta.IndexVerify.CheckedRows = job.GetRowCount()
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+		return ver, nil
+	default:
+		return ver, dbterror.ErrInvalidDDLState.GenWithStackByArgs("index", job.SchemaState)
+	}
+}
+
+func (w *worker) runVerifyIndexReorg(d *ddlCtx, t *meta.Meta, job *model.Job, tbl table.Table, indexInfo *model.IndexInfo) (done bool, err error) {
+	sctx, err := w.sessPool.get()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	defer w.sessPool.put(sctx)
+	rh := newReorgHandler(newSession(sctx))
+	dbInfo, err := t.GetDatabase(job.SchemaID)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	elements := []*meta.Element{{ID: indexInfo.ID, TypeKey: meta.IndexElementKey}}
+	reorgInfo, err := getReorgInfo(d.jobContext(job.ID), d, rh, job, dbInfo, tbl, elements, false)
+	if err != nil || reorgInfo == nil || reorgInfo.first {
+		return false, errors.Trace(err)
+	}
+	err = w.runReorgJob(rh, reorgInfo, tbl.Meta(), d.lease, func() (verifyErr error) {
+		defer util.Recover(metrics.LabelDDL, "onVerifyIndex",
+			func() {
+				verifyErr = dbterror.ErrCancelledDDLJob.GenWithStack("verify table `%v` index `%v` panic", tbl.Meta().Name, indexInfo.Name)
+			}, false)
+		return w.verifyTableIndex(tbl, reorgInfo)
+	})
+	if err != nil {
+		if dbterror.ErrWaitReorgTimeout.Equal(err) {
+			// If timeout, we should return, check for the owner and re-wait job done.
+			return false, nil
+		}
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// verifyTableIndex verifies the index of a table, the partitions are verified one by one.
+func (w *worker) verifyTableIndex(t table.Table, reorgInfo *reorgInfo) error {
+	tbl, ok := t.(table.PartitionedTable)
+	if !ok {
+		//nolint:forcetypeassert
+		return w.verifyPhysicalTableIndex(t.(table.PhysicalTable), reorgInfo)
+	}
+	for {
+		p := tbl.GetPartition(reorgInfo.PhysicalTableID)
+		if p == nil {
+			return dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", reorgInfo.PhysicalTableID, t.Meta().ID)
+		}
+		if err := w.verifyPhysicalTableIndex(p, reorgInfo); err != nil {
+			return errors.Trace(err)
+		}
+		finish, err := updateReorgInfo(w.sessPool, tbl, reorgInfo)
+		if err != nil || finish {
+			return errors.Trace(err)
+		}
+	}
+}
+
+func (w *worker) verifyPhysicalTableIndex(t table.PhysicalTable, reorgInfo *reorgInfo) error {
+	logutil.BgLogger().Info("[ddl] start to verify table index", zap.String("job", reorgInfo.Job.String()), zap.String("reorgInfo", reorgInfo.String()))
+	return w.writePhysicalTableRecord(w.sessPool, t, typeVerifyIndexWorker, reorgInfo)
+}
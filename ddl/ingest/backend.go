@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/util/dbterror"
 	"github.com/pingcap/tidb/util/logutil"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 // BackendContext store a backend info for add index reorg task.
@@ -38,27 +39,46 @@ type BackendContext struct {
 	sysVars  map[string]string
 	diskRoot DiskRoot
 	done     bool
+	progress phaseProgress
 }
 
-// FinishImport imports all the key-values in engine into the storage, collects the duplicate errors if any, and
-// removes the engine from the backend context.
+// engineImportConcurrency is the max number of the engines of an index imported concurrently. The write
+// bandwidth of the backend is shared by the engines being imported.
+const engineImportConcurrency = 4
+
+// FinishImport imports all the key-values in the engines of the index into the storage, collects the duplicate
+// errors if any, and removes the engines from the backend context. The engines of the partitions are imported
+// concurrently.
 func (bc *BackendContext) FinishImport(indexID int64, unique bool, tbl table.Table) error {
-	ei, exist := bc.EngMgr.Load(indexID)
-	if !exist {
+	keys := bc.EngMgr.indexEngineKeys(indexID)
+	if len(keys) == 0 {
 		return dbterror.ErrIngestFailed.FastGenByArgs("ingest engine not found")
 	}
 
-	err := ei.ImportAndClean()
-	if err != nil {
+	flushed := bc.progress.startFlush()
+	eg := &errgroup.Group{}
+	eg.SetLimit(engineImportConcurrency)
+	for _, key := range keys {
+		ei, exist := bc.EngMgr.Load(key)
+		if !exist {
+			return dbterror.ErrIngestFailed.FastGenByArgs("ingest engine not found")
+		}
+		eg.Go(func() error {
+			return ei.ImportAndClean(flushed)
+		})
+	}
+	if err := eg.Wait(); err != nil {
 		return err
 	}
+	// The phase is kept as import since all the records have been imported.
+	bc.progress.imported.store(flushed)
 
 	// Check remote duplicate value for the index.
 	if unique {
 		hasDupe, err := bc.backend.CollectRemoteDuplicateRows(bc.ctx, tbl, tbl.Meta().Name.L, &kv.SessionOptions{
 			SQLMode: mysql.ModeStrictAllTables,
 			SysVars: bc.sysVars,
-			IndexID: ei.indexID,
+			IndexID: indexID,
 		})
 		if err != nil {
 			logutil.BgLogger().Error(LitInfoRemoteDupCheck, zap.Error(err),
@@ -75,11 +95,12 @@ func (bc *BackendContext) FinishImport(indexID int64, unique bool, tbl table.Tab
 
 const importThreshold = 0.85
 
-// Flush checks the disk quota and imports the current key-values in engine to the storage.
-func (bc *BackendContext) Flush(indexID int64) error {
-	ei, exist := bc.EngMgr.Load(indexID)
+// Flush checks the disk quota and imports the current key-values in the engine of the index on the physical table
+// to the storage.
+func (bc *BackendContext) Flush(indexID, physicalID int64) error {
+	ei, exist := bc.EngMgr.Load(engineKey{indexID: indexID, physicalID: physicalID})
 	if !exist {
-		logutil.BgLogger().Error(LitErrGetEngineFail, zap.Int64("index ID", indexID))
+		logutil.BgLogger().Error(LitErrGetEngineFail, zap.Int64("index ID", indexID), zap.Int64("physical ID", physicalID))
 		return dbterror.ErrIngestFailed.FastGenByArgs("ingest engine not found")
 	}
 
@@ -92,10 +113,12 @@ func (bc *BackendContext) Flush(indexID int64) error {
 	if bc.diskRoot.CurrentUsage() >= uint64(importThreshold*float64(bc.diskRoot.MaxQuota())) {
 		// TODO: it should be changed according checkpoint solution.
 		// Flush writer cached data into local disk for engine first.
+		flushed := bc.progress.startFlush()
 		err := ei.Flush()
 		if err != nil {
 			return err
 		}
+		bc.progress.startImport(flushed)
 		logutil.BgLogger().Info(LitInfoUnsafeImport, zap.Int64("index ID", indexID),
 			zap.Uint64("current disk usage", bc.diskRoot.CurrentUsage()),
 			zap.Uint64("max disk quota", bc.diskRoot.MaxQuota()))
@@ -106,6 +129,7 @@ func (bc *BackendContext) Flush(indexID int64) error {
 				zap.Uint64("max disk quota", bc.diskRoot.MaxQuota()))
 			return err
 		}
+		bc.progress.finishImport(flushed)
 	}
 	return nil
 }
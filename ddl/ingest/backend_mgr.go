@@ -135,6 +135,15 @@ func (m *backendCtxManager) TotalDiskUsage() uint64 {
 	return totalDiskUsed
 }
 
+// DiskAvailable checks whether the local disk has enough space to run a new ingest backfill.
+func (m *backendCtxManager) DiskAvailable() bool {
+	if err := m.diskRoot.UpdateUsageAndQuota(); err != nil {
+		logutil.BgLogger().Warn(LitErrUpdateDiskStats, zap.Error(err))
+		return false
+	}
+	return m.diskRoot.CurrentUsage() < uint64(importThreshold*float64(m.diskRoot.MaxQuota()))
+}
+
 // UpdateMemoryUsage collects the memory usages from all the backend and updates it to the memRoot.
 func (m *backendCtxManager) UpdateMemoryUsage() {
 	for _, key := range m.Keys() {
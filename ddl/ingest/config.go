@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
 	lightning "github.com/pingcap/tidb/br/pkg/lightning/config"
 	tidb "github.com/pingcap/tidb/config"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/size"
 	"go.uber.org/zap"
@@ -51,6 +52,8 @@ func genConfig(memRoot MemRoot, jobID int64, unique bool) (*Config, error) {
 		return nil, err
 	}
 	adjustImportMemory(memRoot, cfg)
+	// The write limiter is shared by the engines of the backend, so the bandwidth is the budget of the whole job.
+	cfg.TikvImporter.StoreWriteBWLimit = lightning.ByteSize(variable.DDLIngestWriteBandwidth.Load())
 	cfg.Checkpoint.Enable = true
 	if unique {
 		cfg.TikvImporter.DuplicateResolution = lightning.DupeResAlgErr
@@ -19,6 +19,7 @@ import (
 
 	lcom "github.com/pingcap/tidb/br/pkg/lightning/common"
 	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/util/disk"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/mathutil"
 	"go.uber.org/zap"
@@ -35,7 +36,9 @@ const capacityThreshold = 0.9
 
 // diskRootImpl implements DiskRoot interface.
 type diskRootImpl struct {
-	path         string
+	path string
+	// tempPath is the dedicated temporary path configured by [[temp-paths]], it's nil if the default path is used.
+	tempPath     *disk.TempPath
 	currentUsage uint64
 	maxQuota     uint64
 	bcCtx        *backendCtxManager
@@ -43,10 +46,11 @@ type diskRootImpl struct {
 }
 
 // NewDiskRootImpl creates a new DiskRoot.
-func NewDiskRootImpl(path string, bcCtx *backendCtxManager) DiskRoot {
+func NewDiskRootImpl(path string, tempPath *disk.TempPath, bcCtx *backendCtxManager) DiskRoot {
 	return &diskRootImpl{
-		path:  path,
-		bcCtx: bcCtx,
+		path:     path,
+		tempPath: tempPath,
+		bcCtx:    bcCtx,
 	}
 }
 
@@ -76,6 +80,14 @@ func (d *diskRootImpl) UpdateUsageAndQuota() error {
 	}
 	maxQuota := mathutil.Min(variable.DDLDiskQuota.Load(), uint64(capacityThreshold*float64(sz.Capacity)))
 	d.mu.Lock()
+	if d.tempPath != nil {
+		if d.tempPath.Quota > 0 {
+			// The path may be shared with the spilled data of queries.
+			others := d.tempPath.Used() - int64(d.currentUsage)
+			maxQuota = mathutil.Min(maxQuota, uint64(mathutil.Max(d.tempPath.Quota-others, 0)))
+		}
+		d.tempPath.Consume(int64(totalDiskUsage) - int64(d.currentUsage))
+	}
 	d.currentUsage = totalDiskUsage
 	d.maxQuota = maxQuota
 	d.mu.Unlock()
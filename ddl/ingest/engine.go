@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
 	"github.com/pingcap/tidb/br/pkg/lightning/config"
 	tidbkv "github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/util/generic"
 	"github.com/pingcap/tidb/util/logutil"
 	"go.uber.org/zap"
@@ -44,11 +45,16 @@ type engineInfo struct {
 	memRoot      MemRoot
 	diskRoot     DiskRoot
 	rowSeq       atomic.Int64
+	progress     *phaseProgress
 }
 
-// NewEngineInfo create a new EngineInfo struct.
+// NewEngineInfo create a new EngineInfo struct. The progress of the phases is reported to progress,
+// which is usually shared by the engines of a backend context.
 func NewEngineInfo(ctx context.Context, jobID, indexID int64, cfg *backend.EngineConfig,
-	en *backend.OpenedEngine, uuid uuid.UUID, wCnt int, memRoot MemRoot, diskRoot DiskRoot) *engineInfo {
+	en *backend.OpenedEngine, uuid uuid.UUID, wCnt int, memRoot MemRoot, diskRoot DiskRoot, progress *phaseProgress) *engineInfo {
+	if progress == nil {
+		progress = &phaseProgress{}
+	}
 	return &engineInfo{
 		ctx:          ctx,
 		jobID:        jobID,
@@ -60,6 +66,7 @@ func NewEngineInfo(ctx context.Context, jobID, indexID int64, cfg *backend.Engin
 		writerCache:  generic.NewSyncMap[int, *backend.LocalEngineWriter](wCnt),
 		memRoot:      memRoot,
 		diskRoot:     diskRoot,
+		progress:     progress,
 	}
 }
 
@@ -98,9 +105,12 @@ func (ei *engineInfo) Clean() {
 	}
 }
 
-func (ei *engineInfo) ImportAndClean() error {
+// ImportAndClean closes the engine and imports it. The flushed records are the ones of all the engines being
+// imported, the imported records are recorded by the caller once all the engines are imported.
+func (ei *engineInfo) ImportAndClean(flushed model.ReorgPhaseProgress) error {
 	// Close engine and finish local tasks of lightning.
 	logutil.BgLogger().Info(LitInfoCloseEngine, zap.Int64("job ID", ei.jobID), zap.Int64("index ID", ei.indexID))
+	// Closing the engine flushes the records in memory to the local engine files.
 	indexEngine := ei.openedEngine
 	closeEngine, err1 := indexEngine.Close(ei.ctx)
 	if err1 != nil {
@@ -124,6 +134,7 @@ func (ei *engineInfo) ImportAndClean() error {
 	}
 
 	// Ingest data to TiKV.
+	ei.progress.startImport(flushed)
 	logutil.BgLogger().Info(LitInfoStartImport, zap.Int64("job ID", ei.jobID),
 		zap.Int64("index ID", ei.indexID),
 		zap.String("split region size", strconv.FormatInt(int64(config.SplitRegionSize), 10)))
@@ -133,7 +144,6 @@ func (ei *engineInfo) ImportAndClean() error {
 			zap.Int64("job ID", ei.jobID), zap.Int64("index ID", ei.indexID))
 		return err
 	}
-
 	// Clean up the engine local workspace.
 	err = closeEngine.Cleanup(ei.ctx)
 	if err != nil {
@@ -146,9 +156,10 @@ func (ei *engineInfo) ImportAndClean() error {
 
 // WriterContext is used to keep a lightning local writer for each backfill worker.
 type WriterContext struct {
-	ctx    context.Context
-	unique bool
-	lWrite *backend.LocalEngineWriter
+	ctx      context.Context
+	unique   bool
+	lWrite   *backend.LocalEngineWriter
+	progress *phaseProgress
 }
 
 func (ei *engineInfo) NewWriterCtx(id int, unique bool) (*WriterContext, error) {
@@ -191,9 +202,10 @@ func (ei *engineInfo) newWriterContext(workerID int, unique bool) (*WriterContex
 		ei.writerCache.Store(workerID, lWrite)
 	}
 	wc := &WriterContext{
-		ctx:    ei.ctx,
-		unique: unique,
-		lWrite: lWrite,
+		ctx:      ei.ctx,
+		unique:   unique,
+		lWrite:   lWrite,
+		progress: ei.progress,
 	}
 	return wc, nil
 }
@@ -223,5 +235,10 @@ func (wCtx *WriterContext) WriteRow(key, idxVal []byte, handle tidbkv.Handle) er
 		kvs[0].RowID = handle.Encoded()
 	}
 	row := kv.MakeRowsFromKvPairs(kvs)
-	return wCtx.lWrite.WriteRows(wCtx.ctx, nil, row)
+	err := wCtx.lWrite.WriteRows(wCtx.ctx, nil, row)
+	if err != nil {
+		return err
+	}
+	wCtx.progress.scan.add(1, int64(len(key)+len(idxVal)))
+	return nil
 }
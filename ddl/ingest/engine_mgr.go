@@ -18,26 +18,35 @@ import (
 	"fmt"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/util/dbterror"
 	"github.com/pingcap/tidb/util/generic"
 	"github.com/pingcap/tidb/util/logutil"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
+// engineKey identifies an engine of a backend context. Each partition of an index is written into its own engine,
+// so the engines of the partitions can be written and imported concurrently.
+type engineKey struct {
+	indexID    int64
+	physicalID int64
+}
+
 type engineManager struct {
-	generic.SyncMap[int64, *engineInfo]
+	generic.SyncMap[engineKey, *engineInfo]
 	MemRoot  MemRoot
 	DiskRoot DiskRoot
 }
 
 func (m *engineManager) init(memRoot MemRoot, diskRoot DiskRoot) {
-	m.SyncMap = generic.NewSyncMap[int64, *engineInfo](10)
+	m.SyncMap = generic.NewSyncMap[engineKey, *engineInfo](10)
 	m.MemRoot = memRoot
 	m.DiskRoot = diskRoot
 }
 
-// Register create a new engineInfo and register it to the engineManager.
-func (m *engineManager) Register(bc *BackendContext, jobID, indexID int64, schemaName, tableName string) (*engineInfo, error) {
+// Register create a new engineInfo of the index on the physical table and register it to the engineManager.
+func (m *engineManager) Register(bc *BackendContext, jobID, indexID, physicalID int64, schemaName, tableName string) (*engineInfo, error) {
 	// Calculate lightning concurrency degree and set memory usage
 	// and pre-allocate memory usage for worker.
 	m.MemRoot.RefreshConsumption()
@@ -47,7 +56,8 @@ func (m *engineManager) Register(bc *BackendContext, jobID, indexID int64, schem
 	}
 
 	var info string
-	en, exist := m.Load(indexID)
+	key := engineKey{indexID: indexID, physicalID: physicalID}
+	en, exist := m.Load(key)
 	if !exist {
 		engineCacheSize := int64(bc.cfg.TikvImporter.EngineMemCacheSize)
 		ok := m.MemRoot.CheckConsume(StructSizeEngineInfo + engineCacheSize)
@@ -56,17 +66,21 @@ func (m *engineManager) Register(bc *BackendContext, jobID, indexID int64, schem
 		}
 
 		cfg := generateLocalEngineConfig(jobID, schemaName, tableName)
-		openedEn, err := bc.backend.OpenEngine(bc.ctx, cfg, tableName, int32(indexID))
+		// The engine UUID is generated from the table name and the engine ID, so the physical ID is added to the
+		// table name to distinguish the engines of the partitions.
+		engineTableName := fmt.Sprintf("%s#%d", tableName, physicalID)
+		openedEn, err := bc.backend.OpenEngine(bc.ctx, cfg, engineTableName, int32(indexID))
 		if err != nil {
 			logutil.BgLogger().Warn(LitErrCreateEngineFail, zap.Int64("job ID", jobID),
-				zap.Int64("index ID", indexID), zap.Error(err))
+				zap.Int64("index ID", indexID), zap.Int64("physical ID", physicalID), zap.Error(err))
 			return nil, errors.Trace(err)
 		}
 		id := openedEn.GetEngineUUID()
-		en = NewEngineInfo(bc.ctx, jobID, indexID, cfg, openedEn, id, 1, m.MemRoot, m.DiskRoot)
-		m.Store(indexID, en)
+		en = NewEngineInfo(bc.ctx, jobID, indexID, cfg, openedEn, id, 1, m.MemRoot, m.DiskRoot, &bc.progress)
+		bc.progress.setPhase(model.ReorgPhaseScan)
+		m.Store(key, en)
 		m.MemRoot.Consume(StructSizeEngineInfo)
-		m.MemRoot.ConsumeWithTag(encodeEngineTag(jobID, indexID), engineCacheSize)
+		m.MemRoot.ConsumeWithTag(encodeEngineTag(jobID, key), engineCacheSize)
 		info = LitInfoOpenEngine
 	} else {
 		if en.writerCount+1 > bc.cfg.TikvImporter.RangeConcurrency {
@@ -78,9 +92,9 @@ func (m *engineManager) Register(bc *BackendContext, jobID, indexID int64, schem
 		en.writerCount++
 		info = LitInfoAddWriter
 	}
-	m.MemRoot.ConsumeWithTag(encodeEngineTag(jobID, indexID), int64(bc.cfg.TikvImporter.LocalWriterMemCacheSize))
+	m.MemRoot.ConsumeWithTag(encodeEngineTag(jobID, key), int64(bc.cfg.TikvImporter.LocalWriterMemCacheSize))
 	logutil.BgLogger().Info(info, zap.Int64("job ID", jobID),
-		zap.Int64("index ID", indexID),
+		zap.Int64("index ID", indexID), zap.Int64("physical ID", physicalID),
 		zap.Int64("current memory usage", m.MemRoot.CurrentUsage()),
 		zap.Int64("memory limitation", m.MemRoot.MaxMemoryQuota()),
 		zap.Int("current writer count", en.writerCount))
@@ -88,40 +102,62 @@ func (m *engineManager) Register(bc *BackendContext, jobID, indexID int64, schem
 }
 
 // Unregister delete the engineInfo from the engineManager.
-func (m *engineManager) Unregister(jobID, indexID int64) {
-	ei, exist := m.Load(indexID)
+func (m *engineManager) Unregister(jobID int64, key engineKey) {
+	ei, exist := m.Load(key)
 	if !exist {
 		return
 	}
 
 	ei.Clean()
-	m.Delete(indexID)
-	m.MemRoot.ReleaseWithTag(encodeEngineTag(jobID, indexID))
+	m.Delete(key)
+	m.MemRoot.ReleaseWithTag(encodeEngineTag(jobID, key))
 	m.MemRoot.Release(StructSizeWriterCtx * int64(ei.writerCount))
 	m.MemRoot.Release(StructSizeEngineInfo)
 }
 
-// ResetWorkers reset the writer count of the engineInfo because
+// ResetWorkers reset the writer count of the engineInfo of the index on the physical table because
 // the goroutines of backfill workers have been terminated.
-func (m *engineManager) ResetWorkers(bc *BackendContext, jobID, indexID int64) {
-	ei, exist := m.Load(indexID)
+func (m *engineManager) ResetWorkers(bc *BackendContext, jobID, indexID, physicalID int64) {
+	key := engineKey{indexID: indexID, physicalID: physicalID}
+	ei, exist := m.Load(key)
 	if !exist {
 		return
 	}
 	m.MemRoot.Release(StructSizeWriterCtx * int64(ei.writerCount))
-	m.MemRoot.ReleaseWithTag(encodeEngineTag(jobID, indexID))
+	m.MemRoot.ReleaseWithTag(encodeEngineTag(jobID, key))
 	engineCacheSize := int64(bc.cfg.TikvImporter.EngineMemCacheSize)
-	m.MemRoot.ConsumeWithTag(encodeEngineTag(jobID, indexID), engineCacheSize)
+	m.MemRoot.ConsumeWithTag(encodeEngineTag(jobID, key), engineCacheSize)
 	ei.writerCount = 0
 }
 
+// ResetIndexWorkers resets the writer count of all the engines of the index.
+func (m *engineManager) ResetIndexWorkers(bc *BackendContext, jobID, indexID int64) {
+	for _, key := range m.indexEngineKeys(indexID) {
+		m.ResetWorkers(bc, jobID, key.indexID, key.physicalID)
+	}
+}
+
+// indexEngineKeys returns the keys of the engines of the index, ordered by the physical IDs.
+func (m *engineManager) indexEngineKeys(indexID int64) []engineKey {
+	keys := make([]engineKey, 0, 1)
+	for _, key := range m.Keys() {
+		if key.indexID == indexID {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b engineKey) bool {
+		return a.physicalID < b.physicalID
+	})
+	return keys
+}
+
 // UnregisterAll delete all engineInfo from the engineManager.
 func (m *engineManager) UnregisterAll(jobID int64) {
-	for _, idxID := range m.Keys() {
-		m.Unregister(jobID, idxID)
+	for _, key := range m.Keys() {
+		m.Unregister(jobID, key)
 	}
 }
 
-func encodeEngineTag(jobID, indexID int64) string {
-	return fmt.Sprintf("%d-%d", jobID, indexID)
+func encodeEngineTag(jobID int64, key engineKey) string {
+	return fmt.Sprintf("%d-%d-%d", jobID, key.indexID, key.physicalID)
 }
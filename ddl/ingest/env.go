@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/log"
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/disk"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/size"
 	"go.uber.org/zap"
@@ -38,6 +39,8 @@ var (
 	LitRLimit uint64
 	// LitSortPath is the sort path for the lightning backfill process.
 	LitSortPath string
+	// LitTempPath is the dedicated temporary path of LitSortPath, it's nil if the default temp-dir is used.
+	LitTempPath *disk.TempPath
 	// LitInitialized is the flag indicates whether the lightning backfill process is initialized.
 	LitInitialized bool
 )
@@ -63,7 +66,7 @@ func InitGlobalLightningEnv() {
 	}
 	LitSortPath = sPath
 	LitMemRoot = NewMemRootImpl(int64(maxMemoryQuota), &LitBackCtxMgr)
-	LitDiskRoot = NewDiskRootImpl(LitSortPath, &LitBackCtxMgr)
+	LitDiskRoot = NewDiskRootImpl(LitSortPath, LitTempPath, &LitBackCtxMgr)
 	err = LitDiskRoot.UpdateUsageAndQuota()
 	if err != nil {
 		logutil.BgLogger().Warn(LitErrUpdateDiskStats, zap.Error(err),
@@ -85,7 +88,11 @@ func InitGlobalLightningEnv() {
 func genLightningDataDir() (string, error) {
 	tidbCfg := config.GetGlobalConfig()
 	sortPathSuffix := "/tmp_ddl-" + strconv.Itoa(int(tidbCfg.Port))
-	sortPath := filepath.Join(tidbCfg.TempDir, sortPathSuffix)
+	tempDir := tidbCfg.TempDir
+	if LitTempPath = disk.PickTempPath(config.TempPathUsageIngest); LitTempPath != nil {
+		tempDir = LitTempPath.Path
+	}
+	sortPath := filepath.Join(tempDir, sortPathSuffix)
 
 	if info, err := os.Stat(sortPath); err != nil {
 		if !os.IsNotExist(err) {
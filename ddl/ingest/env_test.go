@@ -15,10 +15,12 @@
 package ingest_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/ddl/ingest"
+	"github.com/pingcap/tidb/util/disk"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,4 +34,19 @@ func TestGenLightningDataDir(t *testing.T) {
 	sPath, err := ingest.GenLightningDataDirForTest()
 	require.NoError(t, err)
 	require.Equal(t, tmpDir+"/tmp_ddl-"+port, sPath)
+	require.Nil(t, ingest.LitTempPath)
+
+	// The dedicated temp path for ingest is preferred.
+	ingestPath := filepath.Join(tmpDir, "ingest")
+	require.NoError(t, disk.InitTempPaths([]config.TempPath{
+		{Path: filepath.Join(tmpDir, "spill"), Usage: config.TempPathUsageSpill},
+		{Path: ingestPath, Usage: config.TempPathUsageIngest},
+	}))
+	defer func() {
+		require.NoError(t, disk.InitTempPaths(nil))
+	}()
+	sPath, err = ingest.GenLightningDataDirForTest()
+	require.NoError(t, err)
+	require.Equal(t, ingestPath+"/tmp_ddl-"+port, sPath)
+	require.Equal(t, ingestPath, ingest.LitTempPath.Path)
 }
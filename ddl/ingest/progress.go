@@ -0,0 +1,82 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingest
+
+import (
+	"sync/atomic"
+
+	"github.com/pingcap/tidb/parser/model"
+)
+
+// phaseCounter is the row and byte counters of an ingest phase.
+type phaseCounter struct {
+	rows  atomic.Int64
+	bytes atomic.Int64
+}
+
+func (c *phaseCounter) add(rows, bytes int64) {
+	c.rows.Add(rows)
+	c.bytes.Add(bytes)
+}
+
+func (c *phaseCounter) store(p model.ReorgPhaseProgress) {
+	c.rows.Store(p.Rows)
+	c.bytes.Store(p.Bytes)
+}
+
+func (c *phaseCounter) load() model.ReorgPhaseProgress {
+	return model.ReorgPhaseProgress{Rows: c.rows.Load(), Bytes: c.bytes.Load()}
+}
+
+// phaseProgress records the progress of the scan, flush and import phases of a backend context.
+// It's updated by the backfill workers and read by the DDL worker concurrently.
+type phaseProgress struct {
+	phase    atomic.Uint32
+	scan     phaseCounter
+	flush    phaseCounter
+	imported phaseCounter
+}
+
+func (p *phaseProgress) setPhase(phase model.ReorgPhase) {
+	p.phase.Store(uint32(phase))
+}
+
+// startFlush enters the flush phase, and returns the scanned records that are going to be flushed.
+func (p *phaseProgress) startFlush() model.ReorgPhaseProgress {
+	p.setPhase(model.ReorgPhaseFlush)
+	return p.scan.load()
+}
+
+// startImport records the flushed records and enters the import phase.
+func (p *phaseProgress) startImport(flushed model.ReorgPhaseProgress) {
+	p.flush.store(flushed)
+	p.setPhase(model.ReorgPhaseImport)
+}
+
+// finishImport records the imported records and goes back to the scan phase.
+func (p *phaseProgress) finishImport(imported model.ReorgPhaseProgress) {
+	p.imported.store(imported)
+	p.setPhase(model.ReorgPhaseScan)
+}
+
+// Progress returns the progress of the ingest phases of the backend context.
+func (bc *BackendContext) Progress() *model.IngestProgress {
+	return &model.IngestProgress{
+		Phase:  model.ReorgPhase(bc.progress.phase.Load()),
+		Scan:   bc.progress.scan.load(),
+		Flush:  bc.progress.flush.load(),
+		Import: bc.progress.imported.load(),
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+)
+
+const getJobDependencyInfoSQL = "select job_id, type, reorg, processing, schema_ids, table_ids from mysql.tidb_ddl_job %s order by job_id"
+
+// queueHeadJobsFilter filters the first job of each job queue, which consists of the jobs working on the same
+// objects, and the flashback cluster jobs which conflict with all jobs. The other queued jobs of a queue depend on
+// its first job, so they can't run, and they needn't be checked to find the dependencies of the runnable jobs.
+var queueHeadJobsFilter = fmt.Sprintf("where job_id in (select min(job_id) from mysql.tidb_ddl_job group by schema_ids, table_ids, processing) or type = %d", model.ActionFlashbackCluster)
+
+// jobDependencyInfo is the information of a job in mysql.tidb_ddl_job to find the jobs it depends on.
+type jobDependencyInfo struct {
+	id         int64
+	tp         model.ActionType
+	reorg      bool
+	processing bool
+	schemaIDs  string
+	tableIDs   string
+	// schemaIDSet and tableIDSet are parsed from schemaIDs and tableIDs once before checking the conflicts.
+	schemaIDSet map[string]struct{}
+	tableIDSet  map[string]struct{}
+}
+
+// loadJobDependencyInfos loads the jobs in mysql.tidb_ddl_job which match the filter.
+func loadJobDependencyInfos(sess *session, label string, filter string) ([]*jobDependencyInfo, error) {
+	rows, err := sess.execute(context.Background(), fmt.Sprintf(getJobDependencyInfoSQL, filter), label)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	jobs := make([]*jobDependencyInfo, 0, len(rows))
+	for _, row := range rows {
+		jobs = append(jobs, &jobDependencyInfo{
+			id:         row.GetInt64(0),
+			tp:         model.ActionType(row.GetInt64(1)),
+			reorg:      row.GetInt64(2) == 1,
+			processing: row.GetInt64(3) == 1,
+			schemaIDs:  row.GetString(4),
+			tableIDs:   row.GetString(5),
+		})
+	}
+	return jobs, nil
+}
+
+// parseIDs parses the comma separated schema and table IDs.
+func (j *jobDependencyInfo) parseIDs() {
+	j.schemaIDSet = parseIDSet(j.schemaIDs)
+	j.tableIDSet = parseIDSet(j.tableIDs)
+}
+
+func parseIDSet(ids string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, id := range strings.Split(ids, ",") {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+// isSchemaJob returns whether the job works on a schema rather than on tables, like creating and dropping schema.
+func (j *jobDependencyInfo) isSchemaJob() bool {
+	return j.tableIDs == "0"
+}
+
+// conflictsWith returns whether the two jobs can't run concurrently. The jobs conflict if they work on the same
+// table, or one of them works on a schema which the other one works on. Flashback cluster conflicts with all jobs.
+func (j *jobDependencyInfo) conflictsWith(other *jobDependencyInfo) bool {
+	if j.tp == model.ActionFlashbackCluster || other.tp == model.ActionFlashbackCluster {
+		return true
+	}
+	if j.isSchemaJob() || other.isSchemaJob() {
+		return idsIntersect(j.schemaIDSet, other.schemaIDSet)
+	}
+	return idsIntersect(j.tableIDSet, other.tableIDSet)
+}
+
+// idsIntersect returns whether the two ID sets have a common ID.
+func idsIntersect(a, b map[string]struct{}) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	for id := range a {
+		if _, ok := b[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// buildJobDependencies returns the IDs of the jobs that each queued job depends on. A queued job depends on the
+// conflicting jobs queued before it, and the conflicting processing jobs, since a job with a smaller ID may be
+// queued after a job with a larger ID starts. The jobs without dependencies can run concurrently, and the
+// processing jobs are already running, so they don't depend on others.
+func buildJobDependencies(jobs []*jobDependencyInfo) map[int64][]int64 {
+	deps := make(map[int64][]int64, len(jobs))
+	for _, job := range jobs {
+		job.parseIDs()
+	}
+	for _, job := range jobs {
+		if job.processing {
+			continue
+		}
+		for _, other := range jobs {
+			if other.id == job.id || (other.id > job.id && !other.processing) {
+				continue
+			}
+			if job.conflictsWith(other) {
+				deps[job.id] = append(deps[job.id], other.id)
+			}
+		}
+	}
+	return deps
+}
+
+// JobDependency is a job in the DDL job queue and the jobs it depends on.
+type JobDependency struct {
+	JobID      int64
+	JobType    model.ActionType
+	SchemaIDs  string
+	TableIDs   string
+	Processing bool
+	// DependsOn is the IDs of the jobs that must be finished before the job runs.
+	DependsOn []int64
+}
+
+// GetDDLJobDependencies returns the jobs in the DDL job queue and their dependencies, ordered by the job ID.
+func GetDDLJobDependencies(sctx sessionctx.Context) ([]*JobDependency, error) {
+	jobs, err := loadJobDependencyInfos(newSession(sctx), "get_job_dependencies", "")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	deps := buildJobDependencies(jobs)
+	result := make([]*JobDependency, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, &JobDependency{
+			JobID:      job.id,
+			JobType:    job.tp,
+			SchemaIDs:  job.schemaIDs,
+			TableIDs:   job.tableIDs,
+			Processing: job.processing,
+			DependsOn:  deps[job.id],
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildJobDependencies(t *testing.T) {
+	jobs := []*jobDependencyInfo{
+		{id: 1, tp: model.ActionAddIndex, reorg: true, processing: true, schemaIDs: "2", tableIDs: "10"},
+		{id: 2, tp: model.ActionAddColumn, schemaIDs: "2", tableIDs: "10"},
+		{id: 3, tp: model.ActionCreateTable, schemaIDs: "2", tableIDs: "11"},
+		// Exchange partition works on both tables.
+		{id: 4, tp: model.ActionExchangeTablePartition, schemaIDs: "2", tableIDs: "11,12"},
+		{id: 5, tp: model.ActionAddIndex, reorg: true, schemaIDs: "2", tableIDs: "12"},
+		{id: 6, tp: model.ActionCreateTable, schemaIDs: "3", tableIDs: "13"},
+		// Dropping schema waits for all the jobs of the schema.
+		{id: 7, tp: model.ActionDropSchema, schemaIDs: "2", tableIDs: "0"},
+		{id: 8, tp: model.ActionCreateSchema, schemaIDs: "4", tableIDs: "0"},
+		// The processing job with a larger ID blocks the conflicting queued jobs.
+		{id: 9, tp: model.ActionAddIndex, reorg: true, processing: true, schemaIDs: "5", tableIDs: "14"},
+	}
+	deps := buildJobDependencies(append(jobs, &jobDependencyInfo{id: 0, tp: model.ActionModifyColumn, schemaIDs: "5", tableIDs: "14"}))
+	require.Equal(t, map[int64][]int64{
+		0: {9},
+		2: {1},
+		4: {3},
+		5: {4},
+		7: {1, 2, 3, 4, 5},
+	}, deps)
+
+	// Flashback cluster conflicts with all the jobs.
+	deps = buildJobDependencies([]*jobDependencyInfo{
+		{id: 1, tp: model.ActionCreateTable, schemaIDs: "2", tableIDs: "10"},
+		{id: 2, tp: model.ActionFlashbackCluster, schemaIDs: "0", tableIDs: "0"},
+		{id: 3, tp: model.ActionCreateSchema, schemaIDs: "4", tableIDs: "0"},
+	})
+	require.Equal(t, map[int64][]int64{2: {1}, 3: {2}}, deps)
+}
+
+func TestJobDependencyIDsIntersect(t *testing.T) {
+	require.True(t, idsIntersect(parseIDSet("11,12"), parseIDSet("12")))
+	require.True(t, idsIntersect(parseIDSet("13"), parseIDSet("10,11,12,13")))
+	require.False(t, idsIntersect(parseIDSet("1,12"), parseIDSet("11,2")))
+	// The IDs are compared as a whole.
+	require.False(t, idsIntersect(parseIDSet("1"), parseIDSet("11")))
+}
@@ -31,13 +31,16 @@ import (
 	"github.com/pingcap/tidb/meta"
 	"github.com/pingcap/tidb/metrics"
 	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/types"
 	tidbutil "github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/dbterror"
 	"github.com/pingcap/tidb/util/intest"
 	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
@@ -68,23 +71,13 @@ func (dc *ddlCtx) deleteRunningDDLJobMap(id int64) {
 	delete(dc.runningJobs.ids, id)
 }
 
-func (dc *ddlCtx) excludeJobIDs() string {
+func (dc *ddlCtx) isRunningDDLJob(id int64) bool {
 	dc.runningJobs.RLock()
 	defer dc.runningJobs.RUnlock()
-	if len(dc.runningJobs.ids) == 0 {
-		return ""
-	}
-	dc.runningJobIDs = dc.runningJobIDs[:0]
-	for id := range dc.runningJobs.ids {
-		dc.runningJobIDs = append(dc.runningJobIDs, strconv.Itoa(int(id)))
-	}
-	return fmt.Sprintf("and job_id not in (%s)", strings.Join(dc.runningJobIDs, ","))
+	_, ok := dc.runningJobs.ids[id]
+	return ok
 }
 
-const (
-	getJobSQL = "select job_meta, processing from mysql.tidb_ddl_job where job_id in (select min(job_id) from mysql.tidb_ddl_job group by schema_ids, table_ids, processing) and %s reorg %s order by processing desc, job_id"
-)
-
 type jobType int
 
 func (t jobType) String() string {
@@ -102,73 +95,67 @@ const (
 	reorg
 )
 
-func (d *ddl) getJob(sess *session, tp jobType, filter func(*model.Job) (bool, error)) (*model.Job, error) {
-	not := "not"
-	label := "get_job_general"
+// getJob gets a job of the type to run. The processing jobs which aren't running on this instance are run first,
+// they were run by the previous owner. Then the first queued job which doesn't depend on other jobs is run.
+// Only the first jobs of the job queues are loaded, the other jobs are blocked by them.
+// The jobs whose maintenance windows are closed are skipped unless they are cancelled.
+func (d *ddl) getJob(sess *session, tp jobType) (*model.Job, error) {
+	label, isReorg := "get_job_general", 0
 	if tp == reorg {
-		not = ""
-		label = "get_job_reorg"
+		label, isReorg = "get_job_reorg", 1
 	}
-	sql := fmt.Sprintf(getJobSQL, not, d.excludeJobIDs())
-	rows, err := sess.execute(context.Background(), sql, label)
+	rows, err := sess.execute(context.Background(), fmt.Sprintf("select count(1) from mysql.tidb_ddl_job where not processing and reorg = %d", isReorg), label)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	for _, row := range rows {
-		jobBinary := row.GetBytes(0)
-		runJob := model.Job{}
-		err := runJob.Decode(jobBinary)
-		if err != nil {
-			return nil, errors.Trace(err)
+	metrics.DDLQueueJobCount.WithLabelValues(tp.String()).Set(float64(rows[0].GetInt64(0)))
+	jobs, err := loadJobDependencyInfos(sess, label, queueHeadJobsFilter)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	deps := buildJobDependencies(jobs)
+	slices.SortStableFunc(jobs, func(a, b *jobDependencyInfo) bool {
+		return a.processing && !b.processing
+	})
+	for _, job := range jobs {
+		if job.reorg != (tp == reorg) || d.isRunningDDLJob(job.id) {
+			continue
 		}
-		if row.GetInt64(1) == 1 {
-			return &runJob, nil
+		if !job.processing && len(deps[job.id]) > 0 {
+			continue
 		}
-		b, err := filter(&runJob)
+		runJobs, err := getJobsBySQL(sess, JobTable, fmt.Sprintf("job_id = %d", job.id))
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
-		if b {
-			if err := d.markJobProcessing(sess, &runJob); err != nil {
+		if len(runJobs) == 0 {
+			// The job is finished by the previous owner.
+			continue
+		}
+		runJob := runJobs[0]
+		if !runJob.IsCancelling() && !runJob.IsRollingback() && !isMaintenanceWindowOpen(runJob.MaintenanceWindow, time.Now()) {
+			// The job waits for its maintenance window to open, the jobs depending on it wait too.
+			// The cancelled jobs are rolled back at once.
+			continue
+		}
+		if !job.processing {
+			if err := d.markJobProcessing(sess, runJob); err != nil {
 				logutil.BgLogger().Warn("[ddl] handle ddl job failed: mark job is processing meet error", zap.Error(err), zap.String("job", runJob.String()))
 				return nil, errors.Trace(err)
 			}
-			return &runJob, nil
+			metrics.DDLQueueWaitHistogram.WithLabelValues(tp.String()).Observe(time.Since(model.TSConvert2Time(runJob.StartTS)).Seconds())
 		}
+		return runJob, nil
 	}
 	return nil, nil
 }
 
 func (d *ddl) getGeneralJob(sess *session) (*model.Job, error) {
-	return d.getJob(sess, general, func(job *model.Job) (bool, error) {
-		if job.Type == model.ActionDropSchema {
-			// Check if there is any reorg job on this schema.
-			sql := fmt.Sprintf("select job_id from mysql.tidb_ddl_job where CONCAT(',', schema_ids, ',') REGEXP CONCAT(',', %s, ',') != 0 and processing limit 1", strconv.Quote(strconv.FormatInt(job.SchemaID, 10)))
-			return d.checkJobIsRunnable(sess, sql)
-		}
-		// Check if there is any running job works on the same table.
-		sql := fmt.Sprintf("select job_id from mysql.tidb_ddl_job t1, (select table_ids from mysql.tidb_ddl_job where job_id = %d) t2 where "+
-			"(processing and CONCAT(',', t2.table_ids, ',') REGEXP CONCAT(',', REPLACE(t1.table_ids, ',', '|'), ',') != 0)"+
-			"or (type = %d and processing)", job.ID, model.ActionFlashbackCluster)
-		return d.checkJobIsRunnable(sess, sql)
-	})
-}
-
-func (d *ddl) checkJobIsRunnable(sess *session, sql string) (bool, error) {
-	rows, err := sess.execute(context.Background(), sql, "check_runnable")
-	return len(rows) == 0, err
+	return d.getJob(sess, general)
 }
 
 func (d *ddl) getReorgJob(sess *session) (*model.Job, error) {
-	return d.getJob(sess, reorg, func(job *model.Job) (bool, error) {
-		// Check if there is any block ddl running, like drop schema and flashback cluster.
-		sql := fmt.Sprintf("select job_id from mysql.tidb_ddl_job where "+
-			"(CONCAT(',', schema_ids, ',') REGEXP CONCAT(',', %s, ',') != 0 and type = %d and processing) "+
-			"or (CONCAT(',', table_ids, ',') REGEXP CONCAT(',', %s, ',') != 0 and processing) "+
-			"or (type = %d and processing) limit 1",
-			strconv.Quote(strconv.FormatInt(job.SchemaID, 10)), model.ActionDropSchema, strconv.Quote(strconv.FormatInt(job.TableID, 10)), model.ActionFlashbackCluster)
-		return d.checkJobIsRunnable(sess, sql)
-	})
+	return d.getJob(sess, reorg)
 }
 
 func (d *ddl) startDispatchLoop() {
@@ -190,6 +177,8 @@ func (d *ddl) startDispatchLoop() {
 		}
 		if !d.isOwner() {
 			d.once.Store(true)
+			// The queues are reported by the owner only.
+			metrics.DDLQueueJobCount.Reset()
 			time.Sleep(dispatchLoopWaitingDuration)
 			continue
 		}
@@ -392,7 +381,16 @@ func (d *ddl) loadBackfillJobAndRun() {
 
 	// TODO: Add ele info to distinguish backfill jobs.
 	// Get a Backfill job to get the reorg info like element info, schema ID and so on.
-	bfJob, err := GetBackfillJobForOneEle(sess, runningJobIDs, InstanceLease)
+	// The backfill jobs placed on the servers with other labels are skipped.
+	excludedJobIDs := runningJobIDs
+	var bfJob *BackfillJob
+	for {
+		bfJob, err = GetBackfillJobForOneEle(sess, excludedJobIDs, InstanceLease)
+		if err != nil || bfJob == nil || matchReorgWorkerLabel(bfJob.Meta.WorkerLabel) {
+			break
+		}
+		excludedJobIDs = append(excludedJobIDs, bfJob.JobID)
+	}
 	if err != nil || bfJob == nil {
 		if err != nil {
 			logutil.BgLogger().Warn("[ddl] get backfill jobs failed in this instance", zap.Error(err))
@@ -403,7 +401,7 @@ func (d *ddl) loadBackfillJobAndRun() {
 		return
 	}
 
-	jobCtx, existent := d.setBackfillCtxJobContext(bfJob.JobID, bfJob.Meta.Query, bfJob.Meta.Type)
+	jobCtx, existent := d.setBackfillCtxJobContext(bfJob.JobID, bfJob.Meta.Query, bfJob.Meta.Type, bfJob.Meta.Labels)
 	if existent {
 		logutil.BgLogger().Warn("[ddl] get the type of backfill job is running in this instance", zap.String("backfill job", bfJob.AbbrStr()))
 		d.sessPool.put(se)
@@ -689,6 +687,9 @@ func AddBackfillJobs(s *session, backfillJobs []*BackfillJob) error {
 	})
 }
 
+// notGCSubtaskCond filters out the subtasks of GC, which share the tidb_background_subtask table with the backfill jobs.
+var notGCSubtaskCond = fmt.Sprintf("(namespace is null or namespace != '%s')", BackgroundSubtaskGCNamespace)
+
 // GetBackfillJobForOneEle gets the backfill jobs in the tblName table that contains only one element.
 func GetBackfillJobForOneEle(s *session, excludedJobIDs []int64, lease time.Duration) (*BackfillJob, error) {
 	eJobIDsBuilder := strings.Builder{}
@@ -705,8 +706,8 @@ func GetBackfillJobForOneEle(s *session, excludedJobIDs []int64, lease time.Dura
 		}
 		leaseStr := currTime.Add(-lease).Format(types.TimeFormat)
 		bJobs, err = GetBackfillJobs(se, BackgroundSubtaskTable,
-			fmt.Sprintf("(exec_id = '' or exec_expired < '%v') %s order by task_key limit 1",
-				leaseStr, eJobIDsBuilder.String()), "get_backfill_job")
+			fmt.Sprintf("(exec_id = '' or exec_expired < '%v') and %s %s order by task_key limit 1",
+				leaseStr, notGCSubtaskCond, eJobIDsBuilder.String()), "get_backfill_job")
 		return err
 	})
 	if err != nil || len(bJobs) == 0 {
@@ -733,8 +734,8 @@ func GetAndMarkBackfillJobsForOneEle(s *session, batch int, jobID int64, uuid st
 		if pTblID != getJobWithoutPartition {
 			if pTblID == 0 {
 				rows, err := s.execute(context.Background(),
-					fmt.Sprintf("select ddl_physical_tid from mysql.%s group by substring_index(task_key,\"_\",3), ddl_physical_tid having max(length(exec_id)) = 0 or max(exec_expired) < '%s' order by substring_index(task_key,\"_\",3), ddl_physical_tid limit 1",
-						BackgroundSubtaskTable, leaseStr), "get_mark_backfill_job")
+					fmt.Sprintf("select ddl_physical_tid from mysql.%s where %s group by substring_index(task_key,\"_\",3), ddl_physical_tid having max(length(exec_id)) = 0 or max(exec_expired) < '%s' order by substring_index(task_key,\"_\",3), ddl_physical_tid limit 1",
+						BackgroundSubtaskTable, notGCSubtaskCond, leaseStr), "get_mark_backfill_job")
 				if err != nil {
 					return errors.Trace(err)
 				}
@@ -885,6 +886,23 @@ func GetBackfillJobs(sess *session, tblName, condition string, label string) ([]
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	return decodeBackfillJobs(rows)
+}
+
+// GetReorgTasks gets the backfill tasks of the DDL job, including the finished ones in the history table.
+func GetReorgTasks(ctx context.Context, sctx sessionctx.Context, jobID int64) ([]*BackfillJob, error) {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnDDL)
+	prefix := fmt.Sprintf("%d\\_%%", jobID)
+	rows, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil,
+		"select * from mysql.%n where task_key like %? union all select * from mysql.%n where task_key like %? order by id",
+		BackgroundSubtaskTable, prefix, BackgroundSubtaskHistoryTable, prefix)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return decodeBackfillJobs(rows)
+}
+
+func decodeBackfillJobs(rows []chunk.Row) ([]*BackfillJob, error) {
 	bJobs := make([]*BackfillJob, 0, len(rows))
 	for _, row := range rows {
 		key := row.GetString(2)
@@ -148,6 +148,180 @@ func TestDDLScheduling(t *testing.T) {
 	check(t, record, 8, 9)
 }
 
+func TestShowDDLDependencies(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustQuery("admin show ddl dependencies").Check(testkit.Rows())
+
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec("use test")
+	var wg util.WaitGroupWrapper
+	var rows [][]interface{}
+	var once sync.Once
+	hook := &callback.TestDDLCallback{Do: dom}
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if job.Type != model.ActionAddIndex || job.SchemaState != model.StateWriteReorganization {
+			return
+		}
+		once.Do(func() {
+			wg.Run(func() {
+				tk1.MustExec("alter table t add column c int")
+			})
+			// Wait until the second job is queued.
+			for {
+				jobs, err := ddl.GetAllDDLJobs(testkit.NewTestKit(t, store).Session(), nil)
+				require.NoError(t, err)
+				if len(jobs) == 2 {
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			rows = testkit.NewTestKit(t, store).MustQuery("admin show ddl dependencies").Rows()
+		})
+	}
+	dom.DDL().SetHook(hook)
+	tk.MustExec("alter table t add index idx(a)")
+	wg.Wait()
+
+	require.Len(t, rows, 2)
+	addIndexID, addColumnID := rows[0][0].(string), rows[1][0].(string)
+	require.Equal(t, []interface{}{addIndexID, "add index", rows[0][2], rows[0][3], "running", ""}, rows[0])
+	require.Equal(t, []interface{}{addColumnID, "add column", rows[0][2], rows[0][3], "waiting", addIndexID}, rows[1])
+	tk.MustQuery("admin show ddl dependencies").Check(testkit.Rows())
+}
+
+func TestExplainRollbackDDLJob(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+
+	var rows [][]interface{}
+	var jobID int64
+	var once sync.Once
+	hook := &callback.TestDDLCallback{Do: dom}
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if job.Type != model.ActionAddIndex || job.SchemaState != model.StateWriteReorganization {
+			return
+		}
+		once.Do(func() {
+			jobID = job.ID
+			rows = testkit.NewTestKit(t, store).MustQuery(fmt.Sprintf("admin explain rollback ddl job %d", job.ID)).Rows()
+		})
+	}
+	dom.DDL().SetHook(hook)
+	tk.MustExec("alter table t add index idx(a)")
+
+	require.Len(t, rows, 1)
+	require.Equal(t, []interface{}{strconv.FormatInt(jobID, 10), "add index", "write reorganization", "YES",
+		"drop the adding index `idx` and delete the keys"}, rows[0][:5])
+	// The job is finished and removed from the queue.
+	err := tk.ExecToErr(fmt.Sprintf("admin explain rollback ddl job %d", jobID))
+	require.ErrorContains(t, err, fmt.Sprintf("DDL Job:%d not found", jobID))
+}
+
+func TestMaintenanceWindow(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+
+	tk.MustGetErrMsg("create maintenance window w schedule '02:00'", "invalid maintenance window schedule '02:00', it should be like '02:00-05:00 UTC'")
+	tk.MustGetErrMsg("create maintenance window w schedule '02:00-05:00' max_reorg_rate = 'fast'", "invalid max reorg rate 'fast', it should be like '200MB/s'")
+	tk.MustGetDBError("alter table t add index idx(a) in window w", dbterror.ErrMaintenanceWindowNotExists)
+
+	now := time.Now().UTC()
+	openSchedule := fmt.Sprintf("%s-%s", now.Add(-time.Hour).Format("15:04"), now.Add(time.Hour).Format("15:04"))
+	closedSchedule := fmt.Sprintf("%s-%s UTC", now.Add(time.Hour).Format("15:04"), now.Add(2*time.Hour).Format("15:04"))
+	tk.MustExec(fmt.Sprintf("create maintenance window w_open schedule '%s' max_reorg_rate = '200MB/s'", openSchedule))
+	tk.MustGetDBError("create maintenance window w_open schedule '02:00-05:00'", dbterror.ErrMaintenanceWindowExists)
+	tk.MustExec("create maintenance window if not exists W_OPEN schedule '02:00-05:00'")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 8255 Maintenance window 'W_OPEN' already exists"))
+	tk.MustQuery("select name, schedule, max_reorg_rate from mysql.tidb_maintenance_windows").Check(testkit.Rows("w_open " + openSchedule + " 209715200"))
+
+	// The job in the open window runs at once.
+	tk.MustExec("alter table t add index idx(a) in window w_open")
+	tk.MustExec("admin check index t idx")
+	jobID, err := strconv.ParseInt(tk.MustQuery("admin show ddl jobs 1").Rows()[0][0].(string), 10, 64)
+	require.NoError(t, err)
+	historyJob, err := ddl.GetHistoryJobByID(tk.Session(), jobID)
+	require.NoError(t, err)
+	require.Equal(t, &model.MaintenanceWindow{Name: "w_open", Schedule: openSchedule, MaxReorgRate: 200 << 20}, historyJob.MaintenanceWindow)
+
+	// The job in the closed window waits in the queue.
+	tk.MustExec(fmt.Sprintf("create maintenance window w_closed schedule '%s'", closedSchedule))
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec("use test")
+	var wg util.WaitGroupWrapper
+	wg.Run(func() {
+		tk1.MustGetDBError("alter table t add column c int in window w_closed", dbterror.ErrCancelledDDLJob)
+	})
+	var jobs []*model.Job
+	require.Eventually(t, func() bool {
+		jobs, err = ddl.GetAllDDLJobs(testkit.NewTestKit(t, store).Session(), nil)
+		require.NoError(t, err)
+		return len(jobs) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	// Give the scheduler a few rounds to pick the job.
+	time.Sleep(200 * time.Millisecond)
+	jobs, err = ddl.GetAllDDLJobs(testkit.NewTestKit(t, store).Session(), nil)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, model.JobStateQueueing, jobs[0].State)
+	require.Equal(t, "w_closed", jobs[0].MaintenanceWindow.Name)
+	// The cancelled job is rolled back without waiting for the window.
+	tk.MustExec(fmt.Sprintf("admin cancel ddl jobs %d", jobs[0].ID))
+	wg.Wait()
+	tk.MustQuery("select * from t").Sort().Check(testkit.Rows("1 1", "2 2"))
+
+	tk.MustExec("drop maintenance window w_closed")
+	tk.MustGetDBError("drop maintenance window w_closed", dbterror.ErrMaintenanceWindowNotExists)
+	tk.MustExec("drop maintenance window if exists w_closed")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 8256 Unknown maintenance window 'w_closed'"))
+}
+
+func TestDDLQueue(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustQuery("select * from information_schema.ddl_queue").Check(testkit.Rows(
+		"general 0 0 <nil> <nil> <nil> <nil>",
+		"reorg 0 0 <nil> <nil> <nil> <nil>",
+	))
+
+	// The job in the closed window waits in the reorg queue.
+	now := time.Now().UTC()
+	closedSchedule := fmt.Sprintf("%s-%s UTC", now.Add(time.Hour).Format("15:04"), now.Add(2*time.Hour).Format("15:04"))
+	tk.MustExec(fmt.Sprintf("create maintenance window w_closed schedule '%s'", closedSchedule))
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec("use test")
+	var wg util.WaitGroupWrapper
+	wg.Run(func() {
+		tk1.MustGetDBError("alter table t add index idx(a) in window w_closed", dbterror.ErrCancelledDDLJob)
+	})
+	var jobs []*model.Job
+	require.Eventually(t, func() bool {
+		var err error
+		jobs, err = ddl.GetAllDDLJobs(testkit.NewTestKit(t, store).Session(), nil)
+		require.NoError(t, err)
+		return len(jobs) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	rows := tk.MustQuery("select queue, waiting_jobs, running_jobs, oldest_waiting_job_id, oldest_waiting_job_type, max_wait_seconds >= 0 from information_schema.ddl_queue").Rows()
+	require.Equal(t, [][]interface{}{
+		{"general", "0", "0", "<nil>", "<nil>", "<nil>"},
+		{"reorg", "1", "0", strconv.FormatInt(jobs[0].ID, 10), "add index", "1"},
+	}, rows)
+
+	tk.MustExec(fmt.Sprintf("admin cancel ddl jobs %d", jobs[0].ID))
+	wg.Wait()
+	tk.MustQuery("select waiting_jobs, running_jobs from information_schema.ddl_queue").Check(testkit.Rows("0 0", "0 0"))
+}
+
 // check will check if there are any cross between ids.
 // e.g. if ids is [1, 2] this function checks all `1` is before or after than `2` in record.
 func check(t *testing.T, record []int64, ids ...int64) {
@@ -827,3 +1001,40 @@ func TestGetTasks(t *testing.T) {
 	})
 	wg.Wait()
 }
+
+func TestGetReorgTasks(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	se := ddl.NewSession(tk.Session())
+	se.GetSessionVars().SQLMode = mysql.ModeNone
+
+	// The tasks of job 11 shouldn't be returned for job 1.
+	jobID1, jobID2 := int64(1), int64(11)
+	bJobs1 := makeAddIdxBackfillJobs(1, 2, jobID1, 21, 2, "alter table t add index idx(a)")
+	bJobs2 := makeAddIdxBackfillJobs(1, 2, jobID2, 22, 1, "alter table t add index idx(b)")
+	require.NoError(t, ddl.AddBackfillJobs(se, append(bJobs1[1:], bJobs2...)))
+	bJobs1[0].State = model.JobStateDone
+	bJobs1[0].Meta.RowCount = 10
+	require.NoError(t, ddl.AddBackfillHistoryJob(se, bJobs1[:1]))
+
+	tasks, err := ddl.GetReorgTasks(context.Background(), tk.Session(), jobID1)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	slices.SortFunc(tasks, func(i, j *ddl.BackfillJob) bool { return i.ID < j.ID })
+	for i, task := range tasks {
+		require.Equal(t, bJobs1[i].ID, task.ID)
+		require.Equal(t, bJobs1[i].State, task.State)
+		require.Equal(t, bJobs1[i].Meta.RowCount, task.Meta.RowCount)
+	}
+
+	// Query the tasks with the table function.
+	tk.MustQuery("select job_id, element_id, task_id, state, row_count, start_key, end_key, current_key " +
+		"from tidb_ddl_reorg_tasks(1) order by task_id").Check(testkit.Rows(
+		"1 21 0 done 10 30 31 30",
+		"1 21 1 none 0 31 32 31",
+	))
+	tk.MustQuery("select count(*) from tidb_ddl_reorg_tasks('11')").Check(testkit.Rows("1"))
+	tk.MustQuery("select count(*) from tidb_ddl_reorg_tasks(2)").Check(testkit.Rows("0"))
+	tk.MustQuery("select count(*) from tidb_ddl_reorg_tasks(null)").Check(testkit.Rows("0"))
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// maintenanceSchedule is the daily time range of a maintenance window.
+type maintenanceSchedule struct {
+	start time.Duration
+	end   time.Duration
+	loc   *time.Location
+}
+
+// parseMaintenanceSchedule parses the schedule like '02:00-05:00 UTC'. The time zone is optional and UTC by default.
+// The window crosses midnight if it ends earlier than it starts.
+func parseMaintenanceSchedule(schedule string) (*maintenanceSchedule, error) {
+	invalidErr := errors.Errorf("invalid maintenance window schedule '%s', it should be like '02:00-05:00 UTC'", schedule)
+	fields := strings.Fields(schedule)
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, invalidErr
+	}
+	startStr, endStr, ok := strings.Cut(fields[0], "-")
+	if !ok {
+		return nil, invalidErr
+	}
+	start, err := parseMaintenanceTime(startStr)
+	if err != nil {
+		return nil, invalidErr
+	}
+	end, err := parseMaintenanceTime(endStr)
+	if err != nil || start == end {
+		return nil, invalidErr
+	}
+	loc := time.UTC
+	if len(fields) == 2 {
+		if loc, err = time.LoadLocation(fields[1]); err != nil {
+			return nil, invalidErr
+		}
+	}
+	return &maintenanceSchedule{start: start, end: end, loc: loc}, nil
+}
+
+func parseMaintenanceTime(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// contains returns whether the time is in the schedule.
+func (s *maintenanceSchedule) contains(now time.Time) bool {
+	now = now.In(s.loc)
+	d := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute + time.Duration(now.Second())*time.Second
+	if s.start < s.end {
+		return d >= s.start && d < s.end
+	}
+	return d >= s.start || d < s.end
+}
+
+// parseMaxReorgRate parses the rate like '200MB/s' to bytes per second, the empty rate means unlimited.
+func parseMaxReorgRate(maxReorgRate string) (uint64, error) {
+	if maxReorgRate == "" {
+		return 0, nil
+	}
+	size := strings.TrimSuffix(strings.ToUpper(strings.TrimSpace(maxReorgRate)), "/S")
+	bytes, err := units.RAMInBytes(size)
+	if err != nil || bytes <= 0 {
+		return 0, errors.Errorf("invalid max reorg rate '%s', it should be like '200MB/s'", maxReorgRate)
+	}
+	return uint64(bytes), nil
+}
+
+// NewMaintenanceWindow checks the schedule and the max reorg rate, and returns the maintenance window.
+func NewMaintenanceWindow(name, schedule, maxReorgRate string) (*model.MaintenanceWindow, error) {
+	if _, err := parseMaintenanceSchedule(schedule); err != nil {
+		return nil, err
+	}
+	bytes, err := parseMaxReorgRate(maxReorgRate)
+	if err != nil {
+		return nil, err
+	}
+	return &model.MaintenanceWindow{Name: name, Schedule: schedule, MaxReorgRate: bytes}, nil
+}
+
+// isMaintenanceWindowOpen returns whether the job in the window can run at the time.
+// The job without a maintenance window can always run.
+func isMaintenanceWindowOpen(window *model.MaintenanceWindow, now time.Time) bool {
+	if window == nil {
+		return true
+	}
+	schedule, err := parseMaintenanceSchedule(window.Schedule)
+	if err != nil {
+		// The schedule is checked when the window is created, it should not happen.
+		logutil.BgLogger().Warn("[ddl] invalid maintenance window schedule", zap.String("window", window.Name), zap.Error(err))
+		return true
+	}
+	return schedule.contains(now)
+}
+
+// newReorgRateLimiter returns the limiter of the bytes written by the reorganization per second,
+// it returns nil if the rate is unlimited.
+func newReorgRateLimiter(window *model.MaintenanceWindow) *rate.Limiter {
+	if window == nil || window.MaxReorgRate == 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(window.MaxReorgRate), int(window.MaxReorgRate))
+}
+
+// GetMaintenanceWindow gets the maintenance window by its name.
+func GetMaintenanceWindow(sctx sessionctx.Context, name string) (*model.MaintenanceWindow, error) {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+	rows, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil,
+		"SELECT name, schedule, max_reorg_rate FROM mysql.tidb_maintenance_windows WHERE name = %?", strings.ToLower(name))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(rows) == 0 {
+		return nil, dbterror.ErrMaintenanceWindowNotExists.GenWithStackByArgs(name)
+	}
+	return &model.MaintenanceWindow{
+		Name:         rows[0].GetString(0),
+		Schedule:     rows[0].GetString(1),
+		MaxReorgRate: rows[0].GetUint64(2),
+	}, nil
+}
+
+// CreateMaintenanceWindow creates a maintenance window.
+func CreateMaintenanceWindow(sctx sessionctx.Context, stmt *ast.CreateMaintenanceWindowStmt) error {
+	window, err := NewMaintenanceWindow(stmt.WindowName.L, stmt.Schedule, stmt.MaxReorgRate)
+	if err != nil {
+		return err
+	}
+	_, err = GetMaintenanceWindow(sctx, stmt.WindowName.L)
+	if err == nil {
+		err = dbterror.ErrMaintenanceWindowExists.GenWithStackByArgs(stmt.WindowName.O)
+		if stmt.IfNotExists {
+			sctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	if !dbterror.ErrMaintenanceWindowNotExists.Equal(err) {
+		return err
+	}
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+	_, _, err = sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil,
+		"INSERT INTO mysql.tidb_maintenance_windows (name, schedule, max_reorg_rate) VALUES (%?, %?, %?)",
+		window.Name, window.Schedule, window.MaxReorgRate)
+	if kv.ErrKeyExists.Equal(err) {
+		return dbterror.ErrMaintenanceWindowExists.GenWithStackByArgs(stmt.WindowName.O)
+	}
+	return errors.Trace(err)
+}
+
+// DropMaintenanceWindow drops a maintenance window. The jobs which have been submitted in the window still run in it.
+func DropMaintenanceWindow(sctx sessionctx.Context, stmt *ast.DropMaintenanceWindowStmt) error {
+	_, err := GetMaintenanceWindow(sctx, stmt.WindowName.L)
+	if err != nil {
+		if stmt.IfExists && dbterror.ErrMaintenanceWindowNotExists.Equal(err) {
+			sctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+	_, _, err = sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil,
+		"DELETE FROM mysql.tidb_maintenance_windows WHERE name = %?", stmt.WindowName.L)
+	return errors.Trace(err)
+}
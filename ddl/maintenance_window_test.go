@@ -0,0 +1,81 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceSchedule(t *testing.T) {
+	at := func(clock string, loc *time.Location) time.Time {
+		tm, err := time.ParseInLocation("2006-01-02 15:04", "2023-03-01 "+clock, loc)
+		require.NoError(t, err)
+		return tm
+	}
+
+	schedule, err := parseMaintenanceSchedule("02:00-05:00 UTC")
+	require.NoError(t, err)
+	require.False(t, schedule.contains(at("01:59", time.UTC)))
+	require.True(t, schedule.contains(at("02:00", time.UTC)))
+	require.True(t, schedule.contains(at("04:59", time.UTC)))
+	require.False(t, schedule.contains(at("05:00", time.UTC)))
+
+	// The window crosses midnight.
+	schedule, err = parseMaintenanceSchedule("22:30-01:00")
+	require.NoError(t, err)
+	require.True(t, schedule.contains(at("23:00", time.UTC)))
+	require.True(t, schedule.contains(at("00:30", time.UTC)))
+	require.False(t, schedule.contains(at("12:00", time.UTC)))
+
+	// The time is converted to the time zone of the window.
+	schedule, err = parseMaintenanceSchedule("02:00-05:00 Asia/Shanghai")
+	require.NoError(t, err)
+	require.True(t, schedule.contains(at("19:00", time.UTC)))
+	require.False(t, schedule.contains(at("02:00", time.UTC)))
+
+	for _, invalid := range []string{"", "02:00", "02:00-02:00", "2am-5am", "02:00-05:00 Mars/Base", "02:00-05:00 UTC daily"} {
+		_, err = parseMaintenanceSchedule(invalid)
+		require.Error(t, err, invalid)
+	}
+
+	require.True(t, isMaintenanceWindowOpen(nil, at("12:00", time.UTC)))
+	require.False(t, isMaintenanceWindowOpen(&model.MaintenanceWindow{Name: "w", Schedule: "02:00-05:00"}, at("12:00", time.UTC)))
+}
+
+func TestParseMaxReorgRate(t *testing.T) {
+	for rate, expected := range map[string]uint64{
+		"":         0,
+		"200MB/s":  200 << 20,
+		"1gb/s":    1 << 30,
+		"512KiB/s": 512 << 10,
+		"1024":     1024,
+	} {
+		bytes, err := parseMaxReorgRate(rate)
+		require.NoError(t, err, rate)
+		require.Equal(t, expected, bytes, rate)
+	}
+	for _, invalid := range []string{"fast", "0MB/s", "-1MB/s"} {
+		_, err := parseMaxReorgRate(invalid)
+		require.Error(t, err, invalid)
+	}
+
+	require.Nil(t, newReorgRateLimiter(&model.MaintenanceWindow{Name: "w", Schedule: "02:00-05:00"}))
+	limiter := newReorgRateLimiter(&model.MaintenanceWindow{Name: "w", Schedule: "02:00-05:00", MaxReorgRate: 1024})
+	require.Equal(t, 1024, limiter.Burst())
+}
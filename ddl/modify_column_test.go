@@ -880,3 +880,25 @@ func TestModifyColumnTypeWhenInterception(t *testing.T) {
 	tk.MustExec("alter table t modify column b decimal(3,1)")
 	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1292 4096 warnings with this error code, first warning: Truncated incorrect DECIMAL value: '11.22'"))
 }
+
+func TestModifyColumnPartitionByPartition(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index idx_b(b), index idx_ab(a, b)) partition by range (a) " +
+		"(partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))")
+	tk.MustExec("insert into t values (1, 1), (5, 50), (11, 110), (19, 190), (21, 210)")
+	tk.MustGetErrMsg("alter table t modify column b varchar(10)", "[ddl:8200]Unsupported modify column: table is partition table")
+
+	tk.MustExec("set global tidb_ddl_enable_rolling_modify_column = on")
+	defer tk.MustExec("set global tidb_ddl_enable_rolling_modify_column = default")
+	tk.MustExec("alter table t modify column b varchar(10)")
+	tk.MustQuery("select b from t use index(idx_b) order by b").Check(testkit.Rows("1", "110", "190", "210", "50"))
+	tk.MustQuery("select a, b from t partition(p1) order by a").Check(testkit.Rows("11 110", "19 190"))
+	tk.MustExec("admin check table t")
+
+	// The sub-jobs of the multi-schema change are reorganized partition by partition too.
+	tk.MustExec("alter table t modify column b bigint, add column c int")
+	tk.MustQuery("select b from t use index(idx_b) order by b").Check(testkit.Rows("1", "50", "110", "190", "210"))
+	tk.MustExec("admin check table t")
+}
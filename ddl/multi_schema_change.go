@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/util/dbterror"
 )
@@ -49,6 +50,9 @@ func (d *ddl) MultiSchemaChange(ctx sessionctx.Context, ti ast.Ident) error {
 			Warnings:      make(map[errors.ErrorID]*terror.Error),
 			WarningsCount: make(map[errors.ErrorID]int64),
 			Location:      &model.TimeZoneLocation{Name: tzName, Offset: tzOffset},
+			// The sub-jobs share the reorg meta, so the modify column sub-jobs of a partitioned table are
+			// reorganized partition by partition when it's enabled.
+			PartitionByPartition: t.Meta().Partition != nil && variable.DDLEnableRollingModifyColumn.Load(),
 		},
 	}
 	err = checkMultiSchemaInfo(ctx.GetSessionVars().StmtCtx.MultiSchemaInfo, t)
@@ -230,6 +234,10 @@ func fillMultiSchemaInfo(info *model.MultiSchemaInfo, job *model.Job) (err error
 		indexPartSpecifications := job.Args[2].([]*ast.IndexPartSpecification)
 		info.AddIndexes = append(info.AddIndexes, indexName)
 		for _, indexPartSpecification := range indexPartSpecifications {
+			// The index can cover the columns added by the former sub-jobs.
+			if containsColumnName(info.AddColumns, indexPartSpecification.Column.Name) {
+				continue
+			}
 			info.RelativeColumns = append(info.RelativeColumns, indexPartSpecification.Column.Name)
 		}
 		if hiddenCols, ok := job.Args[4].([]*model.ColumnInfo); ok {
@@ -276,6 +284,35 @@ func fillMultiSchemaInfo(info *model.MultiSchemaInfo, job *model.Job) (err error
 	return nil
 }
 
+func containsColumnName(names []model.CIStr, name model.CIStr) bool {
+	for _, n := range names {
+		if n.L == name.L {
+			return true
+		}
+	}
+	return false
+}
+
+// addingColumnsOfMultiSchemaChange returns the columns added by the sub-jobs of the multi-schema change.
+// The later sub-jobs adding indexes can cover these columns. The existing rows don't store the added
+// columns, so the index backfill fills them with their origin default values, and the rows are scanned
+// only once for both the columns and the indexes.
+func addingColumnsOfMultiSchemaChange(info *model.MultiSchemaInfo) []*model.ColumnInfo {
+	if info == nil {
+		return nil
+	}
+	var cols []*model.ColumnInfo
+	for _, sub := range info.SubJobs {
+		if sub.Type != model.ActionAddColumn {
+			continue
+		}
+		if col, ok := sub.Args[0].(*table.Column); ok {
+			cols = append(cols, col.ColumnInfo)
+		}
+	}
+	return cols
+}
+
 func checkOperateSameColAndIdx(info *model.MultiSchemaInfo) error {
 	modifyCols := make(map[string]struct{})
 	modifyIdx := make(map[string]struct{})
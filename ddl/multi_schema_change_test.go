@@ -563,6 +563,19 @@ func TestMultiSchemaChangeAddIndexes(t *testing.T) {
 		errno.ErrDupEntry)
 	tk.MustQuery("show index from t;").Check(testkit.Rows( /* no index */ ))
 	tk.MustExec("alter table t add index i1(a), add index i2(a, b), add index i3(c);")
+
+	// Test add indexes covering the added columns.
+	tk.MustExec("drop table if exists t;")
+	tk.MustExec("create table t (a int, b int);")
+	tk.MustExec("insert into t values (1, 1), (2, 2);")
+	tk.MustExec("alter table t add column c int default 3 after a, add index i1(c), add index i2(a, c);")
+	tk.MustQuery("select a, c from t use index (i2) order by a;").Check(testkit.Rows("1 3", "2 3"))
+	tk.MustQuery("select a, b, c from t use index (i1) where c = 3 order by a;").Check(testkit.Rows("1 1 3", "2 2 3"))
+	tk.MustExec("admin check table t;")
+	tk.MustGetErrCode("alter table t add column d int default 4, add unique index i3(d);", errno.ErrDupEntry)
+	tk.MustQuery("show columns from t like 'd';").Check(testkit.Rows( /* no column */ ))
+	// The column must be added before the index covering it.
+	tk.MustGetErrCode("alter table t add index i3(d), add column d int;", errno.ErrKeyColumnDoesNotExits)
 }
 
 func TestMultiSchemaChangeAddIndexesCancelled(t *testing.T) {
@@ -905,7 +918,7 @@ func TestMultiSchemaChangeModifyColumns(t *testing.T) {
 	tk.MustExec("create table t(a char(3), b int, unique index i1(a), index i2(a, b));")
 	tk.MustExec("insert into t values ('aaa', 1), ('aa', 2);")
 	tk.MustExec("set @@sql_mode = '';") // Make it possible to truncate 'aaa' to 'aa'.
-	tk.MustGetErrCode("alter table t modify column b tinyint, modify column a char(2);", errno.ErrDupEntry)
+	tk.MustGetErrCode("alter table t modify column b tinyint, modify column a char(2), with validation;", errno.ErrDupEntry)
 }
 
 func TestMultiSchemaChangeModifyColumnsCancelled(t *testing.T) {
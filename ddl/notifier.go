@@ -0,0 +1,264 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	tidbutil "github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+const (
+	// The events are written into mysql.ddl_notifier in two steps in the transactions of the DDL jobs. The pending
+	// event with the tables before the job is inserted when the job runs its first step, and it gets the event ID
+	// and the tables after the job when the job is finished. So the events are committed in the order of their IDs,
+	// which is the SeqNum of the jobs, and the consumers never miss an event when they poll the events by the IDs.
+	insertPendingDDLEventSQL = `INSERT IGNORE INTO mysql.ddl_notifier (job_id, job_type, schema_name, table_name, query, before_tables)
+		VALUES (%?, %?, %?, %?, %?, %?)`
+	finishDDLEventSQL = `INSERT INTO mysql.ddl_notifier (job_id, event_id, job_type, schema_name, table_name, query, after_tables, finish_time)
+		VALUES (%?, %?, %?, %?, %?, %?, %?, NOW())
+		ON DUPLICATE KEY UPDATE event_id = VALUES(event_id), after_tables = VALUES(after_tables), finish_time = VALUES(finish_time)`
+	deleteDDLEventSQL     = "DELETE FROM mysql.ddl_notifier WHERE job_id = %?"
+	selectDDLEventColumns = "SELECT event_id, job_id, job_type, schema_name, table_name, query, before_tables, after_tables, finish_time, ack_time FROM mysql.ddl_notifier"
+	// The pending events of the jobs which have been finished when the notifier is disabled are cleaned too.
+	cleanDDLEventsSQL = `DELETE FROM mysql.ddl_notifier WHERE finish_time < NOW() - INTERVAL %? SECOND
+		OR (event_id IS NULL AND job_id NOT IN (SELECT job_id FROM mysql.tidb_ddl_job))`
+
+	cleanDDLEventsInterval = 10 * time.Minute
+)
+
+// DDLEvent is the event of a finished DDL job in mysql.ddl_notifier.
+type DDLEvent struct {
+	// EventID is the SeqNum of the job, the events are committed in the order of their IDs.
+	EventID    uint64 `json:"event_id"`
+	JobID      int64  `json:"job_id"`
+	JobType    string `json:"job_type"`
+	SchemaName string `json:"schema_name"`
+	TableName  string `json:"table_name"`
+	Query      string `json:"query"`
+	// BeforeTables are the tables changed by the job before it runs, it's empty if the tables are created by the job,
+	// or the notifier is enabled when the job is running.
+	BeforeTables []*model.TableInfo `json:"before_tables"`
+	// AfterTables are the tables changed by the job after it's finished, it's empty if the tables are dropped.
+	AfterTables []*model.TableInfo `json:"after_tables"`
+	FinishTime  time.Time          `json:"finish_time"`
+	Acked       bool               `json:"acked"`
+}
+
+// eventTableIDs returns the schema IDs and the table IDs of the tables changed by the job, before it runs and after
+// it's finished. The tables are unknown if the arguments can't be decoded, which doesn't block the job.
+func eventTableIDs(job *model.Job) (before, after [][2]int64) {
+	var args []json.RawMessage
+	if len(job.RawArgs) > 0 && json.Unmarshal(job.RawArgs, &args) != nil {
+		return nil, nil
+	}
+	// The arguments are decoded from the raw ones, since job.Args may be used by the job itself.
+	decodeArg := func(i int, v interface{}) bool {
+		return i < len(args) && json.Unmarshal(args[i], v) == nil
+	}
+	switch job.Type {
+	case model.ActionRenameTable:
+		var oldSchemaID int64
+		if decodeArg(0, &oldSchemaID) {
+			return [][2]int64{{oldSchemaID, job.TableID}}, [][2]int64{{job.SchemaID, job.TableID}}
+		}
+	case model.ActionRenameTables:
+		var oldSchemaIDs, newSchemaIDs, tableIDs []int64
+		if decodeArg(0, &oldSchemaIDs) && decodeArg(1, &newSchemaIDs) && decodeArg(3, &tableIDs) &&
+			len(oldSchemaIDs) == len(tableIDs) && len(newSchemaIDs) == len(tableIDs) {
+			for i, tableID := range tableIDs {
+				before = append(before, [2]int64{oldSchemaIDs[i], tableID})
+				after = append(after, [2]int64{newSchemaIDs[i], tableID})
+			}
+		}
+	case model.ActionTruncateTable:
+		var newTableID int64
+		if decodeArg(0, &newTableID) {
+			return [][2]int64{{job.SchemaID, job.TableID}}, [][2]int64{{job.SchemaID, newTableID}}
+		}
+	default:
+		if job.TableID != 0 {
+			ids := [][2]int64{{job.SchemaID, job.TableID}}
+			return ids, ids
+		}
+	}
+	return before, after
+}
+
+// marshalEventTables marshals the existing tables in the meta.
+func marshalEventTables(t *meta.Meta, ids [][2]int64) (string, error) {
+	tables := make([]*model.TableInfo, 0, len(ids))
+	for _, id := range ids {
+		tblInfo, err := t.GetTable(id[0], id[1])
+		if err != nil && !meta.ErrDBNotExists.Equal(err) {
+			return "", errors.Trace(err)
+		}
+		if tblInfo != nil {
+			tables = append(tables, tblInfo)
+		}
+	}
+	b, err := json.Marshal(tables)
+	return string(b), errors.Trace(err)
+}
+
+// getBeforeEventTables gets the marshaled tables before the job runs. It must be called before the first step of the
+// job changes the meta.
+func getBeforeEventTables(t *meta.Meta, job *model.Job) (string, error) {
+	before, _ := eventTableIDs(job)
+	return marshalEventTables(t, before)
+}
+
+// insertPendingDDLEvent inserts the pending event of the job with the tables before it runs. It's inserted after the
+// first step, since the changes of the step may be discarded if it fails.
+func (w *worker) insertPendingDDLEvent(job *model.Job, beforeTables string) error {
+	sql, err := sqlexec.EscapeSQL(insertPendingDDLEventSQL, job.ID, job.Type.String(), job.SchemaName, job.TableName, job.Query, beforeTables)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = w.sess.execute(context.Background(), sql, "insert_ddl_event")
+	return errors.Trace(err)
+}
+
+// finishDDLEvent sets the event ID and the tables after the job for the finished job, or deletes the pending event of
+// the cancelled or rolled back job.
+func (w *worker) finishDDLEvent(t *meta.Meta, job *model.Job) error {
+	var sql string
+	var err error
+	if job.IsCancelled() || job.IsRollbackDone() {
+		sql, err = sqlexec.EscapeSQL(deleteDDLEventSQL, job.ID)
+	} else {
+		_, after := eventTableIDs(job)
+		var afterTables string
+		afterTables, err = marshalEventTables(t, after)
+		if err != nil {
+			return err
+		}
+		sql, err = sqlexec.EscapeSQL(finishDDLEventSQL, job.ID, job.SeqNum, job.Type.String(), job.SchemaName, job.TableName, job.Query, afterTables)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = w.sess.execute(context.Background(), sql, "finish_ddl_event")
+	return errors.Trace(err)
+}
+
+// startCleanDDLEventsLoop deletes the events which are older than tidb_ddl_notifier_retention on the owner.
+func (d *ddl) startCleanDDLEventsLoop() {
+	defer tidbutil.Recover(metrics.LabelDDL, "startCleanDDLEventsLoop", nil, false)
+
+	ticker := time.NewTicker(cleanDDLEventsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !d.ownerManager.IsOwner() {
+				continue
+			}
+			se, err := d.sessPool.get()
+			if err != nil {
+				logutil.BgLogger().Warn("[ddl] get session failed", zap.Error(err))
+				continue
+			}
+			err = cleanExpiredDDLEvents(newSession(se), variable.DDLNotifierRetention.Load())
+			d.sessPool.put(se)
+			if err != nil {
+				logutil.BgLogger().Warn("[ddl] clean expired DDL events failed", zap.Error(err))
+			}
+		case <-d.ctx.Done():
+			return
+		}
+	}
+}
+
+// cleanExpiredDDLEvents deletes the events finished longer than the retention ago.
+func cleanExpiredDDLEvents(sess *session, retention time.Duration) error {
+	sql, err := sqlexec.EscapeSQL(cleanDDLEventsSQL, int64(retention/time.Second))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = sess.execute(context.Background(), sql, "clean_ddl_events")
+	return errors.Trace(err)
+}
+
+// GetDDLEvents gets at most limit events whose IDs are greater than startEventID in the order of their IDs. If
+// startEventID is 0, it gets the events which are not acked.
+func GetDDLEvents(sctx sessionctx.Context, startEventID uint64, limit int) ([]*DDLEvent, error) {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+	sql := selectDDLEventColumns + " WHERE event_id > %? ORDER BY event_id LIMIT %?"
+	if startEventID == 0 {
+		sql = selectDDLEventColumns + " WHERE event_id > %? AND ack_time IS NULL ORDER BY event_id LIMIT %?"
+	}
+	rows, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil, sql, startEventID, limit)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	events := make([]*DDLEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := decodeDDLEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func decodeDDLEvent(row chunk.Row) (*DDLEvent, error) {
+	event := &DDLEvent{
+		EventID:    row.GetUint64(0),
+		JobID:      row.GetInt64(1),
+		JobType:    row.GetString(2),
+		SchemaName: row.GetString(3),
+		TableName:  row.GetString(4),
+		Query:      row.GetString(5),
+		Acked:      !row.IsNull(9),
+	}
+	for i, tables := range []*[]*model.TableInfo{&event.BeforeTables, &event.AfterTables} {
+		if row.IsNull(6 + i) {
+			continue
+		}
+		if err := json.Unmarshal([]byte(row.GetJSON(6+i).String()), tables); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	finishTime, err := row.GetTime(8).GoTime(time.Local)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	event.FinishTime = finishTime
+	return event, nil
+}
+
+// AckDDLEvents acks the events whose IDs are not greater than eventID, the acked events are not returned by
+// GetDDLEvents without the start event ID.
+func AckDDLEvents(sctx sessionctx.Context, eventID uint64) error {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+	_, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil,
+		"UPDATE mysql.ddl_notifier SET ack_time = NOW() WHERE event_id <= %? AND ack_time IS NULL", eventID)
+	return errors.Trace(err)
+}
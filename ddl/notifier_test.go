@@ -0,0 +1,93 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLNotifier(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t0 (a int)")
+	tk.MustExec("set @@global.tidb_enable_ddl_notifier = on")
+	defer tk.MustExec("set @@global.tidb_enable_ddl_notifier = default")
+
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("alter table t add column b int")
+	tk.MustGetErrMsg("alter table t add column b int", "[schema:1060]Duplicate column name 'b'")
+	tk.MustExec("create database test2")
+	tk.MustExec("rename table t to test2.t1")
+	tk.MustExec("drop table test2.t1")
+	// The failed jobs don't leave the pending events.
+	tk.MustQuery("select count(*) from mysql.ddl_notifier where event_id is null").Check(testkit.Rows("0"))
+
+	tableNames := func(tables []*model.TableInfo) []string {
+		names := make([]string, 0, len(tables))
+		for _, tbl := range tables {
+			names = append(names, tbl.Name.L)
+		}
+		return names
+	}
+	events, err := ddl.GetDDLEvents(tk.Session(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+	expected := []struct {
+		jobType string
+		before  []string
+		after   []string
+	}{
+		{"create table", []string{}, []string{"t"}},
+		{"add column", []string{"t"}, []string{"t"}},
+		{"create schema", []string{}, []string{}},
+		{"rename table", []string{"t"}, []string{"t1"}},
+		{"drop table", []string{"t1"}, []string{}},
+	}
+	for i, event := range events {
+		if i > 0 {
+			require.Greater(t, event.EventID, events[i-1].EventID)
+		}
+		require.Equal(t, expected[i].jobType, event.JobType)
+		require.Equal(t, expected[i].before, tableNames(event.BeforeTables), event.JobType)
+		require.Equal(t, expected[i].after, tableNames(event.AfterTables), event.JobType)
+		require.False(t, event.Acked)
+	}
+	require.Len(t, events[1].BeforeTables[0].Columns, 1)
+	require.Len(t, events[1].AfterTables[0].Columns, 2)
+	require.Equal(t, "alter table t add column b int", events[1].Query)
+
+	// The acked events are skipped unless the start event ID is specified.
+	require.NoError(t, ddl.AckDDLEvents(tk.Session(), events[2].EventID))
+	unacked, err := ddl.GetDDLEvents(tk.Session(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, unacked, 2)
+	require.Equal(t, events[3].EventID, unacked[0].EventID)
+	all, err := ddl.GetDDLEvents(tk.Session(), events[0].EventID, 2)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, events[1].EventID, all[0].EventID)
+	require.True(t, all[1].Acked)
+
+	// The events aren't written when the notifier is disabled.
+	tk.MustExec("set @@global.tidb_enable_ddl_notifier = off")
+	tk.MustExec("drop table t0")
+	tk.MustQuery("select count(*) from mysql.ddl_notifier").Check(testkit.Rows("5"))
+}
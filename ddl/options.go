@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx"
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
@@ -27,11 +28,12 @@ type Option func(*Options)
 
 // Options represents all the options of the DDL module needs
 type Options struct {
-	EtcdCli   *clientv3.Client
-	Store     kv.Storage
-	InfoCache *infoschema.InfoCache
-	Hook      Callback
-	Lease     time.Duration
+	EtcdCli        *clientv3.Client
+	Store          kv.Storage
+	InfoCache      *infoschema.InfoCache
+	Hook           Callback
+	Lease          time.Duration
+	SysProcTracker sessionctx.SysProcTracker
 }
 
 // WithEtcdClient specifies the `clientv3.Client` of DDL used to request the etcd service
@@ -68,3 +70,10 @@ func WithLease(lease time.Duration) Option {
 		options.Lease = lease
 	}
 }
+
+// WithSysProcTracker specifies the `sessionctx.SysProcTracker` used to track the sessions of the backfill workers
+func WithSysProcTracker(tracker sessionctx.SysProcTracker) Option {
+	return func(options *Options) {
+		options.SysProcTracker = tracker
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/util/mock"
 	"github.com/stretchr/testify/require"
@@ -36,6 +37,7 @@ func TestOptions(t *testing.T) {
 	lease := time.Second * 3
 	store := &mock.Store{}
 	infoHandle := infoschema.NewCache(16)
+	tracker := &domain.SysProcesses{}
 
 	options := []ddl.Option{
 		ddl.WithEtcdClient(client),
@@ -43,6 +45,7 @@ func TestOptions(t *testing.T) {
 		ddl.WithLease(lease),
 		ddl.WithStore(store),
 		ddl.WithInfoCache(infoHandle),
+		ddl.WithSysProcTracker(tracker),
 	}
 
 	opt := &ddl.Options{}
@@ -55,4 +58,5 @@ func TestOptions(t *testing.T) {
 	require.Equal(t, lease, opt.Lease)
 	require.Equal(t, store, opt.Store)
 	require.Equal(t, infoHandle, opt.InfoCache)
+	require.Equal(t, tracker, opt.SysProcTracker)
 }
@@ -45,6 +45,7 @@ import (
 	"github.com/pingcap/tidb/parser/opcode"
 	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/tablecodec"
@@ -1705,7 +1706,63 @@ func hasGlobalIndex(tblInfo *model.TableInfo) bool {
 	return false
 }
 
-// getTableInfoWithDroppingPartitions builds oldTableInfo including dropping partitions, only used by onDropTablePartition.
+// cleanupGlobalIndexOfPartitions runs the reorganization to clean up the global index entries of the partitions in
+// physicalTableIDs, which must still be the partitions of oldTblInfo. It returns false if the reorganization isn't
+// done yet, then the job should be run again in the next round.
+func (w *worker) cleanupGlobalIndexOfPartitions(d *ddlCtx, t *meta.Meta, job *model.Job, schemaID int64,
+	oldTblInfo *model.TableInfo, physicalTableIDs []int64) (bool, error) {
+	if !hasGlobalIndex(oldTblInfo) || len(physicalTableIDs) == 0 {
+		return true, nil
+	}
+	tbl, err := getTable(d.store, schemaID, oldTblInfo)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	pt, ok := tbl.(table.PartitionedTable)
+	if !ok {
+		return true, nil
+	}
+	dbInfo, err := t.GetDatabase(schemaID)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	// Build elements for compatible with modify column type. elements will not be used when reorganizing.
+	elements := make([]*meta.Element, 0, len(oldTblInfo.Indices))
+	for _, idxInfo := range oldTblInfo.Indices {
+		if idxInfo.Global {
+			elements = append(elements, &meta.Element{ID: idxInfo.ID, TypeKey: meta.IndexElementKey})
+		}
+	}
+	sctx, err := w.sessPool.get()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	defer w.sessPool.put(sctx)
+	rh := newReorgHandler(newSession(sctx))
+	reorgInfo, err := getReorgInfoFromPartitions(d.jobContext(job.ID), d, rh, job, dbInfo, pt, physicalTableIDs, elements)
+	if err != nil || reorgInfo.first {
+		// If we run reorg firstly, we should update the job snapshot version
+		// and then run the reorg next time.
+		return false, errors.Trace(err)
+	}
+	err = w.runReorgJob(rh, reorgInfo, oldTblInfo, d.lease, func() (cleanupErr error) {
+		defer tidbutil.Recover(metrics.LabelDDL, "cleanupGlobalIndexOfPartitions",
+			func() {
+				cleanupErr = dbterror.ErrCancelledDDLJob.GenWithStack("clean up global index of table `%v` panic", oldTblInfo.Name)
+			}, false)
+		return w.cleanupGlobalIndexes(pt, physicalTableIDs, reorgInfo)
+	})
+	if err != nil {
+		if dbterror.ErrWaitReorgTimeout.Equal(err) {
+			// if timeout, we should return, check for the owner and re-wait job done.
+			return false, nil
+		}
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// getTableInfoWithDroppingPartitions builds oldTableInfo including dropping partitions.
 func getTableInfoWithDroppingPartitions(t *model.TableInfo) *model.TableInfo {
 	p := t.Partition
 	nt := t.Clone()
@@ -1740,11 +1797,17 @@ func (w *worker) onDropTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
-	if job.Type == model.ActionAddTablePartition || job.Type == model.ActionReorganizePartition {
+	if job.Type == model.ActionAddTablePartition || job.Type == model.ActionReorganizePartition ||
+		job.Type == model.ActionAlterTablePartitioning {
 		// It is rollback from reorganize partition, just remove DroppingDefinitions from tableInfo
 		tblInfo.Partition.DroppingDefinitions = nil
+		tblInfo.Partition.DDLState = model.StateNone
 		// It is rollback from adding table partition, just remove addingDefinitions from tableInfo.
 		physicalTableIDs, pNames, rollbackBundles := rollbackAddingPartitionInfo(tblInfo)
+		if tblInfo.Partition.Type == model.PartitionTypeNone {
+			// It is rollback from partitioning a non-partitioned table, the table is not partitioned again.
+			tblInfo.Partition = nil
+		}
 		err = infosync.PutRuleBundlesWithDefaultRetry(context.TODO(), rollbackBundles)
 		if err != nil {
 			job.State = model.JobStateCancelled
@@ -1805,51 +1868,10 @@ func (w *worker) onDropTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (
 	case model.StateDeleteReorganization:
 		oldTblInfo := getTableInfoWithDroppingPartitions(tblInfo)
 		physicalTableIDs = getPartitionIDsFromDefinitions(tblInfo.Partition.DroppingDefinitions)
-		tbl, err := getTable(d.store, job.SchemaID, oldTblInfo)
-		if err != nil {
-			return ver, errors.Trace(err)
-		}
-		dbInfo, err := t.GetDatabase(job.SchemaID)
-		if err != nil {
+		done, err := w.cleanupGlobalIndexOfPartitions(d, t, job, job.SchemaID, oldTblInfo, physicalTableIDs)
+		if !done {
 			return ver, errors.Trace(err)
 		}
-		// If table has global indexes, we need reorg to clean up them.
-		if pt, ok := tbl.(table.PartitionedTable); ok && hasGlobalIndex(tblInfo) {
-			// Build elements for compatible with modify column type. elements will not be used when reorganizing.
-			elements := make([]*meta.Element, 0, len(tblInfo.Indices))
-			for _, idxInfo := range tblInfo.Indices {
-				if idxInfo.Global {
-					elements = append(elements, &meta.Element{ID: idxInfo.ID, TypeKey: meta.IndexElementKey})
-				}
-			}
-			sctx, err1 := w.sessPool.get()
-			if err1 != nil {
-				return ver, err1
-			}
-			defer w.sessPool.put(sctx)
-			rh := newReorgHandler(newSession(sctx))
-			reorgInfo, err := getReorgInfoFromPartitions(d.jobContext(job.ID), d, rh, job, dbInfo, pt, physicalTableIDs, elements)
-
-			if err != nil || reorgInfo.first {
-				// If we run reorg firstly, we should update the job snapshot version
-				// and then run the reorg next time.
-				return ver, errors.Trace(err)
-			}
-			err = w.runReorgJob(rh, reorgInfo, tbl.Meta(), d.lease, func() (dropIndexErr error) {
-				defer tidbutil.Recover(metrics.LabelDDL, "onDropTablePartition",
-					func() {
-						dropIndexErr = dbterror.ErrCancelledDDLJob.GenWithStack("drop partition panic")
-					}, false)
-				return w.cleanupGlobalIndexes(pt, physicalTableIDs, reorgInfo)
-			})
-			if err != nil {
-				if dbterror.ErrWaitReorgTimeout.Equal(err) {
-					// if timeout, we should return, check for the owner and re-wait job done.
-					return ver, nil
-				}
-				return ver, errors.Trace(err)
-			}
-		}
 		tblInfo.Partition.DroppingDefinitions = nil
 		// used by ApplyDiff in updateSchemaVersion
 		job.CtxVars = []interface{}{physicalTableIDs}
@@ -1869,7 +1891,10 @@ func (w *worker) onDropTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (
 }
 
 // onTruncateTablePartition truncates old partition meta.
-func onTruncateTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (int64, error) {
+// If the table has global indexes, the old partitions are kept in DroppingDefinitions after the new partition IDs
+// are published, and their global index entries are cleaned up in StateDeleteReorganization before the job is done,
+// the same as dropping partitions.
+func (w *worker) onTruncateTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (int64, error) {
 	var ver int64
 	var oldIDs []int64
 	if err := job.DecodeArgs(&oldIDs); err != nil {
@@ -1885,7 +1910,40 @@ func onTruncateTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (int64, e
 		return ver, errors.Trace(dbterror.ErrPartitionMgmtOnNonpartitioned)
 	}
 
+	switch job.SchemaState {
+	case model.StateDeleteOnly:
+		// Confirm all servers write into the new partitions only, before cleaning up the global indexes.
+		job.SchemaState = model.StateDeleteReorganization
+		return updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	case model.StateDeleteReorganization:
+		oldTblInfo := getTableInfoWithDroppingPartitions(tblInfo)
+		done, err := w.cleanupGlobalIndexOfPartitions(d, t, job, job.SchemaID, oldTblInfo, oldIDs)
+		if !done {
+			return ver, errors.Trace(err)
+		}
+		newPartitions := make([]model.PartitionDefinition, 0, len(pi.DroppingDefinitions))
+		for _, oldDef := range pi.DroppingDefinitions {
+			for _, def := range pi.Definitions {
+				if def.Name.L == oldDef.Name.L {
+					newPartitions = append(newPartitions, def)
+					break
+				}
+			}
+		}
+		pi.DroppingDefinitions = nil
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StateNone, ver, tblInfo)
+		asyncNotifyEvent(d, &util.Event{Tp: model.ActionTruncateTablePartition, TableInfo: tblInfo, PartInfo: &model.PartitionInfo{Definitions: newPartitions}})
+		// A background job will be created to delete old partition data.
+		job.Args = []interface{}{oldIDs}
+		return ver, nil
+	}
+
 	newPartitions := make([]model.PartitionDefinition, 0, len(oldIDs))
+	oldDefinitions := make([]model.PartitionDefinition, 0, len(oldIDs))
 	for _, oldID := range oldIDs {
 		for i := 0; i < len(pi.Definitions); i++ {
 			def := &pi.Definitions[i]
@@ -1894,6 +1952,7 @@ func onTruncateTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (int64, e
 				if err1 != nil {
 					return ver, errors.Trace(err1)
 				}
+				oldDefinitions = append(oldDefinitions, *def)
 				def.ID = pid
 				// Shallow copy only use the def.ID in event handle.
 				newPartitions = append(newPartitions, *def)
@@ -1980,6 +2039,12 @@ func onTruncateTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (int64, e
 		newIDs[i] = newPartitions[i].ID
 	}
 	job.CtxVars = []interface{}{oldIDs, newIDs}
+	if hasGlobalIndex(tblInfo) {
+		// Keep the old partitions to clean up their global index entries.
+		pi.DroppingDefinitions = oldDefinitions
+		job.SchemaState = model.StateDeleteOnly
+		return updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	}
 	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
 	if err != nil {
 		return ver, errors.Trace(err)
@@ -2009,6 +2074,31 @@ func (w *worker) onExchangeTablePartition(d *ddlCtx, t *meta.Meta, job *model.Jo
 		return ver, errors.Trace(err)
 	}
 
+	switch job.SchemaState {
+	case model.StateDeleteOnly:
+		// The partition has been exchanged out, confirm all servers don't write into it through
+		// the partitioned table before purging its global index entries.
+		job.SchemaState = model.StateDeleteReorganization
+		ver, err := updateSchemaVersion(d, t, job)
+		return ver, errors.Trace(err)
+	case model.StateDeleteReorganization:
+		pt, err := getTableInfo(t, ptID, ptSchemaID)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		// The exchanged out partition keeps its ID defID, which is the ID of the non-partitioned table now.
+		err = w.purgeGlobalIndexOfPartition(pt, defID)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		ver, err = updateSchemaVersion(d, t, job)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StateNone, ver, pt)
+		return ver, nil
+	}
+
 	ntDbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
 	if err != nil {
 		job.State = model.JobStateCancelled
@@ -2070,6 +2160,16 @@ func (w *worker) onExchangeTablePartition(d *ddlCtx, t *meta.Meta, job *model.Jo
 		}
 	}
 
+	if hasGlobalIndex(pt) {
+		// The records of the non-partitioned table have no global index entries,
+		// so only an empty table can be exchanged in.
+		err = checkExchangeTableEmpty(w, ntDbInfo.Name, nt.Name)
+		if err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
+	}
+
 	// partition table auto IDs.
 	ptAutoIDs, err := t.GetAutoIDAccessors(ptSchemaID, ptID).Get()
 	if err != nil {
@@ -2216,6 +2316,12 @@ func (w *worker) onExchangeTablePartition(d *ddlCtx, t *meta.Meta, job *model.Jo
 	}
 
 	nt.ExchangePartitionInfo = nil
+	if hasGlobalIndex(pt) {
+		// The global index entries of the exchanged out partition are purged in StateDeleteReorganization,
+		// the same as truncating partitions.
+		job.SchemaState = model.StateDeleteOnly
+		return updateVersionAndTableInfoWithCheck(d, t, job, nt, true)
+	}
 	ver, err = updateVersionAndTableInfoWithCheck(d, t, job, nt, true)
 	if err != nil {
 		return ver, errors.Trace(err)
@@ -2225,6 +2331,56 @@ func (w *worker) onExchangeTablePartition(d *ddlCtx, t *meta.Meta, job *model.Jo
 	return ver, nil
 }
 
+// purgeGlobalIndexOfPartition deletes the entries of the partition pid from the global indexes of tblInfo.
+// It scans the global indexes instead of the records of the partition, since the records have been
+// exchanged into the non-partitioned table, which may change them meanwhile.
+func (w *worker) purgeGlobalIndexOfPartition(tblInfo *model.TableInfo, pid int64) error {
+	ctx := kv.WithInternalSourceType(w.ctx, kv.InternalTxnDDL)
+	batchSize := int(variable.GetDDLReorgBatchSize())
+	for _, idxInfo := range tblInfo.Indices {
+		if !idxInfo.Global {
+			continue
+		}
+		startKey := tablecodec.EncodeTableIndexPrefix(tblInfo.ID, idxInfo.ID)
+		endKey := startKey.PrefixNext()
+		for startKey.Cmp(endKey) < 0 {
+			var nextKey kv.Key
+			err := kv.RunInNewTxn(ctx, w.store, true, func(ctx context.Context, txn kv.Transaction) error {
+				nextKey = endKey
+				it, err := txn.Iter(startKey, endKey)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				defer it.Close()
+				for cnt := 0; it.Valid(); cnt++ {
+					if cnt == batchSize {
+						nextKey = it.Key().Clone()
+						break
+					}
+					id, ok, err := tablecodec.DecodePartitionIDInIndexValue(it.Value())
+					if err != nil {
+						return errors.Trace(err)
+					}
+					if ok && id == pid {
+						if err := txn.Delete(it.Key()); err != nil {
+							return errors.Trace(err)
+						}
+					}
+					if err := it.Next(); err != nil {
+						return errors.Trace(err)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return errors.Trace(err)
+			}
+			startKey = nextKey
+		}
+	}
+	return nil
+}
+
 func checkReorgPartition(t *meta.Meta, job *model.Job) (*model.TableInfo, []model.CIStr, *model.PartitionInfo, []model.PartitionDefinition, []model.PartitionDefinition, error) {
 	schemaID := job.SchemaID
 	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, schemaID)
@@ -2238,6 +2394,10 @@ func checkReorgPartition(t *meta.Meta, job *model.Job) (*model.TableInfo, []mode
 		job.State = model.JobStateCancelled
 		return nil, nil, nil, nil, nil, errors.Trace(err)
 	}
+	if tblInfo.Partition == nil {
+		// The table is partitioned by ALTER TABLE ... PARTITION BY in StateNone.
+		return tblInfo, partNames, partInfo, []model.PartitionDefinition{}, []model.PartitionDefinition{}, nil
+	}
 	addingDefs := tblInfo.Partition.AddingDefinitions
 	droppingDefs := tblInfo.Partition.DroppingDefinitions
 	if len(addingDefs) == 0 {
@@ -2249,6 +2409,33 @@ func checkReorgPartition(t *meta.Meta, job *model.Job) (*model.TableInfo, []mode
 	return tblInfo, partNames, partInfo, droppingDefs, addingDefs, nil
 }
 
+// fullTablePartitionName is the name of the single partition which holds all the rows of a non-partitioned table,
+// while ALTER TABLE ... PARTITION BY reorganizes it into the new partitions.
+var fullTablePartitionName = model.NewCIStr("pFullTable")
+
+// getFullTablePartitionInfo returns the partition info of a non-partitioned table, the only partition uses the
+// table ID, so it is the table itself.
+func getFullTablePartitionInfo(tblInfo *model.TableInfo) *model.PartitionInfo {
+	return &model.PartitionInfo{
+		Type:   model.PartitionTypeNone,
+		Enable: true,
+		Definitions: []model.PartitionDefinition{{
+			ID:      tblInfo.ID,
+			Name:    fullTablePartitionName,
+			Comment: "Intermediate partition during ALTER TABLE ... PARTITION BY",
+		}},
+		Num: 1,
+	}
+}
+
+// swapReorgPartitioning swaps the partitioning of the Definitions and the reorganized partitions, it is done
+// when ALTER TABLE ... PARTITION BY replaces the old partitions with the new ones.
+func swapReorgPartitioning(pi *model.PartitionInfo) {
+	pi.Type, pi.DDLType = pi.DDLType, pi.Type
+	pi.Expr, pi.DDLExpr = pi.DDLExpr, pi.Expr
+	pi.Columns, pi.DDLColumns = pi.DDLColumns, pi.Columns
+}
+
 func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
 	// Handle the rolling back job
 	if job.IsRollingback() {
@@ -2280,6 +2467,18 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 		// The partInfo may have been checked against an older schema version for example.
 		// If the check is done here, it does not need to be repeated, since no other
 		// DDL on the same table can be run concurrently.
+		if job.Type == model.ActionAlterTablePartitioning {
+			if tblInfo.Partition != nil {
+				job.State = model.JobStateCancelled
+				return ver, errors.New("alter table partition is unsupported")
+			}
+			// Reorganize the whole table as a single partition into the new partitions.
+			tblInfo.Partition = getFullTablePartitionInfo(tblInfo)
+			tblInfo.Partition.DDLAction = job.Type
+			tblInfo.Partition.DDLType = partInfo.Type
+			tblInfo.Partition.DDLExpr = partInfo.Expr
+			tblInfo.Partition.DDLColumns = partInfo.Columns
+		}
 		err = checkAddPartitionTooManyPartitions(uint64(len(tblInfo.Partition.Definitions) +
 			len(partInfo.Definitions) -
 			len(partNames)))
@@ -2301,7 +2500,14 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 			return ver, err
 		}
 		sctx := w.sess.Context
-		if err = checkReorgPartitionDefs(sctx, tblInfo, partInfo, firstPartIdx, lastPartIdx, idMap); err != nil {
+		if job.Type == model.ActionAlterTablePartitioning {
+			newTblInfo := tblInfo.Clone()
+			newTblInfo.Partition = partInfo.Clone()
+			err = checkPartitionDefinitionConstraints(sctx, newTblInfo)
+		} else {
+			err = checkReorgPartitionDefs(sctx, tblInfo, partInfo, firstPartIdx, lastPartIdx, idMap)
+		}
+		if err != nil {
 			job.State = model.JobStateCancelled
 			return ver, err
 		}
@@ -2475,6 +2681,10 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 		// From now on, use the new definitions, but keep the Adding and Dropping for double write
 		tblInfo.Partition.Definitions = newDefs
 		tblInfo.Partition.Num = uint64(len(newDefs))
+		if tblInfo.Partition.DDLAction == model.ActionAlterTablePartitioning {
+			// The new definitions use the new partitioning, and the dropping ones use the old.
+			swapReorgPartitioning(tblInfo.Partition)
+		}
 
 		// Now all the data copying is done, but we cannot simply remove the droppingDefinitions
 		// since they are a part of the normal Definitions that other nodes with
@@ -2497,10 +2707,19 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 		// and the addingDefinitions for handling in the updateSchemaVersion
 		physicalTableIDs := getPartitionIDsFromDefinitions(tblInfo.Partition.DroppingDefinitions)
 		newIDs := getPartitionIDsFromDefinitions(partInfo.Definitions)
-		job.CtxVars = []interface{}{physicalTableIDs, newIDs}
+		droppedIDs := physicalTableIDs
+		if job.Type == model.ActionAlterTablePartitioning {
+			// The dropped partition is the table itself, whose placement bundle is still in use.
+			droppedIDs = nil
+		}
+		job.CtxVars = []interface{}{droppedIDs, newIDs}
 		definitionsToDrop := tblInfo.Partition.DroppingDefinitions
 		tblInfo.Partition.DroppingDefinitions = nil
 		tblInfo.Partition.AddingDefinitions = nil
+		tblInfo.Partition.DDLAction = model.ActionNone
+		tblInfo.Partition.DDLType = model.PartitionTypeNone
+		tblInfo.Partition.DDLExpr = ""
+		tblInfo.Partition.DDLColumns = nil
 		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
 		failpoint.Inject("reorgPartWriteReorgSchemaVersionUpdateFail", func(val failpoint.Value) {
 			if val.(bool) {
@@ -2516,7 +2735,7 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 		// How to handle this?
 		// Seems to only trigger asynchronous update of statistics.
 		// Should it actually be synchronous?
-		asyncNotifyEvent(d, &util.Event{Tp: model.ActionReorganizePartition, TableInfo: tblInfo, PartInfo: &model.PartitionInfo{Definitions: definitionsToDrop}})
+		asyncNotifyEvent(d, &util.Event{Tp: job.Type, TableInfo: tblInfo, PartInfo: &model.PartitionInfo{Definitions: definitionsToDrop}})
 		// A background job will be created to delete old partition data.
 		job.Args = []interface{}{physicalTableIDs}
 
@@ -2582,6 +2801,18 @@ type reorgPartitionWorker struct {
 	reorgedTbl        table.PartitionedTable
 }
 
+func init() {
+	registerBackfiller(typeReorgPartitionWorker, "reorganize partition", newReorgPartitionBackfiller)
+}
+
+func newReorgPartitionBackfiller(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error) {
+	partWorker, err := newReorgPartitionWorker(sessCtx, id, b.tbl, b.decodeColMap, b.reorgInfo, b.jobCtx)
+	if err != nil {
+		return nil, err
+	}
+	return partWorker, nil
+}
+
 func newReorgPartitionWorker(sessCtx sessionctx.Context, i int, t table.PhysicalTable, decodeColMap map[int64]decoder.Column, reorgInfo *reorgInfo, jc *JobContext) (*reorgPartitionWorker, error) {
 	reorgedTbl, err := tables.GetReorganizedPartitionedTable(t)
 	if err != nil {
@@ -2591,7 +2822,9 @@ func newReorgPartitionWorker(sessCtx sessionctx.Context, i int, t table.Physical
 	if pt == nil {
 		return nil, dbterror.ErrUnsupportedReorganizePartition.GenWithStackByArgs()
 	}
-	partColIDs := pt.GetPartitionColumnIDs()
+	// The rows are located by the partitioning of the new partitions, which may differ from the
+	// current one when ALTER TABLE ... PARTITION BY changes the partitioning.
+	partColIDs := reorgedTbl.GetPartitionColumnIDs()
 	writeColOffsetMap := make(map[int64]int, len(partColIDs))
 	maxOffset := 0
 	for _, col := range pt.Cols() {
@@ -2667,6 +2900,7 @@ func (w *reorgPartitionWorker) BackfillData(handleRange reorgBackfillTask) (task
 
 		// Collect the warnings.
 		taskCtx.warnings, taskCtx.warningsCount = warningsMap, warningsCountMap
+		taskCtx.addedBytes = txn.Size()
 
 		// also add the index entries here? And make sure they are not added somewhere else
 
@@ -2782,11 +3016,13 @@ func (w *worker) reorgPartitionDataAndIndex(t table.Table, reorgInfo *reorgInfo)
 	// for each new index, one partition at a time.
 
 	// Copy the data from the DroppingDefinitions to the AddingDefinitions
+	rc := w.getReorgCtx(reorgInfo.Job.ID)
 	if bytes.Equal(reorgInfo.currElement.TypeKey, meta.ColumnElementKey) {
 		err := w.updatePhysicalTableRow(t, reorgInfo)
 		if err != nil {
 			return errors.Trace(err)
 		}
+		rc.finishElement()
 	}
 
 	failpoint.Inject("reorgPartitionAfterDataCopy", func(val failpoint.Value) {
@@ -2867,6 +3103,7 @@ func (w *worker) reorgPartitionDataAndIndex(t table.Table, reorgInfo *reorgInfo)
 		if err != nil {
 			return errors.Trace(err)
 		}
+		rc.finishElement()
 		reorgInfo.PhysicalTableID = firstNewPartitionID
 	}
 	failpoint.Inject("reorgPartitionAfterIndex", func(val failpoint.Value) {
@@ -2976,6 +3213,25 @@ func checkExchangePartitionRecordValidation(w *worker, pt *model.TableInfo, inde
 	return nil
 }
 
+// checkExchangeTableEmpty checks the non-partitioned table has no records, it's used when the partitioned table
+// has global indexes.
+func checkExchangeTableEmpty(w *worker, schemaName, tableName model.CIStr) error {
+	ctx, err := w.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer w.sessPool.put(ctx)
+
+	rows, _, err := ctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(w.ctx, nil, "select 1 from %n.%n limit 1", schemaName.L, tableName.L)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(rows) != 0 {
+		return errors.Trace(dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("EXCHANGE PARTITION with a non-empty table when the partitioned table has global indexes"))
+	}
+	return nil
+}
+
 func checkExchangePartitionPlacementPolicy(t *meta.Meta, ntPlacementPolicyRef *model.PolicyRefInfo, ptPlacementPolicyRef *model.PolicyRefInfo) error {
 	if ntPlacementPolicyRef == nil && ptPlacementPolicyRef == nil {
 		return nil
@@ -3135,13 +3391,13 @@ func getPartitionRuleIDs(dbName string, table *model.TableInfo) []string {
 }
 
 // checkPartitioningKeysConstraints checks that the range partitioning key is included in the table constraint.
-func checkPartitioningKeysConstraints(sctx sessionctx.Context, s *ast.CreateTableStmt, tblInfo *model.TableInfo) error {
+func checkPartitioningKeysConstraints(sctx sessionctx.Context, partOpts *ast.PartitionOptions, tblInfo *model.TableInfo) error {
 	// Returns directly if there are no unique keys in the table.
 	if len(tblInfo.Indices) == 0 && !tblInfo.PKIsHandle {
 		return nil
 	}
 
-	partCols, err := getPartitionColSlices(sctx, tblInfo, s.Partition)
+	partCols, err := getPartitionColSlices(sctx, tblInfo, partOpts)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -3568,7 +3824,8 @@ func writeColumnListToBuffer(partitionInfo *model.PartitionInfo, sqlMode mysql.S
 // AppendPartitionInfo is used in SHOW CREATE TABLE as well as generation the SQL syntax
 // for the PartitionInfo during validation of various DDL commands
 func AppendPartitionInfo(partitionInfo *model.PartitionInfo, buf *bytes.Buffer, sqlMode mysql.SQLMode) {
-	if partitionInfo == nil {
+	if partitionInfo == nil || partitionInfo.Type == model.PartitionTypeNone {
+		// The table is not partitioned until ALTER TABLE ... PARTITION BY finishes.
 		return
 	}
 	// Since MySQL 5.1/5.5 is very old and TiDB aims for 5.7/8.0 compatibility, we will not
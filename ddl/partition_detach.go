@@ -0,0 +1,204 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+// tableChecksum is the row count and the checksum of the rows of a table or a partition, which is used to verify the
+// rows are moved intact between a partition and a table.
+type tableChecksum struct {
+	count    int64
+	checksum uint64
+}
+
+// getTableChecksum calculates the checksum of the columns of the rows of the table, or of the partition if partName
+// isn't empty. The checksum doesn't depend on the table ID, so it's the same after the rows are exchanged.
+func getTableChecksum(sctx sessionctx.Context, schema, table model.CIStr, partName string, cols []*model.ColumnInfo) (tableChecksum, error) {
+	var sb strings.Builder
+	params := make([]interface{}, 0, len(cols)+3)
+	sb.WriteString("select count(1), bit_xor(crc32(json_array(")
+	for i, col := range cols {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("%n")
+		params = append(params, col.Name.L)
+	}
+	sb.WriteString("))) from %n.%n")
+	params = append(params, schema.L, table.L)
+	if partName != "" {
+		sb.WriteString(" partition(%n)")
+		params = append(params, partName)
+	}
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+	//nolint:forcetypeassert
+	rows, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil, sb.String(), params...)
+	if err != nil {
+		return tableChecksum{}, errors.Trace(err)
+	}
+	return tableChecksum{count: rows[0].GetInt64(0), checksum: rows[0].GetUint64(1)}, nil
+}
+
+// getDetachablePartitionTable returns the table which is RANGE or LIST partitioned, the partitions of the other types
+// can't be added or dropped individually.
+func (d *ddl) getDetachablePartitionTable(ctx sessionctx.Context, ident ast.Ident, op string) (*model.TableInfo, error) {
+	if ctx.GetSessionVars().StmtCtx.MultiSchemaInfo != nil {
+		return nil, dbterror.ErrRunMultiSchemaChanges.FastGenByArgs(op)
+	}
+	_, t, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	pi := t.Meta().GetPartitionInfo()
+	if pi == nil {
+		return nil, dbterror.ErrPartitionMgmtOnNonpartitioned
+	}
+	if pi.Type != model.PartitionTypeRange && pi.Type != model.PartitionTypeList {
+		return nil, dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(op + " of non-RANGE and non-LIST partitioned table")
+	}
+	return t.Meta(), nil
+}
+
+func newTableIdent(ident ast.Ident, tn *ast.TableName) ast.Ident {
+	ntIdent := ast.Ident{Schema: tn.Schema, Name: tn.Name}
+	if ntIdent.Schema.L == "" {
+		ntIdent.Schema = ident.Schema
+	}
+	return ntIdent
+}
+
+// DetachTablePartition converts the partition into a new non-partitioned table. The table is created like the
+// partitioned table, the partition is exchanged with it and dropped at last. The rows are verified by the checksums
+// before the partition is dropped, so the partition is kept if it's changed during the detachment.
+func (d *ddl) DetachTablePartition(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	ptMeta, err := d.getDetachablePartitionTable(ctx, ident, "DETACH PARTITION")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	partName := spec.PartitionNames[0]
+	if _, err = tables.FindPartitionByName(ptMeta, partName.L); err != nil {
+		return errors.Trace(err)
+	}
+	if len(ptMeta.Partition.Definitions) == 1 {
+		return errors.Trace(dbterror.ErrDropLastPartition)
+	}
+	ntIdent := newTableIdent(ident, spec.NewTable)
+	before, err := getTableChecksum(ctx, ident.Schema, ident.Name, partName.L, ptMeta.Cols())
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	ntInfo, err := BuildTableInfoWithLike(ctx, ntIdent, ptMeta, &ast.CreateTableStmt{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ntInfo.Partition = nil
+	if err = d.CreateTableWithInfo(ctx, ntIdent.Schema, ntInfo, OnExistError); err != nil {
+		return errors.Trace(err)
+	}
+	exchangeSpec := &ast.AlterTableSpec{
+		Tp:             ast.AlterTableExchangePartition,
+		PartitionNames: []model.CIStr{partName},
+		NewTable:       &ast.TableName{Schema: ntIdent.Schema, Name: ntIdent.Name},
+	}
+	if err = d.ExchangeTablePartition(ctx, ident, exchangeSpec); err != nil {
+		// The new table is still empty, drop it so the statement can be retried.
+		if dropErr := d.dropTableObject(ctx, []*ast.TableName{exchangeSpec.NewTable}, false, tableObject); dropErr != nil {
+			logutil.BgLogger().Warn("[ddl] drop the table created by DETACH PARTITION failed", zap.String("table", ntIdent.String()), zap.Error(dropErr))
+		}
+		return errors.Trace(err)
+	}
+
+	after, err := getTableChecksum(ctx, ntIdent.Schema, ntIdent.Name, "", ptMeta.Cols())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if after != before {
+		return errors.Errorf("the rows of table %s don't match the rows of partition %s before the detachment, the partition is changed during DETACH PARTITION and isn't dropped",
+			ntIdent.Name.O, partName.O)
+	}
+	dropSpec := &ast.AlterTableSpec{Tp: ast.AlterTableDropPartition, PartitionNames: []model.CIStr{partName}}
+	return errors.Trace(d.DropTablePartition(ctx, ident, dropSpec))
+}
+
+// AttachTablePartition converts the non-partitioned table into a new partition. The empty partition is added, the
+// table is exchanged with it and dropped at last. The boundaries of the partition are validated by scanning the rows
+// of the table unless WITHOUT VALIDATION is specified, and the rows are verified by the checksums before the table is
+// dropped, so the table is kept if it's changed during the attachment.
+func (d *ddl) AttachTablePartition(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	ptMeta, err := d.getDetachablePartitionTable(ctx, ident, "ATTACH PARTITION")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ntIdent := newTableIdent(ident, spec.NewTable)
+	_, nt, err := d.getSchemaAndTableByIdent(ctx, ntIdent)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err = checkExchangePartition(ptMeta, nt.Meta()); err != nil {
+		return errors.Trace(err)
+	}
+	if err = checkTableDefCompatible(ptMeta, nt.Meta()); err != nil {
+		return errors.Trace(err)
+	}
+	before, err := getTableChecksum(ctx, ntIdent.Schema, ntIdent.Name, "", ptMeta.Cols())
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	partName := spec.PartDefinitions[0].Name
+	addSpec := &ast.AlterTableSpec{Tp: ast.AlterTableAddPartitions, PartDefinitions: spec.PartDefinitions}
+	if err = d.AddTablePartitions(ctx, ident, addSpec); err != nil {
+		return errors.Trace(err)
+	}
+	exchangeSpec := &ast.AlterTableSpec{
+		Tp:             ast.AlterTableExchangePartition,
+		PartitionNames: []model.CIStr{partName},
+		NewTable:       &ast.TableName{Schema: ntIdent.Schema, Name: ntIdent.Name},
+		WithValidation: spec.WithValidation,
+	}
+	if err = d.ExchangeTablePartition(ctx, ident, exchangeSpec); err != nil {
+		// The new partition is still empty, drop it so the statement can be retried.
+		dropSpec := &ast.AlterTableSpec{Tp: ast.AlterTableDropPartition, PartitionNames: []model.CIStr{partName}}
+		if dropErr := d.DropTablePartition(ctx, ident, dropSpec); dropErr != nil {
+			logutil.BgLogger().Warn("[ddl] drop the partition added by ATTACH PARTITION failed", zap.String("partition", partName.O), zap.Error(dropErr))
+		}
+		return errors.Trace(err)
+	}
+
+	after, err := getTableChecksum(ctx, ident.Schema, ident.Name, partName.L, ptMeta.Cols())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if after != before {
+		return errors.Errorf("the rows of partition %s don't match the rows of table %s before the attachment, the table is changed during ATTACH PARTITION and isn't dropped",
+			partName.O, ntIdent.Name.O)
+	}
+	return errors.Trace(d.dropTableObject(ctx, []*ast.TableName{exchangeSpec.NewTable}, false, tableObject))
+}
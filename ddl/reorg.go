@@ -15,6 +15,8 @@
 package ddl
 
 import (
+	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"strconv"
@@ -44,12 +46,14 @@ import (
 	"github.com/pingcap/tidb/util/codec"
 	"github.com/pingcap/tidb/util/dbterror"
 	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/mathutil"
 	"github.com/pingcap/tidb/util/mock"
 	"github.com/pingcap/tidb/util/ranger"
 	"github.com/pingcap/tidb/util/sqlexec"
 	"github.com/pingcap/tipb/go-tipb"
 	atomicutil "go.uber.org/atomic"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 )
 
 // reorgCtx is for reorganization.
@@ -75,9 +79,24 @@ type reorgCtx struct {
 		// warnings are used to store the warnings when doing the reorg job under certain SQL modes.
 		warnings      map[errors.ErrorID]*terror.Error
 		warningsCount map[errors.ErrorID]int64
+		// doneElementRows is the row count of each element which has been reorganized, in the order of the elements.
+		// It's used to show the progress of the reorganize partition job element by element.
+		doneElementRows []int64
+		// indexVerify is the result of the verify index job found since it's merged into the job last time.
+		indexVerify model.IndexVerifyInfo
 	}
 
 	references atomicutil.Int32
+
+	// mergedRows and mergedBytes are the progress of merging the temporary index records in ingest mode.
+	mergedRows  atomicutil.Int64
+	mergedBytes atomicutil.Int64
+
+	// window is the maintenance window of the job, the reorganization pauses when the window closes.
+	window   *model.MaintenanceWindow
+	schedule *maintenanceSchedule
+	// rateLimiter limits the bytes written by the reorganization per second, it's nil if the rate is unlimited.
+	rateLimiter *rate.Limiter
 }
 
 // nullableKey can store <nil> kv.Key.
@@ -111,6 +130,48 @@ func (rc *reorgCtx) isReorgCanceled() bool {
 	return atomic.LoadInt32(&rc.notifyCancelReorgJob) == 1
 }
 
+// setMaintenanceWindow sets the maintenance window of the job, it's called before the reorganization starts.
+func (rc *reorgCtx) setMaintenanceWindow(window *model.MaintenanceWindow) {
+	if window == nil {
+		return
+	}
+	rc.window = window
+	// The schedule is checked when the window is created, the window is always open if it's invalid.
+	rc.schedule, _ = parseMaintenanceSchedule(window.Schedule)
+	rc.rateLimiter = newReorgRateLimiter(window)
+}
+
+// setRateLimit limits the bytes handled by the reorganization per second, it overrides the max reorg rate
+// of the maintenance window.
+func (rc *reorgCtx) setRateLimit(bytes uint64) {
+	if bytes == 0 {
+		return
+	}
+	rc.rateLimiter = rate.NewLimiter(rate.Limit(bytes), int(bytes))
+}
+
+// checkMaintenanceWindow returns an error if the maintenance window of the job is closed.
+func (rc *reorgCtx) checkMaintenanceWindow() error {
+	if rc.schedule != nil && !rc.schedule.contains(time.Now()) {
+		return dbterror.ErrMaintenanceWindowClosed.GenWithStackByArgs(rc.window.Name)
+	}
+	return nil
+}
+
+// throttle waits until the bytes written by the reorganization don't exceed the max reorg rate of the window.
+func (rc *reorgCtx) throttle(ctx context.Context, bytes int) {
+	if rc.rateLimiter == nil {
+		return
+	}
+	for bytes > 0 {
+		n := mathutil.Min(bytes, rc.rateLimiter.Burst())
+		if err := rc.rateLimiter.WaitN(ctx, n); err != nil {
+			return
+		}
+		bytes -= n
+	}
+}
+
 func (rc *reorgCtx) setRowCount(count int64) {
 	atomic.StoreInt64(&rc.rowCount, count)
 }
@@ -128,6 +189,20 @@ func (rc *reorgCtx) mergeWarnings(warnings map[errors.ErrorID]*terror.Error, war
 	rc.mu.warnings, rc.mu.warningsCount = mergeWarningsAndWarningsCount(warnings, rc.mu.warnings, warningsCount, rc.mu.warningsCount)
 }
 
+func (rc *reorgCtx) addIndexVerifyResult(inconsistentHandles []string, repairedRows int64) {
+	if len(inconsistentHandles) == 0 {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	v := &rc.mu.indexVerify
+	v.InconsistentRows += int64(len(inconsistentHandles))
+	v.RepairedRows += repairedRows
+	if room := maxVerifyIndexInconsistentHandles - len(v.InconsistentHandles); room > 0 {
+		v.InconsistentHandles = append(v.InconsistentHandles, inconsistentHandles[:mathutil.Min(room, len(inconsistentHandles))]...)
+	}
+}
+
 func (rc *reorgCtx) resetWarnings() {
 	rc.mu.Lock()
 	defer rc.mu.Unlock()
@@ -144,6 +219,85 @@ func (rc *reorgCtx) getRowCount() int64 {
 	return row
 }
 
+func (rc *reorgCtx) increaseMergeProgress(rows, bytes int64) {
+	rc.mergedRows.Add(rows)
+	rc.mergedBytes.Add(bytes)
+}
+
+func (rc *reorgCtx) getMergeProgress() model.ReorgPhaseProgress {
+	return model.ReorgPhaseProgress{Rows: rc.mergedRows.Load(), Bytes: rc.mergedBytes.Load()}
+}
+
+// finishElement records the row count of the current element when it's done, which is the
+// growth of the row count since the previous element is done.
+func (rc *reorgCtx) finishElement() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rows := rc.getRowCount()
+	for _, doneRows := range rc.mu.doneElementRows {
+		rows -= doneRows
+	}
+	rc.mu.doneElementRows = append(rc.mu.doneElementRows, rows)
+}
+
+func (rc *reorgCtx) getDoneElementRows() []int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return append([]int64(nil), rc.mu.doneElementRows...)
+}
+
+// syncElementProgress copies the progress of each element of the reorganize partition job into the job,
+// so that it can be shown by ADMIN SHOW DDL JOBS. The first element is copying the rows, and the others
+// are building the indexes of the new partitions.
+func syncElementProgress(reorgInfo *reorgInfo, tblInfo *model.TableInfo, rc *reorgCtx) {
+	job := reorgInfo.Job
+	if (job.Type != model.ActionReorganizePartition && job.Type != model.ActionAlterTablePartitioning) || tblInfo == nil {
+		return
+	}
+	doneRows := rc.getDoneElementRows()
+	restRows := rc.getRowCount()
+	progress := make([]*model.ReorgElementProgress, 0, len(reorgInfo.elements))
+	for i, elem := range reorgInfo.elements {
+		p := &model.ReorgElementProgress{Name: "copy data"}
+		if bytes.Equal(elem.TypeKey, meta.IndexElementKey) {
+			p.Name = "add index"
+			if idx := model.FindIndexInfoByID(tblInfo.Indices, elem.ID); idx != nil {
+				p.Name += " `" + idx.Name.O + "`"
+			}
+		}
+		switch {
+		case i < len(doneRows):
+			p.Rows, p.Done = doneRows[i], true
+			restRows -= doneRows[i]
+		case i == len(doneRows):
+			p.Rows = restRows
+		}
+		progress = append(progress, p)
+	}
+	job.ReorgMeta.ElementProgress = progress
+}
+
+// syncIngestProgress copies the progress of the ingest phases into the job, so that it can be shown by
+// ADMIN SHOW DDL JOBS. The progress of the scan, flush and import phases comes from the backend context,
+// and the progress of the merge phase comes from the merge workers.
+func syncIngestProgress(reorgInfo *reorgInfo, rc *reorgCtx) {
+	job := reorgInfo.Job
+	if job.ReorgMeta.ReorgTp != model.ReorgTypeLitMerge {
+		return
+	}
+	if reorgInfo.mergingTmpIdx {
+		if job.ReorgMeta.Progress == nil {
+			job.ReorgMeta.Progress = &model.IngestProgress{}
+		}
+		job.ReorgMeta.Progress.Phase = model.ReorgPhaseMerge
+		job.ReorgMeta.Progress.Merge = rc.getMergeProgress()
+		return
+	}
+	if bc, ok := ingest.LitBackCtxMgr.Load(job.ID); ok {
+		job.ReorgMeta.Progress = bc.Progress()
+	}
+}
+
 // runReorgJob is used as a portal to do the reorganization work.
 // eg:
 // 1: add index
@@ -204,6 +358,21 @@ func (w *worker) runReorgJob(rh *reorgHandler, reorgInfo *reorgInfo, tblInfo *mo
 			return dbterror.ErrCancelledDDLJob
 		}
 		rc = w.newReorgCtx(reorgInfo.Job.ID, reorgInfo.StartKey, reorgInfo.currElement, reorgInfo.Job.GetRowCount())
+		rc.setMaintenanceWindow(job.MaintenanceWindow)
+		if job.ReorgMeta.IndexVerify != nil {
+			rc.setRateLimit(job.ReorgMeta.IndexVerify.Rate)
+		}
+		if reorgInfo.mergingTmpIdx && job.ReorgMeta.Progress != nil {
+			// Resume the merge progress of the previous owner.
+			rc.increaseMergeProgress(job.ReorgMeta.Progress.Merge.Rows, job.ReorgMeta.Progress.Merge.Bytes)
+		}
+		for _, p := range job.ReorgMeta.ElementProgress {
+			// Resume the progress of the elements which have been done by the previous owner.
+			if p.Done {
+				rc.mu.doneElementRows = append(rc.mu.doneElementRows, p.Rows)
+			}
+		}
+		d.publishReorgEvent(reorgInfo, ReorgEventStart, rc.getRowCount(), nil)
 		w.wg.Add(1)
 		go func() {
 			defer w.wg.Done()
@@ -227,6 +396,7 @@ func (w *worker) runReorgJob(rh *reorgHandler, reorgInfo *reorgInfo, tblInfo *mo
 		// Since job is cancelled，we don't care about its partial counts.
 		if rc.isReorgCanceled() || terror.ErrorEqual(err, dbterror.ErrCancelledDDLJob) {
 			d.removeReorgCtx(job.ID)
+			d.publishReorgEvent(reorgInfo, ReorgEventCancel, rc.getRowCount(), nil)
 			return dbterror.ErrCancelledDDLJob
 		}
 		rowCount := rc.getRowCount()
@@ -237,16 +407,26 @@ func (w *worker) runReorgJob(rh *reorgHandler, reorgInfo *reorgInfo, tblInfo *mo
 		}
 
 		job.SetRowCount(rowCount)
+		syncIngestProgress(reorgInfo, rc)
+		syncElementProgress(reorgInfo, tblInfo, rc)
 
 		// Update a job's warnings.
 		w.mergeWarningsIntoJob(job)
+		w.mergeIndexVerifyIntoJob(job)
 
 		d.removeReorgCtx(job.ID)
+		if dbterror.ErrMaintenanceWindowClosed.Equal(err) {
+			// The reorganization is paused, the job is picked again from the checkpoint when the window opens.
+			d.publishReorgEvent(reorgInfo, ReorgEventPause, rowCount, nil)
+			return dbterror.ErrWaitReorgTimeout
+		}
 		// For other errors, even err is not nil here, we still wait the partial counts to be collected.
 		// since in the next round, the startKey is brand new which is stored by last time.
 		if err != nil {
+			d.publishReorgEvent(reorgInfo, ReorgEventFail, rowCount, err)
 			return errors.Trace(err)
 		}
+		d.publishReorgEvent(reorgInfo, ReorgEventFinish, rowCount, nil)
 
 		updateBackfillProgress(w, reorgInfo, tblInfo, 0)
 	case <-w.ctx.Done():
@@ -257,10 +437,13 @@ func (w *worker) runReorgJob(rh *reorgHandler, reorgInfo *reorgInfo, tblInfo *mo
 	case <-time.After(waitTimeout):
 		rowCount := rc.getRowCount()
 		job.SetRowCount(rowCount)
+		syncIngestProgress(reorgInfo, rc)
+		syncElementProgress(reorgInfo, tblInfo, rc)
 		updateBackfillProgress(w, reorgInfo, tblInfo, rowCount)
 
 		// Update a job's warnings.
 		w.mergeWarningsIntoJob(job)
+		w.mergeIndexVerifyIntoJob(job)
 
 		rc.resetWarnings()
 
@@ -284,6 +467,25 @@ func (w *worker) mergeWarningsIntoJob(job *model.Job) {
 	job.SetWarnings(warnings, warningsCount)
 }
 
+// mergeIndexVerifyIntoJob moves the result of the verify index job found by the workers into the job,
+// so that it's persisted with the job and survives the owner changes.
+func (w *worker) mergeIndexVerifyIntoJob(job *model.Job) {
+	result := job.ReorgMeta.IndexVerify
+	if result == nil {
+		return
+	}
+	rc := w.getReorgCtx(job.ID)
+	rc.mu.Lock()
+	delta := rc.mu.indexVerify
+	rc.mu.indexVerify = model.IndexVerifyInfo{}
+	rc.mu.Unlock()
+	result.InconsistentRows += delta.InconsistentRows
+	result.RepairedRows += delta.RepairedRows
+	if room := maxVerifyIndexInconsistentHandles - len(result.InconsistentHandles); room > 0 {
+		result.InconsistentHandles = append(result.InconsistentHandles, delta.InconsistentHandles[:mathutil.Min(room, len(delta.InconsistentHandles))]...)
+	}
+}
+
 func updateBackfillProgress(w *worker, reorgInfo *reorgInfo, tblInfo *model.TableInfo,
 	addedRowCount int64) {
 	if tblInfo == nil {
@@ -316,7 +518,7 @@ func updateBackfillProgress(w *worker, reorgInfo *reorgInfo, tblInfo *model.Tabl
 		metrics.GetBackfillProgressByLabel(label, reorgInfo.SchemaName, tblInfo.Name.String()).Set(progress * 100)
 	case model.ActionModifyColumn:
 		metrics.GetBackfillProgressByLabel(metrics.LblModifyColumn, reorgInfo.SchemaName, tblInfo.Name.String()).Set(progress * 100)
-	case model.ActionReorganizePartition:
+	case model.ActionReorganizePartition, model.ActionAlterTablePartitioning:
 		metrics.GetBackfillProgressByLabel(metrics.LblReorgPartition, reorgInfo.SchemaName, tblInfo.Name.String()).Set(progress * 100)
 	}
 }
@@ -368,6 +570,11 @@ func (dc *ddlCtx) isReorgRunnable(jobID int64, isDistReorg bool) error {
 		return dbterror.ErrCancelledDDLJob
 	}
 
+	if err := dc.getReorgCtx(jobID).checkMaintenanceWindow(); err != nil {
+		// The maintenance window is closed, the reorganization pauses until it opens again.
+		return err
+	}
+
 	// If isDistReorg is true, we needn't check if it is owner.
 	if isDistReorg {
 		return nil
@@ -396,6 +603,32 @@ type reorgInfo struct {
 	dbInfo          *model.DBInfo
 	elements        []*meta.Element
 	currElement     *meta.Element
+	// concurrentPartitions is the number of partitions backfilled together with this one. The progress of the
+	// concurrently backfilled partitions isn't persisted, they're redone from the checkpoint of the first one.
+	concurrentPartitions int
+	// snapshotVer is the snapshot version of a partition backfilled concurrently. The concurrent partitions refresh
+	// their snapshots separately, so they don't write the snapshot version of the shared job.
+	snapshotVer uint64
+}
+
+// getSnapshotVer returns the snapshot version used to calculate the key range of the reorganization.
+func (r *reorgInfo) getSnapshotVer() uint64 {
+	if r.concurrentPartitions > 1 {
+		return r.snapshotVer
+	}
+	return r.Job.SnapshotVer
+}
+
+// setSnapshotVer sets the snapshot version used to calculate the key range of the reorganization.
+func (r *reorgInfo) setSnapshotVer(ver uint64) {
+	if r.concurrentPartitions > 1 {
+		r.snapshotVer = ver
+		return
+	}
+	// The job may be encoded by the DDL worker concurrently.
+	r.Job.Mu.Lock()
+	r.Job.SnapshotVer = ver
+	r.Job.Mu.Unlock()
 }
 
 func (r *reorgInfo) String() string {
@@ -762,7 +995,7 @@ func getReorgInfoFromPartitions(ctx *JobContext, d *ddlCtx, rh *reorgHandler, jo
 // UpdateReorgMeta creates a new transaction and updates tidb_ddl_reorg table,
 // so the reorg can restart in case of issues.
 func (r *reorgInfo) UpdateReorgMeta(startKey kv.Key, pool *sessionPool) (err error) {
-	if startKey == nil && r.EndKey == nil {
+	if startKey == nil && r.EndKey == nil || r.concurrentPartitions > 1 {
 		return nil
 	}
 	sctx, err := pool.get()
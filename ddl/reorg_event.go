@@ -0,0 +1,136 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/ddl/util"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/util/logutil"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+// ReorgEventPrefix is the etcd prefix of the reorganization events. The ecosystem tools like TiCDC can watch
+// the prefix to know when the heavy backfill of a DDL job starts and stops, the key of a job is the prefix
+// followed by the job ID, and the value is the JSON encoded ReorgEvent of its latest phase.
+const ReorgEventPrefix = "/tidb/ddl/reorg_event/"
+
+// reorgEventTTL is the TTL in seconds of the reorganization events.
+const reorgEventTTL = 10 * 60
+
+// ReorgEventPhase is the phase of the reorganization in a ReorgEvent.
+type ReorgEventPhase string
+
+const (
+	// ReorgEventStart means the reorganization of the element starts or resumes on the DDL owner.
+	ReorgEventStart ReorgEventPhase = "start"
+	// ReorgEventFinish means the reorganization of the element finishes.
+	ReorgEventFinish ReorgEventPhase = "finish"
+	// ReorgEventPause means the reorganization pauses since the maintenance window of the job is closed.
+	ReorgEventPause ReorgEventPhase = "pause"
+	// ReorgEventCancel means the job is cancelled during the reorganization.
+	ReorgEventCancel ReorgEventPhase = "cancel"
+	// ReorgEventFail means the reorganization meets an error, and the job may retry or roll back.
+	ReorgEventFail ReorgEventPhase = "fail"
+)
+
+// ReorgEvent is a lifecycle event of the reorganization of a DDL job.
+type ReorgEvent struct {
+	JobID      int64  `json:"job_id"`
+	JobType    string `json:"job_type"`
+	SchemaName string `json:"schema_name"`
+	TableName  string `json:"table_name"`
+	TableID    int64  `json:"table_id"`
+	// PhysicalTableID is the partition being reorganized if the table is partitioned.
+	PhysicalTableID int64 `json:"physical_table_id"`
+	// ElementType is "index" or "column".
+	ElementType string          `json:"element_type"`
+	ElementID   int64           `json:"element_id"`
+	Phase       ReorgEventPhase `json:"phase"`
+	SnapshotTS  uint64          `json:"snapshot_ts"`
+	RowCount    int64           `json:"row_count"`
+	Error       string          `json:"error,omitempty"`
+	Time        time.Time       `json:"time"`
+}
+
+// ReorgEventKey returns the etcd key of the reorganization event of the job.
+func ReorgEventKey(jobID int64) string {
+	return ReorgEventPrefix + strconv.FormatInt(jobID, 10)
+}
+
+func newReorgEvent(reorgInfo *reorgInfo, phase ReorgEventPhase, rowCount int64, reorgErr error) *ReorgEvent {
+	job := reorgInfo.Job
+	event := &ReorgEvent{
+		JobID:           job.ID,
+		JobType:         job.Type.String(),
+		SchemaName:      job.SchemaName,
+		TableName:       job.TableName,
+		TableID:         job.TableID,
+		PhysicalTableID: reorgInfo.PhysicalTableID,
+		Phase:           phase,
+		SnapshotTS:      reorgInfo.getSnapshotVer(),
+		RowCount:        rowCount,
+		Time:            time.Now(),
+	}
+	if elem := reorgInfo.currElement; elem != nil {
+		event.ElementID = elem.ID
+		switch string(elem.TypeKey) {
+		case string(meta.IndexElementKey):
+			event.ElementType = "index"
+		case string(meta.ColumnElementKey):
+			event.ElementType = "column"
+		case string(meta.ConstraintElementKey):
+			event.ElementType = "constraint"
+		}
+	}
+	if reorgErr != nil {
+		event.Error = reorgErr.Error()
+	}
+	return event
+}
+
+// publishReorgEvent publishes the reorganization event to etcd. It's best effort, the failure is only logged
+// and doesn't affect the job.
+func (dc *ddlCtx) publishReorgEvent(reorgInfo *reorgInfo, phase ReorgEventPhase, rowCount int64, reorgErr error) {
+	if dc.etcdCli == nil {
+		return
+	}
+	event := newReorgEvent(reorgInfo, phase, rowCount, reorgErr)
+	err := dc.putReorgEvent(event)
+	if err != nil {
+		logutil.BgLogger().Warn("[ddl] publish reorg event failed", zap.Int64("jobID", event.JobID),
+			zap.String("phase", string(phase)), zap.Error(err))
+	}
+}
+
+func (dc *ddlCtx) putReorgEvent(event *ReorgEvent) error {
+	val, err := json.Marshal(event)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctx, cancel := context.WithTimeout(dc.ctx, util.KeyOpDefaultTimeout)
+	lease, err := dc.etcdCli.Grant(ctx, reorgEventTTL)
+	cancel()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return util.PutKVToEtcd(dc.ctx, dc.etcdCli, 1, ReorgEventKey(event.JobID), string(val), clientv3.WithLease(lease.ID))
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReorgEvent(t *testing.T) {
+	require.Equal(t, "/tidb/ddl/reorg_event/12", ReorgEventKey(12))
+
+	info := &reorgInfo{
+		Job: &model.Job{
+			ID:          12,
+			Type:        model.ActionAddIndex,
+			SchemaName:  "test",
+			TableName:   "t",
+			TableID:     100,
+			SnapshotVer: 4321,
+		},
+		PhysicalTableID: 101,
+		currElement:     &meta.Element{ID: 3, TypeKey: meta.IndexElementKey},
+	}
+	event := newReorgEvent(info, ReorgEventStart, 0, nil)
+	require.Equal(t, int64(12), event.JobID)
+	require.Equal(t, "add index", event.JobType)
+	require.Equal(t, int64(101), event.PhysicalTableID)
+	require.Equal(t, "index", event.ElementType)
+	require.Equal(t, int64(3), event.ElementID)
+	require.Equal(t, uint64(4321), event.SnapshotTS)
+	require.Empty(t, event.Error)
+
+	info.currElement = &meta.Element{ID: 5, TypeKey: meta.ColumnElementKey}
+	event = newReorgEvent(info, ReorgEventFail, 10, errors.New("mock error"))
+	require.Equal(t, "column", event.ElementType)
+	require.Equal(t, ReorgEventFail, event.Phase)
+	require.Equal(t, int64(10), event.RowCount)
+	require.Equal(t, "mock error", event.Error)
+
+	val, err := json.Marshal(event)
+	require.NoError(t, err)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(val, &decoded))
+	require.Equal(t, "fail", decoded["phase"])
+	require.Equal(t, float64(4321), decoded["snapshot_ts"])
+	require.Equal(t, "test", decoded["schema_name"])
+
+	// The event isn't published without etcd.
+	dc := &ddlCtx{}
+	dc.publishReorgEvent(info, ReorgEventFinish, 10, nil)
+}
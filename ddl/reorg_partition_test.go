@@ -19,9 +19,11 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/ddl"
 	"github.com/pingcap/tidb/ddl/internal/callback"
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/errno"
@@ -585,6 +587,56 @@ func TestReorganizeRangeColumnsPartition(t *testing.T) {
 	tk.MustQuery(`SELECT * FROM t PARTITION(pMax)`).Sort().Check(testkit.Rows("2022-05-05 2022-05-05 10:10:10 5", "2023-05-05 2023-05-05 10:10:10 6"))
 }
 
+func TestSplitAndMergeRangePartition(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec(`create table t (a int PRIMARY KEY, b varchar(255), key (b)) partition by range (a) ` +
+		`(partition p0 values less than (10),` +
+		` partition p1 values less than (20),` +
+		` partition pMax values less than (MAXVALUE))`)
+	tk.MustExec(`insert into t values (1,"1"), (12,"12"), (23,"23"), (34,"34"), (45,"45")`)
+
+	tk.MustExec(`alter table t split partition pMax into (partition p2 values less than (30), partition p3 values less than (40), partition pMax values less than (MAXVALUE))`)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select partition_name from information_schema.partitions where table_schema = 'test' and table_name = 't'`).Check(testkit.Rows(
+		"p0", "p1", "p2", "p3", "pMax"))
+	tk.MustQuery(`select * from t partition (p2)`).Check(testkit.Rows("23 23"))
+	tk.MustQuery(`select * from t partition (p3)`).Check(testkit.Rows("34 34"))
+	tk.MustQuery(`select * from t partition (pMax)`).Check(testkit.Rows("45 45"))
+	// The split partitions must cover the same range as the split one.
+	tk.MustGetErrCode(`alter table t split partition p1 into (partition p1a values less than (15), partition p1b values less than (25))`, errno.ErrRangeNotIncreasing)
+	tk.MustGetErrCode(`alter table t split partition p1 into (partition p1a values less than (20))`, errno.ErrUnsupportedDDLOperation)
+
+	tk.MustExec(`alter table t merge partitions p1,p2,p3 into partition p1`)
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`show create table t`).Check(testkit.Rows("" +
+		"t CREATE TABLE `t` (\n" +
+		"  `a` int(11) NOT NULL,\n" +
+		"  `b` varchar(255) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`a`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  KEY `b` (`b`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
+		"PARTITION BY RANGE (`a`)\n" +
+		"(PARTITION `p0` VALUES LESS THAN (10),\n" +
+		" PARTITION `p1` VALUES LESS THAN (40),\n" +
+		" PARTITION `pMax` VALUES LESS THAN (MAXVALUE))"))
+	tk.MustQuery(`select * from t partition (p1)`).Sort().Check(testkit.Rows("12 12", "23 23", "34 34"))
+	tk.MustQuery(`select * from t where b > "2"`).Sort().Check(testkit.Rows("23 23", "34 34", "45 45"))
+	tk.MustExec(`alter table t merge partitions p1,pMax into partition pMax`)
+	tk.MustQuery(`select * from t partition (pMax)`).Sort().Check(testkit.Rows("12 12", "23 23", "34 34", "45 45"))
+	tk.MustGetErrCode(`alter table t merge partitions p0 into partition p0`, errno.ErrUnsupportedDDLOperation)
+	tk.MustExec(`alter table t split partition pMax into (partition p1 values less than (20), partition p2 values less than (30), partition pMax values less than (MAXVALUE))`)
+	tk.MustGetErrCode(`alter table t merge partitions p0,p2 into partition p0`, errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode(`alter table t merge partitions p0,px into partition p0`, errno.ErrWrongPartitionName)
+	tk.MustExec(`admin check table t`)
+
+	tk.MustExec(`create table tl (a int) partition by list (a) (partition p0 values in (1), partition p1 values in (2))`)
+	tk.MustGetErrCode(`alter table tl merge partitions p0,p1 into partition p0`, errno.ErrUnsupportedDDLOperation)
+	tk.MustExec(`create table tn (a int)`)
+	tk.MustGetErrCode(`alter table tn split partition p0 into (partition p1 values less than (1), partition p2 values less than (2))`, errno.ErrPartitionMgmtOnNonpartitioned)
+}
+
 func TestReorganizeListPartition(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -1374,3 +1426,196 @@ func TestReorgPartitionData(t *testing.T) {
 	tk.MustQuery(`select * from t`).Sort().Check(testkit.Rows("0 Zero value! 0 2022-02-30 00:00:00"))
 	tk.MustExec(`admin check table t`)
 }
+
+func TestReorgPartitionElementProgress(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	schemaName := "ReorgPartElementProgress"
+	tk.MustExec("create database " + schemaName)
+	tk.MustExec("use " + schemaName)
+	tk.MustExec(`create table t (a int unsigned PRIMARY KEY, b varchar(255), c int, key (b), key (c,b))` +
+		` partition by range (a) ` +
+		`(partition p0 values less than (10),` +
+		` partition p1 values less than (20),` +
+		` partition pMax values less than (MAXVALUE))`)
+	tk.MustExec(`insert into t values (1,"1",1), (12,"12",21),(23,"23",32),(34,"34",43),(45,"45",54),(56,"56",65)`)
+	tk.MustExec("alter table t reorganize partition p1 into (partition p1a values less than (15), partition p1b values less than (20))")
+	tk.MustExec(`admin check table t`)
+
+	jobID, err := strconv.ParseInt(tk.MustQuery("admin show ddl jobs 1").Rows()[0][0].(string), 10, 64)
+	require.NoError(t, err)
+	historyJob, err := ddl.GetHistoryJobByID(tk.Session(), jobID)
+	require.NoError(t, err)
+	require.Equal(t, model.ActionReorganizePartition, historyJob.Type)
+	progress := historyJob.ReorgMeta.ElementProgress
+	require.Len(t, progress, 3)
+	require.Equal(t, "copy data", progress[0].Name)
+	require.Equal(t, int64(1), progress[0].Rows)
+	require.Equal(t, "add index `b`", progress[1].Name)
+	require.Equal(t, "add index `c`", progress[2].Name)
+	for _, p := range progress {
+		require.True(t, p.Done)
+	}
+}
+
+func TestReorgPartitionCancelWithProgress(t *testing.T) {
+	store := testkit.CreateMockStoreWithSchemaLease(t, testLease)
+	tk := testkit.NewTestKit(t, store)
+	schemaName := "ReorgPartCancelWithProgress"
+	tk.MustExec("create database " + schemaName)
+	tk.MustExec("use " + schemaName)
+	tk.MustExec(`create table t (a int unsigned PRIMARY KEY, b varchar(255), c int, key (b), key (c,b))` +
+		` partition by range (a) ` +
+		`(partition p0 values less than (10),` +
+		` partition p1 values less than (20),` +
+		` partition pMax values less than (MAXVALUE))`)
+	tk.MustExec(`insert into t values (1,"1",1), (12,"12",21),(14,"14",14),(23,"23",32),(34,"34",43),(45,"45",54),(56,"56",65)`)
+	tkCancel := testkit.NewTestKit(t, store)
+	tkCancel.MustExec("use " + schemaName)
+
+	// Each element takes longer than the reorg wait timeout, so the job is updated between the elements.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/ddl/mockBackfillSlow", "return"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/ddl/mockBackfillSlow"))
+	}()
+	dom := domain.GetDomain(tk.Session())
+	originHook := dom.DDL().GetHook()
+	defer dom.DDL().SetHook(originHook)
+	hook := &callback.TestDDLCallback{Do: dom}
+	var (
+		jobID     int64
+		newPIDs   []int64
+		jobRows   [][]interface{}
+		cancelled bool
+	)
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if job.Type != model.ActionReorganizePartition || job.SchemaState != model.StateWriteReorganization || cancelled {
+			return
+		}
+		progress := job.ReorgMeta.ElementProgress
+		// Cancel the job when the rows are copied into the new partitions, and the first index is being built.
+		if len(progress) != 3 || !progress[0].Done || progress[1].Done {
+			return
+		}
+		cancelled = true
+		jobID = job.ID
+		tbl, err := dom.InfoSchema().TableByName(model.NewCIStr(schemaName), model.NewCIStr("t"))
+		require.NoError(t, err)
+		for _, def := range tbl.Meta().Partition.AddingDefinitions {
+			newPIDs = append(newPIDs, def.ID)
+		}
+		for _, row := range tkCancel.MustQuery("admin show ddl jobs").Rows() {
+			if row[0] == strconv.FormatInt(job.ID, 10) {
+				jobRows = append(jobRows, row)
+			}
+		}
+		tkCancel.MustQuery(fmt.Sprintf("admin cancel ddl jobs %d", job.ID))
+	}
+	dom.DDL().SetHook(hook)
+	tk.MustGetErrCode("alter table t reorganize partition p1 into (partition p1a values less than (15), partition p1b values less than (20))", errno.ErrCancelledDDLJob)
+	require.True(t, cancelled)
+
+	// The job itself and each element of it are shown.
+	require.Len(t, jobRows, 4)
+	require.Equal(t, "alter table reorganize partition", jobRows[0][3])
+	require.Equal(t, "running", jobRows[0][11])
+	require.Equal(t, "alter table reorganize partition /* copy data */", jobRows[1][3])
+	require.Equal(t, "2", jobRows[1][7])
+	require.Equal(t, "done", jobRows[1][11])
+	require.Equal(t, "alter table reorganize partition /* add index `b` */", jobRows[2][3])
+	require.Equal(t, "running", jobRows[2][11])
+	require.Equal(t, "alter table reorganize partition /* add index `c` */", jobRows[3][3])
+	require.Equal(t, "0", jobRows[3][7])
+	require.Equal(t, "queueing", jobRows[3][11])
+	for _, row := range jobRows[1:] {
+		require.Equal(t, "write reorganization", row[4])
+	}
+
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`select * from t where a between 10 and 20`).Sort().Check(testkit.Rows("12 12 21", "14 14 14"))
+	tk.MustQuery(`select partition_name from information_schema.partitions where table_schema = '` + schemaName + `' and table_name = 't'`).Sort().Check(testkit.Rows("p0", "p1", "pMax"))
+
+	// The data of the new partitions is removed.
+	require.Len(t, newPIDs, 2)
+	deleted := tk.MustQuery(fmt.Sprintf("select start_key from mysql.gc_delete_range where job_id = %d union all select start_key from mysql.gc_delete_range_done where job_id = %d", jobID, jobID)).Rows()
+	for _, pid := range newPIDs {
+		require.Contains(t, deleted, []interface{}{hex.EncodeToString(tablecodec.EncodeTablePrefix(pid))})
+	}
+	ctx := tk.Session()
+	tbl, err := domain.GetDomain(ctx).InfoSchema().TableByName(model.NewCIStr(schemaName), model.NewCIStr("t"))
+	require.NoError(t, err)
+	require.Nil(t, tbl.Meta().Partition.AddingDefinitions)
+	noNewTablesAfter(t, tk, ctx, tbl)
+}
+
+func TestAlterTablePartitioning(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec(`create table t (a int PRIMARY KEY, b varchar(255), c int, key (b), key (c,b))`)
+	tk.MustExec(`insert into t values (1,"1",1), (12,"12",21), (23,"23",32), (34,"34",43), (45,"45",54)`)
+
+	// The rows written by the other sessions while the table is being partitioned go into the new partitions too.
+	tkDML := testkit.NewTestKit(t, store)
+	tkDML.MustExec("use test")
+	originHook := dom.DDL().GetHook()
+	defer dom.DDL().SetHook(originHook)
+	hook := &callback.TestDDLCallback{Do: dom}
+	states := make([]model.SchemaState, 0, 4)
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if job.Type != model.ActionAlterTablePartitioning || job.SchemaState == model.StateNone {
+			return
+		}
+		if len(states) > 0 && states[len(states)-1] == job.SchemaState {
+			return
+		}
+		states = append(states, job.SchemaState)
+		a := 50 + len(states)
+		tkDML.MustExec(fmt.Sprintf(`insert into t values (%d,"%d",%d)`, a, a, a))
+		tkDML.MustExec(fmt.Sprintf(`update t set c = c + 1 where a = %d`, len(states)*11+1))
+	}
+	dom.DDL().SetHook(hook)
+	tk.MustExec(`alter table t partition by range (a) ` +
+		`(partition p0 values less than (10),` +
+		` partition p1 values less than (20),` +
+		` partition pMax values less than (MAXVALUE))`)
+	dom.DDL().SetHook(originHook)
+	require.Equal(t, []model.SchemaState{model.StateDeleteOnly, model.StateWriteOnly, model.StateWriteReorganization, model.StateDeleteReorganization}, states)
+
+	tk.MustExec(`admin check table t`)
+	tk.MustQuery(`show create table t`).Check(testkit.Rows("" +
+		"t CREATE TABLE `t` (\n" +
+		"  `a` int(11) NOT NULL,\n" +
+		"  `b` varchar(255) DEFAULT NULL,\n" +
+		"  `c` int(11) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`a`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  KEY `b` (`b`),\n" +
+		"  KEY `c` (`c`,`b`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
+		"PARTITION BY RANGE (`a`)\n" +
+		"(PARTITION `p0` VALUES LESS THAN (10),\n" +
+		" PARTITION `p1` VALUES LESS THAN (20),\n" +
+		" PARTITION `pMax` VALUES LESS THAN (MAXVALUE))"))
+	tk.MustQuery(`select * from t partition (p0)`).Check(testkit.Rows("1 1 1"))
+	tk.MustQuery(`select * from t partition (p1)`).Check(testkit.Rows("12 12 22"))
+	tk.MustQuery(`select * from t partition (pMax)`).Sort().Check(testkit.Rows(
+		"23 23 33", "34 34 44", "45 45 55", "51 51 51", "52 52 52", "53 53 53", "54 54 54"))
+	tk.MustQuery(`select a from t where b = "34"`).Check(testkit.Rows("34"))
+
+	tk.MustExec(`create table tl (a int, b int, unique key (b))`)
+	tk.MustExec(`insert into tl values (1, 1), (2, 2), (3, 3)`)
+	tk.MustGetErrCode(`alter table tl partition by list (a) (partition p0 values in (1, 2), partition p1 values in (3))`, errno.ErrUniqueKeyNeedAllFieldsInPf)
+	tk.MustExec(`alter table tl partition by list (b) (partition p0 values in (1, 2), partition p1 values in (3))`)
+	tk.MustExec(`admin check table tl`)
+	tk.MustQuery(`select * from tl partition (p1)`).Check(testkit.Rows("3 3"))
+
+	// The rows must fit into the new partitions.
+	tk.MustExec(`create table tn (a int)`)
+	tk.MustExec(`insert into tn values (1), (20)`)
+	tk.MustGetErrCode(`alter table tn partition by range (a) (partition p0 values less than (10))`, errno.ErrNoPartitionForGivenValue)
+	tk.MustExec(`admin check table tn`)
+	tk.MustQuery(`select count(*) from information_schema.partitions where table_schema = 'test' and table_name = 'tn' and partition_name is not null`).Check(testkit.Rows("0"))
+	tk.MustQuery(`select * from tn`).Sort().Check(testkit.Rows("1", "20"))
+	tk.MustGetErrCode(`alter table tn partition by hash (a) partitions 4`, errno.ErrUnsupportedDDLOperation)
+	tk.MustContainErrMsg(`alter table t partition by hash (a) partitions 4`, "alter table partition is unsupported")
+}
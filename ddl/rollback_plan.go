@@ -0,0 +1,188 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/dbterror"
+)
+
+// RollbackPlan is the estimation of what rolling back a running DDL job entails, which helps to decide between
+// waiting for the job and cancelling it.
+type RollbackPlan struct {
+	Rollbackable bool
+	// Action describes what the rollback does, or why the job can't be rolled back.
+	Action string
+	// KeysToClean is the number of the keys written by the job, which are deleted with the ranges.
+	KeysToClean int64
+	// RangesToDelete is the number of the ranges inserted into mysql.gc_delete_range, they are deleted by the GC
+	// worker after tidb_gc_life_time.
+	RangesToDelete int
+	// SchemaChanges is the number of the schema versions the rollback goes through.
+	SchemaChanges int
+}
+
+// EstimatedDuration returns the expected duration of the rollback with the schema lease. Each schema change waits
+// for all the servers to load the new schema for at most 2 leases, the ranges are deleted in the background.
+func (p *RollbackPlan) EstimatedDuration(lease time.Duration) time.Duration {
+	return time.Duration(p.SchemaChanges) * 2 * lease
+}
+
+// GetDDLJobByID returns the job in the DDL job queue by its ID.
+func GetDDLJobByID(sctx sessionctx.Context, id int64) (*model.Job, error) {
+	jobs, err := getJobsBySQL(newSession(sctx), JobTable, fmt.Sprintf("job_id = %d", id))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(jobs) == 0 {
+		return nil, dbterror.ErrDDLJobNotFound.GenWithStackByArgs(id)
+	}
+	return jobs[0], nil
+}
+
+// physicalTableCount returns the number of the physical tables of the table, which is the number of the ranges of
+// an index.
+func physicalTableCount(tblInfo *model.TableInfo) int {
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		return len(pi.Definitions)
+	}
+	return 1
+}
+
+func quoteIndexNames(indexes []*model.IndexInfo) string {
+	names := make([]string, 0, len(indexes))
+	for _, idx := range indexes {
+		names = append(names, "`"+idx.Name.O+"`")
+	}
+	return strings.Join(names, ", ")
+}
+
+// ExplainRollbackDDLJob estimates the rollback of the job, tblInfo is the table the job works on, which is nil if
+// the table isn't found. The rollback of a reorg job deletes the keys written by the backfill, the other jobs only
+// revert their schema changes.
+func ExplainRollbackDDLJob(job *model.Job, tblInfo *model.TableInfo) *RollbackPlan {
+	switch {
+	case job.IsDone() || job.IsSynced():
+		return &RollbackPlan{Action: "the job is finished"}
+	case job.IsCancelling() || job.IsRollingback() || job.IsCancelled() || job.IsRollbackDone():
+		return &RollbackPlan{Action: "the job is already rolling back"}
+	case !job.IsRollbackable():
+		return &RollbackPlan{Action: fmt.Sprintf("the job can't be rolled back in the %s state, wait for it to finish", job.SchemaState)}
+	}
+	plan := &RollbackPlan{Rollbackable: true}
+	if job.SchemaState == model.StateNone {
+		plan.Action = "cancel the job before it changes the schema"
+		return plan
+	}
+	if tblInfo == nil {
+		plan.Action = "revert the schema changes of the job"
+		plan.SchemaChanges = 1
+		return plan
+	}
+	switch job.Type {
+	case model.ActionAddIndex, model.ActionAddPrimaryKey:
+		explainRollbackAddIndex(plan, job, tblInfo)
+	case model.ActionModifyColumn:
+		explainRollbackModifyColumn(plan, job, tblInfo)
+	case model.ActionReorganizePartition, model.ActionAlterTablePartitioning:
+		explainRollbackReorganizePartition(plan, job, tblInfo)
+	default:
+		plan.Action = "revert the schema changes of the job"
+		plan.SchemaChanges = 1
+	}
+	return plan
+}
+
+// explainRollbackAddIndex estimates the rollback of adding indexes, which drops the adding indexes like DROP INDEX:
+// the indexes go through the delete only, the delete reorganization and the none states, and the ranges of the
+// indexes are deleted on each partition, including the temporary indexes if the incremental changes are merged.
+func explainRollbackAddIndex(plan *RollbackPlan, job *model.Job, tblInfo *model.TableInfo) {
+	var indexes []*model.IndexInfo
+	keyIndexes := 0
+	for _, idx := range tblInfo.Indices {
+		if idx.State == model.StatePublic {
+			continue
+		}
+		indexes = append(indexes, idx)
+		// The columnar indexes have no keys in TiKV.
+		if idx.Tp != model.IndexTypeColumnar {
+			keyIndexes++
+		}
+	}
+	plan.Action = fmt.Sprintf("drop the adding index %s", quoteIndexNames(indexes))
+	plan.SchemaChanges = 3
+	rangesPerIndex := physicalTableCount(tblInfo)
+	if job.ReorgMeta != nil && job.ReorgMeta.ReorgTp.NeedMergeProcess() {
+		rangesPerIndex *= 2
+	}
+	plan.RangesToDelete = keyIndexes * rangesPerIndex
+	if job.SchemaState == model.StateWriteReorganization {
+		plan.KeysToClean = job.GetRowCount() * int64(keyIndexes)
+	}
+	if plan.RangesToDelete > 0 {
+		plan.Action += " and delete the keys"
+	}
+}
+
+// explainRollbackModifyColumn estimates the rollback of changing the column type, which removes the changing column
+// and deletes the ranges of the changing indexes on each partition. The rows written to the changing column are
+// overwritten rather than deleted, so only the keys of the changing indexes are cleaned.
+func explainRollbackModifyColumn(plan *RollbackPlan, job *model.Job, tblInfo *model.TableInfo) {
+	var indexes []*model.IndexInfo
+	for _, idx := range tblInfo.Indices {
+		if strings.HasPrefix(idx.Name.O, changingIndexPrefix) {
+			indexes = append(indexes, idx)
+		}
+	}
+	plan.SchemaChanges = 1
+	if len(indexes) == 0 {
+		plan.Action = "remove the changing column"
+		return
+	}
+	plan.Action = fmt.Sprintf("remove the changing column and delete the keys of the changing index %s", quoteIndexNames(indexes))
+	plan.RangesToDelete = len(indexes) * physicalTableCount(tblInfo)
+	if job.SchemaState == model.StateWriteReorganization {
+		plan.KeysToClean = job.GetRowCount() * int64(len(indexes))
+	}
+}
+
+// explainRollbackReorganizePartition estimates the rollback of reorganizing partitions, which drops the adding
+// partitions with all the rows copied into them and their index entries.
+func explainRollbackReorganizePartition(plan *RollbackPlan, job *model.Job, tblInfo *model.TableInfo) {
+	var adding []string
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		for _, def := range pi.AddingDefinitions {
+			adding = append(adding, "`"+def.Name.O+"`")
+		}
+	}
+	plan.Action = fmt.Sprintf("drop the adding partition %s and delete the keys", strings.Join(adding, ", "))
+	plan.SchemaChanges = 2
+	plan.RangesToDelete = len(adding)
+	if job.SchemaState == model.StateWriteReorganization {
+		keysPerRow := int64(1)
+		for _, idx := range tblInfo.Indices {
+			if idx.Tp != model.IndexTypeColumnar {
+				keysPerRow++
+			}
+		}
+		plan.KeysToClean = job.GetRowCount() * keysPerRow
+	}
+}
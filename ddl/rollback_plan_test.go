@@ -0,0 +1,81 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainRollbackDDLJob(t *testing.T) {
+	partitioned := &model.TableInfo{
+		Indices: []*model.IndexInfo{
+			{Name: model.NewCIStr("a"), State: model.StatePublic},
+			{Name: model.NewCIStr("b"), State: model.StateWriteReorganization},
+		},
+		Partition: &model.PartitionInfo{
+			Definitions:       []model.PartitionDefinition{{Name: model.NewCIStr("p0")}, {Name: model.NewCIStr("p1")}},
+			AddingDefinitions: []model.PartitionDefinition{{Name: model.NewCIStr("p2")}},
+		},
+	}
+	job := &model.Job{
+		Type:        model.ActionAddIndex,
+		State:       model.JobStateRunning,
+		SchemaState: model.StateWriteReorganization,
+		RowCount:    100,
+		ReorgMeta:   &model.DDLReorgMeta{ReorgTp: model.ReorgTypeLitMerge},
+	}
+	plan := ExplainRollbackDDLJob(job, partitioned)
+	require.True(t, plan.Rollbackable)
+	require.Equal(t, "drop the adding index `b` and delete the keys", plan.Action)
+	// The temporary index is deleted on both partitions.
+	require.Equal(t, 4, plan.RangesToDelete)
+	require.Equal(t, int64(100), plan.KeysToClean)
+	require.Equal(t, 3*2*time.Second, plan.EstimatedDuration(time.Second))
+
+	// Nothing is written before the write reorganization state.
+	job.SchemaState = model.StateWriteOnly
+	job.ReorgMeta.ReorgTp = model.ReorgTypeTxn
+	plan = ExplainRollbackDDLJob(job, partitioned)
+	require.Equal(t, 2, plan.RangesToDelete)
+	require.Equal(t, int64(0), plan.KeysToClean)
+
+	job.Type = model.ActionReorganizePartition
+	job.SchemaState = model.StateWriteReorganization
+	plan = ExplainRollbackDDLJob(job, partitioned)
+	require.Equal(t, "drop the adding partition `p2` and delete the keys", plan.Action)
+	require.Equal(t, 1, plan.RangesToDelete)
+	require.Equal(t, int64(300), plan.KeysToClean)
+
+	// The new partitions have replaced the old ones.
+	job.SchemaState = model.StateDeleteReorganization
+	plan = ExplainRollbackDDLJob(job, partitioned)
+	require.False(t, plan.Rollbackable)
+	require.Equal(t, "the job can't be rolled back in the delete reorganization state, wait for it to finish", plan.Action)
+
+	job.Type = model.ActionAddColumn
+	job.SchemaState = model.StateNone
+	plan = ExplainRollbackDDLJob(job, partitioned)
+	require.True(t, plan.Rollbackable)
+	require.Equal(t, 0, plan.SchemaChanges)
+
+	job.State = model.JobStateRollingback
+	plan = ExplainRollbackDDLJob(job, partitioned)
+	require.False(t, plan.Rollbackable)
+	require.Equal(t, "the job is already rolling back", plan.Action)
+}
@@ -151,8 +151,14 @@ func rollingbackModifyColumn(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job)
 	return ver, dbterror.ErrCancelledDDLJob
 }
 
-func rollingbackAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
-	tblInfo, columnInfo, col, _, _, err := checkAddColumn(t, job)
+func rollingbackAddColumn(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	if needNotifyAndStopReorgWorker(job) {
+		// The workers filling the stored generated column are started, ask them to exit.
+		logutil.Logger(w.logCtx).Info("[ddl] run the cancelling DDL job", zap.String("job", job.String()))
+		d.notifyReorgCancel(job)
+		return w.onAddColumn(d, t, job)
+	}
+	tblInfo, columnInfo, _, _, _, err := checkAddColumn(t, job)
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
@@ -160,19 +166,24 @@ func rollingbackAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, e
 		job.State = model.JobStateCancelled
 		return ver, dbterror.ErrCancelledDDLJob
 	}
+	return convertAddColumnJob2RollbackJob(d, t, job, tblInfo, columnInfo, dbterror.ErrCancelledDDLJob)
+}
 
+// convertAddColumnJob2RollbackJob converts the add column job to a rolling back job, which drops the column.
+func convertAddColumnJob2RollbackJob(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo,
+	columnInfo *model.ColumnInfo, err error) (ver int64, _ error) {
 	originalState := columnInfo.State
 	columnInfo.State = model.StateDeleteOnly
 	job.SchemaState = model.StateDeleteOnly
 
-	job.Args = []interface{}{col.Name}
-	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != columnInfo.State)
-	if err != nil {
-		return ver, errors.Trace(err)
+	job.Args = []interface{}{columnInfo.Name}
+	ver, err1 := updateVersionAndTableInfo(d, t, job, tblInfo, originalState != columnInfo.State)
+	if err1 != nil {
+		return ver, errors.Trace(err1)
 	}
 
 	job.State = model.JobStateRollingback
-	return ver, dbterror.ErrCancelledDDLJob
+	return ver, errors.Trace(err)
 }
 
 func rollingbackDropColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
@@ -355,11 +366,19 @@ func rollingbackTruncateTable(t *meta.Meta, job *model.Job) (ver int64, err erro
 	return cancelOnlyNotHandledJob(job, model.StateNone)
 }
 
-func rollingbackReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+func rollingbackReorganizePartition(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
 	if job.SchemaState == model.StateNone {
 		job.State = model.JobStateCancelled
 		return ver, dbterror.ErrCancelledDDLJob
 	}
+	if needNotifyAndStopReorgWorker(job) {
+		// The reorg workers are started, ask them to exit before removing the new partitions,
+		// otherwise they may keep writing into the new partitions. The job is converted to
+		// a rollback job by onReorganizePartition once they exit.
+		logutil.Logger(w.logCtx).Info("[ddl] run the cancelling DDL job", zap.String("job", job.String()))
+		d.notifyReorgCancel(job)
+		return w.onReorganizePartition(d, t, job)
+	}
 
 	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
 	if err != nil {
@@ -370,21 +389,96 @@ func rollingbackReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (ve
 	return convertAddTablePartitionJob2RollbackJob(d, t, job, dbterror.ErrCancelledDDLJob, tblInfo)
 }
 
+func rollingbackCreateTableSelect(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	if job.SchemaState == model.StateNone {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrCancelledDDLJob
+	}
+	if needNotifyAndStopReorgWorker(job) && w.getReorgCtx(job.ID) != nil {
+		// Ask the reorg worker to stop writing the selected rows, the job is converted to
+		// a rollback job by onCreateTableSelect once it exits.
+		logutil.Logger(w.logCtx).Info("[ddl] run the cancelling DDL job", zap.String("job", job.String()))
+		d.notifyReorgCancel(job)
+		return w.onCreateTableSelect(d, t, job)
+	}
+
+	tbInfo := &model.TableInfo{}
+	var selectSQL string
+	var written bool
+	var abandonedIDs []int64
+	if err = job.DecodeArgs(tbInfo, &selectSQL, &written, &abandonedIDs); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	return convertCreateTableSelectJob2RollbackJob(t, job, tbInfo, abandonedIDs, dbterror.ErrCancelledDDLJob)
+}
+
+func rollingbackVerifyIndex(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	if needNotifyAndStopReorgWorker(job) && w.getReorgCtx(job.ID) != nil {
+		// Ask the reorg workers to stop verifying, the job is cancelled by onVerifyIndex once they exit.
+		logutil.Logger(w.logCtx).Info("[ddl] run the cancelling DDL job", zap.String("job", job.String()))
+		d.notifyReorgCancel(job)
+		return w.onVerifyIndex(d, t, job)
+	}
+	// Nothing is changed by the job, it can be cancelled directly.
+	job.State = model.JobStateCancelled
+	return ver, dbterror.ErrCancelledDDLJob
+}
+
+func rollingbackUndropIndex(job *model.Job) (ver int64, err error) {
+	// The index is restored in one step, so it's still dropped if the job is cancelled.
+	job.State = model.JobStateCancelled
+	return ver, dbterror.ErrCancelledDDLJob
+}
+
+func rollingbackAddCheckConstraint(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	if needNotifyAndStopReorgWorker(job) {
+		// The validating workers are started, ask them to exit.
+		logutil.Logger(w.logCtx).Info("[ddl] run the cancelling DDL job", zap.String("job", job.String()))
+		d.notifyReorgCancel(job)
+		return w.onAddCheckConstraint(d, t, job)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	constraintInfo := &model.ConstraintInfo{}
+	if err = job.DecodeArgs(constraintInfo); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	existing := tblInfo.FindConstraintInfoByName(constraintInfo.Name.L)
+	if existing == nil || existing.State == model.StatePublic {
+		// The constraint isn't added by the job yet.
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrCancelledDDLJob
+	}
+	return convertAddCheckConstraintJob2RollbackJob(d, t, job, tblInfo, existing, dbterror.ErrCancelledDDLJob)
+}
+
 func convertJob2RollbackJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
 	switch job.Type {
 	case model.ActionAddColumn:
-		ver, err = rollingbackAddColumn(d, t, job)
+		ver, err = rollingbackAddColumn(w, d, t, job)
 	case model.ActionAddIndex:
 		ver, err = rollingbackAddIndex(w, d, t, job, false)
 	case model.ActionAddPrimaryKey:
 		ver, err = rollingbackAddIndex(w, d, t, job, true)
 	case model.ActionAddTablePartition:
 		ver, err = rollingbackAddTablePartition(d, t, job)
-	case model.ActionReorganizePartition:
-		ver, err = rollingbackReorganizePartition(d, t, job)
+	case model.ActionReorganizePartition, model.ActionAlterTablePartitioning:
+		ver, err = rollingbackReorganizePartition(w, d, t, job)
+	case model.ActionCreateTableSelect:
+		ver, err = rollingbackCreateTableSelect(w, d, t, job)
+	case model.ActionAddCheckConstraint:
+		ver, err = rollingbackAddCheckConstraint(w, d, t, job)
+	case model.ActionVerifyIndex:
+		ver, err = rollingbackVerifyIndex(w, d, t, job)
+	case model.ActionUndropIndex:
+		ver, err = rollingbackUndropIndex(job)
 	case model.ActionDropColumn:
 		ver, err = rollingbackDropColumn(d, t, job)
-	case model.ActionDropIndex, model.ActionDropPrimaryKey:
+	case model.ActionDropIndex, model.ActionDropPrimaryKey, model.ActionDeferredDropIndex:
 		ver, err = rollingbackDropIndex(d, t, job)
 	case model.ActionDropTable, model.ActionDropView, model.ActionDropSequence:
 		err = rollingbackDropTableOrView(t, job)
@@ -398,7 +492,7 @@ func convertJob2RollbackJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job)
 		ver, err = rollingbackTruncateTable(t, job)
 	case model.ActionModifyColumn:
 		ver, err = rollingbackModifyColumn(w, d, t, job)
-	case model.ActionDropForeignKey:
+	case model.ActionDropForeignKey, model.ActionDropCheckConstraint:
 		ver, err = cancelOnlyNotHandledJob(job, model.StatePublic)
 	case model.ActionRebaseAutoID, model.ActionShardRowID, model.ActionAddForeignKey,
 		model.ActionRenameTable, model.ActionRenameTables,
@@ -406,7 +500,7 @@ func convertJob2RollbackJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job)
 		model.ActionModifySchemaCharsetAndCollate, model.ActionRepairTable,
 		model.ActionModifyTableAutoIdCache, model.ActionAlterIndexVisibility,
 		model.ActionExchangeTablePartition, model.ActionModifySchemaDefaultPlacement,
-		model.ActionRecoverSchema:
+		model.ActionRecoverSchema, model.ActionRenameSchema:
 		ver, err = cancelOnlyNotHandledJob(job, model.StateNone)
 	case model.ActionMultiSchemaChange:
 		err = rollingBackMultiSchemaChange(job)
@@ -17,12 +17,16 @@ package ddl
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/ddl/label"
 	"github.com/pingcap/tidb/domain/infosync"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
 	"github.com/pingcap/tidb/parser/model"
 )
 
@@ -177,6 +181,170 @@ func onModifySchemaDefaultPlacement(d *ddlCtx, t *meta.Meta, job *model.Job) (ve
 	return ver, nil
 }
 
+func onRenameSchema(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var newName model.CIStr
+	if err := job.DecodeArgs(&newName); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	// Double check the new name isn't taken while ddl executing.
+	err = checkSchemaNotExistsFromStore(t, dbInfo.ID, &model.DBInfo{Name: newName})
+	if err != nil {
+		if infoschema.ErrDatabaseExists.Equal(err) {
+			job.State = model.JobStateCancelled
+		}
+		return ver, errors.Trace(err)
+	}
+
+	oldName := dbInfo.Name
+	tables, err := t.ListTables(dbInfo.ID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	type labelRules struct {
+		tableRuleID string
+		partRuleIDs []string
+		oldRuleIDs  []string
+		oldRules    map[string]*label.Rule
+	}
+	rules := make([]labelRules, 0, len(tables))
+	for _, tblInfo := range tables {
+		var r labelRules
+		r.tableRuleID, r.partRuleIDs, r.oldRuleIDs, r.oldRules, err = getOldLabelRules(tblInfo, oldName.L, tblInfo.Name.L)
+		if err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Wrapf(err, "failed to get old label rules from PD")
+		}
+		rules = append(rules, r)
+	}
+
+	dbInfo.Name = newName
+	if err = t.UpdateDatabase(dbInfo); err != nil {
+		return ver, errors.Trace(err)
+	}
+	// Rewrite the references to the old name in the views and the foreign keys of all the schemas. The tables of
+	// the renamed schema are reloaded anyway, the others are reloaded if they are rewritten.
+	affected, err := rewriteSchemaReferences(t, oldName, newName, dbInfo.ID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if ver, err = updateSchemaVersion(d, t, job, affected...); err != nil {
+		return ver, errors.Trace(err)
+	}
+	for i, tblInfo := range tables {
+		err = updateLabelRules(job, tblInfo, rules[i].oldRules, rules[i].tableRuleID, rules[i].partRuleIDs, rules[i].oldRuleIDs, tblInfo.ID)
+		if err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Wrapf(err, "failed to update the label rule to PD")
+		}
+	}
+	job.FinishDBJob(model.JobStateDone, model.StatePublic, ver, dbInfo)
+	return ver, nil
+}
+
+// rewriteSchemaReferences rewrites the schema name from oldName to newName in the definitions of the views and
+// the foreign keys, it returns the rewritten tables which are not in the renamed schema.
+func rewriteSchemaReferences(t *meta.Meta, oldName, newName model.CIStr, renamedID int64) ([]schemaIDAndTableInfo, error) {
+	dbs, err := t.ListDatabases()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var affected []schemaIDAndTableInfo
+	for _, db := range dbs {
+		tables, err := t.ListTables(db.ID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, tblInfo := range tables {
+			changed := false
+			for _, fk := range tblInfo.ForeignKeys {
+				if fk.RefSchema.L == oldName.L {
+					fk.RefSchema = newName
+					changed = true
+				}
+			}
+			if tblInfo.IsView() {
+				selectStmt, ok, err := rewriteViewSchemaName(tblInfo, oldName, newName)
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				if ok {
+					tblInfo.View.SelectStmt = selectStmt
+					changed = true
+				}
+			}
+			if !changed {
+				continue
+			}
+			if err = t.UpdateTable(db.ID, tblInfo); err != nil {
+				return nil, errors.Trace(err)
+			}
+			if db.ID != renamedID {
+				affected = append(affected, schemaIDAndTableInfo{schemaID: db.ID, tblInfo: tblInfo})
+			}
+		}
+	}
+	return affected, nil
+}
+
+func rewriteViewSchemaName(tblInfo *model.TableInfo, oldName, newName model.CIStr) (string, bool, error) {
+	stmt, err := parser.New().ParseOneStmt(tblInfo.View.SelectStmt, tblInfo.Charset, tblInfo.Collate)
+	if err != nil {
+		return "", false, errors.Trace(err)
+	}
+	rewriter := &schemaNameRewriter{oldName: oldName, newName: newName}
+	stmt.Accept(rewriter)
+	if !rewriter.changed {
+		return "", false, nil
+	}
+	selectStmt, err := restoreViewSelectStmt(stmt)
+	return selectStmt, err == nil, err
+}
+
+// restoreViewSelectStmt restores the rewritten SELECT statement of a view in the same way as BuildViewInfo.
+func restoreViewSelectStmt(stmt ast.Node) (string, error) {
+	restoreFlag := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreNameBackQuotes
+	var sb strings.Builder
+	if err := stmt.Restore(format.NewRestoreCtx(restoreFlag, &sb)); err != nil {
+		return "", errors.Trace(err)
+	}
+	return sb.String(), nil
+}
+
+// schemaNameRewriter rewrites the schema names of the tables and the columns from oldName to newName.
+type schemaNameRewriter struct {
+	oldName model.CIStr
+	newName model.CIStr
+	changed bool
+}
+
+// Enter implements ast.Visitor interface.
+func (r *schemaNameRewriter) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.TableName:
+		if x.Schema.L == r.oldName.L {
+			x.Schema = r.newName
+			r.changed = true
+		}
+	case *ast.ColumnName:
+		if x.Schema.L == r.oldName.L {
+			x.Schema = r.newName
+			r.changed = true
+		}
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (*schemaNameRewriter) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
 func onDropSchema(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
 	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
 	if err != nil {
@@ -359,7 +527,12 @@ func getIDs(tables []*model.TableInfo) []int64 {
 	for _, t := range tables {
 		ids = append(ids, t.ID)
 		if t.GetPartitionInfo() != nil {
-			ids = append(ids, getPartitionIDs(t)...)
+			for _, pid := range getPartitionIDs(t) {
+				// The only partition of a table being partitioned by ALTER TABLE ... PARTITION BY uses the table ID.
+				if pid != t.ID {
+					ids = append(ids, pid)
+				}
+			}
 		}
 	}
 
@@ -211,6 +211,22 @@ func (d Checker) DropSchema(ctx sessionctx.Context, stmt *ast.DropDatabaseStmt)
 	return nil
 }
 
+// RenameSchema implements the DDL interface.
+func (d Checker) RenameSchema(ctx sessionctx.Context, stmt *ast.RenameDatabaseStmt) error {
+	err := d.realDDL.RenameSchema(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	err = d.tracker.RenameSchema(ctx, stmt)
+	if err != nil {
+		panic(err)
+	}
+
+	d.checkDBInfo(ctx, stmt.OldName)
+	d.checkDBInfo(ctx, stmt.NewName)
+	return nil
+}
+
 // RecoverSchema implements the DDL interface.
 func (d Checker) RecoverSchema(ctx sessionctx.Context, recoverSchemaInfo *ddl.RecoverSchemaInfo) (err error) {
 	return nil
@@ -280,6 +296,16 @@ func (d Checker) FlashbackCluster(ctx sessionctx.Context, flashbackTS uint64) (e
 	panic("implement me")
 }
 
+// VerifyIndex implements the DDL interface.
+func (d Checker) VerifyIndex(ctx sessionctx.Context, tableIdent ast.Ident, indexName model.CIStr, rate string, repair bool) (*model.IndexVerifyInfo, error) {
+	return d.realDDL.VerifyIndex(ctx, tableIdent, indexName, rate, repair)
+}
+
+// UndropIndex implements the DDL interface.
+func (d Checker) UndropIndex(ctx sessionctx.Context, stmt *ast.UndropIndexStmt) error {
+	return d.realDDL.UndropIndex(ctx, stmt)
+}
+
 // DropView implements the DDL interface.
 func (d Checker) DropView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error) {
 	err = d.realDDL.DropView(ctx, stmt)
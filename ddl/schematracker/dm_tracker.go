@@ -174,6 +174,40 @@ func (d SchemaTracker) DropSchema(ctx sessionctx.Context, stmt *ast.DropDatabase
 	return nil
 }
 
+// RenameSchema implements the DDL interface.
+func (d SchemaTracker) RenameSchema(ctx sessionctx.Context, stmt *ast.RenameDatabaseStmt) error {
+	dbInfo := d.SchemaByName(stmt.OldName)
+	if dbInfo == nil {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(stmt.OldName)
+	}
+	if d.SchemaByName(stmt.NewName) != nil {
+		return infoschema.ErrDatabaseExists.GenWithStackByArgs(stmt.NewName)
+	}
+	tableNames, err := d.AllTableNamesOfSchema(stmt.OldName)
+	if err != nil {
+		return err
+	}
+	tables := make([]*model.TableInfo, 0, len(tableNames))
+	for _, name := range tableNames {
+		tbl, err := d.TableByName(stmt.OldName, model.NewCIStr(name))
+		if err != nil {
+			return err
+		}
+		tables = append(tables, tbl)
+	}
+
+	d.DeleteSchema(stmt.OldName)
+	dbInfo = dbInfo.Clone()
+	dbInfo.Name = stmt.NewName
+	d.PutSchema(dbInfo)
+	for _, tbl := range tables {
+		if err = d.PutTable(stmt.NewName, tbl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // CreateTable implements the DDL interface.
 func (d SchemaTracker) CreateTable(ctx sessionctx.Context, s *ast.CreateTableStmt) error {
 	ident := ast.Ident{Schema: s.Table.Schema, Name: s.Table.Name}
@@ -333,6 +367,16 @@ func (d SchemaTracker) RecoverSchema(ctx sessionctx.Context, recoverSchemaInfo *
 	return nil
 }
 
+// VerifyIndex implements the DDL interface, which is no-op in DM's case.
+func (d SchemaTracker) VerifyIndex(ctx sessionctx.Context, tableIdent ast.Ident, indexName model.CIStr, rate string, repair bool) (*model.IndexVerifyInfo, error) {
+	return &model.IndexVerifyInfo{}, nil
+}
+
+// UndropIndex implements the DDL interface, which is no-op in DM's case.
+func (d SchemaTracker) UndropIndex(ctx sessionctx.Context, stmt *ast.UndropIndexStmt) error {
+	return nil
+}
+
 // DropView implements the DDL interface.
 func (d SchemaTracker) DropView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error) {
 	notExistTables := make([]string, 0, len(stmt.Tables))
@@ -1030,6 +1074,8 @@ func (d SchemaTracker) AlterTable(ctx context.Context, sctx sessionctx.Context,
 			ast.AlterTableDropForeignKey,
 			ast.AlterTableCoalescePartitions,
 			ast.AlterTableReorganizePartition,
+			ast.AlterTableSplitPartition,
+			ast.AlterTableMergePartitions,
 			ast.AlterTableCheckPartitions,
 			ast.AlterTableRebuildPartition,
 			ast.AlterTableOptimizePartition,
@@ -1038,6 +1084,8 @@ func (d SchemaTracker) AlterTable(ctx context.Context, sctx sessionctx.Context,
 			ast.AlterTableTruncatePartition,
 			ast.AlterTableWriteable,
 			ast.AlterTableExchangePartition,
+			ast.AlterTableDetachPartition,
+			ast.AlterTableAttachPartition,
 			ast.AlterTablePartition,
 			ast.AlterTableSetTiFlashReplica,
 			ast.AlterTableOrderByColumns,
@@ -165,6 +165,13 @@ func TestFullTextIndex(t *testing.T) {
 	tracker := schematracker.NewSchemaTracker(2)
 	tracker.CreateTestDB()
 	execCreate(t, tracker, sql)
+
+	tblInfo := mustTableByName(t, tracker, "test", "t")
+	expected := "CREATE TABLE `t` (\n" +
+		"  `a` text DEFAULT NULL,\n" +
+		"  FULLTEXT KEY `a` (`a`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"
+	checkShowCreateTable(t, tblInfo, expected)
 }
 
 func checkShowCreateTable(t *testing.T, tblInfo *model.TableInfo, expected string) {
@@ -1085,6 +1085,15 @@ func TestAlterSequence(t *testing.T) {
 	tk.MustQuery("select nextval(seq)").Check(testkit.Rows("3005"))
 	tk.MustQuery("select nextval(seq)").Check(testkit.Rows("3009"))
 	tk.MustExec("drop sequence if exists seq")
+
+	// Restart will reset the cycle round, so the values are stepped from the start value rather than the min value.
+	tk.MustExec("create sequence seq start with 2 minvalue 1 maxvalue 10 increment by 3 nocache cycle")
+	tk.MustQuery("select nextval(seq), nextval(seq), nextval(seq), nextval(seq), nextval(seq)").Check(testkit.Rows("2 5 8 1 4"))
+	tk.MustExec("alter sequence seq restart")
+	tk.MustQuery("select nextval(seq), nextval(seq), nextval(seq), nextval(seq)").Check(testkit.Rows("2 5 8 1"))
+	tk.MustExec("alter sequence seq restart with 7")
+	tk.MustQuery("select nextval(seq), nextval(seq)").Check(testkit.Rows("8 1"))
+	tk.MustExec("drop sequence if exists seq")
 }
 
 func TestAlterSequencePrivilege(t *testing.T) {
@@ -1364,10 +1364,61 @@ func TestLocalTemporaryTableBlockedDDL(t *testing.T) {
 	require.ErrorIs(t, tk.ExecToErr("rename table tmp1 to tmp2"), dbterror.ErrUnsupportedLocalTempTableDDL)
 	require.ErrorIs(t, tk.ExecToErr("alter table tmp1 add column c int"), dbterror.ErrUnsupportedLocalTempTableDDL)
 	require.ErrorIs(t, tk.ExecToErr("alter table tmp1 add index b(b)"), dbterror.ErrUnsupportedLocalTempTableDDL)
-	require.ErrorIs(t, tk.ExecToErr("create index a on tmp1(b)"), dbterror.ErrUnsupportedLocalTempTableDDL)
-	require.ErrorIs(t, tk.ExecToErr("drop index a on tmp1"), dbterror.ErrUnsupportedLocalTempTableDDL)
 	require.ErrorIs(t, tk.ExecToErr("lock tables tmp1 read"), dbterror.ErrUnsupportedLocalTempTableDDL)
 	require.ErrorIs(t, tk.ExecToErr("lock tables tmp1 write"), dbterror.ErrUnsupportedLocalTempTableDDL)
 	require.ErrorIs(t, tk.ExecToErr("lock tables t1 read, tmp1 read"), dbterror.ErrUnsupportedLocalTempTableDDL)
 	require.ErrorIs(t, tk.ExecToErr("admin cleanup table lock tmp1"), dbterror.ErrUnsupportedLocalTempTableDDL)
 }
+
+func TestTemporaryTableCreateIndex(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	// The committed rows of the local temporary table are backfilled in the session.
+	tk.MustExec("create temporary table tmp1 (id int primary key, a int, b int)")
+	tk.MustExec("insert into tmp1 values (1, 10, 100), (2, 20, 200), (3, 20, 300), (4, null, 400)")
+	tk.MustExec("create index idx_a on tmp1(a)")
+	tk.MustExec("create index if not exists idx_a on tmp1(a)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1061 index already exist idx_a"))
+	tk.MustQuery("select id from tmp1 use index(idx_a) where a = 20 order by id").Check(testkit.Rows("2", "3"))
+	tk.MustQuery("select count(*) from tmp1 use index(idx_a)").Check(testkit.Rows("4"))
+	require.True(t, tk.HasPlan("select id from tmp1 where a = 20", "IndexReader"))
+	tk.MustExec("insert into tmp1 values (5, 20, 500)")
+	tk.MustQuery("select id from tmp1 use index(idx_a) where a = 20 order by id").Check(testkit.Rows("2", "3", "5"))
+
+	// The unique index can't be created on the duplicated rows, and the table is kept unchanged.
+	err := tk.ExecToErr("create unique index uk_a on tmp1(a)")
+	require.True(t, kv.ErrKeyExists.Equal(err), "%v", err)
+	require.ErrorContains(t, err, "Duplicate entry '20' for key 'tmp1.uk_a'")
+	tk.MustGetErrCode("select * from tmp1 use index(uk_a)", errno.ErrKeyDoesNotExist)
+	tk.MustExec("create unique index uk_b on tmp1(b)")
+	tk.MustGetErrCode("insert into tmp1 values (6, 60, 100)", errno.ErrDupEntry)
+	tk.MustQuery("select b from tmp1 use index(uk_b) where b >= 300 order by b").Check(testkit.Rows("300", "400", "500"))
+
+	// The rows written in the transaction are committed before the index is created.
+	tk.MustExec("begin")
+	tk.MustExec("insert into tmp1 values (6, 30, 600)")
+	tk.MustExec("create index idx_ab on tmp1(a, b)")
+	tk.MustQuery("select id from tmp1 use index(idx_ab) where a = 30").Check(testkit.Rows("6"))
+
+	tk.MustExec("drop index idx_a on tmp1")
+	tk.MustExec("drop index if exists idx_a on tmp1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1091 index idx_a doesn't exist"))
+	tk.MustGetErrCode("drop index idx_a on tmp1", errno.ErrCantDropFieldOrKey)
+	tk.MustGetErrCode("select * from tmp1 use index(idx_a)", errno.ErrKeyDoesNotExist)
+	tk.MustQuery("select id from tmp1 where a = 20 order by id").Check(testkit.Rows("2", "3", "5"))
+	tk.MustGetErrCode("create fulltext index idx_f on tmp1(a)", errno.ErrOptOnTemporaryTable)
+	tk.MustGetErrCode("create index idx_e on tmp1((a + 1))", errno.ErrOptOnTemporaryTable)
+
+	// The index of the global temporary table is created without backfill.
+	tk.MustExec("create global temporary table tmp2 (id int primary key, a int) on commit delete rows")
+	tk.MustExec("create unique index uk_a on tmp2(a)")
+	tk.MustExec("begin")
+	tk.MustExec("insert into tmp2 values (1, 10)")
+	tk.MustGetErrCode("insert into tmp2 values (2, 10)", errno.ErrDupEntry)
+	tk.MustQuery("select id from tmp2 use index(uk_a) where a = 10").Check(testkit.Rows("1"))
+	tk.MustExec("commit")
+	tk.MustExec("drop index uk_a on tmp2")
+	tk.MustExec("drop table tmp1, tmp2")
+}
@@ -0,0 +1,427 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/distsql"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/statistics"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/generatedexpr"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tipb/go-tipb"
+	"go.uber.org/zap"
+)
+
+func init() {
+	registerBackfiller(typeAddStoredColumnWorker, "add stored column", newAddStoredColumnBackfiller)
+}
+
+// addStoredColumnWorker fills the stored generated column being added. The rows are read by the coprocessor, and
+// the generation expression is evaluated by the coprocessor too if it can be pushed down, so the values are
+// computed close to the data. Otherwise, the expression is evaluated on the rows read back.
+type addStoredColumnWorker struct {
+	*backfillCtx
+	tblInfo *model.TableInfo
+	pkInfo  *model.IndexInfo
+	col     *table.Column
+	// cols are the other stored columns of the rows, they're read and written back with the generated value.
+	cols []*table.Column
+	// colInfos are the scanned columns, the extra handle column is appended if the table has no clustered index.
+	colInfos      []*model.ColumnInfo
+	expCols       []*expression.Column
+	handleOffsets []int
+	// expr is the generation expression, it's resolved on the scanned columns.
+	expr     expression.Expression
+	pushDown bool
+
+	virtualColOffsets  []int
+	virtualColFieldTps []*types.FieldType
+}
+
+func newAddStoredColumnBackfiller(b *backfillScheduler, sessCtx sessionctx.Context, id int) (backfiller, error) {
+	reorgInfo := b.reorgInfo
+	tblInfo := b.tbl.Meta()
+	var col *table.Column
+	cols := make([]*table.Column, 0, len(b.tbl.WritableCols()))
+	colInfos := make([]*model.ColumnInfo, 0, len(b.tbl.WritableCols())+1)
+	for _, c := range b.tbl.WritableCols() {
+		if c.ID == reorgInfo.currElement.ID {
+			col = c
+			continue
+		}
+		cols = append(cols, c)
+		colInfos = append(colInfos, c.ColumnInfo)
+	}
+	if col == nil {
+		return nil, dbterror.ErrCancelledDDLJob.GenWithStack("column %d of table %s is not found",
+			reorgInfo.currElement.ID, tblInfo.Name)
+	}
+
+	var (
+		pkInfo    *model.IndexInfo
+		handleIDs []int64
+	)
+	switch {
+	case tblInfo.PKIsHandle:
+		handleIDs = []int64{tblInfo.GetPkColInfo().ID}
+	case tblInfo.IsCommonHandle:
+		pkInfo = tables.FindPrimaryIndex(tblInfo)
+		for _, idxCol := range pkInfo.Columns {
+			handleIDs = append(handleIDs, tblInfo.Columns[idxCol.Offset].ID)
+		}
+	default:
+		extra := model.NewExtraHandleColInfo()
+		colInfos = append(colInfos, extra)
+		handleIDs = []int64{extra.ID}
+	}
+	expCols, names, err := expression.ColumnInfos2ColumnsAndNames(sessCtx, model.CIStr{} /* unused */, tblInfo.Name, colInfos, tblInfo)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	expr, err := buildStoredColumnExpr(sessCtx, tblInfo, col.ColumnInfo, expression.NewSchema(expCols...), names)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	vColOffsets, vColFts := collectVirtualColumnOffsetsAndTypes(expCols)
+
+	w := &addStoredColumnWorker{
+		backfillCtx:        newBackfillCtx(reorgInfo.d, id, sessCtx, reorgInfo.SchemaName, b.tbl, b.jobCtx, "add_stored_col_rate", false),
+		tblInfo:            tblInfo,
+		pkInfo:             pkInfo,
+		col:                col,
+		cols:               cols,
+		colInfos:           colInfos,
+		expCols:            expCols,
+		handleOffsets:      resolveIndicesForHandle(expCols, handleIDs),
+		expr:               expr,
+		virtualColOffsets:  vColOffsets,
+		virtualColFieldTps: vColFts,
+	}
+	w.pushDown = w.canPushDown()
+	logutil.BgLogger().Info("[ddl] add stored column worker", zap.Stringer("worker", w),
+		zap.String("column", col.Name.O), zap.Bool("push down", w.pushDown))
+	return w, nil
+}
+
+// buildStoredColumnExpr builds the generation expression of the column on the schema.
+func buildStoredColumnExpr(sctx sessionctx.Context, tblInfo *model.TableInfo, colInfo *model.ColumnInfo,
+	schema *expression.Schema, names types.NameSlice) (expression.Expression, error) {
+	node, err := generatedexpr.ParseExpression(colInfo.GeneratedExprString)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	node, err = generatedexpr.SimpleResolveName(node, tblInfo)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	expr, err := expression.RewriteAstExpr(sctx, node, schema, names, true)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return expr.ResolveIndices(schema)
+}
+
+// canPushDown checks whether the generated value can be projected by the coprocessor along with the scanned
+// columns. The virtual generated columns aren't stored, so the expression referring to them is evaluated locally.
+func (w *addStoredColumnWorker) canPushDown() bool {
+	for _, col := range expression.ExtractColumns(w.expr) {
+		if w.expCols[col.Index].VirtualExpr != nil {
+			return false
+		}
+	}
+	return expression.CanExprsPushDown(w.sessCtx.GetSessionVars().StmtCtx, w.projectionExprs(), w.sessCtx.GetClient(), kv.TiKV)
+}
+
+// projectionExprs returns the expressions projected by the coprocessor, the generated value follows the scanned
+// columns.
+func (w *addStoredColumnWorker) projectionExprs() []expression.Expression {
+	exprs := make([]expression.Expression, 0, len(w.expCols)+1)
+	for i, col := range w.expCols {
+		exprs = append(exprs, &expression.Column{RetType: col.RetType, ID: col.ID, UniqueID: col.UniqueID, Index: i})
+	}
+	return append(exprs, w.expr)
+}
+
+func (w *addStoredColumnWorker) AddMetricInfo(cnt float64) {
+	w.metricCounter.Add(cnt)
+}
+
+func (*addStoredColumnWorker) String() string {
+	return typeAddStoredColumnWorker.String()
+}
+
+func (*addStoredColumnWorker) GetTasks() ([]*BackfillJob, error) {
+	panic("[ddl] add stored column worker GetTask function doesn't implement")
+}
+
+func (*addStoredColumnWorker) UpdateTask(*BackfillJob) error {
+	panic("[ddl] add stored column worker UpdateTask function doesn't implement")
+}
+
+func (*addStoredColumnWorker) FinishTask(*BackfillJob) error {
+	panic("[ddl] add stored column worker FinishTask function doesn't implement")
+}
+
+func (w *addStoredColumnWorker) GetCtx() *backfillCtx {
+	return w.backfillCtx
+}
+
+// buildDAGPB builds the request reading a batch of rows, the generated value is projected if it's pushed down.
+func (w *addStoredColumnWorker) buildDAGPB(limit int) (*tipb.DAGRequest, error) {
+	dagReq, err := buildDAGPB(w.sessCtx, w.tblInfo, w.colInfos)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	dagReq.Executors = append(dagReq.Executors, constructLimitPB(uint64(limit)))
+	if !w.pushDown {
+		return dagReq, nil
+	}
+	exprs, err := expression.ExpressionsToPBList(w.sessCtx.GetSessionVars().StmtCtx, w.projectionExprs(), w.sessCtx.GetClient())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	dagReq.Executors = append(dagReq.Executors, &tipb.Executor{Tp: tipb.ExecType_TypeProjection, Projection: &tipb.Projection{Exprs: exprs}})
+	dagReq.OutputOffsets = append(dagReq.OutputOffsets, uint32(len(w.expCols)))
+	return dagReq, nil
+}
+
+func (w *addStoredColumnWorker) buildTableScan(ctx context.Context, startTS uint64, handleRange reorgBackfillTask) (distsql.SelectResult, error) {
+	dagPB, err := w.buildDAGPB(w.batchCnt)
+	if err != nil {
+		return nil, err
+	}
+	var builder distsql.RequestBuilder
+	kvReq, err := builder.
+		SetDAGRequest(dagPB).
+		SetStartTS(startTS).
+		SetKeyRanges([]kv.KeyRange{{StartKey: handleRange.startKey, EndKey: handleRange.excludedEndKey()}}).
+		SetKeepOrder(true).
+		SetFromSessionVars(w.sessCtx.GetSessionVars()).
+		SetFromInfoSchema(w.sessCtx.GetDomainInfoSchema()).
+		SetConcurrency(1).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	kvReq.Priority = handleRange.priority
+	kvReq.NotFillCache = true
+	kvReq.RequestSource.RequestSourceInternal = true
+	kvReq.RequestSource.RequestSourceType = w.jobContext.ddlJobSourceType()
+	return distsql.Select(ctx, w.sessCtx, kvReq, w.fieldTypes(), statistics.NewQueryFeedback(0, nil, 0, false))
+}
+
+// fieldTypes returns the types of the rows read by the coprocessor.
+func (w *addStoredColumnWorker) fieldTypes() []*types.FieldType {
+	fieldTps := make([]*types.FieldType, 0, len(w.expCols)+1)
+	for _, col := range w.expCols {
+		fieldTps = append(fieldTps, col.RetType)
+	}
+	if w.pushDown {
+		fieldTps = append(fieldTps, w.expr.GetType())
+	}
+	return fieldTps
+}
+
+// encodeRow encodes the scanned row with the generated value.
+func (w *addStoredColumnWorker) encodeRow(row chunk.Row) (kv.Handle, []byte, error) {
+	sc := w.sessCtx.GetSessionVars().StmtCtx
+	handle, err := buildHandle(extractDatumByOffsets(row, w.handleOffsets, w.expCols, nil), w.tblInfo, w.pkInfo, sc)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	var val types.Datum
+	if w.pushDown {
+		val = row.GetDatum(len(w.expCols), w.expr.GetType())
+	} else if val, err = w.expr.Eval(row); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	val, err = table.CastValue(w.sessCtx, val, w.col.ColumnInfo, false, false)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if err = w.col.CheckNotNull(&val, 0); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	colIDs := make([]int64, 0, len(w.cols)+1)
+	vals := make([]types.Datum, 0, len(w.cols)+1)
+	for i, col := range w.cols {
+		d := row.GetDatum(i, &col.FieldType)
+		if !tables.CanSkip(w.tblInfo, col, &d) {
+			colIDs = append(colIDs, col.ID)
+			vals = append(vals, tables.CompressColumnValue(w.sessCtx, col, d))
+		}
+	}
+	if !tables.CanSkip(w.tblInfo, w.col, &val) {
+		colIDs = append(colIDs, w.col.ID)
+		vals = append(vals, tables.CompressColumnValue(w.sessCtx, w.col, val))
+	}
+	rowVal, err := tablecodec.EncodeRow(sc, vals, colIDs, nil, nil, &w.sessCtx.GetSessionVars().RowEncoder)
+	return handle, rowVal, errors.Trace(err)
+}
+
+// BackfillData fills the column of a batch of rows in a transaction. The rows are read at the start ts of the
+// transaction, so the concurrent writes of the rows conflict with it.
+func (w *addStoredColumnWorker) BackfillData(handleRange reorgBackfillTask) (taskCtx backfillTaskContext, errInTxn error) {
+	oprStartTime := time.Now()
+	ctx := kv.WithInternalSourceType(context.Background(), w.jobContext.ddlJobSourceType())
+	errInTxn = kv.RunInNewTxn(ctx, w.sessCtx.GetStore(), true, func(ctx context.Context, txn kv.Transaction) error {
+		taskCtx.addedCount = 0
+		taskCtx.scanCount = 0
+		txn.SetOption(kv.Priority, handleRange.priority)
+		if tagger := w.GetCtx().getResourceGroupTaggerForTopSQL(handleRange.getJobID()); tagger != nil {
+			txn.SetOption(kv.ResourceGroupTagger, tagger)
+		}
+
+		result, err := w.buildTableScan(ctx, txn.StartTS(), handleRange)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer terror.Call(result.Close)
+		batchCnt := w.batchCnt
+		chk := chunk.NewChunkWithCapacity(w.fieldTypes(), batchCnt)
+		var lastKey kv.Key
+		for taskCtx.scanCount < batchCnt {
+			if err := result.Next(ctx, chk); err != nil {
+				return errors.Trace(err)
+			}
+			if chk.NumRows() == 0 {
+				break
+			}
+			w.consumeBatchMemory(chk.MemoryUsage())
+			if !w.pushDown {
+				err = table.FillVirtualColumnValue(w.virtualColFieldTps, w.virtualColOffsets, w.expCols, w.colInfos, w.sessCtx, chk)
+				if err != nil {
+					return errors.Trace(err)
+				}
+			}
+			for i := 0; i < chk.NumRows() && taskCtx.scanCount < batchCnt; i++ {
+				taskCtx.scanCount++
+				handle, rowVal, err := w.encodeRow(chk.GetRow(i))
+				if err != nil {
+					return errors.Trace(err)
+				}
+				lastKey = handleRange.physicalTable.RecordKey(handle)
+				if err := txn.Set(lastKey, rowVal); err != nil {
+					return errors.Trace(err)
+				}
+				taskCtx.addedCount++
+			}
+		}
+		w.shrinkBatchIfMemoryExceeded()
+		taskCtx.done = taskCtx.scanCount < batchCnt
+		taskCtx.nextKey = getNextHandleKey(handleRange, taskCtx.done, lastKey)
+		taskCtx.addedBytes = txn.Size()
+		return nil
+	})
+	logSlowOperations(time.Since(oprStartTime), "AddStoredColumnBackfillData", 3000)
+	return
+}
+
+// isStoredGeneratedColumn checks whether the column is a stored generated column, which needs to be filled when
+// it's added.
+func isStoredGeneratedColumn(colInfo *model.ColumnInfo) bool {
+	return colInfo.IsGenerated() && colInfo.GeneratedStored
+}
+
+func (w *worker) runAddStoredColumnReorg(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo,
+	colInfo *model.ColumnInfo) (done bool, ver int64, err error) {
+	tbl, err := getTable(d.store, job.SchemaID, tblInfo)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	sctx, err := w.sessPool.get()
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	defer w.sessPool.put(sctx)
+	rh := newReorgHandler(newSession(sctx))
+	dbInfo, err := t.GetDatabase(job.SchemaID)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	elements := []*meta.Element{{ID: colInfo.ID, TypeKey: meta.ColumnElementKey}}
+	reorgInfo, err := getReorgInfo(d.jobContext(job.ID), d, rh, job, dbInfo, tbl, elements, false)
+	if err != nil || reorgInfo == nil || reorgInfo.first {
+		return false, ver, errors.Trace(err)
+	}
+	err = w.runReorgJob(rh, reorgInfo, tbl.Meta(), d.lease, func() (addErr error) {
+		defer util.Recover(metrics.LabelDDL, "onAddColumn",
+			func() {
+				addErr = dbterror.ErrCancelledDDLJob.GenWithStack("add table `%v` column `%v` panic", tbl.Meta().Name, colInfo.Name)
+			}, false)
+		return w.addTableStoredColumn(tbl, reorgInfo)
+	})
+	if err != nil {
+		if dbterror.ErrWaitReorgTimeout.Equal(err) {
+			// If timeout, we should return, check for the owner and re-wait job done.
+			return false, ver, nil
+		}
+		if kv.IsTxnRetryableError(err) || dbterror.ErrNotOwner.Equal(err) {
+			return false, ver, errors.Trace(err)
+		}
+		logutil.BgLogger().Warn("[ddl] run add stored column job failed, convert job to rollback", zap.String("job", job.String()), zap.Error(err))
+		ver, err = convertAddColumnJob2RollbackJob(d, t, job, tblInfo, colInfo, err)
+		if err1 := rh.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
+			logutil.BgLogger().Warn("[ddl] run add stored column job failed, convert job to rollback, RemoveDDLReorgHandle failed", zap.String("job", job.String()), zap.Error(err1))
+		}
+		return false, ver, errors.Trace(err)
+	}
+	return true, ver, nil
+}
+
+// addTableStoredColumn fills the column of a table, the partitions are filled one by one.
+func (w *worker) addTableStoredColumn(t table.Table, reorgInfo *reorgInfo) error {
+	tbl, ok := t.(table.PartitionedTable)
+	if !ok {
+		//nolint:forcetypeassert
+		return w.addPhysicalTableStoredColumn(t.(table.PhysicalTable), reorgInfo)
+	}
+	for {
+		p := tbl.GetPartition(reorgInfo.PhysicalTableID)
+		if p == nil {
+			return dbterror.ErrCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", reorgInfo.PhysicalTableID, t.Meta().ID)
+		}
+		if err := w.addPhysicalTableStoredColumn(p, reorgInfo); err != nil {
+			return errors.Trace(err)
+		}
+		finish, err := updateReorgInfo(w.sessPool, tbl, reorgInfo)
+		if err != nil || finish {
+			return errors.Trace(err)
+		}
+	}
+}
+
+func (w *worker) addPhysicalTableStoredColumn(t table.PhysicalTable, reorgInfo *reorgInfo) error {
+	logutil.BgLogger().Info("[ddl] start to add table stored column", zap.String("job", reorgInfo.Job.String()), zap.String("reorgInfo", reorgInfo.String()))
+	return w.writePhysicalTableRecord(w.sessPool, t, typeAddStoredColumnWorker, reorgInfo)
+}
@@ -1235,7 +1235,8 @@ func onModifyTableCharsetAndCollate(d *ddlCtx, t *meta.Meta, job *model.Job) (ve
 
 func (w *worker) onSetTableFlashReplica(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
 	var replicaInfo ast.TiFlashReplicaSpec
-	if err := job.DecodeArgs(&replicaInfo); err != nil {
+	var trackProgress bool
+	if err := job.DecodeArgs(&replicaInfo, &trackProgress); err != nil {
 		job.State = model.JobStateCancelled
 		return ver, errors.Trace(err)
 	}
@@ -1250,13 +1251,27 @@ func (w *worker) onSetTableFlashReplica(d *ddlCtx, t *meta.Meta, job *model.Job)
 		return ver, errors.Trace(dbterror.ErrUnsupportedTiFlashOperationForSysOrMemTable)
 	}
 
+	if job.SchemaState == model.StateWriteReorganization {
+		return w.onTrackTiFlashReplica(d, t, job, tblInfo)
+	}
+
 	err = w.checkTiFlashReplicaCount(replicaInfo.Count)
 	if err != nil {
 		job.State = model.JobStateCancelled
 		return ver, errors.Trace(err)
 	}
+	if err = checkTiFlashReplicaRemoval(tblInfo, &replicaInfo); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
 	// We should check this first, in order to avoid creating redundant DDL jobs.
-	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+	if trackProgress {
+		// The placement rules are set by onTrackTiFlashReplica, which throttles the partitions being replicated.
+		if e := initTiFlashReplicaProgress(job, tblInfo, &replicaInfo); e != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(e)
+		}
+	} else if pi := tblInfo.GetPartitionInfo(); pi != nil {
 		logutil.BgLogger().Info("Set TiFlash replica pd rule for partitioned table", zap.Int64("tableID", tblInfo.ID))
 		if e := infosync.ConfigureTiFlashPDForPartitions(false, &pi.Definitions, replicaInfo.Count, &replicaInfo.Labels, tblInfo.ID); e != nil {
 			job.State = model.JobStateCancelled
@@ -1285,6 +1300,9 @@ func (w *worker) onSetTableFlashReplica(d *ddlCtx, t *meta.Meta, job *model.Job)
 			LocationLabels: replicaInfo.Labels,
 			Available:      available,
 		}
+		if trackProgress {
+			tblInfo.TiFlashReplica.SyncJobID = job.ID
+		}
 	} else {
 		if tblInfo.TiFlashReplica != nil {
 			err = infosync.DeleteTiFlashTableSyncProgress(tblInfo)
@@ -1299,6 +1317,11 @@ func (w *worker) onSetTableFlashReplica(d *ddlCtx, t *meta.Meta, job *model.Job)
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
+	if trackProgress {
+		// The replicas are created and tracked in the next rounds.
+		job.SchemaState = model.StateWriteReorganization
+		return ver, nil
+	}
 	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
 	return ver, nil
 }
@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -1351,3 +1352,130 @@ func TestTiFlashAvailableAfterDownOneStore(t *testing.T) {
 	time.Sleep(ddl.PollTiFlashInterval * RoundToBeAvailable * 3)
 	CheckTableAvailable(s.dom, t, 1, []string{})
 }
+
+func TestTiFlashReplicaProgressTrackedByJob(t *testing.T) {
+	s, teardown := createTiFlashContext(t)
+	defer teardown()
+	tk := testkit.NewTestKit(t, s.store)
+
+	tk.MustExec("use test")
+	tk.MustExec("set @@global.tidb_ddl_enable_tiflash_replica_progress = 1")
+	defer tk.MustExec("set @@global.tidb_ddl_enable_tiflash_replica_progress = default")
+	tk.MustExec("set @@global.tidb_ddl_tiflash_replica_concurrency = 1")
+	defer tk.MustExec("set @@global.tidb_ddl_tiflash_replica_concurrency = default")
+	tk.MustExec("create table ddltiflash(z int) PARTITION BY RANGE(z) (PARTITION p0 VALUES LESS THAN (10),PARTITION p1 VALUES LESS THAN (20), PARTITION p2 VALUES LESS THAN (30))")
+	tb, err := s.dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("ddltiflash"))
+	require.NoError(t, err)
+	pi := tb.Meta().GetPartitionInfo()
+
+	s.tiflash.Lock()
+	s.tiflash.NotAvailable = true
+	s.tiflash.Unlock()
+	done := make(chan error, 1)
+	go func() {
+		tk1 := testkit.NewTestKit(t, s.store)
+		done <- tk1.ExecToErr("alter table test.ddltiflash set tiflash replica 1")
+	}()
+
+	// Only one partition is being replicated, the others are throttled.
+	require.Eventually(t, func() bool {
+		states := make(map[string]int)
+		for _, row := range tk.MustQuery("admin show ddl jobs 1").Rows() {
+			if strings.Contains(row[3].(string), "partition `p") {
+				states[row[11].(string)]++
+			}
+		}
+		return states["running"] == 1 && states["queueing"] == 2
+	}, 10*time.Second, 100*time.Millisecond)
+	_, ok := s.tiflash.GetTableSyncStatus(int(pi.Definitions[0].ID))
+	require.True(t, ok)
+	for _, p := range pi.Definitions[1:] {
+		_, ok = s.tiflash.GetTableSyncStatus(int(p.ID))
+		require.False(t, ok)
+	}
+	// The replica status isn't polled separately while the job tracks it.
+	tb, err = s.dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("ddltiflash"))
+	require.NoError(t, err)
+	require.NotZero(t, tb.Meta().TiFlashReplica.SyncJobID)
+	require.False(t, tb.Meta().TiFlashReplica.Available)
+
+	s.tiflash.Lock()
+	s.tiflash.NotAvailable = false
+	s.tiflash.Unlock()
+	select {
+	case err = <-done:
+		require.NoError(t, err)
+	case <-time.After(30 * time.Second):
+		require.FailNow(t, "the job doesn't finish after the replicas are available")
+	}
+	tb, err = s.dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("ddltiflash"))
+	require.NoError(t, err)
+	replica := tb.Meta().TiFlashReplica
+	require.True(t, replica.Available)
+	require.Zero(t, replica.SyncJobID)
+	for _, p := range pi.Definitions {
+		require.True(t, replica.IsPartitionAvailable(p.ID))
+	}
+}
+
+func TestColumnarIndex(t *testing.T) {
+	s, teardown := createTiFlashContext(t)
+	defer teardown()
+	tk := testkit.NewTestKit(t, s.store)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists ddltiflash")
+	tk.MustContainErrMsg("create table ddltiflash(a int primary key, b int, c varchar(20), d int, key cidx(b, c) using columnar)",
+		"COLUMNAR index needs the TiFlash replicas of table ddltiflash")
+	tk.MustExec("create table ddltiflash(a int primary key, b int, c varchar(20), d int, key idx_bc(b, c), key idx_d(d))")
+	tk.MustContainErrMsg("alter table ddltiflash add index cidx(b, c) using columnar", "COLUMNAR index needs the TiFlash replicas of table ddltiflash")
+	tk.MustExec("alter table ddltiflash set tiflash replica 1")
+	time.Sleep(ddl.PollTiFlashInterval * RoundToBeAvailable)
+	CheckTableAvailable(s.dom, t, 1, []string{})
+
+	tk.MustContainErrMsg("alter table ddltiflash add unique index cidx(b, c) using columnar", "PRIMARY or UNIQUE index with USING COLUMNAR is not supported")
+	tk.MustContainErrMsg("alter table ddltiflash add index cidx(b, c(10)) using columnar", "Incorrect prefix key")
+	tk.MustContainErrMsg("alter table ddltiflash add index cidx((b + 1)) using columnar", "COLUMNAR index on expressions is not supported")
+	tk.MustContainErrMsg("alter table ddltiflash add index cidx(b, c) using columnar, add column e int", "Unsupported multi schema change for add COLUMNAR index")
+	tk.MustContainErrMsg("alter table ddltiflash add index cidx(b, c) using columnar, algorithm = instant", "Cannot add COLUMNAR index instantly")
+
+	// The index is populated by the TiFlash replicas from the snapshot recorded by the job.
+	tk.MustExec("insert into ddltiflash values (1, 1, 'a', 1), (2, 2, 'b', 2)")
+	tk.MustExec("alter table ddltiflash add index cidx(b, c) using columnar")
+	tb, err := s.dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("ddltiflash"))
+	require.NoError(t, err)
+	idx := tb.Meta().FindIndexByName("cidx")
+	require.Equal(t, model.IndexTypeColumnar, idx.Tp)
+	require.Equal(t, model.StatePublic, idx.State)
+	require.NotZero(t, idx.ColumnarSnapshotTS)
+	// The columnar index has no entries written by the transactions.
+	for _, index := range tb.Indices() {
+		require.NotEqual(t, idx.ID, index.Meta().ID)
+	}
+	tk.MustQuery("show create table ddltiflash").Check(testkit.Rows("ddltiflash CREATE TABLE `ddltiflash` (\n" +
+		"  `a` int(11) NOT NULL,\n" +
+		"  `b` int(11) DEFAULT NULL,\n" +
+		"  `c` varchar(20) DEFAULT NULL,\n" +
+		"  `d` int(11) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`a`) /*T![clustered_index] CLUSTERED */,\n" +
+		"  KEY `idx_bc` (`b`,`c`),\n" +
+		"  KEY `idx_d` (`d`),\n" +
+		"  KEY `cidx` (`b`,`c`) USING COLUMNAR\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustExec("update ddltiflash set c = 'c' where a = 2")
+	tk.MustExec("admin check table ddltiflash")
+
+	// The reads covered by the columnar index are routed to TiFlash even if the TiKV indexes are cheaper.
+	tk.MustExec("set @@session.tidb_isolation_read_engines = 'tikv,tiflash'")
+	require.True(t, tk.HasTiFlashPlan("select b, c from ddltiflash where b = 1"))
+	require.True(t, tk.HasTiFlashPlan("select a, c from ddltiflash where c = 'c'"))
+	require.False(t, tk.HasTiFlashPlan("select d from ddltiflash where d = 1"))
+	require.False(t, tk.HasTiFlashPlan("select /*+ read_from_storage(tikv[ddltiflash]) */ b, c from ddltiflash where b = 1"))
+	require.False(t, tk.HasTiFlashPlan("select b, c from ddltiflash where b = 1 for update"))
+	tk.MustExec("alter table ddltiflash alter index cidx invisible")
+	require.False(t, tk.HasTiFlashPlan("select b, c from ddltiflash where b = 1"))
+
+	tk.MustContainErrMsg("alter table ddltiflash set tiflash replica 0", "drop the indexes at first")
+	tk.MustExec("drop index cidx on ddltiflash")
+	tk.MustExec("alter table ddltiflash set tiflash replica 0")
+}
@@ -22,6 +22,7 @@ import (
 
 // Event is an event that a ddl operation happened.
 type Event struct {
+	SchemaName  model.CIStr
 	TableInfo   *model.TableInfo
 	PartInfo    *model.PartitionInfo
 	IndexInfo   *model.IndexInfo
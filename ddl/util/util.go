@@ -44,6 +44,8 @@ const (
 	completeDeleteMultiRangesSQL = `DELETE FROM mysql.gc_delete_range WHERE job_id = %?`
 	updateDeleteRangeSQL         = `UPDATE mysql.gc_delete_range SET start_key = %? WHERE job_id = %? AND element_id = %? AND start_key = %?`
 	deleteDoneRecordSQL          = `DELETE FROM mysql.gc_delete_range_done WHERE job_id = %? AND element_id = %?`
+	countDeleteRangeSQL          = `SELECT HIGH_PRIORITY COUNT(IF(ts >= %?, 1, NULL)), COUNT(IF(ts < %?, 1, NULL)) FROM mysql.gc_delete_range`
+	countDoneDeleteRangeSQL      = `SELECT HIGH_PRIORITY COUNT(*) FROM mysql.gc_delete_range_done`
 	loadGlobalVars               = `SELECT HIGH_PRIORITY variable_name, variable_value from mysql.global_variables where variable_name in (` // + nameList + ")"
 	// KeyOpDefaultTimeout is the default timeout for each key operation.
 	KeyOpDefaultTimeout = 2 * time.Second
@@ -59,6 +61,17 @@ const (
 	SessionTTL = 90
 )
 
+// The states of the delete range tasks.
+const (
+	// DeleteRangeStateWaitingSafePoint means the range can't be deleted until the GC safe point passes its ts.
+	DeleteRangeStateWaitingSafePoint = "waiting_safe_point"
+	// DeleteRangeStatePending means the range is ready to be deleted by the next GC.
+	DeleteRangeStatePending = "pending"
+	// DeleteRangeStateDone means the range has been deleted, and it's kept in gc_delete_range_done to be deleted
+	// again later in case some data is written back by the lagging transactions.
+	DeleteRangeStateDone = "done"
+)
+
 // DelRangeTask is for run delete-range command in gc_worker.
 type DelRangeTask struct {
 	StartKey  kv.Key
@@ -122,6 +135,24 @@ func loadDeleteRangesFromTable(ctx context.Context, sctx sessionctx.Context, tab
 	return ranges, nil
 }
 
+// CountDeleteRanges counts the delete range tasks in gc_delete_range and gc_delete_range_done by their states.
+func CountDeleteRanges(ctx context.Context, sctx sessionctx.Context, safePoint uint64) (map[string]int64, error) {
+	exec := sctx.(sqlexec.RestrictedSQLExecutor)
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, countDeleteRangeSQL, safePoint, safePoint)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	doneRows, _, err := exec.ExecRestrictedSQL(ctx, nil, countDoneDeleteRangeSQL)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return map[string]int64{
+		DeleteRangeStateWaitingSafePoint: rows[0].GetInt64(0),
+		DeleteRangeStatePending:          rows[0].GetInt64(1),
+		DeleteRangeStateDone:             doneRows[0].GetInt64(0),
+	}, nil
+}
+
 // CompleteDeleteRange moves a record from gc_delete_range table to gc_delete_range_done table.
 func CompleteDeleteRange(sctx sessionctx.Context, dr DelRangeTask) error {
 	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
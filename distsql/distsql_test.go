@@ -73,6 +73,26 @@ func TestSelectMemTracker(t *testing.T) {
 	require.Equal(t, int64(0), response.memTracker.BytesConsumed())
 }
 
+func TestSelectZeroCopyMemTracker(t *testing.T) {
+	response, colTypes := createSelectNormal(t, 200, 400, nil, nil)
+
+	chk1, chk2 := chunk.New(colTypes, 32, 32), chunk.New(colTypes, 32, 32)
+	require.NoError(t, response.Next(context.TODO(), chk1))
+	// The chunk is built over the whole response chunk without copying.
+	require.Equal(t, 200, chk1.NumRows())
+	respSize := response.memTracker.BytesConsumed()
+	require.Greater(t, respSize, int64(0))
+	require.NoError(t, response.Next(context.TODO(), chk2))
+	require.Equal(t, 200, chk2.NumRows())
+	// The first response is still tracked since chk1 is built over it.
+	require.Equal(t, 2*respSize, response.memTracker.BytesConsumed())
+	require.NoError(t, response.Next(context.TODO(), chk1))
+	require.Equal(t, 0, chk1.NumRows())
+	require.Equal(t, respSize, response.memTracker.BytesConsumed())
+	require.NoError(t, response.Close())
+	require.Equal(t, int64(0), response.memTracker.BytesConsumed())
+}
+
 func TestSelectNormalChunkSize(t *testing.T) {
 	sctx := newMockSessionContext()
 	sctx.GetSessionVars().EnableChunkRPC = false
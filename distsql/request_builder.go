@@ -19,6 +19,7 @@ import (
 	"math"
 	"sort"
 	"sync/atomic"
+	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/kvproto/pkg/metapb"
@@ -275,7 +276,7 @@ func (*RequestBuilder) getKVPriority(sv *variable.SessionVars) int {
 
 // SetFromSessionVars sets the following fields for "kv.Request" from session variables:
 // "Concurrency", "IsolationLevel", "NotFillCache", "TaskID", "Priority", "ReplicaRead",
-// "ResourceGroupTagger", "ResourceGroupName"
+// "ResourceGroupTagger", "ResourceGroupName", "SnapshotRefreshInterval"
 func (builder *RequestBuilder) SetFromSessionVars(sv *variable.SessionVars) *RequestBuilder {
 	distsqlConcurrency := sv.DistSQLScanConcurrency()
 	if builder.Request.Concurrency == 0 {
@@ -309,6 +310,8 @@ func (builder *RequestBuilder) SetFromSessionVars(sv *variable.SessionVars) *Req
 	builder.StoreBatchSize = sv.StoreBatchSize
 	builder.Request.ResourceGroupName = sv.ResourceGroupName
 	builder.Request.StoreBusyThreshold = sv.LoadBasedReplicaReadThreshold
+	builder.Request.SnapshotRefreshInterval = sv.StmtCtx.SnapshotRefreshInterval
+	builder.Request.AdaptiveConcurrency = sv.DistSQLAdaptiveConcurrency
 	return builder
 }
 
@@ -318,6 +321,13 @@ func (builder *RequestBuilder) SetPaging(paging bool) *RequestBuilder {
 	return builder
 }
 
+// SetSnapshotRefreshInterval sets "SnapshotRefreshInterval" for "kv.Request", it overrides the interval set by
+// SetFromSessionVars.
+func (builder *RequestBuilder) SetSnapshotRefreshInterval(interval time.Duration) *RequestBuilder {
+	builder.Request.SnapshotRefreshInterval = interval
+	return builder
+}
+
 // SetConcurrency sets "Concurrency" for "kv.Request".
 func (builder *RequestBuilder) SetConcurrency(concurrency int) *RequestBuilder {
 	builder.Request.Concurrency = concurrency
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -282,6 +283,94 @@ func (ssr *serialSelectResults) Close() (err error) {
 	return
 }
 
+// zeroCopyMinRows is the min number of the rows of a response chunk to build the result chunk over it without copying,
+// even if the result chunk requires more rows. Copying the wide rows costs more than processing the smaller chunks, so
+// only the smaller response chunks are spliced into one chunk.
+const zeroCopyMinRows = 128
+
+// arenaResp is a response in respArena.
+type arenaResp struct {
+	size int64
+	refs int
+	// retired indicates the response isn't decoded anymore, it's released once it isn't referenced.
+	retired bool
+}
+
+// respArena manages the lifetime of the responses which the result chunks are built over without copying. A response
+// is released when all the chunks built over it are reused, rather than when the next response is fetched, since the
+// parent executors may still hold the chunks. The methods return the size of the released responses.
+type respArena struct {
+	mu sync.Mutex
+	// cur is the response being decoded.
+	cur *arenaResp
+	// refs is the response each chunk is built over.
+	refs map[*chunk.Chunk]*arenaResp
+	// consumed is the total size of the responses which aren't released.
+	consumed int64
+}
+
+// add adds the newly fetched response to the arena.
+func (a *respArena) add(size int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.cur = &arenaResp{size: size}
+	a.consumed += size
+}
+
+func (a *respArena) releaseIfUnused(resp *arenaResp) int64 {
+	if !resp.retired || resp.refs > 0 {
+		return 0
+	}
+	a.consumed -= resp.size
+	return resp.size
+}
+
+// retire retires the response being decoded.
+func (a *respArena) retire() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.cur == nil {
+		return 0
+	}
+	resp := a.cur
+	a.cur = nil
+	resp.retired = true
+	return a.releaseIfUnused(resp)
+}
+
+// ref records that chk is built over the response being decoded.
+func (a *respArena) ref(chk *chunk.Chunk) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.refs == nil {
+		a.refs = make(map[*chunk.Chunk]*arenaResp)
+	}
+	a.cur.refs++
+	a.refs[chk] = a.cur
+}
+
+// reuse records that the rows in chk are not used anymore.
+func (a *respArena) reuse(chk *chunk.Chunk) int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	resp, ok := a.refs[chk]
+	if !ok {
+		return 0
+	}
+	delete(a.refs, chk)
+	resp.refs--
+	return a.releaseIfUnused(resp)
+}
+
+// close releases all the responses.
+func (a *respArena) close() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	released := a.consumed
+	a.cur, a.refs, a.consumed = nil, nil, 0
+	return released
+}
+
 type selectResult struct {
 	label string
 	resp  kv.Response
@@ -291,7 +380,7 @@ type selectResult struct {
 	ctx        sessionctx.Context
 
 	selectResp       *tipb.SelectResponse
-	selectRespSize   int64 // record the selectResp.Size() when it is initialized.
+	respArena        respArena
 	respChkIdx       int
 	respChunkDecoder *chunk.Decoder
 
@@ -359,11 +448,10 @@ func (r *selectResult) fetchResp(ctx context.Context) error {
 			return errors.Trace(err)
 		}
 		if r.selectResp != nil {
-			r.memConsume(-atomic.LoadInt64(&r.selectRespSize))
+			r.memConsume(-r.respArena.retire())
 		}
 		if resultSubset == nil {
 			r.selectResp = nil
-			atomic.StoreInt64(&r.selectRespSize, 0)
 			if !r.durationReported {
 				// final round of fetch
 				// TODO: Add a label to distinguish between success or failure.
@@ -383,7 +471,7 @@ func (r *selectResult) fetchResp(ctx context.Context) error {
 			return errors.Trace(err)
 		}
 		respSize := int64(r.selectResp.Size())
-		atomic.StoreInt64(&r.selectRespSize, respSize)
+		r.respArena.add(respSize)
 		r.memConsume(respSize)
 		if err := r.selectResp.Error; err != nil {
 			return dbterror.ClassTiKV.Synthesize(terror.ErrCode(err.Code), err.Msg)
@@ -418,6 +506,7 @@ func (r *selectResult) fetchResp(ctx context.Context) error {
 }
 
 func (r *selectResult) Next(ctx context.Context, chk *chunk.Chunk) error {
+	r.memConsume(-r.respArena.reuse(chk))
 	chk.Reset()
 	if r.selectResp == nil || r.respChkIdx == len(r.selectResp.Chunks) {
 		err := r.fetchResp(ctx)
@@ -494,13 +583,16 @@ func (r *selectResult) readFromChunk(ctx context.Context, chk *chunk.Chunk) erro
 		if r.respChunkDecoder.IsFinished() {
 			r.respChunkDecoder.Reset(r.selectResp.Chunks[r.respChkIdx].RowsData)
 		}
-		// If the next chunk size is greater than required rows * 0.8, reuse the memory of the next chunk and return
-		// immediately. Otherwise, splice the data to one chunk and wait the next chunk.
-		if r.respChunkDecoder.RemainedRows() > int(float64(chk.RequiredRows())*0.8) {
+		// If the next chunk size is greater than required rows * 0.8 or zeroCopyMinRows, build the chunk over the
+		// memory of the next chunk without copying and return immediately. Otherwise, splice the data to one chunk and
+		// wait the next chunk.
+		remainedRows := r.respChunkDecoder.RemainedRows()
+		if remainedRows > int(float64(chk.RequiredRows())*0.8) || remainedRows >= zeroCopyMinRows {
 			if chk.NumRows() > 0 {
 				return nil
 			}
 			r.respChunkDecoder.ReuseIntermChk(chk)
+			r.respArena.ref(chk)
 			r.respChkIdx++
 			return nil
 		}
@@ -622,10 +714,7 @@ func (r *selectResult) Close() error {
 		metrics.DistSQLScanKeysHistogram.Observe(float64(r.feedback.Actual()))
 	}
 	metrics.DistSQLPartialCountHistogram.Observe(float64(r.partialCount))
-	respSize := atomic.SwapInt64(&r.selectRespSize, 0)
-	if respSize > 0 {
-		r.memConsume(-respSize)
-	}
+	r.memConsume(-r.respArena.close())
 	if r.stats != nil {
 		defer func() {
 			if ci, ok := r.resp.(copr.CopInfo); ok {
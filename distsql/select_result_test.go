@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/store/copr"
+	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/mock"
 	"github.com/pingcap/tipb/go-tipb"
@@ -56,3 +57,24 @@ func TestUpdateCopRuntimeStats(t *testing.T) {
 	sr.updateCopRuntimeStats(context.Background(), &copr.CopRuntimeStats{ExecDetails: execdetails.ExecDetails{DetailsNeedP90: execdetails.DetailsNeedP90{CalleeAddress: "callee"}}}, 0)
 	require.Equal(t, "tikv_task:{time:1ns, loops:1}", ctx.GetSessionVars().StmtCtx.RuntimeStatsColl.GetOrCreateCopStats(1234, "tikv").String())
 }
+
+func TestRespArena(t *testing.T) {
+	var arena respArena
+	chk1, chk2 := chunk.NewChunkWithCapacity(nil, 0), chunk.NewChunkWithCapacity(nil, 0)
+	arena.add(100)
+	arena.ref(chk1)
+	arena.ref(chk2)
+	// The retired response is still referenced by the chunks.
+	require.Equal(t, int64(0), arena.retire())
+	arena.add(50)
+	require.Equal(t, int64(0), arena.reuse(chk1))
+	require.Equal(t, int64(100), arena.reuse(chk2))
+	// The chunk isn't built over any response now.
+	require.Equal(t, int64(0), arena.reuse(chk2))
+
+	arena.ref(chk1)
+	require.Equal(t, int64(0), arena.retire())
+	require.Equal(t, int64(50), arena.close())
+	require.Equal(t, int64(0), arena.reuse(chk1))
+	require.Equal(t, int64(0), arena.close())
+}
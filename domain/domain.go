@@ -992,7 +992,12 @@ func NewDomain(store kv.Storage, ddlLease time.Duration, statsLease time.Duratio
 	do.expensiveQueryHandle = expensivequery.NewExpensiveQueryHandle(do.exit)
 	do.memoryUsageAlarmHandle = memoryusagealarm.NewMemoryUsageAlarmHandle(do.exit)
 	do.serverMemoryLimitHandle = servermemorylimit.NewServerMemoryLimitHandle(do.exit)
-	do.sysProcesses = SysProcesses{mu: &sync.RWMutex{}, procMap: make(map[uint64]sessionctx.Context)}
+	do.sysProcesses = SysProcesses{
+		mu:             &sync.RWMutex{},
+		procMap:        make(map[uint64]sessionctx.Context),
+		internalProcs:  make(map[uint64]*internalProc),
+		serverIDGetter: do.ServerID,
+	}
 	do.initDomainSysVars()
 	return do
 }
@@ -1078,6 +1083,7 @@ func (do *Domain) Init(
 		ddl.WithInfoCache(do.infoCache),
 		ddl.WithHook(callback),
 		ddl.WithLease(ddlLease),
+		ddl.WithSysProcTracker(&do.sysProcesses),
 	)
 	failpoint.Inject("MockReplaceDDL", func(val failpoint.Value) {
 		if val.(bool) {
@@ -1639,6 +1645,9 @@ func (do *Domain) globalBindHandleWorkerLoop(owner owner.Manager) {
 				if err == nil && variable.TiDBOptOn(optVal) {
 					bindHandle.CaptureBaselines()
 				}
+				if variable.CapturePlanBaselinesOnRegression.Load() {
+					bindHandle.CaptureRegressedPlans()
+				}
 				bindHandle.SaveEvolveTasksToStore()
 			case <-gcBindTicker.C:
 				if !owner.IsOwner() {
@@ -1732,7 +1741,9 @@ func (do *Domain) TelemetryRotateSubWindowLoop(ctx sessionctx.Context) {
 // SetupPlanReplayerHandle setup plan replayer handle
 func (do *Domain) SetupPlanReplayerHandle(collectorSctx sessionctx.Context, workersSctxs []sessionctx.Context) {
 	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
-	do.planReplayerHandle = &planReplayerHandle{}
+	do.planReplayerHandle = &planReplayerHandle{
+		regressionChecker: newPlanReplayerRegressionChecker(),
+	}
 	do.planReplayerHandle.planReplayerTaskCollectorHandle = &planReplayerTaskCollectorHandle{
 		ctx:  ctx,
 		sctx: collectorSctx,
@@ -1918,6 +1929,11 @@ func (do *Domain) CreateStatsHandle(ctx, initStatsCtx sessionctx.Context) error
 	return nil
 }
 
+// IndexUsageSyncLease returns the lease of syncing the index usage to the storage, 0 means the index usage isn't tracked.
+func (do *Domain) IndexUsageSyncLease() time.Duration {
+	return do.indexUsageSyncLease
+}
+
 // StatsUpdating checks if the stats worker is updating.
 func (do *Domain) StatsUpdating() bool {
 	return do.statsUpdating.Load() > 0
@@ -2223,6 +2239,8 @@ func (do *Domain) autoAnalyzeWorker(owner owner.Manager) {
 		select {
 		case <-analyzeTicker.C:
 			if variable.RunAutoAnalyze.Load() && !do.stopAutoAnalyze.Load() && owner.IsOwner() {
+				// Analyze the new expression indexes first, their hidden columns have no statistics at all.
+				statsHandle.HandleNewIndexAnalyze(do.InfoSchema())
 				statsHandle.HandleAutoAnalyze(do.InfoSchema())
 			}
 		case <-do.exit:
@@ -2590,6 +2608,7 @@ func (do *Domain) StartTTLJobManager() {
 		}()
 
 		ttlJobManager := ttlworker.NewJobManager(do.ddl.GetID(), do.sysSessionPool, do.store, do.etcdClient)
+		ttlJobManager.SetSysProcTracker(&do.sysProcesses)
 		do.ttlJobManager.Store(ttlJobManager)
 		ttlJobManager.Start()
 
@@ -2626,6 +2645,18 @@ var (
 type SysProcesses struct {
 	mu      *sync.RWMutex
 	procMap map[uint64]sessionctx.Context
+	// internalProcs holds the infos of the internal sessions of the background workers, such as the backfill workers
+	// and the TTL workers, which are tracked by TrackInternal.
+	internalProcs  map[uint64]*internalProc
+	serverIDGetter func() uint64
+}
+
+// internalProc is the info of an internal session of the background workers.
+type internalProc struct {
+	operation string
+	jobID     string
+	info      string
+	startTime time.Time
 }
 
 // Track tracks the sys process into procMap
@@ -2641,12 +2672,31 @@ func (s *SysProcesses) Track(id uint64, proc sessionctx.Context) error {
 	return nil
 }
 
+// TrackInternal tracks the internal session of a background worker with the first unused reserved ID
+func (s *SysProcesses) TrackInternal(proc sessionctx.Context, operation, jobID, info string) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := 0; i < util.MaxInternalProcs; i++ {
+		id := util.GetInternalProcID(s.serverIDGetter, i)
+		if _, ok := s.procMap[id]; ok {
+			continue
+		}
+		s.procMap[id] = proc
+		s.internalProcs[id] = &internalProc{operation: operation, jobID: jobID, info: info, startTime: time.Now()}
+		proc.GetSessionVars().ConnectionID = id
+		atomic.StoreUint32(&proc.GetSessionVars().Killed, 0)
+		return id, nil
+	}
+	return 0, errors.Errorf("too many internal sessions are tracked: %v", util.MaxInternalProcs)
+}
+
 // UnTrack removes the sys process from procMap
 func (s *SysProcesses) UnTrack(id uint64) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if proc, ok := s.procMap[id]; ok {
 		delete(s.procMap, id)
+		delete(s.internalProcs, id)
 		proc.GetSessionVars().ConnectionID = 0
 		atomic.StoreUint32(&proc.GetSessionVars().Killed, 0)
 	}
@@ -2658,10 +2708,37 @@ func (s *SysProcesses) GetSysProcessList() map[uint64]*util.ProcessInfo {
 	defer s.mu.RUnlock()
 	rs := make(map[uint64]*util.ProcessInfo)
 	for connID, proc := range s.procMap {
-		// if session is still tracked in this map, it's not returned to sysSessionPool yet
-		if pi := proc.ShowProcess(); pi != nil && pi.ID == connID {
-			rs[connID] = pi
+		pi := proc.ShowProcess()
+		internal, ok := s.internalProcs[connID]
+		if !ok {
+			// if session is still tracked in this map, it's not returned to sysSessionPool yet
+			if pi != nil && pi.ID == connID {
+				cpi := *pi
+				cpi.Internal = true
+				if connID == util.GetAutoAnalyzeProcID(s.serverIDGetter) {
+					cpi.Operation = "auto analyze"
+				}
+				rs[connID] = &cpi
+			}
+			continue
+		}
+		// The internal sessions of the background workers may not execute statements, whose process infos are
+		// built from the tracked infos.
+		var cpi util.ProcessInfo
+		if pi != nil && pi.ID == connID {
+			cpi = *pi
+		} else {
+			cpi = util.ProcessInfo{
+				ID:      connID,
+				Command: mysql.ComDaemon,
+				Time:    internal.startTime,
+				Info:    internal.info,
+			}
 		}
+		cpi.Internal = true
+		cpi.Operation = internal.operation
+		cpi.JobID = internal.jobID
+		rs[connID] = &cpi
 	}
 	return rs
 }
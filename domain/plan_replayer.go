@@ -183,6 +183,12 @@ func insertPlanReplayerSuccessStatusRecord(ctx context.Context, sctx sessionctx.
 type planReplayerHandle struct {
 	*planReplayerTaskCollectorHandle
 	*planReplayerTaskDumpHandle
+	regressionChecker *planReplayerRegressionChecker
+}
+
+// CheckPlanRegression records the latency of the plan and returns whether the plan is regressed and should be captured.
+func (h *planReplayerHandle) CheckPlanRegression(key replayer.PlanReplayerTaskKey, latency time.Duration, ratio float64) bool {
+	return h.regressionChecker.observe(key, latency, ratio)
 }
 
 // SendTask send dumpTask in background task handler
@@ -362,6 +368,78 @@ func (r *planReplayerDumpTaskStatus) clearFinishedTask() {
 	r.finishedTaskMu.finishedTask = map[replayer.PlanReplayerTaskKey]struct{}{}
 }
 
+const (
+	// planReplayerRegressionMinExecCount is the execution count of a plan before its average latency is compared.
+	planReplayerRegressionMinExecCount = 3
+	// planReplayerRegressionMaxSQLDigests is the max number of the SQL digests recorded by the regression checker.
+	planReplayerRegressionMaxSQLDigests = 4096
+)
+
+type planReplayerPlanLatency struct {
+	count int64
+	total time.Duration
+}
+
+func (l *planReplayerPlanLatency) avg() time.Duration {
+	return l.total / time.Duration(l.count)
+}
+
+// planReplayerRegressionChecker records the latencies of the plans of each SQL digest in order to find out the plans
+// which are much slower than the other plans of the same SQL digest.
+type planReplayerRegressionChecker struct {
+	sync.Mutex
+	// digests maps the SQL digest to the latencies of its plans.
+	digests map[string]map[string]*planReplayerPlanLatency
+	// captured records the regressed plans, each of them is only captured once.
+	captured map[replayer.PlanReplayerTaskKey]struct{}
+}
+
+func newPlanReplayerRegressionChecker() *planReplayerRegressionChecker {
+	return &planReplayerRegressionChecker{
+		digests:  make(map[string]map[string]*planReplayerPlanLatency),
+		captured: make(map[replayer.PlanReplayerTaskKey]struct{}),
+	}
+}
+
+// observe records the latency of the plan, and returns true if the average latency of the plan exceeds the ratio of
+// the average latency of any other plan of the same SQL digest for the first time.
+func (c *planReplayerRegressionChecker) observe(key replayer.PlanReplayerTaskKey, latency time.Duration, ratio float64) bool {
+	c.Lock()
+	defer c.Unlock()
+	plans, ok := c.digests[key.SQLDigest]
+	if !ok {
+		if len(c.digests) >= planReplayerRegressionMaxSQLDigests {
+			c.digests = make(map[string]map[string]*planReplayerPlanLatency)
+			c.captured = make(map[replayer.PlanReplayerTaskKey]struct{})
+		}
+		plans = make(map[string]*planReplayerPlanLatency)
+		c.digests[key.SQLDigest] = plans
+	}
+	cur, ok := plans[key.PlanDigest]
+	if !ok {
+		cur = &planReplayerPlanLatency{}
+		plans[key.PlanDigest] = cur
+	}
+	cur.count++
+	cur.total += latency
+	if cur.count < planReplayerRegressionMinExecCount {
+		return false
+	}
+	if _, ok := c.captured[key]; ok {
+		return false
+	}
+	for planDigest, other := range plans {
+		if planDigest == key.PlanDigest || other.count < planReplayerRegressionMinExecCount {
+			continue
+		}
+		if float64(cur.avg()) > float64(other.avg())*ratio {
+			c.captured[key] = struct{}{}
+			return true
+		}
+	}
+	return false
+}
+
 type planReplayerTaskDumpWorker struct {
 	ctx    context.Context
 	sctx   sessionctx.Context
@@ -562,4 +640,6 @@ type PlanReplayerDumpTask struct {
 	IsCapture bool
 	// IsContinuesCapture indicates whether the task is from continues capture
 	IsContinuesCapture bool
+	// IsRegressionCapture indicates whether the task is from the capture of the regressed plans
+	IsRegressionCapture bool
 }
@@ -68,6 +68,8 @@ const (
 	PlanReplayerTaskMetaIsCapture = "isCapture"
 	// PlanReplayerTaskMetaIsContinues indicates whether this task is continues task
 	PlanReplayerTaskMetaIsContinues = "isContinues"
+	// PlanReplayerTaskMetaIsRegression indicates whether this task is captured for the regressed plan
+	PlanReplayerTaskMetaIsRegression = "isRegression"
 	// PlanReplayerTaskMetaSQLDigest indicates the sql digest of this task
 	PlanReplayerTaskMetaSQLDigest = "sqlDigest"
 	// PlanReplayerTaskMetaPlanDigest indicates the plan digest of this task
@@ -290,10 +292,11 @@ func DumpPlanReplayerInfo(ctx context.Context, sctx sessionctx.Context,
 	}
 
 	// For capture task, we dump stats in storage only if EnableHistoricalStatsForCapture is disabled.
+	// For regression capture task, we always dump stats in order to reproduce the regressed plan.
 	// For manual plan replayer dump command, we directly dump stats in storage
 	if task.IsCapture {
 		if !task.IsContinuesCapture {
-			if variable.EnableHistoricalStatsForCapture.Load() {
+			if variable.EnableHistoricalStatsForCapture.Load() || task.IsRegressionCapture {
 				// Dump stats
 				if err = dumpStats(zw, pairs, do); err != nil {
 					return err
@@ -375,6 +378,7 @@ func dumpSQLMeta(zw *zip.Writer, task *PlanReplayerDumpTask) error {
 	varMap[PlanReplayerSQLMetaStartTS] = strconv.FormatUint(task.StartTS, 10)
 	varMap[PlanReplayerTaskMetaIsCapture] = strconv.FormatBool(task.IsCapture)
 	varMap[PlanReplayerTaskMetaIsContinues] = strconv.FormatBool(task.IsContinuesCapture)
+	varMap[PlanReplayerTaskMetaIsRegression] = strconv.FormatBool(task.IsRegressionCapture)
 	varMap[PlanReplayerTaskMetaSQLDigest] = task.SQLDigest
 	varMap[PlanReplayerTaskMetaPlanDigest] = task.PlanDigest
 	varMap[PlanReplayerTaskEnableHistoricalStats] = strconv.FormatBool(variable.EnableHistoricalStatsForCapture.Load())
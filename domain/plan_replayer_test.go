@@ -106,3 +106,26 @@ func TestDumpGCFileParseTime(t *testing.T) {
 	_, err = parseTime(pName)
 	require.NoError(t, err)
 }
+
+func TestPlanReplayerRegressionChecker(t *testing.T) {
+	checker := newPlanReplayerRegressionChecker()
+	fast := replayer.PlanReplayerTaskKey{SQLDigest: "sql", PlanDigest: "fast"}
+	slow := replayer.PlanReplayerTaskKey{SQLDigest: "sql", PlanDigest: "slow"}
+	for i := 0; i < planReplayerRegressionMinExecCount; i++ {
+		require.False(t, checker.observe(fast, time.Millisecond, 2))
+	}
+	// the plan isn't compared before it's executed enough times
+	for i := 0; i < planReplayerRegressionMinExecCount-1; i++ {
+		require.False(t, checker.observe(slow, 10*time.Millisecond, 2))
+	}
+	require.True(t, checker.observe(slow, 10*time.Millisecond, 2))
+	// the regressed plan is only captured once
+	require.False(t, checker.observe(slow, 10*time.Millisecond, 2))
+	// the faster plan isn't regressed
+	require.False(t, checker.observe(fast, time.Millisecond, 2))
+
+	other := replayer.PlanReplayerTaskKey{SQLDigest: "sql", PlanDigest: "other"}
+	for i := 0; i < planReplayerRegressionMinExecCount; i++ {
+		require.False(t, checker.observe(other, 3*time.Millisecond/2, 2))
+	}
+}
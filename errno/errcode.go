@@ -913,6 +913,8 @@ const (
 	ErrDefValGeneratedNamedFunctionIsNotAllowed              = 3770
 	ErrFKIncompatibleColumns                                 = 3780
 	ErrFunctionalIndexRowValueIsNotAllowed                   = 3800
+	ErrCheckConstraintViolated                               = 3819
+	ErrCheckConstraintDupName                                = 3822
 	ErrDependentByFunctionalIndex                            = 3837
 	ErrCannotConvertString                                   = 3854
 	ErrDependentByPartitionFunctional                        = 3855
@@ -921,6 +923,9 @@ const (
 	ErrFunctionalIndexDataIsTooLong                          = 3907
 	ErrFunctionalIndexNotApplicable                          = 3909
 	ErrDynamicPrivilegeNotRegistered                         = 3929
+	ErrJSONSchemaValidationErrorWithDetailedReport           = 3934
+	ErrConstraintNotFound                                    = 3940
+	ErrDependentByCheckConstraint                            = 3959
 	ErUserAccessDeniedForUserAccountBlockedByPasswordLock    = 3955
 	ErrJSONInBooleanContext                                  = 3986
 	ErrTableWithoutPrimaryKey                                = 3750
@@ -1117,6 +1122,12 @@ const (
 	ErrResourceGroupConfigUnavailable = 8251
 	ErrResourceGroupThrottled         = 8252
 
+	ErrColumnHasDependents        = 8253
+	ErrBackfillChecksumMismatch   = 8254
+	ErrMaintenanceWindowExists    = 8255
+	ErrMaintenanceWindowNotExists = 8256
+	ErrMaintenanceWindowClosed    = 8257
+
 	// TiKV/PD/TiFlash errors.
 	ErrPDServerTimeout           = 9001
 	ErrTiKVServerTimeout         = 9002
@@ -1345,6 +1345,7 @@ func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults boo
 	// `LowSlowQuery` and `SummaryStmt` must be called before recording `PrevStmt`.
 	a.LogSlowQuery(txnTS, succ, hasMoreResults)
 	a.SummaryStmt(succ)
+	a.observePlanFeedback(succ)
 	a.observeStmtFinishedForTopSQL()
 	if sessVars.StmtCtx.IsTiFlash.Load() {
 		if succ {
@@ -1405,6 +1406,11 @@ func (a *ExecStmt) checkPlanReplayerCapture(txnTS uint64) {
 	se := a.Ctx
 	if !se.GetSessionVars().InRestrictedSQL && se.GetSessionVars().IsPlanReplayerCaptureEnabled() {
 		stmtNode := a.GetStmtNode()
+		sessVars := se.GetSessionVars()
+		if ratio := sessVars.PlanReplayerCaptureRegressionRatio; ratio > 0 && checkPlanReplayerContinuesCaptureValidStmt(stmtNode) {
+			latency := time.Since(sessVars.StartTime) + sessVars.DurationParse
+			checkPlanReplayerRegressionCapture(se, stmtNode, txnTS, latency, ratio)
+		}
 		if se.GetSessionVars().EnablePlanReplayedContinuesCapture {
 			if checkPlanReplayerContinuesCaptureValidStmt(stmtNode) {
 				checkPlanReplayerContinuesCapture(se, stmtNode, txnTS)
@@ -1719,8 +1725,8 @@ func getEncodedPlan(stmtCtx *stmtctx.StatementContext, genHint bool) (encodedPla
 			// so we have to iterate all hints from the customer and keep some other necessary hints.
 			switch tableHint.HintName.L {
 			case "memory_quota", "use_toja", "no_index_merge", "max_execution_time",
-				plannercore.HintAggToCop, plannercore.HintIgnoreIndex,
-				plannercore.HintReadFromStorage, plannercore.HintLimitToCop:
+				plannercore.HintAggToCop, plannercore.HintAggToRoot, plannercore.HintAggToMPP, plannercore.HintIgnoreIndex,
+				plannercore.HintReadFromStorage, plannercore.HintLimitToCop, plannercore.HintLimitToRoot, plannercore.HintLimitToMPP:
 				hints = append(hints, tableHint)
 			}
 		}
@@ -1871,6 +1877,54 @@ func (a *ExecStmt) SummaryStmt(succ bool) {
 	stmtsummaryv2.Add(stmtExecInfo)
 }
 
+// observePlanFeedback records the execution feedback of the plan, so the binding of the previous plan is captured
+// automatically when the plan regresses.
+func (a *ExecStmt) observePlanFeedback(succ bool) {
+	sessVars := a.Ctx.GetSessionVars()
+	if !succ || !variable.CapturePlanBaselinesOnRegression.Load() || sessVars.InRestrictedSQL || sessVars.FoundInBinding || a.Plan == nil {
+		return
+	}
+	switch x := a.StmtNode.(type) {
+	case *ast.SelectStmt, *ast.DeleteStmt, *ast.UpdateStmt:
+	case *ast.InsertStmt:
+		if x.Select == nil {
+			return
+		}
+	default:
+		return
+	}
+	dom := domain.GetDomain(a.Ctx)
+	if dom == nil || dom.BindHandle() == nil {
+		return
+	}
+	stmtCtx := sessVars.StmtCtx
+	_, sqlDigest := stmtCtx.SQLDigest()
+	_, planDigest := getPlanDigest(stmtCtx)
+	if sqlDigest == nil || planDigest == nil {
+		return
+	}
+	var estRows float64
+	if pp, ok := a.Plan.(plannercore.PhysicalPlan); ok {
+		estRows = pp.StatsCount()
+	}
+	charset, collation := sessVars.GetCharsetInfo()
+	dom.BindHandle().ObservePlanFeedback(&bindinfo.PlanFeedback{
+		SQLDigest:  sqlDigest.String(),
+		PlanDigest: planDigest.String(),
+		Query:      a.StmtNode.Text(),
+		Schema:     sessVars.CurrentDB,
+		Charset:    charset,
+		Collation:  collation,
+		Latency:    time.Since(sessVars.StartTime) + sessVars.DurationParse,
+		EstRows:    estRows,
+		ActRows:    GetResultRowsCount(stmtCtx, a.Plan),
+		PlanHintGen: func() string {
+			_, hint := getEncodedPlan(stmtCtx, true)
+			return hint
+		},
+	}, variable.PlanRegressionThreshold.Load())
+}
+
 // GetTextToLog return the query text to log.
 func (a *ExecStmt) GetTextToLog() string {
 	var sql string
@@ -2027,7 +2081,7 @@ func checkPlanReplayerCaptureTask(sctx sessionctx.Context, stmtNode ast.StmtNode
 	for _, task := range tasks {
 		if task.SQLDigest == sqlDigest.String() {
 			if task.PlanDigest == "*" || task.PlanDigest == planDigest.String() {
-				sendPlanReplayerDumpTask(key, sctx, stmtNode, startTS, false)
+				sendPlanReplayerDumpTask(key, sctx, stmtNode, startTS, false, false)
 				return
 			}
 		}
@@ -2053,12 +2107,37 @@ func checkPlanReplayerContinuesCapture(sctx sessionctx.Context, stmtNode ast.Stm
 	if existed {
 		return
 	}
-	sendPlanReplayerDumpTask(key, sctx, stmtNode, startTS, true)
+	sendPlanReplayerDumpTask(key, sctx, stmtNode, startTS, true, false)
 	sctx.GetSessionVars().AddPlanReplayerFinishedTaskKey(key)
 }
 
+// checkPlanReplayerRegressionCapture captures the plan if it's much slower than the other plans of the same sql digest
+func checkPlanReplayerRegressionCapture(sctx sessionctx.Context, stmtNode ast.StmtNode, startTS uint64,
+	latency time.Duration, ratio float64) {
+	dom := domain.GetDomain(sctx)
+	if dom == nil {
+		return
+	}
+	handle := dom.GetPlanReplayerHandle()
+	if handle == nil {
+		return
+	}
+	_, sqlDigest := sctx.GetSessionVars().StmtCtx.SQLDigest()
+	_, planDigest := sctx.GetSessionVars().StmtCtx.GetPlanDigest()
+	if sqlDigest == nil || planDigest == nil {
+		return
+	}
+	key := replayer.PlanReplayerTaskKey{
+		SQLDigest:  sqlDigest.String(),
+		PlanDigest: planDigest.String(),
+	}
+	if handle.CheckPlanRegression(key, latency, ratio) {
+		sendPlanReplayerDumpTask(key, sctx, stmtNode, startTS, false, true)
+	}
+}
+
 func sendPlanReplayerDumpTask(key replayer.PlanReplayerTaskKey, sctx sessionctx.Context, stmtNode ast.StmtNode,
-	startTS uint64, isContinuesCapture bool) {
+	startTS uint64, isContinuesCapture, isRegressionCapture bool) {
 	stmtCtx := sctx.GetSessionVars().StmtCtx
 	handle := sctx.Value(bindinfo.SessionBindInfoKeyType).(*bindinfo.SessionHandle)
 	dumpTask := &domain.PlanReplayerDumpTask{
@@ -2071,6 +2150,7 @@ func sendPlanReplayerDumpTask(key replayer.PlanReplayerTaskKey, sctx sessionctx.
 		Analyze:             false,
 		IsCapture:           true,
 		IsContinuesCapture:  isContinuesCapture,
+		IsRegressionCapture: isRegressionCapture,
 	}
 	dumpTask.EncodedPlan, _ = GetEncodedPlan(stmtCtx, false)
 	if _, ok := stmtNode.(*ast.ExecuteStmt); ok {
@@ -0,0 +1,128 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/memory"
+)
+
+// adaptiveJoinBuildRowsRatio is the ratio of the build side rows to the estimated rows beyond which the hash join
+// switches to the index join.
+const adaptiveJoinBuildRowsRatio = 10
+
+// adaptiveIndexJoin is the index join which the hash join switches to when its build side has far more rows than
+// estimated. The hash join reads the build side before the probe side is opened. If the rows read exceed
+// maxBuildRows, the build side is closed and the index join looks up the inner rows for each outer row instead,
+// otherwise the rows read are built into the hash table and the probe side is opened. The rows read are discarded
+// when the join switches, since the index join reads the inner rows again by looking up the index for each outer row.
+type adaptiveIndexJoin struct {
+	indexJoin    Executor
+	maxBuildRows int
+
+	// prefetched are the build side chunks read before the decision, which are built into the hash table.
+	prefetched []*chunk.Chunk
+	// buildExhausted indicates all the build side rows are prefetched.
+	buildExhausted bool
+	prefetchedMem  int64
+
+	decided         bool
+	switched        bool
+	probeOpened     bool
+	indexJoinOpened bool
+	// desc describes the decision in the runtime stats.
+	desc string
+}
+
+func (a *adaptiveIndexJoin) reset() {
+	a.prefetched = nil
+	a.buildExhausted = false
+	a.prefetchedMem = 0
+	a.decided = false
+	a.switched = false
+	a.probeOpened = false
+	a.indexJoinOpened = false
+	a.desc = ""
+}
+
+// takePrefetched returns the prefetched build side chunks and whether they are all the build side rows. The memory
+// of the chunks is tracked by the hash table after they are taken.
+func (a *adaptiveIndexJoin) takePrefetched(memTracker *memory.Tracker) (chks []*chunk.Chunk, exhausted bool) {
+	chks, a.prefetched = a.prefetched, nil
+	if a.prefetchedMem > 0 {
+		memTracker.Consume(-a.prefetchedMem)
+		a.prefetchedMem = 0
+	}
+	return chks, a.buildExhausted
+}
+
+// decideAdaptiveJoin reads the build side until it's exhausted or has more than maxBuildRows rows, and then opens
+// the probe side to continue the hash join, or switches to the index join.
+func (e *HashJoinExec) decideAdaptiveJoin(ctx context.Context) error {
+	a := e.adaptive
+	a.decided = true
+	buildSideExec := e.buildWorker.buildSideExec
+	sessVars := e.ctx.GetSessionVars()
+	rows := 0
+	for rows <= a.maxBuildRows {
+		chk := sessVars.GetNewChunkWithCapacity(buildSideExec.base().retFieldTypes, sessVars.MaxChunkSize, sessVars.MaxChunkSize, e.AllocPool)
+		if err := Next(ctx, buildSideExec, chk); err != nil {
+			return err
+		}
+		if chk.NumRows() == 0 {
+			a.buildExhausted = true
+			break
+		}
+		rows += chk.NumRows()
+		a.prefetched = append(a.prefetched, chk)
+		a.prefetchedMem += chk.MemoryUsage()
+		e.hashJoinCtx.memTracker.Consume(chk.MemoryUsage())
+	}
+	if rows <= a.maxBuildRows {
+		a.desc = fmt.Sprintf("hash join, build rows:%d", rows)
+		// The probe side is closed by the join even if it fails to open.
+		a.probeOpened = true
+		return e.probeSideTupleFetcher.probeSideExec.Open(ctx)
+	}
+	a.desc = fmt.Sprintf("switched to index join, build rows:>%d", a.maxBuildRows)
+	a.takePrefetched(e.hashJoinCtx.memTracker)
+	a.switched = true
+	if err := buildSideExec.Close(); err != nil {
+		return err
+	}
+	a.indexJoinOpened = true
+	return a.indexJoin.Open(ctx)
+}
+
+// closeAdaptiveJoin closes the children opened by the hash join with the adaptive join.
+func (e *HashJoinExec) closeAdaptiveJoin() error {
+	a := e.adaptive
+	a.takePrefetched(e.hashJoinCtx.memTracker)
+	switch {
+	case a.switched:
+		// The build side is closed when the join switches.
+		if a.indexJoinOpened {
+			return a.indexJoin.Close()
+		}
+		return nil
+	case a.probeOpened:
+		return e.baseExecutor.Close()
+	default:
+		return e.buildWorker.buildSideExec.Close()
+	}
+}
@@ -17,10 +17,12 @@ package executor
 import (
 	"context"
 	"math"
+	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/tidb/distsql"
+	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/kv"
@@ -29,12 +31,14 @@ import (
 	"github.com/pingcap/tidb/parser/terror"
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/sessiontxn"
 	"github.com/pingcap/tidb/statistics"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/admin"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/ranger"
@@ -45,8 +49,11 @@ import (
 
 var (
 	_ Executor = &CheckIndexRangeExec{}
+	_ Executor = &CheckGlobalIndexExec{}
 	_ Executor = &RecoverIndexExec{}
 	_ Executor = &CleanupIndexExec{}
+	_ Executor = &VerifyIndexExec{}
+	_ Executor = &WorkloadSimulatorExec{}
 )
 
 // CheckIndexRangeExec outputs the index values which has handle between begin and end.
@@ -175,6 +182,35 @@ func (e *CheckIndexRangeExec) Close() error {
 	return nil
 }
 
+// CheckGlobalIndexExec checks the consistency between a global index and the records of the partitions.
+// It is built from the "admin check global index" statement.
+type CheckGlobalIndexExec struct {
+	baseExecutor
+
+	table table.PartitionedTable
+	index table.Index
+	done  bool
+}
+
+// Open implements the Executor Open interface.
+func (e *CheckGlobalIndexExec) Open(ctx context.Context) error {
+	e.done = false
+	return e.baseExecutor.Open(ctx)
+}
+
+// Next implements the Executor Next interface.
+func (e *CheckGlobalIndexExec) Next(ctx context.Context, _ *chunk.Chunk) error {
+	if e.done {
+		return nil
+	}
+	e.done = true
+	txn, err := e.ctx.Txn(true)
+	if err != nil {
+		return err
+	}
+	return admin.CheckGlobalIndex(ctx, e.ctx, txn, e.table, e.index)
+}
+
 // RecoverIndexExec represents a recover index executor.
 // It is built from "admin recover index" statement, is used to backfill
 // corrupted index.
@@ -891,3 +927,46 @@ func (e *CleanupIndexExec) constructLimitPB() *tipb.Executor {
 func (e *CleanupIndexExec) Close() error {
 	return nil
 }
+
+// VerifyIndexExec represents a verify index executor.
+// It is built from "admin verify index" statement, it verifies the index
+// against the table data in a DDL job, and outputs the result of the job.
+type VerifyIndexExec struct {
+	baseExecutor
+
+	done      bool
+	table     *ast.TableName
+	indexName model.CIStr
+	rate      string
+	repair    bool
+}
+
+// Next implements the Executor Next interface.
+func (e *VerifyIndexExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+
+	// The verification runs in a DDL job, commit the previous transaction like the other DDL statements.
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnDDL)
+	if err := sessiontxn.NewTxnInStmt(ctx, e.ctx); err != nil {
+		return err
+	}
+	defer func() {
+		e.ctx.GetSessionVars().StmtCtx.IsDDLJobInQueue = false
+	}()
+	ident := ast.Ident{Schema: e.table.Schema, Name: e.table.Name}
+	result, err := domain.GetDomain(e.ctx).DDL().VerifyIndex(e.ctx, ident, e.indexName, e.rate, e.repair)
+	if err != nil {
+		return err
+	}
+	req.AppendString(0, e.table.Name.O)
+	req.AppendString(1, e.indexName.O)
+	req.AppendInt64(2, result.CheckedRows)
+	req.AppendInt64(3, result.InconsistentRows)
+	req.AppendInt64(4, result.RepairedRows)
+	req.AppendString(5, strings.Join(result.InconsistentHandles, ","))
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/domain"
 	mysql "github.com/pingcap/tidb/errno"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/auth"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/session"
@@ -605,6 +606,48 @@ func TestAdminRecoverIndexEdge(t *testing.T) {
 	tk.MustQuery("admin recover index t col;").Check(testkit.Rows("0 1"))
 }
 
+func TestAdminVerifyIndex(t *testing.T) {
+	store, domain := testkit.CreateMockStoreAndDomain(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists admin_verify")
+	tk.MustExec("create table admin_verify (c1 int primary key, c2 int, c3 int, unique key(c2), key(c3))")
+	tk.MustExec("insert admin_verify values (1, 1, 1), (2, 2, 2), (3, 3, 3), (4, NULL, 4)")
+
+	tk.MustQuery("admin verify index admin_verify c2").Check(testkit.Rows("admin_verify c2 4 0 0 "))
+	tk.MustGetErrCode("admin verify index admin_verify c4", mysql.ErrKeyDoesNotExist)
+	tk.MustContainErrMsg("admin verify index admin_verify c3 rate = 'abc'", "invalid max reorg rate")
+
+	// Remove the entries of some rows, and make the unique entry of a row point to another row.
+	ctx := mock.NewContext()
+	ctx.Store = store
+	tbl, err := domain.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("admin_verify"))
+	require.NoError(t, err)
+	tblInfo := tbl.Meta()
+	indexOpr2 := tables.NewIndex(tblInfo.ID, tblInfo, tblInfo.FindIndexByName("c2"))
+	indexOpr3 := tables.NewIndex(tblInfo.ID, tblInfo, tblInfo.FindIndexByName("c3"))
+	sc := ctx.GetSessionVars().StmtCtx
+	txn, err := store.Begin()
+	require.NoError(t, err)
+	require.NoError(t, indexOpr3.Delete(sc, txn, types.MakeDatums(2), kv.IntHandle(2)))
+	require.NoError(t, indexOpr3.Delete(sc, txn, types.MakeDatums(3), kv.IntHandle(3)))
+	require.NoError(t, indexOpr2.Delete(sc, txn, types.MakeDatums(1), kv.IntHandle(1)))
+	_, err = indexOpr2.Create(ctx, txn, types.MakeDatums(1), kv.IntHandle(100), nil)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit(context.Background()))
+
+	// The verification doesn't change the index without REPAIR.
+	tk.MustQuery("admin verify index admin_verify c3 rate = '1MB/s'").Check(testkit.Rows("admin_verify c3 4 2 0 2,3"))
+	require.Error(t, tk.ExecToErr("admin check index admin_verify c3"))
+	tk.MustQuery("admin verify index admin_verify c3 repair").Check(testkit.Rows("admin_verify c3 4 2 2 2,3"))
+	tk.MustExec("admin check index admin_verify c3")
+	tk.MustQuery("admin verify index admin_verify c3").Check(testkit.Rows("admin_verify c3 4 0 0 "))
+
+	// The unique entry pointing to another row can't be repaired.
+	tk.MustQuery("admin verify index admin_verify c2 repair").Check(testkit.Rows("admin_verify c2 4 1 0 1"))
+}
+
 func TestAdminCleanupIndex(t *testing.T) {
 	store, domain := testkit.CreateMockStoreAndDomain(t)
 
@@ -1730,3 +1773,51 @@ func TestAdminCheckTableFailed(t *testing.T) {
 	require.NoError(t, err)
 	tk.MustExec("admin check table admin_test")
 }
+
+func TestAdminWorkloadSimulator(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t_sim, t_sim_rowid")
+	tk.MustExec("create table t_sim (id int primary key, c int)")
+	tk.MustExec("insert into t_sim values (1, 1), (2, 2), (3, 3), (4, 4)")
+
+	rows := tk.MustQuery("admin workload simulator t_sim ddl = 'alter table t_sim add index idx_c(c)' concurrency = 2").Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, "BASELINE", rows[0][0])
+	require.Equal(t, "200", rows[0][1])
+	require.Equal(t, "0", rows[0][2])
+	require.Equal(t, "DDL", rows[1][0])
+	require.Equal(t, "0", rows[1][2])
+	tk.MustExec("admin check index t_sim idx_c")
+	// The load doesn't change the data.
+	tk.MustQuery("select * from t_sim").Sort().Check(testkit.Rows("1 1", "2 2", "3 3", "4 4"))
+
+	// The tables without the integer primary key are located by the row IDs.
+	tk.MustExec("create table t_sim_rowid (a varchar(10), b int)")
+	tk.MustContainErrMsg("admin workload simulator t_sim_rowid ddl = 'alter table t_sim_rowid add index idx_b(b)'", "is empty")
+	tk.MustExec("insert into t_sim_rowid values ('a', 1), ('b', 2)")
+	rows = tk.MustQuery("admin workload simulator t_sim_rowid ddl = 'alter table t_sim_rowid modify b bigint' rate = 1000").Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, "0", rows[0][2])
+	tk.MustQuery("select * from t_sim_rowid").Sort().Check(testkit.Rows("a 1", "b 2"))
+
+	tk.MustContainErrMsg("admin workload simulator t_sim ddl = 'select 1'", "only runs ALTER TABLE, CREATE INDEX and DROP INDEX statements")
+	tk.MustContainErrMsg("admin workload simulator t_sim ddl = 'drop table t_sim_rowid'", "only runs ALTER TABLE, CREATE INDEX and DROP INDEX statements")
+	tk.MustContainErrMsg("admin workload simulator t_sim ddl = 'alter table t_sim_rowid add index idx_b(b)'", "should change the table test.t_sim")
+	tk.MustContainErrMsg("admin workload simulator t_sim ddl = 'create index idx_c2 on mysql.user(host)'", "should change the table test.t_sim")
+	tk.MustContainErrMsg("admin workload simulator t_sim ddl = 'alter table t_sim rename to t_sim2'", "doesn't run the DDL changing other tables")
+	tk.MustContainErrMsg("admin workload simulator t_sim ddl = 'alter table t_sim add index idx(c)' concurrency = 1000", "should be no more than 256")
+	tk.MustGetErrCode("admin workload simulator t_sim ddl = 'alter table t_sim drop index idx_none'", mysql.ErrCantDropFieldOrKey)
+
+	// The DDL and the load are run by the internal sessions, so the privileges they need are checked for the user.
+	tk.MustExec("create user 'sim_user'@'%'")
+	tk.MustExec("grant super on *.* to 'sim_user'@'%'")
+	tk.MustExec("grant select, update on test.t_sim to 'sim_user'@'%'")
+	userTk := testkit.NewTestKit(t, store)
+	require.NoError(t, userTk.Session().Auth(&auth.UserIdentity{Username: "sim_user", Hostname: "%"}, nil, nil))
+	userTk.MustGetErrCode("admin workload simulator test.t_sim ddl = 'alter table t_sim add index idx_c3(c)'", mysql.ErrTableaccessDenied)
+	tk.MustExec("grant alter on test.t_sim to 'sim_user'@'%'")
+	require.Len(t, userTk.MustQuery("admin workload simulator test.t_sim ddl = 'alter table t_sim add index idx_c3(c)'").Rows(), 2)
+}
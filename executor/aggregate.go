@@ -255,6 +255,9 @@ func (e *HashAggExec) Close() error {
 		if e.memTracker != nil {
 			e.memTracker.ReplaceBytesUsed(0)
 		}
+		if e.spillAction != nil {
+			e.registerSpillStats(int(e.spillAction.spillTimes), e.diskTracker)
+		}
 		if e.listInDisk != nil {
 			firstErr = e.listInDisk.Close()
 		}
@@ -16,6 +16,7 @@ package executor_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/rand"
@@ -1488,6 +1489,20 @@ func TestAggInDisk(t *testing.T) {
 			require.True(t, strings.Contains(disk, "MB") ||
 				strings.Contains(disk, "KB") ||
 				strings.Contains(disk, "Bytes"))
+			require.Contains(t, line, "spill:{rounds:")
+		}
+	}
+	resJSON := tk.MustQuery("explain analyze format = tidb_json select /*+ HASH_AGG() */ avg(t1.a) from t t1 join t t2 group by t1.a, t2.a;").Rows()
+	j := new([]*plannercore.ExplainInfoForEncode)
+	require.NoError(t, json.Unmarshal([]byte(resJSON[0][0].(string)), j))
+	for _, row := range *j {
+		for _, op := range flatJSONPlan(row) {
+			if strings.HasPrefix(op.ID, "HashAgg") {
+				require.Greater(t, op.MaxMemoryBytes, int64(0))
+				require.Greater(t, op.MaxDiskBytes, int64(0))
+				require.Greater(t, op.SpillRounds, int64(0))
+				require.Greater(t, op.SpilledBytes, int64(0))
+			}
 		}
 	}
 
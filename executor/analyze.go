@@ -55,6 +55,9 @@ type AnalyzeExec struct {
 	wg         util.WaitGroupWrapper
 	opts       map[ast.AnalyzeOptionType]uint64
 	OptionsMap map[int64]core.V2AnalyzeOptions
+	// exprStats are the histograms on expressions to be registered before the tasks, or to be dropped.
+	exprStats     []core.AnalyzeExprStats
+	dropExprStats bool
 }
 
 var (
@@ -84,6 +87,17 @@ const (
 // Next implements the Executor Next interface.
 func (e *AnalyzeExec) Next(ctx context.Context, _ *chunk.Chunk) error {
 	statsHandle := domain.GetDomain(e.ctx).StatsHandle()
+	for _, s := range e.exprStats {
+		var err error
+		if e.dropExprStats {
+			err = statsHandle.MarkExtendedStatsDeleted(s.Name, s.TableID, false)
+		} else {
+			err = statsHandle.InsertExprStats(s.Name, s.ColIDs, s.Expr, s.TableID)
+		}
+		if err != nil {
+			return err
+		}
+	}
 	var tasks []*analyzeTask
 	tids := make([]int64, 0)
 	skipedTables := make([]string, 0)
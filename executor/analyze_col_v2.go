@@ -416,6 +416,16 @@ func (e *AnalyzeColumnsExecV2) buildSamplingStats(
 		if err != nil {
 			return 0, nil, nil, nil, nil, err
 		}
+		extStats, err = statsHandle.BuildExprStats(e.ctx, e.TableID.GetStatisticsID(), e.colsInfo, rootRowCollector.Base().Samples,
+			count, int(e.opts[ast.AnalyzeOptNumBuckets]), int(e.opts[ast.AnalyzeOptNumTopN]), extStats)
+		if err != nil {
+			return 0, nil, nil, nil, nil, err
+		}
+		extStats, err = statsHandle.BuildMultiColumnSketches(e.ctx, e.TableID.GetStatisticsID(), e.colsInfo, rootRowCollector.Base().Samples,
+			count, int(e.opts[ast.AnalyzeOptNumTopN]), extStats)
+		if err != nil {
+			return 0, nil, nil, nil, nil, err
+		}
 	}
 	return
 }
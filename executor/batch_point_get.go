@@ -124,28 +124,34 @@ type cacheTableSnapshot struct {
 
 func (s cacheTableSnapshot) BatchGet(ctx context.Context, keys []kv.Key) (map[string][]byte, error) {
 	values := make(map[string][]byte)
-	if s.memBuffer == nil {
-		return values, nil
-	}
-
-	for _, key := range keys {
-		val, err := s.memBuffer.Get(ctx, key)
-		if kv.ErrNotExist.Equal(err) {
-			continue
+	err := s.BatchGetVisit(ctx, keys, func(idx int, val []byte) error {
+		if len(val) > 0 {
+			values[string(keys[idx])] = val
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
 
-		if err != nil {
-			return nil, err
+// BatchGetVisit implements the kv.BatchGetVisitor interface.
+func (s cacheTableSnapshot) BatchGetVisit(ctx context.Context, keys []kv.Key, fn func(idx int, val []byte) error) error {
+	for i, key := range keys {
+		var val []byte
+		if s.memBuffer != nil {
+			var err error
+			val, err = s.memBuffer.Get(ctx, key)
+			if err != nil && !kv.ErrNotExist.Equal(err) {
+				return err
+			}
 		}
-
-		if len(val) == 0 {
-			continue
+		if err := fn(i, val); err != nil {
+			return err
 		}
-
-		values[string(key)] = val
 	}
-
-	return values, nil
+	return nil
 }
 
 func (s cacheTableSnapshot) Get(ctx context.Context, key kv.Key) ([]byte, error) {
@@ -165,6 +171,9 @@ func (e *BatchPointGetExec) Close() error {
 	if e.runtimeStats != nil && e.snapshot != nil {
 		e.snapshot.SetOption(kv.CollectRuntimeStats, nil)
 	}
+	if e.inited == 1 {
+		storeIndexUsage(e.ctx, e.id, e.tblInfo, e.idxInfo)
+	}
 	e.inited = 0
 	e.index = 0
 	return nil
@@ -211,7 +220,6 @@ func datumsContainNull(vals []types.Datum) bool {
 }
 
 func (e *BatchPointGetExec) initialize(ctx context.Context) error {
-	var handleVals map[string][]byte
 	var indexKeys []kv.Key
 	var err error
 	batchGetter := e.batchGetter
@@ -272,25 +280,20 @@ func (e *BatchPointGetExec) initialize(ctx context.Context) error {
 			return nil
 		}
 
-		// Fetch all handles.
-		handleVals, err = batchGetter.BatchGet(ctx, toFetchIndexKeys)
-		if err != nil {
-			return err
-		}
-
 		e.handles = make([]kv.Handle, 0, len(toFetchIndexKeys))
 		if e.tblInfo.Partition != nil {
 			e.physIDs = make([]int64, 0, len(toFetchIndexKeys))
 		}
-		for _, key := range toFetchIndexKeys {
-			handleVal := handleVals[string(key)]
+		// Fetch all handles, they're decoded from the index values as they're visited.
+		err = kv.VisitBatchGet(ctx, batchGetter, toFetchIndexKeys, func(idx int, handleVal []byte) error {
 			if len(handleVal) == 0 {
-				continue
+				return nil
 			}
 			handle, err1 := tablecodec.DecodeHandleInUniqueIndexValue(handleVal, e.tblInfo.IsCommonHandle)
 			if err1 != nil {
 				return err1
 			}
+			key := toFetchIndexKeys[idx]
 			e.handles = append(e.handles, handle)
 			if rc {
 				indexKeys = append(indexKeys, key)
@@ -302,6 +305,10 @@ func (e *BatchPointGetExec) initialize(ctx context.Context) error {
 					e.updateDeltaForTableID(pid)
 				}
 			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 
 		// The injection is used to simulate following scenario:
@@ -383,7 +390,6 @@ func (e *BatchPointGetExec) initialize(ctx context.Context) error {
 	}
 	e.handles = newHandles
 
-	var values map[string][]byte
 	// Lock keys (include exists and non-exists keys) before fetch all values for Repeatable Read Isolation.
 	if e.lock && !rc {
 		lockKeys := make([]kv.Key, len(keys)+len(indexKeys))
@@ -394,19 +400,15 @@ func (e *BatchPointGetExec) initialize(ctx context.Context) error {
 			return err
 		}
 	}
-	// Fetch all values.
-	values, err = batchGetter.BatchGet(ctx, keys)
-	if err != nil {
-		return err
-	}
-	handles := make([]kv.Handle, 0, len(values))
+	handles := make([]kv.Handle, 0, len(keys))
 	var existKeys []kv.Key
 	if e.lock && rc {
-		existKeys = make([]kv.Key, 0, 2*len(values))
+		existKeys = make([]kv.Key, 0, 2*len(keys))
 	}
-	e.values = make([][]byte, 0, len(values))
-	for i, key := range keys {
-		val := values[string(key)]
+	e.values = make([][]byte, 0, len(keys))
+	// Fetch all values.
+	err = kv.VisitBatchGet(ctx, batchGetter, keys, func(i int, val []byte) error {
+		key := keys[i]
 		if len(val) == 0 {
 			if e.idxInfo != nil && (!e.tblInfo.IsCommonHandle || !e.idxInfo.Primary) &&
 				!e.ctx.GetSessionVars().StmtCtx.WeakConsistency {
@@ -427,7 +429,7 @@ func (e *BatchPointGetExec) initialize(ctx context.Context) error {
 					[]consistency.RecordData{{}},
 				)
 			}
-			continue
+			return nil
 		}
 		e.values = append(e.values, val)
 		handles = append(handles, e.handles[i])
@@ -440,6 +442,10 @@ func (e *BatchPointGetExec) initialize(ctx context.Context) error {
 				existKeys = append(existKeys, indexKeys[i])
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 	// Lock exists keys only for Read Committed Isolation.
 	if e.lock && rc {
@@ -552,19 +558,35 @@ type cacheBatchGetter struct {
 }
 
 func (b *cacheBatchGetter) BatchGet(ctx context.Context, keys []kv.Key) (map[string][]byte, error) {
-	cacheDB := b.ctx.GetStore().GetMemCache()
 	vals := make(map[string][]byte)
-	for _, key := range keys {
+	err := b.BatchGetVisit(ctx, keys, func(idx int, val []byte) error {
+		if val != nil {
+			vals[string(keys[idx])] = val
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vals, nil
+}
+
+// BatchGetVisit implements the kv.BatchGetVisitor interface.
+func (b *cacheBatchGetter) BatchGetVisit(ctx context.Context, keys []kv.Key, fn func(idx int, val []byte) error) error {
+	cacheDB := b.ctx.GetStore().GetMemCache()
+	for i, key := range keys {
 		val, err := cacheDB.UnionGet(ctx, b.tid, b.snapshot, key)
 		if err != nil {
 			if !kv.ErrNotExist.Equal(err) {
-				return nil, err
+				return err
 			}
-			continue
+			val = nil
+		}
+		if err = fn(i, val); err != nil {
+			return err
 		}
-		vals[string(key)] = val
 	}
-	return vals, nil
+	return nil
 }
 
 func newCacheBatchGetter(ctx sessionctx.Context, tid int64, snapshot kv.Snapshot) *cacheBatchGetter {
@@ -158,14 +158,22 @@ func (b *executorBuilder) build(p plannercore.Plan) Executor {
 		return b.buildRecoverIndex(v)
 	case *plannercore.CleanupIndex:
 		return b.buildCleanupIndex(v)
+	case *plannercore.VerifyIndex:
+		return b.buildVerifyIndex(v)
+	case *plannercore.WorkloadSimulator:
+		return b.buildWorkloadSimulator(v)
 	case *plannercore.CheckIndexRange:
 		return b.buildCheckIndexRange(v)
+	case *plannercore.CheckGlobalIndex:
+		return b.buildCheckGlobalIndex(v)
 	case *plannercore.ChecksumTable:
 		return b.buildChecksumTable(v)
 	case *plannercore.ReloadExprPushdownBlacklist:
 		return b.buildReloadExprPushdownBlacklist(v)
 	case *plannercore.ReloadOptRuleBlacklist:
 		return b.buildReloadOptRuleBlacklist(v)
+	case *plannercore.ReloadQueryRewriteRules:
+		return b.buildReloadQueryRewriteRules(v)
 	case *plannercore.AdminPlugins:
 		return b.buildAdminPlugins(v)
 	case *plannercore.DDL:
@@ -212,10 +220,16 @@ func (b *executorBuilder) build(p plannercore.Plan) Executor {
 		return b.buildShowDDL(v)
 	case *plannercore.PhysicalShowDDLJobs:
 		return b.buildShowDDLJobs(v)
+	case *plannercore.PhysicalTableFunction:
+		return b.buildTableFunction(v)
 	case *plannercore.ShowDDLJobQueries:
 		return b.buildShowDDLJobQueries(v)
 	case *plannercore.ShowDDLJobQueriesWithRange:
 		return b.buildShowDDLJobQueriesWithRange(v)
+	case *plannercore.ShowDDLDependencies:
+		return b.buildShowDDLDependencies(v)
+	case *plannercore.ExplainRollbackDDLJob:
+		return b.buildExplainRollbackDDLJob(v)
 	case *plannercore.ShowSlow:
 		return b.buildShowSlow(v)
 	case *plannercore.PhysicalShow:
@@ -290,6 +304,8 @@ func (b *executorBuilder) build(p plannercore.Plan) Executor {
 		return b.buildSelectInto(v)
 	case *plannercore.AdminShowTelemetry:
 		return b.buildAdminShowTelemetry(v)
+	case *plannercore.AdminShowUnusedIndexes:
+		return b.buildAdminShowUnusedIndexes(v)
 	case *plannercore.AdminResetTelemetryID:
 		return b.buildAdminResetTelemetryID(v)
 	case *plannercore.PhysicalCTE:
@@ -377,6 +393,19 @@ func (b *executorBuilder) buildShowDDLJobs(v *plannercore.PhysicalShowDDLJobs) E
 	return e
 }
 
+func (b *executorBuilder) buildTableFunction(v *plannercore.PhysicalTableFunction) Executor {
+	retriever, ok := tableFunctionRetrievers[v.FuncName.O]
+	if !ok {
+		b.err = errors.Errorf("table function %s is not implemented", v.FuncName.O)
+		return nil
+	}
+	return &TableFunctionExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		args:         v.Args,
+		retriever:    retriever,
+	}
+}
+
 func (b *executorBuilder) buildShowDDLJobQueries(v *plannercore.ShowDDLJobQueries) Executor {
 	e := &ShowDDLJobQueriesExec{
 		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
@@ -394,6 +423,22 @@ func (b *executorBuilder) buildShowDDLJobQueriesWithRange(v *plannercore.ShowDDL
 	return e
 }
 
+func (b *executorBuilder) buildShowDDLDependencies(v *plannercore.ShowDDLDependencies) Executor {
+	e := &ShowDDLDependenciesExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
+	}
+	return e
+}
+
+func (b *executorBuilder) buildExplainRollbackDDLJob(v *plannercore.ExplainRollbackDDLJob) Executor {
+	e := &ExplainRollbackDDLJobExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		is:           b.is,
+		jobID:        v.JobID,
+	}
+	return e
+}
+
 func (b *executorBuilder) buildShowSlow(v *plannercore.ShowSlow) Executor {
 	e := &ShowSlowExec{
 		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
@@ -604,6 +649,36 @@ func (b *executorBuilder) buildCleanupIndex(v *plannercore.CleanupIndex) Executo
 	return e
 }
 
+func (b *executorBuilder) buildVerifyIndex(v *plannercore.VerifyIndex) Executor {
+	return &VerifyIndexExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		table:        v.Table,
+		indexName:    model.NewCIStr(v.IndexName),
+		rate:         v.Rate,
+		repair:       v.Repair,
+	}
+}
+
+func (b *executorBuilder) buildWorkloadSimulator(v *plannercore.WorkloadSimulator) Executor {
+	tb, err := b.is.TableByName(v.Table.Schema, v.Table.Name)
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	e := &WorkloadSimulatorExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		table:        v.Table,
+		tblInfo:      tb.Meta(),
+		ddl:          v.DDL,
+		concurrency:  v.Concurrency,
+		rate:         v.Rate,
+	}
+	if e.concurrency == 0 {
+		e.concurrency = defaultWorkloadSimulatorConcurrency
+	}
+	return e
+}
+
 func (b *executorBuilder) buildCheckIndexRange(v *plannercore.CheckIndexRange) Executor {
 	tb, err := b.is.TableByName(v.Table.Schema, v.Table.Name)
 	if err != nil {
@@ -627,6 +702,35 @@ func (b *executorBuilder) buildCheckIndexRange(v *plannercore.CheckIndexRange) E
 	return e
 }
 
+func (b *executorBuilder) buildCheckGlobalIndex(v *plannercore.CheckGlobalIndex) Executor {
+	tb, err := b.is.TableByName(v.Table.Schema, v.Table.Name)
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	pt, ok := tb.(table.PartitionedTable)
+	if !ok {
+		b.err = errors.Errorf("table `%v` is not a partitioned table", v.Table.Name.O)
+		return nil
+	}
+	idxName := strings.ToLower(v.IndexName)
+	e := &CheckGlobalIndexExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		table:        pt,
+	}
+	for _, idx := range tb.Indices() {
+		if idx.Meta().Name.L == idxName && idx.Meta().Global {
+			e.index = idx
+			break
+		}
+	}
+	if e.index == nil {
+		b.err = errors.Errorf("global index `%v` is not found in table `%v`", v.IndexName, v.Table.Name.O)
+		return nil
+	}
+	return e
+}
+
 func (b *executorBuilder) buildChecksumTable(v *plannercore.ChecksumTable) Executor {
 	e := &ChecksumTableExec{
 		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
@@ -652,6 +756,10 @@ func (b *executorBuilder) buildReloadOptRuleBlacklist(v *plannercore.ReloadOptRu
 	return &ReloadOptRuleBlacklistExec{baseExecutor{ctx: b.ctx}}
 }
 
+func (b *executorBuilder) buildReloadQueryRewriteRules(v *plannercore.ReloadQueryRewriteRules) Executor {
+	return &ReloadQueryRewriteRulesExec{baseExecutor{ctx: b.ctx}}
+}
+
 func (b *executorBuilder) buildAdminPlugins(v *plannercore.AdminPlugins) Executor {
 	return &AdminPluginsExec{baseExecutor: baseExecutor{ctx: b.ctx}, Action: v.Action, Plugins: v.Plugins}
 }
@@ -1098,7 +1206,7 @@ func (b *executorBuilder) setTelemetryInfo(v *plannercore.DDL) {
 				b.Ti.PartitionTelemetry.UseAddIntervalPartition = true
 			case ast.AlterTableExchangePartition:
 				b.Ti.UseExchangePartition = true
-			case ast.AlterTableReorganizePartition:
+			case ast.AlterTableReorganizePartition, ast.AlterTableSplitPartition, ast.AlterTableMergePartitions:
 				if b.Ti.PartitionTelemetry == nil {
 					b.Ti.PartitionTelemetry = &PartitionTelemetryInfo{}
 				}
@@ -1434,6 +1542,9 @@ func (b *executorBuilder) buildMergeJoin(v *plannercore.PhysicalMergeJoin) Execu
 }
 
 func (b *executorBuilder) buildHashJoin(v *plannercore.PhysicalHashJoin) Executor {
+	if v.PartitionWise {
+		return b.buildPartitionWiseHashJoin(v)
+	}
 	leftExec := b.build(v.Children()[0])
 	if b.err != nil {
 		return nil
@@ -1443,7 +1554,96 @@ func (b *executorBuilder) buildHashJoin(v *plannercore.PhysicalHashJoin) Executo
 	if b.err != nil {
 		return nil
 	}
+	exec := b.buildHashJoinWithChildren(v, leftExec, rightExec)
+	if e, ok := exec.(*HashJoinExec); ok && v.AdaptiveIndexJoin != nil && e.runtimeFilter == nil {
+		e.adaptive = b.buildAdaptiveIndexJoin(v)
+	}
+	return exec
+}
+
+// buildAdaptiveIndexJoin builds the index join which the hash join switches to, it has its own outer side executors
+// which are opened only if the join switches.
+func (b *executorBuilder) buildAdaptiveIndexJoin(v *plannercore.PhysicalHashJoin) *adaptiveIndexJoin {
+	indexJoin := b.build(v.AdaptiveIndexJoin)
+	if b.err != nil {
+		return nil
+	}
+	estRows := int(v.Children()[v.InnerChildIdx].StatsCount() * adaptiveJoinBuildRowsRatio)
+	return &adaptiveIndexJoin{
+		indexJoin:    indexJoin,
+		maxBuildRows: mathutil.Max(estRows, b.ctx.GetSessionVars().MaxChunkSize),
+	}
+}
 
+// buildPartitionWiseHashJoin builds a hash join for each pair of the corresponding partitions of the children, and
+// unions the results of the joins. The partitions pruned on one side are read as empty.
+func (b *executorBuilder) buildPartitionWiseHashJoin(v *plannercore.PhysicalHashJoin) Executor {
+	lReader, rReader := v.Children()[0].(*plannercore.PhysicalTableReader), v.Children()[1].(*plannercore.PhysicalTableReader)
+	lTS, err := lReader.GetTableScan()
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	rTS, err := rReader.GetTableScan()
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	lDefs, rDefs := lTS.Table.GetPartitionInfo().Definitions, rTS.Table.GetPartitionInfo().Definitions
+	joins := make([]Executor, 0, len(lDefs))
+	for i := range lDefs {
+		leftExec := b.buildTableReaderOfPartition(lReader, lDefs[i].ID)
+		if b.err != nil {
+			return nil
+		}
+		rightExec := b.buildTableReaderOfPartition(rReader, rDefs[i].ID)
+		if b.err != nil {
+			return nil
+		}
+		_, leftIsDual := leftExec.(*TableDualExec)
+		_, rightIsDual := rightExec.(*TableDualExec)
+		if leftIsDual && rightIsDual {
+			continue
+		}
+		join := b.buildHashJoinWithChildren(v, leftExec, rightExec)
+		if b.err != nil {
+			return nil
+		}
+		joins = append(joins, join)
+	}
+	if len(joins) == 0 {
+		return &TableDualExec{baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID())}
+	}
+	// The union doesn't record the runtime stats, which are recorded by the joins with the same plan ID.
+	return &UnionExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), 0, joins...),
+		concurrency:  b.ctx.GetSessionVars().UnionConcurrency(),
+	}
+}
+
+// buildTableReaderOfPartition builds the table reader which only reads the given partition, or a dual if the partition
+// is pruned.
+func (b *executorBuilder) buildTableReaderOfPartition(v *plannercore.PhysicalTableReader, pid int64) Executor {
+	exec := b.build(v)
+	if b.err != nil {
+		return nil
+	}
+	reader, ok := exec.(*TableReaderExecutor)
+	if !ok {
+		return exec
+	}
+	if rb, ok := reader.kvRangeBuilder.(kvRangeBuilderFromRangeAndPartition); ok {
+		for _, p := range rb.partitions {
+			if p.GetPhysicalID() == pid {
+				reader.kvRangeBuilder = kvRangeBuilderFromRangeAndPartition{sctx: rb.sctx, partitions: []table.PhysicalTable{p}}
+				return reader
+			}
+		}
+	}
+	return &TableDualExec{baseExecutor: *reader.base()}
+}
+
+func (b *executorBuilder) buildHashJoinWithChildren(v *plannercore.PhysicalHashJoin, leftExec, rightExec Executor) Executor {
 	e := &HashJoinExec{
 		baseExecutor:          newBaseExecutor(b.ctx, v.Schema(), v.ID(), leftExec, rightExec),
 		probeSideTupleFetcher: &probeSideTupleFetcher{},
@@ -1536,6 +1736,10 @@ func (b *executorBuilder) buildHashJoin(v *plannercore.PhysicalHashJoin) Executo
 	}
 	e.buildWorker.buildKeyColIdx, e.buildWorker.buildNAKeyColIdx, e.buildWorker.buildSideExec, e.buildWorker.hashJoinCtx = buildKeyColIdx, buildNAKeyColIdx, buildSideExec, e.hashJoinCtx
 	e.hashJoinCtx.isNullAware = isNAJoin
+	e.hashJoinCtx.hasOtherCondition = len(v.OtherConditions) > 0
+	if v.RuntimeFilter != nil {
+		e.hashJoinCtx.runtimeFilter = newJoinRuntimeFilter(e.probeSideTupleFetcher.probeSideExec, v.RuntimeFilter.ProbeCol, buildKeyColIdx[v.RuntimeFilter.KeyIdx])
+	}
 	executor_metrics.ExecutorCountHashJoinExec.Inc()
 
 	// We should use JoinKey to construct the type information using by hashing, instead of using the child's schema directly.
@@ -1707,6 +1911,9 @@ func (b *executorBuilder) buildSelection(v *plannercore.PhysicalSelection) Execu
 	if b.err != nil {
 		return nil
 	}
+	if cte, ok := childExec.(*CTEExec); ok {
+		cte.setupProbeIndex(v.Conditions)
+	}
 	e := &SelectionExec{
 		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID(), childExec),
 		filters:      v.Conditions,
@@ -1961,7 +2168,12 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) Executo
 			strings.ToLower(infoschema.TableMemoryUsageOpsHistory),
 			strings.ToLower(infoschema.ClusterTableMemoryUsage),
 			strings.ToLower(infoschema.ClusterTableMemoryUsageOpsHistory),
-			strings.ToLower(infoschema.TableResourceGroups):
+			strings.ToLower(infoschema.TableResourceGroups),
+			strings.ToLower(infoschema.TableTiDBTempPaths),
+			strings.ToLower(infoschema.ClusterTableTiDBTempPaths),
+			strings.ToLower(infoschema.TableDDLQueue),
+			strings.ToLower(infoschema.TableDeleteRanges),
+			strings.ToLower(infoschema.TableOptimizerTrace):
 			return &MemTableReaderExec{
 				baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
 				table:        v.Table,
@@ -2224,6 +2436,9 @@ func (b *executorBuilder) buildUnionAll(v *plannercore.PhysicalUnionAll) Executo
 			return nil
 		}
 	}
+	if len(v.SortItems) > 0 {
+		return buildMergeUnionAll(b.ctx, v, childExecs)
+	}
 	e := &UnionExec{
 		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID(), childExecs...),
 		concurrency:  b.ctx.GetSessionVars().UnionConcurrency(),
@@ -2231,6 +2446,21 @@ func (b *executorBuilder) buildUnionAll(v *plannercore.PhysicalUnionAll) Executo
 	return e
 }
 
+func buildMergeUnionAll(ctx sessionctx.Context, v *plannercore.PhysicalUnionAll, childExecs []Executor) *MergeUnionExec {
+	e := &MergeUnionExec{
+		baseExecutor: newBaseExecutor(ctx, v.Schema(), v.ID(), childExecs...),
+		keyColumns:   make([]int, 0, len(v.SortItems)),
+		keyCmpFuncs:  make([]chunk.CompareFunc, 0, len(v.SortItems)),
+		keyDesc:      make([]bool, 0, len(v.SortItems)),
+	}
+	for _, item := range v.SortItems {
+		e.keyColumns = append(e.keyColumns, item.Col.Index)
+		e.keyCmpFuncs = append(e.keyCmpFuncs, chunk.GetCompareFunc(item.Col.RetType))
+		e.keyDesc = append(e.keyDesc, item.Desc)
+	}
+	return e
+}
+
 func buildHandleColsForSplit(sc *stmtctx.StatementContext, tbInfo *model.TableInfo) plannercore.HandleCols {
 	if tbInfo.IsCommonHandle {
 		primaryIdx := tables.FindPrimaryIndex(tbInfo)
@@ -2944,10 +3174,12 @@ func (b *executorBuilder) buildAnalyzeFastIndex(e *AnalyzeExec, task plannercore
 
 func (b *executorBuilder) buildAnalyze(v *plannercore.Analyze) Executor {
 	e := &AnalyzeExec{
-		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
-		tasks:        make([]*analyzeTask, 0, len(v.ColTasks)+len(v.IdxTasks)),
-		opts:         v.Opts,
-		OptionsMap:   v.OptionsMap,
+		baseExecutor:  newBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		tasks:         make([]*analyzeTask, 0, len(v.ColTasks)+len(v.IdxTasks)),
+		opts:          v.Opts,
+		OptionsMap:    v.OptionsMap,
+		exprStats:     v.ExprStats,
+		dropExprStats: v.DropExprStats,
 	}
 	enableFastAnalyze := b.ctx.GetSessionVars().EnableFastAnalyze
 	autoAnalyze := ""
@@ -3682,27 +3914,29 @@ func buildNoRangeIndexReader(b *executorBuilder, v *plannercore.PhysicalIndexRea
 	}
 	paging := b.ctx.GetSessionVars().EnablePaging
 	e := &IndexReaderExecutor{
-		baseExecutor:     newBaseExecutor(b.ctx, v.Schema(), v.ID()),
-		dagPB:            dagReq,
-		startTS:          startTS,
-		txnScope:         b.txnScope,
-		readReplicaScope: b.readReplicaScope,
-		isStaleness:      b.isStaleness,
-		netDataSize:      v.GetNetDataSize(),
-		physicalTableID:  physicalTableID,
-		table:            tbl,
-		index:            is.Index,
-		keepOrder:        is.KeepOrder,
-		desc:             is.Desc,
-		columns:          is.Columns,
-		byItems:          is.ByItems,
-		paging:           paging,
-		corColInFilter:   b.corColInDistPlan(v.IndexPlans),
-		corColInAccess:   b.corColInAccess(v.IndexPlans[0]),
-		idxCols:          is.IdxCols,
-		colLens:          is.IdxColLens,
-		plans:            v.IndexPlans,
-		outputColumns:    v.OutputColumns,
+		baseExecutor:      newBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		dagPB:             dagReq,
+		startTS:           startTS,
+		txnScope:          b.txnScope,
+		readReplicaScope:  b.readReplicaScope,
+		isStaleness:       b.isStaleness,
+		netDataSize:       v.GetNetDataSize(),
+		physicalTableID:   physicalTableID,
+		table:             tbl,
+		index:             is.Index,
+		keepOrder:         is.KeepOrder,
+		desc:              is.Desc,
+		columns:           is.Columns,
+		byItems:           is.ByItems,
+		paging:            paging,
+		corColInFilter:    b.corColInDistPlan(v.IndexPlans),
+		corColInAccess:    b.corColInAccess(v.IndexPlans[0]),
+		idxCols:           is.IdxCols,
+		colLens:           is.IdxColLens,
+		plans:             v.IndexPlans,
+		outputColumns:     v.OutputColumns,
+		skipScanPrefixLen: is.SkipScanPrefixLen,
+		skipScanRanges:    is.SkipScanRanges,
 	}
 	if containsLimit(dagReq.Executors) {
 		e.feedback = statistics.NewQueryFeedback(0, nil, 0, is.Desc)
@@ -4414,6 +4648,7 @@ func (builder *dataReaderBuilder) buildTableReaderBase(ctx context.Context, e *T
 		SetReadReplicaScope(e.readReplicaScope).
 		SetIsStaleness(e.isStaleness).
 		SetFromSessionVars(e.ctx.GetSessionVars()).
+		SetSnapshotRefreshInterval(e.snapshotRefreshInterval()).
 		SetFromInfoSchema(e.ctx.GetInfoSchema()).
 		SetClosestReplicaReadAdjuster(newClosestReadAdjuster(e.ctx, &reqBuilderWithRange.Request, e.netDataSize)).
 		SetPaging(e.paging).
@@ -5118,6 +5353,10 @@ func (b *executorBuilder) buildAdminShowTelemetry(v *plannercore.AdminShowTeleme
 	return &AdminShowTelemetryExec{baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID())}
 }
 
+func (b *executorBuilder) buildAdminShowUnusedIndexes(v *plannercore.AdminShowUnusedIndexes) Executor {
+	return &AdminShowUnusedIndexesExec{baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID())}
+}
+
 func (b *executorBuilder) buildAdminResetTelemetryID(v *plannercore.AdminResetTelemetryID) Executor {
 	return &AdminResetTelemetryIDExec{baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID())}
 }
@@ -16,11 +16,13 @@ package executor
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/config"
+	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/metrics"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/mysql"
@@ -29,6 +31,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessiontxn"
 	"github.com/pingcap/tidb/sessiontxn/staleread"
+	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/memory"
 	"github.com/pingcap/tidb/util/tracing"
@@ -57,6 +60,7 @@ func (c *Compiler) Compile(ctx context.Context, stmtNode ast.StmtNode) (_ *ExecS
 		logutil.Logger(ctx).Error("compile SQL panic", zap.String("SQL", stmtNode.Text()), zap.Stack("stack"), zap.Any("recover", r))
 	}()
 
+	stmtNode = rewriteQuery(ctx, c.Ctx, stmtNode)
 	c.Ctx.GetSessionVars().StmtCtx.IsReadOnly = plannercore.IsReadOnly(stmtNode, c.Ctx.GetSessionVars())
 
 	ret := &plannercore.PreprocessorReturn{}
@@ -95,10 +99,18 @@ func (c *Compiler) Compile(ctx context.Context, stmtNode ast.StmtNode) (_ *ExecS
 			return nil, err
 		}
 	}
+	traceOptimizer := needOptimizerTrace(c.Ctx, stmtNode)
+	if traceOptimizer {
+		stmtCtx.EnableOptimizeTrace = true
+	}
 	finalPlan, names, err := planner.Optimize(ctx, c.Ctx, stmtNode, is)
 	if err != nil {
 		return nil, err
 	}
+	if traceOptimizer {
+		recordOptimizerTrace(c.Ctx, stmtNode)
+		stmtCtx.EnableOptimizeTrace = false
+	}
 
 	failpoint.Inject("assertStmtCtxIsStaleness", func(val failpoint.Value) {
 		staleread.AssertStmtStaleness(c.Ctx, val.(bool))
@@ -247,6 +259,11 @@ func getStmtDbLabel(stmtNode ast.StmtNode) map[string]struct{} {
 			dbLabel := x.Table.Schema.O
 			dbLabelSet[dbLabel] = struct{}{}
 		}
+	case *ast.UndropIndexStmt:
+		if x.Table != nil {
+			dbLabel := x.Table.Schema.O
+			dbLabelSet[dbLabel] = struct{}{}
+		}
 	case *ast.TruncateTableStmt:
 		if x.Table != nil {
 			dbLabel := x.Table.Schema.O
@@ -272,6 +289,8 @@ func getStmtDbLabel(stmtNode ast.StmtNode) map[string]struct{} {
 			dbLabel := x.ViewName.Schema.O
 			dbLabelSet[dbLabel] = struct{}{}
 		}
+	case *ast.RenameDatabaseStmt:
+		dbLabelSet[x.OldName.O] = struct{}{}
 	case *ast.RenameTableStmt:
 		tables := x.TableToTables
 		for _, table := range tables {
@@ -563,3 +582,56 @@ func getDbFromResultNode(resultNode ast.ResultSetNode) []string { // may have du
 
 	return dbLabels
 }
+
+// needOptimizerTrace checks whether to keep the optimizer trace of the statement in the session. The statements
+// reading INFORMATION_SCHEMA.OPTIMIZER_TRACE aren't traced, so the traces of the previous statements are kept.
+func needOptimizerTrace(sctx sessionctx.Context, stmtNode ast.StmtNode) bool {
+	vars := sctx.GetSessionVars()
+	if !vars.EnableOptimizerTrace || vars.InRestrictedSQL {
+		return false
+	}
+	// TRACE PLAN traces the optimizer by itself.
+	if _, ok := stmtNode.(*ast.TraceStmt); ok {
+		return false
+	}
+	checker := &optimizerTraceTableChecker{}
+	stmtNode.Accept(checker)
+	return !checker.found
+}
+
+// optimizerTraceTableChecker checks whether the statement reads INFORMATION_SCHEMA.OPTIMIZER_TRACE.
+type optimizerTraceTableChecker struct {
+	found bool
+}
+
+// Enter implements the ast.Visitor interface.
+func (c *optimizerTraceTableChecker) Enter(in ast.Node) (ast.Node, bool) {
+	if tn, ok := in.(*ast.TableName); ok && tn.Schema.L == util.InformationSchemaName.L &&
+		tn.Name.L == strings.ToLower(infoschema.TableOptimizerTrace) {
+		c.found = true
+	}
+	return in, c.found
+}
+
+// Leave implements the ast.Visitor interface.
+func (c *optimizerTraceTableChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, !c.found
+}
+
+// recordOptimizerTrace keeps the optimizer trace of the statement in the session. There is no trace if the statement
+// isn't optimized, such as the DDL statements and the statements whose plans are got from the plan cache.
+func recordOptimizerTrace(sctx sessionctx.Context, stmtNode ast.StmtNode) {
+	vars := sctx.GetSessionVars()
+	if vars.StmtCtx.OptimizeTracer == nil {
+		return
+	}
+	writer := strings.Builder{}
+	jsonEncoder := json.NewEncoder(&writer)
+	// If we do not set this to false, ">", "<", "&"... will be escaped to "\u003c","\u003e", "\u0026"...
+	jsonEncoder.SetEscapeHTML(false)
+	if err := jsonEncoder.Encode(vars.StmtCtx.OptimizeTracer); err != nil {
+		logutil.BgLogger().Warn("encode the optimizer trace failed", zap.Error(err))
+		return
+	}
+	vars.AppendOptimizerTrace(stmtNode.Text(), strings.TrimSuffix(writer.String(), "\n"))
+}
@@ -19,10 +19,15 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/cteutil"
 	"github.com/pingcap/tidb/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/util/disk"
@@ -97,6 +102,18 @@ type CTEExec struct {
 	// and should resTbl/iterInTbl be reset for each outer row of Apply.
 	// Because we reset them when SQL is finished instead of when CTEExec.Close() is called.
 	isInApply bool
+
+	// probeColIdx and probeCorCols are the CTE columns and the correlated columns which equal to each other in the
+	// parent Selection, when the CTE is not correlated but in the inner side of Apply. For this case, the result of
+	// CTE is computed only once but read by each outer row, so a hash index is built on these columns of `resTbl`
+	// to read the matched rows only, instead of scanning `resTbl` which may have been spilled to disk.
+	probeColIdx  []int
+	probeCorCols []*expression.CorrelatedColumn
+	probeIndex   map[string][]chunk.RowPtr
+	probeRows    []chunk.RowPtr
+	probeCursor  int
+	probed       bool
+	indexTracker *memory.Tracker
 }
 
 // Open implements the Executor interface.
@@ -190,6 +207,9 @@ func (e *CTEExec) Next(ctx context.Context, req *chunk.Chunk) (err error) {
 	if e.hasLimit {
 		return e.nextChunkLimit(req)
 	}
+	if len(e.probeColIdx) > 0 {
+		return e.nextChunkByIndex(req)
+	}
 	if e.chkIdx < e.resTbl.NumChunks() {
 		res, err := e.resTbl.GetChunk(e.chkIdx)
 		if err != nil {
@@ -408,6 +428,7 @@ func (e *CTEExec) reset() {
 	e.hashTbl = nil
 	e.cursor = 0
 	e.meetFirstBatch = false
+	e.probed = false
 }
 
 func (e *CTEExec) reopenTbls() (err error) {
@@ -420,6 +441,119 @@ func (e *CTEExec) reopenTbls() (err error) {
 	return e.iterInTbl.Reopen()
 }
 
+// setupProbeIndex finds the equal conditions between the CTE columns and the correlated columns in the filters of the
+// parent Selection, which are used to build the hash index of `resTbl`. Only the integer and string columns are
+// indexed, whose equal values always have the same keys.
+func (e *CTEExec) setupProbeIndex(filters []expression.Expression) {
+	if e.isInApply || e.hasLimit {
+		return
+	}
+	for _, filter := range filters {
+		sf, ok := filter.(*expression.ScalarFunction)
+		if !ok || sf.FuncName.L != ast.EQ {
+			continue
+		}
+		args := sf.GetArgs()
+		col, isCol := args[0].(*expression.Column)
+		corCol, isCorCol := args[1].(*expression.CorrelatedColumn)
+		if !isCol || !isCorCol {
+			col, isCol = args[1].(*expression.Column)
+			corCol, isCorCol = args[0].(*expression.CorrelatedColumn)
+		}
+		if !isCol || !isCorCol {
+			continue
+		}
+		idx := e.schema.ColumnIndex(col)
+		if idx < 0 {
+			continue
+		}
+		tp, corTp := col.GetType(), corCol.GetType()
+		isInt := mysql.IsIntegerType(tp.GetType()) && mysql.IsIntegerType(corTp.GetType())
+		isStr := types.IsString(tp.GetType()) && types.IsString(corTp.GetType()) && tp.GetCollate() == corTp.GetCollate()
+		if !isInt && !isStr {
+			continue
+		}
+		e.probeColIdx = append(e.probeColIdx, idx)
+		e.probeCorCols = append(e.probeCorCols, corCol)
+	}
+}
+
+func (e *CTEExec) appendProbeKey(key []byte, d types.Datum, tp *types.FieldType) []byte {
+	if mysql.IsIntegerType(tp.GetType()) {
+		return codec.EncodeInt(key, d.GetInt64())
+	}
+	return codec.EncodeBytes(key, collate.GetCollator(tp.GetCollate()).Key(d.GetString()))
+}
+
+// buildProbeIndex scans `resTbl` once to build the hash index. The rows with null keys are skipped because they never
+// equal to any outer row.
+func (e *CTEExec) buildProbeIndex() error {
+	e.probeIndex = make(map[string][]chunk.RowPtr)
+	e.indexTracker = memory.NewTracker(e.id, -1)
+	e.indexTracker.AttachTo(e.ctx.GetSessionVars().StmtCtx.MemTracker)
+	tps := e.base().retFieldTypes
+	var key []byte
+	for chkIdx := 0; chkIdx < e.resTbl.NumChunks(); chkIdx++ {
+		chk, err := e.resTbl.GetChunk(chkIdx)
+		if err != nil {
+			return err
+		}
+		for rowIdx := 0; rowIdx < chk.NumRows(); rowIdx++ {
+			row := chk.GetRow(rowIdx)
+			key = key[:0]
+			hasNull := false
+			for _, colIdx := range e.probeColIdx {
+				if row.IsNull(colIdx) {
+					hasNull = true
+					break
+				}
+				key = e.appendProbeKey(key, row.GetDatum(colIdx, tps[colIdx]), tps[colIdx])
+			}
+			if hasNull {
+				continue
+			}
+			ptrs, ok := e.probeIndex[string(key)]
+			if !ok {
+				e.indexTracker.Consume(int64(len(key)))
+			}
+			e.probeIndex[string(key)] = append(ptrs, chunk.RowPtr{ChkIdx: uint32(chkIdx), RowIdx: uint32(rowIdx)})
+			e.indexTracker.Consume(rowPtrSize)
+		}
+	}
+	return nil
+}
+
+// nextChunkByIndex reads the rows of `resTbl` matched with the current values of the correlated columns.
+func (e *CTEExec) nextChunkByIndex(req *chunk.Chunk) error {
+	if e.probeIndex == nil {
+		if err := e.buildProbeIndex(); err != nil {
+			return err
+		}
+	}
+	if !e.probed {
+		e.probed = true
+		e.probeRows = nil
+		e.probeCursor = 0
+		tps := e.base().retFieldTypes
+		var key []byte
+		for i, corCol := range e.probeCorCols {
+			if corCol.Data.IsNull() {
+				return nil
+			}
+			key = e.appendProbeKey(key, *corCol.Data, tps[e.probeColIdx[i]])
+		}
+		e.probeRows = e.probeIndex[string(key)]
+	}
+	for ; e.probeCursor < len(e.probeRows) && !req.IsFull(); e.probeCursor++ {
+		row, err := e.resTbl.GetRow(e.probeRows[e.probeCursor])
+		if err != nil {
+			return err
+		}
+		req.AppendRow(row)
+	}
+	return nil
+}
+
 // Check if tbl meets the requirement of limit.
 func (e *CTEExec) limitDone(tbl cteutil.Storage) bool {
 	return e.hasLimit && uint64(tbl.NumRows()) >= e.limitEnd
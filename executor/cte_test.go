@@ -17,6 +17,7 @@ package executor_test
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/failpoint"
@@ -402,6 +403,53 @@ func TestSpillToDisk(t *testing.T) {
 	rows.Check(testkit.Rows(resRows...))
 }
 
+func TestCTEProbedByApply(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test;")
+	tk.MustExec("drop table if exists t1, t2;")
+	tk.MustExec("create table t1(a int, b varchar(10) collate utf8mb4_general_ci);")
+	tk.MustExec("create table t2(a int, b varchar(10) collate utf8mb4_general_ci);")
+	tk.MustExec("insert into t1 values (1, 'a'), (2, 'B'), (3, 'c'), (null, 'a'), (4, null), (5, 'e');")
+	tk.MustExec("insert into t2 values (1, 'A'), (1, 'a'), (2, 'b'), (3, 'x'), (null, 'c'), (4, null), (6, 'e');")
+
+	// Use the recursive CTEs to avoid inlining them.
+	cte := "with recursive c(a, b) as (select a, b from t2 union all select a, b from c where a < 0) "
+	sqls := []string{
+		cte + "select a from t1 where exists (select /*/ 1 from c where c.a = t1.a) order by a",
+		cte + "select a from t1 where not exists (select /*/ 1 from c where c.a = t1.a) order by a",
+		cte + "select b from t1 where exists (select /*/ 1 from c where c.b = t1.b) order by b",
+		cte + "select a, b from t1 where exists (select /*/ 1 from c where c.a = t1.a and c.b = t1.b) order by a",
+		cte + "select a, (select /*/ count(*) from c where t1.a = c.a) from t1 order by a",
+		"with recursive c(a, b) as (select a, b from t2 union select a + 1, b from c where a < 5) " +
+			"select a, (select /*/ count(*) from c where c.a = t1.a) from t1 order by a",
+	}
+	for _, sql := range sqls {
+		expected := tk.MustQuery(strings.ReplaceAll(sql, "/*/", "")).Rows()
+		tk.MustQuery(strings.ReplaceAll(sql, "/*/", "/*+ NO_DECORRELATE() */")).Check(expected)
+	}
+
+	// The index is built on the spilled result of the CTE.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/executor/testCTEStorageSpill", "return(true)"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/executor/testCTEStorageSpill"))
+	}()
+	tk.MustExec("SET GLOBAL tidb_enable_tmp_storage_on_oom = 1")
+	defer tk.MustExec("SET GLOBAL tidb_enable_tmp_storage_on_oom = 0")
+	insertStr := "insert into t2 values (0, 'x')"
+	for i := 1; i < 1000; i++ {
+		insertStr += fmt.Sprintf(", (%d, 'x')", i%10)
+	}
+	tk.MustExec(insertStr)
+	sql := cte + "select a, (select /*/ count(*) from c where c.a = t1.a) from t1 order by a"
+	expected := tk.MustQuery(strings.ReplaceAll(sql, "/*/", "")).Rows()
+	tk.MustExec("set tidb_mem_quota_query = 40000;")
+	defer tk.MustExec("set tidb_mem_quota_query = 1073741824;")
+	tk.MustQuery(strings.ReplaceAll(sql, "/*/", "/*+ NO_DECORRELATE() */")).Check(expected)
+	require.Greater(t, tk.Session().GetSessionVars().StmtCtx.DiskTracker.MaxConsumed(), int64(0))
+}
+
 func TestCTEExecError(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
@@ -158,8 +158,12 @@ func (e *DDLExec) Next(ctx context.Context, req *chunk.Chunk) (err error) {
 		err = e.executeCreateView(ctx, x)
 	case *ast.DropIndexStmt:
 		err = e.executeDropIndex(x)
+	case *ast.UndropIndexStmt:
+		err = e.executeUndropIndex(x)
 	case *ast.DropDatabaseStmt:
 		err = e.executeDropDatabase(x)
+	case *ast.RenameDatabaseStmt:
+		err = e.executeRenameDatabase(x)
 	case *ast.DropTableStmt:
 		if x.IsView {
 			err = e.executeDropView(x)
@@ -247,7 +251,24 @@ func (e *DDLExec) executeRenameTable(s *ast.RenameTableStmt) error {
 			return dbterror.ErrUnsupportedLocalTempTableDDL.GenWithStackByArgs("RENAME TABLE")
 		}
 	}
-	return domain.GetDomain(e.ctx).DDL().RenameTable(e.ctx, s)
+	dom := domain.GetDomain(e.ctx)
+	if err := dom.DDL().RenameTable(e.ctx, s); err != nil {
+		return err
+	}
+	if bindHandle := dom.BindHandle(); bindHandle != nil {
+		oldTables := make([]ast.Ident, 0, len(s.TableToTables))
+		newTables := make([]ast.Ident, 0, len(s.TableToTables))
+		for _, tables := range s.TableToTables {
+			oldTables = append(oldTables, ast.Ident{Schema: tables.OldTable.Schema, Name: tables.OldTable.Name})
+			newTables = append(newTables, ast.Ident{Schema: tables.NewTable.Schema, Name: tables.NewTable.Name})
+		}
+		// The tables have been renamed, so the failure of rewriting the bindings doesn't fail the statement.
+		if err := bindHandle.RenameTables(oldTables, newTables); err != nil {
+			logutil.BgLogger().Warn("failed to rename the tables in the bindings", zap.Error(err))
+			e.ctx.GetSessionVars().StmtCtx.AppendWarning(err)
+		}
+	}
+	return nil
 }
 
 func (e *DDLExec) executeCreateDatabase(s *ast.CreateDatabaseStmt) error {
@@ -309,13 +330,46 @@ func (e *DDLExec) executeCreateView(ctx context.Context, s *ast.CreateViewStmt)
 }
 
 func (e *DDLExec) executeCreateIndex(s *ast.CreateIndexStmt) error {
-	if _, ok := e.getLocalTemporaryTable(s.Table.Schema, s.Table.Name); ok {
-		return dbterror.ErrUnsupportedLocalTempTableDDL.GenWithStackByArgs("CREATE INDEX")
+	if tbl, ok := e.getLocalTemporaryTable(s.Table.Schema, s.Table.Name); ok {
+		tblInfo, indexInfo, err := ddl.BuildIndexForLocalTemporaryTable(e.ctx, tbl, s)
+		if err != nil || tblInfo == nil {
+			return err
+		}
+		return e.tempTableDDL.CreateLocalTemporaryTableIndex(s.Table.Schema, s.Table.Name, tblInfo, indexInfo)
+	}
+	if err := e.setMaintenanceWindow(s.MaintenanceWindow); err != nil {
+		return err
 	}
 
 	return domain.GetDomain(e.ctx).DDL().CreateIndex(e.ctx, s)
 }
 
+// setMaintenanceWindow makes the DDL jobs of the statement run in the maintenance window.
+func (e *DDLExec) setMaintenanceWindow(name model.CIStr) error {
+	if name.L == "" {
+		return nil
+	}
+	window, err := ddl.GetMaintenanceWindow(e.ctx, name.L)
+	if err != nil {
+		return err
+	}
+	e.ctx.GetSessionVars().StmtCtx.MaintenanceWindow = window
+	return nil
+}
+
+func (e *DDLExec) executeRenameDatabase(s *ast.RenameDatabaseStmt) error {
+	err := domain.GetDomain(e.ctx).DDL().RenameSchema(e.ctx, s)
+	if err != nil {
+		return err
+	}
+	// The session keeps using the renamed database.
+	sessionVars := e.ctx.GetSessionVars()
+	if strings.ToLower(sessionVars.CurrentDB) == s.OldName.L {
+		sessionVars.CurrentDB = s.NewName.O
+	}
+	return nil
+}
+
 func (e *DDLExec) executeDropDatabase(s *ast.DropDatabaseStmt) error {
 	dbName := s.Name
 
@@ -369,17 +423,34 @@ func (e *DDLExec) dropLocalTemporaryTables(localTempTables []*ast.TableName) err
 }
 
 func (e *DDLExec) executeDropIndex(s *ast.DropIndexStmt) error {
-	if _, ok := e.getLocalTemporaryTable(s.Table.Schema, s.Table.Name); ok {
-		return dbterror.ErrUnsupportedLocalTempTableDDL.GenWithStackByArgs("DROP INDEX")
+	if tbl, ok := e.getLocalTemporaryTable(s.Table.Schema, s.Table.Name); ok {
+		if s.Deferred {
+			return dbterror.ErrUnsupportedLocalTempTableDDL.GenWithStackByArgs("DROP INDEX ... DEFERRED")
+		}
+		tblInfo, indexInfo, err := ddl.BuildDropIndexForLocalTemporaryTable(e.ctx, tbl, s)
+		if err != nil || tblInfo == nil {
+			return err
+		}
+		return e.tempTableDDL.DropLocalTemporaryTableIndex(s.Table.Schema, s.Table.Name, tblInfo, indexInfo)
 	}
 
 	return domain.GetDomain(e.ctx).DDL().DropIndex(e.ctx, s)
 }
 
+func (e *DDLExec) executeUndropIndex(s *ast.UndropIndexStmt) error {
+	if _, ok := e.getLocalTemporaryTable(s.Table.Schema, s.Table.Name); ok {
+		return dbterror.ErrUnsupportedLocalTempTableDDL.GenWithStackByArgs("UNDROP INDEX")
+	}
+	return domain.GetDomain(e.ctx).DDL().UndropIndex(e.ctx, s)
+}
+
 func (e *DDLExec) executeAlterTable(ctx context.Context, s *ast.AlterTableStmt) error {
 	if _, ok := e.getLocalTemporaryTable(s.Table.Schema, s.Table.Name); ok {
 		return dbterror.ErrUnsupportedLocalTempTableDDL.GenWithStackByArgs("ALTER TABLE")
 	}
+	if err := e.setMaintenanceWindow(s.MaintenanceWindow); err != nil {
+		return err
+	}
 
 	return domain.GetDomain(e.ctx).DDL().AlterTable(ctx, e.ctx, s)
 }
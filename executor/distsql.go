@@ -210,6 +210,11 @@ type IndexReaderExecutor struct {
 	// If dummy flag is set, this is not a real IndexReader, it just provides the KV ranges for UnionScan.
 	// Used by the temporary table, cached table.
 	dummy bool
+
+	// skipScanPrefixLen and skipScanRanges are the skip scan of the index, see PhysicalIndexScan.
+	skipScanPrefixLen int
+	skipScanRanges    []*ranger.Range
+	skipScan          *indexSkipScanner
 }
 
 // Table implements the dataSourceExecutor interface.
@@ -227,11 +232,17 @@ func (e *IndexReaderExecutor) Close() (err error) {
 		err = e.result.Close()
 	}
 	e.result = nil
+	if e.skipScan != nil {
+		if closeErr := e.skipScan.closeResult(); err == nil {
+			err = closeErr
+		}
+	}
 	e.kvRanges = e.kvRanges[:0]
 	if e.dummy {
 		return nil
 	}
 	e.ctx.StoreQueryFeedback(e.feedback)
+	storeIndexUsage(e.ctx, e.id, e.table.Meta(), e.index)
 	return err
 }
 
@@ -241,6 +252,9 @@ func (e *IndexReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 		req.Reset()
 		return nil
 	}
+	if e.skipScan != nil {
+		return e.skipScan.next(ctx, req)
+	}
 
 	err := e.result.Next(ctx, req)
 	if err != nil {
@@ -293,6 +307,10 @@ func (e *IndexReaderExecutor) Open(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// The index join builds the ranges by itself and reads all the entries, so only the Open skip scans the index.
+	if e.skipScanPrefixLen > 0 && e.skipScan == nil {
+		e.skipScan = newIndexSkipScanner(e)
+	}
 
 	return e.open(ctx, kvRanges)
 }
@@ -346,6 +364,11 @@ func (e *IndexReaderExecutor) open(ctx context.Context, kvRanges []kv.KeyRange)
 	slices.SortFunc(kvRanges, func(i, j kv.KeyRange) bool {
 		return bytes.Compare(i.StartKey, j.StartKey) < 0
 	})
+	if e.skipScan != nil {
+		e.feedback.Invalidate()
+		e.skipScan.open(kvRanges)
+		return nil
+	}
 	// use sortedSelectResults only when byItems pushed down and partition numbers > 1
 	if e.byItems == nil || len(e.partitions) <= 1 {
 		kvReq, err := e.buildKVReq(ctx, kvRanges)
@@ -644,6 +667,8 @@ func (e *IndexLookUpExecutor) startIndexWorker(ctx context.Context, workCh chan<
 			SetReadReplicaScope(e.readReplicaScope).
 			SetIsStaleness(e.isStaleness).
 			SetFromSessionVars(e.ctx.GetSessionVars()).
+			// The table rows are read at the start ts, so the index must be read at the same snapshot.
+			SetSnapshotRefreshInterval(0).
 			SetFromInfoSchema(e.ctx.GetInfoSchema()).
 			SetClosestReplicaReadAdjuster(newClosestReadAdjuster(e.ctx, &builder.Request, e.idxNetDataSize/float64(len(kvRanges)))).
 			SetMemTracker(tracker).
@@ -753,6 +778,7 @@ func (e *IndexLookUpExecutor) buildTableReader(ctx context.Context, task *lookup
 		corColInFilter:   e.corColInTblSide,
 		plans:            e.tblPlans,
 		netDataSize:      e.avgRowSize * float64(len(task.handles)),
+		pinSnapshot:      true,
 	}
 	tableReaderExec.buildVirtualColumnInfo()
 	tableReader, err := e.dataReaderBuilder.buildTableReaderFromHandles(ctx, tableReaderExec, task.handles, true)
@@ -776,6 +802,7 @@ func (e *IndexLookUpExecutor) Close() error {
 	if !e.workerStarted || e.finished == nil {
 		return nil
 	}
+	storeIndexUsage(e.ctx, e.id, e.table.Meta(), e.index)
 
 	if e.cancelFunc != nil {
 		e.cancelFunc()
@@ -698,3 +698,42 @@ func TestCoprocessorBatchByStore(t *testing.T) {
 		}
 	}
 }
+
+func TestIndexSkipScan(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c varchar(10) collate utf8mb4_general_ci, d varchar(10) collate utf8mb4_bin, " +
+		"key idx_ab(a, b), key idx_cb(c, b), key idx_db(d, b))")
+	tk.MustExec("insert into t values (1, 1, 'a', 'a'), (1, 2, 'A', 'A'), (1, 3, 'b', 'b'), (2, 1, 'b', 'b'), (2, 2, 'B', 'B'), " +
+		"(null, 1, null, null), (null, 3, null, null), (3, null, 'c', 'c')")
+	tk.MustExec("create table pt (a int, b int, key idx_ab(a, b)) partition by hash(b) partitions 3")
+	tk.MustExec("insert into pt select a, b from t")
+	tk.MustExec("analyze table t, pt")
+
+	for _, enabled := range []bool{false, true} {
+		tk.MustExec(fmt.Sprintf("set @@tidb_opt_enable_index_skip_scan = %v", enabled))
+		sqls := []string{
+			"select distinct a from t use index(idx_ab)",
+			"select a, b from t use index(idx_ab) where b = 1",
+			"select a, b from t use index(idx_ab) where b > 1 and b <= 3",
+			"select distinct d from t use index(idx_db)",
+			"select d, b from t use index(idx_db) where b = 2",
+		}
+		for _, sql := range sqls {
+			require.Equal(t, enabled, tk.HasKeywordInOperatorInfo(sql, "skip scan prefix:1"), sql)
+		}
+		// The prefix of the non-binary collation can't be skip scanned.
+		require.False(t, tk.HasKeywordInOperatorInfo("select distinct c from t use index(idx_cb)", "skip scan prefix:1"))
+		tk.MustQuery(sqls[0]).Sort().Check(testkit.Rows("1", "2", "3", "<nil>"))
+		tk.MustQuery("select distinct a from t use index(idx_ab) where b > 1").Sort().Check(testkit.Rows("1", "2", "<nil>"))
+		tk.MustQuery("select distinct a, b from t use index(idx_ab) where a > 1").Sort().Check(testkit.Rows("2 1", "2 2", "3 <nil>"))
+		tk.MustQuery(sqls[1]).Sort().Check(testkit.Rows("1 1", "2 1", "<nil> 1"))
+		tk.MustQuery(sqls[2]).Sort().Check(testkit.Rows("1 2", "1 3", "2 2", "<nil> 3"))
+		tk.MustQuery(sqls[3]).Sort().Check(testkit.Rows("<nil>", "A", "B", "a", "b", "c"))
+		tk.MustQuery(sqls[4]).Sort().Check(testkit.Rows("A 2", "B 2"))
+		tk.MustQuery("select count(*) from (select distinct c from t use index(idx_cb)) x").Check(testkit.Rows("4"))
+		tk.MustQuery("select distinct a from pt use index(idx_ab)").Sort().Check(testkit.Rows("1", "2", "3", "<nil>"))
+		tk.MustQuery("select a, b from pt use index(idx_ab) where b = 1").Sort().Check(testkit.Rows("1 1", "2 1", "<nil> 1"))
+	}
+}
@@ -228,6 +228,19 @@ func (e *baseExecutor) Schema() *expression.Schema {
 	return e.schema
 }
 
+// registerSpillStats records the spill rounds of the executor and the peak bytes of its spilled data, which are shown
+// in the execution info of EXPLAIN ANALYZE.
+func (e *baseExecutor) registerSpillStats(rounds int, diskTracker *disk.Tracker) {
+	if e.runtimeStats == nil || rounds == 0 {
+		return
+	}
+	stats := &execdetails.SpillRuntimeStats{Rounds: int64(rounds)}
+	if diskTracker != nil {
+		stats.Bytes = diskTracker.MaxConsumed()
+	}
+	e.ctx.GetSessionVars().StmtCtx.RuntimeStatsColl.RegisterStats(e.id, stats)
+}
+
 // newFirstChunk creates a new chunk to buffer current executor's result.
 func newFirstChunk(e Executor) *chunk.Chunk {
 	base := e.base()
@@ -604,12 +617,66 @@ func (e *DDLJobRetriever) appendJobToChunk(req *chunk.Chunk, job *model.Job, che
 			req.AppendString(11, subJob.State.String())
 		}
 	}
+	if (job.Type == model.ActionReorganizePartition || job.Type == model.ActionAlterTablePartitioning) && job.ReorgMeta != nil && !job.IsFinished() && !job.IsSynced() {
+		// Show the progress of each element for the running jobs, the elements are reorganized one by one.
+		running := false
+		for _, p := range job.ReorgMeta.ElementProgress {
+			state := model.JobStateDone
+			if !p.Done {
+				state = model.JobStateQueueing
+				if !running {
+					state, running = model.JobStateRunning, true
+				}
+			}
+			req.AppendInt64(0, job.ID)
+			req.AppendString(1, schemaName)
+			req.AppendString(2, tableName)
+			req.AppendString(3, job.Type.String()+" /* "+p.Name+" */")
+			req.AppendString(4, job.SchemaState.String())
+			req.AppendInt64(5, job.SchemaID)
+			req.AppendInt64(6, job.TableID)
+			req.AppendInt64(7, p.Rows)
+			req.AppendNull(8)
+			req.AppendNull(9)
+			req.AppendNull(10)
+			req.AppendString(11, state.String())
+		}
+	}
+	if job.Type == model.ActionSetTiFlashReplica && job.ReorgMeta != nil && !job.IsFinished() && !job.IsSynced() {
+		// Show the replica progress of each partition for the running jobs, the partitions not started are throttled.
+		for _, p := range job.ReorgMeta.ElementProgress {
+			state := model.JobStateQueueing
+			if p.Done {
+				state = model.JobStateDone
+			} else if p.Started {
+				state = model.JobStateRunning
+			}
+			req.AppendInt64(0, job.ID)
+			req.AppendString(1, schemaName)
+			req.AppendString(2, tableName)
+			req.AppendString(3, fmt.Sprintf("%s /* %s, progress: %.2f%% */", job.Type.String(), p.Name, p.Progress*100))
+			req.AppendString(4, job.SchemaState.String())
+			req.AppendInt64(5, job.SchemaID)
+			req.AppendInt64(6, job.TableID)
+			req.AppendInt64(7, 0)
+			req.AppendNull(8)
+			req.AppendNull(9)
+			req.AppendNull(10)
+			req.AppendString(11, state.String())
+		}
+	}
 }
 
 func showAddIdxReorgTp(job *model.Job) string {
 	if job.Type == model.ActionAddIndex || job.Type == model.ActionAddPrimaryKey {
 		if job.ReorgMeta != nil {
 			tp := job.ReorgMeta.ReorgTp.String()
+			// Show the progress of the ingest phases for the running jobs only, because the row count
+			// alone is misleading when the import phase dominates.
+			if job.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge && job.ReorgMeta.Progress != nil &&
+				!job.IsFinished() && !job.IsSynced() {
+				tp += ", " + job.ReorgMeta.Progress.String()
+			}
 			if len(tp) > 0 {
 				return " /* " + tp + " */"
 			}
@@ -816,6 +883,102 @@ func (e *ShowDDLJobQueriesWithRangeExec) Next(ctx context.Context, req *chunk.Ch
 	return nil
 }
 
+// ShowDDLDependenciesExec represents a show DDL dependencies executor.
+// It shows the jobs in the DDL job queue and the jobs each of them waits for.
+type ShowDDLDependenciesExec struct {
+	baseExecutor
+
+	cursor int
+	jobs   []*ddl.JobDependency
+}
+
+// Open implements the Executor Open interface.
+func (e *ShowDDLDependenciesExec) Open(ctx context.Context) error {
+	if err := e.baseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	session, err := e.getSysSession()
+	if err != nil {
+		return err
+	}
+	defer e.releaseSysSession(kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL), session)
+	e.jobs, err = ddl.GetDDLJobDependencies(session)
+	return err
+}
+
+// Next implements the Executor Next interface.
+func (e *ShowDDLDependenciesExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.GrowAndReset(e.maxChunkSize)
+	numCurBatch := mathutil.Min(req.Capacity(), len(e.jobs)-e.cursor)
+	for _, job := range e.jobs[e.cursor : e.cursor+numCurBatch] {
+		state := "queueing"
+		if job.Processing {
+			state = "running"
+		} else if len(job.DependsOn) > 0 {
+			state = "waiting"
+		}
+		dependsOn := make([]string, 0, len(job.DependsOn))
+		for _, id := range job.DependsOn {
+			dependsOn = append(dependsOn, strconv.FormatInt(id, 10))
+		}
+		req.AppendInt64(0, job.JobID)
+		req.AppendString(1, job.JobType.String())
+		req.AppendString(2, job.SchemaIDs)
+		req.AppendString(3, job.TableIDs)
+		req.AppendString(4, state)
+		req.AppendString(5, strings.Join(dependsOn, ","))
+	}
+	e.cursor += numCurBatch
+	return nil
+}
+
+// ExplainRollbackDDLJobExec represents an explain rollback DDL job executor.
+// It estimates what rolling back the running DDL job entails, without cancelling it.
+type ExplainRollbackDDLJobExec struct {
+	baseExecutor
+
+	is    infoschema.InfoSchema
+	jobID int64
+	done  bool
+}
+
+// Next implements the Executor Next interface.
+func (e *ExplainRollbackDDLJobExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+	session, err := e.getSysSession()
+	if err != nil {
+		return err
+	}
+	defer e.releaseSysSession(kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL), session)
+	job, err := ddl.GetDDLJobByID(session, e.jobID)
+	if err != nil {
+		return err
+	}
+	var tblInfo *model.TableInfo
+	if tbl, ok := e.is.TableByID(job.TableID); ok {
+		tblInfo = tbl.Meta()
+	}
+	plan := ddl.ExplainRollbackDDLJob(job, tblInfo)
+	rollbackable := "NO"
+	if plan.Rollbackable {
+		rollbackable = "YES"
+	}
+	lease := domain.GetDomain(e.ctx).DDL().GetLease()
+	req.AppendInt64(0, job.ID)
+	req.AppendString(1, job.Type.String())
+	req.AppendString(2, job.SchemaState.String())
+	req.AppendString(3, rollbackable)
+	req.AppendString(4, plan.Action)
+	req.AppendInt64(5, plan.KeysToClean)
+	req.AppendInt64(6, int64(plan.RangesToDelete))
+	req.AppendString(7, plan.EstimatedDuration(lease).String())
+	return nil
+}
+
 // Open implements the Executor Open interface.
 func (e *ShowDDLJobsExec) Open(ctx context.Context) error {
 	if err := e.baseExecutor.Open(ctx); err != nil {
@@ -2170,8 +2333,10 @@ func ResetContextOfStmt(ctx sessionctx.Context, s ast.StmtNode) (err error) {
 			sc.NotFillCache = !opts.SQLCache
 		}
 		sc.WeakConsistency = isWeakConsistencyRead(ctx, stmt)
+		sc.SnapshotRefreshInterval = snapshotRefreshInterval(ctx, stmt)
 	case *ast.SetOprStmt:
 		sc.InSelectStmt = true
+		sc.SnapshotRefreshInterval = snapshotRefreshInterval(ctx, stmt)
 		sc.OverflowAsWarning = true
 		sc.TruncateAsWarning = true
 		sc.IgnoreZeroInDate = true
@@ -2295,3 +2460,14 @@ func isWeakConsistencyRead(ctx sessionctx.Context, node ast.Node) bool {
 	return sessionVars.ConnectionID > 0 && sessionVars.ReadConsistency.IsWeak() &&
 		plannercore.IsAutoCommitTxn(ctx) && plannercore.IsReadOnly(node, sessionVars)
 }
+
+// snapshotRefreshInterval returns the interval to refresh the snapshot of the coprocessor requests. The snapshot can
+// only be refreshed for the autocommit read-only statements which don't read a historical snapshot.
+func snapshotRefreshInterval(ctx sessionctx.Context, node ast.Node) time.Duration {
+	sessionVars := ctx.GetSessionVars()
+	if sessionVars.SnapshotRefreshInterval <= 0 || sessionVars.InRestrictedSQL || sessionVars.SnapshotTS != 0 ||
+		!plannercore.IsAutoCommitTxn(ctx) || !plannercore.IsReadOnly(node, sessionVars) {
+		return 0
+	}
+	return sessionVars.SnapshotRefreshInterval
+}
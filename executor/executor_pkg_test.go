@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/executor/aggfuncs"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
 	plannerutil "github.com/pingcap/tidb/planner/util"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/tablecodec"
@@ -211,6 +212,33 @@ func TestFilterTemporaryTableKeys(t *testing.T) {
 	require.Len(t, res, 1)
 }
 
+func TestShowAddIdxReorgTp(t *testing.T) {
+	job := &model.Job{
+		Type:  model.ActionAddIndex,
+		State: model.JobStateRunning,
+		ReorgMeta: &model.DDLReorgMeta{
+			ReorgTp: model.ReorgTypeLitMerge,
+		},
+	}
+	require.Equal(t, " /* ingest */", showAddIdxReorgTp(job))
+
+	job.ReorgMeta.Progress = &model.IngestProgress{
+		Phase:  model.ReorgPhaseImport,
+		Scan:   model.ReorgPhaseProgress{Rows: 10, Bytes: 400},
+		Flush:  model.ReorgPhaseProgress{Rows: 10, Bytes: 400},
+		Import: model.ReorgPhaseProgress{Rows: 8, Bytes: 320},
+	}
+	require.Equal(t, " /* ingest, phase: import, scan: 10 rows 400 bytes, flush: 10 rows 400 bytes, "+
+		"import: 8 rows 320 bytes, merge: 0 rows 0 bytes */", showAddIdxReorgTp(job))
+
+	// The progress is not shown after the job is finished.
+	job.State = model.JobStateSynced
+	require.Equal(t, " /* ingest */", showAddIdxReorgTp(job))
+	job.State = model.JobStateRunning
+	job.ReorgMeta.ReorgTp = model.ReorgTypeTxnMerge
+	require.Equal(t, " /* txn-merge */", showAddIdxReorgTp(job))
+}
+
 func TestSortSpillDisk(t *testing.T) {
 	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/executor/testSortedRowContainerSpill", "return(true)"))
 	defer func() {
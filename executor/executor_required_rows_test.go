@@ -931,3 +931,78 @@ func TestVecGroupCheckerDATARACE(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeUnionRequiredRows(t *testing.T) {
+	maxChunkSize := defaultCtx().GetSessionVars().MaxChunkSize
+	testCases := []struct {
+		totalRows      []int
+		requiredRows   []int
+		expectedRows   []int
+		expectedRowsDS [][]int
+	}{
+		{
+			totalRows:      []int{10, 10},
+			requiredRows:   []int{5, 20, 10},
+			expectedRows:   []int{5, 15, 0},
+			expectedRowsDS: [][]int{{10, 0}, {10, 0}},
+		},
+		{
+			totalRows:      []int{maxChunkSize + 1, 1, 0},
+			requiredRows:   []int{1},
+			expectedRows:   []int{1},
+			expectedRowsDS: [][]int{{maxChunkSize}, {1}, {0}},
+		},
+		{
+			totalRows:      []int{maxChunkSize + 1, maxChunkSize},
+			requiredRows:   []int{maxChunkSize, maxChunkSize, maxChunkSize, 1},
+			expectedRows:   []int{maxChunkSize, maxChunkSize, 1, 0},
+			expectedRowsDS: [][]int{{maxChunkSize, 1, 0}, {maxChunkSize, 0}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		sctx := defaultCtx()
+		ctx := context.Background()
+		srcs := make([]Executor, 0, len(testCase.totalRows))
+		dss := make([]*requiredRowsDataSource, 0, len(testCase.totalRows))
+		for i, totalRows := range testCase.totalRows {
+			// The i-th data source generates i, i+n, i+2n, ... in the second column.
+			next, step := int64(i), int64(len(testCase.totalRows))
+			ds := newRequiredRowsDataSourceWithGenerator(sctx, totalRows, testCase.expectedRowsDS[i], func(valType *types.FieldType) interface{} {
+				if valType.GetType() == mysql.TypeDouble {
+					return float64(0)
+				}
+				next += step
+				return next - step
+			})
+			srcs = append(srcs, ds)
+			dss = append(dss, ds)
+		}
+		exec := buildMergeUnionExec(sctx, srcs...)
+		require.NoError(t, exec.Open(ctx))
+		chk := newFirstChunk(exec)
+		expected := int64(0)
+		for i := range testCase.requiredRows {
+			chk.SetRequiredRows(testCase.requiredRows[i], sctx.GetSessionVars().MaxChunkSize)
+			require.NoError(t, exec.Next(ctx, chk))
+			require.Equal(t, testCase.expectedRows[i], chk.NumRows())
+			for j := 0; j < chk.NumRows(); j++ {
+				require.Equal(t, expected, chk.GetRow(j).GetInt64(1))
+				expected++
+			}
+		}
+		require.NoError(t, exec.Close())
+		for _, ds := range dss {
+			require.NoError(t, ds.checkNumNextCalled())
+		}
+	}
+}
+
+func buildMergeUnionExec(ctx sessionctx.Context, srcs ...Executor) Executor {
+	return &MergeUnionExec{
+		baseExecutor: newBaseExecutor(ctx, srcs[0].Schema(), 0, srcs...),
+		keyColumns:   []int{1},
+		keyCmpFuncs:  []chunk.CompareFunc{chunk.GetCompareFunc(srcs[0].Schema().Columns[1].RetType)},
+		keyDesc:      []bool{false},
+	}
+}
@@ -4520,6 +4520,31 @@ func TestAdminShowDDLJobsInfo(t *testing.T) {
 	require.Equal(t, "alter table nocache", tk.MustQuery("admin show ddl jobs 1").Rows()[0][3])
 }
 
+func TestAdminWaitDDLJob(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+	tk.MustExec("alter table t add index idx(b)")
+	jobID1 := tk.MustQuery("admin show ddl jobs 1").Rows()[0][0].(string)
+	tk.MustExec("alter table t add column c int default 3")
+	jobID2 := tk.MustQuery("admin show ddl jobs 1").Rows()[0][0].(string)
+	tk.MustExec(fmt.Sprintf("admin wait ddl job %s", jobID1))
+	tk.MustExec(fmt.Sprintf("admin wait ddl job %s, %s", jobID1, jobID2))
+	tk.MustQuery("select a, c from t use index(idx) where b = 2").Check(testkit.Rows("2 3"))
+
+	err := tk.ExecToErr("admin wait ddl job 9999999")
+	require.ErrorContains(t, err, "DDL Job:9999999 not found")
+
+	// Waiting for the DDL jobs requires the SUPER privilege like the other admin statements.
+	tk.MustExec("create user 'wait_ddl'@'localhost'")
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "wait_ddl", Hostname: "localhost"}, nil, nil))
+	err = tk.ExecToErr(fmt.Sprintf("admin wait ddl job %s", jobID1))
+	require.True(t, terror.ErrorEqual(err, plannercore.ErrPrivilegeCheckFail), err)
+}
+
 func TestAdminChecksumOfPartitionedTable(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -4789,6 +4814,26 @@ func TestUnionLimit(t *testing.T) {
 	tk.MustQuery("select * from union_limit limit 10")
 }
 
+func TestUnionOrderByLimit(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2, tp")
+	tk.MustExec("create table t1 (a int, b int, key(a))")
+	tk.MustExec("create table t2 (a int, b int, key(a))")
+	tk.MustExec("create table tp (a int, b int, key(a)) partition by hash(b) partitions 4")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'static'")
+	for i := 0; i < 20; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t1 values (%d, %d)", i*3, i))
+		tk.MustExec(fmt.Sprintf("insert into t2 values (%d, %d)", i*3+1, i))
+		tk.MustExec(fmt.Sprintf("insert into tp values (%d, %d)", i*3+2, i))
+	}
+	tk.MustQuery("(select a from t1) union all (select a from t2) order by a limit 3, 4").Check(testkit.Rows("4", "6", "7", "9"))
+	tk.MustQuery("(select a from t1) union all (select a from t2) order by a desc limit 3").Check(testkit.Rows("58", "57", "55"))
+	tk.MustQuery("select a from tp order by a limit 3").Check(testkit.Rows("2", "5", "8"))
+	tk.MustQuery("(select a from t1 where a > 50) union all (select a from tp) order by a desc limit 2, 3").Check(testkit.Rows("56", "54", "53"))
+}
+
 func TestLowResolutionTSORead(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
@@ -5794,10 +5839,8 @@ func TestAdmin(t *testing.T) {
 	r, err = tk.Exec("admin checksum table checksum_with_index, checksum_without_index")
 	require.NoError(t, err)
 	res := tk.ResultSetToResult(r, "admin checksum table")
-	// Mocktikv returns 1 for every table/index scan, then we will xor the checksums of a table.
-	// For "checksum_with_index", we have two checksums, so the result will be 1^1 = 0.
-	// For "checksum_without_index", we only have one checksum, so the result will be 1.
-	res.Sort().Check(testkit.Rows("test checksum_with_index 0 2 2", "test checksum_without_index 1 1 1"))
+	// Both tables are empty.
+	res.Sort().Check(testkit.Rows("test checksum_with_index 0 0 0", "test checksum_without_index 0 0 0"))
 
 	tk.MustExec("drop table if exists t1;")
 	tk.MustExec("CREATE TABLE t1 (c2 BOOL, PRIMARY KEY (c2));")
@@ -6481,3 +6524,46 @@ from ssci right join csci on (ssci.customer_sk=csci.customer_sk
                                and ssci.item_sk = csci.item_sk)
 limit 100;`)
 }
+
+func TestQueryRewriteRules(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b int, key(a))")
+	tk.MustExec("insert into t values(1, 10), (2, 20), (3, 30)")
+	defer func() {
+		tk.MustExec("delete from mysql.query_rewrite_rules")
+		tk.MustExec("admin reload query_rewrite_rules")
+	}()
+
+	tk.MustExec("insert into mysql.query_rewrite_rules(pattern, pattern_database, replacement) values " +
+		"('select * from t where a = ? and b > ?', 'test', 'select /*+ use_index(t, a) */ * from t where a = ? and b > ?'), " +
+		"('select b from t where a = 1', NULL, 'select b + 1 from t where a = 1'), " +
+		"('select a from t where b = ?', NULL, 'delete from t where b = ?'), " +
+		"('select a from t where b = ?', NULL, 'select a from t where b = ? and a = ?')")
+	tk.MustExec("admin reload query_rewrite_rules")
+	tk.MustQuery("show warnings").Sort().Check(testkit.Rows(
+		"Warning 1105 query rewrite rule 3 is skipped: the pattern and the replacement should be the same type of statement",
+		"Warning 1105 query rewrite rule 4 is skipped: the replacement has 2 parameter markers, but the pattern only has 1"))
+
+	// The literals at the parameter markers are filled into the replacement.
+	tk.MustQuery("select * from t where a = 2 and b > 0").Check(testkit.Rows("2 20"))
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1105 Query is rewritten by the query rewrite rule 1"))
+	// The other literals should be the same as the pattern.
+	tk.MustQuery("select b from t where a = 1").Check(testkit.Rows("11"))
+	tk.MustQuery("select b from t where a = 2").Check(testkit.Rows("20"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	// The rule only matches the statements in its pattern_database.
+	tk.MustExec("use mysql")
+	tk.MustGetErrMsg("select * from t where a = 2 and b > 0", "[schema:1146]Table 'mysql.t' doesn't exist")
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_enable_query_rewrite = off")
+	tk.MustQuery("select b from t where a = 1").Check(testkit.Rows("10"))
+	tk.MustExec("set @@tidb_enable_query_rewrite = on")
+
+	tk.MustExec("update mysql.query_rewrite_rules set enabled = 'NO' where id = 2")
+	tk.MustQuery("select b from t where a = 1").Check(testkit.Rows("11"))
+	tk.MustExec("admin reload query_rewrite_rules")
+	tk.MustQuery("select b from t where a = 1").Check(testkit.Rows("10"))
+}
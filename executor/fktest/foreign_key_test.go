@@ -1975,6 +1975,29 @@ func TestForeignKeyOnUpdateSetNull(t *testing.T) {
 	tk.MustQuery("select count(*) from t2 where b is null").Check(testkit.Rows("32768"))
 }
 
+func TestForeignKeyOnUpdateCascadeManyValues(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("set @@global.tidb_enable_foreign_key=1")
+	tk.MustExec("set @@foreign_key_checks=1")
+	tk.MustExec("use test")
+
+	// The old values are cascaded in batches, and the batches of the different new values are cascaded in order.
+	tk.MustExec("create table t1 (id int auto_increment key, b int, index(b));")
+	tk.MustExec("create table t2 (id int, b int, foreign key fk(b) references t1(b) on update cascade)")
+	tk.MustExec("insert into t1 (b) values (8),(7),(6),(5),(4),(3),(2),(1);")
+	for i := 0; i < 9; i++ {
+		tk.MustExec("insert into t1 (b) select b from t1")
+	}
+	tk.MustQuery("select count(*) from t1").Check(testkit.Rows("4096"))
+	tk.MustExec("insert into t2 select * from t1")
+	tk.MustExec("update t1 set b=b%2")
+	tk.MustQuery("select b, count(*) from t2 group by b order by b").Check(testkit.Rows("0 2048", "1 2048"))
+	tk.MustExec("update t1 set b=b+10 where b=0")
+	tk.MustQuery("select b, count(*) from t2 group by b order by b").Check(testkit.Rows("1 2048", "10 2048"))
+	tk.MustQuery("select count(*) from t1 join t2 on t1.id = t2.id and t1.b = t2.b").Check(testkit.Rows("4096"))
+}
+
 func TestShowCreateTableWithForeignKey(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -37,6 +37,7 @@ import (
 	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/set"
 	"github.com/tikv/client-go/v2/txnkv/txnsnapshot"
+	"golang.org/x/exp/slices"
 )
 
 // WithForeignKeyTrigger indicates the executor has foreign key check or cascade.
@@ -84,8 +85,15 @@ type FKCascadeExec struct {
 	// On delete statement, fkValues stores the delete foreign key values.
 	// On update statement and the foreign key cascade is `SET NULL`, fkValues stores the old foreign key values.
 	fkValues [][]types.Datum
+	// fkValuesSorted indicates whether fkValues is sorted by the encoded keys.
+	fkValuesSorted bool
 	// new-value-key => UpdatedValuesCouple
 	fkUpdatedValuesMap map[string]*UpdatedValuesCouple
+	// fkUpdatedValuesKeys is the keys of fkUpdatedValuesMap, the couples are cascaded in the order of the keys.
+	fkUpdatedValuesKeys       []string
+	fkUpdatedValuesKeysSorted bool
+	// fkUpdatingCoupleSorted indicates whether the old values of the first couple in fkUpdatedValuesKeys are sorted.
+	fkUpdatingCoupleSorted bool
 
 	stats *FKCascadeRuntimeStats
 }
@@ -646,6 +654,7 @@ func (fkc *FKCascadeExec) onDeleteRow(sc *stmtctx.StatementContext, row []types.
 		return err
 	}
 	fkc.fkValues = append(fkc.fkValues, vals)
+	fkc.fkValuesSorted = false
 	return nil
 }
 
@@ -656,6 +665,7 @@ func (fkc *FKCascadeExec) onUpdateRow(sc *stmtctx.StatementContext, oldRow, newR
 	}
 	if model.ReferOptionType(fkc.fk.OnUpdate) == model.ReferOptionSetNull {
 		fkc.fkValues = append(fkc.fkValues, oldVals)
+		fkc.fkValuesSorted = false
 		return nil
 	}
 	newVals, err := fkc.fetchFKValues(newRow)
@@ -671,6 +681,8 @@ func (fkc *FKCascadeExec) onUpdateRow(sc *stmtctx.StatementContext, oldRow, newR
 		couple = &UpdatedValuesCouple{
 			NewValues: newVals,
 		}
+		fkc.fkUpdatedValuesKeys = append(fkc.fkUpdatedValuesKeys, string(newValsKey))
+		fkc.fkUpdatedValuesKeysSorted = false
 	}
 	couple.OldValuesList = append(couple.OldValuesList, oldVals)
 	fkc.fkUpdatedValuesMap[string(newValsKey)] = couple
@@ -699,10 +711,14 @@ func (fkc *FKCascadeExec) buildFKCascadePlan(ctx context.Context) (plannercore.P
 	if fkc.fkIdx != nil {
 		indexName = fkc.fkIdx.Name
 	}
+	sc := fkc.b.ctx.GetSessionVars().StmtCtx
 	var stmtNode ast.StmtNode
 	switch fkc.tp {
 	case plannercore.FKCascadeOnDelete:
-		fkValues := fkc.fetchOnDeleteOrUpdateFKValues()
+		fkValues, err := fkc.fetchOnDeleteOrUpdateFKValues(sc)
+		if err != nil {
+			return nil, err
+		}
 		switch model.ReferOptionType(fkc.fk.OnDelete) {
 		case model.ReferOptionCascade:
 			stmtNode = GenCascadeDeleteAST(fkc.referredFK.ChildSchema, fkc.childTable.Name, indexName, fkc.fkCols, fkValues)
@@ -712,7 +728,10 @@ func (fkc *FKCascadeExec) buildFKCascadePlan(ctx context.Context) (plannercore.P
 	case plannercore.FKCascadeOnUpdate:
 		switch model.ReferOptionType(fkc.fk.OnUpdate) {
 		case model.ReferOptionCascade:
-			couple := fkc.fetchUpdatedValuesCouple()
+			couple, err := fkc.fetchUpdatedValuesCouple(sc)
+			if err != nil {
+				return nil, err
+			}
 			if couple != nil && len(couple.NewValues) != 0 {
 				if fkc.stats != nil {
 					fkc.stats.Keys += len(couple.OldValuesList)
@@ -720,7 +739,10 @@ func (fkc *FKCascadeExec) buildFKCascadePlan(ctx context.Context) (plannercore.P
 				stmtNode = GenCascadeUpdateAST(fkc.referredFK.ChildSchema, fkc.childTable.Name, indexName, fkc.fkCols, couple)
 			}
 		case model.ReferOptionSetNull:
-			fkValues := fkc.fetchOnDeleteOrUpdateFKValues()
+			fkValues, err := fkc.fetchOnDeleteOrUpdateFKValues(sc)
+			if err != nil {
+				return nil, err
+			}
 			stmtNode = GenCascadeSetNullAST(fkc.referredFK.ChildSchema, fkc.childTable.Name, indexName, fkc.fkCols, fkValues)
 		}
 	}
@@ -739,7 +761,15 @@ func (fkc *FKCascadeExec) buildFKCascadePlan(ctx context.Context) (plannercore.P
 	return finalPlan, err
 }
 
-func (fkc *FKCascadeExec) fetchOnDeleteOrUpdateFKValues() [][]types.Datum {
+// fetchOnDeleteOrUpdateFKValues fetches a batch of fkValues in the order of their encoded keys. The cascades of
+// the concurrent statements lock the child rows in the same order, so they wait for each other instead of deadlock.
+func (fkc *FKCascadeExec) fetchOnDeleteOrUpdateFKValues(sc *stmtctx.StatementContext) ([][]types.Datum, error) {
+	if !fkc.fkValuesSorted {
+		if err := sortFKValues(sc, fkc.fkValues); err != nil {
+			return nil, err
+		}
+		fkc.fkValuesSorted = true
+	}
 	var fkValues [][]types.Datum
 	if len(fkc.fkValues) <= maxHandleFKValueInOneCascade {
 		fkValues = fkc.fkValues
@@ -751,21 +781,64 @@ func (fkc *FKCascadeExec) fetchOnDeleteOrUpdateFKValues() [][]types.Datum {
 	if fkc.stats != nil {
 		fkc.stats.Keys += len(fkValues)
 	}
-	return fkValues
+	return fkValues, nil
 }
 
-func (fkc *FKCascadeExec) fetchUpdatedValuesCouple() *UpdatedValuesCouple {
-	for k, couple := range fkc.fkUpdatedValuesMap {
-		if len(couple.OldValuesList) <= maxHandleFKValueInOneCascade {
-			delete(fkc.fkUpdatedValuesMap, k)
-			return couple
+// fetchUpdatedValuesCouple fetches a batch of the old values updated to the same new values. The couples are
+// fetched in the order of the new values, and the old values of a couple are fetched in the order of their keys.
+func (fkc *FKCascadeExec) fetchUpdatedValuesCouple(sc *stmtctx.StatementContext) (*UpdatedValuesCouple, error) {
+	if len(fkc.fkUpdatedValuesKeys) == 0 {
+		return nil, nil
+	}
+	if !fkc.fkUpdatedValuesKeysSorted {
+		slices.Sort(fkc.fkUpdatedValuesKeys)
+		fkc.fkUpdatedValuesKeysSorted = true
+		fkc.fkUpdatingCoupleSorted = false
+	}
+	k := fkc.fkUpdatedValuesKeys[0]
+	couple := fkc.fkUpdatedValuesMap[k]
+	if !fkc.fkUpdatingCoupleSorted {
+		if err := sortFKValues(sc, couple.OldValuesList); err != nil {
+			return nil, err
 		}
-		result := &UpdatedValuesCouple{
-			NewValues:     couple.NewValues,
-			OldValuesList: couple.OldValuesList[:maxHandleFKValueInOneCascade],
+		fkc.fkUpdatingCoupleSorted = true
+	}
+	if len(couple.OldValuesList) <= maxHandleFKValueInOneCascade {
+		delete(fkc.fkUpdatedValuesMap, k)
+		fkc.fkUpdatedValuesKeys = fkc.fkUpdatedValuesKeys[1:]
+		fkc.fkUpdatingCoupleSorted = false
+		return couple, nil
+	}
+	result := &UpdatedValuesCouple{
+		NewValues:     couple.NewValues,
+		OldValuesList: couple.OldValuesList[:maxHandleFKValueInOneCascade],
+	}
+	couple.OldValuesList = couple.OldValuesList[maxHandleFKValueInOneCascade:]
+	return result, nil
+}
+
+// sortFKValues sorts the foreign key values by their encoded keys.
+func sortFKValues(sc *stmtctx.StatementContext, fkValues [][]types.Datum) error {
+	if len(fkValues) <= 1 {
+		return nil
+	}
+	type fkValueWithKey struct {
+		key  []byte
+		vals []types.Datum
+	}
+	items := make([]fkValueWithKey, 0, len(fkValues))
+	for _, vals := range fkValues {
+		key, err := codec.EncodeKey(sc, nil, vals...)
+		if err != nil {
+			return err
 		}
-		couple.OldValuesList = couple.OldValuesList[maxHandleFKValueInOneCascade:]
-		return result
+		items = append(items, fkValueWithKey{key: key, vals: vals})
+	}
+	slices.SortFunc(items, func(a, b fkValueWithKey) bool {
+		return bytes.Compare(a.key, b.key) < 0
+	})
+	for i := range items {
+		fkValues[i] = items[i].vals
 	}
 	return nil
 }
@@ -290,6 +290,26 @@ func (c *hashRowContainer) GetMatchedRowsAndPtrs(probeKey uint64, probeRow chunk
 	return matched, matchedPtrs, err
 }
 
+// hasAllNullNAKeysRow returns whether there is a build row whose null-aware join keys are all null.
+func (c *hashRowContainer) hasAllNullNAKeysRow() bool {
+	if c.hashNANullBucket == nil {
+		return false
+	}
+	for _, entry := range c.hashNANullBucket.entries {
+		allNull := true
+		for i := range c.hCtx.naKeyColIdx {
+			if !entry.nullBitMap.UnsafeIsSet(i) {
+				allNull = false
+				break
+			}
+		}
+		if allNull {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *hashRowContainer) GetNullBucketRows(probeHCtx *hashContext, probeSideRow chunk.Row,
 	probeKeyNullBits *bitmap.ConcurrentBitmap, matched []chunk.Row, needCheckBuildColPos, needCheckProbeColPos []int, needCheckBuildTypes, needCheckProbeTypes []*types.FieldType) ([]chunk.Row, error) {
 	var (
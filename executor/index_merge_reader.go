@@ -360,6 +360,8 @@ func (e *IndexMergeReaderExecutor) startPartialIndexWorker(ctx context.Context,
 					SetReadReplicaScope(e.readReplicaScope).
 					SetIsStaleness(e.isStaleness).
 					SetFromSessionVars(e.ctx.GetSessionVars()).
+					// The table rows are read at the start ts, so the index must be read at the same snapshot.
+					SetSnapshotRefreshInterval(0).
 					SetMemTracker(e.memTracker).
 					SetPaging(e.paging).
 					SetFromInfoSchema(e.ctx.GetInfoSchema()).
@@ -472,6 +474,7 @@ func (e *IndexMergeReaderExecutor) startPartialTableWorker(ctx context.Context,
 					plans:            e.partialPlans[workID],
 					ranges:           e.ranges[workID],
 					netDataSize:      e.partialNetDataSizes[workID],
+					pinSnapshot:      true,
 				}
 
 				worker := &partialTableWorker{
@@ -715,6 +718,7 @@ func (e *IndexMergeReaderExecutor) buildFinalTableReader(ctx context.Context, tb
 		feedback:         statistics.NewQueryFeedback(0, nil, 0, false),
 		plans:            e.tblPlans,
 		netDataSize:      e.dataAvgRowSize * float64(len(handles)),
+		pinSnapshot:      true,
 	}
 	tableReaderExec.buildVirtualColumnInfo()
 	// Reorder handles because SplitKeyRangesByLocations() requires startKey of kvRanges is ordered.
@@ -825,6 +829,9 @@ func (e *IndexMergeReaderExecutor) Close() error {
 	if e.finished == nil {
 		return nil
 	}
+	for _, idx := range e.indexes {
+		storeIndexUsage(e.ctx, e.id, e.table.Meta(), idx)
+	}
 	close(e.finished)
 	e.tblWorkerWg.Wait()
 	e.idxWorkerWg.Wait()
@@ -0,0 +1,258 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/distsql"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/statistics"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/ranger"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+// indexSkipScanner reads an index by skipping the duplicated prefixes. In each kv range, it probes the next distinct
+// prefix by reading the first entry, then reads the entries of the prefix by the original request, and continues
+// the kv range after the prefix. So each distinct prefix costs two requests no matter how many entries it has.
+type indexSkipScanner struct {
+	e *IndexReaderExecutor
+	// probeDAG reads the prefix columns of the first entry in a kv range.
+	probeDAG   *tipb.DAGRequest
+	probeTypes []*types.FieldType
+	// fetchDAG reads the entries of a prefix, it only reads one entry if there are no skip scan ranges.
+	fetchDAG *tipb.DAGRequest
+	feedback *statistics.QueryFeedback
+
+	kvRanges []kv.KeyRange
+	// cursor is the offset of the current kv range, and startKey is where the current kv range continues from.
+	cursor   int
+	startKey kv.Key
+	probeChk *chunk.Chunk
+	// result reads the entries of the current prefix.
+	result distsql.SelectResult
+}
+
+func newIndexSkipScanner(e *IndexReaderExecutor) *indexSkipScanner {
+	tblInfo := e.table.Meta()
+	probeTypes := make([]*types.FieldType, 0, e.skipScanPrefixLen)
+	for _, idxCol := range e.index.Columns[:e.skipScanPrefixLen] {
+		probeTypes = append(probeTypes, &tblInfo.Columns[idxCol.Offset].FieldType)
+	}
+	feedback := statistics.NewQueryFeedback(0, nil, 0, false)
+	feedback.Invalidate()
+	return &indexSkipScanner{
+		e:          e,
+		probeTypes: probeTypes,
+		feedback:   feedback,
+		probeChk:   chunk.NewChunkWithCapacity(probeTypes, 1),
+	}
+}
+
+// open builds the requests from the DAG request of the executor, and starts to read the kv ranges.
+func (s *indexSkipScanner) open(kvRanges []kv.KeyRange) {
+	e := s.e
+	tblInfo := e.table.Meta()
+	prefixCols := make([]*model.ColumnInfo, 0, e.skipScanPrefixLen)
+	outputOffsets := make([]uint32, 0, e.skipScanPrefixLen)
+	for i, idxCol := range e.index.Columns[:e.skipScanPrefixLen] {
+		prefixCols = append(prefixCols, tblInfo.Columns[idxCol.Offset])
+		outputOffsets = append(outputOffsets, uint32(i))
+	}
+	limitOne := &tipb.Executor{Tp: tipb.ExecType_TypeLimit, Limit: &tipb.Limit{Limit: 1}}
+	collect, unique := false, false
+
+	// The probing scan only decodes the prefix columns, which may not be read by the original request.
+	probeScan := *e.dagPB.Executors[0].IdxScan
+	probeScan.Columns = util.ColumnsToProto(prefixCols, tblInfo.PKIsHandle, true)
+	probeScan.Unique = &unique
+	probeDAG := *e.dagPB
+	probeDAG.Executors = []*tipb.Executor{{Tp: tipb.ExecType_TypeIndexScan, IdxScan: &probeScan}, limitOne}
+	probeDAG.OutputOffsets = outputOffsets
+	probeDAG.CollectRangeCounts = &collect
+	probeDAG.CollectExecutionSummaries = nil
+	s.probeDAG = &probeDAG
+
+	fetchDAG := *e.dagPB
+	fetchDAG.Executors = append([]*tipb.Executor(nil), e.dagPB.Executors...)
+	if len(e.skipScanRanges) == 0 {
+		fetchDAG.Executors = append(fetchDAG.Executors, limitOne)
+	}
+	fetchDAG.CollectRangeCounts = &collect
+	fetchDAG.CollectExecutionSummaries = nil
+	s.fetchDAG = &fetchDAG
+
+	s.kvRanges = kvRanges
+	s.cursor = 0
+	s.startKey = nil
+}
+
+func (s *indexSkipScanner) next(ctx context.Context, req *chunk.Chunk) error {
+	for {
+		if s.result != nil {
+			if err := s.result.Next(ctx, req); err != nil {
+				return err
+			}
+			if req.NumRows() > 0 {
+				return nil
+			}
+			if err := s.closeResult(); err != nil {
+				return err
+			}
+		}
+		kvRanges, err := s.nextPrefix(ctx)
+		if err != nil {
+			return err
+		}
+		if kvRanges == nil {
+			req.Reset()
+			return nil
+		}
+		if len(kvRanges) == 0 {
+			continue
+		}
+		s.result, err = s.selectResult(ctx, s.fetchDAG, kvRanges, retTypes(s.e))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// nextPrefix probes the next distinct prefix, and returns the kv ranges of the entries to read for it. It returns
+// nil if all the kv ranges are read.
+func (s *indexSkipScanner) nextPrefix(ctx context.Context) ([]kv.KeyRange, error) {
+	sc := s.e.ctx.GetSessionVars().StmtCtx
+	for s.cursor < len(s.kvRanges) {
+		kvRange := s.kvRanges[s.cursor]
+		if s.startKey != nil {
+			kvRange.StartKey = s.startKey
+		}
+		if len(kvRange.EndKey) > 0 && bytes.Compare(kvRange.StartKey, kvRange.EndKey) >= 0 {
+			s.cursor++
+			s.startKey = nil
+			continue
+		}
+		result, err := s.selectResult(ctx, s.probeDAG, []kv.KeyRange{kvRange}, s.probeTypes)
+		if err != nil {
+			return nil, err
+		}
+		err = result.Next(ctx, s.probeChk)
+		if closeErr := result.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		if s.probeChk.NumRows() == 0 {
+			s.cursor++
+			s.startKey = nil
+			continue
+		}
+
+		// The string prefix columns have binary collations, so the prefix is encoded as the same key in the index.
+		prefix := s.probeChk.GetRow(0).GetDatumRow(s.probeTypes)
+		encoded, err := codec.EncodeKey(sc, nil, prefix...)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		physicalID := tablecodec.DecodeTableID(kvRange.StartKey)
+		prefixKey := tablecodec.EncodeIndexSeekKey(physicalID, s.e.index.ID, encoded)
+		// The encoding of the index values is memory comparable, so the entries of the next prefix start after it.
+		s.startKey = prefixKey.PrefixNext()
+		if len(s.e.skipScanRanges) == 0 {
+			return clipKeyRanges([]kv.KeyRange{{StartKey: prefixKey, EndKey: s.startKey}}, kvRange), nil
+		}
+
+		ranges := make([]*ranger.Range, 0, len(s.e.skipScanRanges))
+		for _, ran := range s.e.skipScanRanges {
+			newRange := &ranger.Range{
+				LowVal:      make([]types.Datum, 0, len(prefix)+len(ran.LowVal)),
+				HighVal:     make([]types.Datum, 0, len(prefix)+len(ran.HighVal)),
+				Collators:   make([]collate.Collator, 0, len(prefix)+len(ran.Collators)),
+				LowExclude:  ran.LowExclude,
+				HighExclude: ran.HighExclude,
+			}
+			newRange.LowVal = append(append(newRange.LowVal, prefix...), ran.LowVal...)
+			newRange.HighVal = append(append(newRange.HighVal, prefix...), ran.HighVal...)
+			for _, tp := range s.probeTypes {
+				newRange.Collators = append(newRange.Collators, collate.GetCollator(tp.GetCollate()))
+			}
+			newRange.Collators = append(newRange.Collators, ran.Collators...)
+			ranges = append(ranges, newRange)
+		}
+		keyRanges, err := distsql.IndexRangesToKVRanges(sc, physicalID, s.e.index.ID, ranges, nil)
+		if err != nil {
+			return nil, err
+		}
+		return clipKeyRanges(keyRanges.FirstPartitionRange(), kvRange), nil
+	}
+	return nil, nil
+}
+
+func (s *indexSkipScanner) selectResult(ctx context.Context, dag *tipb.DAGRequest, kvRanges []kv.KeyRange, fieldTypes []*types.FieldType) (distsql.SelectResult, error) {
+	e := s.e
+	var builder distsql.RequestBuilder
+	builder.SetKeyRanges(kvRanges).
+		SetDAGRequest(dag).
+		SetStartTS(e.startTS).
+		SetKeepOrder(true).
+		SetTxnScope(e.txnScope).
+		SetReadReplicaScope(e.readReplicaScope).
+		SetIsStaleness(e.isStaleness).
+		SetFromSessionVars(e.ctx.GetSessionVars()).
+		SetFromInfoSchema(e.ctx.GetInfoSchema()).
+		SetMemTracker(e.memTracker).
+		SetConnID(e.ctx.GetSessionVars().ConnectionID)
+	kvReq, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return e.SelectResult(ctx, e.ctx, kvReq, fieldTypes, s.feedback, nil, e.id)
+}
+
+func (s *indexSkipScanner) closeResult() error {
+	if s.result == nil {
+		return nil
+	}
+	err := s.result.Close()
+	s.result = nil
+	return err
+}
+
+// clipKeyRanges returns the parts of the key ranges within the bound, the key ranges should be sorted.
+func clipKeyRanges(keyRanges []kv.KeyRange, bound kv.KeyRange) []kv.KeyRange {
+	clipped := make([]kv.KeyRange, 0, len(keyRanges))
+	for _, r := range keyRanges {
+		if bytes.Compare(r.StartKey, bound.StartKey) < 0 {
+			r.StartKey = bound.StartKey
+		}
+		if len(bound.EndKey) > 0 && (len(r.EndKey) == 0 || bytes.Compare(r.EndKey, bound.EndKey) > 0) {
+			r.EndKey = bound.EndKey
+		}
+		if len(r.EndKey) == 0 || bytes.Compare(r.StartKey, r.EndKey) < 0 {
+			clipped = append(clipped, r)
+		}
+	}
+	return clipped
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/statistics/handle"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+// storeIndexUsage records that the index is read by the executor of the plan, the rows read
+// are taken from the runtime stats if they are collected.
+func storeIndexUsage(sctx sessionctx.Context, planID int, tblInfo *model.TableInfo, idxInfo *model.IndexInfo) {
+	if tblInfo == nil || idxInfo == nil {
+		return
+	}
+	var actRows int64
+	if coll := sctx.GetSessionVars().StmtCtx.RuntimeStatsColl; coll != nil && coll.ExistsRootStats(planID) {
+		actRows = coll.GetBasicRuntimeStats(planID).GetActRows()
+	}
+	sctx.StoreIndexUsage(tblInfo.ID, idxInfo.ID, actRows)
+}
+
+// AdminShowUnusedIndexesExec is an executor for ADMIN SHOW UNUSED INDEXES. It reports the public
+// secondary indexes that haven't been read by any query since the index usage has been tracked.
+type AdminShowUnusedIndexesExec struct {
+	baseExecutor
+	done bool
+}
+
+// Next implements the Executor Next interface.
+func (e *AdminShowUnusedIndexesExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+	dom := domain.GetDomain(e.ctx)
+	if dom.IndexUsageSyncLease() <= 0 {
+		e.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.New("the index usage isn't tracked, " +
+			"set performance.index-usage-sync-lease to a positive value to enable it"))
+	}
+	if h := dom.StatsHandle(); h != nil {
+		// Flush the index usage collected by the sessions of this instance, so it's up-to-date.
+		if err := h.DumpIndexUsageToKV(); err != nil {
+			return err
+		}
+	}
+	used, err := e.loadUsedIndexes(ctx)
+	if err != nil {
+		return err
+	}
+
+	is := e.ctx.GetInfoSchema().(infoschema.InfoSchema)
+	dbs := is.AllSchemas()
+	sort.Slice(dbs, func(i, j int) bool { return dbs[i].Name.L < dbs[j].Name.L })
+	for _, db := range dbs {
+		if util.IsMemOrSysDB(db.Name.L) {
+			continue
+		}
+		tbls := make([]*model.TableInfo, len(db.Tables))
+		copy(tbls, db.Tables)
+		sort.Slice(tbls, func(i, j int) bool { return tbls[i].Name.L < tbls[j].Name.L })
+		for _, tbl := range tbls {
+			if tbl.IsView() || tbl.IsSequence() {
+				continue
+			}
+			for _, idx := range tbl.Indices {
+				if idx.Primary || idx.State != model.StatePublic {
+					continue
+				}
+				if _, ok := used[handle.GlobalIndexID{TableID: tbl.ID, IndexID: idx.ID}]; ok {
+					continue
+				}
+				req.AppendString(0, db.Name.O)
+				req.AppendString(1, tbl.Name.O)
+				req.AppendString(2, idx.Name.O)
+				req.AppendInt64(3, idx.ID)
+				if idx.Unique {
+					req.AppendInt64(4, 1)
+				} else {
+					req.AppendInt64(4, 0)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// loadUsedIndexes loads the indexes which have been read by queries from mysql.schema_index_usage.
+func (e *AdminShowUnusedIndexesExec) loadUsedIndexes(ctx context.Context) (map[handle.GlobalIndexID]struct{}, error) {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnAdmin)
+	exec := e.ctx.(sqlexec.RestrictedSQLExecutor)
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, "select table_id, index_id from mysql.schema_index_usage where query_count > 0")
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[handle.GlobalIndexID]struct{}, len(rows))
+	for _, row := range rows {
+		used[handle.GlobalIndexID{TableID: row.GetInt64(0), IndexID: row.GetInt64(1)}] = struct{}{}
+	}
+	return used, nil
+}
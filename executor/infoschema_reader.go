@@ -32,6 +32,7 @@ import (
 	rmpb "github.com/pingcap/kvproto/pkg/resource_manager"
 	"github.com/pingcap/tidb/ddl/label"
 	"github.com/pingcap/tidb/ddl/placement"
+	ddlutil "github.com/pingcap/tidb/ddl/util"
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/domain/infosync"
 	"github.com/pingcap/tidb/errno"
@@ -59,6 +60,8 @@ import (
 	"github.com/pingcap/tidb/util/codec"
 	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/deadlockhistory"
+	"github.com/pingcap/tidb/util/disk"
+	"github.com/pingcap/tidb/util/gcutil"
 	"github.com/pingcap/tidb/util/hint"
 	"github.com/pingcap/tidb/util/keydecoder"
 	"github.com/pingcap/tidb/util/logutil"
@@ -72,6 +75,7 @@ import (
 	"github.com/pingcap/tidb/util/sqlexec"
 	"github.com/pingcap/tidb/util/stringutil"
 	"github.com/pingcap/tidb/util/syncutil"
+	"github.com/pingcap/tidb/util/sys/storage"
 	"github.com/tikv/client-go/v2/txnkv/txnlock"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
@@ -184,6 +188,16 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			err = e.setDataForClusterMemoryUsageOpsHistory(sctx)
 		case infoschema.TableResourceGroups:
 			err = e.setDataFromResourceGroups()
+		case infoschema.TableTiDBTempPaths:
+			e.setDataForTiDBTempPaths()
+		case infoschema.ClusterTableTiDBTempPaths:
+			err = e.setDataForClusterTiDBTempPaths(sctx)
+		case infoschema.TableDDLQueue:
+			err = e.setDataForDDLQueue(sctx)
+		case infoschema.TableDeleteRanges:
+			err = e.setDataForDeleteRanges(sctx)
+		case infoschema.TableOptimizerTrace:
+			e.setDataForOptimizerTrace(sctx)
 		}
 		if err != nil {
 			return nil, err
@@ -2451,6 +2465,152 @@ func (e *memtableRetriever) setDataForClusterMemoryUsage(ctx sessionctx.Context)
 	return nil
 }
 
+func (e *memtableRetriever) setDataForTiDBTempPaths() {
+	for _, p := range disk.GetTempPaths() {
+		capacity := types.NewDatum(nil)
+		if c, err := storage.GetTargetDirectoryCapacity(p.Path); err == nil {
+			capacity.SetUint64(c)
+		}
+		row := []types.Datum{
+			types.NewStringDatum(p.Path),  // PATH
+			types.NewStringDatum(p.Usage), // USAGE
+			types.NewIntDatum(p.Quota),    // QUOTA
+			types.NewIntDatum(p.Used()),   // USED
+			capacity,                      // CAPACITY
+		}
+		e.rows = append(e.rows, row)
+	}
+}
+
+func (e *memtableRetriever) setDataForClusterTiDBTempPaths(ctx sessionctx.Context) error {
+	e.setDataForTiDBTempPaths()
+	rows, err := infoschema.AppendHostInfoToRows(ctx, e.rows)
+	if err != nil {
+		return err
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForDDLQueue summarizes the general and reorg queues of the DDL jobs in mysql.tidb_ddl_job. The jobs which
+// have been started by the DDL owner are running, and the others are waiting.
+func (e *memtableRetriever) setDataForDDLQueue(sctx sessionctx.Context) error {
+	exec := sctx.(sqlexec.RestrictedSQLExecutor)
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+	chunkRows, _, err := exec.ExecRestrictedSQL(ctx, nil, "SELECT reorg, processing, job_meta FROM mysql.tidb_ddl_job ORDER BY job_id")
+	if err != nil {
+		return err
+	}
+	queues := []string{"general", "reorg"}
+	waiting, running := make([]int64, len(queues)), make([]int64, len(queues))
+	oldest := make([]*model.Job, len(queues))
+	for _, chunkRow := range chunkRows {
+		queue := 0
+		if chunkRow.GetInt64(0) == 1 {
+			queue = 1
+		}
+		if chunkRow.GetInt64(1) == 1 {
+			running[queue]++
+			continue
+		}
+		waiting[queue]++
+		// The job IDs are allocated in order, so the first waiting job is the oldest one.
+		if oldest[queue] == nil {
+			job := &model.Job{}
+			if err := job.Decode(chunkRow.GetBytes(2)); err != nil {
+				return err
+			}
+			oldest[queue] = job
+		}
+	}
+	loc := sctx.GetSessionVars().Location()
+	now := time.Now()
+	rows := make([][]types.Datum, 0, len(queues))
+	for i, queue := range queues {
+		row := types.MakeDatums(queue, waiting[i], running[i], nil, nil, nil, nil)
+		if job := oldest[i]; job != nil {
+			createTime := model.TSConvert2Time(job.StartTS)
+			row[3].SetInt64(job.ID)
+			row[4].SetString(job.Type.String(), mysql.DefaultCollationName)
+			row[5].SetMysqlTime(types.NewTime(types.FromGoTime(createTime.In(loc)), mysql.TypeDatetime, 0))
+			row[6].SetInt64(int64(now.Sub(createTime).Seconds()))
+		}
+		rows = append(rows, row)
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForDeleteRanges lists the delete range tasks in mysql.gc_delete_range and mysql.gc_delete_range_done with the
+// DDL jobs which submitted them, so the disk space not reclaimed yet can be related to the pending deletions.
+func (e *memtableRetriever) setDataForDeleteRanges(sctx sessionctx.Context) error {
+	// The safe point isn't saved before the first GC, then none of the ranges is ready to be deleted.
+	safePoint, err := gcutil.GetGCSafePoint(sctx)
+	if err != nil {
+		safePoint = 0
+	}
+	exec := sctx.(sqlexec.RestrictedSQLExecutor)
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL)
+	chunkRows, _, err := exec.ExecRestrictedSQL(ctx, nil, `SELECT r.job_id, r.element_id, r.start_key, r.end_key, r.ts, r.done, h.db_name, h.table_name, h.job_meta
+		FROM (SELECT job_id, element_id, start_key, end_key, ts, 0 AS done FROM mysql.gc_delete_range
+			UNION ALL SELECT job_id, element_id, start_key, end_key, ts, 1 AS done FROM mysql.gc_delete_range_done) r
+		LEFT JOIN mysql.tidb_ddl_history h ON r.job_id = h.job_id
+		ORDER BY r.job_id, r.element_id, r.done`)
+	if err != nil {
+		return err
+	}
+	loc := sctx.GetSessionVars().Location()
+	jobTypes := make(map[int64]string)
+	rows := make([][]types.Datum, 0, len(chunkRows))
+	for _, chunkRow := range chunkRows {
+		jobID := chunkRow.GetInt64(0)
+		ts := chunkRow.GetUint64(4)
+		state := ddlutil.DeleteRangeStatePending
+		if chunkRow.GetInt64(5) == 1 {
+			state = ddlutil.DeleteRangeStateDone
+		} else if ts >= safePoint {
+			state = ddlutil.DeleteRangeStateWaitingSafePoint
+		}
+		row := types.MakeDatums(jobID, chunkRow.GetInt64(1), nil, nil, nil, nil,
+			chunkRow.GetString(2), chunkRow.GetString(3), nil, state)
+		// The job history may have been cleaned up or not recorded for the old jobs.
+		if !chunkRow.IsNull(8) {
+			jobType, ok := jobTypes[jobID]
+			if !ok {
+				job := &model.Job{}
+				if err := job.Decode(chunkRow.GetBytes(8)); err != nil {
+					return err
+				}
+				jobType = job.Type.String()
+				jobTypes[jobID] = jobType
+			}
+			row[2].SetString(jobType, mysql.DefaultCollationName)
+			row[3].SetString(chunkRow.GetString(6), mysql.DefaultCollationName)
+			row[4].SetString(chunkRow.GetString(7), mysql.DefaultCollationName)
+		}
+		if startKey, err := hex.DecodeString(chunkRow.GetString(2)); err == nil {
+			if tableID := tablecodec.DecodeTableID(startKey); tableID != 0 {
+				row[5].SetInt64(tableID)
+			}
+		}
+		row[8].SetMysqlTime(types.NewTime(types.FromGoTime(model.TSConvert2Time(ts).In(loc)), mysql.TypeDatetime, 0))
+		rows = append(rows, row)
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForOptimizerTrace lists the optimizer traces of the latest statements of the session, which are kept if
+// tidb_enable_optimizer_trace is on.
+func (e *memtableRetriever) setDataForOptimizerTrace(sctx sessionctx.Context) {
+	traces := sctx.GetSessionVars().OptimizerTraces
+	rows := make([][]types.Datum, 0, len(traces))
+	for _, trace := range traces {
+		rows = append(rows, types.MakeDatums(trace.Query, trace.Trace, trace.MissingBytes, 0))
+	}
+	e.rows = rows
+}
+
 func (e *memtableRetriever) setDataForMemoryUsageOpsHistory(ctx sessionctx.Context) error {
 	e.rows = servermemorylimit.GlobalMemoryOpsHistoryManager.GetRows()
 	return nil
@@ -15,6 +15,7 @@
 package executor_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
@@ -32,6 +33,7 @@ import (
 	"github.com/pingcap/tidb/statistics/handle"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/util/stringutil"
+	"github.com/pingcap/tidb/util/tracing"
 	"github.com/stretchr/testify/require"
 )
 
@@ -882,3 +884,86 @@ func TestNullColumns(t *testing.T) {
 	tk.MustQuery("select * from  information_schema.columns where TABLE_SCHEMA = 'test' and TABLE_NAME = 'v_test';").
 		Check(testkit.Rows("def test v_test type 1 <nil> YES binary 0 0 <nil> <nil> <nil> <nil> <nil> binary(0)   select,insert,update,references  "))
 }
+
+func TestDeleteRanges(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tableID := tk.MustQuery("select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 't'").Rows()[0][0].(string)
+	tk.MustExec("drop table t")
+	jobID := tk.MustQuery("select job_id from mysql.tidb_ddl_history order by job_id desc limit 1").Rows()[0][0].(string)
+	// The range of the dropped table is deleted by the delete range emulator of the mock store.
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("select * from mysql.gc_delete_range_done where job_id = "+jobID).Rows()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	tk.MustQuery("select job_id, job_type, db_name, table_name, table_id, submit_time is not null, state from information_schema.delete_ranges where job_id = " + jobID).Check(testkit.Rows(
+		jobID + " drop table test t " + tableID + " 1 done"))
+
+	// The ranges are waiting for the safe point before it's saved by the first GC.
+	tk.MustExec("insert into mysql.gc_delete_range select job_id, 100, start_key, end_key, 1 from mysql.gc_delete_range_done where job_id = " + jobID)
+	tk.MustExec("insert into mysql.gc_delete_range select job_id, 101, start_key, end_key, ts from mysql.gc_delete_range_done where job_id = " + jobID)
+	tk.MustQuery("select element_id, table_id, state from information_schema.delete_ranges where element_id >= 100").Check(testkit.Rows(
+		"100 "+tableID+" waiting_safe_point",
+		"101 "+tableID+" waiting_safe_point"))
+	tk.MustExec(`insert into mysql.tidb values ('tikv_gc_safe_point', '20160102-15:04:05 -0700', '')
+		on duplicate key update variable_value = '20160102-15:04:05 -0700'`)
+	tk.MustQuery("select element_id, state from information_schema.delete_ranges where element_id >= 100").Check(testkit.Rows(
+		"100 pending",
+		"101 waiting_safe_point"))
+
+	// The job history is missing for the ranges submitted by the unknown jobs.
+	tk.MustExec("insert into mysql.gc_delete_range_done values (0, 1, '', '', 1)")
+	tk.MustQuery("select job_type, db_name, table_name, table_id, state from information_schema.delete_ranges where job_id = 0").Check(testkit.Rows(
+		"<nil> <nil> <nil> <nil> done"))
+}
+
+func TestOptimizerTrace(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index idx(a))")
+	tk.MustQuery("select * from t where a > 1").Check(testkit.Rows())
+	tk.MustQuery("select * from information_schema.optimizer_trace").Check(testkit.Rows())
+
+	tk.MustExec("set tidb_enable_optimizer_trace = 1")
+	tk.MustQuery("select * from t where a > 1").Check(testkit.Rows())
+	tk.MustExec("create table t2(a int)")
+	tk.MustQuery("select b from t where a = 1 order by b").Check(testkit.Rows())
+	// The statements reading the optimizer traces and the DDL statements aren't traced.
+	rows := tk.MustQuery("select query, trace, missing_bytes_beyond_max_mem_size, insufficient_privileges from information_schema.optimizer_trace").Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, "select * from t where a > 1", rows[0][0])
+	require.Equal(t, "select b from t where a = 1 order by b", rows[1][0])
+	for _, row := range rows {
+		trace := &tracing.OptimizeTracer{}
+		require.NoError(t, json.Unmarshal([]byte(row[1].(string)), trace))
+		require.NotEmpty(t, trace.Logical.Steps)
+		require.NotEmpty(t, trace.FinalPlan)
+		// The candidates are kept with their costs whether they're selected or not.
+		var selected, rejected int
+		for _, candidate := range trace.Physical.Candidates {
+			if candidate.Selected {
+				selected++
+			} else {
+				rejected++
+			}
+		}
+		require.Greater(t, selected, 0)
+		require.Greater(t, rejected, 0)
+		require.Equal(t, "0", row[2])
+		require.Equal(t, "0", row[3])
+	}
+
+	// Only the latest traces are kept.
+	for i := 0; i < variable.MaxOptimizerTraces; i++ {
+		tk.MustQuery(fmt.Sprintf("select * from t where a = %d", i)).Check(testkit.Rows())
+	}
+	rows = tk.MustQuery("select query from information_schema.optimizer_trace").Rows()
+	require.Len(t, rows, variable.MaxOptimizerTraces)
+	require.Equal(t, "select * from t where a = 0", rows[0][0])
+
+	tk.MustExec("set tidb_enable_optimizer_trace = 0")
+	tk.MustQuery("select * from t where a > 2").Check(testkit.Rows())
+	require.Len(t, tk.MustQuery("select query from information_schema.optimizer_trace").Rows(), variable.MaxOptimizerTraces)
+}
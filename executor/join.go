@@ -68,8 +68,16 @@ type hashJoinCtx struct {
 	buildTypes         []*types.FieldType
 	outerFilter        expression.CNFExprs
 	isNullAware        bool
+	hasOtherCondition  bool
 	memTracker         *memory.Tracker // track memory usage.
 	diskTracker        *disk.Tracker   // track disk usage.
+
+	// runtimeFilter is not nil if the probe side is opened after the build side is finished, with the filter generated
+	// from the build side keys.
+	runtimeFilter *joinRuntimeFilter
+	// adaptive is not nil if the probe side is opened after the build side is checked, the join switches to the index
+	// join if the build side has far more rows than estimated.
+	adaptive *adaptiveIndexJoin
 }
 
 // probeSideTupleFetcher reads tuples from probeSideExec and send them to probeWorkers.
@@ -172,6 +180,10 @@ func (e *HashJoinExec) Close() error {
 			channel.Clear(e.probeWorkers[i].joinChkResourceCh)
 		}
 		e.probeSideTupleFetcher.probeChkResourceCh = nil
+		// The build side rows are spilled at most once.
+		if e.rowContainer.rowContainer.AlreadySpilled() {
+			e.registerSpillStats(1, e.diskTracker)
+		}
 		terror.Call(e.rowContainer.Close)
 		e.waiterWg.Wait()
 	}
@@ -189,16 +201,40 @@ func (e *HashJoinExec) Close() error {
 	if e.stats != nil && e.rowContainer != nil {
 		e.stats.hashStat = *e.rowContainer.stat
 	}
+	if e.stats != nil && e.runtimeFilter != nil {
+		e.stats.runtimeFilter = e.runtimeFilter.desc
+	}
+	if e.stats != nil && e.adaptive != nil {
+		e.stats.adaptiveJoin = e.adaptive.desc
+	}
 	if e.stats != nil {
 		defer e.ctx.GetSessionVars().StmtCtx.RuntimeStatsColl.RegisterStats(e.id, e.stats)
 	}
+	if e.adaptive != nil {
+		return e.closeAdaptiveJoin()
+	}
+	if e.runtimeFilter != nil && !e.runtimeFilter.probeOpened.Load() {
+		return e.buildWorker.buildSideExec.Close()
+	}
 	err := e.baseExecutor.Close()
 	return err
 }
 
 // Open implements the Executor Open interface.
 func (e *HashJoinExec) Open(ctx context.Context) error {
-	if err := e.baseExecutor.Open(ctx); err != nil {
+	var err error
+	if e.runtimeFilter != nil {
+		// The probe side is opened after the runtime filter is pushed to it, see fetchProbeSideChunks.
+		e.runtimeFilter.reset()
+		err = e.buildWorker.buildSideExec.Open(ctx)
+	} else if e.adaptive != nil {
+		// The probe side is opened after the build side is checked, see decideAdaptiveJoin.
+		e.adaptive.reset()
+		err = e.buildWorker.buildSideExec.Open(ctx)
+	} else {
+		err = e.baseExecutor.Open(ctx)
+	}
+	if err != nil {
 		e.closeCh = nil
 		e.prepared = false
 		return err
@@ -231,6 +267,21 @@ func (e *HashJoinExec) Open(ctx context.Context) error {
 // and sends the chunks to multiple channels which will be read by multiple join workers.
 func (fetcher *probeSideTupleFetcher) fetchProbeSideChunks(ctx context.Context, maxChunkSize int) {
 	hasWaitedForBuild := false
+	if fetcher.runtimeFilter != nil {
+		emptyBuild, buildErr := fetcher.wait4BuildSide()
+		if buildErr == nil && !emptyBuild {
+			buildErr = fetcher.runtimeFilter.openProbeSide(ctx, fetcher.sessCtx, fetcher.memTracker)
+		}
+		if buildErr != nil {
+			fetcher.joinResultCh <- &hashjoinWorkerResult{
+				err: buildErr,
+			}
+			return
+		} else if emptyBuild {
+			return
+		}
+		hasWaitedForBuild = true
+	}
 	for {
 		if fetcher.finished.Load() {
 			return
@@ -300,6 +351,11 @@ func (fetcher *probeSideTupleFetcher) wait4BuildSide() (emptyBuild bool, err err
 	if fetcher.rowContainer.Len() == uint64(0) && (fetcher.joinType == plannercore.InnerJoin || fetcher.joinType == plannercore.SemiJoin) {
 		return true, nil
 	}
+	// For x NOT IN (y set), a y whose keys are all null refuses every x, so the probe side needs no fetching.
+	if fetcher.joinType == plannercore.AntiSemiJoin && fetcher.isNullAware && !fetcher.hasOtherCondition && !fetcher.useOuterToBuild &&
+		fetcher.rowContainer.hasAllNullNAKeysRow() {
+		return true, nil
+	}
 	return false, nil
 }
 
@@ -315,6 +371,21 @@ func (w *buildWorker) fetchBuildSideRows(ctx context.Context, chkCh chan<- *chun
 			return
 		}
 	})
+	if adaptive := w.hashJoinCtx.adaptive; adaptive != nil {
+		chks, exhausted := adaptive.takePrefetched(w.hashJoinCtx.memTracker)
+		for _, chk := range chks {
+			select {
+			case <-doneCh:
+				return
+			case <-w.hashJoinCtx.closeCh:
+				return
+			case chkCh <- chk:
+			}
+		}
+		if exhausted {
+			return
+		}
+	}
 	sessVars := w.hashJoinCtx.sessCtx.GetSessionVars()
 	for {
 		if w.hashJoinCtx.finished.Load() {
@@ -1102,6 +1173,16 @@ func (w *probeWorker) join2ChunkForOuterHashJoin(probeSideChk *chunk.Chunk, hCtx
 // step 1. fetch data from build side child and build a hash table;
 // step 2. fetch data from probe child in a background goroutine and probe the hash table in multiple join workers.
 func (e *HashJoinExec) Next(ctx context.Context, req *chunk.Chunk) (err error) {
+	if e.adaptive != nil {
+		if !e.adaptive.decided {
+			if err = e.decideAdaptiveJoin(ctx); err != nil {
+				return err
+			}
+		}
+		if e.adaptive.switched {
+			return Next(ctx, e.adaptive.indexJoin, req)
+		}
+	}
 	if !e.prepared {
 		e.buildFinished = make(chan error, 1)
 		hCtx := &hashContext{
@@ -1220,6 +1301,9 @@ func (w *buildWorker) buildHashTableForList(buildSideResultCh <-chan *chunk.Chun
 		}
 		if !w.hashJoinCtx.useOuterToBuild {
 			err = rowContainer.PutChunk(chk, w.hashJoinCtx.isNullEQ)
+			if err == nil && w.hashJoinCtx.runtimeFilter != nil {
+				w.hashJoinCtx.runtimeFilter.collect(chk, w.hashJoinCtx.memTracker)
+			}
 		} else {
 			var bitMap = bitmap.NewConcurrentBitmap(chk.NumRows())
 			w.hashJoinCtx.outerMatchedStatus = append(w.hashJoinCtx.outerMatchedStatus, bitMap)
@@ -1571,6 +1655,8 @@ type hashJoinRuntimeStats struct {
 	probe                  int64
 	concurrent             int
 	maxFetchAndProbe       int64
+	runtimeFilter          string
+	adaptiveJoin           string
 }
 
 func (e *hashJoinRuntimeStats) setMaxFetchAndProbeTime(t int64) {
@@ -1618,6 +1704,16 @@ func (e *hashJoinRuntimeStats) String() string {
 		}
 		buf.WriteString("}")
 	}
+	if e.runtimeFilter != "" {
+		buf.WriteString(", runtime_filter:{")
+		buf.WriteString(e.runtimeFilter)
+		buf.WriteString("}")
+	}
+	if e.adaptiveJoin != "" {
+		buf.WriteString(", adaptive_join:{")
+		buf.WriteString(e.adaptiveJoin)
+		buf.WriteString("}")
+	}
 	return buf.String()
 }
 
@@ -1629,6 +1725,8 @@ func (e *hashJoinRuntimeStats) Clone() execdetails.RuntimeStats {
 		probe:                  e.probe,
 		concurrent:             e.concurrent,
 		maxFetchAndProbe:       e.maxFetchAndProbe,
+		runtimeFilter:          e.runtimeFilter,
+		adaptiveJoin:           e.adaptiveJoin,
 	}
 }
 
@@ -1645,4 +1743,10 @@ func (e *hashJoinRuntimeStats) Merge(rs execdetails.RuntimeStats) {
 	if e.maxFetchAndProbe < tmp.maxFetchAndProbe {
 		e.maxFetchAndProbe = tmp.maxFetchAndProbe
 	}
+	if e.runtimeFilter == "" {
+		e.runtimeFilter = tmp.runtimeFilter
+	}
+	if e.adaptiveJoin == "" {
+		e.adaptiveJoin = tmp.adaptiveJoin
+	}
 }
@@ -15,10 +15,14 @@
 package executor_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/testkit/testdata"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNaturalJoin(t *testing.T) {
@@ -98,3 +102,154 @@ func TestTiDBNAAJ(t *testing.T) {
 	tk.MustQuery("select ( table1 . a , table1 . b ) NOT IN ( SELECT 3 , 2 UNION  SELECT 9, 2 ) AS field2 from t as table1 order by field2;").Check(testkit.Rows(
 		"0", "0", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1"))
 }
+
+func TestNAAJWithNullBuildKeys(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@session.tidb_enable_null_aware_anti_join=1;")
+	tk.MustExec("create table t(a int, b int)")
+	tk.MustExec("create table s(a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (NULL, 3)")
+	tk.MustExec("insert into s values (1, 1)")
+	tk.MustQuery("select * from t where a not in (select a from s) order by b").Check(testkit.Rows("2 2"))
+
+	// A null key of the build side refuses all the probe rows.
+	tk.MustExec("insert into s values (NULL, 2)")
+	tk.MustQuery("select * from t where a not in (select a from s) order by b").Check(testkit.Rows())
+	tk.MustQuery("select * from t where (a, b) not in (select a, b from s) order by b").Check(testkit.Rows("<nil> 3"))
+	tk.MustExec("insert into s values (NULL, NULL)")
+	tk.MustQuery("select * from t where (a, b) not in (select a, b from s) order by b").Check(testkit.Rows())
+	// The other conditions filter the build rows for each probe row.
+	tk.MustQuery("select * from t where a not in (select a from s where s.b > t.b) order by b").Check(testkit.Rows("2 2", "<nil> 3"))
+	tk.MustQuery("select b, a not in (select a from s) from t order by b").Check(testkit.Rows("1 0", "2 <nil>", "3 <nil>"))
+}
+
+func TestHashJoinRuntimeFilter(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int)")
+	tk.MustExec("create table t3(a bigint unsigned, b int)")
+	vals := make([]string, 0, 3001)
+	for i := 0; i < 3000; i++ {
+		vals = append(vals, fmt.Sprintf("(%d, %d)", i, i%100))
+	}
+	vals = append(vals, "(null, 1)")
+	tk.MustExec("insert into t2 values " + strings.Join(vals, ","))
+	tk.MustExec("insert into t3 select a, b from t2")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (2, 3), (null, 4), (5000, 5)")
+
+	// runtimeFilterInfo returns the operator info and the execution info of the hash join.
+	runtimeFilterInfo := func(sql string) (string, string) {
+		for _, row := range tk.MustQuery("explain analyze " + sql).Rows() {
+			if strings.Contains(row[0].(string), "HashJoin") {
+				return row[6].(string), row[5].(string)
+			}
+		}
+		return "", ""
+	}
+	for _, c := range []struct {
+		sql      string
+		probeCol string
+		filter   string
+	}{
+		{"select /*+ hash_join_build(t1) */ * from t1 join t2 on t1.a = t2.a", "test.t2.a", "in, keys:3"},
+		{"select /*+ hash_join_build(t1) */ * from t1 join t2 on t1.a = t2.a and t1.b < t2.b where t2.b > 1", "test.t2.a", "in, keys:3"},
+		{"select /*+ hash_join_build(x) */ * from t3 x join t3 y on x.a = y.a where x.b = 1", "test.t3.a", "in, keys:30"},
+		{"select /*+ hash_join_build(x) */ count(*) from t2 x join t2 y on x.a = y.a where x.b < 50", "test.t2.a", "bloom, keys:1500"},
+		// The build side is empty, so the probe side isn't read.
+		{"select /*+ hash_join_build(t1) */ * from t1 join t2 on t1.a = t2.a where t1.b > 10", "test.t2.a", ""},
+		// The keys have different signedness.
+		{"select /*+ hash_join_build(t1) */ * from t1 join t3 on t1.a = t3.a", "", ""},
+		// The null keys are joined by the null-safe equal condition.
+		{"select /*+ hash_join_build(t1) */ * from t1 join t2 on t1.a <=> t2.a", "", ""},
+		// The rows of the probe side are all returned by the outer join.
+		{"select /*+ hash_join_build(t1) */ * from t1 right join t2 on t1.a = t2.a", "", ""},
+	} {
+		tk.MustExec("set @@tidb_enable_runtime_filter = off")
+		expected := tk.MustQuery(c.sql).Sort().Rows()
+		tk.MustExec("set @@tidb_enable_runtime_filter = on")
+		tk.MustQuery(c.sql).Sort().Check(expected)
+		operatorInfo, execInfo := runtimeFilterInfo(c.sql)
+		if c.probeCol == "" {
+			require.NotContains(t, operatorInfo, "runtime filter", c.sql)
+			continue
+		}
+		require.Contains(t, operatorInfo, "runtime filter:"+c.probeCol, c.sql)
+		if c.filter != "" {
+			require.Contains(t, execInfo, "runtime_filter:{"+c.filter+"}", c.sql)
+		}
+	}
+	tk.MustExec("set @@tidb_enable_runtime_filter = on")
+	tk.MustQuery("select t2.* from t2 where exists (select 1 from t1 where t1.a = t2.a) order by t2.a").Check(testkit.Rows("1 1", "2 2"))
+}
+
+func TestAdaptiveHashJoin(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int, key(a))")
+	vals := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		vals = append(vals, fmt.Sprintf("(%d, %d)", i, i%10))
+	}
+	tk.MustExec("insert into t1 values " + strings.Join(vals, ","))
+	tk.MustExec("insert into t2 select a, b from t1 where a < 10")
+	tk.MustExec("analyze table t1, t2")
+	// The build side t2 has far more rows than the stats after the insertion.
+	tk.MustExec("insert into t2 select a, b from t1")
+	tk.MustExec("set @@tidb_max_chunk_size = 32")
+
+	// adaptiveJoinInfo returns the operator info and the execution info of the hash join.
+	adaptiveJoinInfo := func(sql string) (string, string) {
+		for _, row := range tk.MustQuery("explain analyze " + sql).Rows() {
+			if strings.Contains(row[0].(string), "HashJoin") {
+				return row[6].(string), row[5].(string)
+			}
+		}
+		return "", ""
+	}
+	for _, c := range []struct {
+		sql      string
+		decision string
+	}{
+		{"select t1.a, t2.b from t1 join t2 on t1.a = t2.a", "switched to index join, build rows:>100"},
+		{"select t1.a, t2.b from t1 left join t2 on t1.a = t2.a and t2.b < 5", "switched to index join, build rows:>100"},
+		// The rows read are built into the hash table.
+		{"select t1.a, t2.b from t1 join t2 on t1.a = t2.a where t2.a < 50", "hash join, build rows:60"},
+	} {
+		tk.MustExec("set @@tidb_enable_adaptive_join = off")
+		expected := tk.MustQuery(c.sql).Sort().Rows()
+		tk.MustExec("set @@tidb_enable_adaptive_join = on")
+		tk.MustQuery(c.sql).Sort().Check(expected)
+		operatorInfo, execInfo := adaptiveJoinInfo(c.sql)
+		require.Contains(t, operatorInfo, "adaptive:", c.sql)
+		require.Contains(t, execInfo, "adaptive_join:{"+c.decision+"}", c.sql)
+	}
+	// The hints are respected.
+	operatorInfo, _ := adaptiveJoinInfo("select /*+ hash_join(t1, t2) */ t1.a, t2.b from t1 join t2 on t1.a = t2.a")
+	require.NotContains(t, operatorInfo, "adaptive:")
+
+	// The outer side of the index join is cloned from the probe side of the hash join with its own plan IDs.
+	tk.MustQuery("select t1.a, t2.b from t1 join t2 on t1.a = t2.a")
+	var hashJoin *plannercore.PhysicalHashJoin
+	var findHashJoin func(p plannercore.PhysicalPlan)
+	findHashJoin = func(p plannercore.PhysicalPlan) {
+		if join, ok := p.(*plannercore.PhysicalHashJoin); ok {
+			hashJoin = join
+			return
+		}
+		for _, child := range p.Children() {
+			findHashJoin(child)
+		}
+	}
+	findHashJoin(tk.Session().GetSessionVars().StmtCtx.GetPlan().(plannercore.PhysicalPlan))
+	require.NotNil(t, hashJoin)
+	require.NotNil(t, hashJoin.AdaptiveIndexJoin)
+	probe := hashJoin.Children()[1-hashJoin.InnerChildIdx]
+	outer := hashJoin.AdaptiveIndexJoin.Children()[1-hashJoin.InnerChildIdx]
+	require.NotEqual(t, probe.ID(), outer.ID())
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+// MergeUnionExec merges the rows of the children which are ordered by the same keys, so its result is in the order
+// too. Unlike UnionExec, it reads the children in the main thread a chunk at a time, so the limit above it stops
+// reading the children once it has enough rows.
+type MergeUnionExec struct {
+	baseExecutor
+
+	// keyColumns are the offsets of the keys in the rows of the children.
+	keyColumns  []int
+	keyCmpFuncs []chunk.CompareFunc
+	keyDesc     []bool
+
+	// childChunks are the chunks read from each child, whose rows are being merged.
+	childChunks []*chunk.Chunk
+	// merge is the heap of the next row of each child, which is initialized at the first Next.
+	merge *multiWayMerge
+}
+
+// Open implements the Executor Open interface.
+func (e *MergeUnionExec) Open(ctx context.Context) error {
+	e.childChunks = nil
+	e.merge = nil
+	return e.baseExecutor.Open(ctx)
+}
+
+// Next implements the Executor Next interface.
+func (e *MergeUnionExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.merge == nil {
+		if err := e.initMerge(ctx); err != nil {
+			return err
+		}
+	}
+	for !req.IsFull() && e.merge.Len() > 0 {
+		ptr := e.merge.elements[0]
+		req.AppendRow(ptr.row)
+		ptr.consumed++
+		chk := e.childChunks[ptr.partitionID]
+		if ptr.consumed >= chk.NumRows() {
+			// The row is copied into req, so the chunk can be reused.
+			if err := Next(ctx, e.children[ptr.partitionID], chk); err != nil {
+				return err
+			}
+			if chk.NumRows() == 0 {
+				heap.Remove(e.merge, 0)
+				continue
+			}
+			ptr.consumed = 0
+		}
+		ptr.row = chk.GetRow(ptr.consumed)
+		e.merge.elements[0] = ptr
+		heap.Fix(e.merge, 0)
+	}
+	return nil
+}
+
+// initMerge reads the first chunk of each child and puts their first rows into the heap.
+func (e *MergeUnionExec) initMerge(ctx context.Context) error {
+	childChunks := make([]*chunk.Chunk, 0, len(e.children))
+	merge := &multiWayMerge{e.lessRow, make([]partitionPointer, 0, len(e.children))}
+	for i, child := range e.children {
+		chk := newFirstChunk(child)
+		if err := Next(ctx, child, chk); err != nil {
+			return err
+		}
+		childChunks = append(childChunks, chk)
+		if chk.NumRows() > 0 {
+			merge.elements = append(merge.elements, partitionPointer{row: chk.GetRow(0), partitionID: i, consumed: 0})
+		}
+	}
+	heap.Init(merge)
+	e.childChunks, e.merge = childChunks, merge
+	return nil
+}
+
+func (e *MergeUnionExec) lessRow(rowI, rowJ chunk.Row) bool {
+	for i, colIdx := range e.keyColumns {
+		cmp := e.keyCmpFuncs[i](rowI, colIdx, rowJ, colIdx)
+		if e.keyDesc[i] {
+			cmp = -cmp
+		}
+		if cmp < 0 {
+			return true
+		} else if cmp > 0 {
+			return false
+		}
+	}
+	return false
+}
+
+// Close implements the Executor Close interface.
+func (e *MergeUnionExec) Close() error {
+	e.childChunks = nil
+	e.merge = nil
+	return e.baseExecutor.Close()
+}
@@ -4092,3 +4092,72 @@ from
 		`  └─Selection_18 0.80 cop[tikv]  eq(test39999.c.occur_trade_date, 2022-11-17 00:00:00.000000)`,
 		`    └─TableRangeScan_17 0.80 cop[tikv] table:c range: decided by [eq(test39999.c.txt_account_id, test39999.t.txn_account_id) eq(test39999.c.serial_id, test39999.t.serial_id) eq(test39999.c.occur_trade_date, 2022-11-17 00:00:00.000000)], keep order:false`))
 }
+
+func TestPartitionWiseJoin(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec("set @@tidb_enable_partition_wise_join = 1")
+	tk.MustExec("create table th1(a int, b int) partition by hash(a) partitions 4")
+	tk.MustExec("create table th2(a int, b int) partition by hash(a) partitions 4")
+	tk.MustExec("create table th3(a int, b int) partition by hash(a) partitions 3")
+	tk.MustExec(`create table tr1(a int, b int) partition by range(a) (
+		partition p0 values less than (100), partition p1 values less than (200), partition p2 values less than maxvalue)`)
+	tk.MustExec(`create table tr2(a int, b int) partition by range(a) (
+		partition p0 values less than (100), partition p1 values less than (200), partition p2 values less than maxvalue)`)
+	tk.MustExec("create table tn1(a int, b int)")
+	tk.MustExec("create table tn2(a int, b int)")
+
+	vals1 := make([]string, 0, 500)
+	vals2 := make([]string, 0, 500)
+	for i := 0; i < 500; i++ {
+		vals1 = append(vals1, fmt.Sprintf("(%v, %v)", rand.Intn(300), rand.Intn(300)))
+		vals2 = append(vals2, fmt.Sprintf("(%v, %v)", rand.Intn(300), rand.Intn(300)))
+	}
+	vals1 = append(vals1, "(null, 1)")
+	vals2 = append(vals2, "(null, 1)")
+	for _, tbl := range []string{"th1", "tr1", "tn1"} {
+		tk.MustExec(fmt.Sprintf("insert into %s values %s", tbl, strings.Join(vals1, ",")))
+	}
+	for _, tbl := range []string{"th2", "th3", "tr2", "tn2"} {
+		tk.MustExec(fmt.Sprintf("insert into %s values %s", tbl, strings.Join(vals2, ",")))
+	}
+	tk.MustExec("analyze table th1, th2, th3, tr1, tr2, tn1, tn2")
+
+	isPartitionWise := func(sql string) bool {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "HashJoin") && strings.Contains(row[4].(string), "partition-wise") {
+				return true
+			}
+		}
+		return false
+	}
+	queries := []string{
+		"select /*+ hash_join(%[1]s, %[2]s) */ * from %[1]s join %[2]s on %[1]s.a = %[2]s.a",
+		"select /*+ hash_join(%[1]s, %[2]s) */ * from %[1]s join %[2]s on %[1]s.a = %[2]s.a and %[1]s.b > %[2]s.b",
+		"select /*+ hash_join(%[1]s, %[2]s) */ * from %[1]s left join %[2]s on %[1]s.a = %[2]s.a and %[2]s.b < 100",
+		"select /*+ hash_join(%[1]s, %[2]s) */ * from %[1]s right join %[2]s on %[1]s.a = %[2]s.a where %[2]s.a < 150",
+		"select /*+ hash_join(%[1]s, %[2]s) */ * from %[1]s join %[2]s on %[1]s.a <=> %[2]s.a",
+		"select /*+ hash_join(%[1]s) */ * from %[1]s where exists (select 1 from %[2]s where %[1]s.a = %[2]s.a)",
+		"select /*+ hash_join(%[1]s) */ * from %[1]s where not exists (select 1 from %[2]s where %[1]s.a = %[2]s.a)",
+	}
+	for _, pair := range [][2]string{{"th1", "th2"}, {"tr1", "tr2"}} {
+		for _, query := range queries {
+			sql := fmt.Sprintf(query, pair[0], pair[1])
+			require.True(t, isPartitionWise(sql), sql)
+			expected := tk.MustQuery(fmt.Sprintf(query, "tn1", "tn2")).Sort().Rows()
+			tk.MustQuery(sql).Sort().Check(expected)
+		}
+	}
+
+	// The partition schemes are different.
+	require.False(t, isPartitionWise("select /*+ hash_join(th1, th3) */ * from th1 join th3 on th1.a = th3.a"))
+	// The partition columns are not joined.
+	require.False(t, isPartitionWise("select /*+ hash_join(th1, th2) */ * from th1 join th2 on th1.b = th2.b"))
+	// The null-aware anti semi join needs the whole inner side.
+	require.False(t, isPartitionWise("select /*+ hash_join(th1, th2) */ * from th1 where a not in (select a from th2)"))
+	tk.MustExec("set @@tidb_enable_partition_wise_join = 0")
+	require.False(t, isPartitionWise("select /*+ hash_join(th1, th2) */ * from th1 join th2 on th1.a = th2.a"))
+}
@@ -0,0 +1,278 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/sessionctx"
+	driver "github.com/pingcap/tidb/types/parser_driver"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+)
+
+// ReloadQueryRewriteRulesExec indicates ReloadQueryRewriteRules executor.
+type ReloadQueryRewriteRulesExec struct {
+	baseExecutor
+}
+
+// Next implements the Executor Next interface.
+func (e *ReloadQueryRewriteRulesExec) Next(ctx context.Context, _ *chunk.Chunk) error {
+	internalCtx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnSysVar)
+	return LoadQueryRewriteRules(internalCtx, e.ctx)
+}
+
+// queryRewriteRule is a rule loaded from mysql.query_rewrite_rules. The statements whose digest is the same as the
+// pattern are rewritten to the replacement, and the literals of the statement at the `?` of the pattern are filled
+// into the `?` of the replacement in order.
+type queryRewriteRule struct {
+	id int64
+	// db is the lower-cased pattern_database, the rule only matches the statements executed in it if it's not empty.
+	db          string
+	replacement string
+	// patternValues are the literals and the parameter markers of the pattern in the order they are visited.
+	patternValues []ast.ValueExpr
+	// paramPositions are the positions in patternValues of the parameter markers of the pattern in the text order.
+	paramPositions []int
+}
+
+// queryRewriteRules are the enabled query rewrite rules grouped by the SQL digest of their patterns.
+var queryRewriteRules atomic.Pointer[map[string][]*queryRewriteRule]
+
+// valueExprCollector collects the literals and the parameter markers of a statement in the order they are visited.
+type valueExprCollector struct {
+	values []ast.ValueExpr
+}
+
+func (c *valueExprCollector) Enter(n ast.Node) (ast.Node, bool) {
+	if v, ok := n.(ast.ValueExpr); ok {
+		c.values = append(c.values, v)
+	}
+	return n, false
+}
+
+func (*valueExprCollector) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
+
+// paramMarkerReplacer replaces the parameter markers with the literals of the rewritten statement.
+type paramMarkerReplacer struct {
+	values map[*driver.ParamMarkerExpr]ast.ValueExpr
+}
+
+func (*paramMarkerReplacer) Enter(n ast.Node) (ast.Node, bool) {
+	return n, false
+}
+
+func (r *paramMarkerReplacer) Leave(n ast.Node) (ast.Node, bool) {
+	if marker, ok := n.(*driver.ParamMarkerExpr); ok {
+		if v, ok := r.values[marker]; ok {
+			return v, true
+		}
+	}
+	return n, true
+}
+
+// paramMarkersInTextOrder returns the parameter markers of the values in the order they appear in the SQL text.
+func paramMarkersInTextOrder(values []ast.ValueExpr) []*driver.ParamMarkerExpr {
+	markers := make([]*driver.ParamMarkerExpr, 0, len(values))
+	for _, v := range values {
+		if marker, ok := v.(*driver.ParamMarkerExpr); ok {
+			markers = append(markers, marker)
+		}
+	}
+	slices.SortFunc(markers, func(a, b *driver.ParamMarkerExpr) bool { return a.Offset < b.Offset })
+	return markers
+}
+
+func isRewritableStmt(stmt ast.StmtNode) bool {
+	switch stmt.(type) {
+	case *ast.SelectStmt, *ast.SetOprStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		return true
+	}
+	return false
+}
+
+func restoreNode(n ast.Node) (string, error) {
+	var sb strings.Builder
+	if err := n.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// newQueryRewriteRule validates the pattern and the replacement of the rule, and returns the rule with the SQL digest
+// of the pattern.
+func newQueryRewriteRule(p *parser.Parser, id int64, pattern, db, replacement string) (*queryRewriteRule, string, error) {
+	patternStmt, err := p.ParseOneStmt(pattern, "", "")
+	if err != nil {
+		return nil, "", errors.Annotate(err, "invalid pattern")
+	}
+	replacementStmt, err := p.ParseOneStmt(replacement, "", "")
+	if err != nil {
+		return nil, "", errors.Annotate(err, "invalid replacement")
+	}
+	if !isRewritableStmt(patternStmt) {
+		return nil, "", errors.New("only SELECT, INSERT, UPDATE and DELETE statements can be rewritten")
+	}
+	if ast.GetStmtLabel(patternStmt) != ast.GetStmtLabel(replacementStmt) {
+		return nil, "", errors.New("the pattern and the replacement should be the same type of statement")
+	}
+	collector := &valueExprCollector{}
+	patternStmt.Accept(collector)
+	rule := &queryRewriteRule{
+		id:            id,
+		db:            strings.ToLower(db),
+		replacement:   replacement,
+		patternValues: collector.values,
+	}
+	for _, marker := range paramMarkersInTextOrder(collector.values) {
+		rule.paramPositions = append(rule.paramPositions, slices.IndexFunc(collector.values, func(v ast.ValueExpr) bool {
+			return v == ast.ValueExpr(marker)
+		}))
+	}
+	collector = &valueExprCollector{}
+	replacementStmt.Accept(collector)
+	if n := len(paramMarkersInTextOrder(collector.values)); n > len(rule.paramPositions) {
+		return nil, "", errors.Errorf("the replacement has %d parameter markers, but the pattern only has %d", n, len(rule.paramPositions))
+	}
+	_, digest := parser.NormalizeDigest(pattern)
+	return rule, digest.String(), nil
+}
+
+// LoadQueryRewriteRules loads the enabled rules from table mysql.query_rewrite_rules. The invalid rules are skipped
+// with warnings, so the other rules still take effect.
+func LoadQueryRewriteRules(ctx context.Context, sctx sessionctx.Context) error {
+	exec := sctx.(sqlexec.RestrictedSQLExecutor)
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, "select HIGH_PRIORITY id, pattern, pattern_database, replacement from mysql.query_rewrite_rules where enabled = 'YES' order by id")
+	if err != nil {
+		return err
+	}
+	newRules := make(map[string][]*queryRewriteRule, len(rows))
+	p := parser.New()
+	for _, row := range rows {
+		id := row.GetInt64(0)
+		var db string
+		if !row.IsNull(2) {
+			db = row.GetString(2)
+		}
+		rule, digest, err := newQueryRewriteRule(p, id, row.GetString(1), db, row.GetString(3))
+		if err != nil {
+			sctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("query rewrite rule %d is skipped: %v", id, err))
+			logutil.BgLogger().Warn("skip the invalid query rewrite rule", zap.Int64("id", id), zap.Error(err))
+			continue
+		}
+		newRules[digest] = append(newRules[digest], rule)
+	}
+	queryRewriteRules.Store(&newRules)
+	return nil
+}
+
+// rewrite rewrites the statement whose literals are values by the rule. It returns nil if the literals of the
+// statement don't match the literals of the pattern.
+func (r *queryRewriteRule) rewrite(sctx sessionctx.Context, values []ast.ValueExpr) (ast.StmtNode, error) {
+	if len(values) != len(r.patternValues) {
+		return nil, nil
+	}
+	for i, v := range r.patternValues {
+		if _, ok := v.(*driver.ParamMarkerExpr); ok {
+			continue
+		}
+		// The literals which are not parameter markers in the pattern should be matched exactly.
+		expected, err := restoreNode(v)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := restoreNode(values[i])
+		if err != nil {
+			return nil, err
+		}
+		if expected != actual {
+			return nil, nil
+		}
+	}
+	sessVars := sctx.GetSessionVars()
+	charset, collation := sessVars.GetCharsetInfo()
+	p := parser.New()
+	p.SetParserConfig(sessVars.BuildParserConfig())
+	p.SetSQLMode(sessVars.SQLMode)
+	stmt, err := p.ParseOneStmt(r.replacement, charset, collation)
+	if err != nil {
+		return nil, err
+	}
+	collector := &valueExprCollector{}
+	stmt.Accept(collector)
+	replacer := &paramMarkerReplacer{values: make(map[*driver.ParamMarkerExpr]ast.ValueExpr)}
+	for i, marker := range paramMarkersInTextOrder(collector.values) {
+		replacer.values[marker] = values[r.paramPositions[i]]
+	}
+	newStmt, _ := stmt.Accept(replacer)
+	text, err := restoreNode(newStmt)
+	if err != nil {
+		return nil, err
+	}
+	newStmt.SetText(nil, text)
+	return newStmt.(ast.StmtNode), nil
+}
+
+// rewriteQuery rewrites the statement by the first matched query rewrite rule before it's optimized. The original
+// statement is returned if no rule matches.
+func rewriteQuery(ctx context.Context, sctx sessionctx.Context, stmtNode ast.StmtNode) ast.StmtNode {
+	sessVars := sctx.GetSessionVars()
+	if !sessVars.EnableQueryRewrite || sessVars.InRestrictedSQL || !isRewritableStmt(stmtNode) {
+		return stmtNode
+	}
+	rules := queryRewriteRules.Load()
+	if rules == nil || len(*rules) == 0 {
+		return stmtNode
+	}
+	_, digest := sessVars.StmtCtx.SQLDigest()
+	candidates := (*rules)[digest.String()]
+	if len(candidates) == 0 {
+		return stmtNode
+	}
+	collector := &valueExprCollector{}
+	stmtNode.Accept(collector)
+	for _, rule := range candidates {
+		if rule.db != "" && rule.db != strings.ToLower(sessVars.CurrentDB) {
+			continue
+		}
+		newStmt, err := rule.rewrite(sctx, collector.values)
+		if err != nil {
+			logutil.Logger(ctx).Warn("rewrite the query failed", zap.Int64("rule", rule.id), zap.Error(err))
+			continue
+		}
+		if newStmt == nil {
+			continue
+		}
+		// Audit the rewrite by a note of the statement and the counter of the rule.
+		sessVars.StmtCtx.AppendNote(errors.Errorf("Query is rewritten by the query rewrite rule %d", rule.id))
+		metrics.QueryRewriteCounter.WithLabelValues(strconv.FormatInt(rule.id, 10)).Inc()
+		return newStmt
+	}
+	return stmtNode
+}
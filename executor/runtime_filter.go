@@ -0,0 +1,156 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/memory"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// maxRuntimeFilterInValues is the max number of the distinct build keys which generate an IN filter, more keys
+	// generate a bloom filter.
+	maxRuntimeFilterInValues = 1024
+	// maxRuntimeFilterBloomKeys is the max number of the distinct build keys which generate a bloom filter. No filter
+	// is generated for more keys, because the filter is sent with every coprocessor request.
+	maxRuntimeFilterBloomKeys = 1 << 17
+	// runtimeFilterKeyMemUsage is the approximate memory usage of a collected key.
+	runtimeFilterKeyMemUsage = 16
+)
+
+// joinRuntimeFilter collects the keys of the build side of the hash join, and pushes the filter generated from them to
+// the table reader of the probe side before the reader is opened.
+type joinRuntimeFilter struct {
+	probeReader *TableReaderExecutor
+	probeCol    *expression.Column
+	buildKeyIdx int
+	// numConds is the number of the conditions of the pushed down selection of the probe reader without the filter.
+	numConds int
+
+	keys map[int64]struct{}
+	// exceeded indicates there are too many distinct build keys to generate the filter.
+	exceeded    bool
+	probeOpened atomic.Bool
+	// desc describes the pushed filter in the runtime stats.
+	desc string
+}
+
+func (f *joinRuntimeFilter) reset() {
+	f.keys = make(map[int64]struct{})
+	f.exceeded = false
+	f.probeOpened.Store(false)
+	f.desc = ""
+}
+
+// collect collects the distinct non-null build keys of the chunk.
+func (f *joinRuntimeFilter) collect(chk *chunk.Chunk, memTracker *memory.Tracker) {
+	if f.exceeded {
+		return
+	}
+	before := len(f.keys)
+	for i := 0; i < chk.NumRows(); i++ {
+		row := chk.GetRow(i)
+		if row.IsNull(f.buildKeyIdx) {
+			continue
+		}
+		f.keys[row.GetInt64(f.buildKeyIdx)] = struct{}{}
+	}
+	if len(f.keys) > maxRuntimeFilterBloomKeys {
+		memTracker.Consume(-int64(before) * runtimeFilterKeyMemUsage)
+		f.keys, f.exceeded = nil, true
+		return
+	}
+	memTracker.Consume(int64(len(f.keys)-before) * runtimeFilterKeyMemUsage)
+}
+
+// openProbeSide pushes the filter generated from the collected build keys to the probe reader, and opens the reader.
+func (f *joinRuntimeFilter) openProbeSide(ctx context.Context, sctx sessionctx.Context, memTracker *memory.Tracker) error {
+	err := f.push(sctx)
+	memTracker.Consume(-int64(len(f.keys)) * runtimeFilterKeyMemUsage)
+	f.keys = nil
+	if err != nil {
+		return err
+	}
+	// The reader is closed by the join even if it fails to open.
+	f.probeOpened.Store(true)
+	return f.probeReader.Open(ctx)
+}
+
+func (f *joinRuntimeFilter) push(sctx sessionctx.Context) error {
+	sel := f.probeReader.dagPB.Executors[1].Selection
+	// Remove the filter pushed by the last execution if the join is opened again.
+	sel.Conditions = sel.Conditions[:f.numConds:f.numConds]
+	if f.exceeded {
+		f.desc = fmt.Sprintf("skipped, keys:>%d", maxRuntimeFilterBloomKeys)
+		return nil
+	}
+	keys := make([]int64, 0, len(f.keys))
+	for key := range f.keys {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	var filter expression.Expression
+	var err error
+	// The bloom filter of no keys filters out all the rows, because the null keys are never joined.
+	if len(keys) > 0 && len(keys) <= maxRuntimeFilterInValues {
+		filter, err = expression.NewRuntimeFilterIn(sctx, f.probeCol, keys)
+		f.desc = fmt.Sprintf("in, keys:%d", len(keys))
+	} else {
+		filter, err = expression.NewRuntimeFilterBloom(sctx, f.probeCol, keys)
+		f.desc = fmt.Sprintf("bloom, keys:%d", len(keys))
+	}
+	if err != nil {
+		return err
+	}
+	sc, client := sctx.GetSessionVars().StmtCtx, sctx.GetClient()
+	filters := []expression.Expression{filter}
+	if !expression.CanExprsPushDown(sc, filters, client, kv.TiKV) {
+		f.desc = "skipped, not pushed down"
+		return nil
+	}
+	pbFilters, err := expression.ExpressionsToPBList(sc, filters, client)
+	if err != nil {
+		return err
+	}
+	sel.Conditions = append(sel.Conditions, pbFilters...)
+	return nil
+}
+
+// newJoinRuntimeFilter returns the runtime filter of the hash join whose probe side is probeExec, or nil if the filter
+// can't be pushed to it.
+func newJoinRuntimeFilter(probeExec Executor, probeCol *expression.Column, buildKeyIdx int) *joinRuntimeFilter {
+	reader, ok := probeExec.(*TableReaderExecutor)
+	// The executors of the reader with correlated columns are rebuilt when it's opened.
+	if !ok || reader.dummy || reader.corColInFilter || reader.storeType != kv.TiKV {
+		return nil
+	}
+	if len(reader.dagPB.Executors) != 2 || reader.dagPB.Executors[1].Selection == nil {
+		return nil
+	}
+	return &joinRuntimeFilter{
+		probeReader: reader,
+		probeCol:    probeCol,
+		buildKeyIdx: buildKeyIdx,
+		numConds:    len(reader.dagPB.Executors[1].Selection.Conditions),
+	}
+}
@@ -88,6 +88,16 @@ func (e *ShowExec) appendTableForStatsExtended(dbName string, tbl *model.TableIn
 		case ast.StatsTypeCardinality:
 			statsType = "cardinality"
 			statsVal = item.StringVals
+		case ast.StatsTypeExpression:
+			statsType = "expression"
+			if item.ExprStats != nil {
+				statsVal = item.ExprStats.Expr
+			}
+		case ast.StatsTypeSketch:
+			statsType = "sketch"
+			if item.Sketch != nil {
+				statsVal = fmt.Sprintf("ndv: %d", item.Sketch.NDV)
+			}
 		}
 		e.appendRow([]interface{}{
 			dbName,
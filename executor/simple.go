@@ -23,12 +23,14 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/ngaut/pools"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/config"
+	"github.com/pingcap/tidb/ddl"
 	"github.com/pingcap/tidb/distsql"
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/errno"
@@ -36,6 +38,7 @@ import (
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/auth"
 	"github.com/pingcap/tidb/parser/model"
@@ -51,6 +54,7 @@ import (
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/dbterror"
 	"github.com/pingcap/tidb/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/util/hack"
 	"github.com/pingcap/tidb/util/logutil"
@@ -209,9 +213,13 @@ func (e *SimpleExec) Next(ctx context.Context, req *chunk.Chunk) (err error) {
 	case *ast.ShutdownStmt:
 		err = e.executeShutdown(x)
 	case *ast.AdminStmt:
-		err = e.executeAdmin(x)
+		err = e.executeAdmin(ctx, x)
 	case *ast.SetResourceGroupStmt:
 		err = e.executeSetResourceGroupName(x)
+	case *ast.CreateMaintenanceWindowStmt:
+		err = ddl.CreateMaintenanceWindow(e.ctx, x)
+	case *ast.DropMaintenanceWindowStmt:
+		err = ddl.DropMaintenanceWindow(e.ctx, x)
 	}
 	e.done = true
 	return err
@@ -570,17 +578,27 @@ func (e *SimpleExec) setRoleNone(s *ast.SetRoleStmt) error {
 }
 
 func (e *SimpleExec) executeSetRole(s *ast.SetRoleStmt) error {
+	var err error
 	switch s.SetRoleOpt {
 	case ast.SetRoleRegular:
-		return e.setRoleRegular(s)
+		err = e.setRoleRegular(s)
 	case ast.SetRoleAll:
-		return e.setRoleAll(s)
+		err = e.setRoleAll(s)
 	case ast.SetRoleAllExcept:
-		return e.setRoleAllExcept(s)
+		err = e.setRoleAllExcept(s)
 	case ast.SetRoleNone:
-		return e.setRoleNone(s)
+		err = e.setRoleNone(s)
 	case ast.SetRoleDefault:
-		return e.setRoleDefault(s)
+		err = e.setRoleDefault(s)
+	}
+	if err != nil {
+		return err
+	}
+	// Remember how the active roles are specified, so they can be evaluated again when the privileges change.
+	if s.SetRoleOpt == ast.SetRoleDefault {
+		e.ctx.GetSessionVars().ActiveRolesSpec = nil
+	} else {
+		e.ctx.GetSessionVars().ActiveRolesSpec = s
 	}
 	return nil
 }
@@ -2795,12 +2813,14 @@ func (e *SimpleExec) executeSetSessionStates(ctx context.Context, s *ast.SetSess
 	return e.ctx.DecodeSessionStates(ctx, e.ctx, &sessionStates)
 }
 
-func (e *SimpleExec) executeAdmin(s *ast.AdminStmt) error {
+func (e *SimpleExec) executeAdmin(ctx context.Context, s *ast.AdminStmt) error {
 	switch s.Tp {
 	case ast.AdminReloadStatistics:
 		return e.executeAdminReloadStatistics(s)
 	case ast.AdminFlushPlanCache:
 		return e.executeAdminFlushPlanCache(s)
+	case ast.AdminWaitDDLJob:
+		return e.executeAdminWaitDDLJob(ctx, s)
 	}
 	return nil
 }
@@ -2837,6 +2857,91 @@ func (e *SimpleExec) executeAdminFlushPlanCache(s *ast.AdminStmt) error {
 	return nil
 }
 
+// waitDDLJobInterval is the interval to check whether the DDL job is finished and its schema version is loaded.
+var waitDDLJobInterval = 50 * time.Millisecond
+
+// executeAdminWaitDDLJob blocks until the DDL jobs are finished and the schema versions produced by them
+// are loaded by the current TiDB node, so that the following statements in any session on this node
+// can see the result of the DDL jobs.
+func (e *SimpleExec) executeAdminWaitDDLJob(ctx context.Context, s *ast.AdminStmt) error {
+	dom := domain.GetDomain(e.ctx)
+	for _, id := range s.JobIDs {
+		job, err := e.waitDDLJobFinished(ctx, id)
+		if err != nil {
+			return err
+		}
+		if job.BinlogInfo != nil {
+			if err = e.waitSchemaVersionLoaded(ctx, dom, job.BinlogInfo.SchemaVersion); err != nil {
+				return err
+			}
+		}
+		if !job.IsSynced() {
+			e.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("DDL job %d is %s", job.ID, job.State.String()))
+		}
+	}
+	return nil
+}
+
+// waitDDLJobFinished waits until the DDL job is moved to the history queue and returns the history job.
+func (e *SimpleExec) waitDDLJobFinished(ctx context.Context, id int64) (*model.Job, error) {
+	internalCtx := kv.WithInternalSourceType(ctx, kv.InternalTxnAdmin)
+	exec := e.ctx.(sqlexec.RestrictedSQLExecutor)
+	for {
+		// The job is deleted from the job table and added to the history queue in the same transaction,
+		// so check the job table first to avoid missing a job which is finished between the two reads.
+		rows, _, err := exec.ExecRestrictedSQL(internalCtx, nil, "SELECT job_id FROM %n.%n WHERE job_id = %?", mysql.SystemDB, ddl.JobTable, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			var job *model.Job
+			err = kv.RunInNewTxn(internalCtx, e.ctx.GetStore(), false, func(ctx context.Context, txn kv.Transaction) error {
+				var err error
+				job, err = meta.NewMeta(txn).GetHistoryDDLJob(id)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+			if job == nil {
+				return nil, dbterror.ErrDDLJobNotFound.GenWithStackByArgs(id)
+			}
+			return job, nil
+		}
+		if err = e.waitDDLJobInterval(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitSchemaVersionLoaded waits until the schema version of the current TiDB node reaches the given version.
+func (e *SimpleExec) waitSchemaVersionLoaded(ctx context.Context, dom *domain.Domain, schemaVer int64) error {
+	for dom.InfoSchema().SchemaMetaVersion() < schemaVer {
+		if err := dom.Reload(); err != nil {
+			logutil.Logger(ctx).Warn("reload schema failed when waiting for DDL job", zap.Error(err))
+		}
+		if dom.InfoSchema().SchemaMetaVersion() >= schemaVer {
+			break
+		}
+		if err := e.waitDDLJobInterval(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *SimpleExec) waitDDLJobInterval(ctx context.Context) error {
+	if atomic.LoadUint32(&e.ctx.GetSessionVars().Killed) == 1 {
+		return errors.Trace(exeerrors.ErrQueryInterrupted)
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(waitDDLJobInterval):
+	}
+	return nil
+}
+
 func (e *SimpleExec) executeSetResourceGroupName(s *ast.SetResourceGroupStmt) error {
 	if s.Name.L != "" {
 		if _, ok := e.is.ResourceGroupByName(s.Name); !ok {
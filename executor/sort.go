@@ -62,6 +62,14 @@ type SortExec struct {
 
 // Close implements the Executor Close interface.
 func (e *SortExec) Close() error {
+	// Every spilled partition is a round of spilling.
+	spilledPartitions := 0
+	for _, container := range e.partitionList {
+		if container.AlreadySpilled() {
+			spilledPartitions++
+		}
+	}
+	e.registerSpillStats(spilledPartitions, e.diskTracker)
 	for _, container := range e.partitionList {
 		err := container.Close()
 		if err != nil {
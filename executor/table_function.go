@@ -0,0 +1,153 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/mathutil"
+)
+
+// tableFunctionRetriever returns the rows of a table function for the evaluated arguments.
+// The rows must match the columns defined by infoschema.TableFunction.
+type tableFunctionRetriever func(ctx context.Context, sctx sessionctx.Context, args []types.Datum) ([][]types.Datum, error)
+
+// tableFunctionRetrievers is the registry of the table function implementations, the key is the name
+// defined in infoschema.
+var tableFunctionRetrievers = map[string]tableFunctionRetriever{
+	infoschema.TableFunctionDDLReorgTasks: retrieveDDLReorgTasks,
+	infoschema.TableFunctionJSONEach:      retrieveJSONEach,
+}
+
+// TableFunctionExec executes a table function and returns its rows.
+type TableFunctionExec struct {
+	baseExecutor
+
+	args      []expression.Expression
+	retriever tableFunctionRetriever
+
+	rows      [][]types.Datum
+	cursor    int
+	retrieved bool
+}
+
+// Open implements the Executor Open interface.
+func (e *TableFunctionExec) Open(ctx context.Context) error {
+	// The arguments may reference the outer columns, so the rows are retrieved again after reopen.
+	e.rows, e.cursor, e.retrieved = nil, 0, false
+	return e.baseExecutor.Open(ctx)
+}
+
+// Next implements the Executor Next interface.
+func (e *TableFunctionExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.GrowAndReset(e.maxChunkSize)
+	if !e.retrieved {
+		args := make([]types.Datum, 0, len(e.args))
+		for _, arg := range e.args {
+			d, err := arg.Eval(chunk.Row{})
+			if err != nil {
+				return err
+			}
+			args = append(args, d)
+		}
+		rows, err := e.retriever(ctx, e.ctx, args)
+		if err != nil {
+			return err
+		}
+		e.rows = rows
+		e.retrieved = true
+	}
+	if e.cursor >= len(e.rows) {
+		return nil
+	}
+	end := mathutil.Min(e.cursor+req.Capacity(), len(e.rows))
+	mutableRow := chunk.MutRowFromTypes(retTypes(e))
+	for _, row := range e.rows[e.cursor:end] {
+		mutableRow.SetDatums(row...)
+		req.AppendRow(mutableRow.ToRow())
+	}
+	e.cursor = end
+	return nil
+}
+
+// Close implements the Executor Close interface.
+func (e *TableFunctionExec) Close() error {
+	e.rows = nil
+	return e.baseExecutor.Close()
+}
+
+// retrieveDDLReorgTasks returns the backfill tasks of the DDL job, the argument is the job ID.
+func retrieveDDLReorgTasks(ctx context.Context, sctx sessionctx.Context, args []types.Datum) ([][]types.Datum, error) {
+	if args[0].IsNull() {
+		return nil, nil
+	}
+	tasks, err := ddl.GetReorgTasks(ctx, sctx, args[0].GetInt64())
+	if err != nil {
+		return nil, err
+	}
+	rows := make([][]types.Datum, 0, len(tasks))
+	for _, task := range tasks {
+		rows = append(rows, types.MakeDatums(
+			task.JobID,
+			task.EleID,
+			string(task.EleKey),
+			task.ID,
+			task.PhysicalTableID,
+			task.State.String(),
+			task.InstanceID,
+			task.Meta.RowCount,
+			hex.EncodeToString(task.Meta.StartKey),
+			hex.EncodeToString(task.Meta.EndKey),
+			hex.EncodeToString(task.Meta.CurrKey),
+			task.StartTS,
+			task.StateUpdateTS,
+		))
+	}
+	return rows, nil
+}
+
+// retrieveJSONEach returns the top-level elements of the JSON document. The key is the index for the
+// elements of an array, and a scalar is returned as a single row with NULL key.
+func retrieveJSONEach(_ context.Context, _ sessionctx.Context, args []types.Datum) ([][]types.Datum, error) {
+	if args[0].IsNull() {
+		return nil, nil
+	}
+	doc := args[0].GetMysqlJSON()
+	switch doc.TypeCode {
+	case types.JSONTypeCodeObject:
+		rows := make([][]types.Datum, 0, doc.GetElemCount())
+		for i := 0; i < doc.GetElemCount(); i++ {
+			key, val := doc.ObjectGetElem(i)
+			rows = append(rows, []types.Datum{types.NewStringDatum(string(key)), types.NewJSONDatum(val), types.NewStringDatum(val.Type())})
+		}
+		return rows, nil
+	case types.JSONTypeCodeArray:
+		rows := make([][]types.Datum, 0, doc.GetElemCount())
+		for i := 0; i < doc.GetElemCount(); i++ {
+			val := doc.ArrayGetElem(i)
+			rows = append(rows, []types.Datum{types.NewStringDatum(strconv.Itoa(i)), types.NewJSONDatum(val), types.NewStringDatum(val.Type())})
+		}
+		return rows, nil
+	}
+	return [][]types.Datum{{types.NewDatum(nil), types.NewJSONDatum(doc), types.NewStringDatum(doc.Type())}}, nil
+}
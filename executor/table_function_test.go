@@ -0,0 +1,59 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/testkit"
+)
+
+func TestJSONEach(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustQuery(`select * from json_each('{"b": 2, "a": [1, "x"]}')`).Check(testkit.Rows(
+		`a [1, "x"] ARRAY`,
+		"b 2 INTEGER",
+	))
+	tk.MustQuery(`select j.key, j.type from json_each('[true, null, 1.5]') as j where j.key > 0`).Check(testkit.Rows(
+		"1 NULL",
+		"2 DOUBLE",
+	))
+	tk.MustQuery(`select * from json_each('"s"')`).Check(testkit.Rows(`<nil> "s" STRING`))
+	tk.MustQuery(`select * from json_each(null)`).Check(testkit.Rows())
+
+	// The arguments can reference the outer columns.
+	tk.MustExec("create table t(id int, doc json)")
+	tk.MustExec(`insert into t values (1, '[1, 2, 3]'), (2, '{"a": 1}'), (3, null)`)
+	tk.MustQuery("select id, (select count(*) from json_each(t.doc)) from t order by id").Check(testkit.Rows(
+		"1 3",
+		"2 1",
+		"3 0",
+	))
+	tk.MustQuery("select t.id, j.value from t join json_each('[2, 3]') j on t.id = j.value order by t.id").Check(testkit.Rows(
+		"2 2",
+		"3 3",
+	))
+	tk.MustQuery("explain format = 'brief' select * from json_each('[]')").CheckContain("TableFunction")
+
+	tk.MustGetErrCode("select * from no_such_func(1)", errno.ErrSpDoesNotExist)
+	tk.MustGetErrCode("select * from json_each('[]', 1)", errno.ErrWrongParamcountToNativeFct)
+	tk.MustGetErrCode("select * from json_each((select doc from t limit 1))", errno.ErrWrongArguments)
+	tk.MustGetErrCode("select * from json_each('[]') j, json_each('[]') j", errno.ErrNonuniqTable)
+	tk.MustGetErrCode("select * from json_each('{')", errno.ErrInvalidJSONText)
+}
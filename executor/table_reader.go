@@ -110,6 +110,9 @@ type TableReaderExecutor struct {
 	byItems   []*util.ByItems
 	paging    bool
 	storeType kv.StoreType
+	// pinSnapshot indicates the snapshot of the requests can't be refreshed, it's set when the rows are looked up by
+	// the handles read from an index, otherwise the handles may be missing in the refreshed snapshot.
+	pinSnapshot bool
 	// corColInFilter tells whether there's correlated column in filter.
 	corColInFilter bool
 	// corColInAccess tells whether there's correlated column in access conditions.
@@ -369,6 +372,7 @@ func (e *TableReaderExecutor) buildKVReqSeparately(ctx context.Context, ranges [
 			SetTxnScope(e.txnScope).
 			SetReadReplicaScope(e.readReplicaScope).
 			SetFromSessionVars(e.ctx.GetSessionVars()).
+			SetSnapshotRefreshInterval(e.snapshotRefreshInterval()).
 			SetFromInfoSchema(e.ctx.GetInfoSchema()).
 			SetMemTracker(e.memTracker).
 			SetStoreType(e.storeType).
@@ -411,6 +415,7 @@ func (e *TableReaderExecutor) buildKVReqForPartitionTableScan(ctx context.Contex
 		SetTxnScope(e.txnScope).
 		SetReadReplicaScope(e.readReplicaScope).
 		SetFromSessionVars(e.ctx.GetSessionVars()).
+		SetSnapshotRefreshInterval(e.snapshotRefreshInterval()).
 		SetFromInfoSchema(e.ctx.GetInfoSchema()).
 		SetMemTracker(e.memTracker).
 		SetStoreType(e.storeType).
@@ -425,6 +430,14 @@ func (e *TableReaderExecutor) buildKVReqForPartitionTableScan(ctx context.Contex
 	return kvReq, nil
 }
 
+// snapshotRefreshInterval returns the interval to refresh the snapshot of the requests, 0 means never.
+func (e *TableReaderExecutor) snapshotRefreshInterval() time.Duration {
+	if e.pinSnapshot {
+		return 0
+	}
+	return e.ctx.GetSessionVars().StmtCtx.SnapshotRefreshInterval
+}
+
 func (e *TableReaderExecutor) buildKVReq(ctx context.Context, ranges []*ranger.Range) (*kv.Request, error) {
 	var builder distsql.RequestBuilder
 	var reqBuilder *distsql.RequestBuilder
@@ -461,6 +474,7 @@ func (e *TableReaderExecutor) buildKVReq(ctx context.Context, ranges []*ranger.R
 		SetReadReplicaScope(e.readReplicaScope).
 		SetIsStaleness(e.isStaleness).
 		SetFromSessionVars(e.ctx.GetSessionVars()).
+		SetSnapshotRefreshInterval(e.snapshotRefreshInterval()).
 		SetFromInfoSchema(sessiontxn.GetTxnManager(e.ctx).GetTxnInfoSchema()).
 		SetMemTracker(e.memTracker).
 		SetStoreType(e.storeType).
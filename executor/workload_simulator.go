@@ -0,0 +1,356 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/execdetails"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// defaultWorkloadSimulatorConcurrency is the number of the workers if CONCURRENCY isn't specified.
+	defaultWorkloadSimulatorConcurrency = 4
+	// workloadSimulatorBaselineOps is the number of the operations of each worker in the baseline phase.
+	workloadSimulatorBaselineOps = 100
+	// workloadSimulatorSampleHandles is the max number of the handles sampled from the table for the operations.
+	workloadSimulatorSampleHandles = 1024
+	// workloadSimulatorLatencySamples is the max number of the latencies sampled by each worker for the percentiles.
+	workloadSimulatorLatencySamples = 1024
+)
+
+// WorkloadSimulatorExec represents an ADMIN WORKLOAD SIMULATOR executor.
+// It generates the DML load against the table without the DDL as the baseline at first, then runs the DDL under the
+// same load, and outputs the latencies of the operations in both phases, so the impact of the DDL can be rehearsed.
+// The load consists of the point reads and the no-op updates on the sampled rows, which don't change the data.
+type WorkloadSimulatorExec struct {
+	baseExecutor
+
+	done        bool
+	table       *ast.TableName
+	tblInfo     *model.TableInfo
+	ddl         string
+	concurrency int
+	rate        int
+}
+
+// workloadLatencySampler records the operations of a worker. The latencies are sampled by the reservoir sampling,
+// so the memory doesn't grow with the number of the operations in the DDL phase, which can run for hours.
+type workloadLatencySampler struct {
+	ops        int64
+	errors     int64
+	maxLatency time.Duration
+	samples    []time.Duration
+}
+
+func (s *workloadLatencySampler) add(latency time.Duration) {
+	s.ops++
+	if latency > s.maxLatency {
+		s.maxLatency = latency
+	}
+	if len(s.samples) < workloadSimulatorLatencySamples {
+		s.samples = append(s.samples, latency)
+		return
+	}
+	//nolint: gosec
+	if i := rand.Int63n(s.ops); i < workloadSimulatorLatencySamples {
+		s.samples[i] = latency
+	}
+}
+
+// weightedLatency is a sampled latency standing for weight operations of a worker.
+type weightedLatency struct {
+	latency time.Duration
+	weight  float64
+}
+
+// workloadPhaseResult is the result of the operations in a phase of the workload simulator.
+type workloadPhaseResult struct {
+	phase      string
+	ops        int64
+	errors     int64
+	duration   time.Duration
+	maxLatency time.Duration
+	// latencies are the latencies sampled by all the workers, sorted in the ascending order.
+	latencies []weightedLatency
+}
+
+// mergeWorkloadSamplers merges the operations of the workers. A sample of a worker stands for the operations of the
+// worker divided by its number of samples, so the workers which run more operations weigh more in the percentiles.
+func mergeWorkloadSamplers(phase string, duration time.Duration, samplers []workloadLatencySampler) *workloadPhaseResult {
+	result := &workloadPhaseResult{phase: phase, duration: duration}
+	for _, s := range samplers {
+		result.ops += s.ops
+		result.errors += s.errors
+		if s.maxLatency > result.maxLatency {
+			result.maxLatency = s.maxLatency
+		}
+		if len(s.samples) == 0 {
+			continue
+		}
+		weight := float64(s.ops) / float64(len(s.samples))
+		for _, latency := range s.samples {
+			result.latencies = append(result.latencies, weightedLatency{latency: latency, weight: weight})
+		}
+	}
+	slices.SortFunc(result.latencies, func(i, j weightedLatency) bool {
+		return i.latency < j.latency
+	})
+	return result
+}
+
+// percentile returns the latency at the percentile p of the operations, which is estimated from the samples except
+// the max latency.
+func (r *workloadPhaseResult) percentile(p float64) time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	if p >= 1 {
+		return r.maxLatency
+	}
+	target := math.Max(p*float64(r.ops), 1)
+	var cum float64
+	for _, l := range r.latencies {
+		cum += l.weight
+		if cum >= target {
+			return l.latency
+		}
+	}
+	return r.latencies[len(r.latencies)-1].latency
+}
+
+// workloadOperation is a DML statement with its parameters run by the workers.
+type workloadOperation struct {
+	sql  string
+	args []interface{}
+}
+
+// Next implements the Executor Next interface.
+func (e *WorkloadSimulatorExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+
+	ops, err := e.buildOperations(ctx)
+	if err != nil {
+		return err
+	}
+	if len(ops) == 0 {
+		return errors.Errorf("table %s is empty, the workload simulator needs rows to generate the load", e.table.Name.O)
+	}
+	baseline, err := e.runWorkers(ctx, "BASELINE", ops, workloadSimulatorBaselineOps, nil)
+	if err != nil {
+		return err
+	}
+	stopCh := make(chan struct{})
+	var ddlPhase *workloadPhaseResult
+	var wg sync.WaitGroup
+	var workersErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ddlPhase, workersErr = e.runWorkers(ctx, "DDL", ops, 0, stopCh)
+	}()
+	ddlErr := e.runDDL(ctx)
+	close(stopCh)
+	wg.Wait()
+	if ddlErr != nil {
+		return ddlErr
+	}
+	if workersErr != nil {
+		return workersErr
+	}
+	for _, r := range []*workloadPhaseResult{baseline, ddlPhase} {
+		req.AppendString(0, r.phase)
+		req.AppendInt64(1, r.ops)
+		req.AppendInt64(2, r.errors)
+		req.AppendString(3, execdetails.FormatDuration(r.duration))
+		req.AppendString(4, execdetails.FormatDuration(r.percentile(0.5)))
+		req.AppendString(5, execdetails.FormatDuration(r.percentile(0.9)))
+		req.AppendString(6, execdetails.FormatDuration(r.percentile(0.99)))
+		req.AppendString(7, execdetails.FormatDuration(r.percentile(1)))
+	}
+	return nil
+}
+
+// workloadHandleColumns returns the names of the columns which locate a row of the table.
+func workloadHandleColumns(tblInfo *model.TableInfo) []string {
+	if tblInfo.PKIsHandle {
+		return []string{tblInfo.GetPkColInfo().Name.L}
+	}
+	if tblInfo.IsCommonHandle {
+		pk := tables.FindPrimaryIndex(tblInfo)
+		cols := make([]string, 0, len(pk.Columns))
+		for _, col := range pk.Columns {
+			cols = append(cols, col.Name.L)
+		}
+		return cols
+	}
+	return []string{model.ExtraHandleName.L}
+}
+
+// buildOperations samples the handles of the table, and builds a point read and a no-op update for each of them.
+// The update is skipped if all the columns of the table are in the handle.
+func (e *WorkloadSimulatorExec) buildOperations(ctx context.Context) ([]workloadOperation, error) {
+	handleCols := workloadHandleColumns(e.tblInfo)
+	var updateCol string
+	for _, col := range e.tblInfo.Cols() {
+		if col.IsGenerated() || slices.Contains(handleCols, col.Name.L) {
+			continue
+		}
+		updateCol = col.Name.L
+		break
+	}
+	args := make([]interface{}, 0, len(handleCols)+3)
+	for _, col := range handleCols {
+		args = append(args, col)
+	}
+	args = append(args, e.table.Schema.L, e.table.Name.L, workloadSimulatorSampleHandles)
+	sampleSQL := "select " + strings.Repeat("%n, ", len(handleCols)-1) + "%n from %n.%n limit %?"
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnAdmin)
+	rows, fields, err := e.ctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, nil, sampleSQL, args...)
+	if err != nil {
+		return nil, err
+	}
+	cond := "%n = %?" + strings.Repeat(" and %n = %?", len(handleCols)-1)
+	ops := make([]workloadOperation, 0, 2*len(rows))
+	for _, row := range rows {
+		condArgs := make([]interface{}, 0, 2*len(handleCols))
+		for i, col := range handleCols {
+			// The handle values are compared as strings, which are converted to the types of the columns.
+			d := row.GetDatum(i, &fields[i].Column.FieldType)
+			val, err := d.ToString()
+			if err != nil {
+				return nil, err
+			}
+			condArgs = append(condArgs, col, val)
+		}
+		ops = append(ops, workloadOperation{
+			sql:  "select * from %n.%n where " + cond,
+			args: append([]interface{}{e.table.Schema.L, e.table.Name.L}, condArgs...),
+		})
+		if updateCol != "" {
+			ops = append(ops, workloadOperation{
+				sql:  "update %n.%n set %n = %n where " + cond,
+				args: append([]interface{}{e.table.Schema.L, e.table.Name.L, updateCol, updateCol}, condArgs...),
+			})
+		}
+	}
+	return ops, nil
+}
+
+// runWorkers runs the operations by the workers until each of them runs opsPerWorker operations, or stopCh is closed
+// if opsPerWorker is 0.
+func (e *WorkloadSimulatorExec) runWorkers(ctx context.Context, phase string, ops []workloadOperation, opsPerWorker int, stopCh <-chan struct{}) (*workloadPhaseResult, error) {
+	sessions := make([]sessionctx.Context, 0, e.concurrency)
+	defer func() {
+		for _, se := range sessions {
+			e.releaseSysSession(ctx, se)
+		}
+	}()
+	for i := 0; i < e.concurrency; i++ {
+		se, err := e.getSysSession()
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, se)
+	}
+	var limiter *rate.Limiter
+	if e.rate > 0 {
+		limiter = rate.NewLimiter(rate.Limit(e.rate), 1)
+	}
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnAdmin)
+	samplers := make([]workloadLatencySampler, e.concurrency)
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i, se := range sessions {
+		wg.Add(1)
+		go func(se sessionctx.Context, s *workloadLatencySampler) {
+			defer wg.Done()
+			exec := se.(sqlexec.SQLExecutor)
+			for n := 0; opsPerWorker == 0 || n < opsPerWorker; n++ {
+				if stopCh != nil {
+					select {
+					case <-stopCh:
+						return
+					default:
+					}
+				}
+				if limiter != nil {
+					if err := limiter.Wait(ctx); err != nil {
+						return
+					}
+				}
+				//nolint: gosec
+				op := ops[rand.Intn(len(ops))]
+				opStart := time.Now()
+				err := runWorkloadOperation(ctx, exec, op)
+				s.add(time.Since(opStart))
+				if err != nil {
+					s.errors++
+					logutil.Logger(ctx).Debug("workload simulator operation failed", zap.String("sql", op.sql), zap.Error(err))
+				}
+			}
+		}(se, &samplers[i])
+	}
+	wg.Wait()
+	return mergeWorkloadSamplers(phase, time.Since(start), samplers), nil
+}
+
+func runWorkloadOperation(ctx context.Context, exec sqlexec.SQLExecutor, op workloadOperation) error {
+	rs, err := exec.ExecuteInternal(ctx, op.sql, op.args...)
+	if err != nil || rs == nil {
+		return err
+	}
+	_, err = sqlexec.DrainRecordSet(ctx, rs, 1024)
+	if closeErr := rs.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// runDDL runs the DDL in a system session whose current database is the database of the table. The DDL has been
+// checked to change only the table, and the privileges it needs have been checked for the user when it's planned.
+func (e *WorkloadSimulatorExec) runDDL(ctx context.Context) error {
+	se, err := e.getSysSession()
+	if err != nil {
+		return err
+	}
+	// The session isn't put back to the pool, because its current database is changed.
+	defer clearSysSession(ctx, se)
+	se.GetSessionVars().CurrentDB = e.table.Schema.O
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnDDL)
+	_, err = se.(sqlexec.SQLExecutor).ExecuteInternal(ctx, e.ddl)
+	return err
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+var (
+	_ functionClass = &jsonSchemaValidFunctionClass{}
+
+	_ builtinFunc = &builtinJSONSchemaValidSig{}
+)
+
+type jsonSchemaValidFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *jsonSchemaValidFunctionClass) verifyArgs(args []Expression) error {
+	if err := c.baseFunctionClass.verifyArgs(args); err != nil {
+		return err
+	}
+	for i, arg := range args {
+		if evalType := arg.GetType().EvalType(); evalType != types.ETJson && evalType != types.ETString {
+			return ErrInvalidTypeForJSON.GenWithStackByArgs(i+1, c.funcName)
+		}
+	}
+	return nil
+}
+
+func (c *jsonSchemaValidFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETJson, types.ETJson)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinJSONSchemaValidSig{baseBuiltinFunc: bf}
+	// The constant schema is compiled only once, so the invalid schema is reported when the expression is built,
+	// e.g. when a check constraint is added.
+	if bf.args[0].ConstItem(ctx.GetSessionVars().StmtCtx) {
+		schema, isNull, err := bf.args[0].EvalJSON(ctx, chunk.Row{})
+		if err != nil {
+			return nil, err
+		}
+		if !isNull {
+			if sig.validator, err = types.CompileJSONSchema(schema); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return sig, nil
+}
+
+type builtinJSONSchemaValidSig struct {
+	baseBuiltinFunc
+	// validator is the compiled schema if the schema is a constant. It's immutable, so it's shared by the clones.
+	validator *types.JSONSchemaValidator
+}
+
+func (b *builtinJSONSchemaValidSig) Clone() builtinFunc {
+	newSig := &builtinJSONSchemaValidSig{validator: b.validator}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinJSONSchemaValidSig) evalInt(row chunk.Row) (res int64, isNull bool, err error) {
+	validator := b.validator
+	if validator == nil {
+		schema, isNull, err := b.args[0].EvalJSON(b.ctx, row)
+		if isNull || err != nil {
+			return res, isNull, err
+		}
+		if validator, err = types.CompileJSONSchema(schema); err != nil {
+			return res, false, err
+		}
+	}
+	doc, isNull, err := b.args[1].EvalJSON(b.ctx, row)
+	if isNull || err != nil {
+		return res, isNull, err
+	}
+	if validator.Validate(doc) != nil {
+		return 0, false, nil
+	}
+	return 1, false, nil
+}
+
+// JSONSchemaCheck validates the JSON documents by the compiled schema of a JSON_SCHEMA_VALID function, it's used to
+// check the constraints like `CHECK (JSON_SCHEMA_VALID('{...}', doc))` without evaluating the function.
+type JSONSchemaCheck struct {
+	validator *types.JSONSchemaValidator
+	doc       Expression
+}
+
+// NewJSONSchemaCheck returns the JSONSchemaCheck if the expression is a JSON_SCHEMA_VALID function with a constant
+// schema, otherwise it returns nil.
+func NewJSONSchemaCheck(expr Expression) *JSONSchemaCheck {
+	sf, ok := expr.(*ScalarFunction)
+	if !ok || sf.FuncName.L != ast.JSONSchemaValid {
+		return nil
+	}
+	sig, ok := sf.Function.(*builtinJSONSchemaValidSig)
+	if !ok || sig.validator == nil {
+		return nil
+	}
+	return &JSONSchemaCheck{validator: sig.validator, doc: sig.args[1]}
+}
+
+// Check validates the document of the row. It returns the report of the failure if the document is invalid, the
+// row satisfies the check if the document is NULL.
+func (c *JSONSchemaCheck) Check(ctx sessionctx.Context, row chunk.Row) (*types.JSONSchemaValidationReport, error) {
+	doc, isNull, err := c.doc.EvalJSON(ctx, row)
+	if isNull || err != nil {
+		return nil, err
+	}
+	return c.validator.Validate(doc), nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/fulltext"
 	"github.com/pingcap/tidb/util/set"
 	"github.com/pingcap/tidb/util/stringutil"
 	"github.com/pingcap/tipb/go-tipb"
@@ -45,6 +46,7 @@ var (
 	_ functionClass = &valuesFunctionClass{}
 	_ functionClass = &bitCountFunctionClass{}
 	_ functionClass = &getParamFunctionClass{}
+	_ functionClass = &matchAgainstFunctionClass{}
 )
 
 var (
@@ -77,6 +79,7 @@ var (
 	_ builtinFunc = &builtinValuesJSONSig{}
 	_ builtinFunc = &builtinBitCountSig{}
 	_ builtinFunc = &builtinGetParamStringSig{}
+	_ builtinFunc = &builtinMatchAgainstSig{}
 )
 
 type inFunctionClass struct {
@@ -1486,3 +1489,67 @@ func (b *builtinGetParamStringSig) evalString(row chunk.Row) (string, bool, erro
 	}
 	return str, false, nil
 }
+
+type matchAgainstFunctionClass struct {
+	baseFunctionClass
+}
+
+// getFunction gets the function of MATCH ... AGAINST. The arguments are the search
+// modifier, the search string and the matched columns.
+func (c *matchAgainstFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := make([]types.EvalType, 0, len(args))
+	argTps = append(argTps, types.ETInt)
+	for i := 1; i < len(args); i++ {
+		argTps = append(argTps, types.ETString)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinMatchAgainstSig{baseBuiltinFunc: bf}
+	return sig, nil
+}
+
+type builtinMatchAgainstSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinMatchAgainstSig) Clone() builtinFunc {
+	newSig := &builtinMatchAgainstSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalReal evals the relevance of the row to the search string, 0 means the row doesn't match.
+// See https://dev.mysql.com/doc/refman/8.0/en/fulltext-search.html
+func (b *builtinMatchAgainstSig) evalReal(row chunk.Row) (float64, bool, error) {
+	modifier, isNull, err := b.args[0].EvalInt(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	query, isNull, err := b.args[1].EvalString(b.ctx, row)
+	if err != nil {
+		return 0, true, err
+	}
+	if isNull {
+		return 0, false, nil
+	}
+	texts := make([]string, 0, len(b.args)-2)
+	for _, arg := range b.args[2:] {
+		text, isNull, err := arg.EvalString(b.ctx, row)
+		if err != nil {
+			return 0, true, err
+		}
+		if !isNull {
+			texts = append(texts, text)
+		}
+	}
+	doc := fulltext.NewDocument(texts...)
+	if ast.FulltextSearchModifier(modifier).IsBooleanMode() {
+		return fulltext.BooleanScore(doc, fulltext.ParseBooleanQuery(query)), false, nil
+	}
+	return fulltext.NaturalLanguageScore(doc, query), false, nil
+}
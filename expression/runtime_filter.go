@@ -0,0 +1,143 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"hash/crc32"
+	"strconv"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+)
+
+const (
+	// runtimeFilterBloomHashes is the number of the hash functions of the bloom filter.
+	runtimeFilterBloomHashes = 3
+	// runtimeFilterBloomBitsPerKey is the number of the bits of the bloom filter for each key, the false positive rate
+	// is about 0.5% with 3 hash functions.
+	runtimeFilterBloomBitsPerKey = 16
+)
+
+// NewRuntimeFilterIn builds `col in (keys...)` as the runtime filter of the integer column col. The keys are the values
+// of the build side of a hash join, which are the bits of uint64 values if col is unsigned.
+func NewRuntimeFilterIn(ctx sessionctx.Context, col *Column, keys []int64) (Expression, error) {
+	unsigned := mysql.HasUnsignedFlag(col.RetType.GetFlag())
+	args := make([]Expression, 0, len(keys)+1)
+	args = append(args, col)
+	for _, key := range keys {
+		if unsigned {
+			args = append(args, DatumToConstant(types.NewUintDatum(uint64(key)), mysql.TypeLonglong, mysql.UnsignedFlag))
+		} else {
+			args = append(args, DatumToConstant(types.NewIntDatum(key), mysql.TypeLonglong, 0))
+		}
+	}
+	return NewFunction(ctx, ast.In, types.NewFieldType(mysql.TypeLonglong), args...)
+}
+
+// runtimeFilterBloomHash returns the i-th hash of the key in the bloom filter, which is the same as
+// `crc32(concat(col, '#i'))` evaluated on the column.
+func runtimeFilterBloomHash(key int64, unsigned bool, i int) uint32 {
+	var s string
+	if unsigned {
+		s = strconv.FormatUint(uint64(key), 10)
+	} else {
+		s = strconv.FormatInt(key, 10)
+	}
+	return crc32.ChecksumIEEE([]byte(s + "#" + strconv.Itoa(i)))
+}
+
+// NewRuntimeFilterBloom builds a bloom filter of the keys as the runtime filter of the integer column col, which
+// selects the rows whose values are in keys and a few others by the false positives. The filter only consists of the
+// functions which can be pushed down to TiKV: the bit array of m bits is a binary string constant, and the bit of the
+// hash h is tested by `(ascii(substring(bits, ((h & (m-1)) >> 3) + 1, 1)) >> (h & 7)) & 1 = 1`, where the i-th hash
+// is `crc32(concat(col, '#i'))`.
+func NewRuntimeFilterBloom(ctx sessionctx.Context, col *Column, keys []int64) (Expression, error) {
+	unsigned := mysql.HasUnsignedFlag(col.RetType.GetFlag())
+	numBits := uint32(64)
+	for numBits < uint32(len(keys))*runtimeFilterBloomBitsPerKey {
+		numBits <<= 1
+	}
+	bits := make([]byte, numBits/8)
+	for _, key := range keys {
+		for i := 0; i < runtimeFilterBloomHashes; i++ {
+			pos := runtimeFilterBloomHash(key, unsigned, i) & (numBits - 1)
+			bits[pos>>3] |= 1 << (pos & 7)
+		}
+	}
+	bitsTp := types.NewFieldType(mysql.TypeVarString)
+	bitsTp.SetCharset(charset.CharsetBin)
+	bitsTp.SetCollate(charset.CollationBin)
+	bitsTp.SetFlen(len(bits))
+	bitsTp.AddFlag(mysql.BinaryFlag)
+	bitsConst := &Constant{Value: types.NewBytesDatum(bits), RetType: bitsTp}
+	intConst := func(v int64) *Constant {
+		return DatumToConstant(types.NewIntDatum(v), mysql.TypeLonglong, 0)
+	}
+	intTp, strTp := types.NewFieldType(mysql.TypeLonglong), types.NewFieldType(mysql.TypeVarString)
+
+	conds := make([]Expression, 0, runtimeFilterBloomHashes)
+	for i := 0; i < runtimeFilterBloomHashes; i++ {
+		suffix := DatumToConstant(types.NewStringDatum("#"+strconv.Itoa(i)), mysql.TypeVarString, 0)
+		str, err := NewFunction(ctx, ast.Concat, strTp, col, suffix)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := NewFunction(ctx, ast.CRC32, intTp, str)
+		if err != nil {
+			return nil, err
+		}
+		pos, err := NewFunction(ctx, ast.And, intTp, hash, intConst(int64(numBits-1)))
+		if err != nil {
+			return nil, err
+		}
+		byteIdx, err := NewFunction(ctx, ast.RightShift, intTp, pos, intConst(3))
+		if err != nil {
+			return nil, err
+		}
+		byteIdx, err = NewFunction(ctx, ast.Plus, intTp, byteIdx, intConst(1))
+		if err != nil {
+			return nil, err
+		}
+		byteStr, err := NewFunction(ctx, ast.Substring, strTp, bitsConst, byteIdx, intConst(1))
+		if err != nil {
+			return nil, err
+		}
+		byteVal, err := NewFunction(ctx, ast.ASCII, intTp, byteStr)
+		if err != nil {
+			return nil, err
+		}
+		bitIdx, err := NewFunction(ctx, ast.And, intTp, hash, intConst(7))
+		if err != nil {
+			return nil, err
+		}
+		bit, err := NewFunction(ctx, ast.RightShift, intTp, byteVal, bitIdx)
+		if err != nil {
+			return nil, err
+		}
+		bit, err = NewFunction(ctx, ast.And, intTp, bit, intConst(1))
+		if err != nil {
+			return nil, err
+		}
+		cond, err := NewFunction(ctx, ast.EQ, types.NewFieldType(mysql.TypeTiny), bit, intConst(1))
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+	}
+	return ComposeCNFCondition(ctx, conds...), nil
+}
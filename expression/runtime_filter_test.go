@@ -0,0 +1,71 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeFilter(t *testing.T) {
+	ctx := createContext(t)
+	for _, unsigned := range []bool{false, true} {
+		tp := types.NewFieldType(mysql.TypeLonglong)
+		if unsigned {
+			tp.AddFlag(mysql.UnsignedFlag)
+		}
+		col := &Column{RetType: tp, Index: 0}
+		keys := make([]int64, 0, 1000)
+		for i := int64(0); i < 1000; i++ {
+			keys = append(keys, i*7-3000)
+		}
+		inFilter, err := NewRuntimeFilterIn(ctx, col, keys[:10])
+		require.NoError(t, err)
+		bloomFilter, err := NewRuntimeFilterBloom(ctx, col, keys)
+		require.NoError(t, err)
+		require.True(t, CanExprsPushDown(ctx.GetSessionVars().StmtCtx, []Expression{inFilter, bloomFilter}, ctx.GetClient(), kv.TiKV))
+
+		eval := func(filter Expression, v int64) bool {
+			d := types.NewIntDatum(v)
+			if unsigned {
+				d = types.NewUintDatum(uint64(v))
+			}
+			res, isNull, err := filter.EvalInt(ctx, chunk.MutRowFromDatums([]types.Datum{d}).ToRow())
+			require.NoError(t, err)
+			return !isNull && res != 0
+		}
+		// The keys are never filtered out.
+		for i, key := range keys {
+			require.True(t, eval(bloomFilter, key))
+			if i < 10 {
+				require.True(t, eval(inFilter, key))
+			}
+		}
+		falsePositives := 0
+		for i := int64(0); i < 10000; i++ {
+			v := i*7 - 2999
+			require.False(t, eval(inFilter, v))
+			if eval(bloomFilter, v) {
+				falsePositives++
+			}
+		}
+		require.Less(t, falsePositives, 200)
+	}
+}
@@ -201,6 +201,8 @@ func (b *Builder) ApplyDiff(m *meta.Meta, diff *model.SchemaDiff) ([]int64, erro
 		return b.applyDropSchema(diff.SchemaID), nil
 	case model.ActionRecoverSchema:
 		return b.applyRecoverSchema(m, diff)
+	case model.ActionRenameSchema:
+		return b.applyRenameSchema(m, diff)
 	case model.ActionModifySchemaCharsetAndCollate:
 		return nil, b.applyModifySchemaCharsetAndCollate(m, diff)
 	case model.ActionModifySchemaDefaultPlacement:
@@ -223,9 +225,11 @@ func (b *Builder) ApplyDiff(m *meta.Meta, diff *model.SchemaDiff) ([]int64, erro
 		return b.applyDropTableOrPartition(m, diff)
 	case model.ActionRecoverTable:
 		return b.applyRecoverTable(m, diff)
+	case model.ActionRenameTables:
+		return b.applyRenameTables(m, diff)
 	case model.ActionCreateTables:
 		return b.applyCreateTables(m, diff)
-	case model.ActionReorganizePartition:
+	case model.ActionReorganizePartition, model.ActionAlterTablePartitioning:
 		return b.applyReorganizePartition(m, diff)
 	case model.ActionFlashbackCluster:
 		return []int64{-1}, nil
@@ -317,6 +321,56 @@ func (b *Builder) applyRecoverTable(m *meta.Meta, diff *model.SchemaDiff) ([]int
 	return tblIDs, nil
 }
 
+// applyRenameTables applies the tables renamed by one statement. A table may be renamed more than once by the
+// statement, e.g. `RENAME TABLE a.t1 TO b.t1, b.t1 TO c.t1`, so the renames of the same table are merged into one
+// from its original schema to its final schema. And all the tables are dropped before any of them is created, since
+// a table may take the old name of another one, e.g. when two tables are swapped.
+func (b *Builder) applyRenameTables(m *meta.Meta, diff *model.SchemaDiff) ([]int64, error) {
+	opts := make([]*model.AffectedOption, 0, len(diff.AffectedOpts))
+	optIdx := make(map[int64]int, len(diff.AffectedOpts))
+	for _, opt := range diff.AffectedOpts {
+		if idx, ok := optIdx[opt.TableID]; ok {
+			opts[idx].SchemaID = opt.SchemaID
+			continue
+		}
+		optIdx[opt.TableID] = len(opts)
+		merged := *opt
+		opts = append(opts, &merged)
+	}
+
+	tblIDs := make([]int64, 0, 2*len(opts))
+	for _, opt := range opts {
+		oldRoDBInfo, ok := b.is.SchemaByID(opt.OldSchemaID)
+		if !ok {
+			return nil, ErrDatabaseNotExists.GenWithStackByArgs(
+				fmt.Sprintf("(Schema ID %d)", opt.OldSchemaID),
+			)
+		}
+		oldDBInfo := b.getSchemaAndCopyIfNecessary(oldRoDBInfo.Name.L)
+		b.copySortedTables(opt.TableID, opt.TableID)
+		tblIDs = b.applyDropTable(oldDBInfo, opt.TableID, tblIDs)
+	}
+	for _, opt := range opts {
+		roDBInfo, ok := b.is.SchemaByID(opt.SchemaID)
+		if !ok {
+			return nil, ErrDatabaseNotExists.GenWithStackByArgs(
+				fmt.Sprintf("(Schema ID %d)", opt.SchemaID),
+			)
+		}
+		dbInfo := b.getSchemaAndCopyIfNecessary(roDBInfo.Name.L)
+		var err error
+		// The allocators are not reused, since they are bound to the schema ID.
+		tblIDs, err = b.applyCreateTable(m, dbInfo, opt.TableID, autoid.Allocators{}, diff.Type, tblIDs)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// The placement bundles are keyed by the table IDs, refresh them so that the policies bound to the renamed
+		// tables are kept in the new infoschema.
+		b.markTableBundleShouldUpdate(opt.TableID)
+	}
+	return tblIDs, nil
+}
+
 func updateAutoIDForExchangePartition(store kv.Storage, ptSchemaID, ptID, ntSchemaID, ntID int64) error {
 	err := kv.RunInNewTxn(kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL), store, true, func(ctx context.Context, txn kv.Transaction) error {
 		t := meta.NewMeta(txn)
@@ -397,7 +451,7 @@ func (b *Builder) applyTableUpdate(m *meta.Meta, diff *model.SchemaDiff) ([]int6
 	switch diff.Type {
 	case model.ActionCreateSequence, model.ActionRecoverTable:
 		newTableID = diff.TableID
-	case model.ActionCreateTable:
+	case model.ActionCreateTable, model.ActionCreateTableSelect:
 		// WARN: when support create table with foreign key in https://github.com/pingcap/tidb/pull/37148,
 		// create table with foreign key requires a multi-step state change(none -> write-only -> public),
 		// when the table's state changes from write-only to public, infoSchema need to drop the old table
@@ -420,7 +474,7 @@ func (b *Builder) applyTableUpdate(m *meta.Meta, diff *model.SchemaDiff) ([]int6
 	}
 	// handle placement rule cache
 	switch diff.Type {
-	case model.ActionCreateTable:
+	case model.ActionCreateTable, model.ActionCreateTableSelect:
 		b.markTableBundleShouldUpdate(newTableID)
 	case model.ActionDropTable:
 		b.deleteBundle(b.is, oldTableID)
@@ -690,6 +744,52 @@ func (b *Builder) applyRecoverSchema(m *meta.Meta, diff *model.SchemaDiff) ([]in
 	return b.applyCreateTables(m, diff)
 }
 
+// applyRenameSchema moves the tables of the renamed schema under its new name, and reloads the tables of the other
+// schemas whose views or foreign keys reference it.
+func (b *Builder) applyRenameSchema(m *meta.Meta, diff *model.SchemaDiff) ([]int64, error) {
+	di, err := m.GetDatabase(diff.SchemaID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	oldDBInfo, ok := b.is.SchemaByID(diff.SchemaID)
+	if di == nil || !ok {
+		// When we apply an old schema diff, the database may has been dropped already, so we need to fall back to
+		// full load.
+		return nil, ErrDatabaseNotExists.GenWithStackByArgs(
+			fmt.Sprintf("(Schema ID %d)", diff.SchemaID),
+		)
+	}
+	// Keep the allocators, so the cached auto IDs can be reused.
+	tableIDs := make([]int64, 0, len(oldDBInfo.Tables))
+	allocs := make(map[int64]autoid.Allocators, len(oldDBInfo.Tables))
+	for _, tbl := range oldDBInfo.Tables {
+		tableIDs = append(tableIDs, tbl.ID)
+		allocs[tbl.ID], _ = b.is.AllocByID(tbl.ID)
+	}
+	tblIDs := b.applyDropSchema(diff.SchemaID)
+	b.is.schemaMap[di.Name.L] = &schemaTables{dbInfo: di, tables: make(map[string]table.Table, len(tableIDs))}
+	for _, id := range tableIDs {
+		b.markTableBundleShouldUpdate(id)
+		if _, err = b.applyCreateTable(m, di, id, allocs[id], diff.Type, nil); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	for _, opt := range diff.AffectedOpts {
+		affectedIDs, err := b.applyTableUpdate(m, &model.SchemaDiff{
+			Version:  diff.Version,
+			Type:     diff.Type,
+			SchemaID: opt.SchemaID,
+			TableID:  opt.TableID,
+		})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		tblIDs = append(tblIDs, affectedIDs...)
+	}
+	return tblIDs, nil
+}
+
 func (b *Builder) copySortedTablesBucket(bucketIdx int) {
 	oldSortedTables := b.is.sortedTablesBuckets[bucketIdx]
 	newSortedTables := make(sortedTables, len(oldSortedTables))
@@ -715,7 +815,7 @@ func (b *Builder) applyCreateTable(m *meta.Meta, dbInfo *model.DBInfo, tableID i
 	case model.ActionDropTablePartition:
 	case model.ActionTruncateTablePartition:
 	// ReorganizePartition handle the bundles in applyReorganizePartition
-	case model.ActionReorganizePartition:
+	case model.ActionReorganizePartition, model.ActionAlterTablePartitioning:
 	default:
 		pi := tblInfo.GetPartitionInfo()
 		if pi != nil {
@@ -791,6 +891,9 @@ func (b *Builder) applyCreateTable(m *meta.Meta, dbInfo *model.DBInfo, tableID i
 	if tblInfo.TempTableType != model.TempTableNone {
 		b.addTemporaryTable(tableID)
 	}
+	if hasDeferredDroppedIndex(tblInfo) {
+		b.addDeferredDroppedIndexTable(tableID)
+	}
 
 	newTbl, ok := b.is.TableByID(tableID)
 	if ok {
@@ -849,6 +952,9 @@ func (b *Builder) applyDropTable(dbInfo *model.DBInfo, tableID int64, affected [
 	if b.is.temporaryTableIDs != nil {
 		delete(b.is.temporaryTableIDs, tableID)
 	}
+	if b.is.deferredDroppedIndexTableIDs != nil {
+		delete(b.is.deferredDroppedIndexTableIDs, tableID)
+	}
 
 	// The old DBInfo still holds a reference to old table info, we need to remove it.
 	for i, tblInfo := range dbInfo.Tables {
@@ -880,6 +986,7 @@ func (b *Builder) InitWithOldInfoSchema(oldSchema InfoSchema) *Builder {
 	b.copyPoliciesMap(oldIS)
 	b.copyResourceGroupMap(oldIS)
 	b.copyTemporaryTableIDsMap(oldIS)
+	b.copyDeferredDroppedIndexTableIDsMap(oldIS)
 	b.copyReferredForeignKeyMap(oldIS)
 
 	copy(b.is.sortedTablesBuckets, oldIS.sortedTablesBuckets)
@@ -926,6 +1033,19 @@ func (b *Builder) copyTemporaryTableIDsMap(oldIS *infoSchema) {
 	}
 }
 
+func (b *Builder) copyDeferredDroppedIndexTableIDsMap(oldIS *infoSchema) {
+	is := b.is
+	if len(oldIS.deferredDroppedIndexTableIDs) == 0 {
+		is.deferredDroppedIndexTableIDs = nil
+		return
+	}
+
+	is.deferredDroppedIndexTableIDs = make(map[int64]struct{}, len(oldIS.deferredDroppedIndexTableIDs))
+	for tblID := range oldIS.deferredDroppedIndexTableIDs {
+		is.deferredDroppedIndexTableIDs[tblID] = struct{}{}
+	}
+}
+
 func (b *Builder) copyReferredForeignKeyMap(oldIS *infoSchema) {
 	for k, v := range oldIS.referredForeignKeyMap {
 		b.is.referredForeignKeyMap[k] = v
@@ -1040,6 +1160,9 @@ func (b *Builder) createSchemaTablesForDB(di *model.DBInfo, tableFromMeta tableF
 		if tblInfo := tbl.Meta(); tblInfo.TempTableType != model.TempTableNone {
 			b.addTemporaryTable(tblInfo.ID)
 		}
+		if tblInfo := tbl.Meta(); hasDeferredDroppedIndex(tblInfo) {
+			b.addDeferredDroppedIndexTable(tblInfo.ID)
+		}
 	}
 	return nil
 }
@@ -1051,6 +1174,22 @@ func (b *Builder) addTemporaryTable(tblID int64) {
 	b.is.temporaryTableIDs[tblID] = struct{}{}
 }
 
+func (b *Builder) addDeferredDroppedIndexTable(tblID int64) {
+	if b.is.deferredDroppedIndexTableIDs == nil {
+		b.is.deferredDroppedIndexTableIDs = make(map[int64]struct{})
+	}
+	b.is.deferredDroppedIndexTableIDs[tblID] = struct{}{}
+}
+
+func hasDeferredDroppedIndex(tblInfo *model.TableInfo) bool {
+	for _, idx := range tblInfo.Indices {
+		if idx.IsDeferredDropped() {
+			return true
+		}
+	}
+	return false
+}
+
 type virtualTableDriver struct {
 	*model.DBInfo
 	TableFromMeta tableFromMetaFunc
@@ -65,6 +65,8 @@ type InfoSchema interface {
 	AllResourceGroups() []*model.ResourceGroupInfo
 	// HasTemporaryTable returns whether information schema has temporary table
 	HasTemporaryTable() bool
+	// TableIDsWithDeferredDroppedIndexes returns the IDs of the tables which have indexes dropped by DROP INDEX ... DEFERRED.
+	TableIDsWithDeferredDroppedIndexes() []int64
 	// GetTableReferredForeignKeys gets the table's ReferredFKInfo by lowercase schema and table name.
 	GetTableReferredForeignKeys(schema, table string) []*model.ReferredFKInfo
 }
@@ -108,6 +110,9 @@ type infoSchema struct {
 	// temporaryTables stores the temporary table ids
 	temporaryTableIDs map[int64]struct{}
 
+	// deferredDroppedIndexTableIDs stores the ids of the tables which have indexes dropped by DROP INDEX ... DEFERRED.
+	deferredDroppedIndexTableIDs map[int64]struct{}
+
 	// schemaMetaVersion is the version of schema, and we should check version when change schema.
 	schemaMetaVersion int64
 
@@ -344,6 +349,15 @@ func (is *infoSchema) HasTemporaryTable() bool {
 	return len(is.temporaryTableIDs) != 0
 }
 
+// TableIDsWithDeferredDroppedIndexes returns the IDs of the tables which have indexes dropped by DROP INDEX ... DEFERRED.
+func (is *infoSchema) TableIDsWithDeferredDroppedIndexes() []int64 {
+	ids := make([]int64, 0, len(is.deferredDroppedIndexTableIDs))
+	for id := range is.deferredDroppedIndexTableIDs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func (is *infoSchema) Clone() (result []*model.DBInfo) {
 	for _, v := range is.schemaMap {
 		result = append(result, v.dbInfo.Clone())
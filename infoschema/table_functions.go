@@ -0,0 +1,78 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"strings"
+
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+)
+
+const (
+	// TableFunctionDDLReorgTasks is the table function that returns the backfill tasks of a DDL job.
+	TableFunctionDDLReorgTasks = "TIDB_DDL_REORG_TASKS"
+	// TableFunctionJSONEach is the table function that returns the top-level elements of a JSON document.
+	TableFunctionJSONEach = "JSON_EACH"
+)
+
+// TableFunction is the definition of a table function, which returns a set of rows for the arguments.
+// It's used in the FROM clause like a table, e.g. SELECT * FROM JSON_EACH('[1, 2]').
+type TableFunction struct {
+	// ArgTypes are the types of the arguments, the arguments are casted to these types before calling the function.
+	ArgTypes []byte
+	// Meta describes the columns of the returned rows.
+	Meta *model.TableInfo
+}
+
+var tableDDLReorgTasksCols = []columnInfo{
+	{name: "JOB_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "ELEMENT_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "ELEMENT_KEY", tp: mysql.TypeBlob, size: 196606},
+	{name: "TASK_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "PHYSICAL_TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "STATE", tp: mysql.TypeVarchar, size: 64},
+	{name: "INSTANCE_ID", tp: mysql.TypeVarchar, size: 256},
+	{name: "ROW_COUNT", tp: mysql.TypeLonglong, size: 21},
+	{name: "START_KEY", tp: mysql.TypeBlob, size: 196606},
+	{name: "END_KEY", tp: mysql.TypeBlob, size: 196606},
+	{name: "CURRENT_KEY", tp: mysql.TypeBlob, size: 196606},
+	{name: "START_TS", tp: mysql.TypeLonglong, size: 21, flag: mysql.UnsignedFlag},
+	{name: "STATE_UPDATE_TS", tp: mysql.TypeLonglong, size: 21, flag: mysql.UnsignedFlag},
+}
+
+var tableJSONEachCols = []columnInfo{
+	{name: "KEY", tp: mysql.TypeVarchar, size: 256},
+	{name: "VALUE", tp: mysql.TypeJSON, size: types.UnspecifiedLength},
+	{name: "TYPE", tp: mysql.TypeVarchar, size: 16},
+}
+
+var tableFunctions = map[string]*TableFunction{
+	TableFunctionDDLReorgTasks: {
+		ArgTypes: []byte{mysql.TypeLonglong},
+		Meta:     buildTableMeta(TableFunctionDDLReorgTasks, tableDDLReorgTasksCols),
+	},
+	TableFunctionJSONEach: {
+		ArgTypes: []byte{mysql.TypeJSON},
+		Meta:     buildTableMeta(TableFunctionJSONEach, tableJSONEachCols),
+	},
+}
+
+// GetTableFunction gets the table function by name, the name is case-insensitive.
+func GetTableFunction(name string) (*TableFunction, bool) {
+	fn, ok := tableFunctions[strings.ToUpper(name)]
+	return fn, ok
+}
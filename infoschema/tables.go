@@ -108,8 +108,9 @@ const (
 	tableGlobalStatus    = "GLOBAL_STATUS"
 	tableGlobalVariables = "GLOBAL_VARIABLES"
 	tableSessionStatus   = "SESSION_STATUS"
-	tableOptimizerTrace  = "OPTIMIZER_TRACE"
-	tableTableSpaces     = "TABLESPACES"
+	// TableOptimizerTrace is the optimizer traces of the latest statements of the session.
+	TableOptimizerTrace = "OPTIMIZER_TRACE"
+	tableTableSpaces    = "TABLESPACES"
 	// TableCollationCharacterSetApplicability is the string constant of infoschema memory table.
 	TableCollationCharacterSetApplicability = "COLLATION_CHARACTER_SET_APPLICABILITY"
 	// TableProcesslist is the string constant of infoschema table.
@@ -202,6 +203,12 @@ const (
 	TableMemoryUsageOpsHistory = "MEMORY_USAGE_OPS_HISTORY"
 	// TableResourceGroups is the metadata of resource groups.
 	TableResourceGroups = "RESOURCE_GROUPS"
+	// TableTiDBTempPaths is the temporary paths configured by [[temp-paths]] of tidb instance.
+	TableTiDBTempPaths = "TIDB_TEMP_PATHS"
+	// TableDDLQueue is the summary of the DDL job queues of the cluster.
+	TableDDLQueue = "DDL_QUEUE"
+	// TableDeleteRanges is the delete range tasks of the dropped tables, indexes and the rolled back DDL jobs.
+	TableDeleteRanges = "DELETE_RANGES"
 )
 
 const (
@@ -249,7 +256,7 @@ var tableIDMap = map[string]int64{
 	tableGlobalStatus:                       autoid.InformationSchemaDBID + 27,
 	tableGlobalVariables:                    autoid.InformationSchemaDBID + 28,
 	tableSessionStatus:                      autoid.InformationSchemaDBID + 29,
-	tableOptimizerTrace:                     autoid.InformationSchemaDBID + 30,
+	TableOptimizerTrace:                     autoid.InformationSchemaDBID + 30,
 	tableTableSpaces:                        autoid.InformationSchemaDBID + 31,
 	TableCollationCharacterSetApplicability: autoid.InformationSchemaDBID + 32,
 	TableProcesslist:                        autoid.InformationSchemaDBID + 33,
@@ -309,6 +316,10 @@ var tableIDMap = map[string]int64{
 	ClusterTableMemoryUsage:              autoid.InformationSchemaDBID + 86,
 	ClusterTableMemoryUsageOpsHistory:    autoid.InformationSchemaDBID + 87,
 	TableResourceGroups:                  autoid.InformationSchemaDBID + 88,
+	TableTiDBTempPaths:                   autoid.InformationSchemaDBID + 89,
+	ClusterTableTiDBTempPaths:            autoid.InformationSchemaDBID + 90,
+	TableDDLQueue:                        autoid.InformationSchemaDBID + 91,
+	TableDeleteRanges:                    autoid.InformationSchemaDBID + 92,
 }
 
 // columnInfo represents the basic column information of all kinds of INFORMATION_SCHEMA tables
@@ -826,6 +837,9 @@ var tableProcesslistCols = []columnInfo{
 	{name: "DISK", tp: mysql.TypeLonglong, size: 21, flag: mysql.UnsignedFlag},
 	{name: "TxnStart", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag, deflt: ""},
 	{name: "RESOURCE_GROUP", tp: mysql.TypeVarchar, size: resourcegroup.MaxGroupNameLength, flag: mysql.NotNullFlag, deflt: ""},
+	{name: "INTERNAL", tp: mysql.TypeTiny, size: 1, flag: mysql.NotNullFlag, deflt: 0},
+	{name: "OPERATION", tp: mysql.TypeVarchar, size: 64},
+	{name: "JOB_ID", tp: mysql.TypeVarchar, size: 64},
 }
 
 var tableTiDBIndexesCols = []columnInfo{
@@ -1599,6 +1613,37 @@ var tableResourceGroupsCols = []columnInfo{
 	{name: "BURSTABLE", tp: mysql.TypeVarchar, size: 3},
 }
 
+var tableTiDBTempPathsCols = []columnInfo{
+	{name: "PATH", tp: mysql.TypeVarchar, size: 512, flag: mysql.NotNullFlag},
+	{name: "USAGE", tp: mysql.TypeVarchar, size: 16},
+	{name: "QUOTA", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "USED", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "CAPACITY", tp: mysql.TypeLonglong, size: 21},
+}
+
+var tableDDLQueueCols = []columnInfo{
+	{name: "QUEUE", tp: mysql.TypeVarchar, size: 16, flag: mysql.NotNullFlag},
+	{name: "WAITING_JOBS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "RUNNING_JOBS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "OLDEST_WAITING_JOB_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "OLDEST_WAITING_JOB_TYPE", tp: mysql.TypeVarchar, size: 64},
+	{name: "OLDEST_WAITING_CREATE_TIME", tp: mysql.TypeDatetime, size: 19},
+	{name: "MAX_WAIT_SECONDS", tp: mysql.TypeLonglong, size: 21},
+}
+
+var tableDeleteRangesCols = []columnInfo{
+	{name: "JOB_ID", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "ELEMENT_ID", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "JOB_TYPE", tp: mysql.TypeVarchar, size: 64},
+	{name: "DB_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "START_KEY", tp: mysql.TypeBlob, size: types.UnspecifiedLength},
+	{name: "END_KEY", tp: mysql.TypeBlob, size: types.UnspecifiedLength},
+	{name: "SUBMIT_TIME", tp: mysql.TypeDatetime, size: 19},
+	{name: "STATE", tp: mysql.TypeVarchar, size: 32, flag: mysql.NotNullFlag},
+}
+
 // GetShardingInfo returns a nil or description string for the sharding information of given TableInfo.
 // The returned description string may be:
 //   - "NOT_SHARDED": for tables that SHARD_ROW_ID_BITS is not specified.
@@ -2032,7 +2077,7 @@ var tableNameToColumns = map[string][]columnInfo{
 	tableGlobalStatus:                       tableGlobalStatusCols,
 	tableGlobalVariables:                    tableGlobalVariablesCols,
 	tableSessionStatus:                      tableSessionStatusCols,
-	tableOptimizerTrace:                     tableOptimizerTraceCols,
+	TableOptimizerTrace:                     tableOptimizerTraceCols,
 	tableTableSpaces:                        tableTableSpacesCols,
 	TableCollationCharacterSetApplicability: tableCollationCharacterSetApplicabilityCols,
 	TableProcesslist:                        tableProcesslistCols,
@@ -2080,6 +2125,9 @@ var tableNameToColumns = map[string][]columnInfo{
 	TableMemoryUsage:                        tableMemoryUsageCols,
 	TableMemoryUsageOpsHistory:              tableMemoryUsageOpsHistoryCols,
 	TableResourceGroups:                     tableResourceGroupsCols,
+	TableTiDBTempPaths:                      tableTiDBTempPathsCols,
+	TableDDLQueue:                           tableDDLQueueCols,
+	TableDeleteRanges:                       tableDeleteRangesCols,
 }
 
 func createInfoSchemaTable(_ autoid.Allocators, meta *model.TableInfo) (table.Table, error) {
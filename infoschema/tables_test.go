@@ -42,6 +42,7 @@ import (
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/disk"
 	"github.com/pingcap/tidb/util/gctuner"
 	"github.com/pingcap/tidb/util/memory"
 	"github.com/stretchr/testify/require"
@@ -162,7 +163,10 @@ func TestInfoSchemaFieldValue(t *testing.T) {
 			"  `MEM` bigint(21) unsigned DEFAULT NULL,\n" +
 			"  `DISK` bigint(21) unsigned DEFAULT NULL,\n" +
 			"  `TxnStart` varchar(64) NOT NULL DEFAULT '',\n" +
-			"  `RESOURCE_GROUP` varchar(32) NOT NULL DEFAULT ''\n" +
+			"  `RESOURCE_GROUP` varchar(32) NOT NULL DEFAULT '',\n" +
+			"  `INTERNAL` tinyint(1) NOT NULL DEFAULT '0',\n" +
+			"  `OPERATION` varchar(64) DEFAULT NULL,\n" +
+			"  `JOB_ID` varchar(64) DEFAULT NULL\n" +
 			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
 	tk.MustQuery("show create table information_schema.cluster_log").Check(
 		testkit.Rows("" +
@@ -345,9 +349,9 @@ func TestSomeTables(t *testing.T) {
 	tk.Session().SetSessionManager(sm)
 	tk.MustQuery("select * from information_schema.PROCESSLIST order by ID;").Sort().Check(
 		testkit.Rows(
-			fmt.Sprintf("1 user-1 localhost information_schema Quit 9223372036 %s %s abc1 0 0  rg1", "in transaction", "do something"),
-			fmt.Sprintf("2 user-2 localhost test Init DB 9223372036 %s %s abc2 0 0  rg2", "autocommit", strings.Repeat("x", 101)),
-			fmt.Sprintf("3 user-3 127.0.0.1:12345 test Init DB 9223372036 %s %s abc3 0 0  rg3", "in transaction", "check port"),
+			fmt.Sprintf("1 user-1 localhost information_schema Quit 9223372036 %s %s abc1 0 0  rg1 0 <nil> <nil>", "in transaction", "do something"),
+			fmt.Sprintf("2 user-2 localhost test Init DB 9223372036 %s %s abc2 0 0  rg2 0 <nil> <nil>", "autocommit", strings.Repeat("x", 101)),
+			fmt.Sprintf("3 user-3 127.0.0.1:12345 test Init DB 9223372036 %s %s abc3 0 0  rg3 0 <nil> <nil>", "in transaction", "check port"),
 		))
 	tk.MustQuery("SHOW PROCESSLIST;").Sort().Check(
 		testkit.Rows(
@@ -388,8 +392,8 @@ func TestSomeTables(t *testing.T) {
 	tk.Session().GetSessionVars().TimeZone = time.UTC
 	tk.MustQuery("select * from information_schema.PROCESSLIST order by ID;").Check(
 		testkit.Rows(
-			fmt.Sprintf("1 user-1 localhost information_schema Quit 9223372036 %s %s abc1 0 0  rg1", "in transaction", "<nil>"),
-			fmt.Sprintf("2 user-2 localhost <nil> Init DB 9223372036 %s %s abc2 0 0 07-29 03:26:05.158(410090409861578752) rg2", "autocommit", strings.Repeat("x", 101)),
+			fmt.Sprintf("1 user-1 localhost information_schema Quit 9223372036 %s %s abc1 0 0  rg1 0 <nil> <nil>", "in transaction", "<nil>"),
+			fmt.Sprintf("2 user-2 localhost <nil> Init DB 9223372036 %s %s abc2 0 0 07-29 03:26:05.158(410090409861578752) rg2 0 <nil> <nil>", "autocommit", strings.Repeat("x", 101)),
 		))
 	tk.MustQuery("SHOW PROCESSLIST;").Sort().Check(
 		testkit.Rows(
@@ -403,12 +407,30 @@ func TestSomeTables(t *testing.T) {
 		))
 	tk.MustQuery("select * from information_schema.PROCESSLIST where db is null;").Check(
 		testkit.Rows(
-			fmt.Sprintf("2 user-2 localhost <nil> Init DB 9223372036 %s %s abc2 0 0 07-29 03:26:05.158(410090409861578752) rg2", "autocommit", strings.Repeat("x", 101)),
+			fmt.Sprintf("2 user-2 localhost <nil> Init DB 9223372036 %s %s abc2 0 0 07-29 03:26:05.158(410090409861578752) rg2 0 <nil> <nil>", "autocommit", strings.Repeat("x", 101)),
 		))
 	tk.MustQuery("select * from information_schema.PROCESSLIST where Info is null;").Check(
 		testkit.Rows(
-			fmt.Sprintf("1 user-1 localhost information_schema Quit 9223372036 %s %s abc1 0 0  rg1", "in transaction", "<nil>"),
+			fmt.Sprintf("1 user-1 localhost information_schema Quit 9223372036 %s %s abc1 0 0  rg1 0 <nil> <nil>", "in transaction", "<nil>"),
 		))
+
+	// The internal sessions of the background workers are shown with their operations and job IDs.
+	sm.PS = append(sm.PS, &util.ProcessInfo{
+		ID:                3,
+		Command:           mysql.ComDaemon,
+		State:             2,
+		Info:              "alter table t add index idx(a)",
+		ResourceGroupName: "default",
+		Internal:          true,
+		Operation:         "backfill",
+		JobID:             "100",
+	})
+	tk.MustQuery("select * from information_schema.PROCESSLIST where internal = 1;").Check(
+		testkit.Rows(
+			"3   <nil> Daemon 9223372036 autocommit alter table t add index idx(a)  0 0  default 1 backfill 100",
+		))
+	tk.MustQuery("select id, operation, job_id from information_schema.PROCESSLIST where internal = 0 order by id;").Check(
+		testkit.Rows("1 <nil> <nil>", "2 <nil> <nil>"))
 }
 
 func prepareSlowLogfile(t *testing.T, slowLogFileName string) {
@@ -1851,3 +1873,23 @@ func TestAddFieldsForBinding(t *testing.T) {
 	require.Equal(t, rows[0][7], "use_index(@`sel_1` `test`.`t` ), ignore_index(`t` `a`)")
 	require.Equal(t, rows[0][8], "select * from `t` where `a` = ?")
 }
+
+func TestTiDBTempPaths(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustQuery("select * from information_schema.tidb_temp_paths").Check(testkit.Rows())
+
+	dir := t.TempDir()
+	require.NoError(t, disk.InitTempPaths([]config.TempPath{
+		{Path: dir + "/ingest", Quota: 1024, Usage: config.TempPathUsageIngest},
+		{Path: dir + "/spill", Usage: config.TempPathUsageSpill},
+	}))
+	defer func() {
+		require.NoError(t, disk.InitTempPaths(nil))
+	}()
+	disk.PickTempPath(config.TempPathUsageIngest).Consume(100)
+	tk.MustQuery("select path, `usage`, quota, used, capacity > 0 from information_schema.tidb_temp_paths").Check(testkit.Rows(
+		dir+"/ingest ingest 1024 100 1",
+		dir+"/spill spill 0 0 1",
+	))
+}
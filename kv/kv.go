@@ -578,6 +578,13 @@ type Request struct {
 	LimitSize uint64
 	// StoreBusyThreshold is the threshold for the store to return ServerIsBusy
 	StoreBusyThreshold time.Duration
+	// SnapshotRefreshInterval is the interval to refresh the snapshot read by the coprocessor tasks, 0 means never.
+	// The tasks which start after the snapshot gets older than the interval read a newer snapshot, so the results
+	// of the request may come from different snapshots. It's only allowed for the autocommit read-only statements.
+	SnapshotRefreshInterval time.Duration
+	// AdaptiveConcurrency indicates whether the in-flight coprocessor tasks sent to each TiKV store are limited by
+	// the latency of the store, Concurrency is the base of the number of the workers then.
+	AdaptiveConcurrency bool
 
 	// ConnID stores the session connection id.
 	ConnID uint64
@@ -648,6 +655,14 @@ type BatchGetter interface {
 	BatchGet(ctx context.Context, keys []Key) (map[string][]byte, error)
 }
 
+// BatchGetVisitor is the interface for BatchGetVisit. It is implemented by the BatchGetters which
+// can visit a batch of values without building the result map of BatchGet.
+type BatchGetVisitor interface {
+	// BatchGetVisit gets a batch of values and calls fn with the index and the value of each key in the order of keys.
+	// The value is empty if the key doesn't exist.
+	BatchGetVisit(ctx context.Context, keys []Key, fn func(idx int, val []byte) error) error
+}
+
 // Driver is the interface that must be implemented by a KV storage.
 type Driver interface {
 	// Open returns a new Storage.
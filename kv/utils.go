@@ -84,3 +84,22 @@ func WalkMemBuffer(memBuf Retriever, f func(k Key, v []byte) error) error {
 
 	return nil
 }
+
+// VisitBatchGet gets a batch of values by the getter and calls fn with the index and the value of each key
+// in the order of keys, the value is empty if the key doesn't exist. If the getter implements BatchGetVisitor,
+// the values are passed to fn directly, so the callers can decode them without the intermediate result map.
+func VisitBatchGet(ctx context.Context, getter BatchGetter, keys []Key, fn func(idx int, val []byte) error) error {
+	if visitor, ok := getter.(BatchGetVisitor); ok {
+		return visitor.BatchGetVisit(ctx, keys, fn)
+	}
+	vals, err := getter.BatchGet(ctx, keys)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		if err = fn(i, vals[string(key)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
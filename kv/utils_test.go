@@ -64,6 +64,50 @@ func TestGetInt64(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+type mockBatchGetVisitor struct {
+	*mockSnapshot
+	visited bool
+}
+
+func (s *mockBatchGetVisitor) BatchGetVisit(ctx context.Context, keys []Key, fn func(idx int, val []byte) error) error {
+	s.visited = true
+	for i, k := range keys {
+		v, err := s.store.Get(ctx, k)
+		if err != nil && !IsErrNotFound(err) {
+			return err
+		}
+		if err = fn(i, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestVisitBatchGet(t *testing.T) {
+	mb := newMockMap()
+	assert.Nil(t, mb.Set(Key("a"), []byte("1")))
+	assert.Nil(t, mb.Set(Key("c"), []byte("3")))
+	keys := []Key{Key("c"), Key("b"), Key("a")}
+
+	visitor := &mockBatchGetVisitor{mockSnapshot: &mockSnapshot{mb}}
+	for _, getter := range []BatchGetter{&mockSnapshot{mb}, visitor} {
+		var vals []string
+		err := VisitBatchGet(context.TODO(), getter, keys, func(idx int, val []byte) error {
+			assert.Equal(t, len(vals), idx)
+			vals = append(vals, string(val))
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"3", "", "1"}, vals)
+	}
+	assert.True(t, visitor.visited)
+
+	err := VisitBatchGet(context.TODO(), visitor, keys, func(int, []byte) error {
+		return ErrNotExist
+	})
+	assert.True(t, ErrNotExist.Equal(err))
+}
+
 type mockMap struct {
 	index []Key
 	value [][]byte
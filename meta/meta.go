@@ -775,7 +775,11 @@ func (m *Meta) RestartSequenceValue(dbID int64, tableInfo *model.TableInfo, seqV
 	if err := m.checkTableExists(dbKey, tableKey); err != nil {
 		return errors.Trace(err)
 	}
-	return errors.Trace(m.txn.HSet(m.dbKey(dbID), m.sequenceKey(tableInfo.ID), []byte(strconv.FormatInt(seqValue, 10))))
+	// The restarted sequence is not in cycle any more, so its values are stepped from the start value again.
+	if err := m.txn.HDel(dbKey, m.sequenceCycleKey(tableInfo.ID)); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(m.txn.HSet(dbKey, m.sequenceKey(tableInfo.ID), []byte(strconv.FormatInt(seqValue, 10))))
 }
 
 // DropPolicy drops the specified policy.
@@ -1307,6 +1311,8 @@ var (
 	ColumnElementKey ElementKeyType = []byte("_col_")
 	// IndexElementKey is the key for index element.
 	IndexElementKey ElementKeyType = []byte("_idx_")
+	// ConstraintElementKey is the key for check constraint element.
+	ConstraintElementKey ElementKeyType = []byte("_cst_")
 )
 
 const elementKeyLen = 5
@@ -1347,6 +1353,8 @@ func DecodeElement(b []byte) (*Element, error) {
 		tp = IndexElementKey
 	case string(ColumnElementKey):
 		tp = ColumnElementKey
+	case string(ConstraintElementKey):
+		tp = ConstraintElementKey
 	default:
 		return nil, errors.Errorf("invalid encoded element key prefix %q", prefix)
 	}
@@ -53,8 +53,11 @@ var (
 
 	BackfillTotalCounter  *prometheus.CounterVec
 	BackfillProgressGauge *prometheus.GaugeVec
+	BackfillRetryCounter  *prometheus.CounterVec
 	DDLJobTableDuration   *prometheus.HistogramVec
 	DDLRunningJobCount    *prometheus.GaugeVec
+	DDLQueueJobCount      *prometheus.GaugeVec
+	DDLQueueWaitHistogram *prometheus.HistogramVec
 )
 
 // InitDDLMetrics initializes defines DDL metrics.
@@ -145,6 +148,14 @@ func InitDDLMetrics() {
 			Help:      "Percentage progress of backfill",
 		}, []string{LblType})
 
+	BackfillRetryCounter = NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb",
+			Subsystem: "ddl",
+			Name:      "backfill_task_retry_total",
+			Help:      "Counter of retries of the backfill tasks caused by transient errors",
+		}, []string{LblType})
+
 	DDLJobTableDuration = NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: "tidb",
 		Subsystem: "ddl",
@@ -160,6 +171,23 @@ func InitDDLMetrics() {
 			Name:      "running_job_count",
 			Help:      "Running DDL jobs count",
 		}, []string{LblType})
+
+	DDLQueueJobCount = NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb",
+			Subsystem: "ddl",
+			Name:      "queue_job_count",
+			Help:      "Gauge of the DDL jobs waiting in the general and reorg queues of the cluster",
+		}, []string{LblType})
+
+	DDLQueueWaitHistogram = NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tidb",
+			Subsystem: "ddl",
+			Name:      "queue_wait_duration_seconds",
+			Help:      "Bucketed histogram of the time (s) that the DDL jobs wait in the queues before they start",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 20), // 10ms ~ 1.5hours
+		}, []string{LblType})
 }
 
 // Label constants.
@@ -28,6 +28,7 @@ var (
 	GCActionRegionResultCounter            *prometheus.CounterVec
 	GCRegionTooManyLocksCounter            prometheus.Counter
 	GCUnsafeDestroyRangeFailuresCounterVec *prometheus.CounterVec
+	GCDeleteRangeGauge                     *prometheus.GaugeVec
 )
 
 // InitGCWorkerMetrics initializes GC worker metrics.
@@ -80,6 +81,14 @@ func InitGCWorkerMetrics() {
 			Name:      "gc_region_too_many_locks",
 			Help:      "Counter of gc scan lock request more than once in the same region.",
 		})
+
+	GCDeleteRangeGauge = NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb",
+			Subsystem: "tikvclient",
+			Name:      "gc_delete_range_tasks",
+			Help:      "Gauge of the delete range tasks by state.",
+		}, []string{"type"})
 }
 
 func init() {
@@ -129,9 +129,12 @@ func RegisterMetrics() {
 	prometheus.MustRegister(DDLCounter)
 	prometheus.MustRegister(BackfillTotalCounter)
 	prometheus.MustRegister(BackfillProgressGauge)
+	prometheus.MustRegister(BackfillRetryCounter)
 	prometheus.MustRegister(DDLWorkerHistogram)
 	prometheus.MustRegister(DDLJobTableDuration)
 	prometheus.MustRegister(DDLRunningJobCount)
+	prometheus.MustRegister(DDLQueueJobCount)
+	prometheus.MustRegister(DDLQueueWaitHistogram)
 	prometheus.MustRegister(DeploySyncerHistogram)
 	prometheus.MustRegister(DistSQLPartialCountHistogram)
 	prometheus.MustRegister(DistSQLCoprCacheCounter)
@@ -164,6 +167,7 @@ func RegisterMetrics() {
 	prometheus.MustRegister(PanicCounter)
 	prometheus.MustRegister(PlanCacheCounter)
 	prometheus.MustRegister(PlanCacheMissCounter)
+	prometheus.MustRegister(QueryRewriteCounter)
 	prometheus.MustRegister(PlanCacheInstanceMemoryUsage)
 	prometheus.MustRegister(PlanCacheInstancePlanNumCounter)
 	prometheus.MustRegister(PseudoEstimation)
@@ -179,6 +183,7 @@ func RegisterMetrics() {
 	prometheus.MustRegister(SessionRestrictedSQLCounter)
 	prometheus.MustRegister(SessionRetry)
 	prometheus.MustRegister(SessionRetryErrorCounter)
+	prometheus.MustRegister(StatementRetryCounter)
 	prometheus.MustRegister(StatementPerTransaction)
 	prometheus.MustRegister(StatsInaccuracyRate)
 	prometheus.MustRegister(StmtNodeCounter)
@@ -196,6 +201,7 @@ func RegisterMetrics() {
 	prometheus.MustRegister(WatchOwnerCounter)
 	prometheus.MustRegister(GCActionRegionResultCounter)
 	prometheus.MustRegister(GCConfigGauge)
+	prometheus.MustRegister(GCDeleteRangeGauge)
 	prometheus.MustRegister(GCHistogram)
 	prometheus.MustRegister(GCJobFailureCounter)
 	prometheus.MustRegister(GCRegionTooManyLocksCounter)
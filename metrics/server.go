@@ -47,6 +47,7 @@ var (
 	TimeJumpBackCounter             prometheus.Counter
 	PlanCacheCounter                *prometheus.CounterVec
 	PlanCacheMissCounter            *prometheus.CounterVec
+	QueryRewriteCounter             *prometheus.CounterVec
 	PlanCacheInstanceMemoryUsage    *prometheus.GaugeVec
 	PlanCacheInstancePlanNumCounter *prometheus.GaugeVec
 	ReadFromTableCacheCounter       prometheus.Counter
@@ -177,6 +178,14 @@ func InitServerMetrics() {
 			Help:      "Counter of plan cache miss.",
 		}, []string{LblType})
 
+	QueryRewriteCounter = NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb",
+			Subsystem: "server",
+			Name:      "query_rewrite_total",
+			Help:      "Counter of queries rewritten by each query rewrite rule.",
+		}, []string{LblName})
+
 	PlanCacheInstanceMemoryUsage = NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "tidb",
@@ -25,6 +25,7 @@ var (
 	SchemaLeaseErrorCounter            *prometheus.CounterVec
 	SessionRetry                       *prometheus.HistogramVec
 	SessionRetryErrorCounter           *prometheus.CounterVec
+	StatementRetryCounter              *prometheus.CounterVec
 	SessionRestrictedSQLCounter        prometheus.Counter
 	StatementPerTransaction            *prometheus.HistogramVec
 	TransactionDuration                *prometheus.HistogramVec
@@ -105,6 +106,14 @@ func InitSessionMetrics() {
 			Help:      "Counter of session retry error.",
 		}, []string{LblSQLType, LblType})
 
+	StatementRetryCounter = NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb",
+			Subsystem: "session",
+			Name:      "statement_retry_total",
+			Help:      "Counter of the automatic retries of autocommit statements.",
+		}, []string{LblType})
+
 	SessionRestrictedSQLCounter = NewCounter(
 		prometheus.CounterOpts{
 			Namespace: "tidb",
@@ -197,8 +197,12 @@ func GetStmtLabel(stmtNode StmtNode) string {
 		return "Delete"
 	case *DropDatabaseStmt:
 		return "DropDatabase"
+	case *RenameDatabaseStmt:
+		return "RenameDatabase"
 	case *DropIndexStmt:
 		return "DropIndex"
+	case *UndropIndexStmt:
+		return "UndropIndex"
 	case *DropTableStmt:
 		if x.IsView {
 			return "DropView"
@@ -39,10 +39,12 @@ var (
 	_ DDLNode = &DropDatabaseStmt{}
 	_ DDLNode = &FlashBackDatabaseStmt{}
 	_ DDLNode = &DropIndexStmt{}
+	_ DDLNode = &UndropIndexStmt{}
 	_ DDLNode = &DropTableStmt{}
 	_ DDLNode = &DropSequenceStmt{}
 	_ DDLNode = &DropPlacementPolicyStmt{}
 	_ DDLNode = &DropResourceGroupStmt{}
+	_ DDLNode = &RenameDatabaseStmt{}
 	_ DDLNode = &RenameTableStmt{}
 	_ DDLNode = &TruncateTableStmt{}
 	_ DDLNode = &RepairTableStmt{}
@@ -522,6 +524,7 @@ const (
 	ColumnOptionColumnFormat
 	ColumnOptionStorage
 	ColumnOptionAutoRandom
+	ColumnOptionCompression
 )
 
 var (
@@ -639,6 +642,13 @@ func (n *ColumnOption) Restore(ctx *format.RestoreCtx) error {
 	case ColumnOptionStorage:
 		ctx.WriteKeyWord("STORAGE ")
 		ctx.WriteKeyWord(n.StrValue)
+	case ColumnOptionCompression:
+		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDColumnCompression, func() error {
+			ctx.WriteKeyWord("COMPRESSION ")
+			ctx.WritePlain("= ")
+			ctx.WriteString(n.StrValue)
+			return nil
+		})
 	case ColumnOptionAutoRandom:
 		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDAutoRandom, func() error {
 			ctx.WriteKeyWord("AUTO_RANDOM")
@@ -1353,6 +1363,33 @@ func (n *DropSequenceStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// RenameDatabaseStmt is a statement to rename a database.
+type RenameDatabaseStmt struct {
+	ddlNode
+
+	OldName model.CIStr
+	NewName model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *RenameDatabaseStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RENAME DATABASE ")
+	ctx.WriteName(n.OldName.O)
+	ctx.WriteKeyWord(" TO ")
+	ctx.WriteName(n.NewName.O)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *RenameDatabaseStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*RenameDatabaseStmt)
+	return v.Leave(n)
+}
+
 // RenameTableStmt is a statement to rename a table.
 // See http://dev.mysql.com/doc/refman/5.7/en/rename-table.html
 type RenameTableStmt struct {
@@ -1723,6 +1760,8 @@ type CreateIndexStmt struct {
 	IndexOption             *IndexOption
 	KeyType                 IndexKeyType
 	LockAlg                 *IndexLockAndAlgorithm
+	// MaintenanceWindow is the name of the maintenance window the DDL job runs in, it's empty if the job runs at once.
+	MaintenanceWindow model.CIStr
 }
 
 // Restore implements Node interface.
@@ -1771,6 +1810,11 @@ func (n *CreateIndexStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	}
 
+	if n.MaintenanceWindow.O != "" {
+		ctx.WriteKeyWord(" IN WINDOW ")
+		ctx.WriteName(n.MaintenanceWindow.O)
+	}
+
 	return nil
 }
 
@@ -1819,6 +1863,8 @@ type DropIndexStmt struct {
 	IndexName string
 	Table     *TableName
 	LockAlg   *IndexLockAndAlgorithm
+	// Deferred indicates the index is only dropped logically and can be restored by UNDROP INDEX.
+	Deferred bool
 }
 
 // Restore implements Node interface.
@@ -1844,6 +1890,10 @@ func (n *DropIndexStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	}
 
+	if n.Deferred {
+		ctx.WriteKeyWord(" DEFERRED")
+	}
+
 	return nil
 }
 
@@ -1869,6 +1919,40 @@ func (n *DropIndexStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// UndropIndexStmt is a statement to restore the index dropped by `DROP INDEX ... DEFERRED`.
+type UndropIndexStmt struct {
+	ddlNode
+
+	IndexName string
+	Table     *TableName
+}
+
+// Restore implements Node interface.
+func (n *UndropIndexStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("UNDROP INDEX ")
+	ctx.WriteName(n.IndexName)
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore UndropIndexStmt.Table")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *UndropIndexStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*UndropIndexStmt)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	return v.Leave(n)
+}
+
 // LockTablesStmt is a statement to lock tables.
 type LockTablesStmt struct {
 	ddlNode
@@ -2800,6 +2884,10 @@ const (
 	AlterTableReorganizeLastPartition
 	AlterTableReorganizeFirstPartition
 	AlterTableRemoveTTL
+	AlterTableSplitPartition
+	AlterTableMergePartitions
+	AlterTableDetachPartition
+	AlterTableAttachPartition
 )
 
 // LockType is the type for AlterTableSpec.
@@ -2869,32 +2957,37 @@ type AlterTableSpec struct {
 	// see https://mariadb.com/kb/en/library/alter-table/
 	IfNotExists bool
 
+	// Cascade indicates whether to drop the objects which depend on the dropped column, such as views and foreign keys.
+	Cascade bool
+
 	NoWriteToBinlog bool
 	OnAllPartitions bool
 
-	Tp               AlterTableType
-	Name             string
-	IndexName        model.CIStr
-	Constraint       *Constraint
-	Options          []*TableOption
-	OrderByList      []*AlterOrderItem
-	NewTable         *TableName
-	NewColumns       []*ColumnDef
-	NewConstraints   []*Constraint
-	OldColumnName    *ColumnName
-	NewColumnName    *ColumnName
-	Position         *ColumnPosition
-	LockType         LockType
-	Algorithm        AlgorithmType
-	Comment          string
-	FromKey          model.CIStr
-	ToKey            model.CIStr
-	Partition        *PartitionOptions
-	PartitionNames   []model.CIStr
-	PartDefinitions  []*PartitionDefinition
-	WithValidation   bool
-	Num              uint64
-	Visibility       IndexVisibility
+	Tp              AlterTableType
+	Name            string
+	IndexName       model.CIStr
+	Constraint      *Constraint
+	Options         []*TableOption
+	OrderByList     []*AlterOrderItem
+	NewTable        *TableName
+	NewColumns      []*ColumnDef
+	NewConstraints  []*Constraint
+	OldColumnName   *ColumnName
+	NewColumnName   *ColumnName
+	Position        *ColumnPosition
+	LockType        LockType
+	Algorithm       AlgorithmType
+	Comment         string
+	FromKey         model.CIStr
+	ToKey           model.CIStr
+	Partition       *PartitionOptions
+	PartitionNames  []model.CIStr
+	PartDefinitions []*PartitionDefinition
+	WithValidation  bool
+	Num             uint64
+	Visibility      IndexVisibility
+	// Deferred indicates the index of `DROP INDEX` is only dropped logically and can be restored by UNDROP INDEX.
+	Deferred         bool
 	TiFlashReplica   *TiFlashReplicaSpec
 	Writeable        bool
 	Statistics       *StatisticsSpec
@@ -2971,6 +3064,8 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 			ctx.WriteKeyWord(" DEPENDENCY(")
 		case StatsTypeCorrelation:
 			ctx.WriteKeyWord(" CORRELATION(")
+		case StatsTypeSketch:
+			ctx.WriteKeyWord(" SKETCH(")
 		}
 		for i, col := range n.Statistics.Columns {
 			if i != 0 {
@@ -3065,7 +3160,9 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		if err := n.OldColumnName.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore AlterTableSpec.OldColumnName")
 		}
-	// TODO: RestrictOrCascadeOpt not support
+		if n.Cascade {
+			ctx.WriteKeyWord(" CASCADE")
+		}
 	case AlterTableDropPrimaryKey:
 		ctx.WriteKeyWord("DROP PRIMARY KEY")
 	case AlterTableDropIndex:
@@ -3074,6 +3171,9 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 			ctx.WriteKeyWord("IF EXISTS ")
 		}
 		ctx.WriteName(n.Name)
+		if n.Deferred {
+			ctx.WriteKeyWord(" DEFERRED")
+		}
 	case AlterTableDropForeignKey:
 		ctx.WriteKeyWord("DROP FOREIGN KEY ")
 		if n.IfExists {
@@ -3403,6 +3503,49 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore AlterTableReorganizeLastPartition Exprs")
 		}
 		ctx.WriteKeyWord(")")
+	case AlterTableSplitPartition:
+		ctx.WriteKeyWord("SPLIT PARTITION ")
+		ctx.WriteName(n.PartitionNames[0].O)
+		ctx.WriteKeyWord(" INTO ")
+		ctx.WritePlain("(")
+		for i, def := range n.PartDefinitions {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			if err := def.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore AlterTableSpec.PartDefinitions[%d]", i)
+			}
+		}
+		ctx.WritePlain(")")
+	case AlterTableMergePartitions:
+		ctx.WriteKeyWord("MERGE PARTITIONS ")
+		for i, name := range n.PartitionNames {
+			if i != 0 {
+				ctx.WritePlain(",")
+			}
+			ctx.WriteName(name.O)
+		}
+		ctx.WriteKeyWord(" INTO PARTITION ")
+		ctx.WriteName(n.Name)
+	case AlterTableDetachPartition:
+		ctx.WriteKeyWord("DETACH PARTITION ")
+		ctx.WriteName(n.PartitionNames[0].O)
+		ctx.WriteKeyWord(" INTO TABLE ")
+		if err := n.NewTable.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterTableSpec.NewTable")
+		}
+	case AlterTableAttachPartition:
+		ctx.WriteKeyWord("ATTACH ")
+		if err := n.PartDefinitions[0].Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterTableSpec.PartDefinitions[0]")
+		}
+		ctx.WriteKeyWord(" FROM TABLE ")
+		if err := n.NewTable.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterTableSpec.NewTable")
+		}
+		if !n.WithValidation {
+			ctx.WriteKeyWord(" WITHOUT VALIDATION")
+		}
 	case AlterTableReorganizePartition:
 		ctx.WriteKeyWord("REORGANIZE PARTITION")
 		if n.NoWriteToBinlog {
@@ -3571,6 +3714,8 @@ type AlterTableStmt struct {
 
 	Table *TableName
 	Specs []*AlterTableSpec
+	// MaintenanceWindow is the name of the maintenance window the DDL job runs in, it's empty if the job runs at once.
+	MaintenanceWindow model.CIStr
 }
 
 func (n *AlterTableStmt) HaveOnlyPlacementOptions() bool {
@@ -3620,6 +3765,10 @@ func (n *AlterTableStmt) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore AlterTableStmt.Specs[%d]", i)
 		}
 	}
+	if n.MaintenanceWindow.O != "" {
+		ctx.WriteKeyWord(" IN WINDOW ")
+		ctx.WriteName(n.MaintenanceWindow.O)
+	}
 	return nil
 }
 
@@ -506,7 +506,7 @@ func TestAlterTableSpecRestore(t *testing.T) {
 		{"ADD CONSTRAINT fk_123 FOREIGN KEY (parent_id) REFERENCES parent(id) ON DELETE CASCADE ON UPDATE RESTRICT", "ADD CONSTRAINT `fk_123` FOREIGN KEY (`parent_id`) REFERENCES `parent`(`id`) ON DELETE CASCADE ON UPDATE RESTRICT"},
 		{"DROP COLUMN a", "DROP COLUMN `a`"},
 		{"DROP COLUMN a RESTRICT", "DROP COLUMN `a`"},
-		{"DROP COLUMN a CASCADE", "DROP COLUMN `a`"},
+		{"DROP COLUMN a CASCADE", "DROP COLUMN `a` CASCADE"},
 		{"DROP PRIMARY KEY", "DROP PRIMARY KEY"},
 		{"drop index a", "DROP INDEX `a`"},
 		{"drop key a", "DROP INDEX `a`"},
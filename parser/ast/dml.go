@@ -449,6 +449,52 @@ func (n *TableName) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// TableFunction represents a call of a table function in the FROM clause, which returns a set of rows.
+// e.g. SELECT * FROM JSON_EACH('{"a": 1}')
+type TableFunction struct {
+	node
+
+	// FnName is the name of the table function.
+	FnName model.CIStr
+	// Args is the arguments of the table function.
+	Args []ExprNode
+}
+
+func (*TableFunction) resultSet() {}
+
+// Restore implements Node interface.
+func (n *TableFunction) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteName(n.FnName.O)
+	ctx.WritePlain("(")
+	for i, arg := range n.Args {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := arg.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore TableFunction.Args[%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *TableFunction) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*TableFunction)
+	for i, arg := range n.Args {
+		node, ok := arg.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Args[i] = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
 // DeleteTableList is the tablelist used in delete statement multi-table mode.
 type DeleteTableList struct {
 	node
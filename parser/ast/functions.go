@@ -79,6 +79,7 @@ const (
 	Values             = "values"
 	BitCount           = "bit_count"
 	GetParam           = "getparam"
+	MatchAgainstFunc   = "match_against" // Avoid name conflict with the MatchAgainst expression node.
 
 	// common functions
 	Coalesce = "coalesce"
@@ -338,6 +339,7 @@ const (
 	JSONMemberOf      = "json_memberof"
 	JSONContainsPath  = "json_contains_path"
 	JSONValid         = "json_valid"
+	JSONSchemaValid   = "json_schema_valid"
 	JSONArrayAppend   = "json_array_append"
 	JSONArrayInsert   = "json_array_insert"
 	JSONMergePatch    = "json_merge_patch"
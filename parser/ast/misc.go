@@ -59,6 +59,8 @@ var (
 	_ StmtNode = &PlanReplayerStmt{}
 	_ StmtNode = &CompactTableStmt{}
 	_ StmtNode = &SetResourceGroupStmt{}
+	_ StmtNode = &CreateMaintenanceWindowStmt{}
+	_ StmtNode = &DropMaintenanceWindowStmt{}
 
 	_ Node = &PrivElem{}
 	_ Node = &VariableAssignment{}
@@ -212,6 +214,57 @@ type ExplainStmt struct {
 	Stmt    StmtNode
 	Format  string
 	Analyze bool
+	// HypoIndexes are the indexes of `WITH HYPOTHETICAL INDEX`, the statement is explained as if they existed.
+	HypoIndexes []*HypotheticalIndex
+}
+
+// HypotheticalIndex is an index which doesn't exist but is considered by the optimizer in `EXPLAIN ... WITH
+// HYPOTHETICAL INDEX t(a, b)`, so the benefit of an index can be evaluated before creating it.
+type HypotheticalIndex struct {
+	node
+
+	Table                   *TableName
+	IndexPartSpecifications []*IndexPartSpecification
+}
+
+// Restore implements Node interface.
+func (n *HypotheticalIndex) Restore(ctx *format.RestoreCtx) error {
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore HypotheticalIndex.Table")
+	}
+	ctx.WritePlain("(")
+	for i, part := range n.IndexPartSpecifications {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := part.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore HypotheticalIndex.IndexPartSpecifications: [%v]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *HypotheticalIndex) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*HypotheticalIndex)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	for i, part := range n.IndexPartSpecifications {
+		node, ok := part.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.IndexPartSpecifications[i] = node.(*IndexPartSpecification)
+	}
+	return v.Leave(n)
 }
 
 // Restore implements Node interface.
@@ -239,6 +292,18 @@ func (n *ExplainStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteString(n.Format)
 		ctx.WritePlain(" ")
 	}
+	if len(n.HypoIndexes) > 0 {
+		ctx.WriteKeyWord("WITH HYPOTHETICAL INDEX ")
+		for i, idx := range n.HypoIndexes {
+			if i > 0 {
+				ctx.WritePlain(", ")
+			}
+			if err := idx.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore ExplainStmt.HypoIndexes: [%v]", i)
+			}
+		}
+		ctx.WritePlain(" ")
+	}
 	if err := n.Stmt.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occurred while restore ExplainStmt.Stmt")
 	}
@@ -252,6 +317,13 @@ func (n *ExplainStmt) Accept(v Visitor) (Node, bool) {
 		return v.Leave(newNode)
 	}
 	n = newNode.(*ExplainStmt)
+	for i, idx := range n.HypoIndexes {
+		node, ok := idx.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.HypoIndexes[i] = node.(*HypotheticalIndex)
+	}
 	node, ok := n.Stmt.Accept(v)
 	if !ok {
 		return n, false
@@ -2070,6 +2142,11 @@ const (
 	StatsTypeCardinality uint8 = iota
 	StatsTypeDependency
 	StatsTypeCorrelation
+	// StatsTypeExpression is the histogram on an expression, which is built by `ANALYZE TABLE ... UPDATE HISTOGRAM ON (expr)`.
+	StatsTypeExpression
+	// StatsTypeSketch is the count-min sketch and the FM sketch on the combined values of multiple columns, which are
+	// used to estimate the selectivity of the conjunctive equal conditions on the correlated columns.
+	StatsTypeSketch
 )
 
 // StatisticsSpec is the specification for ADD /DROP STATISTICS.
@@ -2085,6 +2162,7 @@ type StatisticsSpec struct {
 //	CREATE STATISTICS stats1 (cardinality) ON t(a, b, c);
 //	CREATE STATISTICS stats2 (dependency) ON t(a, b);
 //	CREATE STATISTICS stats3 (correlation) ON t(a, b);
+//	CREATE STATISTICS stats4 (sketch) ON t(a, b, c);
 type CreateStatisticsStmt struct {
 	stmtNode
 
@@ -2109,6 +2187,8 @@ func (n *CreateStatisticsStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord(" (dependency) ")
 	case StatsTypeCorrelation:
 		ctx.WriteKeyWord(" (correlation) ")
+	case StatsTypeSketch:
+		ctx.WriteKeyWord(" (sketch) ")
 	}
 	ctx.WriteKeyWord("ON ")
 	if err := n.Table.Restore(ctx); err != nil {
@@ -2245,6 +2325,14 @@ const (
 	AdminResetTelemetryID
 	AdminReloadStatistics
 	AdminFlushPlanCache
+	AdminWaitDDLJob
+	AdminShowUnusedIndexes
+	AdminCheckGlobalIndex
+	AdminShowDDLDependencies
+	AdminVerifyIndex
+	AdminReloadQueryRewriteRules
+	AdminWorkloadSimulator
+	AdminExplainRollbackDDLJob
 )
 
 // HandleRange represents a range where handle value >= Begin and < End.
@@ -2340,6 +2428,18 @@ type AdminStmt struct {
 	Where          ExprNode
 	StatementScope StatementScope
 	LimitSimple    LimitSimple
+
+	// VerifyRate is the rate limit of ADMIN VERIFY INDEX, like '50MB/s', empty means unlimited.
+	VerifyRate string
+	// VerifyRepair indicates whether ADMIN VERIFY INDEX repairs the missing index entries.
+	VerifyRepair bool
+
+	// WorkloadDDL is the DDL statement run by ADMIN WORKLOAD SIMULATOR.
+	WorkloadDDL string
+	// WorkloadConcurrency is the number of the workers which generate the DML load, 0 means the default.
+	WorkloadConcurrency uint64
+	// WorkloadRate is the max DML operations per second of all the workers, 0 means unlimited.
+	WorkloadRate uint64
 }
 
 // Restore implements Node interface.
@@ -2368,6 +2468,8 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 	switch n.Tp {
 	case AdminShowDDL:
 		ctx.WriteKeyWord("SHOW DDL")
+	case AdminShowDDLDependencies:
+		ctx.WriteKeyWord("SHOW DDL DEPENDENCIES")
 	case AdminShowDDLJobs:
 		ctx.WriteKeyWord("SHOW DDL JOBS")
 		if n.JobNumber != 0 {
@@ -2396,6 +2498,12 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 			return err
 		}
 		ctx.WritePlainf(" %s", n.Index)
+	case AdminCheckGlobalIndex:
+		ctx.WriteKeyWord("CHECK GLOBAL INDEX ")
+		if err := restoreTables(); err != nil {
+			return err
+		}
+		ctx.WritePlainf(" %s", n.Index)
 	case AdminRecoverIndex:
 		ctx.WriteKeyWord("RECOVER INDEX ")
 		if err := restoreTables(); err != nil {
@@ -2408,6 +2516,36 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 			return err
 		}
 		ctx.WritePlainf(" %s", n.Index)
+	case AdminVerifyIndex:
+		ctx.WriteKeyWord("VERIFY INDEX ")
+		if err := restoreTables(); err != nil {
+			return err
+		}
+		ctx.WritePlainf(" %s", n.Index)
+		if n.VerifyRate != "" {
+			ctx.WriteKeyWord(" RATE ")
+			ctx.WritePlain("= ")
+			ctx.WriteString(n.VerifyRate)
+		}
+		if n.VerifyRepair {
+			ctx.WriteKeyWord(" REPAIR")
+		}
+	case AdminWorkloadSimulator:
+		ctx.WriteKeyWord("WORKLOAD SIMULATOR ")
+		if err := restoreTables(); err != nil {
+			return err
+		}
+		ctx.WriteKeyWord(" DDL ")
+		ctx.WritePlain("= ")
+		ctx.WriteString(n.WorkloadDDL)
+		if n.WorkloadConcurrency != 0 {
+			ctx.WriteKeyWord(" CONCURRENCY ")
+			ctx.WritePlainf("= %d", n.WorkloadConcurrency)
+		}
+		if n.WorkloadRate != 0 {
+			ctx.WriteKeyWord(" RATE ")
+			ctx.WritePlainf("= %d", n.WorkloadRate)
+		}
 	case AdminCheckIndexRange:
 		ctx.WriteKeyWord("CHECK INDEX ")
 		if err := restoreTables(); err != nil {
@@ -2434,6 +2572,12 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 	case AdminShowDDLJobQueries:
 		ctx.WriteKeyWord("SHOW DDL JOB QUERIES ")
 		restoreJobIDs()
+	case AdminWaitDDLJob:
+		ctx.WriteKeyWord("WAIT DDL JOB ")
+		restoreJobIDs()
+	case AdminExplainRollbackDDLJob:
+		ctx.WriteKeyWord("EXPLAIN ROLLBACK DDL JOB ")
+		restoreJobIDs()
 	case AdminShowDDLJobQueriesWithRange:
 		ctx.WriteKeyWord("SHOW DDL JOB QUERIES LIMIT ")
 		ctx.WritePlainf("%d, %d", n.LimitSimple.Offset, n.LimitSimple.Count)
@@ -2446,6 +2590,8 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("RELOAD EXPR_PUSHDOWN_BLACKLIST")
 	case AdminReloadOptRuleBlacklist:
 		ctx.WriteKeyWord("RELOAD OPT_RULE_BLACKLIST")
+	case AdminReloadQueryRewriteRules:
+		ctx.WriteKeyWord("RELOAD QUERY_REWRITE_RULES")
 	case AdminPluginEnable:
 		ctx.WriteKeyWord("PLUGINS ENABLE")
 		for i, v := range n.Plugins {
@@ -2474,6 +2620,8 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("EVOLVE BINDINGS")
 	case AdminReloadBindings:
 		ctx.WriteKeyWord("RELOAD BINDINGS")
+	case AdminShowUnusedIndexes:
+		ctx.WriteKeyWord("SHOW UNUSED INDEXES")
 	case AdminShowTelemetry:
 		ctx.WriteKeyWord("SHOW TELEMETRY")
 	case AdminResetTelemetryID:
@@ -3504,6 +3652,54 @@ func (ht *HintTable) Restore(ctx *format.RestoreCtx) {
 	}
 }
 
+// LeadingList is the table list of the LEADING hint which has nested lists, e.g. `LEADING(t1, (t2, t3))`. The items
+// of a list are joined in order and a nested list is joined as a whole, so the hint can specify a bushy join tree.
+type LeadingList struct {
+	// Items are HintTable or *LeadingList.
+	Items []interface{}
+}
+
+// Tables returns the tables in the list in order, including the tables in the nested lists.
+func (l *LeadingList) Tables() []HintTable {
+	tables := make([]HintTable, 0, len(l.Items))
+	for _, item := range l.Items {
+		switch x := item.(type) {
+		case HintTable:
+			tables = append(tables, x)
+		case *LeadingList:
+			tables = append(tables, x.Tables()...)
+		}
+	}
+	return tables
+}
+
+// IsNested returns whether the list has nested lists.
+func (l *LeadingList) IsNested() bool {
+	for _, item := range l.Items {
+		if _, ok := item.(*LeadingList); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Restore restores the items of the list, the nested lists are enclosed in parentheses.
+func (l *LeadingList) Restore(ctx *format.RestoreCtx) {
+	for i, item := range l.Items {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		switch x := item.(type) {
+		case HintTable:
+			x.Restore(ctx)
+		case *LeadingList:
+			ctx.WritePlain("(")
+			x.Restore(ctx)
+			ctx.WritePlain(")")
+		}
+	}
+}
+
 // Restore implements Node interface.
 func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord(n.HintName.String())
@@ -3520,7 +3716,7 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 	}
 	// Hints without args except query block.
 	switch n.HintName.L {
-	case "mpp_1phase_agg", "mpp_2phase_agg", "hash_agg", "stream_agg", "agg_to_cop", "read_consistent_replica", "no_index_merge", "ignore_plan_cache", "limit_to_cop", "straight_join", "merge", "no_decorrelate":
+	case "mpp_1phase_agg", "mpp_2phase_agg", "hash_agg", "stream_agg", "agg_to_cop", "agg_to_root", "agg_to_mpp", "read_consistent_replica", "no_index_merge", "ignore_plan_cache", "limit_to_cop", "limit_to_root", "limit_to_mpp", "straight_join", "merge", "no_decorrelate":
 		ctx.WritePlain(")")
 		return nil
 	}
@@ -3535,7 +3731,18 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteName(n.HintData.(string))
 	case "nth_plan":
 		ctx.WritePlainf("%d", n.HintData.(int64))
-	case "tidb_hj", "tidb_smj", "tidb_inlj", "hash_join", "hash_join_build", "hash_join_probe", "merge_join", "inl_join", "broadcast_join", "shuffle_join", "inl_hash_join", "inl_merge_join", "leading":
+	case "leading":
+		if list, ok := n.HintData.(*LeadingList); ok {
+			list.Restore(ctx)
+			break
+		}
+		for i, table := range n.Tables {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			table.Restore(ctx)
+		}
+	case "tidb_hj", "tidb_smj", "tidb_inlj", "hash_join", "hash_join_build", "hash_join_probe", "merge_join", "inl_join", "broadcast_join", "shuffle_join", "inl_hash_join", "inl_merge_join":
 		for i, table := range n.Tables {
 			if i != 0 {
 				ctx.WritePlain(", ")
@@ -3650,6 +3857,75 @@ func (n *SetResourceGroupStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// CreateMaintenanceWindowStmt is a statement to create a maintenance window, the DDL jobs in the window
+// only run within its schedule.
+type CreateMaintenanceWindowStmt struct {
+	stmtNode
+
+	IfNotExists bool
+	WindowName  model.CIStr
+	// Schedule is the daily time range of the window, like '02:00-05:00 UTC'.
+	Schedule string
+	// MaxReorgRate is the max write rate of the reorganization in the window, like '200MB/s'.
+	MaxReorgRate string
+}
+
+// Restore implements Node interface.
+func (n *CreateMaintenanceWindowStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE MAINTENANCE WINDOW ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.WindowName.O)
+	ctx.WriteKeyWord(" SCHEDULE ")
+	ctx.WritePlain("= ")
+	ctx.WriteString(n.Schedule)
+	if n.MaxReorgRate != "" {
+		ctx.WriteKeyWord(" MAX_REORG_RATE ")
+		ctx.WritePlain("= ")
+		ctx.WriteString(n.MaxReorgRate)
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateMaintenanceWindowStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateMaintenanceWindowStmt)
+	return v.Leave(n)
+}
+
+// DropMaintenanceWindowStmt is a statement to drop a maintenance window.
+type DropMaintenanceWindowStmt struct {
+	stmtNode
+
+	IfExists   bool
+	WindowName model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *DropMaintenanceWindowStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP MAINTENANCE WINDOW ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.WindowName.O)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropMaintenanceWindowStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropMaintenanceWindowStmt)
+	return v.Leave(n)
+}
+
 // CalibrateResourceStmt is a statement to fetch the cluster RU capacity
 type CalibrateResourceStmt struct {
 	stmtNode
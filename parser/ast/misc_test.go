@@ -268,6 +268,9 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"LEADING(t1@sel1)", "LEADING(`t1`@`sel1`)"},
 		{"LEADING(t1@sel1, c1)", "LEADING(`t1`@`sel1`, `c1`)"},
 		{"LEADING(t1@sel1, c1, t2)", "LEADING(`t1`@`sel1`, `c1`, `t2`)"},
+		{"LEADING(t1, (t2, t3))", "LEADING(`t1`, (`t2`, `t3`))"},
+		{"LEADING((t1, t2), (t3, t4))", "LEADING((`t1`, `t2`), (`t3`, `t4`))"},
+		{"LEADING(@sel1 (t1, (t2, t3)), t4)", "LEADING(@`sel1` (`t1`, (`t2`, `t3`)), `t4`)"},
 		{"MAX_EXECUTION_TIME(3000)", "MAX_EXECUTION_TIME(3000)"},
 		{"MAX_EXECUTION_TIME(@sel1 3000)", "MAX_EXECUTION_TIME(@`sel1` 3000)"},
 		{"USE_INDEX_MERGE(t1 c1)", "USE_INDEX_MERGE(`t1` `c1`)"},
@@ -293,6 +296,10 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"AGG_TO_COP()", "AGG_TO_COP()"},
 		{"AGG_TO_COP(@sel_1)", "AGG_TO_COP(@`sel_1`)"},
 		{"LIMIT_TO_COP()", "LIMIT_TO_COP()"},
+		{"AGG_TO_ROOT()", "AGG_TO_ROOT()"},
+		{"AGG_TO_MPP(@sel1)", "AGG_TO_MPP(@`sel1`)"},
+		{"LIMIT_TO_ROOT()", "LIMIT_TO_ROOT()"},
+		{"LIMIT_TO_MPP(@sel1)", "LIMIT_TO_MPP(@`sel1`)"},
 		{"MERGE()", "MERGE()"},
 		{"STRAIGHT_JOIN()", "STRAIGHT_JOIN()"},
 		{"NO_INDEX_MERGE()", "NO_INDEX_MERGE()"},
@@ -42,6 +42,8 @@ type AnalyzeTableStmt struct {
 	// ColumnNames indicate the columns whose statistics need to be collected.
 	ColumnNames  []model.CIStr
 	ColumnChoice model.ColumnChoice
+	// Exprs indicate the expressions whose histograms need to be updated or dropped.
+	Exprs []ExprNode
 }
 
 // AnalyzeOptType is the type for analyze options.
@@ -132,6 +134,19 @@ func (n *AnalyzeTableStmt) Restore(ctx *format.RestoreCtx) error {
 				ctx.WriteName(columnName.O)
 			}
 		}
+		if len(n.Exprs) > 0 {
+			ctx.WriteKeyWord("ON ")
+			for i, expr := range n.Exprs {
+				if i != 0 {
+					ctx.WritePlain(",")
+				}
+				ctx.WritePlain("(")
+				if err := expr.Restore(ctx); err != nil {
+					return errors.Annotatef(err, "An error occurred while restore AnalyzeTableStmt.Exprs[%d]", i)
+				}
+				ctx.WritePlain(")")
+			}
+		}
 	}
 	switch n.ColumnChoice {
 	case model.AllColumns:
@@ -185,6 +200,13 @@ func (n *AnalyzeTableStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.TableNames[i] = node.(*TableName)
 	}
+	for i, val := range n.Exprs {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Exprs[i] = node.(ExprNode)
+	}
 	return v.Leave(n)
 }
 
@@ -59,7 +59,7 @@ func IsReadOnly(node Node) bool {
 		switch node.(*AdminStmt).Tp {
 		case AdminShowTelemetry, AdminShowDDL, AdminShowDDLJobs, AdminShowSlow,
 			AdminCaptureBindings, AdminShowNextRowID, AdminShowDDLJobQueries,
-			AdminShowDDLJobQueriesWithRange:
+			AdminShowDDLJobQueriesWithRange, AdminShowUnusedIndexes:
 			return true
 		default:
 			return false
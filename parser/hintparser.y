@@ -32,6 +32,8 @@ import (
 	hints []*ast.TableOptimizerHint
 	table 	ast.HintTable
 	modelIdents []model.CIStr
+	leadingList *ast.LeadingList
+	leadingItem interface{}
 }
 
 %token	<number>
@@ -83,6 +85,8 @@ import (
 
 	/* TiDB hint names */
 	hintAggToCop              "AGG_TO_COP"
+	hintAggToRoot             "AGG_TO_ROOT"
+	hintAggToMPP              "AGG_TO_MPP"
 	hintIgnorePlanCache       "IGNORE_PLAN_CACHE"
 	hintHashAgg               "HASH_AGG"
 	hintMpp1PhaseAgg          "MPP_1PHASE_AGG"
@@ -111,6 +115,8 @@ import (
 	hintUseCascades           "USE_CASCADES"
 	hintNthPlan               "NTH_PLAN"
 	hintLimitToCop            "LIMIT_TO_COP"
+	hintLimitToRoot           "LIMIT_TO_ROOT"
+	hintLimitToMPP            "LIMIT_TO_MPP"
 	hintForceIndex            "FORCE_INDEX"
 	hintStraightJoin          "STRAIGHT_JOIN"
 	hintLeading               "LEADING"
@@ -169,6 +175,7 @@ import (
 	SubqueryStrategiesOpt   "optional subquery strategies"
 	HintTrueOrFalse         "true or false in optimizer hint"
 	HintStorageTypeAndTable "storage type and tables in optimizer hint"
+	LeadingTableListOpt     "optional table list in leading hint"
 
 %type	<table>
 	HintTable "Table in optimizer hint"
@@ -178,6 +185,12 @@ import (
 	PartitionList    "partition name list in optimizer hint"
 	PartitionListOpt "optional partition name list in optimizer hint"
 
+%type	<leadingList>
+	LeadingList "table list in leading hint"
+
+%type	<leadingItem>
+	LeadingItem "table or nested table list in leading hint"
+
 
 %start	Start
 
@@ -235,6 +248,12 @@ TableOptimizerHintOpt:
 		h.HintName = model.NewCIStr($1)
 		$$ = h
 	}
+|	"LEADING" '(' LeadingTableListOpt ')'
+	{
+		h := $3
+		h.HintName = model.NewCIStr($1)
+		$$ = h
+	}
 |	UnsupportedIndexLevelOptimizerHintName '(' HintIndexList ')'
 	{
 		parser.warnUnsupportedHint($1)
@@ -442,6 +461,55 @@ HintTableList:
 		$$ = h
 	}
 
+/**
+ * LeadingTableListOpt:
+ *
+ *	[@query_block_name] [leading_item [, leading_item] ...]
+ *
+ * leading_item:
+ *	tbl_name | (leading_item [, leading_item] ...)
+ *
+ */
+LeadingTableListOpt:
+	QueryBlockOpt LeadingList
+	{
+		h := &ast.TableOptimizerHint{
+			Tables: $2.Tables(),
+			QBName: model.NewCIStr($1),
+		}
+		if $2.IsNested() {
+			h.HintData = $2
+		}
+		$$ = h
+	}
+|	QueryBlockOpt
+	{
+		$$ = &ast.TableOptimizerHint{
+			QBName: model.NewCIStr($1),
+		}
+	}
+
+LeadingList:
+	LeadingItem
+	{
+		$$ = &ast.LeadingList{Items: []interface{}{$1}}
+	}
+|	LeadingList ',' LeadingItem
+	{
+		$1.Items = append($1.Items, $3)
+		$$ = $1
+	}
+
+LeadingItem:
+	HintTable
+	{
+		$$ = $1
+	}
+|	'(' LeadingList ')'
+	{
+		$$ = $2
+	}
+
 HintTable:
 	Identifier QueryBlockOpt PartitionListOpt
 	{
@@ -594,7 +662,6 @@ SupportedTableLevelOptimizerHintName:
 |	"HASH_JOIN"
 |	"HASH_JOIN_BUILD"
 |	"HASH_JOIN_PROBE"
-|	"LEADING"
 
 UnsupportedIndexLevelOptimizerHintName:
 	"INDEX_MERGE"
@@ -635,7 +702,11 @@ NullaryHintName:
 |	"MPP_2PHASE_AGG"
 |	"STREAM_AGG"
 |	"AGG_TO_COP"
+|	"AGG_TO_ROOT"
+|	"AGG_TO_MPP"
 |	"LIMIT_TO_COP"
+|	"LIMIT_TO_ROOT"
+|	"LIMIT_TO_MPP"
 |	"NO_INDEX_MERGE"
 |	"READ_CONSISTENT_REPLICA"
 |	"IGNORE_PLAN_CACHE"
@@ -684,7 +755,11 @@ Identifier:
 |	"QB_NAME"
 /* TiDB hint names */
 |	"AGG_TO_COP"
+|	"AGG_TO_ROOT"
+|	"AGG_TO_MPP"
 |	"LIMIT_TO_COP"
+|	"LIMIT_TO_ROOT"
+|	"LIMIT_TO_MPP"
 |	"IGNORE_PLAN_CACHE"
 |	"HASH_AGG"
 |	"MPP_1PHASE_AGG"
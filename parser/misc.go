@@ -157,6 +157,7 @@ var tokenMap = map[string]int{
 	"AS":                       as,
 	"ASC":                      asc,
 	"ASCII":                    ascii,
+	"ATTACH":                   attach,
 	"ATTRIBUTE":                attribute,
 	"ATTRIBUTES":               attributes,
 	"BATCH":                    batch,
@@ -228,6 +229,7 @@ var tokenMap = map[string]int{
 	"COALESCE":                 coalesce,
 	"COLLATE":                  collate,
 	"COLLATION":                collation,
+	"COLUMNAR":                 columnar,
 	"COLUMN_FORMAT":            columnFormat,
 	"COLUMN_STATS_USAGE":       columnStatsUsage,
 	"COLUMN":                   column,
@@ -285,15 +287,18 @@ var tokenMap = map[string]int{
 	"DEC":                      decimalType,
 	"DECIMAL":                  decimalType,
 	"DEFAULT":                  defaultKwd,
+	"DEFERRED":                 deferred,
 	"DEFINED":                  defined,
 	"DEFINER":                  definer,
 	"DELAY_KEY_WRITE":          delayKeyWrite,
 	"DELAYED":                  delayed,
 	"DELETE":                   deleteKwd,
+	"DEPENDENCIES":             dependencies,
 	"DEPENDENCY":               dependency,
 	"DEPTH":                    depth,
 	"DESC":                     desc,
 	"DESCRIBE":                 describe,
+	"DETACH":                   detach,
 	"DIGEST":                   digest,
 	"DIRECTORY":                directory,
 	"DISABLE":                  disable,
@@ -383,6 +388,7 @@ var tokenMap = map[string]int{
 	"HOUR_MINUTE":              hourMinute,
 	"HOUR_SECOND":              hourSecond,
 	"HOUR":                     hour,
+	"HYPOTHETICAL":             hypothetical,
 	"IDENTIFIED":               identified,
 	"IF":                       ifKwd,
 	"IGNORE":                   ignore,
@@ -467,12 +473,14 @@ var tokenMap = map[string]int{
 	"LONGBLOB":                 longblobType,
 	"LONGTEXT":                 longtextType,
 	"LOW_PRIORITY":             lowPriority,
+	"MAINTENANCE":              maintenance,
 	"MASTER":                   master,
 	"MATCH":                    match,
 	"MAX_CONNECTIONS_PER_HOUR": maxConnectionsPerHour,
 	"MAX_IDXNUM":               max_idxnum,
 	"MAX_MINUTES":              max_minutes,
 	"MAX_QUERIES_PER_HOUR":     maxQueriesPerHour,
+	"MAX_REORG_RATE":           maxReorgRate,
 	"MAX_ROWS":                 maxRows,
 	"MAX_UPDATES_PER_HOUR":     maxUpdatesPerHour,
 	"MAX_USER_CONNECTIONS":     maxUserConnections,
@@ -579,8 +587,10 @@ var tokenMap = map[string]int{
 	"QUARTER":                  quarter,
 	"QUERIES":                  queries,
 	"QUERY":                    query,
+	"QUERY_REWRITE_RULES":      queryRewriteRules,
 	"QUICK":                    quick,
 	"RANGE":                    rangeKwd,
+	"RATE":                     rate,
 	"RATE_LIMIT":               rateLimit,
 	"READ":                     read,
 	"REAL":                     realType,
@@ -661,6 +671,8 @@ var tokenMap = map[string]int{
 	"SHUTDOWN":                 shutdown,
 	"SIGNED":                   signed,
 	"SIMPLE":                   simple,
+	"SIMULATOR":                simulator,
+	"SKETCH":                   sketch,
 	"SKIP":                     skip,
 	"SKIP_SCHEMA_FILES":        skipSchemaFiles,
 	"SLAVE":                    slave,
@@ -785,12 +797,14 @@ var tokenMap = map[string]int{
 	"UNBOUNDED":                unbounded,
 	"UNCOMMITTED":              uncommitted,
 	"UNDEFINED":                undefined,
+	"UNDROP":                   undrop,
 	"UNICODE":                  unicodeSym,
 	"UNION":                    union,
 	"UNIQUE":                   unique,
 	"UNKNOWN":                  unknown,
 	"UNLOCK":                   unlock,
 	"UNSIGNED":                 unsigned,
+	"UNUSED":                   unused,
 	"UPDATE":                   update,
 	"USAGE":                    usage,
 	"USE":                      use,
@@ -811,6 +825,7 @@ var tokenMap = map[string]int{
 	"VARIANCE":                 varPop,
 	"VARYING":                  varying,
 	"VERBOSE":                  verboseType,
+	"VERIFY":                   verify,
 	"VOTER":                    voter,
 	"VOTER_CONSTRAINTS":        voterConstraints,
 	"VOTERS":                   voters,
@@ -825,6 +840,7 @@ var tokenMap = map[string]int{
 	"WIDTH":                    width,
 	"WITH":                     with,
 	"WITHOUT":                  without,
+	"WORKLOAD":                 workload,
 	"WRITE":                    write,
 	"X509":                     x509,
 	"XOR":                      xor,
@@ -946,7 +962,11 @@ var hintTokenMap = map[string]int{
 
 	// TiDB hint names
 	"AGG_TO_COP":              hintAggToCop,
+	"AGG_TO_ROOT":             hintAggToRoot,
+	"AGG_TO_MPP":              hintAggToMPP,
 	"LIMIT_TO_COP":            hintLimitToCop,
+	"LIMIT_TO_ROOT":           hintLimitToRoot,
+	"LIMIT_TO_MPP":            hintLimitToMPP,
 	"IGNORE_PLAN_CACHE":       hintIgnorePlanCache,
 	"HASH_AGG":                hintHashAgg,
 	"MPP_1PHASE_AGG":          hintMpp1PhaseAgg,
@@ -102,6 +102,12 @@ const (
 	ActionCreateResourceGroup           ActionType = 68
 	ActionAlterResourceGroup            ActionType = 69
 	ActionDropResourceGroup             ActionType = 70
+	ActionCreateTableSelect             ActionType = 71
+	ActionAlterTablePartitioning        ActionType = 72
+	ActionRenameSchema                  ActionType = 73
+	ActionVerifyIndex                   ActionType = 74
+	ActionDeferredDropIndex             ActionType = 75
+	ActionUndropIndex                   ActionType = 76
 )
 
 var actionMap = map[ActionType]string{
@@ -170,6 +176,12 @@ var actionMap = map[ActionType]string{
 	ActionCreateResourceGroup:           "create resource group",
 	ActionAlterResourceGroup:            "alter resource group",
 	ActionDropResourceGroup:             "drop resource group",
+	ActionCreateTableSelect:             "create table select",
+	ActionAlterTablePartitioning:        "alter table partition by",
+	ActionRenameSchema:                  "rename schema",
+	ActionVerifyIndex:                   "verify index",
+	ActionDeferredDropIndex:             "deferred drop index",
+	ActionUndropIndex:                   "undrop index",
 
 	// `ActionAlterTableAlterPartition` is removed and will never be used.
 	// Just left a tombstone here for compatibility.
@@ -344,6 +356,7 @@ func (sub *SubJob) ToProxyJob(parentJob *Job) Job {
 		MultiSchemaInfo: &MultiSchemaInfo{Revertible: sub.Revertible},
 		Priority:        parentJob.Priority,
 		SeqNum:          parentJob.SeqNum,
+		Labels:          parentJob.Labels,
 	}
 }
 
@@ -370,6 +383,8 @@ type JobMeta struct {
 	Query string `json:"query"`
 	// Priority is only used to set the operation priority of adding indices.
 	Priority int `json:"priority"`
+	// Labels is the labels of the DDL job.
+	Labels string `json:"labels,omitempty"`
 }
 
 // Job is for a DDL operation.
@@ -423,6 +438,23 @@ type Job struct {
 
 	// SeqNum is the total order in all DDLs, it's used to identify the order of DDL.
 	SeqNum uint64 `json:"seq_num"`
+
+	// Labels is the labels of the job in the "key=value,key=value" format, which are attached to the request source
+	// of the job and its backfill.
+	Labels string `json:"labels,omitempty"`
+
+	// MaintenanceWindow is the maintenance window the job runs in, it's nil if the job runs at once.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty"`
+}
+
+// MaintenanceWindow is a daily time range in which the DDL jobs referencing it run.
+// The jobs wait for the window to open, and their reorganization pauses when the window closes.
+type MaintenanceWindow struct {
+	Name string `json:"name"`
+	// Schedule is the daily time range of the window, like '02:00-05:00 UTC'.
+	Schedule string `json:"schedule"`
+	// MaxReorgRate is the max bytes written by the reorganization per second, 0 means unlimited.
+	MaxReorgRate uint64 `json:"max_reorg_rate"`
 }
 
 // FinishTableJob is called when a job is finished.
@@ -758,9 +790,10 @@ func (job *Job) NotStarted() bool {
 // MayNeedReorg indicates that this job may need to reorganize the data.
 func (job *Job) MayNeedReorg() bool {
 	switch job.Type {
-	case ActionAddIndex, ActionAddPrimaryKey, ActionReorganizePartition:
+	case ActionAddIndex, ActionAddPrimaryKey, ActionReorganizePartition, ActionCreateTableSelect,
+		ActionAlterTablePartitioning, ActionVerifyIndex, ActionAddCheckConstraint:
 		return true
-	case ActionModifyColumn:
+	case ActionModifyColumn, ActionAddColumn, ActionSetTiFlashReplica:
 		if len(job.CtxVars) > 0 {
 			needReorg, ok := job.CtxVars[0].(bool)
 			return ok && needReorg
@@ -782,7 +815,7 @@ func (job *Job) MayNeedReorg() bool {
 // IsRollbackable checks whether the job can be rollback.
 func (job *Job) IsRollbackable() bool {
 	switch job.Type {
-	case ActionDropIndex, ActionDropPrimaryKey:
+	case ActionDropIndex, ActionDropPrimaryKey, ActionDeferredDropIndex:
 		// We can't cancel if index current state is in StateDeleteOnly or StateDeleteReorganization or StateWriteOnly, otherwise there will be an inconsistent issue between record and index.
 		// In WriteOnly state, we can rollback for normal index but can't rollback for expression index(need to drop hidden column). Since we can't
 		// know the type of index here, we consider all indices except primary index as non-rollbackable.
@@ -795,11 +828,18 @@ func (job *Job) IsRollbackable() bool {
 		}
 	case ActionAddTablePartition:
 		return job.SchemaState == StateNone || job.SchemaState == StateReplicaOnly
+	case ActionReorganizePartition, ActionAlterTablePartitioning:
+		// The new partitions have replaced the old ones in StateDeleteReorganization.
+		return job.SchemaState != StateDeleteReorganization
+	case ActionSetTiFlashReplica:
+		// The replica setting is public while the creation of the replicas is tracked, the tracking can be
+		// stopped by turning off tidb_ddl_enable_tiflash_replica_progress instead.
+		return job.SchemaState != StateWriteReorganization
 	case ActionDropColumn, ActionDropSchema, ActionDropTable, ActionDropSequence,
 		ActionDropForeignKey, ActionDropTablePartition:
 		return job.SchemaState == StatePublic
 	case ActionRebaseAutoID, ActionShardRowID,
-		ActionTruncateTable, ActionAddForeignKey, ActionRenameTable,
+		ActionTruncateTable, ActionAddForeignKey, ActionRenameTable, ActionRenameSchema,
 		ActionModifyTableCharsetAndCollate, ActionTruncateTablePartition,
 		ActionModifySchemaCharsetAndCollate, ActionRepairTable,
 		ActionModifyTableAutoIdCache, ActionModifySchemaDefaultPlacement:
@@ -44,13 +44,20 @@ func TestJobClone(t *testing.T) {
 	require.Equal(t, job.MultiSchemaInfo, clone.MultiSchemaInfo)
 }
 
+func TestProxyJobLabels(t *testing.T) {
+	job := &model.Job{ID: 100, Type: model.ActionMultiSchemaChange, Labels: "ticket=DB-1234"}
+	sub := &model.SubJob{Type: model.ActionAddIndex}
+	proxyJob := sub.ToProxyJob(job)
+	require.Equal(t, job.Labels, proxyJob.Labels)
+}
+
 func TestJobSize(t *testing.T) {
 	msg := `Please make sure that the following methods work as expected:
 - SubJob.FromProxyJob()
 - SubJob.ToProxyJob()
 `
 	job := model.Job{}
-	require.Equal(t, 288, int(unsafe.Sizeof(job)), msg)
+	require.Equal(t, 312, int(unsafe.Sizeof(job)), msg)
 }
 
 func TestBackfillMetaCodec(t *testing.T) {
@@ -102,3 +109,59 @@ func TestMayNeedReorg(t *testing.T) {
 		require.False(t, job.MayNeedReorg())
 	}
 }
+
+func TestIngestProgress(t *testing.T) {
+	job := &model.Job{
+		ID:   100,
+		Type: model.ActionAddIndex,
+		ReorgMeta: &model.DDLReorgMeta{
+			ReorgTp: model.ReorgTypeLitMerge,
+			Progress: &model.IngestProgress{
+				Phase:  model.ReorgPhaseImport,
+				Scan:   model.ReorgPhaseProgress{Rows: 100, Bytes: 4000},
+				Flush:  model.ReorgPhaseProgress{Rows: 100, Bytes: 4000},
+				Import: model.ReorgPhaseProgress{Rows: 60, Bytes: 2400},
+			},
+		},
+	}
+	require.Equal(t, "phase: import, scan: 100 rows 4000 bytes, flush: 100 rows 4000 bytes, "+
+		"import: 60 rows 2400 bytes, merge: 0 rows 0 bytes", job.ReorgMeta.Progress.String())
+
+	b, err := job.Encode(true)
+	require.NoError(t, err)
+	newJob := &model.Job{}
+	require.NoError(t, newJob.Decode(b))
+	require.Equal(t, job.ReorgMeta.Progress, newJob.ReorgMeta.Progress)
+
+	// The progress is omitted for the other reorganizations.
+	job.ReorgMeta.Progress = nil
+	b, err = job.Encode(true)
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "progress")
+}
+
+func TestReorgElementProgress(t *testing.T) {
+	job := &model.Job{
+		ID:   100,
+		Type: model.ActionReorganizePartition,
+		ReorgMeta: &model.DDLReorgMeta{
+			ReorgTp: model.ReorgTypeTxn,
+			ElementProgress: []*model.ReorgElementProgress{
+				{Name: "copy data", Rows: 100, Done: true},
+				{Name: "add index `idx`", Rows: 20},
+			},
+		},
+	}
+	b, err := job.Encode(true)
+	require.NoError(t, err)
+	newJob := &model.Job{}
+	require.NoError(t, newJob.Decode(b))
+	require.Equal(t, job.ReorgMeta.ElementProgress, newJob.ReorgMeta.ElementProgress)
+
+	for _, state := range []model.SchemaState{model.StateNone, model.StateDeleteOnly, model.StateWriteOnly, model.StateWriteReorganization} {
+		job.SchemaState = state
+		require.True(t, job.IsRollbackable())
+	}
+	job.SchemaState = model.StateDeleteReorganization
+	require.False(t, job.IsRollbackable())
+}
@@ -117,6 +117,9 @@ type ColumnInfo struct {
 	FieldType           types.FieldType     `json:"type"`
 	State               SchemaState         `json:"state"`
 	Comment             string              `json:"comment"`
+	// Compression is the algorithm to compress the values of the column in the rows, the column has the
+	// mysql.CompressedFlag if it's set.
+	Compression string `json:"compression,omitempty"`
 	// A hidden column is used internally(expression index) and are not accessible by users.
 	Hidden           bool `json:"hidden"`
 	*ChangeStateInfo `json:"change_state_info"`
@@ -670,6 +673,9 @@ type TiFlashReplicaInfo struct {
 	LocationLabels        []string
 	Available             bool
 	AvailablePartitionIDs []int64
+	// SyncJobID is the ID of the DDL job which tracks the creation of the replicas, the replica status of the table
+	// isn't polled separately until the job is done.
+	SyncJobID int64 `json:",omitempty"`
 }
 
 // IsPartitionAvailable checks whether the partition table replica was available.
@@ -723,6 +729,13 @@ func (t *TableInfo) Clone() *TableInfo {
 		nt.ForeignKeys[i] = t.ForeignKeys[i].Clone()
 	}
 
+	if len(t.Constraints) > 0 {
+		nt.Constraints = make([]*ConstraintInfo, len(t.Constraints))
+		for i := range t.Constraints {
+			nt.Constraints[i] = t.Constraints[i].Clone()
+		}
+	}
+
 	if t.Partition != nil {
 		nt.Partition = t.Partition.Clone()
 	}
@@ -1113,6 +1126,9 @@ type PartitionType int
 
 // Partition types.
 const (
+	// PartitionTypeNone is only used for the single partition which holds the whole non-partitioned table,
+	// while ALTER TABLE ... PARTITION BY is partitioning the table.
+	PartitionTypeNone       PartitionType = 0
 	PartitionTypeRange      PartitionType = 1
 	PartitionTypeHash       PartitionType = 2
 	PartitionTypeList       PartitionType = 3
@@ -1132,6 +1148,8 @@ func (p PartitionType) String() string {
 		return "KEY"
 	case PartitionTypeSystemTime:
 		return "SYSTEM_TIME"
+	case PartitionTypeNone:
+		return "NONE"
 	default:
 		return ""
 	}
@@ -1164,6 +1182,13 @@ type PartitionInfo struct {
 	Num                 uint64                `json:"num"`
 	// Only used during ReorganizePartition so far
 	DDLState SchemaState `json:"ddl_state"`
+	// DDLAction is the action which reorganizes the partitions, it is only set by ALTER TABLE ... PARTITION BY,
+	// which changes the partitioning of the table. The partitioning of the partitions reorganized with the
+	// Definitions is in DDLType, DDLExpr and DDLColumns then.
+	DDLAction  ActionType    `json:"ddl_action"`
+	DDLType    PartitionType `json:"ddl_type"`
+	DDLExpr    string        `json:"ddl_expr"`
+	DDLColumns []CIStr       `json:"ddl_columns"`
 }
 
 // Clone clones itself.
@@ -1171,6 +1196,8 @@ func (pi *PartitionInfo) Clone() *PartitionInfo {
 	newPi := *pi
 	newPi.Columns = make([]CIStr, len(pi.Columns))
 	copy(newPi.Columns, pi.Columns)
+	newPi.DDLColumns = make([]CIStr, len(pi.DDLColumns))
+	copy(newPi.DDLColumns, pi.DDLColumns)
 
 	newPi.Definitions = make([]PartitionDefinition, len(pi.Definitions))
 	for i := range pi.Definitions {
@@ -1361,6 +1388,10 @@ func (t IndexType) String() string {
 		return "HASH"
 	case IndexTypeRtree:
 		return "RTREE"
+	case IndexTypeFulltext:
+		return "FULLTEXT"
+	case IndexTypeColumnar:
+		return "COLUMNAR"
 	default:
 		return ""
 	}
@@ -1372,6 +1403,8 @@ const (
 	IndexTypeBtree
 	IndexTypeHash
 	IndexTypeRtree
+	IndexTypeFulltext
+	IndexTypeColumnar
 )
 
 // IndexInfo provides meta data describing a DB index.
@@ -1385,12 +1418,18 @@ type IndexInfo struct {
 	State         SchemaState    `json:"state"`
 	BackfillState BackfillState  `json:"backfill_state"`
 	Comment       string         `json:"comment"`      // Comment
-	Tp            IndexType      `json:"index_type"`   // Index type: Btree, Hash or Rtree
+	Tp            IndexType      `json:"index_type"`   // Index type: Btree, Hash, Rtree, Fulltext or Columnar
 	Unique        bool           `json:"is_unique"`    // Whether the index is unique.
 	Primary       bool           `json:"is_primary"`   // Whether the index is primary key.
 	Invisible     bool           `json:"is_invisible"` // Whether the index is invisible.
 	Global        bool           `json:"is_global"`    // Whether the index is global.
 	MVIndex       bool           `json:"mv_index"`     // Whether the index is multivalued index.
+	// DeferredDropTS is the TSO when the index is dropped by `DROP INDEX ... DEFERRED`. Such an index stays in the
+	// write-only state with its data maintained until it's restored by UNDROP INDEX or purged after the grace period.
+	DeferredDropTS uint64 `json:"deferred_drop_ts,omitempty"`
+	// ColumnarSnapshotTS is the TSO of the snapshot from which the TiFlash replicas build the columnar index. The
+	// index is kept updated by the replica pipeline after the snapshot, so it can't serve the reads before it.
+	ColumnarSnapshotTS uint64 `json:"columnar_snapshot_ts,omitempty"`
 }
 
 // Clone clones IndexInfo.
@@ -1406,6 +1445,11 @@ func (index *IndexInfo) Clone() *IndexInfo {
 	return &ni
 }
 
+// IsDeferredDropped returns whether the index is dropped by `DROP INDEX ... DEFERRED` and can be restored.
+func (index *IndexInfo) IsDeferredDropped() bool {
+	return index.DeferredDropTS != 0 && index.State == StateWriteOnly
+}
+
 // HasPrefixIndex returns whether any columns of this index uses prefix length.
 func (index *IndexInfo) HasPrefixIndex() bool {
 	for _, ic := range index.Columns {
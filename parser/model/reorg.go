@@ -15,6 +15,7 @@ package model
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/parser/mysql"
@@ -29,6 +30,109 @@ type DDLReorgMeta struct {
 	Location      *TimeZoneLocation                `json:"location"`
 	ReorgTp       ReorgType                        `json:"reorg_tp"`
 	IsDistReorg   bool                             `json:"is_dist_reorg"`
+	// Progress is the progress of the ingest phases, it's only used by the ingest reorganization.
+	Progress *IngestProgress `json:"progress,omitempty"`
+	// CharsetConvertPolicy is the policy of the invalid characters when converting the charset of a column,
+	// it's "ABORT" or "REPLACE".
+	CharsetConvertPolicy string `json:"charset_convert_policy,omitempty"`
+	// ElementProgress is the progress of each element, it's only used by the reorganize partition,
+	// whose elements are reorganized one by one, and the TiFlash replica tracked by SET TIFLASH REPLICA,
+	// whose elements are the partitions.
+	ElementProgress []*ReorgElementProgress `json:"element_progress,omitempty"`
+	// IngestEnvErrCount is the number of the ingest environment errors met by the job. The job falls back to
+	// the txn-merge backfill permanently when it reaches the threshold.
+	IngestEnvErrCount int `json:"ingest_env_err_count,omitempty"`
+	// Instant means the index is added by ALGORITHM=INSTANT, it becomes public without the backfill
+	// if the table is still empty when all the servers write the index.
+	Instant bool `json:"instant,omitempty"`
+	// PartitionByPartition means the modify column job reorganizes the column and the indexes of a partitioned
+	// table one partition at a time, a partition is finished before the next one is started.
+	PartitionByPartition bool `json:"partition_by_partition,omitempty"`
+	// WorkerLabel is the server label in the "key=value" format, the distributed backfill jobs are only run by the
+	// TiDB servers with the label if it's not empty.
+	WorkerLabel string `json:"worker_label,omitempty"`
+	// IndexVerify is the option and the result of the ADMIN VERIFY INDEX job, it's only used by the verify index job.
+	IndexVerify *IndexVerifyInfo `json:"index_verify,omitempty"`
+}
+
+// IndexVerifyInfo is the option and the result of verifying an index against the table data.
+type IndexVerifyInfo struct {
+	// Rate is the max bytes of the rows checked per second, 0 means unlimited.
+	Rate uint64 `json:"rate"`
+	// Repair means the missing index entries are written back when they are found.
+	Repair bool `json:"repair"`
+
+	CheckedRows      int64 `json:"checked_rows"`
+	InconsistentRows int64 `json:"inconsistent_rows"`
+	RepairedRows     int64 `json:"repaired_rows"`
+	// InconsistentHandles are the handles of the inconsistent rows, only the first ones are recorded.
+	InconsistentHandles []string `json:"inconsistent_handles,omitempty"`
+}
+
+// ReorgPhase is the phase of the ingest reorganization.
+type ReorgPhase byte
+
+const (
+	// ReorgPhaseNone means the ingest reorganization is not started yet.
+	ReorgPhaseNone ReorgPhase = iota
+	// ReorgPhaseScan is the phase that the index records are read from the table and written to the local engine.
+	ReorgPhaseScan
+	// ReorgPhaseFlush is the phase that the index records in memory are flushed to the local engine files.
+	ReorgPhaseFlush
+	// ReorgPhaseImport is the phase that the local engine files are imported to the storage.
+	ReorgPhaseImport
+	// ReorgPhaseMerge is the phase that the temporary index records are merged back to the origin index.
+	ReorgPhaseMerge
+)
+
+// String implements fmt.Stringer interface.
+func (p ReorgPhase) String() string {
+	switch p {
+	case ReorgPhaseScan:
+		return "scan"
+	case ReorgPhaseFlush:
+		return "flush"
+	case ReorgPhaseImport:
+		return "import"
+	case ReorgPhaseMerge:
+		return "merge"
+	}
+	return "none"
+}
+
+// ReorgPhaseProgress is the row and byte counters of a phase.
+type ReorgPhaseProgress struct {
+	Rows  int64 `json:"rows"`
+	Bytes int64 `json:"bytes"`
+}
+
+// IngestProgress is the progress of the ingest reorganization broken into phases.
+// The row count of the job only reflects the scan phase, while the import phase may dominate the whole process.
+type IngestProgress struct {
+	Phase  ReorgPhase         `json:"phase"`
+	Scan   ReorgPhaseProgress `json:"scan"`
+	Flush  ReorgPhaseProgress `json:"flush"`
+	Import ReorgPhaseProgress `json:"import"`
+	Merge  ReorgPhaseProgress `json:"merge"`
+}
+
+// String implements fmt.Stringer interface.
+func (p *IngestProgress) String() string {
+	return fmt.Sprintf("phase: %s, scan: %d rows %d bytes, flush: %d rows %d bytes, import: %d rows %d bytes, merge: %d rows %d bytes",
+		p.Phase, p.Scan.Rows, p.Scan.Bytes, p.Flush.Rows, p.Flush.Bytes, p.Import.Rows, p.Import.Bytes, p.Merge.Rows, p.Merge.Bytes)
+}
+
+// ReorgElementProgress is the progress of an element of the reorganization, the element is the rows
+// to be copied, an index to be built or the TiFlash replica of a partition.
+type ReorgElementProgress struct {
+	Name string `json:"name"`
+	Rows int64  `json:"rows"`
+	Done bool   `json:"done"`
+	// ID, Progress and Started are only used by the TiFlash replica or the columnar index of the partition with the
+	// ID, whose progress is a ratio instead of the rows, and only the started partitions are being replicated.
+	ID       int64   `json:"id,omitempty"`
+	Progress float64 `json:"progress,omitempty"`
+	Started  bool    `json:"started,omitempty"`
 }
 
 // ReorgType indicates which process is used for the data reorganization.
@@ -121,6 +225,7 @@ type BackfillMeta struct {
 	StartKey      []byte                           `json:"start_key"`
 	EndKey        []byte                           `json:"end_key"`
 	CurrKey       []byte                           `json:"curr_key"`
+	WorkerLabel   string                           `json:"worker_label,omitempty"`
 	*JobMeta      `json:"job_meta"`
 }
 
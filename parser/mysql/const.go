@@ -59,8 +59,24 @@ const (
 	ServerStatusMetadataChanged    uint16 = 0x0400
 	ServerStatusWasSlow            uint16 = 0x0800
 	ServerPSOutParams              uint16 = 0x1000
+	ServerSessionStateChanged      uint16 = 0x4000
 )
 
+// Session state tracker types.
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_basic_ok_packet.html
+const (
+	SessionTrackSystemVariables byte = iota
+	SessionTrackSchema
+	SessionTrackStateChange
+	SessionTrackGtids
+	SessionTrackTransactionCharacteristics
+	SessionTrackTransactionState
+)
+
+// SessionTrackResourceUsage is the TiDB specific session state tracker type which reports the resource usage of
+// the statement. The clients which don't know it can skip it by the length of its data.
+const SessionTrackResourceUsage byte = 0x80
+
 // HasCursorExistsFlag return true if cursor exists indicated by server status.
 func HasCursorExistsFlag(serverStatus uint16) bool {
 	return serverStatus&ServerStatusCursorExists > 0
@@ -158,7 +174,7 @@ const (
 	ClientConnectAtts                                   // CLIENT_CONNECT_ATTRS
 	ClientPluginAuthLenencClientData                    // CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA
 	ClientHandleExpiredPasswords                        // CLIENT_CAN_HANDLE_EXPIRED_PASSWORDS, Not supported: https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_basic_expired_passwords.html
-	ClientSessionTrack                                  // CLIENT_SESSION_TRACK, Not supported: https://github.com/pingcap/tidb/issues/35309
+	ClientSessionTrack                                  // CLIENT_SESSION_TRACK, Only advertised when enable-session-track is set, and only the resource usage of statements is tracked: https://github.com/pingcap/tidb/issues/35309
 	ClientDeprecateEOF                                  // CLIENT_DEPRECATE_EOF
 	// 1 << 25 == CLIENT_OPTIONAL_RESULTSET_METADATA
 	// 1 << 26 == CLIENT_ZSTD_COMPRESSION_ALGORITHM
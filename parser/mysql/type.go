@@ -75,6 +75,7 @@ const (
 	EnumSetAsIntFlag      uint = 1 << 21 /* Internal: Used for inferring enum eval type. */
 	DropColumnIndexFlag   uint = 1 << 22 /* Internal: Used for indicate the column is being dropped with index */
 	GeneratedColumnFlag   uint = 1 << 23 /* Internal: TiFlash will check this flag and add a placeholder for this column */
+	CompressedFlag        uint = 1 << 24 /* Internal: The values of the column are compressed in the rows */
 )
 
 // TypeInt24 bounds.
@@ -89,6 +90,11 @@ func HasDropColumnWithIndexFlag(flag uint) bool {
 	return (flag & DropColumnIndexFlag) > 0
 }
 
+// HasCompressedFlag checks if CompressedFlag is set.
+func HasCompressedFlag(flag uint) bool {
+	return (flag & CompressedFlag) > 0
+}
+
 // HasNotNullFlag checks if NotNullFlag is set.
 func HasNotNullFlag(flag uint) bool {
 	return (flag & NotNullFlag) > 0
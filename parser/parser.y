@@ -304,6 +304,7 @@ import (
 	always                "ALWAYS"
 	any                   "ANY"
 	ascii                 "ASCII"
+	attach                "ATTACH"
 	attribute             "ATTRIBUTE"
 	attributes            "ATTRIBUTES"
 	statsOptions          "STATS_OPTIONS"
@@ -346,6 +347,7 @@ import (
 	clientErrorsSummary   "CLIENT_ERRORS_SUMMARY"
 	coalesce              "COALESCE"
 	collation             "COLLATION"
+	columnar              "COLUMNAR"
 	columnFormat          "COLUMN_FORMAT"
 	columns               "COLUMNS"
 	config                "CONFIG"
@@ -377,8 +379,10 @@ import (
 	dateType              "DATE"
 	day                   "DAY"
 	deallocate            "DEALLOCATE"
+	deferred              "DEFERRED"
 	definer               "DEFINER"
 	delayKeyWrite         "DELAY_KEY_WRITE"
+	detach                "DETACH"
 	digest                "DIGEST"
 	directory             "DIRECTORY"
 	disable               "DISABLE"
@@ -630,8 +634,10 @@ import (
 	unbounded             "UNBOUNDED"
 	uncommitted           "UNCOMMITTED"
 	undefined             "UNDEFINED"
+	undrop                "UNDROP"
 	unicodeSym            "UNICODE"
 	unknown               "UNKNOWN"
+	unused                "UNUSED"
 	user                  "USER"
 	validation            "VALIDATION"
 	value                 "VALUE"
@@ -677,6 +683,7 @@ import (
 	followers             "FOLLOWERS"
 	getFormat             "GET_FORMAT"
 	groupConcat           "GROUP_CONCAT"
+	hypothetical          "HYPOTHETICAL"
 	next_row_id           "NEXT_ROW_ID"
 	inplace               "INPLACE"
 	instant               "INSTANT"
@@ -698,11 +705,13 @@ import (
 	position              "POSITION"
 	predicate             "PREDICATE"
 	primaryRegion         "PRIMARY_REGION"
+	queryRewriteRules     "QUERY_REWRITE_RULES"
 	recent                "RECENT"
 	replayer              "REPLAYER"
 	running               "RUNNING"
 	s3                    "S3"
 	schedule              "SCHEDULE"
+	simulator             "SIMULATOR"
 	staleness             "STALENESS"
 	std                   "STD"
 	stddev                "STDDEV"
@@ -739,6 +748,7 @@ import (
 	voter                 "VOTER"
 	voterConstraints      "VOTER_CONSTRAINTS"
 	voters                "VOTERS"
+	workload              "WORKLOAD"
 	ruRate                "RU_PER_SEC"
 	priority              "PRIORITY"
 	high                  "HIGH"
@@ -758,21 +768,26 @@ import (
 	columnStatsUsage           "COLUMN_STATS_USAGE"
 	correlation                "CORRELATION"
 	ddl                        "DDL"
+	dependencies               "DEPENDENCIES"
 	dependency                 "DEPENDENCY"
 	depth                      "DEPTH"
 	drainer                    "DRAINER"
 	dry                        "DRY"
 	jobs                       "JOBS"
 	job                        "JOB"
+	maintenance                "MAINTENANCE"
+	maxReorgRate               "MAX_REORG_RATE"
 	nodeID                     "NODE_ID"
 	nodeState                  "NODE_STATE"
 	optimistic                 "OPTIMISTIC"
+	rate                       "RATE"
 	pessimistic                "PESSIMISTIC"
 	pump                       "PUMP"
 	run                        "RUN"
 	samples                    "SAMPLES"
 	sampleRate                 "SAMPLERATE"
 	sessionStates              "SESSION_STATES"
+	sketch                     "SKETCH"
 	statistics                 "STATISTICS"
 	stats                      "STATS"
 	statsMeta                  "STATS_META"
@@ -789,6 +804,7 @@ import (
 	topn                       "TOPN"
 	split                      "SPLIT"
 	width                      "WIDTH"
+	verify                     "VERIFY"
 	reset                      "RESET"
 	regions                    "REGIONS"
 	region                     "REGION"
@@ -885,110 +901,114 @@ import (
 	ProcedureCall                   "Procedure call with Identifier or identifier"
 
 %type	<statement>
-	AdminStmt                  "Check table statement or show ddl statement"
-	AlterDatabaseStmt          "Alter database statement"
-	AlterTableStmt             "Alter table statement"
-	AlterUserStmt              "Alter user statement"
-	AlterInstanceStmt          "Alter instance statement"
-	AlterPolicyStmt            "Alter Placement Policy statement"
-	AlterResourceGroupStmt     "Alter Resource Group statement"
-	AlterSequenceStmt          "Alter sequence statement"
-	AnalyzeTableStmt           "Analyze table statement"
-	BeginTransactionStmt       "BEGIN TRANSACTION statement"
-	BinlogStmt                 "Binlog base64 statement"
-	BRIEStmt                   "BACKUP or RESTORE statement"
-	CalibrateResourceStmt      "CALIBRATE RESOURCE statement"
-	CommitStmt                 "COMMIT statement"
-	CreateTableStmt            "CREATE TABLE statement"
-	CreateViewStmt             "CREATE VIEW  statement"
-	CreateUserStmt             "CREATE User statement"
-	CreateRoleStmt             "CREATE Role statement"
-	CreateDatabaseStmt         "Create Database Statement"
-	CreateIndexStmt            "CREATE INDEX statement"
-	CreateBindingStmt          "CREATE BINDING  statement"
-	CreatePolicyStmt           "CREATE PLACEMENT POLICY statement"
-	CreateResourceGroupStmt    "CREATE RESOURCE GROUP statement"
-	CreateSequenceStmt         "CREATE SEQUENCE statement"
-	CreateStatisticsStmt       "CREATE STATISTICS statement"
-	DoStmt                     "Do statement"
-	DropDatabaseStmt           "DROP DATABASE statement"
-	DropIndexStmt              "DROP INDEX statement"
-	DropResourceGroupStmt      "DROP RESOURCE GROUP statement"
-	DropStatisticsStmt         "DROP STATISTICS statement"
-	DropStatsStmt              "DROP STATS statement"
-	DropTableStmt              "DROP TABLE statement"
-	DropSequenceStmt           "DROP SEQUENCE statement"
-	DropUserStmt               "DROP USER"
-	DropRoleStmt               "DROP ROLE"
-	DropViewStmt               "DROP VIEW statement"
-	DropBindingStmt            "DROP BINDING  statement"
-	DropPolicyStmt             "DROP PLACEMENT POLICY statement"
-	DeallocateStmt             "Deallocate prepared statement"
-	DeleteFromStmt             "DELETE FROM statement"
-	DeleteWithoutUsingStmt     "Normal DELETE statement"
-	DeleteWithUsingStmt        "DELETE USING statement"
-	EmptyStmt                  "empty statement"
-	ExecuteStmt                "Execute statement"
-	ExplainStmt                "EXPLAIN statement"
-	ExplainableStmt            "explainable statement"
-	FlushStmt                  "Flush statement"
-	FlashbackTableStmt         "Flashback table statement"
-	FlashbackToTimestampStmt   "Flashback cluster statement"
-	FlashbackDatabaseStmt      "Flashback Database statement"
-	GrantStmt                  "Grant statement"
-	GrantProxyStmt             "Grant proxy statement"
-	GrantRoleStmt              "Grant role statement"
-	InsertIntoStmt             "INSERT INTO statement"
-	CallStmt                   "CALL statement"
-	IndexAdviseStmt            "INDEX ADVISE statement"
-	KillStmt                   "Kill statement"
-	LoadDataStmt               "Load data statement"
-	LoadStatsStmt              "Load statistic statement"
-	LockStatsStmt              "Lock statistic statement"
-	UnlockStatsStmt            "Unlock statistic statement"
-	LockTablesStmt             "Lock tables statement"
-	NonTransactionalDMLStmt    "Non-transactional DML statement"
-	PlanReplayerStmt           "Plan replayer statement"
-	PreparedStmt               "PreparedStmt"
-	SelectStmt                 "SELECT statement"
-	SelectStmtWithClause       "common table expression SELECT statement"
-	RenameTableStmt            "rename table statement"
-	RenameUserStmt             "rename user statement"
-	ReplaceIntoStmt            "REPLACE INTO statement"
-	RecoverTableStmt           "recover table statement"
-	RevokeStmt                 "Revoke statement"
-	RevokeRoleStmt             "Revoke role statement"
-	RollbackStmt               "ROLLBACK statement"
-	ReleaseSavepointStmt       "RELEASE SAVEPOINT statement"
-	SavepointStmt              "SAVEPOINT statement"
-	SplitRegionStmt            "Split index region statement"
-	SetStmt                    "Set variable statement"
-	ChangeStmt                 "Change statement"
-	SetBindingStmt             "Set binding statement"
-	SetRoleStmt                "Set active role statement"
-	SetDefaultRoleStmt         "Set default statement for some user"
-	ShowStmt                   "Show engines/databases/tables/user/columns/warnings/status statement"
-	Statement                  "statement"
-	TraceStmt                  "TRACE statement"
-	TraceableStmt              "traceable statement"
-	TruncateTableStmt          "TRUNCATE TABLE statement"
-	UnlockTablesStmt           "Unlock tables statement"
-	UpdateStmt                 "UPDATE statement"
-	SetOprStmt                 "Union/Except/Intersect select statement"
-	SetOprStmtWithLimitOrderBy "Union/Except/Intersect select statement with limit and order by"
-	SetOprStmtWoutLimitOrderBy "Union/Except/Intersect select statement without limit and order by"
-	UseStmt                    "USE statement"
-	ShutdownStmt               "SHUTDOWN statement"
-	RestartStmt                "RESTART statement"
-	CreateViewSelectOpt        "Select/Union/Except/Intersect statement in CREATE VIEW ... AS SELECT"
-	BindableStmt               "Statement that can be created binding on"
-	UpdateStmtNoWith           "Update statement without CTE clause"
-	HelpStmt                   "HELP statement"
-	ShardableStmt              "Shardable statement that can be used in non-transactional DMLs"
-	PauseLoadDataStmt          "PAUSE LOAD DATA JOB statement"
-	ResumeLoadDataStmt         "RESUME LOAD DATA JOB statement"
-	CancelLoadDataStmt         "CANCEL LOAD DATA JOB statement"
-	DropLoadDataStmt           "DROP LOAD DATA JOB statement"
+	AdminStmt                   "Check table statement or show ddl statement"
+	AlterDatabaseStmt           "Alter database statement"
+	AlterTableStmt              "Alter table statement"
+	AlterUserStmt               "Alter user statement"
+	AlterInstanceStmt           "Alter instance statement"
+	AlterPolicyStmt             "Alter Placement Policy statement"
+	AlterResourceGroupStmt      "Alter Resource Group statement"
+	AlterSequenceStmt           "Alter sequence statement"
+	AnalyzeTableStmt            "Analyze table statement"
+	BeginTransactionStmt        "BEGIN TRANSACTION statement"
+	BinlogStmt                  "Binlog base64 statement"
+	BRIEStmt                    "BACKUP or RESTORE statement"
+	CalibrateResourceStmt       "CALIBRATE RESOURCE statement"
+	CommitStmt                  "COMMIT statement"
+	CreateTableStmt             "CREATE TABLE statement"
+	CreateViewStmt              "CREATE VIEW  statement"
+	CreateUserStmt              "CREATE User statement"
+	CreateRoleStmt              "CREATE Role statement"
+	CreateDatabaseStmt          "Create Database Statement"
+	CreateIndexStmt             "CREATE INDEX statement"
+	CreateMaintenanceWindowStmt "CREATE MAINTENANCE WINDOW statement"
+	CreateBindingStmt           "CREATE BINDING  statement"
+	CreatePolicyStmt            "CREATE PLACEMENT POLICY statement"
+	CreateResourceGroupStmt     "CREATE RESOURCE GROUP statement"
+	CreateSequenceStmt          "CREATE SEQUENCE statement"
+	CreateStatisticsStmt        "CREATE STATISTICS statement"
+	DoStmt                      "Do statement"
+	DropDatabaseStmt            "DROP DATABASE statement"
+	DropIndexStmt               "DROP INDEX statement"
+	DropMaintenanceWindowStmt   "DROP MAINTENANCE WINDOW statement"
+	DropResourceGroupStmt       "DROP RESOURCE GROUP statement"
+	DropStatisticsStmt          "DROP STATISTICS statement"
+	DropStatsStmt               "DROP STATS statement"
+	DropTableStmt               "DROP TABLE statement"
+	DropSequenceStmt            "DROP SEQUENCE statement"
+	DropUserStmt                "DROP USER"
+	DropRoleStmt                "DROP ROLE"
+	DropViewStmt                "DROP VIEW statement"
+	DropBindingStmt             "DROP BINDING  statement"
+	DropPolicyStmt              "DROP PLACEMENT POLICY statement"
+	DeallocateStmt              "Deallocate prepared statement"
+	DeleteFromStmt              "DELETE FROM statement"
+	DeleteWithoutUsingStmt      "Normal DELETE statement"
+	DeleteWithUsingStmt         "DELETE USING statement"
+	EmptyStmt                   "empty statement"
+	ExecuteStmt                 "Execute statement"
+	ExplainStmt                 "EXPLAIN statement"
+	ExplainableStmt             "explainable statement"
+	FlushStmt                   "Flush statement"
+	FlashbackTableStmt          "Flashback table statement"
+	FlashbackToTimestampStmt    "Flashback cluster statement"
+	FlashbackDatabaseStmt       "Flashback Database statement"
+	GrantStmt                   "Grant statement"
+	GrantProxyStmt              "Grant proxy statement"
+	GrantRoleStmt               "Grant role statement"
+	InsertIntoStmt              "INSERT INTO statement"
+	CallStmt                    "CALL statement"
+	IndexAdviseStmt             "INDEX ADVISE statement"
+	KillStmt                    "Kill statement"
+	LoadDataStmt                "Load data statement"
+	LoadStatsStmt               "Load statistic statement"
+	LockStatsStmt               "Lock statistic statement"
+	UnlockStatsStmt             "Unlock statistic statement"
+	LockTablesStmt              "Lock tables statement"
+	NonTransactionalDMLStmt     "Non-transactional DML statement"
+	PlanReplayerStmt            "Plan replayer statement"
+	PreparedStmt                "PreparedStmt"
+	SelectStmt                  "SELECT statement"
+	SelectStmtWithClause        "common table expression SELECT statement"
+	RenameDatabaseStmt          "rename database statement"
+	RenameTableStmt             "rename table statement"
+	RenameUserStmt              "rename user statement"
+	ReplaceIntoStmt             "REPLACE INTO statement"
+	RecoverTableStmt            "recover table statement"
+	UndropIndexStmt             "UNDROP INDEX statement"
+	RevokeStmt                  "Revoke statement"
+	RevokeRoleStmt              "Revoke role statement"
+	RollbackStmt                "ROLLBACK statement"
+	ReleaseSavepointStmt        "RELEASE SAVEPOINT statement"
+	SavepointStmt               "SAVEPOINT statement"
+	SplitRegionStmt             "Split index region statement"
+	SetStmt                     "Set variable statement"
+	ChangeStmt                  "Change statement"
+	SetBindingStmt              "Set binding statement"
+	SetRoleStmt                 "Set active role statement"
+	SetDefaultRoleStmt          "Set default statement for some user"
+	ShowStmt                    "Show engines/databases/tables/user/columns/warnings/status statement"
+	Statement                   "statement"
+	TraceStmt                   "TRACE statement"
+	TraceableStmt               "traceable statement"
+	TruncateTableStmt           "TRUNCATE TABLE statement"
+	UnlockTablesStmt            "Unlock tables statement"
+	UpdateStmt                  "UPDATE statement"
+	SetOprStmt                  "Union/Except/Intersect select statement"
+	SetOprStmtWithLimitOrderBy  "Union/Except/Intersect select statement with limit and order by"
+	SetOprStmtWoutLimitOrderBy  "Union/Except/Intersect select statement without limit and order by"
+	UseStmt                     "USE statement"
+	ShutdownStmt                "SHUTDOWN statement"
+	RestartStmt                 "RESTART statement"
+	CreateViewSelectOpt         "Select/Union/Except/Intersect statement in CREATE VIEW ... AS SELECT"
+	BindableStmt                "Statement that can be created binding on"
+	UpdateStmtNoWith            "Update statement without CTE clause"
+	HelpStmt                    "HELP statement"
+	ShardableStmt               "Shardable statement that can be used in non-transactional DMLs"
+	PauseLoadDataStmt           "PAUSE LOAD DATA JOB statement"
+	ResumeLoadDataStmt          "RESUME LOAD DATA JOB statement"
+	CancelLoadDataStmt          "CANCEL LOAD DATA JOB statement"
+	DropLoadDataStmt            "DROP LOAD DATA JOB statement"
 
 %type	<item>
 	AdminShowSlow                          "Admin Show Slow statement"
@@ -1050,12 +1070,14 @@ import (
 	DistinctOpt                            "Explicit distinct option"
 	DefaultFalseDistinctOpt                "Distinct option which defaults to false"
 	DefaultTrueDistinctOpt                 "Distinct option which defaults to true"
+	DeferredOpt                            "DEFERRED or empty"
 	BuggyDefaultFalseDistinctOpt           "Distinct option which accepts DISTINCT ALL and defaults to false"
 	RequireClause                          "Encrypted connections options"
 	RequireClauseOpt                       "optional Encrypted connections options"
 	EqOpt                                  "= or empty"
 	EscapedTableRef                        "escaped table reference"
 	ExpressionList                         "expression list"
+	ExpressionHistogramList                "expression list of histograms"
 	ExtendedPriv                           "Extended privileges like LOAD FROM S3 or dynamic privileges"
 	MaxValueOrExpressionList               "maxvalue or expression list"
 	ExpressionListOpt                      "expression list opt"
@@ -1084,6 +1106,8 @@ import (
 	AsOfClauseOpt                          "AS OF clause optional"
 	HandleRange                            "handle range"
 	HandleRangeList                        "handle range list"
+	HypoIndex                              "hypothetical index"
+	HypoIndexList                          "hypothetical index list"
 	IfExists                               "If Exists"
 	IfNotExists                            "If Not Exists"
 	IgnoreOptional                         "IGNORE or empty"
@@ -1183,6 +1207,7 @@ import (
 	RequireList                            "require list for tls options"
 	RequireListElement                     "require list element for tls option"
 	ResourceGroupNameOption                "resource group name for user"
+	RestrictOrCascadeOpt                   "optional RESTRICT or CASCADE"
 	Rolename                               "Rolename"
 	RolenameComposed                       "Rolename that composed with more than 1 symbol"
 	RolenameList                           "RolenameList"
@@ -1282,6 +1307,7 @@ import (
 	ValuesStmtList                         "VALUES statement field list"
 	VariableAssignment                     "set variable value"
 	VariableAssignmentList                 "set variable value list"
+	VerifyIndexRepairOpt                   "Optional REPAIR of ADMIN VERIFY INDEX"
 	ViewAlgorithm                          "view algorithm"
 	ViewCheckOption                        "view check option"
 	ViewDefiner                            "view definer"
@@ -1299,6 +1325,8 @@ import (
 	WithGrantOptionOpt                     "With Grant Option opt"
 	WithValidation                         "with validation"
 	WithValidationOpt                      "optional with validation"
+	WorkloadConcurrencyOpt                 "Optional CONCURRENCY of ADMIN WORKLOAD SIMULATOR"
+	WorkloadRateOpt                        "Optional RATE of ADMIN WORKLOAD SIMULATOR"
 	Writeable                              "Table writeable status"
 	ElseOpt                                "Optional else clause"
 	Type                                   "Types"
@@ -1397,6 +1425,7 @@ import (
 	CurdateSym        "CURDATE or CURRENT_DATE"
 	DefaultKwdOpt     "optional DEFAULT keyword"
 	DatabaseSym       "DATABASE or SCHEMA"
+	WindowSym         "WINDOW, which is an identifier when the window functions are disabled"
 	ExplainSym        "EXPLAIN or DESCRIBE or DESC"
 	RegexpSym         "REGEXP or RLIKE"
 	IntoOpt           "INTO or EmptyString"
@@ -1453,6 +1482,8 @@ import (
 	FieldAsNameOpt                  "Field alias name opt"
 	FieldTerminator                 "Field terminator"
 	FlashbackToNewName              "Flashback to new name"
+	MaintenanceWindowOpt            "Optional maintenance window of DDL"
+	MaxReorgRateOpt                 "Optional max reorg rate of maintenance window"
 	HashString                      "Hashed string"
 	LikeOrIlikeEscapeOpt            "like or ilike escape option"
 	OptCharset                      "Optional Character setting"
@@ -1463,6 +1494,7 @@ import (
 	StringName                      "string literal or identifier"
 	StringNameOrBRIEOptionKeyword   "string literal or identifier or keyword used for BRIE options"
 	Symbol                          "Constraint Symbol"
+	VerifyIndexRateOpt              "Optional rate limit of ADMIN VERIFY INDEX"
 
 %precedence empty
 %precedence as
@@ -1544,15 +1576,16 @@ Start:
  * See https://dev.mysql.com/doc/refman/5.7/en/alter-table.html
  *******************************************************************************************/
 AlterTableStmt:
-	"ALTER" IgnoreOptional "TABLE" TableName AlterTableSpecListOpt AlterTableSpecSingleOpt
+	"ALTER" IgnoreOptional "TABLE" TableName AlterTableSpecListOpt AlterTableSpecSingleOpt MaintenanceWindowOpt
 	{
 		specs := $5.([]*ast.AlterTableSpec)
 		if $6 != nil {
 			specs = append(specs, $6.(*ast.AlterTableSpec))
 		}
 		$$ = &ast.AlterTableStmt{
-			Table: $4.(*ast.TableName),
-			Specs: specs,
+			Table:             $4.(*ast.TableName),
+			Specs:             specs,
+			MaintenanceWindow: model.NewCIStr($7),
 		}
 	}
 |	"ALTER" IgnoreOptional "TABLE" TableName "ANALYZE" "PARTITION" PartitionNameList AnalyzeOptionListOpt
@@ -1809,6 +1842,22 @@ AlterTableSpecSingleOpt:
 			Partition: &ast.PartitionOptions{PartitionMethod: partitionMethod},
 		}
 	}
+|	"SPLIT" "PARTITION" Identifier "INTO" '(' PartitionDefinitionList ')'
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:              ast.AlterTableSplitPartition,
+			PartitionNames:  []model.CIStr{model.NewCIStr($3)},
+			PartDefinitions: $6.([]*ast.PartitionDefinition),
+		}
+	}
+|	"MERGE" "PARTITIONS" PartitionNameList "INTO" "PARTITION" Identifier
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:             ast.AlterTableMergePartitions,
+			PartitionNames: $3.([]model.CIStr),
+			Name:           $6,
+		}
+	}
 |	"PARTITION" Identifier AttributesOpt
 	{
 		$$ = &ast.AlterTableSpec{
@@ -2032,6 +2081,7 @@ AlterTableSpec:
 			IfExists:      $3.(bool),
 			Tp:            ast.AlterTableDropColumn,
 			OldColumnName: $4.(*ast.ColumnName),
+			Cascade:       $5.(bool),
 		}
 	}
 |	"DROP" "PRIMARY" "KEY"
@@ -2080,6 +2130,23 @@ AlterTableSpec:
 			WithValidation: $7.(bool),
 		}
 	}
+|	"DETACH" "PARTITION" Identifier "INTO" "TABLE" TableName
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:             ast.AlterTableDetachPartition,
+			PartitionNames: []model.CIStr{model.NewCIStr($3)},
+			NewTable:       $6.(*ast.TableName),
+		}
+	}
+|	"ATTACH" PartitionDefinition "FROM" "TABLE" TableName WithValidationOpt
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:              ast.AlterTableAttachPartition,
+			PartDefinitions: []*ast.PartitionDefinition{$2.(*ast.PartitionDefinition)},
+			NewTable:        $5.(*ast.TableName),
+			WithValidation:  $6.(bool),
+		}
+	}
 |	"TRUNCATE" "PARTITION" AllOrPartitionNameList
 	{
 		ret := &ast.AlterTableSpec{
@@ -2177,12 +2244,13 @@ AlterTableSpec:
 		}
 		$$ = ret
 	}
-|	"DROP" KeyOrIndex IfExists Identifier
+|	"DROP" KeyOrIndex IfExists Identifier DeferredOpt
 	{
 		$$ = &ast.AlterTableSpec{
 			IfExists: $3.(bool),
 			Tp:       ast.AlterTableDropIndex,
 			Name:     $4,
+			Deferred: $5.(bool),
 		}
 	}
 |	"DROP" "FOREIGN" "KEY" IfExists Symbol
@@ -2590,6 +2658,18 @@ ConstraintKeywordOpt:
 Symbol:
 	Identifier
 
+/**************************************RenameDatabaseStmt************************************
+ * RENAME {DATABASE | SCHEMA} db_name TO new_db_name
+ *******************************************************************************************/
+RenameDatabaseStmt:
+	"RENAME" DatabaseSym DBName "TO" DBName
+	{
+		$$ = &ast.RenameDatabaseStmt{
+			OldName: model.NewCIStr($3),
+			NewName: model.NewCIStr($5),
+		}
+	}
+
 /**************************************RenameTableStmt***************************************
  * See http://dev.mysql.com/doc/refman/5.7/en/rename-table.html
  *
@@ -2884,6 +2964,23 @@ AnalyzeTableStmt:
 			HistogramOperation: ast.HistogramOperationDrop,
 		}
 	}
+|	"ANALYZE" "TABLE" TableName "UPDATE" "HISTOGRAM" "ON" ExpressionHistogramList AnalyzeOptionListOpt
+	{
+		$$ = &ast.AnalyzeTableStmt{
+			TableNames:         []*ast.TableName{$3.(*ast.TableName)},
+			Exprs:              $7.([]ast.ExprNode),
+			AnalyzeOpts:        $8.([]ast.AnalyzeOpt),
+			HistogramOperation: ast.HistogramOperationUpdate,
+		}
+	}
+|	"ANALYZE" "TABLE" TableName "DROP" "HISTOGRAM" "ON" ExpressionHistogramList
+	{
+		$$ = &ast.AnalyzeTableStmt{
+			TableNames:         []*ast.TableName{$3.(*ast.TableName)},
+			Exprs:              $7.([]ast.ExprNode),
+			HistogramOperation: ast.HistogramOperationDrop,
+		}
+	}
 |	"ANALYZE" "TABLE" TableName "COLUMNS" IdentList AnalyzeOptionListOpt
 	{
 		$$ = &ast.AnalyzeTableStmt{
@@ -3126,6 +3223,16 @@ IdentList:
 		$$ = append($1.([]model.CIStr), model.NewCIStr($3))
 	}
 
+ExpressionHistogramList:
+	'(' Expression ')'
+	{
+		$$ = []ast.ExprNode{$2}
+	}
+|	ExpressionHistogramList ',' '(' Expression ')'
+	{
+		$$ = append($1.([]ast.ExprNode), $4)
+	}
+
 ColumnNameOrUserVarListOpt:
 	/* EMPTY */
 	{
@@ -3334,6 +3441,10 @@ ColumnOption:
 	{
 		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionAutoRandom, AutoRandOpt: $2.(ast.AutoRandomOption)}
 	}
+|	"COMPRESSION" EqOpt stringLit
+	{
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionCompression, StrValue: $3}
+	}
 
 AutoRandomOpt:
 	{
@@ -3735,6 +3846,10 @@ StatsType:
 	{
 		$$ = ast.StatsTypeCorrelation
 	}
+|	"SKETCH"
+	{
+		$$ = ast.StatsTypeSketch
+	}
 
 BindingStatusType:
 	"ENABLED"
@@ -3794,7 +3909,7 @@ DropStatisticsStmt:
  *     LOCK [=] {DEFAULT | NONE | SHARED | EXCLUSIVE}
  *******************************************************************************************/
 CreateIndexStmt:
-	"CREATE" IndexKeyTypeOpt "INDEX" IfNotExists Identifier IndexTypeOpt "ON" TableName '(' IndexPartSpecificationList ')' IndexOptionList IndexLockAndAlgorithmOpt
+	"CREATE" IndexKeyTypeOpt "INDEX" IfNotExists Identifier IndexTypeOpt "ON" TableName '(' IndexPartSpecificationList ')' IndexOptionList IndexLockAndAlgorithmOpt MaintenanceWindowOpt
 	{
 		var indexOption *ast.IndexOption
 		if $12 != nil {
@@ -3825,6 +3940,26 @@ CreateIndexStmt:
 			IndexOption:             indexOption,
 			KeyType:                 $2.(ast.IndexKeyType),
 			LockAlg:                 indexLockAndAlgorithm,
+			MaintenanceWindow:       model.NewCIStr($14),
+		}
+	}
+
+MaintenanceWindowOpt:
+	{
+		$$ = ""
+	}
+|	"IN" WindowSym Identifier
+	{
+		$$ = $3
+	}
+
+WindowSym:
+	"WINDOW"
+|	identifier
+	{
+		if !strings.EqualFold($1, "window") {
+			yylex.AppendError(ErrSyntax)
+			return 1
 		}
 	}
 
@@ -4818,7 +4953,7 @@ DropDatabaseStmt:
  *      LOCK [=] {DEFAULT|NONE|SHARED|EXCLUSIVE}
  ******************************************************************/
 DropIndexStmt:
-	"DROP" "INDEX" IfExists Identifier "ON" TableName IndexLockAndAlgorithmOpt
+	"DROP" "INDEX" IfExists Identifier "ON" TableName IndexLockAndAlgorithmOpt DeferredOpt
 	{
 		var indexLockAndAlgorithm *ast.IndexLockAndAlgorithm
 		if $7 != nil {
@@ -4827,7 +4962,27 @@ DropIndexStmt:
 				indexLockAndAlgorithm = nil
 			}
 		}
-		$$ = &ast.DropIndexStmt{IfExists: $3.(bool), IndexName: $4, Table: $6.(*ast.TableName), LockAlg: indexLockAndAlgorithm}
+		$$ = &ast.DropIndexStmt{IfExists: $3.(bool), IndexName: $4, Table: $6.(*ast.TableName), LockAlg: indexLockAndAlgorithm, Deferred: $8.(bool)}
+	}
+
+DeferredOpt:
+	{
+		$$ = false
+	}
+|	"DEFERRED"
+	{
+		$$ = true
+	}
+
+/******************************************************************
+ * Undrop Index Statement
+ *  UNDROP INDEX index_name ON tbl_name
+ *  Restore the index dropped by DROP INDEX ... DEFERRED.
+ ******************************************************************/
+UndropIndexStmt:
+	"UNDROP" "INDEX" Identifier "ON" TableName
+	{
+		$$ = &ast.UndropIndexStmt{IndexName: $3, Table: $5.(*ast.TableName)}
 	}
 
 DropTableStmt:
@@ -4915,9 +5070,17 @@ DropStatsStmt:
 	}
 
 RestrictOrCascadeOpt:
-	{}
+	{
+		$$ = false
+	}
 |	"RESTRICT"
+	{
+		$$ = false
+	}
 |	"CASCADE"
+	{
+		$$ = true
+	}
 
 TableOrTables:
 	"TABLE"
@@ -5041,6 +5204,30 @@ ExplainStmt:
 			Format: $4,
 		}
 	}
+|	ExplainSym "WITH" "HYPOTHETICAL" "INDEX" HypoIndexList ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:        $6,
+			Format:      "row",
+			HypoIndexes: $5.([]*ast.HypotheticalIndex),
+		}
+	}
+|	ExplainSym "FORMAT" "=" stringLit "WITH" "HYPOTHETICAL" "INDEX" HypoIndexList ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:        $9,
+			Format:      $4,
+			HypoIndexes: $8.([]*ast.HypotheticalIndex),
+		}
+	}
+|	ExplainSym "FORMAT" "=" ExplainFormatType "WITH" "HYPOTHETICAL" "INDEX" HypoIndexList ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:        $9,
+			Format:      $4,
+			HypoIndexes: $8.([]*ast.HypotheticalIndex),
+		}
+	}
 |	ExplainSym "ANALYZE" ExplainableStmt
 	{
 		$$ = &ast.ExplainStmt{
@@ -5066,6 +5253,25 @@ ExplainStmt:
 		}
 	}
 
+HypoIndexList:
+	HypoIndex
+	{
+		$$ = []*ast.HypotheticalIndex{$1.(*ast.HypotheticalIndex)}
+	}
+|	HypoIndexList ',' HypoIndex
+	{
+		$$ = append($1.([]*ast.HypotheticalIndex), $3.(*ast.HypotheticalIndex))
+	}
+
+HypoIndex:
+	TableName '(' IndexPartSpecificationList ')'
+	{
+		$$ = &ast.HypotheticalIndex{
+			Table:                   $1.(*ast.TableName),
+			IndexPartSpecifications: $3.([]*ast.IndexPartSpecification),
+		}
+	}
+
 ExplainFormatType:
 	"TRADITIONAL"
 |	"JSON"
@@ -6058,6 +6264,10 @@ IndexTypeName:
 	{
 		$$ = model.IndexTypeRtree
 	}
+|	"COLUMNAR"
+	{
+		$$ = model.IndexTypeColumnar
+	}
 
 IndexInvisible:
 	"VISIBLE"
@@ -6080,6 +6290,7 @@ UnReservedKeyword:
 	"ACTION"
 |	"ADVISE"
 |	"ASCII"
+|	"ATTACH"
 |	"ATTRIBUTE"
 |	"ATTRIBUTES"
 |	"BINDING_CACHE"
@@ -6187,6 +6398,7 @@ UnReservedKeyword:
 |	"TRUNCATE"
 |	"UNBOUNDED"
 |	"UNKNOWN"
+|	"UNUSED"
 |	"VALUE" %prec lowerThanValueKeyword
 |	"WARNINGS"
 |	"YEAR"
@@ -6281,6 +6493,7 @@ UnReservedKeyword:
 |	"EVENT"
 |	"ALGORITHM"
 |	"DEFINER"
+|	"DETACH"
 |	"INVOKER"
 |	"MERGE"
 |	"TEMPTABLE"
@@ -6329,6 +6542,7 @@ UnReservedKeyword:
 |	"VALIDATION"
 |	"WITHOUT"
 |	"RTREE"
+|	"COLUMNAR"
 |	"EXCHANGE"
 |	"COLUMN_FORMAT"
 |	"REPAIR"
@@ -6429,6 +6643,8 @@ UnReservedKeyword:
 |	"DIGEST"
 |	"REUSE" %prec lowerThanEq
 |	"CALIBRATE"
+|	"DEFERRED"
+|	"UNDROP"
 
 TiDBKeyword:
 	"ADMIN"
@@ -6441,17 +6657,22 @@ TiDBKeyword:
 |	"COLUMN_STATS_USAGE"
 |	"CORRELATION"
 |	"DDL"
+|	"DEPENDENCIES"
 |	"DEPENDENCY"
 |	"DEPTH"
 |	"DRAINER"
 |	"JOBS"
 |	"JOB"
+|	"MAINTENANCE"
+|	"MAX_REORG_RATE"
 |	"NODE_ID"
 |	"NODE_STATE"
 |	"PUMP"
+|	"RATE"
 |	"SAMPLES"
 |	"SAMPLERATE"
 |	"SESSION_STATES"
+|	"SKETCH"
 |	"STATISTICS"
 |	"STATS"
 |	"STATS_META"
@@ -6470,6 +6691,7 @@ TiDBKeyword:
 |	"OPTIMISTIC"
 |	"PESSIMISTIC"
 |	"WIDTH"
+|	"VERIFY"
 |	"REGIONS"
 |	"REGION"
 |	"RESET"
@@ -6496,6 +6718,7 @@ NotKeywordToken:
 |	"EXTRACT"
 |	"GET_FORMAT"
 |	"GROUP_CONCAT"
+|	"HYPOTHETICAL"
 |	"INPLACE"
 |	"INSTANT"
 |	"INTERNAL"
@@ -6540,6 +6763,7 @@ NotKeywordToken:
 |	"NEXT_ROW_ID"
 |	"EXPR_PUSHDOWN_BLACKLIST"
 |	"OPT_RULE_BLACKLIST"
+|	"QUERY_REWRITE_RULES"
 |	"BOUND"
 |	"EXACT" %prec lowerThanStringLitToken
 |	"STALENESS"
@@ -6557,9 +6781,11 @@ NotKeywordToken:
 |	"TRUE_CARD_COST"
 |	"VOTER"
 |	"VOTERS"
+|	"WORKLOAD"
 |	"CONSTRAINTS"
 |	"PRIMARY_REGION"
 |	"SCHEDULE"
+|	"SIMULATOR"
 |	"SURVIVAL_PREFERENCES"
 |	"LEADER_CONSTRAINTS"
 |	"FOLLOWER_CONSTRAINTS"
@@ -9137,6 +9363,11 @@ TableFactor:
 		resultNode := $1.(*ast.SubqueryExpr).Query
 		$$ = &ast.TableSource{Source: resultNode, AsName: $2.(model.CIStr)}
 	}
+|	Identifier '(' ExpressionListOpt ')' TableAsNameOpt
+	{
+		tf := &ast.TableFunction{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+		$$ = &ast.TableSource{Source: tf, AsName: $5.(model.CIStr)}
+	}
 |	'(' TableRefs ')'
 	{
 		j := $2.(*ast.Join)
@@ -10404,11 +10635,51 @@ AdminStmtLimitOpt:
 		$$ = &ast.LimitSimple{Offset: $4.(uint64), Count: $2.(uint64)}
 	}
 
+VerifyIndexRateOpt:
+	{
+		$$ = ""
+	}
+|	"RATE" EqOpt stringLit
+	{
+		$$ = $3
+	}
+
+VerifyIndexRepairOpt:
+	{
+		$$ = false
+	}
+|	"REPAIR"
+	{
+		$$ = true
+	}
+
+WorkloadConcurrencyOpt:
+	{
+		$$ = uint64(0)
+	}
+|	"CONCURRENCY" EqOpt LengthNum
+	{
+		$$ = $3
+	}
+
+WorkloadRateOpt:
+	{
+		$$ = uint64(0)
+	}
+|	"RATE" EqOpt LengthNum
+	{
+		$$ = $3
+	}
+
 AdminStmt:
 	"ADMIN" "SHOW" "DDL"
 	{
 		$$ = &ast.AdminStmt{Tp: ast.AdminShowDDL}
 	}
+|	"ADMIN" "SHOW" "DDL" "DEPENDENCIES"
+	{
+		$$ = &ast.AdminStmt{Tp: ast.AdminShowDDLDependencies}
+	}
 |	"ADMIN" "SHOW" "DDL" "JOBS" WhereClauseOptional
 	{
 		stmt := &ast.AdminStmt{Tp: ast.AdminShowDDLJobs}
@@ -10450,6 +10721,14 @@ AdminStmt:
 			Index:  string($5),
 		}
 	}
+|	"ADMIN" "CHECK" "GLOBAL" "INDEX" TableName Identifier
+	{
+		$$ = &ast.AdminStmt{
+			Tp:     ast.AdminCheckGlobalIndex,
+			Tables: []*ast.TableName{$5.(*ast.TableName)},
+			Index:  string($6),
+		}
+	}
 |	"ADMIN" "RECOVER" "INDEX" TableName Identifier
 	{
 		$$ = &ast.AdminStmt{
@@ -10466,6 +10745,26 @@ AdminStmt:
 			Index:  string($5),
 		}
 	}
+|	"ADMIN" "VERIFY" "INDEX" TableName Identifier VerifyIndexRateOpt VerifyIndexRepairOpt
+	{
+		$$ = &ast.AdminStmt{
+			Tp:           ast.AdminVerifyIndex,
+			Tables:       []*ast.TableName{$4.(*ast.TableName)},
+			Index:        string($5),
+			VerifyRate:   $6,
+			VerifyRepair: $7.(bool),
+		}
+	}
+|	"ADMIN" "WORKLOAD" "SIMULATOR" TableName "DDL" EqOpt stringLit WorkloadConcurrencyOpt WorkloadRateOpt
+	{
+		$$ = &ast.AdminStmt{
+			Tp:                  ast.AdminWorkloadSimulator,
+			Tables:              []*ast.TableName{$4.(*ast.TableName)},
+			WorkloadDDL:         $7,
+			WorkloadConcurrency: $8.(uint64),
+			WorkloadRate:        $9.(uint64),
+		}
+	}
 |	"ADMIN" "CHECK" "INDEX" TableName Identifier HandleRangeList
 	{
 		$$ = &ast.AdminStmt{
@@ -10496,6 +10795,20 @@ AdminStmt:
 			JobIDs: $6.([]int64),
 		}
 	}
+|	"ADMIN" "WAIT" "DDL" "JOB" NumList
+	{
+		$$ = &ast.AdminStmt{
+			Tp:     ast.AdminWaitDDLJob,
+			JobIDs: $5.([]int64),
+		}
+	}
+|	"ADMIN" "EXPLAIN" "ROLLBACK" "DDL" "JOB" Int64Num
+	{
+		$$ = &ast.AdminStmt{
+			Tp:     ast.AdminExplainRollbackDDLJob,
+			JobIDs: []int64{$6.(int64)},
+		}
+	}
 |	"ADMIN" "SHOW" "DDL" "JOB" "QUERIES" AdminStmtLimitOpt
 	{
 		ret := &ast.AdminStmt{
@@ -10524,6 +10837,12 @@ AdminStmt:
 			Tp: ast.AdminReloadOptRuleBlacklist,
 		}
 	}
+|	"ADMIN" "RELOAD" "QUERY_REWRITE_RULES"
+	{
+		$$ = &ast.AdminStmt{
+			Tp: ast.AdminReloadQueryRewriteRules,
+		}
+	}
 |	"ADMIN" "PLUGINS" "ENABLE" PluginNameList
 	{
 		$$ = &ast.AdminStmt{
@@ -10587,6 +10906,12 @@ AdminStmt:
 			Tp: ast.AdminReloadStatistics,
 		}
 	}
+|	"ADMIN" "SHOW" "UNUSED" "INDEXES"
+	{
+		$$ = &ast.AdminStmt{
+			Tp: ast.AdminShowUnusedIndexes,
+		}
+	}
 |	"ADMIN" "SHOW" "TELEMETRY"
 	{
 		$$ = &ast.AdminStmt{
@@ -11421,6 +11746,7 @@ Statement:
 |	CreateBindingStmt
 |	CreatePolicyStmt
 |	CreateResourceGroupStmt
+|	CreateMaintenanceWindowStmt
 |	CreateSequenceStmt
 |	CreateStatisticsStmt
 |	DoStmt
@@ -11432,6 +11758,7 @@ Statement:
 |	DropViewStmt
 |	DropUserStmt
 |	DropResourceGroupStmt
+|	DropMaintenanceWindowStmt
 |	DropRoleStmt
 |	DropStatisticsStmt
 |	DropStatsStmt
@@ -11454,6 +11781,7 @@ Statement:
 |	PlanReplayerStmt
 |	PreparedStmt
 |	RollbackStmt
+|	RenameDatabaseStmt
 |	RenameTableStmt
 |	RenameUserStmt
 |	ReplaceIntoStmt
@@ -11486,6 +11814,7 @@ Statement:
 |	ShowStmt
 |	TraceStmt
 |	TruncateTableStmt
+|	UndropIndexStmt
 |	UpdateStmt
 |	UseStmt
 |	UnlockTablesStmt
@@ -14195,6 +14524,42 @@ DropResourceGroupStmt:
 		}
 	}
 
+/*******************************************************************
+ *
+ *  Create Maintenance Window Statement
+ *
+ *  Example:
+ *	CREATE MAINTENANCE WINDOW w1 SCHEDULE '02:00-05:00 UTC' MAX_REORG_RATE='200MB/s'
+ *******************************************************************/
+CreateMaintenanceWindowStmt:
+	"CREATE" "MAINTENANCE" WindowSym IfNotExists Identifier "SCHEDULE" EqOpt stringLit MaxReorgRateOpt
+	{
+		$$ = &ast.CreateMaintenanceWindowStmt{
+			IfNotExists:  $4.(bool),
+			WindowName:   model.NewCIStr($5),
+			Schedule:     $8,
+			MaxReorgRate: $9,
+		}
+	}
+
+MaxReorgRateOpt:
+	{
+		$$ = ""
+	}
+|	"MAX_REORG_RATE" EqOpt stringLit
+	{
+		$$ = $3
+	}
+
+DropMaintenanceWindowStmt:
+	"DROP" "MAINTENANCE" WindowSym IfExists Identifier
+	{
+		$$ = &ast.DropMaintenanceWindowStmt{
+			IfExists:   $4.(bool),
+			WindowName: model.NewCIStr($5),
+		}
+	}
+
 CreatePolicyStmt:
 	"CREATE" OrReplace "PLACEMENT" "POLICY" IfNotExists PolicyName PlacementOptionList
 	{
@@ -810,11 +810,19 @@ func TestDMLStmt(t *testing.T) {
 		// for admin
 		{"admin show ddl;", true, "ADMIN SHOW DDL"},
 		{"admin show ddl jobs;", true, "ADMIN SHOW DDL JOBS"},
+		{"admin show ddl dependencies;", true, "ADMIN SHOW DDL DEPENDENCIES"},
 		{"admin show ddl jobs where id > 0;", true, "ADMIN SHOW DDL JOBS WHERE `id`>0"},
 		{"admin show ddl jobs 20 where id=0;", true, "ADMIN SHOW DDL JOBS 20 WHERE `id`=0"},
 		{"admin show ddl jobs -1;", false, ""},
 		{"admin show ddl job queries 1", true, "ADMIN SHOW DDL JOB QUERIES 1"},
 		{"admin show ddl job queries 1, 2, 3, 4", true, "ADMIN SHOW DDL JOB QUERIES 1, 2, 3, 4"},
+		{"admin wait ddl job 1", true, "ADMIN WAIT DDL JOB 1"},
+		{"admin wait ddl job 1, 2, 3", true, "ADMIN WAIT DDL JOB 1, 2, 3"},
+		{"admin show unused indexes", true, "ADMIN SHOW UNUSED INDEXES"},
+		{"admin wait ddl job", false, ""},
+		{"admin explain rollback ddl job 1", true, "ADMIN EXPLAIN ROLLBACK DDL JOB 1"},
+		{"admin explain rollback ddl job 1, 2", false, ""},
+		{"admin explain rollback ddl job", false, ""},
 		{"admin show ddl job queries limit 5", true, "ADMIN SHOW DDL JOB QUERIES LIMIT 0, 5"},
 		{"admin show ddl job queries limit 5, 10", true, "ADMIN SHOW DDL JOB QUERIES LIMIT 5, 10"},
 		{"admin show ddl job queries limit 3 offset 2", true, "ADMIN SHOW DDL JOB QUERIES LIMIT 2, 3"},
@@ -823,16 +831,28 @@ func TestDMLStmt(t *testing.T) {
 		{"admin check table t1, t2;", true, "ADMIN CHECK TABLE `t1`, `t2`"},
 		{"admin check index tableName idxName;", true, "ADMIN CHECK INDEX `tableName` idxName"},
 		{"admin check index tableName idxName (1, 2), (4, 5);", true, "ADMIN CHECK INDEX `tableName` idxName (1,2), (4,5)"},
+		{"admin check global index tableName idxName;", true, "ADMIN CHECK GLOBAL INDEX `tableName` idxName"},
 		{"admin checksum table t1, t2;", true, "ADMIN CHECKSUM TABLE `t1`, `t2`"},
 		{"admin cancel ddl jobs 1", true, "ADMIN CANCEL DDL JOBS 1"},
 		{"admin cancel ddl jobs 1, 2", true, "ADMIN CANCEL DDL JOBS 1, 2"},
 		{"admin recover index t1 idx_a", true, "ADMIN RECOVER INDEX `t1` idx_a"},
 		{"admin cleanup index t1 idx_a", true, "ADMIN CLEANUP INDEX `t1` idx_a"},
+		{"admin verify index t1 idx_a", true, "ADMIN VERIFY INDEX `t1` idx_a"},
+		{"admin verify index test.t1 idx_a rate = '50MB/s'", true, "ADMIN VERIFY INDEX `test`.`t1` idx_a RATE = '50MB/s'"},
+		{"admin verify index t1 idx_a rate '1GB/s' repair", true, "ADMIN VERIFY INDEX `t1` idx_a RATE = '1GB/s' REPAIR"},
+		{"admin verify index t1 idx_a repair", true, "ADMIN VERIFY INDEX `t1` idx_a REPAIR"},
+		{"admin workload simulator t1 ddl = 'alter table t1 add index idx_a(a)'", true, "ADMIN WORKLOAD SIMULATOR `t1` DDL = 'alter table t1 add index idx_a(a)'"},
+		{"admin workload simulator test.t1 ddl 'alter table t1 modify a bigint' concurrency = 8 rate = 1000", true, "ADMIN WORKLOAD SIMULATOR `test`.`t1` DDL = 'alter table t1 modify a bigint' CONCURRENCY = 8 RATE = 1000"},
+		{"admin workload simulator t1 ddl = 'alter table t1 add column b int' rate 500", true, "ADMIN WORKLOAD SIMULATOR `t1` DDL = 'alter table t1 add column b int' RATE = 500"},
+		{"admin workload simulator t1", false, ""},
+		{"admin verify index t1 idx_a repair rate = '1GB/s'", false, ""},
+		{"admin verify index t1", false, ""},
 		{"admin show slow top 3", true, "ADMIN SHOW SLOW TOP 3"},
 		{"admin show slow top internal 7", true, "ADMIN SHOW SLOW TOP INTERNAL 7"},
 		{"admin show slow top all 9", true, "ADMIN SHOW SLOW TOP ALL 9"},
 		{"admin show slow recent 11", true, "ADMIN SHOW SLOW RECENT 11"},
 		{"admin reload expr_pushdown_blacklist", true, "ADMIN RELOAD EXPR_PUSHDOWN_BLACKLIST"},
+		{"admin reload query_rewrite_rules", true, "ADMIN RELOAD QUERY_REWRITE_RULES"},
 		{"admin plugins disable audit, whitelist", true, "ADMIN PLUGINS DISABLE audit, whitelist"},
 		{"admin plugins enable audit, whitelist", true, "ADMIN PLUGINS ENABLE audit, whitelist"},
 		{"admin flush bindings", true, "ADMIN FLUSH BINDINGS"},
@@ -3018,6 +3038,9 @@ func TestDDL(t *testing.T) {
 		{"ALTER TABLE t ADD INDEX IF NOT EXISTS (a) USING BTREE COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX IF NOT EXISTS(`a`) USING BTREE COMMENT 'a'"},
 		{"ALTER TABLE t ADD INDEX (a) USING RTREE COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX(`a`) USING RTREE COMMENT 'a'"},
 		{"ALTER TABLE t ADD KEY (a) USING HASH COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX(`a`) USING HASH COMMENT 'a'"},
+		{"ALTER TABLE t ADD INDEX idx (a, b) USING COLUMNAR", true, "ALTER TABLE `t` ADD INDEX `idx`(`a`, `b`) USING COLUMNAR"},
+		{"CREATE INDEX idx USING COLUMNAR ON t (a)", true, "CREATE INDEX `idx` ON `t` (`a`) USING COLUMNAR"},
+		{"create table columnar (columnar int)", true, "CREATE TABLE `columnar` (`columnar` INT)"},
 		{"ALTER TABLE t ADD KEY IF NOT EXISTS (a) USING HASH COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX IF NOT EXISTS(`a`) USING HASH COMMENT 'a'"},
 		{"ALTER TABLE t ADD PRIMARY KEY ident USING RTREE ( a DESC , b   )", true, "ALTER TABLE `t` ADD PRIMARY KEY `ident`(`a` DESC, `b`) USING RTREE"},
 		{"ALTER TABLE t ADD KEY USING RTREE   ( a ) ", true, "ALTER TABLE `t` ADD INDEX(`a`) USING RTREE"},
@@ -3074,8 +3097,9 @@ func TestDDL(t *testing.T) {
 
 		{"ALTER TABLE t DROP FOREIGN KEY a", true, "ALTER TABLE `t` DROP FOREIGN KEY `a`"},
 		{"ALTER TABLE t DROP FOREIGN KEY IF EXISTS a", true, "ALTER TABLE `t` DROP FOREIGN KEY IF EXISTS `a`"},
-		{"ALTER TABLE t DROP COLUMN a CASCADE", true, "ALTER TABLE `t` DROP COLUMN `a`"},
-		{"ALTER TABLE t DROP COLUMN IF EXISTS a CASCADE", true, "ALTER TABLE `t` DROP COLUMN IF EXISTS `a`"},
+		{"ALTER TABLE t DROP COLUMN a CASCADE", true, "ALTER TABLE `t` DROP COLUMN `a` CASCADE"},
+		{"ALTER TABLE t DROP COLUMN IF EXISTS a CASCADE", true, "ALTER TABLE `t` DROP COLUMN IF EXISTS `a` CASCADE"},
+		{"ALTER TABLE t DROP COLUMN a RESTRICT", true, "ALTER TABLE `t` DROP COLUMN `a`"},
 		{`ALTER TABLE testTableCompression COMPRESSION="LZ4";`, true, "ALTER TABLE `testTableCompression` COMPRESSION = 'LZ4'"},
 		{`ALTER TABLE t1 COMPRESSION="zlib";`, true, "ALTER TABLE `t1` COMPRESSION = 'zlib'"},
 		{"ALTER TABLE t1", true, "ALTER TABLE `t1`"},
@@ -3135,6 +3159,17 @@ func TestDDL(t *testing.T) {
 		{"ALTER TABLE members REORGANIZE PARTITION p1,p2,p3 INTO ( PARTITION s0 VALUES LESS THAN (1960), PARTITION s1 VALUES LESS THAN (1970));", true, "ALTER TABLE `members` REORGANIZE PARTITION `p1`,`p2`,`p3` INTO (PARTITION `s0` VALUES LESS THAN (1960), PARTITION `s1` VALUES LESS THAN (1970))"},
 		{"alter table t reorganize partition remove partition;", false, ""},
 		{"alter table t reorganize partition no_write_to_binlog remove into (partition p0 VALUES LESS THAN (1991));", true, "ALTER TABLE `t` REORGANIZE PARTITION NO_WRITE_TO_BINLOG `remove` INTO (PARTITION `p0` VALUES LESS THAN (1991))"},
+		{"ALTER TABLE members SPLIT PARTITION p0 INTO (PARTITION s0 VALUES LESS THAN (1960), PARTITION s1 VALUES LESS THAN (1970));", true, "ALTER TABLE `members` SPLIT PARTITION `p0` INTO (PARTITION `s0` VALUES LESS THAN (1960), PARTITION `s1` VALUES LESS THAN (1970))"},
+		{"ALTER TABLE members SPLIT PARTITION p0,p1 INTO (PARTITION s0 VALUES LESS THAN (1960));", false, ""},
+		{"ALTER TABLE members MERGE PARTITIONS p1,p2,p3 INTO PARTITION p;", true, "ALTER TABLE `members` MERGE PARTITIONS `p1`,`p2`,`p3` INTO PARTITION `p`"},
+		{"ALTER TABLE members MERGE PARTITIONS p1,p2 INTO (PARTITION p VALUES LESS THAN (1960));", false, ""},
+		{"ALTER TABLE members DETACH PARTITION p1 INTO TABLE members_p1", true, "ALTER TABLE `members` DETACH PARTITION `p1` INTO TABLE `members_p1`"},
+		{"ALTER TABLE members DETACH PARTITION p1 INTO TABLE test.members_p1", true, "ALTER TABLE `members` DETACH PARTITION `p1` INTO TABLE `test`.`members_p1`"},
+		{"ALTER TABLE members DETACH PARTITION p1, p2 INTO TABLE members_p1", false, ""},
+		{"ALTER TABLE members ATTACH PARTITION p1 VALUES LESS THAN (1960) FROM TABLE members_p1", true, "ALTER TABLE `members` ATTACH PARTITION `p1` VALUES LESS THAN (1960) FROM TABLE `members_p1`"},
+		{"ALTER TABLE members ATTACH PARTITION p1 VALUES IN (1, 2) FROM TABLE members_p1 WITHOUT VALIDATION", true, "ALTER TABLE `members` ATTACH PARTITION `p1` VALUES IN (1, 2) FROM TABLE `members_p1` WITHOUT VALIDATION"},
+		{"ALTER TABLE members ATTACH PARTITION p1 VALUES LESS THAN (1960) FROM TABLE members_p1 WITH VALIDATION", true, "ALTER TABLE `members` ATTACH PARTITION `p1` VALUES LESS THAN (1960) FROM TABLE `members_p1`"},
+		{"ALTER TABLE members ATTACH PARTITION p1 FROM TABLE members_p1", true, "ALTER TABLE `members` ATTACH PARTITION `p1` FROM TABLE `members_p1`"},
 
 		// alter attributes
 		{"ALTER TABLE t ATTRIBUTES='str'", true, "ALTER TABLE `t` ATTRIBUTES='str'"},
@@ -3210,6 +3245,14 @@ func TestDDL(t *testing.T) {
 		{"drop index if exists a on t", true, "DROP INDEX IF EXISTS `a` ON `t`"},
 		{"drop index if exists a on db.t", true, "DROP INDEX IF EXISTS `a` ON `db`.`t`"},
 		{"drop index if exists a on db.`tb-ttb`", true, "DROP INDEX IF EXISTS `a` ON `db`.`tb-ttb`"},
+		{"drop index a on t deferred", true, "DROP INDEX `a` ON `t` DEFERRED"},
+		{"drop index if exists a on t algorithm = inplace deferred", true, "DROP INDEX IF EXISTS `a` ON `t` ALGORITHM = INPLACE DEFERRED"},
+		{"alter table t drop index a deferred", true, "ALTER TABLE `t` DROP INDEX `a` DEFERRED"},
+		{"alter table t drop key if exists a deferred, add column c int", true, "ALTER TABLE `t` DROP INDEX IF EXISTS `a` DEFERRED, ADD COLUMN `c` INT"},
+		{"undrop index a on t", true, "UNDROP INDEX `a` ON `t`"},
+		{"undrop index a on db.t", true, "UNDROP INDEX `a` ON `db`.`t`"},
+		{"undrop index if exists a on t", false, ""},
+		{"create table deferred (undrop int)", true, "CREATE TABLE `deferred` (`undrop` INT)"},
 		{"drop index idx on t algorithm = default", true, "DROP INDEX `idx` ON `t`"},
 		{"drop index idx on t algorithm default", true, "DROP INDEX `idx` ON `t`"},
 		{"drop index idx on t algorithm = inplace", true, "DROP INDEX `idx` ON `t` ALGORITHM = INPLACE"},
@@ -3227,6 +3270,12 @@ func TestDDL(t *testing.T) {
 		{"drop index idx on t lock = lock_type", false, ""},
 		{"drop index idx on t lock lock_type", false, ""},
 
+		// for rename database statement
+		{"RENAME DATABASE d TO d1", true, "RENAME DATABASE `d` TO `d1`"},
+		{"RENAME SCHEMA d TO d1", true, "RENAME DATABASE `d` TO `d1`"},
+		{"RENAME DATABASE d d1", false, ""},
+		{"RENAME DATABASE d.t TO d1", false, ""},
+
 		// for rename table statement
 		{"RENAME TABLE t TO t1", true, "RENAME TABLE `t` TO `t1`"},
 		{"RENAME TABLE t t1", false, "RENAME TABLE `t` TO `t1`"},
@@ -3284,6 +3333,10 @@ func TestDDL(t *testing.T) {
 		{"create table t (a int column_format default)", true, "CREATE TABLE `t` (`a` INT COLUMN_FORMAT DEFAULT)"},
 		{"create table t (a int column_format dynamic)", true, "CREATE TABLE `t` (`a` INT COLUMN_FORMAT DYNAMIC)"},
 		{"alter table t modify column a bigint column_format default", true, "ALTER TABLE `t` MODIFY COLUMN `a` BIGINT COLUMN_FORMAT DEFAULT"},
+		{"create table t (a text compression='zstd', b blob compression 'zstd')", true, "CREATE TABLE `t` (`a` TEXT COMPRESSION = 'zstd',`b` BLOB COMPRESSION = 'zstd')"},
+		{"create table t (a text /*T![column_compression] COMPRESSION='zstd' */)", true, "CREATE TABLE `t` (`a` TEXT COMPRESSION = 'zstd')"},
+		{"alter table t modify column a longtext compression = 'zstd'", true, "ALTER TABLE `t` MODIFY COLUMN `a` LONGTEXT COMPRESSION = 'zstd'"},
+		{"alter table t modify column a text compression = 'zstd', compression = 'lz4'", true, "ALTER TABLE `t` MODIFY COLUMN `a` TEXT COMPRESSION = 'zstd', COMPRESSION = 'lz4'"},
 
 		// for recover table
 		{"recover table by job 11", true, "RECOVER TABLE BY JOB 11"},
@@ -3700,6 +3753,19 @@ func TestDDL(t *testing.T) {
 		{"set resource group x;", true, "SET RESOURCE GROUP `x`"},
 		{"set resource group x y", false, ""},
 
+		// for maintenance window
+		{"create maintenance window w1 schedule '02:00-05:00 UTC'", true, "CREATE MAINTENANCE WINDOW `w1` SCHEDULE = '02:00-05:00 UTC'"},
+		{"create maintenance window if not exists w1 schedule = '02:00-05:00' max_reorg_rate='200MB/s'", true, "CREATE MAINTENANCE WINDOW IF NOT EXISTS `w1` SCHEDULE = '02:00-05:00' MAX_REORG_RATE = '200MB/s'"},
+		{"create maintenance window w1 max_reorg_rate='200MB/s'", false, ""},
+		{"drop maintenance window w1", true, "DROP MAINTENANCE WINDOW `w1`"},
+		{"drop maintenance window if exists w1", true, "DROP MAINTENANCE WINDOW IF EXISTS `w1`"},
+		{"alter table t add index idx(a) in window w1", true, "ALTER TABLE `t` ADD INDEX `idx`(`a`) IN WINDOW `w1`"},
+		{"alter table t modify column a bigint, add column b int in window w1", true, "ALTER TABLE `t` MODIFY COLUMN `a` BIGINT, ADD COLUMN `b` INT IN WINDOW `w1`"},
+		{"create index idx on t(a) in window w1", true, "CREATE INDEX `idx` ON `t` (`a`) IN WINDOW `w1`"},
+		{"create index idx on t(a) algorithm = inplace in window w1", true, "CREATE INDEX `idx` ON `t` (`a`) ALGORITHM = INPLACE IN WINDOW `w1`"},
+		{"create index idx on t(a) in window", false, ""},
+		{"create table maintenance (max_reorg_rate int)", true, "CREATE TABLE `maintenance` (`max_reorg_rate` INT)"},
+
 		{"CREATE ROLE `RESOURCE`", true, "CREATE ROLE `RESOURCE`@`%`"},
 		{"CREATE ROLE RESOURCE", false, ""},
 
@@ -4394,6 +4460,16 @@ func TestOptimizerHints(t *testing.T) {
 	require.Equal(t, "agg_to_cop", hints[0].HintName.L)
 	require.Equal(t, "agg_to_cop", hints[1].HintName.L)
 
+	// Test AGG_TO_ROOT and AGG_TO_MPP
+	stmt, _, err = p.Parse("select /*+ AGG_TO_ROOT(), agg_to_mpp() */ c1, c2 from t1, t2 where t1.c1 = t2.c1", "", "")
+	require.NoError(t, err)
+	selectStmt = stmt[0].(*ast.SelectStmt)
+
+	hints = selectStmt.TableHints
+	require.Len(t, hints, 2)
+	require.Equal(t, "agg_to_root", hints[0].HintName.L)
+	require.Equal(t, "agg_to_mpp", hints[1].HintName.L)
+
 	// Test NO_INDEX_MERGE
 	stmt, _, err = p.Parse("select /*+ NO_INDEX_MERGE(), no_index_merge() */ c1, c2 from t1, t2 where t1.c1 = t2.c1", "", "")
 	require.NoError(t, err)
@@ -4424,6 +4500,16 @@ func TestOptimizerHints(t *testing.T) {
 	require.Equal(t, "limit_to_cop", hints[0].HintName.L)
 	require.Equal(t, "limit_to_cop", hints[1].HintName.L)
 
+	// Test LIMIT_TO_ROOT and LIMIT_TO_MPP
+	stmt, _, err = p.Parse("select /*+ LIMIT_TO_ROOT(), limit_to_mpp() */ c1, c2 from t1, t2 where t1.c1 = t2.c1", "", "")
+	require.NoError(t, err)
+	selectStmt = stmt[0].(*ast.SelectStmt)
+
+	hints = selectStmt.TableHints
+	require.Len(t, hints, 2)
+	require.Equal(t, "limit_to_root", hints[0].HintName.L)
+	require.Equal(t, "limit_to_mpp", hints[1].HintName.L)
+
 	// Test CTE MERGE
 	stmt, _, err = p.Parse("with cte(x) as (select * from t1) select /*+ MERGE(), merge() */ * from cte;", "", "")
 	require.NoError(t, err)
@@ -4465,6 +4551,22 @@ func TestOptimizerHints(t *testing.T) {
 	require.Equal(t, "t4", hints[2].Tables[0].TableName.L)
 	require.Equal(t, "t5", hints[2].Tables[1].TableName.L)
 	require.Equal(t, "t6", hints[2].Tables[2].TableName.L)
+
+	// Test LEADING with nested table lists
+	stmt, _, err = p.Parse("select /*+ LEADING(t1, t2), LEADING((t1, t2), (t3, t4)) */ c1, c2 from t1, t2 where t1.c1 = t2.c1", "", "")
+	require.NoError(t, err)
+	selectStmt = stmt[0].(*ast.SelectStmt)
+
+	hints = selectStmt.TableHints
+	require.Len(t, hints, 2)
+	require.Nil(t, hints[0].HintData)
+	require.Len(t, hints[1].Tables, 4)
+	require.Equal(t, "t1", hints[1].Tables[0].TableName.L)
+	require.Equal(t, "t4", hints[1].Tables[3].TableName.L)
+	list := hints[1].HintData.(*ast.LeadingList)
+	require.Len(t, list.Items, 2)
+	require.Len(t, list.Items[0].(*ast.LeadingList).Items, 2)
+	require.Equal(t, "t3", list.Items[1].(*ast.LeadingList).Items[0].(ast.HintTable).TableName.L)
 }
 
 func TestType(t *testing.T) {
@@ -5078,6 +5180,13 @@ func TestExplain(t *testing.T) {
 		{"explain select c1 from t1 union (select c2 from t2) limit 1, 1", true, "EXPLAIN FORMAT = 'row' SELECT `c1` FROM `t1` UNION (SELECT `c2` FROM `t2`) LIMIT 1,1"},
 		{`explain format = "row" select c1 from t1 union (select c2 from t2) limit 1, 1`, true, "EXPLAIN FORMAT = 'row' SELECT `c1` FROM `t1` UNION (SELECT `c2` FROM `t2`) LIMIT 1,1"},
 		{"explain format = 'brief' select * from t", true, "EXPLAIN FORMAT = 'brief' SELECT * FROM `t`"},
+		{"explain with hypothetical index t(a, b) select * from t where a = 1", true, "EXPLAIN FORMAT = 'row' WITH HYPOTHETICAL INDEX `t`(`a`, `b`) SELECT * FROM `t` WHERE `a`=1"},
+		{"explain format = 'brief' with hypothetical index test.t1(a), t2(c(10)) select * from t1 join t2 on t1.a = t2.c", true, "EXPLAIN FORMAT = 'brief' WITH HYPOTHETICAL INDEX `test`.`t1`(`a`), `t2`(`c`(10)) SELECT * FROM `t1` JOIN `t2` ON `t1`.`a`=`t2`.`c`"},
+		{"explain format = brief with hypothetical index t(a) update t set b = 1 where a = 1", true, "EXPLAIN FORMAT = 'brief' WITH HYPOTHETICAL INDEX `t`(`a`) UPDATE `t` SET `b`=1 WHERE `a`=1"},
+		{"explain with cte as (select 1) select * from cte", true, "EXPLAIN FORMAT = 'row' WITH `cte` AS (SELECT 1) SELECT * FROM `cte`"},
+		{"explain with hypothetical as (select 1) select * from hypothetical", true, "EXPLAIN FORMAT = 'row' WITH `hypothetical` AS (SELECT 1) SELECT * FROM `hypothetical`"},
+		{"explain with hypothetical index t select * from t", false, ""},
+		{"explain analyze with hypothetical index t(a) select * from t", false, ""},
 		{"DESC SCHE.TABL", true, "DESC `SCHE`.`TABL`"},
 		{"DESC SCHE.TABL COLUM", true, "DESC `SCHE`.`TABL` `COLUM`"},
 		{"DESCRIBE SCHE.TABL COLUM", true, "DESC `SCHE`.`TABL` `COLUM`"},
@@ -5577,6 +5686,10 @@ func TestAnalyze(t *testing.T) {
 		{"analyze table t drop histogram on c1, c2;", true, "ANALYZE TABLE `t` DROP HISTOGRAM ON `c1`,`c2`"},
 		{"analyze table t update histogram on t.c1, t.c2", false, ""},
 		{"analyze table t drop histogram on t.c1, t.c2", false, ""},
+		{"analyze table t update histogram on (date(ts))", true, "ANALYZE TABLE `t` UPDATE HISTOGRAM ON (DATE(`ts`))"},
+		{"analyze table t update histogram on (a+1), (json_extract(j, '$.k')) with 64 buckets", true, "ANALYZE TABLE `t` UPDATE HISTOGRAM ON (`a`+1),(JSON_EXTRACT(`j`, _UTF8MB4'$.k')) WITH 64 BUCKETS"},
+		{"analyze table t drop histogram on (date(ts)), (lower(b))", true, "ANALYZE TABLE `t` DROP HISTOGRAM ON (DATE(`ts`)),(LOWER(`b`))"},
+		{"analyze table t update histogram on (a), b", false, ""},
 		{"analyze table t1,t2 all columns", true, "ANALYZE TABLE `t1`,`t2` ALL COLUMNS"},
 		{"analyze table t partition a all columns", true, "ANALYZE TABLE `t` PARTITION `a` ALL COLUMNS"},
 		{"analyze table t1,t2 all columns with 4 topn", true, "ANALYZE TABLE `t1`,`t2` ALL COLUMNS WITH 4 TOPN"},
@@ -5657,6 +5770,19 @@ func TestTableSample(t *testing.T) {
 	}
 }
 
+func TestTableFunction(t *testing.T) {
+	table := []testCase{
+		{"select * from json_each('{\"a\": 1}')", true, "SELECT * FROM `json_each`(_UTF8MB4'{\"a\": 1}')"},
+		{"select * from tidb_ddl_reorg_tasks(123) as t", true, "SELECT * FROM `tidb_ddl_reorg_tasks`(123) AS `t`"},
+		{"select t.key from json_each(@j) t where t.value > 1", true, "SELECT `t`.`key` FROM `json_each`(@`j`) AS `t` WHERE `t`.`value`>1"},
+		{"select * from f() join t on f.a = t.a", true, "SELECT * FROM `f`() JOIN `t` ON `f`.`a`=`t`.`a`"},
+		{"select * from t, json_each(concat('{', '}'), 1)", true, "SELECT * FROM (`t`) JOIN `json_each`(CONCAT(_UTF8MB4'{', _UTF8MB4'}'), 1)"},
+		{"select * from test.json_each('{}')", false, ""},
+		{"select * from json_each('{}') partition (p0)", false, ""},
+	}
+	RunTest(t, table, false)
+}
+
 func TestGeneratedColumn(t *testing.T) {
 	tests := []struct {
 		input string
@@ -6568,6 +6694,8 @@ func TestStatisticsOps(t *testing.T) {
 		{"create statistics if not exists stats2 (dependency) on t(a,b)", true, "CREATE STATISTICS IF NOT EXISTS `stats2` (DEPENDENCY) ON `t`(`a`, `b`)"},
 		{"create statistics if not exists stats3 (correlation) on t(a,b)", true, "CREATE STATISTICS IF NOT EXISTS `stats3` (CORRELATION) ON `t`(`a`, `b`)"},
 		{"create statistics if not exists stats3 on t(a,b)", false, ""},
+		{"create statistics stats4 (sketch) on t(a,b,c)", true, "CREATE STATISTICS `stats4` (SKETCH) ON `t`(`a`, `b`, `c`)"},
+		{"alter table t add stats_extended s1 sketch(a,b)", true, "ALTER TABLE `t` ADD STATS_EXTENDED `s1` SKETCH(`a`, `b`)"},
 		{"create statistics stats1(cardinality) on t(a,b,c)", true, "CREATE STATISTICS `stats1` (CARDINALITY) ON `t`(`a`, `b`, `c`)"},
 		{"drop statistics stats1", true, "DROP STATISTICS `stats1`"},
 	}
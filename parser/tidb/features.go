@@ -32,16 +32,19 @@ const (
 	FeatureIDTTL = "ttl"
 	// FeatureIDResourceGroup is the `resource group` feature.
 	FeatureIDResourceGroup = "resource_group"
+	// FeatureIDColumnCompression is the `column compression` feature.
+	FeatureIDColumnCompression = "column_compression"
 )
 
 var featureIDs = map[string]struct{}{
-	FeatureIDAutoRandom:     {},
-	FeatureIDAutoIDCache:    {},
-	FeatureIDAutoRandomBase: {},
-	FeatureIDClusteredIndex: {},
-	FeatureIDForceAutoInc:   {},
-	FeatureIDPlacement:      {},
-	FeatureIDTTL:            {},
+	FeatureIDAutoRandom:        {},
+	FeatureIDAutoIDCache:       {},
+	FeatureIDAutoRandomBase:    {},
+	FeatureIDClusteredIndex:    {},
+	FeatureIDForceAutoInc:      {},
+	FeatureIDPlacement:         {},
+	FeatureIDTTL:               {},
+	FeatureIDColumnCompression: {},
 }
 
 // CanParseFeature is used to check if a feature can be parsed.
@@ -88,6 +88,12 @@ var defaultImplementationMap = map[memo.Operand][]ImplementationRule{
 	memo.OperandWindow: {
 		&ImplWindow{},
 	},
+	memo.OperandLock: {
+		&ImplLock{},
+	},
+	memo.OperandUnionScan: {
+		&ImplUnionScan{},
+	},
 }
 
 // ImplTableDual implements LogicalTableDual as PhysicalTableDual.
@@ -514,7 +520,7 @@ func (*ImplMergeJoin) OnImplement(expr *memo.GroupExpr, reqProp *property.Physic
 	return mergeJoinImpls, nil
 }
 
-// ImplUnionAll implements LogicalUnionAll to PhysicalUnionAll.
+// ImplUnionAll implements LogicalUnionAll and LogicalPartitionUnionAll to PhysicalUnionAll.
 type ImplUnionAll struct {
 }
 
@@ -525,17 +531,18 @@ func (*ImplUnionAll) Match(_ *memo.GroupExpr, prop *property.PhysicalProperty) (
 
 // OnImplement implements ImplementationRule OnImplement interface.
 func (*ImplUnionAll) OnImplement(expr *memo.GroupExpr, reqProp *property.PhysicalProperty) ([]memo.Implementation, error) {
-	logicalUnion := expr.ExprNode.(*plannercore.LogicalUnionAll)
 	chReqProps := make([]*property.PhysicalProperty, len(expr.Children))
 	for i := range expr.Children {
 		chReqProps[i] = &property.PhysicalProperty{ExpectedCnt: reqProp.ExpectedCnt}
 	}
-	physicalUnion := plannercore.PhysicalUnionAll{}.Init(
-		logicalUnion.SCtx(),
-		expr.Group.Prop.Stats.ScaleByExpectCnt(reqProp.ExpectedCnt),
-		logicalUnion.SelectBlockOffset(),
-		chReqProps...,
-	)
+	stats := expr.Group.Prop.Stats.ScaleByExpectCnt(reqProp.ExpectedCnt)
+	var physicalUnion *plannercore.PhysicalUnionAll
+	switch logicalUnion := expr.ExprNode.(type) {
+	case *plannercore.LogicalPartitionUnionAll:
+		physicalUnion = logicalUnion.GetPhysicalUnionAll(stats, chReqProps...)
+	case *plannercore.LogicalUnionAll:
+		physicalUnion = logicalUnion.GetPhysicalUnionAll(stats, chReqProps...)
+	}
 	physicalUnion.SetSchema(expr.Group.Prop.Schema)
 	return []memo.Implementation{impl.NewUnionAllImpl(physicalUnion)}, nil
 }
@@ -620,3 +627,35 @@ func (*ImplWindow) OnImplement(expr *memo.GroupExpr, reqProp *property.PhysicalP
 	physicalWindow.SetSchema(expr.Group.Prop.Schema)
 	return []memo.Implementation{impl.NewWindowImpl(physicalWindow)}, nil
 }
+
+// ImplLock implements LogicalLock to PhysicalLock.
+type ImplLock struct {
+}
+
+// Match implements ImplementationRule Match interface.
+func (*ImplLock) Match(_ *memo.GroupExpr, _ *property.PhysicalProperty) (matched bool) {
+	return true
+}
+
+// OnImplement implements ImplementationRule OnImplement interface.
+func (*ImplLock) OnImplement(expr *memo.GroupExpr, reqProp *property.PhysicalProperty) ([]memo.Implementation, error) {
+	ll := expr.ExprNode.(*plannercore.LogicalLock)
+	physicalLock := ll.GetPhysicalLock(expr.Group.Prop.Stats.ScaleByExpectCnt(reqProp.ExpectedCnt), reqProp.CloneEssentialFields())
+	return []memo.Implementation{impl.NewLockImpl(physicalLock)}, nil
+}
+
+// ImplUnionScan implements LogicalUnionScan to PhysicalUnionScan.
+type ImplUnionScan struct {
+}
+
+// Match implements ImplementationRule Match interface.
+func (*ImplUnionScan) Match(_ *memo.GroupExpr, _ *property.PhysicalProperty) (matched bool) {
+	return true
+}
+
+// OnImplement implements ImplementationRule OnImplement interface.
+func (*ImplUnionScan) OnImplement(expr *memo.GroupExpr, reqProp *property.PhysicalProperty) ([]memo.Implementation, error) {
+	us := expr.ExprNode.(*plannercore.LogicalUnionScan)
+	physicalUnionScan := us.GetPhysicalUnionScan(expr.Group.Prop.Stats.ScaleByExpectCnt(reqProp.ExpectedCnt), reqProp.CloneEssentialFields())
+	return []memo.Implementation{impl.NewUnionScanImpl(physicalUnionScan)}, nil
+}
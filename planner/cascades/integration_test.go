@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/testkit/testdata"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSimpleProjDual(t *testing.T) {
@@ -439,3 +440,74 @@ func TestInlineProjection(t *testing.T) {
 		tk.MustQuery(sql).Check(testkit.Rows(output[i].Result...))
 	}
 }
+
+func TestCascadesQueryShapes(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int primary key, b int)")
+	tk.MustExec("create table t2(a int primary key, b int)")
+	tk.MustExec("create table t3(a int primary key, b int)")
+	tk.MustExec("insert into t1 values (1, 11), (2, 22), (3, 33)")
+	tk.MustExec("insert into t2 values (1, 111), (2, 222), (4, 444)")
+	tk.MustExec("insert into t3 values (11, 1), (22, 2), (33, 3)")
+	tk.MustGetErrMsg("set @@tidb_cascades_planner_query_shapes = 'star_join,unknown'",
+		"[variable:1231]Variable 'tidb_cascades_planner_query_shapes' can't be set to the value of 'star_join,unknown'")
+	tk.MustExec("set @@tidb_cascades_planner_query_shapes = ' Agg_Over_Join '")
+	tk.MustQuery("select @@tidb_cascades_planner_query_shapes").Check(testkit.Rows("agg_over_join"))
+
+	explain := func(sql string, cascadesPlanner bool) []string {
+		tk.MustExec(fmt.Sprintf("set @@tidb_enable_cascades_planner = %v", cascadesPlanner))
+		defer tk.MustExec("set @@tidb_enable_cascades_planner = 0")
+		return testdata.ConvertRowsToStrings(tk.MustQuery("explain format = 'brief' " + sql).Rows())
+	}
+	tk.MustExec("set @@tidb_cascades_planner_query_shapes = 'star_join,agg_over_join'")
+	for _, c := range []struct {
+		sql    string
+		result []string
+	}{
+		{"select count(*) from t1 join t2 on t1.a = t2.a", []string{"2"}},
+		{"select t1.a, t2.b, t3.a from t1, t2, t3 where t1.a = t2.a and t1.b = t3.a order by t1.a", []string{"1 111 11", "2 222 22"}},
+	} {
+		// The query is optimized by the cascades planner.
+		tk.MustQuery("explain format = 'brief' " + c.sql).Check(testkit.Rows(explain(c.sql, true)...))
+		tk.MustQuery(c.sql).Check(testkit.Rows(c.result...))
+	}
+	// The joins don't match any query shape.
+	sql := "select t1.a, t2.b from t1 join t2 on t1.a = t2.a order by t1.a"
+	tk.MustQuery("explain format = 'brief' " + sql).Check(testkit.Rows(explain(sql, false)...))
+	tk.MustQuery(sql).Check(testkit.Rows("1 111", "2 222"))
+}
+
+func TestCascadesPlannerStatements(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'static'")
+	tk.MustExec("create table t(a int primary key, b int)")
+	tk.MustExec("create table pt(a int, b int) partition by range (a) (partition p0 values less than (10), partition p1 values less than (20))")
+	tk.MustExec("insert into t values (1, 10), (2, 20), (3, 30)")
+	tk.MustExec("insert into pt values (1, 10), (11, 110), (12, 120)")
+	tk.MustExec("set @@tidb_enable_cascades_planner = 1")
+
+	// The partitions are unioned in the static partition prune mode.
+	require.True(t, tk.HasPlan("select * from pt", "PartitionUnion"))
+	tk.MustQuery("select a, b from pt where b > 10 order by a").Check(testkit.Rows("11 110", "12 120"))
+	tk.MustQuery("select count(*) from pt partition (p1)").Check(testkit.Rows("2"))
+	tk.MustQuery("select a, row_number() over (order by b desc) from t order by a").Check(testkit.Rows("1 3", "2 2", "3 1"))
+
+	// The uncommitted rows are read by UnionScan, and the rows are locked by SelectLock.
+	tk.MustExec("begin pessimistic")
+	tk.MustExec("insert into t values (4, 40)")
+	require.True(t, tk.HasPlan("select a from t where b > 20 for update", "UnionScan"))
+	require.True(t, tk.HasPlan("select a from t where b > 20 for update", "SelectLock"))
+	tk.MustQuery("select a from t where b > 20 order by a for update").Check(testkit.Rows("3", "4"))
+	tk.MustExec("update t set b = b + 1 where a > 2")
+	tk.MustExec("delete from t where a = 1")
+	tk.MustExec("insert into pt select a, b from t where a = 4")
+	tk.MustExec("commit")
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("2 20", "3 31", "4 41"))
+	tk.MustQuery("select * from pt order by a").Check(testkit.Rows("1 10", "4 41", "11 110", "12 120"))
+}
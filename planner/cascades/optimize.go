@@ -16,19 +16,26 @@ package cascades
 
 import (
 	"container/list"
+	"context"
 	"math"
+	"time"
 
+	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/expression"
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/planner/memo"
 	"github.com/pingcap/tidb/planner/property"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/tracing"
 )
 
 // DefaultOptimizer is the optimizer which contains all of the default
 // transformation and implementation rules.
 var DefaultOptimizer = NewOptimizer()
 
+// ErrExplorationTimeout is returned if the exploration isn't finished before the deadline.
+var ErrExplorationTimeout = errors.New("the exploration of the cascades planner timed out")
+
 // Optimizer is the struct for cascades optimizer.
 type Optimizer struct {
 	transformationRuleBatches []TransformationRuleBatch
@@ -70,7 +77,9 @@ func (opt *Optimizer) GetImplementationRules(node plannercore.LogicalPlan) []Imp
 // ------------------------------------------------------------------------------
 //
 // The target of this phase is to preprocess the plan tree by some heuristic
-// rules which should always be beneficial, for example Column Pruning.
+// rules which should always be beneficial, for example Column Pruning. The
+// partitioned tables are also rewritten into the unions of their partitions
+// in the static partition prune mode.
 //
 // ------------------------------------------------------------------------------
 // Phase 2: Exploration
@@ -97,13 +106,33 @@ func (opt *Optimizer) GetImplementationRules(node plannercore.LogicalPlan) []Imp
 // for each expression in each group under the required physical property. A
 // memo structure is used for a group to reduce the repeated search on the same
 // required physical property.
+//
+// If the optimizer trace is enabled by `trace plan`, the transformation rules
+// applied in each round of the exploration are recorded as the logical steps
+// of the trace.
 func (opt *Optimizer) FindBestPlan(sctx sessionctx.Context, logical plannercore.LogicalPlan) (p plannercore.PhysicalPlan, cost float64, err error) {
+	return opt.FindBestPlanWithDeadline(sctx, logical, time.Time{})
+}
+
+// FindBestPlanWithDeadline is like FindBestPlan, but it returns ErrExplorationTimeout if the exploration isn't
+// finished before the deadline. The zero deadline means no deadline. The logical plan may have been changed when the
+// error is returned, so it should be rebuilt to be optimized by the other optimizer.
+func (opt *Optimizer) FindBestPlanWithDeadline(sctx sessionctx.Context, logical plannercore.LogicalPlan, deadline time.Time) (p plannercore.PhysicalPlan, cost float64, err error) {
 	logical, err = opt.onPhasePreprocessing(sctx, logical)
 	if err != nil {
 		return nil, 0, err
 	}
 	rootGroup := memo.Convert2Group(logical)
-	err = opt.onPhaseExploration(sctx, rootGroup)
+	var tracer *tracing.LogicalOptimizeTracer
+	stmtCtx := sctx.GetSessionVars().StmtCtx
+	if stmtCtx.EnableOptimizeTrace {
+		stmtCtx.OptimizeTracer = &tracing.OptimizeTracer{}
+		tracer = &tracing.LogicalOptimizeTracer{
+			Steps: make([]*tracing.LogicalRuleOptimizeTracer, 0),
+		}
+		stmtCtx.OptimizeTracer.Logical = tracer
+	}
+	err = opt.explore(rootGroup, deadline, tracer)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -112,11 +141,18 @@ func (opt *Optimizer) FindBestPlan(sctx sessionctx.Context, logical plannercore.
 		return nil, 0, err
 	}
 	err = p.ResolveIndices()
+	if err == nil && tracer != nil {
+		stmtCtx.OptimizeTracer.RecordFinalPlan(buildPhysicalPlanTrace(p))
+	}
 	return p, cost, err
 }
 
 func (*Optimizer) onPhasePreprocessing(_ sessionctx.Context, plan plannercore.LogicalPlan) (plannercore.LogicalPlan, error) {
-	err := plan.PruneColumns(plan.Schema().Columns, nil)
+	plan, err := plannercore.RewritePartitionedDataSources(context.Background(), plan)
+	if err != nil {
+		return nil, err
+	}
+	err = plan.PruneColumns(plan.Schema().Columns, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -124,24 +160,37 @@ func (*Optimizer) onPhasePreprocessing(_ sessionctx.Context, plan plannercore.Lo
 }
 
 func (opt *Optimizer) onPhaseExploration(_ sessionctx.Context, g *memo.Group) error {
+	return opt.explore(g, time.Time{}, nil)
+}
+
+func (opt *Optimizer) explore(g *memo.Group, deadline time.Time, tracer *tracing.LogicalOptimizeTracer) error {
 	for round, ruleBatch := range opt.transformationRuleBatches {
+		if tracer != nil {
+			tracer.AppendRuleTracerBeforeRuleOptimize(round, explorationRoundName(round), buildGroupPlanTrace(g))
+		}
 		for !g.Explored(round) {
-			err := opt.exploreGroup(g, round, ruleBatch)
+			err := opt.exploreGroup(g, round, ruleBatch, deadline, tracer)
 			if err != nil {
 				return err
 			}
 		}
 	}
+	if tracer != nil {
+		tracer.RecordFinalLogicalPlan(buildGroupPlanTrace(g))
+	}
 	return nil
 }
 
-func (opt *Optimizer) exploreGroup(g *memo.Group, round int, ruleBatch TransformationRuleBatch) error {
+func (opt *Optimizer) exploreGroup(g *memo.Group, round int, ruleBatch TransformationRuleBatch, deadline time.Time, tracer *tracing.LogicalOptimizeTracer) error {
 	if g.Explored(round) {
 		return nil
 	}
 	g.SetExplored(round)
 
 	for elem := g.Equivalents.Front(); elem != nil; elem = elem.Next() {
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return ErrExplorationTimeout
+		}
 		curExpr := elem.Value.(*memo.GroupExpr)
 		if curExpr.Explored(round) {
 			continue
@@ -151,13 +200,13 @@ func (opt *Optimizer) exploreGroup(g *memo.Group, round int, ruleBatch Transform
 		// Explore child groups firstly.
 		for _, childGroup := range curExpr.Children {
 			for !childGroup.Explored(round) {
-				if err := opt.exploreGroup(childGroup, round, ruleBatch); err != nil {
+				if err := opt.exploreGroup(childGroup, round, ruleBatch, deadline, tracer); err != nil {
 					return err
 				}
 			}
 		}
 
-		eraseCur, err := opt.findMoreEquiv(g, elem, round, ruleBatch)
+		eraseCur, err := opt.findMoreEquiv(g, elem, round, ruleBatch, tracer)
 		if err != nil {
 			return err
 		}
@@ -169,7 +218,7 @@ func (opt *Optimizer) exploreGroup(g *memo.Group, round int, ruleBatch Transform
 }
 
 // findMoreEquiv finds and applies the matched transformation rules.
-func (*Optimizer) findMoreEquiv(g *memo.Group, elem *list.Element, round int, ruleBatch TransformationRuleBatch) (eraseCur bool, err error) {
+func (*Optimizer) findMoreEquiv(g *memo.Group, elem *list.Element, round int, ruleBatch TransformationRuleBatch, tracer *tracing.LogicalOptimizeTracer) (eraseCur bool, err error) {
 	expr := elem.Value.(*memo.GroupExpr)
 	operand := memo.GetOperand(expr.ExprNode)
 	for _, rule := range ruleBatch[operand] {
@@ -191,6 +240,9 @@ func (*Optimizer) findMoreEquiv(g *memo.Group, elem *list.Element, round int, ru
 			}
 
 			if eraseAll {
+				if tracer != nil {
+					appendTransformationTraceStep(tracer, rule, expr, len(newExprs), eraseOld, eraseAll)
+				}
 				g.DeleteAll()
 				for _, e := range newExprs {
 					g.Insert(e)
@@ -201,15 +253,20 @@ func (*Optimizer) findMoreEquiv(g *memo.Group, elem *list.Element, round int, ru
 			}
 
 			eraseCur = eraseCur || eraseOld
+			inserted := 0
 			for _, e := range newExprs {
 				if !g.Insert(e) {
 					continue
 				}
+				inserted++
 				// If the new Group expression is successfully inserted into the
 				// current Group, mark the Group as unexplored to enable the exploration
 				// on the new Group expressions.
 				g.SetUnexplored(round)
 			}
+			if tracer != nil && (inserted > 0 || eraseOld) {
+				appendTransformationTraceStep(tracer, rule, expr, inserted, eraseOld, false)
+			}
 		}
 	}
 	return eraseCur, nil
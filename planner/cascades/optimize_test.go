@@ -17,7 +17,9 @@ package cascades
 import (
 	"context"
 	"math"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/expression"
@@ -27,6 +29,7 @@ import (
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/planner/memo"
 	"github.com/pingcap/tidb/planner/property"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/stretchr/testify/require"
 )
 
@@ -220,3 +223,89 @@ func TestAppliedRuleSet(t *testing.T) {
 	require.NoError(t, optimizer.onPhaseExploration(ctx, group))
 	require.Equal(t, 1, rule.appliedTimes)
 }
+
+func TestMatchQueryShape(t *testing.T) {
+	p := parser.New()
+	ctx := plannercore.MockContext()
+	is := infoschema.MockInfoSchema([]*model.TableInfo{plannercore.MockSignedTable()})
+	domain.GetDomain(ctx).MockInfoCacheAndLoadInfoSchema(is)
+	allShapes := []string{variable.CascadesQueryShapeStarJoin, variable.CascadesQueryShapeAggOverJoin}
+
+	for _, c := range []struct {
+		sql    string
+		shapes []string
+		shape  string
+	}{
+		{"select t1.a from t t1, t t2, t t3 where t1.a = t2.a and t1.b = t3.b", allShapes, variable.CascadesQueryShapeStarJoin},
+		{"select t1.a from t t1 join t t2 on t1.a = t2.a join t t3 on t1.b = t3.b join t t4 on t1.c = t4.c", allShapes, variable.CascadesQueryShapeStarJoin},
+		{"select t1.a from t t1 join t t2 on t1.a = t2.a join t t3 on t2.b = t3.b join t t4 on t3.c = t4.c", allShapes, ""},
+		{"select t1.a from t t1 left join t t2 on t1.a = t2.a left join t t3 on t1.b = t3.b", allShapes, ""},
+		{"select count(*) from t t1 join t t2 on t1.a = t2.a", allShapes, variable.CascadesQueryShapeAggOverJoin},
+		{"select sum(t1.b) from t t1 join t t2 on t1.a = t2.a where t2.c > 1 group by t1.c", allShapes, variable.CascadesQueryShapeAggOverJoin},
+		{"select count(*) from t t1 join t t2 on t1.a = t2.a", []string{variable.CascadesQueryShapeStarJoin}, ""},
+		{"select count(*) from t", allShapes, ""},
+		{"select count(*) from t t1, t t2, t t3 where t1.a = t2.a and t1.b = t3.b", nil, ""},
+	} {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err)
+		plan, _, err := plannercore.BuildLogicalPlanForTest(context.Background(), ctx, stmt, is)
+		require.NoError(t, err)
+		require.Equal(t, c.shape, MatchQueryShape(plan.(plannercore.LogicalPlan), c.shapes), c.sql)
+	}
+}
+
+func TestFindBestPlanWithDeadline(t *testing.T) {
+	p := parser.New()
+	ctx := plannercore.MockContext()
+	is := infoschema.MockInfoSchema([]*model.TableInfo{plannercore.MockSignedTable()})
+	domain.GetDomain(ctx).MockInfoCacheAndLoadInfoSchema(is)
+
+	stmt, err := p.ParseOneStmt("select count(*) from t t1 join t t2 on t1.a = t2.a", "", "")
+	require.NoError(t, err)
+	plan, _, err := plannercore.BuildLogicalPlanForTest(context.Background(), ctx, stmt, is)
+	require.NoError(t, err)
+	_, _, err = NewOptimizer().FindBestPlanWithDeadline(ctx, plan.(plannercore.LogicalPlan), time.Now().Add(-time.Second))
+	require.ErrorIs(t, err, ErrExplorationTimeout)
+
+	plan, _, err = plannercore.BuildLogicalPlanForTest(context.Background(), ctx, stmt, is)
+	require.NoError(t, err)
+	_, _, err = NewOptimizer().FindBestPlanWithDeadline(ctx, plan.(plannercore.LogicalPlan), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+}
+
+func TestExplorationTrace(t *testing.T) {
+	p := parser.New()
+	ctx := plannercore.MockContext()
+	is := infoschema.MockInfoSchema([]*model.TableInfo{plannercore.MockSignedTable()})
+	domain.GetDomain(ctx).MockInfoCacheAndLoadInfoSchema(is)
+	stmtCtx := ctx.GetSessionVars().StmtCtx
+	stmtCtx.EnableOptimizeTrace = true
+
+	stmt, err := p.ParseOneStmt("select a from t where a > 1", "", "")
+	require.NoError(t, err)
+	plan, _, err := plannercore.BuildLogicalPlanForTest(context.Background(), ctx, stmt, is)
+	require.NoError(t, err)
+	_, _, err = NewOptimizer().FindBestPlan(ctx, plan.(plannercore.LogicalPlan))
+	require.NoError(t, err)
+
+	tracer := stmtCtx.OptimizeTracer
+	require.NotNil(t, tracer.Logical)
+	require.NotEmpty(t, tracer.Logical.FinalLogicalPlan)
+	require.NotEmpty(t, tracer.FinalPlan)
+	var reasons []string
+	for _, round := range tracer.Logical.Steps {
+		require.Contains(t, round.RuleName, "cascades_exploration_round_")
+		require.NotEmpty(t, round.Steps)
+		for _, step := range round.Steps {
+			reasons = append(reasons, step.Reason)
+		}
+	}
+	require.Condition(t, func() bool {
+		for _, reason := range reasons {
+			if strings.HasSuffix(reason, "matches the pattern of EnumeratePaths") {
+				return true
+			}
+		}
+		return false
+	}, "%v", reasons)
+}
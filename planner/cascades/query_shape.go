@@ -0,0 +1,155 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cascades
+
+import (
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/ast"
+	plannercore "github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/sessionctx/variable"
+)
+
+// MatchQueryShape returns the first shape of the shapes which the logical plan matches, or "" if none is matched.
+// The shapes are the ones in tidb_cascades_planner_query_shapes, whose exploration is known to be weak in the
+// default optimizer.
+func MatchQueryShape(p plannercore.LogicalPlan, shapes []string) string {
+	for _, shape := range shapes {
+		switch shape {
+		case variable.CascadesQueryShapeStarJoin:
+			if hasStarJoin(p) {
+				return shape
+			}
+		case variable.CascadesQueryShapeAggOverJoin:
+			if hasAggOverJoin(p) {
+				return shape
+			}
+		}
+	}
+	return ""
+}
+
+// hasAggOverJoin checks whether there is an aggregation whose child is a join, the projections and the selections
+// between them are skipped.
+func hasAggOverJoin(p plannercore.LogicalPlan) bool {
+	if _, ok := p.(*plannercore.LogicalAggregation); ok {
+		child := p.Children()[0]
+		for {
+			_, isProj := child.(*plannercore.LogicalProjection)
+			_, isSel := child.(*plannercore.LogicalSelection)
+			if !isProj && !isSel {
+				break
+			}
+			child = child.Children()[0]
+		}
+		if _, ok := child.(*plannercore.LogicalJoin); ok {
+			return true
+		}
+	}
+	for _, child := range p.Children() {
+		if hasAggOverJoin(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasStarJoin checks whether there is a group of inner joins of at least 3 tables, where a table is joined with all
+// the other tables by equal conditions. The conditions of the selection above the joins are included, because the
+// conditions in the WHERE clause aren't pushed down to the joins before the logical optimization.
+func hasStarJoin(p plannercore.LogicalPlan) bool {
+	var conds []expression.Expression
+	joinPlan := p
+	if sel, ok := p.(*plannercore.LogicalSelection); ok {
+		conds = append(conds, sel.Conditions...)
+		joinPlan = sel.Children()[0]
+	}
+	if join, ok := joinPlan.(*plannercore.LogicalJoin); ok && join.JoinType == plannercore.InnerJoin {
+		var leaves []plannercore.LogicalPlan
+		conds = collectInnerJoinGroup(join, &leaves, conds)
+		if isStarJoin(leaves, conds) {
+			return true
+		}
+		for _, leaf := range leaves {
+			if hasStarJoin(leaf) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, child := range p.Children() {
+		if hasStarJoin(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectInnerJoinGroup collects the children of the inner joins rooted at p into leaves, and returns conds with the
+// conditions of the joins appended.
+func collectInnerJoinGroup(p plannercore.LogicalPlan, leaves *[]plannercore.LogicalPlan, conds []expression.Expression) []expression.Expression {
+	join, ok := p.(*plannercore.LogicalJoin)
+	if !ok || join.JoinType != plannercore.InnerJoin {
+		*leaves = append(*leaves, p)
+		return conds
+	}
+	for _, cond := range join.EqualConditions {
+		conds = append(conds, cond)
+	}
+	conds = append(conds, join.OtherConditions...)
+	for _, child := range join.Children() {
+		conds = collectInnerJoinGroup(child, leaves, conds)
+	}
+	return conds
+}
+
+func isStarJoin(leaves []plannercore.LogicalPlan, conds []expression.Expression) bool {
+	if len(leaves) < 3 {
+		return false
+	}
+	leafOf := func(expr expression.Expression) int {
+		col, ok := expr.(*expression.Column)
+		if !ok {
+			return -1
+		}
+		for i, leaf := range leaves {
+			if leaf.Schema().Contains(col) {
+				return i
+			}
+		}
+		return -1
+	}
+	neighbors := make([]map[int]struct{}, len(leaves))
+	for i := range neighbors {
+		neighbors[i] = make(map[int]struct{})
+	}
+	for _, cond := range conds {
+		sf, ok := cond.(*expression.ScalarFunction)
+		if !ok || sf.FuncName.L != ast.EQ {
+			continue
+		}
+		l, r := leafOf(sf.GetArgs()[0]), leafOf(sf.GetArgs()[1])
+		if l < 0 || r < 0 || l == r {
+			continue
+		}
+		neighbors[l][r] = struct{}{}
+		neighbors[r][l] = struct{}{}
+	}
+	for _, n := range neighbors {
+		if len(n) == len(leaves)-1 {
+			return true
+		}
+	}
+	return false
+}
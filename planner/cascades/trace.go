@@ -0,0 +1,83 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cascades
+
+import (
+	"fmt"
+	"reflect"
+
+	plannercore "github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/planner/memo"
+	"github.com/pingcap/tidb/util/tracing"
+)
+
+// explorationRoundName returns the name of the exploration round in the trace, each round applies a batch of the
+// transformation rules.
+func explorationRoundName(round int) string {
+	return fmt.Sprintf("cascades_exploration_round_%d", round)
+}
+
+// transformationRuleName returns the name of the transformation rule, which is the name of its type.
+func transformationRuleName(rule Transformation) string {
+	tp := reflect.TypeOf(rule)
+	if tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	return tp.Name()
+}
+
+// buildGroupPlanTrace builds the trace of the plan which consists of the first expression of each group.
+func buildGroupPlanTrace(g *memo.Group) *tracing.PlanTrace {
+	expr := g.Equivalents.Front().Value.(*memo.GroupExpr)
+	trace := &tracing.PlanTrace{
+		ID:          expr.ExprNode.ID(),
+		TP:          expr.ExprNode.TP(),
+		ExplainInfo: expr.ExprNode.ExplainInfo(),
+	}
+	for _, child := range expr.Children {
+		trace.Children = append(trace.Children, buildGroupPlanTrace(child))
+	}
+	return trace
+}
+
+// buildPhysicalPlanTrace builds the trace of the physical plan found by the cascades planner.
+func buildPhysicalPlanTrace(p plannercore.PhysicalPlan) *tracing.PlanTrace {
+	trace := &tracing.PlanTrace{
+		ID:          p.ID(),
+		TP:          p.TP(),
+		ExplainInfo: p.ExplainInfo(),
+	}
+	for _, child := range p.Children() {
+		trace.Children = append(trace.Children, buildPhysicalPlanTrace(child))
+	}
+	return trace
+}
+
+// appendTransformationTraceStep records that the transformation rule is applied on the group expression, and the
+// inserted expressions are added to its group.
+func appendTransformationTraceStep(tracer *tracing.LogicalOptimizeTracer, rule Transformation, expr *memo.GroupExpr, inserted int, eraseOld, eraseAll bool) {
+	node := expr.ExprNode
+	reason := fmt.Sprintf("%v_%v matches the pattern of %s", node.TP(), node.ID(), transformationRuleName(rule))
+	var action string
+	switch {
+	case eraseAll:
+		action = fmt.Sprintf("all the expressions of the group are replaced by %d new expressions", inserted)
+	case eraseOld:
+		action = fmt.Sprintf("%d new expressions are added to the group, and %v_%v is removed", inserted, node.TP(), node.ID())
+	default:
+		action = fmt.Sprintf("%d new expressions are added to the group", inserted)
+	}
+	tracer.AppendRuleTracerStepToCurrent(node.ID(), node.TP(), reason, action)
+}
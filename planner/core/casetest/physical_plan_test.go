@@ -724,6 +724,58 @@ func TestMPPHints(t *testing.T) {
 	}
 }
 
+func TestOperatorLocationHints(t *testing.T) {
+	store := testkit.CreateMockStore(t, internal.WithMockTiFlash(2))
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set tidb_cost_model_version=2")
+	tk.MustExec("create table t (a int, b int, c int, index idx_a(a))")
+	tk.MustExec("alter table t set tiflash replica 1")
+	tk.MustExec("set @@session.tidb_allow_mpp=ON")
+	tb := external.GetTableByName(t, tk, "test", "t")
+	err := domain.GetDomain(tk.Session()).DDL().UpdateTableReplicaInfo(tk.Session(), tb.Meta().ID, true)
+	require.NoError(t, err)
+
+	// opTasks returns the tasks of the operators whose IDs contain op in the plan of sql.
+	opTasks := func(sql, op string) []string {
+		var tasks []string
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), op) {
+				tasks = append(tasks, row[2].(string))
+			}
+		}
+		require.NotEmpty(t, tasks, sql)
+		return tasks
+	}
+	checkWarning := func(sql, warning string) {
+		tk.MustExec(sql)
+		warnings := testdata.ConvertSQLWarnToStrings(tk.Session().GetSessionVars().StmtCtx.GetWarnings())
+		require.Contains(t, warnings, warning, sql)
+	}
+
+	for _, task := range opTasks("select /*+ read_from_storage(tiflash[t]), agg_to_root() */ a, sum(b) from t group by a", "Agg") {
+		require.Equal(t, "root", task)
+	}
+	tasks := opTasks("select /*+ agg_to_mpp() */ a, sum(b) from t group by a", "Agg")
+	require.Contains(t, tasks, "mpp[tiflash]")
+	require.NotContains(t, tasks, "cop[tikv]")
+	for _, task := range opTasks("select /*+ limit_to_root() */ * from t limit 10", "Limit") {
+		require.Equal(t, "root", task)
+	}
+	for _, task := range opTasks("select /*+ read_from_storage(tiflash[t]), limit_to_root() */ * from t order by b limit 10", "TopN") {
+		require.Equal(t, "root", task)
+	}
+	require.Contains(t, opTasks("select /*+ read_from_storage(tiflash[t]), limit_to_mpp() */ * from t order by b limit 10", "TopN"), "mpp[tiflash]")
+
+	checkWarning("select /*+ agg_to_root(), agg_to_mpp() */ a, sum(b) from t group by a",
+		"Optimizer aggregation location hints are conflicted")
+	checkWarning("select /*+ limit_to_cop(), limit_to_root() */ * from t limit 10",
+		"Optimizer limit location hints are conflicted")
+	tk.MustExec("set @@session.tidb_allow_mpp=OFF")
+	checkWarning("select /*+ agg_to_mpp() */ a, sum(b) from t group by a", "Optimizer Hint AGG_TO_MPP is inapplicable")
+	checkWarning("select /*+ limit_to_mpp() */ * from t limit 10", "Optimizer Hint LIMIT_TO_MPP is inapplicable")
+}
+
 func TestMPPHintsScope(t *testing.T) {
 	store := testkit.CreateMockStore(t, internal.WithMockTiFlash(2))
 	tk := testkit.NewTestKit(t, store)
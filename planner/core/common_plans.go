@@ -48,6 +48,18 @@ type ShowDDL struct {
 	baseSchemaProducer
 }
 
+// ShowDDLDependencies is for showing the dependencies of the jobs in the DDL job queue.
+type ShowDDLDependencies struct {
+	baseSchemaProducer
+}
+
+// ExplainRollbackDDLJob is for estimating what rolling back a running DDL job entails.
+type ExplainRollbackDDLJob struct {
+	baseSchemaProducer
+
+	JobID int64
+}
+
 // ShowSlow is for showing slow queries.
 type ShowSlow struct {
 	baseSchemaProducer
@@ -103,6 +115,26 @@ type CleanupIndex struct {
 	IndexName string
 }
 
+// VerifyIndex is used to verify an index against the table data in a DDL job.
+type VerifyIndex struct {
+	baseSchemaProducer
+
+	Table     *ast.TableName
+	IndexName string
+	Rate      string
+	Repair    bool
+}
+
+// WorkloadSimulator is used to run a DDL under the simulated DML load against a table.
+type WorkloadSimulator struct {
+	baseSchemaProducer
+
+	Table       *ast.TableName
+	DDL         string
+	Concurrency int
+	Rate        int
+}
+
 // CheckIndexRange is used for checking index data, output the index values that handle within begin and end.
 type CheckIndexRange struct {
 	baseSchemaProducer
@@ -113,6 +145,14 @@ type CheckIndexRange struct {
 	HandleRanges []ast.HandleRange
 }
 
+// CheckGlobalIndex is used for checking the consistency between a global index and the records of the partitions.
+type CheckGlobalIndex struct {
+	baseSchemaProducer
+
+	Table     *ast.TableName
+	IndexName string
+}
+
 // ChecksumTable is used for calculating table checksum, built from the `admin checksum table` statement.
 type ChecksumTable struct {
 	baseSchemaProducer
@@ -137,6 +177,11 @@ type ReloadOptRuleBlacklist struct {
 	baseSchemaProducer
 }
 
+// ReloadQueryRewriteRules reloads the data from query_rewrite_rules table.
+type ReloadQueryRewriteRules struct {
+	baseSchemaProducer
+}
+
 // AdminPluginsAction indicate action will be taken on plugins.
 type AdminPluginsAction int
 
@@ -159,6 +204,11 @@ type AdminShowTelemetry struct {
 	baseSchemaProducer
 }
 
+// AdminShowUnusedIndexes displays the secondary indexes which haven't been read by any query.
+type AdminShowUnusedIndexes struct {
+	baseSchemaProducer
+}
+
 // AdminResetTelemetryID regenerates a new telemetry tracking ID.
 type AdminResetTelemetryID struct {
 	baseSchemaProducer
@@ -533,6 +583,14 @@ type AnalyzeIndexTask struct {
 	AnalyzeInfo
 }
 
+// AnalyzeExprStats is the histogram on an expression of the table in `ANALYZE TABLE ... UPDATE/DROP HISTOGRAM ON (expr)`.
+type AnalyzeExprStats struct {
+	TableID int64
+	Name    string
+	Expr    string
+	ColIDs  []int64
+}
+
 // Analyze represents an analyze plan
 type Analyze struct {
 	baseSchemaProducer
@@ -541,6 +599,9 @@ type Analyze struct {
 	IdxTasks   []AnalyzeIndexTask
 	Opts       map[ast.AnalyzeOptionType]uint64
 	OptionsMap map[int64]V2AnalyzeOptions
+	// ExprStats are the histograms on expressions which are registered or dropped before analyzing the tables.
+	ExprStats     []AnalyzeExprStats
+	DropExprStats bool
 }
 
 // LoadData represents a loaddata plan.
@@ -720,6 +781,10 @@ type ExplainInfoForEncode struct {
 	MemoryInfo          string                  `json:"memoryInfo,omitempty"`
 	DiskInfo            string                  `json:"diskInfo,omitempty"`
 	TotalMemoryConsumed string                  `json:"totalMemoryConsumed,omitempty"`
+	MaxMemoryBytes      int64                   `json:"maxMemoryBytes,omitempty"`
+	MaxDiskBytes        int64                   `json:"maxDiskBytes,omitempty"`
+	SpillRounds         int64                   `json:"spillRounds,omitempty"`
+	SpilledBytes        int64                   `json:"spilledBytes,omitempty"`
 	SubOperators        []*ExplainInfoForEncode `json:"subOperators,omitempty"`
 }
 
@@ -1092,6 +1157,18 @@ func (e *Explain) prepareOperatorInfoForJSONFormat(p Plan, taskType, id string,
 
 	if e.Analyze || e.RuntimeStatsColl != nil {
 		jsonRow.ActRows, jsonRow.ExecuteInfo, jsonRow.MemoryInfo, jsonRow.DiskInfo = getRuntimeInfoStr(e.ctx, p, e.RuntimeStatsColl)
+		rootStats, _, memTracker, diskTracker := getRuntimeInfo(e.ctx, p, e.RuntimeStatsColl)
+		if memTracker != nil {
+			jsonRow.MaxMemoryBytes = memTracker.MaxConsumed()
+		}
+		if diskTracker != nil {
+			jsonRow.MaxDiskBytes = diskTracker.MaxConsumed()
+		}
+		if rootStats != nil {
+			if spillStats := rootStats.GetSpillStats(); spillStats != nil {
+				jsonRow.SpillRounds, jsonRow.SpilledBytes = spillStats.Rounds, spillStats.Bytes
+			}
+		}
 	}
 	return jsonRow
 }
@@ -17,6 +17,7 @@ package core_test
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/domain"
@@ -79,3 +80,69 @@ func TestRowSizeInMPP(t *testing.T) {
 	}
 	require.True(t, costs[0] < costs[1] && costs[1] < costs[2]) // rowSize can affect the final cost
 }
+
+func TestMPPJoinSkew(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int)")
+	tk.MustExec("create table t3(a int, b int)")
+	// 61 of the 120 rows of t1 share the same join key.
+	for i := 0; i < 120; i++ {
+		a := i
+		if i <= 60 {
+			a = 0
+		}
+		tk.MustExec(fmt.Sprintf("insert into t1 values (%d, %d)", a, i))
+	}
+	for i := 0; i < 100; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t2 values (%d, %d)", i, i))
+	}
+	for i := 0; i < 10; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t3 values (%d, %d)", i, i))
+	}
+	tk.MustExec("analyze table t1, t2, t3")
+
+	// Create virtual tiflash replica info.
+	dom := domain.GetDomain(tk.Session())
+	is := dom.InfoSchema()
+	db, exists := is.SchemaByName(model.NewCIStr("test"))
+	require.True(t, exists)
+	for _, tblInfo := range db.Tables {
+		tblInfo.TiFlashReplica = &model.TiFlashReplicaInfo{
+			Count:     1,
+			Available: true,
+		}
+	}
+
+	tk.MustExec("set @@tidb_allow_mpp = 1, @@tidb_enforce_mpp = 1")
+	tk.MustExec("set @@tidb_broadcast_join_threshold_count = 0, @@tidb_broadcast_join_threshold_size = 0")
+	planContains := func(sql, substr string) bool {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(fmt.Sprint(row...), substr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	shuffle := "select /*+ read_from_storage(tiflash[t1, t2]) */ * from t1 join t2 on t1.a = t2.a"
+	broadcast := "select /*+ read_from_storage(tiflash[t1, t3]) */ * from t1 join t3 on t1.a = t3.a"
+	require.False(t, planContains(shuffle, "Expand"))
+	require.False(t, planContains(broadcast, "Broadcast"))
+
+	tk.MustExec("set @@tidb_opt_mpp_join_skew_ratio = 0.5")
+	// The build side t3 is small enough, broadcasting it is preferred.
+	require.True(t, planContains(broadcast, "Broadcast"))
+	require.False(t, planContains(broadcast, "Expand"))
+	// The build side t2 is too large to broadcast, the skewed join keys are salted instead.
+	require.False(t, planContains(shuffle, "Broadcast"))
+	require.True(t, planContains(shuffle, "Expand"))
+
+	tk.MustExec("set @@tidb_opt_mpp_join_skew_salt_num = 0")
+	require.False(t, planContains(shuffle, "Expand"))
+	// The join keys of the probe side t2 aren't skewed.
+	tk.MustExec("set @@tidb_opt_mpp_join_skew_salt_num = 8")
+	require.False(t, planContains("select /*+ read_from_storage(tiflash[t2, t]) */ * from t2 join t2 as t on t2.a = t.a", "Expand"))
+}
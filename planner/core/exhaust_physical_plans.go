@@ -52,12 +52,16 @@ func (p *LogicalUnionScan) exhaustPhysicalPlans(prop *property.PhysicalProperty)
 			"MPP mode may be blocked because operator `UnionScan` is not supported now.")
 		return nil, true, nil
 	}
-	childProp := prop.CloneEssentialFields()
-	us := PhysicalUnionScan{
+	us := p.GetPhysicalUnionScan(p.stats, prop.CloneEssentialFields())
+	return []PhysicalPlan{us}, true, nil
+}
+
+// GetPhysicalUnionScan is public for cascades planner.
+func (p *LogicalUnionScan) GetPhysicalUnionScan(stats *property.StatsInfo, childProp *property.PhysicalProperty) *PhysicalUnionScan {
+	return PhysicalUnionScan{
 		Conditions: p.conditions,
 		HandleCols: p.handleCols,
-	}.Init(p.ctx, p.stats, p.blockOffset, childProp)
-	return []PhysicalPlan{us}, true, nil
+	}.Init(p.ctx, stats, p.blockOffset, childProp)
 }
 
 func getMaxSortPrefix(sortCols, allCols []*expression.Column) []int {
@@ -704,23 +708,30 @@ func (p *LogicalJoin) constructIndexHashJoin(
 func (p *LogicalJoin) getIndexJoinByOuterIdx(prop *property.PhysicalProperty, outerIdx int) (joins []PhysicalPlan) {
 	outerChild, innerChild := p.children[outerIdx], p.children[1-outerIdx]
 	all, _ := prop.AllSameOrder()
-	// If the order by columns are not all from outer child, index join cannot promise the order.
-	if !prop.AllColsFromSchema(outerChild.Schema()) || !all {
+	if !all {
 		return nil
 	}
 	var (
 		innerJoinKeys []*expression.Column
 		outerJoinKeys []*expression.Column
+		isNullEQ      []bool
 	)
 	if outerIdx == 0 {
-		outerJoinKeys, innerJoinKeys, _, _ = p.GetJoinKeys()
+		outerJoinKeys, innerJoinKeys, isNullEQ, _ = p.GetJoinKeys()
 	} else {
-		innerJoinKeys, outerJoinKeys, _, _ = p.GetJoinKeys()
+		innerJoinKeys, outerJoinKeys, isNullEQ, _ = p.GetJoinKeys()
 	}
 	innerChildWrapper := p.extractIndexJoinInnerChildPattern(innerChild)
 	if innerChildWrapper == nil {
 		return nil
 	}
+	if !prop.AllColsFromSchema(outerChild.Schema()) {
+		prop = p.rewritePropForIndexJoinOuter(prop, outerChild.Schema(), innerJoinKeys, outerJoinKeys, isNullEQ, innerChildWrapper)
+		// If the order by columns are not all from outer child, index join cannot promise the order.
+		if prop == nil {
+			return nil
+		}
+	}
 
 	var avgInnerRowCnt float64
 	if outerChild.statsInfo().RowCount > 0 {
@@ -733,6 +744,72 @@ func (p *LogicalJoin) getIndexJoinByOuterIdx(prop *property.PhysicalProperty, ou
 	return p.buildIndexJoinInner2IndexScan(prop, innerChildWrapper, innerJoinKeys, outerJoinKeys, outerIdx, avgInnerRowCnt)
 }
 
+// rewritePropForIndexJoinOuter rewrites the sort items from the inner child to the outer child, so the index join can
+// satisfy the property by keeping the order of the outer child. For inner join, an inner column is replaced by the outer
+// join key it equals to, and an inner column equal to a constant is removed since it's the same in all the rows.
+// It returns nil if some sort items can't be rewritten.
+func (p *LogicalJoin) rewritePropForIndexJoinOuter(prop *property.PhysicalProperty, outerSchema *expression.Schema,
+	innerJoinKeys, outerJoinKeys []*expression.Column, isNullEQ []bool, innerChildWrapper *indexJoinInnerChildWrapper) *property.PhysicalProperty {
+	if p.JoinType != InnerJoin {
+		return nil
+	}
+	var innerConds []expression.Expression
+	// The columns of the projection may be different from the columns of the data source.
+	if innerChildWrapper.proj == nil {
+		innerConds = append(innerConds, innerChildWrapper.ds.pushedDownConds...)
+		if innerChildWrapper.sel != nil {
+			innerConds = append(innerConds, innerChildWrapper.sel.Conditions...)
+		}
+	}
+	constItems := expression.ExtractConstantEqColumnsOrScalar(p.ctx, nil, innerConds)
+	isConstCol := func(col *expression.Column) bool {
+		for _, item := range constItems {
+			if c, ok := item.(*expression.Column); ok && c.Equal(nil, col) {
+				return true
+			}
+		}
+		return false
+	}
+
+	sortItems := make([]property.SortItem, 0, len(prop.SortItems))
+	appendItem := func(item property.SortItem) {
+		for _, existing := range sortItems {
+			// The duplicated item doesn't change the order.
+			if existing.Col.Equal(nil, item.Col) {
+				return
+			}
+		}
+		sortItems = append(sortItems, item)
+	}
+	for _, item := range prop.SortItems {
+		if outerSchema.Contains(item.Col) {
+			appendItem(item)
+			continue
+		}
+		rewritten := false
+		for i, innerKey := range innerJoinKeys {
+			if !isNullEQ[i] && innerKey.Equal(nil, item.Col) && isSameOrderType(innerKey.RetType, outerJoinKeys[i].RetType) {
+				appendItem(property.SortItem{Col: outerJoinKeys[i], Desc: item.Desc})
+				rewritten = true
+				break
+			}
+		}
+		if !rewritten && !isConstCol(item.Col) {
+			return nil
+		}
+	}
+	newProp := prop.CloneEssentialFields()
+	newProp.CanAddEnforcer = prop.CanAddEnforcer
+	newProp.SortItems = sortItems
+	return newProp
+}
+
+// isSameOrderType checks whether the values of the two types which are equal to each other are sorted in the same order.
+func isSameOrderType(lhs, rhs *types.FieldType) bool {
+	return lhs.GetType() == rhs.GetType() && lhs.GetCollate() == rhs.GetCollate() &&
+		mysql.HasUnsignedFlag(lhs.GetFlag()) == mysql.HasUnsignedFlag(rhs.GetFlag())
+}
+
 type indexJoinInnerChildWrapper struct {
 	ds   *DataSource
 	us   *LogicalUnionScan
@@ -2153,6 +2230,11 @@ func (p *LogicalJoin) preferMppBCJ() bool {
 	if len(p.EqualConditions) == 0 && p.ctx.GetSessionVars().AllowCartesianBCJ == 2 {
 		return true
 	}
+	// A null key of the build side affects all the probe rows of the null-aware anti join, so every task
+	// needs the whole build side, only the broadcast join can do it.
+	if p.isNAAJ() {
+		return true
+	}
 
 	onlyCheckChild1 := p.JoinType == LeftOuterJoin || p.JoinType == SemiJoin || p.JoinType == AntiSemiJoin
 	onlyCheckChild0 := p.JoinType == RightOuterJoin
@@ -2188,6 +2270,89 @@ func (p *LogicalJoin) preferMppBCJ() bool {
 	return checkChildFitBC(p.children[0]) || checkChildFitBC(p.children[1])
 }
 
+// preferMppBCJForSkew checks whether the join keys of the probe side are skewed. The shuffle join partitions
+// the rows by the hash value of join keys, so all the rows of a heavy-hitter key are sent to the same TiFlash
+// node. In this case, we prefer the broadcast join if each node receives less rows by broadcasting the build side
+// than the node receiving the heavy-hitter key in the shuffle join.
+func (p *LogicalJoin) preferMppBCJForSkew() bool {
+	skewRatio := p.ctx.GetSessionVars().MPPJoinSkewRatio
+	if skewRatio <= 0 || len(p.EqualConditions) == 0 {
+		return false
+	}
+	// Keep the same build side as tryToGetMppHashJoin chooses for the broadcast join.
+	buildIdx := 1
+	switch p.JoinType {
+	case InnerJoin:
+		if p.children[0].statsInfo().Count() <= p.children[1].statsInfo().Count() {
+			buildIdx = 0
+		}
+	case RightOuterJoin:
+		buildIdx = 0
+	}
+	lKeys, rKeys, _, _ := p.GetJoinKeys()
+	probeKeys := lKeys
+	if buildIdx == 0 {
+		probeKeys = rKeys
+	}
+	probe, build := p.children[1-buildIdx], p.children[buildIdx]
+	ratio := getJoinKeysSkewRatio(probe, probeKeys)
+	if ratio < skewRatio {
+		return false
+	}
+	return build.statsInfo().RowCount < ratio*probe.statsInfo().RowCount
+}
+
+// getJoinKeysSkewRatio estimates the max ratio of rows that share the same join key value by the TopN of the
+// join key columns. The ratio of the composite join keys can't be larger than the ratio of any column in them,
+// so the minimum one is used. 0 is returned if there is no available statistics.
+func getJoinKeysSkewRatio(p LogicalPlan, keys []*expression.Column) float64 {
+	histColl := p.statsInfo().HistColl
+	if histColl == nil || histColl.Pseudo || len(keys) == 0 {
+		return 0
+	}
+	ratio := 1.0
+	for _, key := range keys {
+		colStats, ok := histColl.Columns[key.UniqueID]
+		if !ok || colStats.TopN.Num() == 0 {
+			return 0
+		}
+		total := colStats.TotalRowCount()
+		if total <= 0 {
+			return 0
+		}
+		colRatio := 0.0
+		for _, meta := range colStats.TopN.TopN {
+			colRatio = math.Max(colRatio, float64(meta.Count)/total)
+		}
+		ratio = math.Min(ratio, colRatio)
+	}
+	return ratio
+}
+
+// getMppJoinSkewSaltNum returns the number of salts to spread the rows of the skewed join keys of the probe side in
+// the shuffle join, 0 means the join keys don't need to be salted. Only the inner join is salted, because the build
+// side is replicated once for each salt, which would duplicate its unmatched rows in an outer join.
+func (p *LogicalJoin) getMppJoinSkewSaltNum(prop *property.PhysicalProperty, buildIdx int) int {
+	vars := p.ctx.GetSessionVars()
+	if vars.MPPJoinSkewRatio <= 0 || vars.MPPJoinSkewSaltNum <= 1 || p.JoinType != InnerJoin || len(p.EqualConditions) == 0 {
+		return 0
+	}
+	// The join result is partitioned by the join keys and the salt, it can't satisfy the hash partition required by
+	// the parent.
+	if prop.MPPPartitionTp == property.HashType {
+		return 0
+	}
+	lKeys, rKeys, _, _ := p.GetJoinKeys()
+	probeKeys := lKeys
+	if buildIdx == 0 {
+		probeKeys = rKeys
+	}
+	if getJoinKeysSkewRatio(p.children[1-buildIdx], probeKeys) < vars.MPPJoinSkewRatio {
+		return 0
+	}
+	return vars.MPPJoinSkewSaltNum
+}
+
 // LogicalJoin can generates hash join, index join and sort merge join.
 // Firstly we check the hint, if hint is figured by user, we force to choose the corresponding physical plan.
 // If the hint is not matched, it will get other candidates.
@@ -2229,7 +2394,7 @@ func (p *LogicalJoin) exhaustPhysicalPlans(prop *property.PhysicalProperty) ([]P
 				return bcastJoins, true, nil
 			}
 		}
-		if p.preferMppBCJ() {
+		if p.preferMppBCJ() || p.preferMppBCJForSkew() {
 			mppJoins := p.tryToGetMppHashJoin(prop, true)
 			joins = append(joins, mppJoins...)
 		} else {
@@ -2327,7 +2492,13 @@ func (p *LogicalJoin) tryToGetMppHashJoin(prop *property.PhysicalProperty, useBC
 		return nil
 	}
 
-	if len(p.EqualConditions) == 0 {
+	if p.isNAAJ() {
+		// The null-aware anti join is not a `Cartesian Product` though it has no equal conditions.
+		if !useBCJ {
+			p.SCtx().GetSessionVars().RaiseWarningWhenMPPEnforced("MPP mode may be blocked because `Null-aware anti join` is only supported by broadcast join.")
+			return nil
+		}
+	} else if len(p.EqualConditions) == 0 {
 		if !useBCJ {
 			p.SCtx().GetSessionVars().RaiseWarningWhenMPPEnforced("MPP mode may be blocked because `Cartesian Product` is only supported by broadcast join, check value and documents of variables `tidb_broadcast_join_threshold_size` and `tidb_broadcast_join_threshold_count`.")
 			return nil
@@ -2478,6 +2649,9 @@ func (p *LogicalJoin) tryToGetMppHashJoin(prop *property.PhysicalProperty, useBC
 		// Mpp Join has quite heavy cost. Even limit might not suspend it in time, so we don't scale the count.
 	}.Init(p.ctx, p.stats, p.blockOffset, childrenProps...)
 	join.SetSchema(p.schema)
+	if !useBCJ {
+		join.mppSkewSaltNum = p.getMppJoinSkewSaltNum(prop, preferredBuildIndex)
+	}
 	return []PhysicalPlan{join}
 }
 
@@ -2546,9 +2720,11 @@ func pushLimitOrTopNForcibly(p LogicalPlan) bool {
 	var preferPushDown *bool
 	switch lp := p.(type) {
 	case *LogicalTopN:
+		resetLimitHintsIfConflicted(lp.ctx, &lp.limitHints)
 		preferPushDown = &lp.limitHints.preferLimitToCop
 		meetThreshold = lp.Count+lp.Offset <= uint64(lp.ctx.GetSessionVars().LimitPushDownThreshold)
 	case *LogicalLimit:
+		resetLimitHintsIfConflicted(lp.ctx, &lp.limitHints)
 		preferPushDown = &lp.limitHints.preferLimitToCop
 		meetThreshold = true // always push Limit down in this case since it has no side effect
 	default:
@@ -2570,6 +2746,32 @@ func pushLimitOrTopNForcibly(p LogicalPlan) bool {
 	return false
 }
 
+// resetLimitHintsIfConflicted resets the LIMIT_TO_COP, LIMIT_TO_ROOT and LIMIT_TO_MPP hints if more than one of them
+// are specified.
+func resetLimitHintsIfConflicted(sctx sessionctx.Context, hints *limitHintInfo) {
+	if (hints.preferLimitToCop && hints.preferLimitToRoot) || (hints.preferLimitToCop && hints.preferLimitToMPP) ||
+		(hints.preferLimitToRoot && hints.preferLimitToMPP) {
+		sctx.GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("Optimizer limit location hints are conflicted"))
+		hints.preferLimitToCop, hints.preferLimitToRoot, hints.preferLimitToMPP = false, false, false
+	}
+}
+
+// limitTaskTypesByHint restricts the task types of the child of the limit or topN to the one where the LIMIT_TO_ROOT
+// or LIMIT_TO_MPP hint places the operator, which is TiDB or TiFlash MPP respectively.
+func limitTaskTypesByHint(p LogicalPlan, hints *limitHintInfo, taskTypes []property.TaskType) []property.TaskType {
+	if hints.preferLimitToMPP && !(p.SCtx().GetSessionVars().IsMPPAllowed() && p.canPushToCop(kv.TiFlash)) {
+		p.SCtx().GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("Optimizer Hint LIMIT_TO_MPP is inapplicable"))
+		hints.preferLimitToMPP = false
+	}
+	if hints.preferLimitToRoot {
+		return []property.TaskType{property.RootTaskType}
+	}
+	if hints.preferLimitToMPP {
+		return []property.TaskType{property.MppTaskType}
+	}
+	return taskTypes
+}
+
 func (lt *LogicalTopN) getPhysTopN(_ *property.PhysicalProperty) []PhysicalPlan {
 	allTaskTypes := []property.TaskType{property.CopSingleReadTaskType, property.CopMultiReadTaskType}
 	if !pushLimitOrTopNForcibly(lt) {
@@ -2578,6 +2780,7 @@ func (lt *LogicalTopN) getPhysTopN(_ *property.PhysicalProperty) []PhysicalPlan
 	if lt.ctx.GetSessionVars().IsMPPAllowed() {
 		allTaskTypes = append(allTaskTypes, property.MppTaskType)
 	}
+	allTaskTypes = limitTaskTypesByHint(lt, &lt.limitHints, allTaskTypes)
 	ret := make([]PhysicalPlan, 0, len(allTaskTypes))
 	for _, tp := range allTaskTypes {
 		resultProp := &property.PhysicalProperty{TaskTp: tp, ExpectedCnt: math.MaxFloat64}
@@ -2602,6 +2805,11 @@ func (lt *LogicalTopN) getPhysLimits(_ *property.PhysicalProperty) []PhysicalPla
 	if !pushLimitOrTopNForcibly(lt) {
 		allTaskTypes = append(allTaskTypes, property.RootTaskType)
 	}
+	// The MPP tasks don't keep the order, the topN is executed in MPP instead.
+	if lt.limitHints.preferLimitToMPP {
+		return nil
+	}
+	allTaskTypes = limitTaskTypesByHint(lt, &lt.limitHints, allTaskTypes)
 	ret := make([]PhysicalPlan, 0, len(allTaskTypes))
 	for _, tp := range allTaskTypes {
 		resultProp := &property.PhysicalProperty{TaskTp: tp, ExpectedCnt: float64(lt.Count + lt.Offset), SortItems: p.SortItems}
@@ -2935,6 +3143,9 @@ func (la *LogicalAggregation) getEnforcedStreamAggs(prop *property.PhysicalPrope
 	} else if !la.aggHints.preferAggToCop {
 		taskTypes = append(taskTypes, property.RootTaskType)
 	}
+	if la.aggHints.preferAggToRoot {
+		taskTypes = []property.TaskType{property.RootTaskType}
+	}
 	for _, taskTp := range taskTypes {
 		copiedChildProperty := new(property.PhysicalProperty)
 		*copiedChildProperty = *childProp // It's ok to not deep copy the "cols" field.
@@ -2963,9 +3174,88 @@ func (la *LogicalAggregation) distinctArgsMeetsProperty() bool {
 	return true
 }
 
+// tryToGetWindowFusedStreamAggs fuses the aggregation with the window below it if the group-by columns are exactly the
+// partition-by columns of the window, which is common in the sessionization queries. The output of the window is
+// clustered by the partitions, so the stream aggregation consumes it in a single streaming pass without sorting it
+// again or materializing it in the hash table.
+func (la *LogicalAggregation) tryToGetWindowFusedStreamAggs(prop *property.PhysicalProperty) []PhysicalPlan {
+	if prop.TaskTp != property.RootTaskType || len(la.GroupByItems) == 0 {
+		return nil
+	}
+	// Leave the plans which can be pushed down to TiFlash to the cost model, they're executed in MPP.
+	if la.ctx.GetSessionVars().IsMPPAllowed() && la.canPushToCop(kv.TiFlash) {
+		return nil
+	}
+	for _, aggFunc := range la.AggFuncs {
+		if aggFunc.Mode == aggregation.FinalMode {
+			return nil
+		}
+	}
+	groupByCols := la.GetGroupByCols()
+	if len(groupByCols) != len(la.GroupByItems) {
+		return nil
+	}
+	// cols are the columns of the child plans which the group-by columns come from.
+	cols := make([]*expression.Column, len(groupByCols))
+	copy(cols, groupByCols)
+	for child := la.children[0]; ; child = child.Children()[0] {
+		switch x := child.(type) {
+		case *LogicalSelection:
+		case *LogicalProjection:
+			for i, col := range cols {
+				idx := x.schema.ColumnIndex(col)
+				if idx < 0 {
+					return nil
+				}
+				childCol, ok := x.Exprs[idx].(*expression.Column)
+				if !ok {
+					return nil
+				}
+				cols[i] = childCol
+			}
+		case *LogicalWindow:
+			return la.getWindowFusedStreamAggs(prop, x, groupByCols, cols)
+		default:
+			return nil
+		}
+	}
+}
+
+func (la *LogicalAggregation) getWindowFusedStreamAggs(prop *property.PhysicalProperty, window *LogicalWindow, groupByCols, cols []*expression.Column) []PhysicalPlan {
+	if len(window.PartitionBy) != len(cols) {
+		return nil
+	}
+	// Order the group-by columns by the partition-by columns, so the required order is satisfied by the window.
+	sortCols := make([]*expression.Column, 0, len(groupByCols))
+	for _, item := range window.PartitionBy {
+		if item.Desc {
+			return nil
+		}
+		idx := slices.IndexFunc(cols, func(col *expression.Column) bool { return col.Equal(nil, item.Col) })
+		if idx < 0 {
+			return nil
+		}
+		sortCols = append(sortCols, groupByCols[idx])
+	}
+	childProp := &property.PhysicalProperty{
+		ExpectedCnt: math.Max(prop.ExpectedCnt*la.inputCount/la.stats.RowCount, prop.ExpectedCnt),
+		SortItems:   property.SortItemsFromCols(sortCols, false),
+		TaskTp:      property.RootTaskType,
+	}
+	if !prop.IsPrefix(childProp) {
+		return nil
+	}
+	agg := basePhysicalAgg{
+		GroupByItems: la.GroupByItems,
+		AggFuncs:     la.AggFuncs,
+	}.initForStream(la.ctx, la.stats.ScaleByExpectCnt(prop.ExpectedCnt), la.blockOffset, childProp)
+	agg.SetSchema(la.schema.Clone())
+	return []PhysicalPlan{agg}
+}
+
 func (la *LogicalAggregation) getStreamAggs(prop *property.PhysicalProperty) []PhysicalPlan {
 	// TODO: support CopTiFlash task type in stream agg
-	if prop.IsFlashProp() {
+	if prop.IsFlashProp() || la.aggHints.preferAggToMPP {
 		return nil
 	}
 	all, desc := prop.AllSameOrder()
@@ -3011,7 +3301,7 @@ func (la *LogicalAggregation) getStreamAggs(prop *property.PhysicalProperty) []P
 		} else if !la.aggHints.preferAggToCop {
 			taskTypes = append(taskTypes, property.RootTaskType)
 		}
-		if !la.canPushToCop(kv.TiKV) && !la.canPushToCop(kv.TiFlash) {
+		if (!la.canPushToCop(kv.TiKV) && !la.canPushToCop(kv.TiFlash)) || la.aggHints.preferAggToRoot {
 			taskTypes = []property.TaskType{property.RootTaskType}
 		}
 		for _, taskTp := range taskTypes {
@@ -3212,6 +3502,14 @@ func (la *LogicalAggregation) getHashAggs(prop *property.PhysicalProperty) []Phy
 	if prop.IsFlashProp() {
 		taskTypes = []property.TaskType{prop.TaskTp}
 	}
+	if la.aggHints.preferAggToRoot {
+		if prop.IsFlashProp() {
+			return nil
+		}
+		taskTypes = []property.TaskType{property.RootTaskType}
+	} else if la.aggHints.preferAggToMPP {
+		taskTypes = []property.TaskType{property.MppTaskType}
+	}
 
 	for _, taskTp := range taskTypes {
 		if taskTp == property.MppTaskType {
@@ -3243,7 +3541,23 @@ func (la *LogicalAggregation) ResetHintIfConflicted() (preferHash bool, preferSt
 	return
 }
 
+// resetLocationHintsIfConflicted resets the AGG_TO_COP, AGG_TO_ROOT and AGG_TO_MPP hints if more than one of them are
+// specified, and resets the AGG_TO_MPP hint if the aggregation can't be executed in MPP.
+func (la *LogicalAggregation) resetLocationHintsIfConflicted() {
+	hints := &la.aggHints
+	if (hints.preferAggToCop && hints.preferAggToRoot) || (hints.preferAggToCop && hints.preferAggToMPP) ||
+		(hints.preferAggToRoot && hints.preferAggToMPP) {
+		la.ctx.GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("Optimizer aggregation location hints are conflicted"))
+		hints.preferAggToCop, hints.preferAggToRoot, hints.preferAggToMPP = false, false, false
+	}
+	if hints.preferAggToMPP && !(la.ctx.GetSessionVars().IsMPPAllowed() && la.canPushToCop(kv.TiFlash) && la.checkCanPushDownToMPP()) {
+		la.ctx.GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("Optimizer Hint AGG_TO_MPP is inapplicable"))
+		hints.preferAggToMPP = false
+	}
+}
+
 func (la *LogicalAggregation) exhaustPhysicalPlans(prop *property.PhysicalProperty) ([]PhysicalPlan, bool, error) {
+	la.resetLocationHintsIfConflicted()
 	if la.aggHints.preferAggToCop {
 		if !la.canPushToCop(kv.TiKV) {
 			errMsg := "Optimizer Hint AGG_TO_COP is inapplicable"
@@ -3255,6 +3569,12 @@ func (la *LogicalAggregation) exhaustPhysicalPlans(prop *property.PhysicalProper
 
 	preferHash, preferStream := la.ResetHintIfConflicted()
 
+	if !preferHash && !la.aggHints.preferAggToCop && !la.aggHints.preferAggToMPP {
+		if fusedAggs := la.tryToGetWindowFusedStreamAggs(prop); fusedAggs != nil {
+			return fusedAggs, true, nil
+		}
+	}
+
 	hashAggs := la.getHashAggs(prop)
 	if hashAggs != nil && preferHash {
 		return hashAggs, true, nil
@@ -3273,7 +3593,7 @@ func (la *LogicalAggregation) exhaustPhysicalPlans(prop *property.PhysicalProper
 		la.ctx.GetSessionVars().StmtCtx.AppendWarning(warning)
 	}
 
-	return aggs, !(preferStream || preferHash), nil
+	return aggs, !(preferStream || preferHash || la.aggHints.preferAggToMPP), nil
 }
 
 func (p *LogicalSelection) exhaustPhysicalPlans(prop *property.PhysicalProperty) ([]PhysicalPlan, bool, error) {
@@ -3322,6 +3642,7 @@ func (p *LogicalLimit) exhaustPhysicalPlans(prop *property.PhysicalProperty) ([]
 	if p.canPushToCop(kv.TiFlash) && p.ctx.GetSessionVars().IsMPPAllowed() {
 		allTaskTypes = append(allTaskTypes, property.MppTaskType)
 	}
+	allTaskTypes = limitTaskTypesByHint(p, &p.limitHints, allTaskTypes)
 	ret := make([]PhysicalPlan, 0, len(allTaskTypes))
 	for _, tp := range allTaskTypes {
 		resultProp := &property.PhysicalProperty{TaskTp: tp, ExpectedCnt: float64(p.Count + p.Offset)}
@@ -3342,20 +3663,27 @@ func (p *LogicalLock) exhaustPhysicalPlans(prop *property.PhysicalProperty) ([]P
 			"MPP mode may be blocked because operator `Lock` is not supported now.")
 		return nil, true, nil
 	}
-	childProp := prop.CloneEssentialFields()
-	lock := PhysicalLock{
+	lock := p.GetPhysicalLock(p.stats.ScaleByExpectCnt(prop.ExpectedCnt), prop.CloneEssentialFields())
+	return []PhysicalPlan{lock}, true, nil
+}
+
+// GetPhysicalLock is public for cascades planner.
+func (p *LogicalLock) GetPhysicalLock(stats *property.StatsInfo, childProp *property.PhysicalProperty) *PhysicalLock {
+	return PhysicalLock{
 		Lock:               p.Lock,
 		TblID2Handle:       p.tblID2Handle,
 		TblID2PhysTblIDCol: p.tblID2PhysTblIDCol,
-	}.Init(p.ctx, p.stats.ScaleByExpectCnt(prop.ExpectedCnt), childProp)
-	return []PhysicalPlan{lock}, true, nil
+	}.Init(p.ctx, stats, childProp)
 }
 
 func (p *LogicalUnionAll) exhaustPhysicalPlans(prop *property.PhysicalProperty) ([]PhysicalPlan, bool, error) {
-	// TODO: UnionAll can not pass any order, but we can change it to sort merge to keep order.
-	if !prop.IsSortItemEmpty() || (prop.IsFlashProp() && prop.TaskTp != property.MppTaskType) {
+	if prop.IsFlashProp() && prop.TaskTp != property.MppTaskType {
 		return nil, true, nil
 	}
+	// UnionAll can not pass any order, but the ordered children can be merged to keep the order.
+	if !prop.IsSortItemEmpty() {
+		return p.getMergeUnionAll(prop), true, nil
+	}
 	// TODO: UnionAll can pass partition info, but for briefness, we prevent it from pushing down.
 	if prop.TaskTp == property.MppTaskType && prop.MPPPartitionTp != property.AnyType {
 		return nil, true, nil
@@ -3393,6 +3721,39 @@ func (p *LogicalUnionAll) exhaustPhysicalPlans(prop *property.PhysicalProperty)
 	return []PhysicalPlan{ua}, true, nil
 }
 
+// getMergeUnionAll returns the union all which merges the children ordered by the sort items of the prop. The limit
+// above it reads the children in the order, so it stops reading them once it has enough rows rather than reading all
+// the rows of every child.
+func (p *LogicalUnionAll) getMergeUnionAll(prop *property.PhysicalProperty) []PhysicalPlan {
+	if prop.TaskTp != property.RootTaskType {
+		return nil
+	}
+	offsets := make([]int, 0, len(prop.SortItems))
+	for _, item := range prop.SortItems {
+		offset := p.schema.ColumnIndex(item.Col)
+		if offset < 0 {
+			return nil
+		}
+		offsets = append(offsets, offset)
+	}
+	chReqProps := make([]*property.PhysicalProperty, 0, len(p.children))
+	for _, child := range p.children {
+		// The children output the columns in the same order as the union all.
+		sortItems := make([]property.SortItem, 0, len(offsets))
+		for i, offset := range offsets {
+			sortItems = append(sortItems, property.SortItem{Col: child.Schema().Columns[offset], Desc: prop.SortItems[i].Desc})
+		}
+		chReqProps = append(chReqProps, &property.PhysicalProperty{ExpectedCnt: prop.ExpectedCnt, SortItems: sortItems})
+	}
+	sortItems := make([]property.SortItem, 0, len(prop.SortItems))
+	for _, item := range prop.SortItems {
+		sortItems = append(sortItems, item.Clone())
+	}
+	ua := PhysicalUnionAll{SortItems: sortItems}.Init(p.ctx, p.stats.ScaleByExpectCnt(prop.ExpectedCnt), p.blockOffset, chReqProps...)
+	ua.SetSchema(p.Schema())
+	return []PhysicalPlan{ua}
+}
+
 func (p *LogicalPartitionUnionAll) exhaustPhysicalPlans(prop *property.PhysicalProperty) ([]PhysicalPlan, bool, error) {
 	uas, flagHint, err := p.LogicalUnionAll.exhaustPhysicalPlans(prop)
 	if err != nil {
@@ -3404,6 +3765,18 @@ func (p *LogicalPartitionUnionAll) exhaustPhysicalPlans(prop *property.PhysicalP
 	return uas, flagHint, nil
 }
 
+// GetPhysicalUnionAll is public for cascades planner.
+func (p *LogicalUnionAll) GetPhysicalUnionAll(stats *property.StatsInfo, childProps ...*property.PhysicalProperty) *PhysicalUnionAll {
+	return PhysicalUnionAll{}.Init(p.ctx, stats, p.blockOffset, childProps...)
+}
+
+// GetPhysicalUnionAll is public for cascades planner, the union of the partitions is explained as PartitionUnion.
+func (p *LogicalPartitionUnionAll) GetPhysicalUnionAll(stats *property.StatsInfo, childProps ...*property.PhysicalProperty) *PhysicalUnionAll {
+	ua := p.LogicalUnionAll.GetPhysicalUnionAll(stats, childProps...)
+	ua.tp = plancodec.TypePartitionUnion
+	return ua
+}
+
 func (ls *LogicalSort) getPhysicalSort(prop *property.PhysicalProperty) *PhysicalSort {
 	ps := PhysicalSort{ByItems: ls.ByItems}.Init(ls.ctx, ls.stats.ScaleByExpectCnt(prop.ExpectedCnt), ls.blockOffset, &property.PhysicalProperty{TaskTp: prop.TaskTp, ExpectedCnt: math.MaxFloat64, RejectSort: true})
 	return ps
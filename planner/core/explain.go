@@ -113,6 +113,19 @@ func (p *PhysicalIndexScan) OperatorInfo(normalized bool) string {
 	if p.Desc {
 		buffer.WriteString(", desc")
 	}
+	if p.SkipScanPrefixLen > 0 {
+		buffer.WriteString(", skip scan prefix:")
+		buffer.WriteString(strconv.Itoa(p.SkipScanPrefixLen))
+		if len(p.SkipScanRanges) > 0 && !normalized {
+			buffer.WriteString(", skip scan range:")
+			for i, idxRange := range p.SkipScanRanges {
+				if i > 0 {
+					buffer.WriteString(", ")
+				}
+				buffer.WriteString(idxRange.String())
+			}
+		}
+	}
 	if p.stats.StatsVersion == statistics.PseudoVersion && !normalized {
 		buffer.WriteString(", stats:pseudo")
 	}
@@ -344,6 +357,20 @@ func (p *PhysicalProjection) ExplainNormalizedInfo() string {
 	return string(expression.SortedExplainNormalizedExpressionList(p.Exprs))
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalTableFunction) ExplainInfo() string {
+	args := make([]string, 0, len(p.Args))
+	for _, arg := range p.Args {
+		args = append(args, arg.ExplainInfo())
+	}
+	return fmt.Sprintf("%s(%s)", p.FuncName.L, strings.Join(args, ", "))
+}
+
+// ExplainNormalizedInfo implements Plan interface.
+func (p *PhysicalTableFunction) ExplainNormalizedInfo() string {
+	return p.FuncName.L + "(...)"
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalTableDual) ExplainInfo() string {
 	var str strings.Builder
@@ -582,6 +609,21 @@ func (p *PhysicalHashJoin) explainInfo(normalized bool) string {
 	if p.TiFlashFineGrainedShuffleStreamCount > 0 {
 		buffer.WriteString(fmt.Sprintf(", stream_count: %d", p.TiFlashFineGrainedShuffleStreamCount))
 	}
+	if p.PartitionWise {
+		buffer.WriteString(", partition-wise")
+	}
+	if p.RuntimeFilter != nil {
+		buffer.WriteString(", runtime filter:")
+		buffer.WriteString(p.RuntimeFilter.ProbeCol.ExplainInfo())
+	}
+	if p.AdaptiveIndexJoin != nil {
+		buffer.WriteString(", adaptive:")
+		if normalized {
+			buffer.WriteString(p.AdaptiveIndexJoin.TP())
+		} else {
+			buffer.WriteString(p.AdaptiveIndexJoin.ExplainID().String())
+		}
+	}
 	return buffer.String()
 }
 
@@ -646,6 +688,37 @@ func explainPartitionBy(buffer *bytes.Buffer, partitionBy []property.SortItem, n
 	return buffer
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalUnionAll) ExplainInfo() string {
+	return p.explainInfo(false)
+}
+
+// ExplainNormalizedInfo implements Plan interface.
+func (p *PhysicalUnionAll) ExplainNormalizedInfo() string {
+	return p.explainInfo(true)
+}
+
+func (p *PhysicalUnionAll) explainInfo(normalized bool) string {
+	if len(p.SortItems) == 0 {
+		return ""
+	}
+	buffer := bytes.NewBufferString("merge by:")
+	for i, item := range p.SortItems {
+		if normalized {
+			buffer.WriteString(item.Col.ExplainNormalizedInfo())
+		} else {
+			buffer.WriteString(item.Col.ExplainInfo())
+		}
+		if item.Desc {
+			buffer.WriteString(":desc")
+		}
+		if i+1 < len(p.SortItems) {
+			buffer.WriteString(", ")
+		}
+	}
+	return buffer.String()
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalTopN) ExplainInfo() string {
 	buffer := bytes.NewBufferString("")
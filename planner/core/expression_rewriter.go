@@ -1231,6 +1231,8 @@ func (er *expressionRewriter) Leave(originInNode ast.Node) (retNode ast.Node, ok
 		}
 	case *ast.PositionExpr:
 		er.positionToScalarFunc(v)
+	case *ast.MatchAgainst:
+		er.matchAgainstToScalarFunc(v)
 	case *ast.IsNullExpr:
 		er.isNullToExpression(v)
 	case *ast.IsTruthExpr:
@@ -1495,6 +1497,82 @@ func (er *expressionRewriter) isNullToExpression(v *ast.IsNullExpr) {
 	er.ctxStackAppend(function, types.EmptyName)
 }
 
+// matchAgainstToScalarFunc rewrites MATCH ... AGAINST to the match_against function. The matched
+// columns must be exactly the columns of a fulltext index of the table.
+func (er *expressionRewriter) matchAgainstToScalarFunc(v *ast.MatchAgainst) {
+	if v.Modifier.WithQueryExpansion() {
+		er.err = ErrNotSupportedYet.GenWithStackByArgs("MATCH ... AGAINST WITH QUERY EXPANSION")
+		return
+	}
+	stkLen := len(er.ctxStack)
+	colCnt := len(v.ColumnNames)
+	cols, names := er.ctxStack[stkLen-colCnt-1:stkLen-1], er.ctxNameStk[stkLen-colCnt-1:stkLen-1]
+	against := er.ctxStack[stkLen-1]
+	if _, ok := against.(*expression.Constant); !ok {
+		er.err = ErrWrongArguments.GenWithStackByArgs("AGAINST")
+		return
+	}
+	if !er.hasMatchingFulltextIndex(cols, names) {
+		er.err = ErrFtMatchingKeyNotFound
+		return
+	}
+	args := make([]expression.Expression, 0, colCnt+2)
+	args = append(args, &expression.Constant{
+		Value:   types.NewIntDatum(int64(v.Modifier)),
+		RetType: types.NewFieldType(mysql.TypeLonglong),
+	}, against)
+	args = append(args, cols...)
+	function, err := er.newFunction(ast.MatchAgainstFunc, types.NewFieldType(mysql.TypeDouble), args...)
+	if err != nil {
+		er.err = err
+		return
+	}
+	er.ctxStackPop(colCnt + 1)
+	er.ctxStackAppend(function, types.EmptyName)
+}
+
+// hasMatchingFulltextIndex checks whether the columns belong to the same table and are exactly
+// the columns of a public fulltext index of it.
+func (er *expressionRewriter) hasMatchingFulltextIndex(cols []expression.Expression, names []*types.FieldName) bool {
+	if er.b == nil || er.b.is == nil {
+		return false
+	}
+	colNames := make(map[string]struct{}, len(names))
+	for i, name := range names {
+		if _, ok := cols[i].(*expression.Column); !ok || name.OrigTblName.L == "" {
+			return false
+		}
+		if name.DBName.L != names[0].DBName.L || name.OrigTblName.L != names[0].OrigTblName.L {
+			return false
+		}
+		colNames[name.OrigColName.L] = struct{}{}
+	}
+	dbName := names[0].DBName
+	if dbName.L == "" {
+		dbName = model.NewCIStr(er.sctx.GetSessionVars().CurrentDB)
+	}
+	tbl, err := er.b.is.TableByName(dbName, names[0].OrigTblName)
+	if err != nil {
+		return false
+	}
+	for _, idx := range tbl.Meta().Indices {
+		if idx.Tp != model.IndexTypeFulltext || idx.State != model.StatePublic || len(idx.Columns) != len(colNames) {
+			continue
+		}
+		matched := true
+		for _, idxCol := range idx.Columns {
+			if _, ok := colNames[idxCol.Name.L]; !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (er *expressionRewriter) positionToScalarFunc(v *ast.PositionExpr) {
 	pos := v.N
 	str := strconv.Itoa(pos)
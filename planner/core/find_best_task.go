@@ -173,6 +173,16 @@ func (p *LogicalShowDDLJobs) findBestTask(prop *property.PhysicalProperty, planC
 	return &rootTask{p: pShow}, 1, nil
 }
 
+func (p *LogicalTableFunction) findBestTask(prop *property.PhysicalProperty, planCounter *PlanCounterTp, _ *physicalOptimizeOp) (task, int64, error) {
+	if !prop.IsSortItemEmpty() || planCounter.Empty() {
+		return invalidTask, 0, nil
+	}
+	pTableFunc := PhysicalTableFunction{FuncName: p.FuncName, Args: p.Args}.Init(p.ctx, p.stats, p.blockOffset)
+	pTableFunc.SetSchema(p.schema)
+	planCounter.Dec(1)
+	return &rootTask{p: pTableFunc}, 1, nil
+}
+
 // rebuildChildTasks rebuilds the childTasks to make the clock_th combination.
 func (p *baseLogicalPlan) rebuildChildTasks(childTasks *[]task, pp PhysicalPlan, childCnts []int64, planCounter int64, ts uint64, opt *physicalOptimizeOp) error {
 	// The taskMap of children nodes should be rolled back first.
@@ -212,6 +222,9 @@ func (p *baseLogicalPlan) enumeratePhysicalPlans4Task(physicalPlans []PhysicalPl
 	childTasks := make([]task, 0, len(p.children))
 	childCnts := make([]int64, len(p.children))
 	cntPlan = 0
+	// bestIndexJoinTask is the best index join, which is the alternative of the best hash join for adaptive join.
+	var bestIndexJoinTask task = invalidTask
+	adaptive := p.canSwitchJoinAtRuntime(prop, planCounter)
 	for _, pp := range physicalPlans {
 		// Find best child tasks firstly.
 		childTasks = childTasks[:0]
@@ -285,10 +298,111 @@ func (p *baseLogicalPlan) enumeratePhysicalPlans4Task(physicalPlans []PhysicalPl
 		} else if curIsBetter {
 			bestTask = curTask
 		}
+		if adaptive && isAdaptiveIndexJoinTask(curTask) {
+			if curIsBetter, err := compareTaskCost(p.ctx, curTask, bestIndexJoinTask, opt); err != nil {
+				return nil, 0, err
+			} else if curIsBetter {
+				bestIndexJoinTask = curTask
+			}
+		}
+	}
+	if adaptive {
+		attachAdaptiveIndexJoin(bestTask, bestIndexJoinTask)
 	}
 	return bestTask, cntPlan, nil
 }
 
+// canSwitchJoinAtRuntime returns whether the best hash join of the join can switch to the best index join during the
+// execution. The plans chosen by the hints or nth_plan() are never switched, and the cached plans aren't switched
+// because the ranges of the index join aren't rebuilt with the parameters.
+func (p *baseLogicalPlan) canSwitchJoinAtRuntime(prop *property.PhysicalProperty, planCounter *PlanCounterTp) bool {
+	if _, ok := p.self.(*LogicalJoin); !ok {
+		return false
+	}
+	sessVars := p.ctx.GetSessionVars()
+	return sessVars.EnableAdaptiveJoin && !sessVars.StmtCtx.UseCache && !planCounter.IsForce() &&
+		prop.TaskTp == property.RootTaskType && prop.IsSortItemEmpty()
+}
+
+// isAdaptiveIndexJoinTask returns whether the task is an index join which the hash join can switch to, the index
+// merge join is excluded since the hash join doesn't keep the order of the outer side.
+func isAdaptiveIndexJoinTask(t task) bool {
+	if _, ok := t.(*rootTask); !ok {
+		return false
+	}
+	switch t.plan().(type) {
+	case *PhysicalIndexJoin, *PhysicalIndexHashJoin:
+		return true
+	}
+	return false
+}
+
+// attachAdaptiveIndexJoin attaches the best index join to the best hash join as its alternative, if the build side of
+// the hash join is the inner side of the index join. The executor switches to the index join when the build side has
+// far more rows than estimated, which usually means the hash join is chosen by a wrong estimation. Only the root hash
+// joins switch, the MPP joins aren't switched to the shuffle joins since their fragments are dispatched before any rows
+// are read.
+func attachAdaptiveIndexJoin(hashJoinTask, indexJoinTask task) {
+	if hashJoinTask.invalid() || indexJoinTask.invalid() {
+		return
+	}
+	if _, ok := hashJoinTask.(*rootTask); !ok {
+		return
+	}
+	hashJoin, ok := hashJoinTask.plan().(*PhysicalHashJoin)
+	if !ok || hashJoin.UseOuterToBuild || len(hashJoin.NAEqualConditions) > 0 {
+		return
+	}
+	var innerChildIdx int
+	switch indexJoin := indexJoinTask.plan().(type) {
+	case *PhysicalIndexJoin:
+		innerChildIdx = indexJoin.InnerChildIdx
+	case *PhysicalIndexHashJoin:
+		innerChildIdx = indexJoin.InnerChildIdx
+	}
+	if innerChildIdx != hashJoin.InnerChildIdx {
+		return
+	}
+	// The outer side of the index join is the same plan as the probe side of the hash join. It's cloned with new plan
+	// IDs, so the two sides are post-optimized, resolved and registered in the runtime stats separately. The join
+	// doesn't switch if the outer side can't be cloned.
+	indexJoin := indexJoinTask.plan()
+	outerChildIdx := 1 - innerChildIdx
+	outer, err := indexJoin.Children()[outerChildIdx].Clone()
+	if err != nil {
+		return
+	}
+	renewPlanIDs(outer, make(map[int]int))
+	indexJoin.SetChild(outerChildIdx, outer)
+	hashJoin.AdaptiveIndexJoin = indexJoin
+}
+
+// renewPlanIDs allocates new IDs for the cloned plans. The plans cloned from the same plan, like the pushed down plans
+// of a reader which are cloned as both a tree and a flattened list, get the same new ID.
+func renewPlanIDs(p PhysicalPlan, newIDs map[int]int) {
+	id, ok := newIDs[p.ID()]
+	if !ok {
+		id = int(p.SCtx().GetSessionVars().PlanID.Add(1))
+		newIDs[p.ID()] = id
+	}
+	p.(interface{ setID(int) }).setID(id)
+	var pushedDown []PhysicalPlan
+	switch x := p.(type) {
+	case *PhysicalTableReader:
+		pushedDown = append([]PhysicalPlan{x.tablePlan}, x.TablePlans...)
+	case *PhysicalIndexReader:
+		pushedDown = append([]PhysicalPlan{x.indexPlan}, x.IndexPlans...)
+	case *PhysicalIndexLookUpReader:
+		pushedDown = append([]PhysicalPlan{x.indexPlan, x.tablePlan}, x.IndexPlans...)
+		pushedDown = append(pushedDown, x.TablePlans...)
+	}
+	for _, child := range append(pushedDown, p.Children()...) {
+		if child != nil {
+			renewPlanIDs(child, newIDs)
+		}
+	}
+}
+
 // compareTaskCost compares cost of curTask and bestTask and returns whether curTask's cost is smaller than bestTask's.
 func compareTaskCost(_ sessionctx.Context, curTask, bestTask task, op *physicalOptimizeOp) (curIsBetter bool, err error) {
 	curCost, curInvalid, err := getTaskPlanCost(curTask, op)
@@ -1891,6 +2005,9 @@ func getMostCorrCol4Index(path *util.AccessPath, histColl *statistics.Table, thr
 		colSet.Insert(col.UniqueID)
 		curCorr := float64(0)
 		for _, item := range histColl.ExtendedStats.Stats {
+			if item.Tp != ast.StatsTypeCorrelation {
+				continue
+			}
 			if (col.ID == item.ColIDs[0] && path.FullIdxCols[0].ID == item.ColIDs[1]) ||
 				(col.ID == item.ColIDs[1] && path.FullIdxCols[0].ID == item.ColIDs[0]) {
 				curCorr = item.ScalarVals
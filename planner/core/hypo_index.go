@@ -0,0 +1,128 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/planner/util"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// hypoIndexNamePrefix is the prefix of the names of the hypothetical indexes.
+const hypoIndexNamePrefix = "hypo_"
+
+// NewHypoIndex returns the hypothetical index on the columns of the table, others are the hypothetical indexes of
+// the table built before. The index isn't created, it's only considered by the optimizer when it's in
+// StatementContext.HypoIndexes. It has no stats, so its row count is estimated by the stats of its columns.
+func NewHypoIndex(tblInfo *model.TableInfo, others []*model.IndexInfo, parts []*ast.IndexPartSpecification) (*model.IndexInfo, error) {
+	cols := make([]*model.IndexColumn, 0, len(parts))
+	names := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part.Expr != nil {
+			return nil, ErrNotSupportedYet.GenWithStackByArgs("hypothetical index on expressions")
+		}
+		col := model.FindColumnInfo(tblInfo.Columns, part.Column.Name.L)
+		if col == nil || col.State != model.StatePublic {
+			return nil, ErrUnknownColumn.GenWithStackByArgs(part.Column.Name.O, "hypothetical index")
+		}
+		cols = append(cols, &model.IndexColumn{Name: col.Name, Offset: col.Offset, Length: part.Length})
+		names = append(names, col.Name.L)
+	}
+	name := hypoIndexNamePrefix + strings.Join(names, "_")
+	for i := 2; tblInfo.FindIndexByName(name) != nil || findHypoIndexByName(others, name) != nil; i++ {
+		name = fmt.Sprintf("%s%s_%d", hypoIndexNamePrefix, strings.Join(names, "_"), i)
+	}
+	return &model.IndexInfo{
+		// The ID doesn't conflict with the existing indexes or the other hypothetical indexes of the table.
+		ID:      tblInfo.MaxIndexID + int64(len(others)) + 1,
+		Name:    model.NewCIStr(name),
+		Table:   tblInfo.Name,
+		Columns: cols,
+		State:   model.StatePublic,
+		Tp:      model.IndexTypeBtree,
+	}, nil
+}
+
+func findHypoIndexByName(indexes []*model.IndexInfo, name string) *model.IndexInfo {
+	for _, idx := range indexes {
+		if idx.Name.L == name {
+			return idx
+		}
+	}
+	return nil
+}
+
+// buildHypoIndexes builds the hypothetical indexes of `EXPLAIN ... WITH HYPOTHETICAL INDEX` by the IDs of their
+// tables. The user needs the SELECT privilege on the tables, even if they are not read by the explained statement.
+func (b *PlanBuilder) buildHypoIndexes(hypoIndexes []*ast.HypotheticalIndex) (map[int64][]*model.IndexInfo, error) {
+	indexes := make(map[int64][]*model.IndexInfo, len(hypoIndexes))
+	for _, hypo := range hypoIndexes {
+		dbName := hypo.Table.Schema
+		if dbName.L == "" {
+			dbName = model.NewCIStr(b.ctx.GetSessionVars().CurrentDB)
+		}
+		var authErr error
+		if user := b.ctx.GetSessionVars().User; user != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("SELECT", user.AuthUsername, user.AuthHostname, hypo.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SelectPriv, dbName.L, hypo.Table.Name.L, "", authErr)
+		tbl, err := b.is.TableByName(dbName, hypo.Table.Name)
+		if err != nil {
+			return nil, err
+		}
+		tblInfo := tbl.Meta()
+		if tblInfo.IsView() || tblInfo.IsSequence() {
+			return nil, ErrNotSupportedYet.GenWithStackByArgs("hypothetical index on views or sequences")
+		}
+		idx, err := NewHypoIndex(tblInfo, indexes[tblInfo.ID], hypo.IndexPartSpecifications)
+		if err != nil {
+			return nil, err
+		}
+		indexes[tblInfo.ID] = append(indexes[tblInfo.ID], idx)
+	}
+	return indexes, nil
+}
+
+// isHypoIndex returns whether the index is a hypothetical index of the table in the statement.
+func isHypoIndex(sctx sessionctx.Context, tblID int64, index *model.IndexInfo) bool {
+	for _, idx := range sctx.GetSessionVars().StmtCtx.HypoIndexes[tblID] {
+		if idx.ID == index.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// hypoIndexRowCount estimates the row count of the ranges of the hypothetical index, which has no stats, by the
+// selectivity of the access conditions on the stats of its columns.
+func (ds *DataSource) hypoIndexRowCount(path *util.AccessPath) float64 {
+	count := float64(ds.statisticTable.Count)
+	if len(path.AccessConds) == 0 {
+		return count
+	}
+	selectivity, _, err := ds.tableStats.HistColl.Selectivity(ds.ctx, path.AccessConds, nil)
+	if err != nil {
+		logutil.BgLogger().Debug("calculate selectivity failed, use selection factor", zap.Error(err))
+		selectivity = SelectionFactor
+	}
+	return count * selectivity
+}
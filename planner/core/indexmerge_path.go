@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/fulltext"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/ranger"
 	"go.uber.org/zap"
@@ -74,6 +75,9 @@ func (ds *DataSource) generateIndexMergePath() error {
 	if err := ds.generateIndexMerge4MVIndex(regularPathCount, indexMergeConds); err != nil {
 		return err
 	}
+	if err := ds.generateIndexMerge4FulltextIndex(indexMergeConds); err != nil {
+		return err
+	}
 
 	// If without hints, it means that `enableIndexMerge` is true
 	if len(ds.indexMergeHints) == 0 {
@@ -921,3 +925,144 @@ func unwrapJSONCast(expr expression.Expression) (expression.Expression, bool) {
 func isMVIndexPath(path *util.AccessPath) bool {
 	return !path.IsTablePath() && path.Index != nil && path.Index.MVIndex
 }
+
+// generateIndexMerge4FulltextIndex generates the IndexMerge paths reading the FULLTEXT indexes for the
+// MATCH ... AGAINST filters. The entries of a FULLTEXT index are the tokens of the indexed columns, so the
+// rows which may match the query are located by the tokens of the query, and the MATCH filter is still
+// evaluated on these rows. For example, `match(a) against('+mysql +tidb' in boolean mode)` generates:
+/*
+	IndexMerge(intersection)
+		IndexRangeScan(ft_a, ["mysql","mysql"])
+		IndexRangeScan(ft_a, ["tidb","tidb"])
+		TableRowIdScan(t)
+*/
+func (ds *DataSource) generateIndexMerge4FulltextIndex(filters []expression.Expression) error {
+	for _, filter := range filters {
+		sf, ok := filter.(*expression.ScalarFunction)
+		if !ok || sf.FuncName.L != ast.MatchAgainstFunc {
+			continue
+		}
+		args := sf.GetArgs()
+		idx := ds.findFulltextIndex(args[2:])
+		if idx == nil {
+			continue
+		}
+		modifier, ok1 := args[0].(*expression.Constant)
+		against, ok2 := args[1].(*expression.Constant)
+		if !ok1 || !ok2 {
+			continue
+		}
+		mode, isNull, err := modifier.EvalInt(ds.ctx, chunk.Row{})
+		if err != nil {
+			return err
+		}
+		if isNull {
+			continue
+		}
+		query, isNull, err := against.EvalString(ds.ctx, chunk.Row{})
+		if err != nil {
+			return err
+		}
+		if isNull {
+			continue
+		}
+		tokens, isIntersection, ok := fulltext.AccessTokens(query, ast.FulltextSearchModifier(mode).IsBooleanMode())
+		if !ok {
+			continue
+		}
+		partialPaths, ok, err := ds.buildPartialPaths4FulltextIndex(idx, tokens)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		// The partial paths depend on the value of the AGAINST argument.
+		ds.ctx.GetSessionVars().StmtCtx.SetSkipPlanCache(errors.New("the plan with IndexMerge accessing FULLTEXT index is un-cacheable"))
+		indexMergePath := &util.AccessPath{PartialIndexPaths: partialPaths, IndexMergeIsIntersection: isIntersection}
+		indexMergePath.TableFilters = filters
+		for _, p := range partialPaths {
+			if isIntersection {
+				if indexMergePath.CountAfterAccess == 0 || p.CountAfterAccess < indexMergePath.CountAfterAccess {
+					indexMergePath.CountAfterAccess = p.CountAfterAccess
+				}
+			} else {
+				indexMergePath.CountAfterAccess += p.CountAfterAccess
+			}
+		}
+		indexMergePath.CountAfterAccess = math.Min(indexMergePath.CountAfterAccess, ds.tableStats.RowCount)
+		ds.possibleAccessPaths = append(ds.possibleAccessPaths, indexMergePath)
+	}
+	return nil
+}
+
+// findFulltextIndex finds the public FULLTEXT index whose columns are exactly the matched columns.
+func (ds *DataSource) findFulltextIndex(matchedCols []expression.Expression) *model.IndexInfo {
+	colIDs := make(map[int64]struct{}, len(matchedCols))
+	for _, arg := range matchedCols {
+		col, ok := arg.(*expression.Column)
+		if !ok || !ds.schema.Contains(col) {
+			return nil
+		}
+		colIDs[col.ID] = struct{}{}
+	}
+	for _, idx := range ds.tableInfo.Indices {
+		if idx.Tp != model.IndexTypeFulltext || idx.State != model.StatePublic || idx.Invisible || len(idx.Columns) != len(colIDs) {
+			continue
+		}
+		matched := true
+		for _, idxCol := range idx.Columns {
+			if _, ok := colIDs[ds.tableInfo.Columns[idxCol.Offset].ID]; !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return idx
+		}
+	}
+	return nil
+}
+
+// buildPartialPaths4FulltextIndex builds a partial path for each token on the FULLTEXT index. An entry of a
+// FULLTEXT index only has one column, the token, which is encoded as the first indexed column.
+func (ds *DataSource) buildPartialPaths4FulltextIndex(idx *model.IndexInfo, tokens []string) ([]*util.AccessPath, bool, error) {
+	colID := ds.tableInfo.Columns[idx.Columns[0].Offset].ID
+	var tokenCol *expression.Column
+	for _, col := range ds.TblCols {
+		if col.ID == colID {
+			tokenCol = col.Clone().(*expression.Column)
+			tokenCol.UniqueID = ds.ctx.GetSessionVars().AllocPlanColumnID()
+			break
+		}
+	}
+	if tokenCol == nil {
+		return nil, false, nil
+	}
+	tokenIdx := idx.Clone()
+	tokenIdx.Columns = tokenIdx.Columns[:1]
+
+	partialPaths := make([]*util.AccessPath, 0, len(tokens))
+	for _, token := range tokens {
+		eq, err := expression.NewFunction(ds.ctx, ast.EQ, types.NewFieldType(mysql.TypeTiny), tokenCol,
+			expression.DatumToConstant(types.NewStringDatum(token), mysql.TypeVarString, 0))
+		if err != nil {
+			return nil, false, err
+		}
+		partialPath := &util.AccessPath{
+			Index:          tokenIdx,
+			IdxCols:        []*expression.Column{tokenCol},
+			IdxColLens:     []int{types.UnspecifiedLength},
+			FullIdxCols:    []*expression.Column{tokenCol},
+			FullIdxColLens: []int{types.UnspecifiedLength},
+		}
+		if err := ds.detachCondAndBuildRangeForPath(partialPath, []expression.Expression{eq}); err != nil {
+			return nil, false, err
+		}
+		if len(partialPath.AccessConds) != 1 || len(partialPath.TableFilters) > 0 {
+			return nil, false, nil
+		}
+		partialPaths = append(partialPaths, partialPath)
+	}
+	return partialPaths, true, nil
+}
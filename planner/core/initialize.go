@@ -279,6 +279,19 @@ func (p PhysicalShowDDLJobs) Init(ctx sessionctx.Context) *PhysicalShowDDLJobs {
 	return &p
 }
 
+// Init initializes LogicalTableFunction.
+func (p LogicalTableFunction) Init(ctx sessionctx.Context, offset int) *LogicalTableFunction {
+	p.baseLogicalPlan = newBaseLogicalPlan(ctx, plancodec.TypeTableFunction, &p, offset)
+	return &p
+}
+
+// Init initializes PhysicalTableFunction.
+func (p PhysicalTableFunction) Init(ctx sessionctx.Context, stats *property.StatsInfo, offset int) *PhysicalTableFunction {
+	p.basePhysicalPlan = newBasePhysicalPlan(ctx, plancodec.TypeTableFunction, &p, offset)
+	p.stats = stats
+	return &p
+}
+
 // Init initializes LogicalLock.
 func (p LogicalLock) Init(ctx sessionctx.Context) *LogicalLock {
 	p.baseLogicalPlan = newBaseLogicalPlan(ctx, plancodec.TypeLock, &p, 0)
@@ -661,6 +661,67 @@ func TestInvisibleIndex(t *testing.T) {
 
 	tk.MustExec("admin check table t")
 	tk.MustExec("admin check index t i_a")
+
+	// The invisible indexes can be used by the optimizer when tidb_opt_use_invisible_indexes is on.
+	tk.MustExec("set @@tidb_opt_use_invisible_indexes = on")
+	require.True(t, tk.MustUseIndex("select a from t where a > 0", "i_a"))
+	tk.MustQuery("select * from t use index(i_a)").Check(testkit.Rows("1 2"))
+	require.True(t, tk.HasPlan("select * from t where a = 1", "Point_Get"))
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("1 2"))
+	// admin check table keeps the variable.
+	tk.MustExec("admin check table t")
+	tk.MustQuery("select @@tidb_opt_use_invisible_indexes").Check(testkit.Rows("1"))
+	tk.MustExec("set @@tidb_opt_use_invisible_indexes = off")
+	require.False(t, tk.MustUseIndex("select a from t where a > 0", "i_a"))
+	tk.MustGetErrMsg("select * from t use index(i_a)", errStr)
+	// It can be set by the SET_VAR hint.
+	require.True(t, tk.MustUseIndex("select /*+ SET_VAR(tidb_opt_use_invisible_indexes=on) */ a from t where a > 0", "i_a"))
+
+	// Altering the visibility is instant, and the index is kept up to date while invisible.
+	tk.MustExec("alter table t alter index i_b invisible")
+	tk.MustExec("insert into t values (3, 4)")
+	tk.MustExec("alter table t alter index i_b visible")
+	require.True(t, tk.MustUseIndex("select b from t where b > 3", "i_b"))
+	tk.MustQuery("select b from t where b > 3").Check(testkit.Rows("4"))
+	tk.MustExec("admin check index t i_b")
+}
+
+func TestExplainWithHypotheticalIndex(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t, t2")
+	tk.MustExec("create table t(a int, b int, c varchar(20), key hypo_b(b))")
+	tk.MustExec("create table t2(a int, b int)")
+
+	tk.MustQuery("explain format = 'brief' select * from t where a = 1").CheckNotContain("IndexRangeScan")
+	tk.MustQuery("explain format = 'brief' with hypothetical index t(a) select * from t where a = 1").CheckContain("index:hypo_a(a)")
+	tk.MustQuery("explain format = 'brief' with hypothetical index test.t(c(5), a) select * from t where c = 'x' and a = 1").CheckContain("index:hypo_c_a(c, a)")
+	// The names of the hypothetical indexes don't conflict with the existing indexes.
+	tk.MustQuery("explain format = 'brief' with hypothetical index t(b), t(b) select * from t use index(hypo_b_3) where b = 1").CheckContain("index:hypo_b_3(b)")
+	tk.MustQuery("explain format = 'brief' with hypothetical index t(a), t2(b) select * from t join t2 on t.a = t2.a where t.a = 1 and t2.b = 1").
+		MultiCheckContain([]string{"table:t, index:hypo_a(a)", "table:t2, index:hypo_b(b)"})
+	tk.MustQuery("explain format = 'brief' with hypothetical index t(a) update t set b = 1 where a = 1").CheckContain("index:hypo_a(a)")
+
+	// The hypothetical indexes only live in the EXPLAIN statement.
+	tk.MustQuery("explain format = 'brief' select * from t where a = 1").CheckNotContain("hypo_a")
+	tk.MustGetErrMsg("select * from t use index(hypo_a)", "[planner:1176]Key 'hypo_a' doesn't exist in table 't'")
+	require.Len(t, tk.MustQuery("show index from t").Rows(), 1)
+
+	tk.MustGetErrCode("explain with hypothetical index t(d) select * from t", errno.ErrBadField)
+	tk.MustGetErrCode("explain with hypothetical index t((a + 1)) select * from t", errno.ErrNotSupportedYet)
+	tk.MustGetErrCode("explain with hypothetical index t3(a) select * from t", errno.ErrNoSuchTable)
+	tk.MustGetErrCode("explain format = 'plan_cache' with hypothetical index t(a) select * from t", errno.ErrNotSupportedYet)
+
+	// The tables of the hypothetical indexes need the SELECT privilege.
+	tk.MustExec("create user 'hypo_user'@'%'")
+	tk.MustExec("grant select on test.t2 to 'hypo_user'@'%'")
+	userTk := testkit.NewTestKit(t, store)
+	require.NoError(t, userTk.Session().Auth(&auth.UserIdentity{Username: "hypo_user", Hostname: "%"}, nil, nil))
+	userTk.MustExec("use test")
+	userTk.MustQuery("explain format = 'brief' with hypothetical index t2(b) select * from t2 where b = 1").CheckContain("index:hypo_b(b)")
+	userTk.MustGetErrCode("explain with hypothetical index t(a) select * from t2 where a = 1", errno.ErrTableaccessDenied)
 }
 
 func TestTopNByConstFunc(t *testing.T) {
@@ -2347,6 +2408,78 @@ func TestExtendedStatsSwitch(t *testing.T) {
 	))
 }
 
+func TestHistogramOnExpression(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, ts datetime)")
+	tk.MustExec("insert into t values(1, '2023-01-01 10:00:00'), (2, '2023-01-01 11:00:00'), (3, '2023-01-01 12:00:00'), " +
+		"(4, '2023-01-01 13:00:00'), (5, '2023-01-01 14:00:00'), (6, '2023-01-01 15:00:00'), (7, '2023-01-02 10:00:00'), " +
+		"(8, '2023-01-02 11:00:00'), (9, '2023-01-03 10:00:00'), (10, '2023-01-04 10:00:00')")
+
+	tk.MustExec("set session tidb_enable_extended_stats = off")
+	tk.MustGetErrMsg("analyze table t update histogram on (date(ts))",
+		"Extended statistics feature is not generally available now, and tidb_enable_extended_stats is OFF")
+	tk.MustExec("set session tidb_enable_extended_stats = on")
+	tk.MustGetErrMsg("analyze table t update histogram on (rand())", "histograms on non-deterministic expression RAND() are not supported")
+	tk.MustExec("set @@tidb_analyze_version = 2")
+	tk.MustExec("analyze table t update histogram on (date(ts))")
+	tk.MustQuery("select type, status from mysql.stats_extended").Check(testkit.Rows("3 1"))
+	tk.MustQuery("show stats_extended where table_name = 't'").CheckAt([]int{1, 4}, testkit.Rows("t expression"))
+
+	// 6 rows are estimated by the histogram on date(ts) rather than the default selectivity.
+	rows := tk.MustQuery("explain format = 'brief' select * from t where date(ts) = '2023-01-01'").Rows()
+	require.Equal(t, "6.00", rows[0][1])
+	rows = tk.MustQuery("explain format = 'brief' select * from t where date(ts) > '2023-01-01' and a > 0").Rows()
+	require.Equal(t, "4.00", rows[0][1])
+
+	tk.MustExec("analyze table t drop histogram on (date(ts))")
+	tk.MustQuery("select status from mysql.stats_extended").Check(testkit.Rows("2"))
+	rows = tk.MustQuery("explain format = 'brief' select * from t where date(ts) = '2023-01-01'").Rows()
+	require.Equal(t, "8.00", rows[0][1])
+}
+
+func TestMultiColumnSketch(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b int, c varchar(10))")
+	// The columns are fully correlated, each combination of them has 20 rows.
+	for i := 0; i < 20; i++ {
+		tk.MustExec("insert into t values(1, 1, 'x'), (2, 2, 'x'), (3, 3, 'x'), (4, 4, 'x'), (5, 5, 'x'), " +
+			"(6, 6, 'y'), (7, 7, 'y'), (8, 8, 'y'), (9, 9, 'y'), (10, 10, 'y')")
+	}
+	tk.MustExec("set session tidb_enable_extended_stats = on")
+	tk.MustExec("set @@tidb_analyze_version = 2")
+	tk.MustGetErrMsg("alter table t add stats_extended s1 sketch(a)", "Only support Sketch statistics type on at least 2 columns")
+	tk.MustExec("analyze table t")
+	// The selectivities of the columns are multiplied without the sketch.
+	rows := tk.MustQuery("explain format = 'brief' select * from t where a = 1 and b = 1").Rows()
+	require.Equal(t, "2.00", rows[0][1])
+
+	tk.MustExec("alter table t add stats_extended s1 sketch(a, b)")
+	tk.MustExec("alter table t add stats_extended s2 sketch(b, c)")
+	tk.MustExec("analyze table t")
+	tk.MustQuery("select name, type, status from mysql.stats_extended where name like 's%' order by name").Check(testkit.Rows("s1 4 1", "s2 4 1"))
+	tk.MustQuery("show stats_extended where table_name = 't'").Sort().CheckAt([]int{2, 3, 4, 5}, testkit.Rows("s1 [a,b] sketch ndv: 10", "s2 [b,c] sketch ndv: 10"))
+
+	rows = tk.MustQuery("explain format = 'brief' select * from t where a = 1 and b = 1").Rows()
+	require.Equal(t, "20.00", rows[0][1])
+	rows = tk.MustQuery("explain format = 'brief' select * from t where b = 6 and c = 'y'").Rows()
+	require.Equal(t, "20.00", rows[0][1])
+	// The sketch is not used if any of its columns is not compared with a constant.
+	rows = tk.MustQuery("explain format = 'brief' select * from t where a = 1 and b > 0").Rows()
+	require.Equal(t, "20.00", rows[0][1])
+	rows = tk.MustQuery("explain format = 'brief' select * from t where a = 1 and b = 1 and a > 0").Rows()
+	require.Equal(t, "20.00", rows[0][1])
+
+	tk.MustExec("set session tidb_enable_extended_stats = off")
+	rows = tk.MustQuery("explain format = 'brief' select * from t where a = 1 and b = 1").Rows()
+	require.Equal(t, "2.00", rows[0][1])
+}
+
 func TestOrderByNotInSelectDistinct(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -3042,6 +3175,37 @@ func TestIssue25300(t *testing.T) {
 	tk.MustGetErrCode(`(select a from t) union ( select b from t) union select 'a' except select 'd';`, mysql.ErrCantAggregateNcollations)
 }
 
+func TestIntersectAllAndExceptAll(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int, b int)")
+	tk.MustExec("create table t2 (a int, b int)")
+	tk.MustExec("insert into t1 values (1, 1), (1, 1), (1, 1), (2, 2), (2, 2), (3, 3), (null, null), (null, null)")
+	tk.MustExec("insert into t2 values (1, 1), (1, 1), (2, 2), (2, 2), (2, 2), (4, 4), (null, null)")
+
+	tk.MustQuery("select * from t1 intersect all select * from t2").Sort().Check(testkit.Rows(
+		"1 1", "1 1", "2 2", "2 2", "<nil> <nil>"))
+	tk.MustQuery("select * from t1 except all select * from t2").Sort().Check(testkit.Rows(
+		"1 1", "3 3", "<nil> <nil>"))
+	tk.MustQuery("select * from t2 except all select * from t1").Sort().Check(testkit.Rows(
+		"2 2", "4 4"))
+	tk.MustQuery("select * from t1 intersect select * from t2").Sort().Check(testkit.Rows(
+		"1 1", "2 2", "<nil> <nil>"))
+	tk.MustQuery("select * from t1 except select * from t2").Sort().Check(testkit.Rows("3 3"))
+
+	// INTERSECT ALL has higher precedence than UNION ALL and EXCEPT ALL.
+	tk.MustQuery("select a from t1 union all select a from t2 intersect all select a from t1 order by a").Check(testkit.Rows(
+		"<nil>", "<nil>", "<nil>", "1", "1", "1", "1", "1", "2", "2", "2", "2", "3"))
+	tk.MustQuery("select a from t1 union all select a from t2 except all select a from t1 order by a").Check(testkit.Rows(
+		"<nil>", "1", "1", "2", "2", "2", "4"))
+	tk.MustQuery("select a from t1 except all select a from t2 except all select 1 order by a").Check(testkit.Rows(
+		"<nil>", "3"))
+	tk.MustQuery("select count(*) from (select a from t1 intersect all select a from t2) t").Check(testkit.Rows("5"))
+	tk.MustGetErrCode("select a from t1 intersect all select * from t2", mysql.ErrWrongNumberOfColumnsInSelect)
+	tk.MustGetErrCode("select a from t1 except all select * from t2", mysql.ErrWrongNumberOfColumnsInSelect)
+}
+
 func TestSelectIgnoreTemporaryTableInView(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -5275,3 +5439,136 @@ func TestIssue41458(t *testing.T) {
 		require.Equalf(t, expectedRes[i], op, fmt.Sprintf("Mismatch at index %d.", i))
 	}
 }
+
+func TestIndexJoinKeepOrderByInnerColumns(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(a int, b int, index idx_a(a))")
+	tk.MustExec("create table t2(a int, b int, c varchar(10), index idx_a(a))")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (3, 3), (4, 4)")
+	tk.MustExec("insert into t2 values (4, 1, 'a'), (3, 1, 'b'), (2, 2, 'c'), (1, 1, 'd')")
+
+	// t2.a equals to t1.a, and t2.b is a constant, so the order of t1.a is kept.
+	for _, sql := range []string{
+		"select /*+ inl_join(t2) */ t1.a, t2.c from t1 join t2 on t1.a = t2.a order by t2.a limit 2",
+		"select /*+ inl_join(t2) */ t1.a, t2.c from t1 join t2 on t1.a = t2.a where t2.b = 1 order by t2.b, t2.a limit 2",
+		"select /*+ inl_join(t2) */ t1.a, t2.c from t1 join t2 on t1.a = t2.a where t2.b = 1 order by t2.a, t1.a limit 2",
+	} {
+		require.False(t, tk.HasPlan(sql, "TopN"), sql)
+		require.False(t, tk.HasPlan(sql, "Sort"), sql)
+	}
+	tk.MustQuery("select /*+ inl_join(t2) */ t1.a, t2.c from t1 join t2 on t1.a = t2.a order by t2.a limit 2").Check(testkit.Rows("1 d", "2 c"))
+	tk.MustQuery("select /*+ inl_join(t2) */ t1.a, t2.c from t1 join t2 on t1.a = t2.a where t2.b = 1 order by t2.b, t2.a limit 2").Check(testkit.Rows("1 d", "3 b"))
+
+	// The inner columns may be NULL in the outer join.
+	require.True(t, tk.HasPlan("select /*+ inl_join(t2) */ t1.a, t2.c from t1 left join t2 on t1.a = t2.a order by t2.a limit 2", "TopN"))
+	// t2.c doesn't equal to any outer column.
+	require.True(t, tk.HasPlan("select /*+ inl_join(t2) */ t1.a, t2.c from t1 join t2 on t1.a = t2.a order by t2.c limit 2", "TopN"))
+}
+
+func TestDecorrelateSemiApplyWithAgg(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1 (a int, b int)")
+	tk.MustExec("create table t2 (a int, b int, c int)")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (3, 3), (null, 4)")
+	tk.MustExec("insert into t2 values (1, 1, 1), (1, 1, 2), (2, 1, 1), (2, 2, 1), (3, 0, 1), (null, 1, 1)")
+	// `/*/` is replaced by the NO_DECORRELATE hint to get the expected results from the apply.
+	for _, sql := range []string{
+		"select * from t1 where exists (select /*/ 1 from t2 where t2.a < t1.a group by t2.b limit 1)",
+		"select * from t1 where exists (select /*/ 1 from t2 where t2.a = t1.a group by t2.b having count(*) > 1)",
+		"select * from t1 where not exists (select /*/ 1 from t2 where t2.a = t1.a group by t2.b having count(*) > 1)",
+		"select * from t1 where t1.b in (select /*/ max(t2.c) from t2 where t2.a = t1.a group by t2.b)",
+		"select t1.b, exists (select /*/ 1 from t2 where t2.a = t1.a group by t2.b having sum(t2.c) > 1) from t1",
+	} {
+		decorrelated := strings.Replace(sql, "/*/", "", 1)
+		require.False(t, tk.HasPlan(decorrelated, "Apply"), decorrelated)
+		expected := tk.MustQuery(strings.Replace(sql, "/*/", "/*+ NO_DECORRELATE() */", 1)).Sort().Rows()
+		tk.MustQuery(decorrelated).Sort().Check(expected)
+	}
+}
+
+func TestDecorrelateSemiApplyWithLimit(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1 (a int, b int)")
+	tk.MustExec("create table t2 (a int, b int, c int)")
+	tk.MustExec("insert into t1 values (1, 1), (2, 1), (3, 1), (1, 2), (2, 2), (null, 3), (1, null)")
+	tk.MustExec("insert into t2 values (1, 1, 3), (2, 1, 2), (3, 1, 1), (1, 2, 1), (2, 2, 2), (null, 2, 3), (1, 3, 1), (1, null, 1)")
+	// `/*/` is replaced by the NO_DECORRELATE hint to get the expected results from the apply.
+	for _, sql := range []string{
+		"select * from t1 where t1.a in (select /*/ t2.a from t2 where t2.b = t1.b order by t2.c limit 2)",
+		"select * from t1 where t1.a in (select /*/ t2.a from t2 where t2.b = t1.b and t2.a > 1 order by t2.c desc limit 1)",
+		"select * from t1 where t1.a not in (select /*/ t2.a from t2 where t2.b = t1.b order by t2.c limit 1)",
+		"select * from t1 where exists (select /*/ 1 from t2 where t2.b = t1.b order by t2.c limit 2, 1)",
+		"select * from t1 where not exists (select /*/ 1 from t2 where t2.b = t1.b and t2.a = t1.a order by t2.c limit 1, 1)",
+		"select t1.a, t1.a in (select /*/ t2.a from t2 where t2.b = t1.b order by t2.c limit 1) from t1",
+	} {
+		decorrelated := strings.Replace(sql, "/*/", "", 1)
+		expected := tk.MustQuery(strings.Replace(sql, "/*/", "/*+ NO_DECORRELATE() */", 1)).Sort().Rows()
+		tk.MustQuery(decorrelated).Sort().Check(expected)
+	}
+	require.False(t, tk.HasPlan("select * from t1 where t1.a in (select t2.a from t2 where t2.b = t1.b order by t2.c limit 2)", "Apply"))
+	require.False(t, tk.HasPlan("select * from t1 where exists (select 1 from t2 where t2.b = t1.b order by t2.c limit 2, 1)", "Apply"))
+	// The non-equal correlated conditions can't be pulled up as the partition keys.
+	require.True(t, tk.HasPlan("select * from t1 where t1.a in (select t2.a from t2 where t2.b > t1.b order by t2.c limit 2)", "Apply"))
+}
+
+func TestFuseWindowAndStreamAgg(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (uid int, ts int, v int)")
+	tk.MustExec("insert into t values (1, 1, 1), (1, 5, 2), (1, 100, 3), (2, 3, 4), (2, 200, 5), (3, 7, 6)")
+
+	// The aggregation grouped by the partition-by columns of the window is done over the window output in a single pass.
+	sql := "select uid, count(*), sum(v) from (select uid, v, ts - lag(ts, 1, 0) over (partition by uid order by ts) gap from t) x where gap > 10 group by uid"
+	require.True(t, tk.HasPlan(sql, "StreamAgg"))
+	require.False(t, tk.HasPlan(sql, "HashAgg"))
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("1 1 3", "2 1 5"))
+	sql = "select v, uid, count(*), max(rn) from (select uid, v, row_number() over (partition by uid, v order by ts) rn from t) x group by v, uid"
+	require.True(t, tk.HasPlan(sql, "StreamAgg"))
+	require.False(t, tk.HasPlan(sql, "HashAgg"))
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("1 1 1 1", "2 1 1 1", "3 1 1 1", "4 2 1 1", "5 2 1 1", "6 3 1 1"))
+
+	// The hint is respected.
+	require.True(t, tk.HasPlan("select /*+ hash_agg() */ uid, count(*) from (select uid, row_number() over (partition by uid order by ts) rn from t) x group by uid", "HashAgg"))
+}
+
+func TestPushDownPredicatesThroughWindowInCTE(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (id int primary key, c int, v int)")
+	tk.MustExec("insert into t values (1, 1, 10), (2, 1, 20), (3, 1, 30), (4, 2, 40), (5, 2, 50), (6, 3, 60)")
+
+	// The CTE referenced twice isn't inlined, the filters on the partition by columns are pushed below the window
+	// functions in it, so only the rows of the selected partitions are materialized.
+	sql := "with cte as (select id, c, row_number() over (partition by c order by id) rn from t) " +
+		"select * from cte where c = 1 and rn <= 2 union all select * from cte where c = 2 and rn <= 1"
+	rows := tk.MustQuery("explain format = 'brief' " + sql).Rows()
+	windowIdx, pushedIdx := -1, -1
+	for i, row := range rows {
+		if windowIdx < 0 && strings.Contains(row[0].(string), "Window") {
+			windowIdx = i
+		}
+		if windowIdx >= 0 && strings.Contains(row[4].(string), "eq(test.t.c, 1)") {
+			pushedIdx = i
+		}
+	}
+	require.GreaterOrEqual(t, windowIdx, 0)
+	require.Greater(t, pushedIdx, windowIdx)
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("1 1 1", "2 1 2", "4 2 1"))
+
+	// The filters on the derived tables are pushed down in the same way.
+	sql = "select * from (select id, c, row_number() over (partition by c order by id) rn from t) x where c = 2 and rn <= 1"
+	tk.MustQuery(sql).Check(testkit.Rows("4 2 1"))
+}
@@ -121,6 +121,10 @@ const (
 	HintNoOrderIndex = "no_order_index"
 	// HintAggToCop is hint enforce pushing aggregation to coprocessor.
 	HintAggToCop = "agg_to_cop"
+	// HintAggToRoot is hint enforce executing aggregation in TiDB.
+	HintAggToRoot = "agg_to_root"
+	// HintAggToMPP is hint enforce executing aggregation in TiFlash MPP.
+	HintAggToMPP = "agg_to_mpp"
 	// HintReadFromStorage is hint enforce some tables read from specific type of storage.
 	HintReadFromStorage = "read_from_storage"
 	// HintTiFlash is a label represents the tiflash storage type.
@@ -135,6 +139,10 @@ const (
 	HintIgnorePlanCache = "ignore_plan_cache"
 	// HintLimitToCop is a hint enforce pushing limit or topn to coprocessor.
 	HintLimitToCop = "limit_to_cop"
+	// HintLimitToRoot is a hint enforce executing limit or topn in TiDB.
+	HintLimitToRoot = "limit_to_root"
+	// HintLimitToMPP is a hint enforce executing limit or topn in TiFlash MPP.
+	HintLimitToMPP = "limit_to_mpp"
 	// HintMerge is a hint which can switch turning inline for the CTE.
 	HintMerge = "merge"
 	// HintSemiJoinRewrite is a hint to force we rewrite the semi join operator as much as possible.
@@ -383,6 +391,10 @@ func (b *PlanBuilder) buildResultSetNode(ctx context.Context, node ast.ResultSet
 		case *ast.TableName:
 			p, err = b.buildDataSource(ctx, v, &x.AsName)
 			isTableName = true
+		case *ast.TableFunction:
+			p, err = b.buildTableFunction(ctx, v)
+			// The table function is not a select block either.
+			isTableName = true
 		default:
 			err = ErrUnsupportedType.GenWithStackByArgs(v)
 		}
@@ -1823,6 +1835,68 @@ func (b *PlanBuilder) buildSemiJoinForSetOperator(
 	if err != nil {
 		return nil, err
 	}
+	return b.buildSetOprJoin(leftPlan, rightPlan, joinType)
+}
+
+// buildSemiJoinAllForSetOperator builds the semi join for INTERSECT ALL and the anti semi join for EXCEPT ALL.
+// The duplicated rows of both sides are numbered by row_number() partitioned by all the columns, and the join
+// keys include the row number, so the n-th duplicate of the left side matches only the n-th duplicate of the right
+// side. Then INTERSECT ALL outputs min(m, n) duplicates, and EXCEPT ALL outputs max(m-n, 0) duplicates for a row
+// which has m duplicates on the left side and n duplicates on the right side.
+func (b *PlanBuilder) buildSemiJoinAllForSetOperator(
+	leftOriginPlan LogicalPlan,
+	rightOriginPlan LogicalPlan,
+	joinType JoinType) (LogicalPlan, error) {
+	leftPlan, err := b.buildRowNumberForSetOperator(leftOriginPlan)
+	if err != nil {
+		return nil, err
+	}
+	rightPlan, err := b.buildRowNumberForSetOperator(rightOriginPlan)
+	if err != nil {
+		return nil, err
+	}
+	joinPlan, err := b.buildSetOprJoin(leftPlan, rightPlan, joinType)
+	if err != nil {
+		return nil, err
+	}
+	// Remove the row number from the output.
+	length := leftOriginPlan.Schema().Len()
+	proj := LogicalProjection{Exprs: expression.Column2Exprs(joinPlan.Schema().Columns[:length])}.Init(b.ctx, b.getSelectOffset())
+	proj.SetChildren(joinPlan)
+	proj.SetSchema(expression.NewSchema(joinPlan.Schema().Clone().Columns[:length]...))
+	proj.names = joinPlan.OutputNames()[:length]
+	return proj, nil
+}
+
+// buildRowNumberForSetOperator appends the row_number() partitioned by all the columns to the plan.
+func (b *PlanBuilder) buildRowNumberForSetOperator(p LogicalPlan) (LogicalPlan, error) {
+	partitionBy := make([]property.SortItem, 0, p.Schema().Len())
+	for _, col := range p.Schema().Columns {
+		partitionBy = append(partitionBy, property.SortItem{Col: col})
+	}
+	desc, err := aggregation.NewWindowFuncDesc(b.ctx, ast.WindowFuncRowNumber, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	window := LogicalWindow{
+		WindowFuncDescs: []*aggregation.WindowFuncDesc{desc},
+		PartitionBy:     partitionBy,
+	}.Init(b.ctx, b.getSelectOffset())
+	schema := p.Schema().Clone()
+	schema.Append(&expression.Column{
+		UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+		RetType:  desc.RetTp,
+	})
+	window.names = make([]*types.FieldName, 0, schema.Len())
+	window.names = append(window.names, p.OutputNames()...)
+	window.names = append(window.names, types.EmptyName)
+	window.SetChildren(p)
+	window.SetSchema(schema)
+	return window, nil
+}
+
+// buildSetOprJoin joins the two plans of a set operator on all their columns, and only outputs the left columns.
+func (b *PlanBuilder) buildSetOprJoin(leftPlan, rightPlan LogicalPlan, joinType JoinType) (LogicalPlan, error) {
 	joinPlan := LogicalJoin{JoinType: joinType}.Init(b.ctx, b.getSelectOffset())
 	joinPlan.SetChildren(leftPlan, rightPlan)
 	joinPlan.SetSchema(leftPlan.Schema())
@@ -1869,18 +1943,13 @@ func (b *PlanBuilder) buildIntersect(ctx context.Context, selects []ast.Node) (L
 	columnNums := leftPlan.Schema().Len()
 	for i := 1; i < len(selects); i++ {
 		var rightPlan LogicalPlan
+		var setOprType *ast.SetOprType
 		switch x := selects[i].(type) {
 		case *ast.SelectStmt:
-			if *x.AfterSetOperator == ast.IntersectAll {
-				// TODO: support intersect all
-				return nil, nil, errors.Errorf("TiDB do not support intersect all")
-			}
+			setOprType = x.AfterSetOperator
 			rightPlan, err = b.buildSelect(ctx, x)
 		case *ast.SetOprSelectList:
-			if *x.AfterSetOperator == ast.IntersectAll {
-				// TODO: support intersect all
-				return nil, nil, errors.Errorf("TiDB do not support intersect all")
-			}
+			setOprType = x.AfterSetOperator
 			rightPlan, err = b.buildSetOpr(ctx, &ast.SetOprStmt{SelectList: x, With: x.With})
 		}
 		if err != nil {
@@ -1889,7 +1958,11 @@ func (b *PlanBuilder) buildIntersect(ctx context.Context, selects []ast.Node) (L
 		if rightPlan.Schema().Len() != columnNums {
 			return nil, nil, ErrWrongNumberOfColumnsInSelect.GenWithStackByArgs()
 		}
-		leftPlan, err = b.buildSemiJoinForSetOperator(leftPlan, rightPlan, SemiJoin)
+		if *setOprType == ast.IntersectAll {
+			leftPlan, err = b.buildSemiJoinAllForSetOperator(leftPlan, rightPlan, SemiJoin)
+		} else {
+			leftPlan, err = b.buildSemiJoinForSetOperator(leftPlan, rightPlan, SemiJoin)
+		}
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1908,20 +1981,21 @@ func (b *PlanBuilder) buildExcept(ctx context.Context, selects []LogicalPlan, af
 		if rightPlan.Schema().Len() != columnNums {
 			return nil, ErrWrongNumberOfColumnsInSelect.GenWithStackByArgs()
 		}
-		if *afterSetOpts[i] == ast.Except {
+		if *afterSetOpts[i] == ast.Except || *afterSetOpts[i] == ast.ExceptAll {
 			leftPlan, err := b.buildUnion(ctx, unionPlans, tmpAfterSetOpts)
 			if err != nil {
 				return nil, err
 			}
-			leftPlan, err = b.buildSemiJoinForSetOperator(leftPlan, rightPlan, AntiSemiJoin)
+			if *afterSetOpts[i] == ast.ExceptAll {
+				leftPlan, err = b.buildSemiJoinAllForSetOperator(leftPlan, rightPlan, AntiSemiJoin)
+			} else {
+				leftPlan, err = b.buildSemiJoinForSetOperator(leftPlan, rightPlan, AntiSemiJoin)
+			}
 			if err != nil {
 				return nil, err
 			}
 			unionPlans = []LogicalPlan{leftPlan}
 			tmpAfterSetOpts = []*ast.SetOprType{nil}
-		} else if *afterSetOpts[i] == ast.ExceptAll {
-			// TODO: support except all.
-			return nil, errors.Errorf("TiDB do not support except all")
 		} else {
 			unionPlans = append(unionPlans, rightPlan)
 			tmpAfterSetOpts = append(tmpAfterSetOpts, afterSetOpts[i])
@@ -3716,6 +3790,7 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 		limitHints                                                                      limitHintInfo
 		MergeHints                                                                      MergeHintInfo
 		leadingJoinOrder                                                                []hintTableInfo
+		leadingList                                                                     *ast.LeadingList
 		hjBuildTables, hjProbeTables                                                    []hintTableInfo
 		leadingHintCnt                                                                  int
 	)
@@ -3759,6 +3834,10 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 			aggHints.preferAggType |= preferStreamAgg
 		case HintAggToCop:
 			aggHints.preferAggToCop = true
+		case HintAggToRoot:
+			aggHints.preferAggToRoot = true
+		case HintAggToMPP:
+			aggHints.preferAggToMPP = true
 		case HintUseIndex, HintIgnoreIndex, HintForceIndex, HintOrderIndex, HintNoOrderIndex:
 			dbName := hint.Tables[0].DBName
 			if dbName.L == "" {
@@ -3813,6 +3892,10 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 			timeRangeHint = hint.HintData.(ast.HintTimeRange)
 		case HintLimitToCop:
 			limitHints.preferLimitToCop = true
+		case HintLimitToRoot:
+			limitHints.preferLimitToRoot = true
+		case HintLimitToMPP:
+			limitHints.preferLimitToMPP = true
 		case HintMerge:
 			if hint.Tables != nil {
 				b.ctx.GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("The MERGE hint is not used correctly, maybe it inputs a table name."))
@@ -3822,6 +3905,9 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 		case HintLeading:
 			if leadingHintCnt == 0 {
 				leadingJoinOrder = append(leadingJoinOrder, tableNames2HintTableInfo(b.ctx, hint.HintName.L, hint.Tables, b.hintProcessor, currentLevel)...)
+				if list, ok := hint.HintData.(*ast.LeadingList); ok && len(leadingJoinOrder) > 0 {
+					leadingList = list
+				}
 			}
 			leadingHintCnt++
 		case HintSemiJoinRewrite:
@@ -3843,6 +3929,7 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 	if leadingHintCnt > 1 || (leadingHintCnt > 0 && b.ctx.GetSessionVars().StmtCtx.StraightJoinOrder) {
 		// If there are more leading hints or the straight_join hint existes, all leading hints will be invalid.
 		leadingJoinOrder = leadingJoinOrder[:0]
+		leadingList = nil
 		if leadingHintCnt > 1 {
 			b.ctx.GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("We can only use one leading hint at most, when multiple leading hints are used, all leading hints will be invalid"))
 		} else if b.ctx.GetSessionVars().StmtCtx.StraightJoinOrder {
@@ -3864,6 +3951,7 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 		limitHints:                limitHints,
 		MergeHints:                MergeHints,
 		leadingJoinOrder:          leadingJoinOrder,
+		leadingList:               leadingList,
 		hjBuildTables:             hjBuildTables,
 		hjProbeTables:             hjProbeTables,
 	})
@@ -4994,6 +5082,52 @@ func (b *PlanBuilder) timeRangeForSummaryTable() QueryTimeRange {
 	return QueryTimeRange{From: from, To: to}
 }
 
+// buildTableFunction builds the plan of a table function. The arguments are rewritten without any input
+// row, so they can only reference the outer columns, and are casted to the argument types of the function.
+func (b *PlanBuilder) buildTableFunction(ctx context.Context, tf *ast.TableFunction) (LogicalPlan, error) {
+	fn, ok := infoschema.GetTableFunction(tf.FnName.L)
+	if !ok {
+		return nil, ErrSpDoesNotExist.GenWithStackByArgs("FUNCTION", tf.FnName.O)
+	}
+	if len(tf.Args) != len(fn.ArgTypes) {
+		return nil, expression.ErrIncorrectParameterCount.GenWithStackByArgs(tf.FnName.O)
+	}
+	dual := LogicalTableDual{RowCount: 1}.Init(b.ctx, b.getSelectOffset())
+	dual.SetSchema(expression.NewSchema())
+	args := make([]expression.Expression, 0, len(tf.Args))
+	for i, arg := range tf.Args {
+		expr, np, err := b.rewrite(ctx, arg, dual, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		// The subqueries in the arguments are not supported.
+		if np != dual {
+			return nil, ErrWrongArguments.GenWithStackByArgs(tf.FnName.O)
+		}
+		args = append(args, expression.BuildCastFunction(b.ctx, expr, types.NewFieldType(fn.ArgTypes[i])))
+	}
+
+	schema := expression.NewSchema(make([]*expression.Column, 0, len(fn.Meta.Columns))...)
+	names := make([]*types.FieldName, 0, len(fn.Meta.Columns))
+	for _, col := range fn.Meta.Columns {
+		names = append(names, &types.FieldName{
+			TblName:     tf.FnName,
+			ColName:     col.Name,
+			OrigTblName: fn.Meta.Name,
+			OrigColName: col.Name,
+		})
+		schema.Append(&expression.Column{
+			UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+			RetType:  col.FieldType.Clone(),
+		})
+	}
+	b.handleHelper.pushMap(nil)
+	p := LogicalTableFunction{FuncName: fn.Meta.Name, Args: args}.Init(b.ctx, b.getSelectOffset())
+	p.SetSchema(schema)
+	p.names = names
+	return p, nil
+}
+
 func (b *PlanBuilder) buildMemTable(_ context.Context, dbName model.CIStr, tableInfo *model.TableInfo) (LogicalPlan, error) {
 	// We can use the `tableInfo.Columns` directly because the memory table has
 	// a stable schema and there is no online DDL on the memory table.
@@ -5569,7 +5703,7 @@ func (b *PlanBuilder) buildUpdate(ctx context.Context, update *ast.UpdateStmt) (
 	updt.names = p.OutputNames()
 	// We cannot apply projection elimination when building the subplan, because
 	// columns in orderedList cannot be resolved. (^flagEliminateProjection should also be applied in postOptimize)
-	updt.SelectPlan, _, err = DoOptimize(ctx, b.ctx, b.optFlag&^flagEliminateProjection, p)
+	updt.SelectPlan, _, err = doOptimizeDMLSource(ctx, b.ctx, b.optFlag&^flagEliminateProjection, p)
 	if err != nil {
 		return nil, err
 	}
@@ -5974,7 +6108,7 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (Plan
 	}.Init(b.ctx)
 
 	del.names = p.OutputNames()
-	del.SelectPlan, _, err = DoOptimize(ctx, b.ctx, b.optFlag, p)
+	del.SelectPlan, _, err = doOptimizeDMLSource(ctx, b.ctx, b.optFlag, p)
 	if err != nil {
 		return nil, err
 	}
@@ -1395,6 +1395,10 @@ func (ds *DataSource) detachCondAndBuildRangeForPath(path *util.AccessPath, cond
 			path.ConstCols[i] = res.ColumnValues[i] != nil
 		}
 	}
+	if isHypoIndex(ds.ctx, ds.tableInfo.ID, path.Index) {
+		path.CountAfterAccess = ds.hypoIndexRowCount(path)
+		return nil
+	}
 	path.CountAfterAccess, err = ds.tableStats.HistColl.GetRowCountByIndexRanges(ds.ctx, path.Index.ID, path.Ranges)
 	return err
 }
@@ -1966,6 +1970,15 @@ type LogicalShowDDLJobs struct {
 	JobNumber int64
 }
 
+// LogicalTableFunction represents a table function in the FROM clause, which returns a set of rows.
+type LogicalTableFunction struct {
+	logicalSchemaProducer
+
+	FuncName model.CIStr
+	// Args are the arguments of the table function, they can be evaluated without any input row.
+	Args []expression.Expression
+}
+
 // CTEClass holds the information and plan for a CTE. Most of the fields in this struct are the same as cteInfo.
 // But the cteInfo is used when building the plan, and CTEClass is used also for building the executor.
 type CTEClass struct {
@@ -20,6 +20,7 @@ import (
 	"math"
 	"runtime"
 	"strconv"
+	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -53,6 +54,10 @@ import (
 // OptimizeAstNode optimizes the query to a physical plan directly.
 var OptimizeAstNode func(ctx context.Context, sctx sessionctx.Context, node ast.Node, is infoschema.InfoSchema) (Plan, types.NameSlice, error)
 
+// CascadesOptimize optimizes the logical plan by the cascades planner, it's set by the planner package because the
+// cascades planner depends on this package.
+var CascadesOptimize func(sctx sessionctx.Context, logic LogicalPlan) (PhysicalPlan, float64, error)
+
 // AllowCartesianProduct means whether tidb allows cartesian join without equal conditions.
 var AllowCartesianProduct = atomic.NewBool(true)
 
@@ -276,6 +281,15 @@ func checkStableResultMode(sctx sessionctx.Context) bool {
 	return s.EnableStableResultMode && (!st.InInsertStmt && !st.InUpdateStmt && !st.InDeleteStmt && !st.InLoadDataStmt)
 }
 
+// doOptimizeDMLSource optimizes the plan which reads the rows of UPDATE, DELETE and INSERT ... SELECT, it's optimized by
+// the cascades planner if it's enabled, the same as the SELECT statements.
+func doOptimizeDMLSource(ctx context.Context, sctx sessionctx.Context, flag uint64, logic LogicalPlan) (PhysicalPlan, float64, error) {
+	if sctx.GetSessionVars().GetEnableCascadesPlanner() && CascadesOptimize != nil {
+		return CascadesOptimize(sctx, logic)
+	}
+	return DoOptimize(ctx, sctx, flag, logic)
+}
+
 // DoOptimize optimizes a logical plan to a physical plan.
 func DoOptimize(ctx context.Context, sctx sessionctx.Context, flag uint64, logic LogicalPlan) (PhysicalPlan, float64, error) {
 	// if there is something after flagPrunColumns, do flagPrunColumnsAgain
@@ -398,9 +412,15 @@ func postOptimize(ctx context.Context, sctx sessionctx.Context, plan PhysicalPla
 	mergeContinuousSelections(plan)
 	plan = eliminateUnionScanAndLock(sctx, plan)
 	plan = enableParallelApply(sctx, plan)
+	enablePartitionWiseJoin(sctx, plan)
+	postOptimizeAdaptiveJoin(plan)
+	enableRuntimeFilter(sctx, plan)
 	handleFineGrainedShuffle(ctx, sctx, plan)
 	propagateProbeParents(plan, nil)
 	countStarRewrite(plan)
+	if err := tryEnableIndexSkipScan(sctx, plan); err != nil {
+		return nil, err
+	}
 	disableReuseChunkIfNeeded(sctx, plan)
 	tryEnableLateMaterialization(sctx, plan)
 	return plan, nil
@@ -1063,6 +1083,215 @@ func enableParallelApply(sctx sessionctx.Context, plan PhysicalPlan) PhysicalPla
 	return plan
 }
 
+// enablePartitionWiseJoin marks the hash joins whose children are both partitioned on the join key with the same
+// partition scheme. The rows of a partition can only match the rows of the corresponding partition of the other side,
+// so the pairs of the partitions can be joined independently and in parallel, with much smaller hash tables.
+func enablePartitionWiseJoin(sctx sessionctx.Context, plan PhysicalPlan) {
+	if !sctx.GetSessionVars().EnablePartitionWiseJoin || !sctx.GetSessionVars().StmtCtx.UseDynamicPartitionPrune() {
+		return
+	}
+	enablePartitionWiseJoinInternal(plan)
+}
+
+func enablePartitionWiseJoinInternal(plan PhysicalPlan) {
+	if apply, ok := plan.(*PhysicalApply); ok {
+		// The inner child of the apply is executed once for each outer row, so it's not joined partition-wise.
+		enablePartitionWiseJoinInternal(apply.Children()[1-apply.InnerChildIdx])
+		return
+	}
+	if join, ok := plan.(*PhysicalHashJoin); ok {
+		join.PartitionWise = join.canJoinPartitionWise()
+	}
+	for _, child := range plan.Children() {
+		enablePartitionWiseJoinInternal(child)
+	}
+}
+
+// canJoinPartitionWise checks whether both children are tikv table readers of the tables partitioned by a single
+// column with the same partition scheme, and the partition columns are joined by an equal condition.
+func (p *PhysicalHashJoin) canJoinPartitionWise() bool {
+	// The null-aware anti join needs to know whether the whole inner side has null keys.
+	if len(p.NAEqualConditions) > 0 {
+		return false
+	}
+	lReader, lOk := p.children[0].(*PhysicalTableReader)
+	rReader, rOk := p.children[1].(*PhysicalTableReader)
+	if !lOk || !rOk || lReader.StoreType != kv.TiKV || rReader.StoreType != kv.TiKV {
+		return false
+	}
+	lCol, lPi := partitionColumnOfReader(lReader)
+	rCol, rPi := partitionColumnOfReader(rReader)
+	if lCol == nil || rCol == nil || !samePartitionScheme(lPi, rPi) {
+		return false
+	}
+	lTp, rTp := lCol.RetType, rCol.RetType
+	if lTp.GetType() != rTp.GetType() || mysql.HasUnsignedFlag(lTp.GetFlag()) != mysql.HasUnsignedFlag(rTp.GetFlag()) ||
+		lTp.GetCollate() != rTp.GetCollate() {
+		return false
+	}
+	for i := range p.LeftJoinKeys {
+		if p.LeftJoinKeys[i].Equal(nil, lCol) && p.RightJoinKeys[i].Equal(nil, rCol) {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionColumnOfReader returns the partition column in the schema of the reader and the partition info, if the
+// table is partitioned by a single column and the partitioning is not being changed.
+func partitionColumnOfReader(reader *PhysicalTableReader) (*expression.Column, *model.PartitionInfo) {
+	ts, err := reader.GetTableScan()
+	if err != nil {
+		return nil, nil
+	}
+	if ok, _ := ts.IsPartition(); ok {
+		return nil, nil
+	}
+	pi := ts.Table.GetPartitionInfo()
+	if pi == nil || len(pi.AddingDefinitions) > 0 || len(pi.DroppingDefinitions) > 0 || pi.DDLAction != model.ActionNone {
+		return nil, nil
+	}
+	var colName string
+	switch pi.Type {
+	case model.PartitionTypeHash, model.PartitionTypeRange, model.PartitionTypeList:
+		if len(pi.Columns) == 0 {
+			// The partition expression must be a bare column.
+			colName = strings.Trim(pi.Expr, "` ")
+		} else if len(pi.Columns) == 1 {
+			colName = pi.Columns[0].L
+		}
+	}
+	colInfo := model.FindColumnInfo(ts.Table.Columns, colName)
+	if colInfo == nil {
+		return nil, nil
+	}
+	for _, col := range reader.Schema().Columns {
+		if col.ID == colInfo.ID {
+			return col, pi
+		}
+	}
+	return nil, nil
+}
+
+// samePartitionScheme checks whether the partitions of the two tables have the same type and the same boundaries.
+func samePartitionScheme(l, r *model.PartitionInfo) bool {
+	if l.Type != r.Type || len(l.Definitions) != len(r.Definitions) {
+		return false
+	}
+	for i := range l.Definitions {
+		ld, rd := &l.Definitions[i], &r.Definitions[i]
+		if !slices.Equal(ld.LessThan, rd.LessThan) || len(ld.InValues) != len(rd.InValues) {
+			return false
+		}
+		for j := range ld.InValues {
+			if !slices.Equal(ld.InValues[j], rd.InValues[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// postOptimizeAdaptiveJoin injects the extra projections into the index joins which the hash joins switch to, like
+// the plan itself. The partition-wise hash joins don't switch, since each pair of the partitions is joined separately.
+func postOptimizeAdaptiveJoin(plan PhysicalPlan) {
+	if join, ok := plan.(*PhysicalHashJoin); ok && join.AdaptiveIndexJoin != nil {
+		if join.PartitionWise {
+			join.AdaptiveIndexJoin = nil
+		} else {
+			join.AdaptiveIndexJoin = InjectExtraProjection(join.AdaptiveIndexJoin)
+			postOptimizeAdaptiveJoin(join.AdaptiveIndexJoin)
+		}
+	}
+	for _, child := range plan.Children() {
+		postOptimizeAdaptiveJoin(child)
+	}
+}
+
+// enableRuntimeFilter generates the runtime filters for the hash joins whose probe side is a tikv table reader. The
+// probe side is read after the build side is finished, and the filter generated from the build side keys is pushed
+// to the table scan, so the rows which can't be joined are filtered out by TiKV.
+func enableRuntimeFilter(sctx sessionctx.Context, plan PhysicalPlan) {
+	if !sctx.GetSessionVars().EnableRuntimeFilter {
+		return
+	}
+	enableRuntimeFilterInternal(sctx, plan)
+}
+
+func enableRuntimeFilterInternal(sctx sessionctx.Context, plan PhysicalPlan) {
+	if apply, ok := plan.(*PhysicalApply); ok {
+		// The inner child of the apply is executed once for each outer row, waiting for the build side each time
+		// doesn't pay off.
+		enableRuntimeFilterInternal(sctx, apply.Children()[1-apply.InnerChildIdx])
+		return
+	}
+	// The probe side of the adaptive join is opened after the build side is checked, which conflicts with the filter.
+	if join, ok := plan.(*PhysicalHashJoin); ok && join.AdaptiveIndexJoin == nil {
+		join.RuntimeFilter = join.buildRuntimeFilter(sctx)
+	}
+	for _, child := range plan.Children() {
+		enableRuntimeFilterInternal(sctx, child)
+	}
+}
+
+// buildRuntimeFilter returns the runtime filter of the hash join, or nil if the join can't be filtered. The probe side
+// should be a tikv table reader with only a table scan and an optional selection, and the keys should be integers with
+// the same signedness, so the filter on the values of the build side keys never filters out the joined rows.
+func (p *PhysicalHashJoin) buildRuntimeFilter(sctx sessionctx.Context) *RuntimeFilter {
+	// Only the rows of the probe side which are not matched can be filtered out.
+	if p.UseOuterToBuild || p.PartitionWise || (p.JoinType != InnerJoin && p.JoinType != SemiJoin) {
+		return nil
+	}
+	probeIdx := 1 - p.InnerChildIdx
+	reader, ok := p.children[probeIdx].(*PhysicalTableReader)
+	if !ok || reader.StoreType != kv.TiKV || len(reader.ExtractCorrelatedCols()) > 0 {
+		return nil
+	}
+	ts, ok := reader.TablePlans[0].(*PhysicalTableScan)
+	if !ok {
+		return nil
+	}
+	switch len(reader.TablePlans) {
+	case 1:
+	case 2:
+		if _, ok := reader.TablePlans[1].(*PhysicalSelection); !ok {
+			return nil
+		}
+	default:
+		return nil
+	}
+	buildKeys, probeKeys := p.LeftJoinKeys, p.RightJoinKeys
+	if probeIdx == 0 {
+		buildKeys, probeKeys = p.RightJoinKeys, p.LeftJoinKeys
+	}
+	for i, cond := range p.EqualConditions {
+		// The null keys are joined by the null-safe equal condition, but they are always filtered out.
+		if cond.FuncName.L != ast.EQ {
+			continue
+		}
+		buildTp, probeTp := buildKeys[i].RetType, probeKeys[i].RetType
+		if !mysql.IsIntegerType(buildTp.GetType()) || !mysql.IsIntegerType(probeTp.GetType()) ||
+			mysql.HasUnsignedFlag(buildTp.GetFlag()) != mysql.HasUnsignedFlag(probeTp.GetFlag()) {
+			continue
+		}
+		idx := ts.Schema().ColumnIndex(probeKeys[i])
+		if idx < 0 {
+			continue
+		}
+		probeCol := ts.Schema().Columns[idx].Clone().(*expression.Column)
+		probeCol.Index = idx
+		if len(reader.TablePlans) == 1 {
+			// The filter is appended to the conditions of the selection, so the executors sent to TiKV are still
+			// consistent with the plans of the reader.
+			sel := PhysicalSelection{}.Init(sctx, ts.statsInfo(), ts.SelectBlockOffset())
+			sel.SetChildren(ts)
+			reader.SetChildren(sel)
+		}
+		return &RuntimeFilter{KeyIdx: i, ProbeCol: probeCol}
+	}
+	return nil
+}
+
 // LogicalOptimizeTest is just exported for test.
 func LogicalOptimizeTest(ctx context.Context, flag uint64, logic LogicalPlan) (LogicalPlan, error) {
 	return logicalOptimize(ctx, flag, logic)
@@ -688,6 +688,13 @@ type PhysicalIndexScan struct {
 	// e.g. the index is (a, b, c) and there's filter a = 1 and b = 2, then the column a and b are const part.
 	constColsByCond []bool
 
+	// SkipScanPrefixLen is the number of the leading index columns whose duplicated values are skipped when the
+	// index is read, 0 means the index isn't skip scanned.
+	SkipScanPrefixLen int
+	// SkipScanRanges are the ranges of the index columns after the skipped prefix, they're scanned for each distinct
+	// prefix. If they're empty, only one entry is read for each distinct prefix.
+	SkipScanRanges []*ranger.Range
+
 	prop *property.PhysicalProperty
 }
 
@@ -711,6 +718,7 @@ func (p *PhysicalIndexScan) Clone() (PhysicalPlan, error) {
 	cloned.IdxColLens = make([]int, len(p.IdxColLens))
 	copy(cloned.IdxColLens, p.IdxColLens)
 	cloned.Ranges = util.CloneRanges(p.Ranges)
+	cloned.SkipScanRanges = util.CloneRanges(p.SkipScanRanges)
 	cloned.Columns = util.CloneColInfos(p.Columns)
 	if p.dataSourceSchema != nil {
 		cloned.dataSourceSchema = p.dataSourceSchema.Clone()
@@ -762,6 +770,9 @@ func (p *PhysicalIndexScan) MemoryUsage() (sum int64) {
 	for _, rang := range p.Ranges {
 		sum += rang.MemUsage()
 	}
+	for _, rang := range p.SkipScanRanges {
+		sum += rang.MemUsage()
+	}
 	for iid, expr := range p.GenExprs {
 		sum += int64(unsafe.Sizeof(iid)) + expr.MemoryUsage()
 	}
@@ -1265,6 +1276,16 @@ func (p *basePhysicalJoin) MemoryUsage() (sum int64) {
 	return
 }
 
+// RuntimeFilter is the filter of a hash join generated from its build side keys after the build side is finished. It's
+// pushed to the table scan of the probe side, so the probe rows which can't be joined aren't sent back.
+type RuntimeFilter struct {
+	// KeyIdx is the index of the equal condition whose build side keys generate the filter.
+	KeyIdx int
+	// ProbeCol is the column of the probe side table scan filtered by the build side keys, whose index is the offset
+	// in the schema of the table scan.
+	ProbeCol *expression.Column
+}
+
 // PhysicalHashJoin represents hash join implementation of LogicalJoin.
 type PhysicalHashJoin struct {
 	basePhysicalJoin
@@ -1277,9 +1298,25 @@ type PhysicalHashJoin struct {
 	// use the outer table to build a hash table when the outer table is smaller.
 	UseOuterToBuild bool
 
+	// PartitionWise indicates the children are partitioned on the join key with the same partition scheme, so the
+	// corresponding partitions of the children are joined independently and in parallel.
+	PartitionWise bool
+
+	// RuntimeFilter is the filter generated from the build side keys, which is pushed to the table scan of the probe
+	// side, nil means no runtime filter.
+	RuntimeFilter *RuntimeFilter
+
+	// AdaptiveIndexJoin is the index join of the same join whose inner side is the build side, the executor switches
+	// to it when the build side has far more rows than estimated. It's a PhysicalIndexJoin or a PhysicalIndexHashJoin,
+	// nil means the join never switches.
+	AdaptiveIndexJoin PhysicalPlan
+
 	// on which store the join executes.
 	storeTp        kv.StoreType
 	mppShuffleJoin bool
+	// mppSkewSaltNum is the number of salts to spread the rows of skewed join keys in the shuffle join, 0 means
+	// the join keys are not salted.
+	mppSkewSaltNum int
 }
 
 // Clone implements PhysicalPlan interface.
@@ -1292,6 +1329,12 @@ func (p *PhysicalHashJoin) Clone() (PhysicalPlan, error) {
 	cloned.basePhysicalJoin = *base
 	cloned.Concurrency = p.Concurrency
 	cloned.UseOuterToBuild = p.UseOuterToBuild
+	cloned.PartitionWise = p.PartitionWise
+	if p.RuntimeFilter != nil {
+		cloned.RuntimeFilter = &RuntimeFilter{KeyIdx: p.RuntimeFilter.KeyIdx, ProbeCol: p.RuntimeFilter.ProbeCol.Clone().(*expression.Column)}
+	}
+	// The index joins can't be cloned, so the cloned join never switches to the index join.
+	cloned.mppSkewSaltNum = p.mppSkewSaltNum
 	for _, c := range p.EqualConditions {
 		cloned.EqualConditions = append(cloned.EqualConditions, c.Clone().(*expression.ScalarFunction))
 	}
@@ -1703,6 +1746,9 @@ type PhysicalUnionAll struct {
 	physicalSchemaProducer
 
 	mpp bool
+	// SortItems are the columns the children are ordered by, the rows of the children are merged in the order if
+	// it's not empty.
+	SortItems []property.SortItem
 }
 
 // Clone implements PhysicalPlan interface.
@@ -1713,6 +1759,13 @@ func (p *PhysicalUnionAll) Clone() (PhysicalPlan, error) {
 		return nil, err
 	}
 	cloned.physicalSchemaProducer = *base
+	cloned.mpp = p.mpp
+	if p.SortItems != nil {
+		cloned.SortItems = make([]property.SortItem, 0, len(p.SortItems))
+		for _, it := range p.SortItems {
+			cloned.SortItems = append(cloned.SortItems, it.Clone())
+		}
+	}
 	return cloned, nil
 }
 
@@ -1722,7 +1775,11 @@ func (p *PhysicalUnionAll) MemoryUsage() (sum int64) {
 		return
 	}
 
-	return p.physicalSchemaProducer.MemoryUsage() + size.SizeOfBool
+	sum = p.physicalSchemaProducer.MemoryUsage() + size.SizeOfBool + size.SizeOfSlice
+	for _, item := range p.SortItems {
+		sum += item.MemoryUsage()
+	}
+	return
 }
 
 // AggMppRunMode defines the running mode of aggregation in MPP
@@ -2354,6 +2411,26 @@ func (p *PhysicalShowDDLJobs) MemoryUsage() (sum int64) {
 	return p.physicalSchemaProducer.MemoryUsage() + size.SizeOfInt64
 }
 
+// PhysicalTableFunction is the physical plan of LogicalTableFunction.
+type PhysicalTableFunction struct {
+	physicalSchemaProducer
+
+	FuncName model.CIStr
+	Args     []expression.Expression
+}
+
+// MemoryUsage return the memory usage of PhysicalTableFunction
+func (p *PhysicalTableFunction) MemoryUsage() (sum int64) {
+	if p == nil {
+		return
+	}
+	sum = p.physicalSchemaProducer.MemoryUsage() + p.FuncName.MemoryUsage() + size.SizeOfSlice
+	for _, arg := range p.Args {
+		sum += arg.MemoryUsage()
+	}
+	return
+}
+
 // BuildMergeJoinPlan builds a PhysicalMergeJoin from the given fields. Currently, it is only used for test purpose.
 func BuildMergeJoinPlan(ctx sessionctx.Context, joinType JoinType, leftKeys, rightKeys []*expression.Column) *PhysicalMergeJoin {
 	baseJoin := basePhysicalJoin{
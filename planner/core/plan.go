@@ -790,6 +790,11 @@ func (p *basePlan) ID() int {
 	return p.id
 }
 
+// setID sets the ID of the plan, which gives the cloned plans their own IDs.
+func (p *basePlan) setID(id int) {
+	p.id = id
+}
+
 // property.StatsInfo implements the Plan interface.
 func (p *basePlan) statsInfo() *property.StatsInfo {
 	return p.stats
@@ -0,0 +1,72 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/tidb/planner/property"
+	"github.com/pingcap/tidb/sessionctx/variable"
+)
+
+// CostModel calculates the costs of the physical plans instead of the built-in cost model, e.g. a cost model
+// calibrated for the hardware of the cluster. It's registered by RegisterCostModel and selected by tidb_cost_model.
+type CostModel interface {
+	// PlanCost returns the cost of the plan running as the task type, including the costs of its children.
+	PlanCost(p PhysicalPlan, taskType property.TaskType, option *PlanCostOption) (float64, error)
+}
+
+var costModels = struct {
+	sync.RWMutex
+	models map[string]CostModel
+}{models: make(map[string]CostModel)}
+
+// RegisterCostModel registers the cost model with the case-insensitive name.
+// It's expected to be called in the init functions and panics if the name has been registered.
+func RegisterCostModel(name string, model CostModel) {
+	name = strings.ToLower(name)
+	costModels.Lock()
+	defer costModels.Unlock()
+	if _, ok := costModels.models[name]; ok {
+		panic(fmt.Sprintf("cost model %s has been registered", name))
+	}
+	costModels.models[name] = model
+}
+
+func getCostModel(name string) (CostModel, bool) {
+	costModels.RLock()
+	defer costModels.RUnlock()
+	model, ok := costModels.models[strings.ToLower(name)]
+	return model, ok
+}
+
+// BuiltinPlanCost returns the cost of the plan calculated by the built-in cost model of tidb_cost_model_version,
+// so the registered cost models can adjust it instead of calculating the cost from scratch.
+func BuiltinPlanCost(p PhysicalPlan, taskType property.TaskType, option *PlanCostOption) (float64, error) {
+	if p.SCtx().GetSessionVars().CostModelVersion == modelVer2 {
+		planCost, err := p.getPlanCostVer2(taskType, option)
+		return planCost.cost, err
+	}
+	return p.getPlanCostVer1(taskType, option)
+}
+
+func init() {
+	variable.CostModelRegistered = func(name string) bool {
+		_, ok := getCostModel(name)
+		return ok
+	}
+}
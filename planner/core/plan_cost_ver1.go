@@ -1220,13 +1220,21 @@ func (p *PhysicalUnionAll) getPlanCostVer1(taskType property.TaskType, option *P
 	if p.planCostInit && !hasCostFlag(costFlag, CostFlagRecalculate) {
 		return p.planCost, nil
 	}
-	var childMaxCost float64
+	var childMaxCost, childSumCost float64
 	for _, child := range p.children {
 		childCost, err := child.getPlanCostVer1(taskType, option)
 		if err != nil {
 			return 0, err
 		}
 		childMaxCost = math.Max(childMaxCost, childCost)
+		childSumCost += childCost
+	}
+	if len(p.SortItems) > 0 {
+		// The children are read one by one to merge their rows, and each row is compared to find the next one.
+		rows := getCardinality(p, costFlag)
+		p.planCost = childSumCost + rows*math.Log2(float64(len(p.children)))*p.ctx.GetSessionVars().GetCPUFactor()
+		p.planCostInit = true
+		return p.planCost, nil
 	}
 	p.planCost = childMaxCost + float64(1+len(p.children))*p.ctx.GetSessionVars().GetConcurrencyFactor()
 	p.planCostInit = true
@@ -36,11 +36,12 @@ func GetPlanCost(p PhysicalPlan, taskType property.TaskType, option *PlanCostOpt
 }
 
 func getPlanCost(p PhysicalPlan, taskType property.TaskType, option *PlanCostOption) (float64, error) {
-	if p.SCtx().GetSessionVars().CostModelVersion == modelVer2 {
-		planCost, err := p.getPlanCostVer2(taskType, option)
-		return planCost.cost, err
+	if name := p.SCtx().GetSessionVars().CostModel; name != "" {
+		if model, ok := getCostModel(name); ok {
+			return model.PlanCost(p, taskType, option)
+		}
 	}
-	return p.getPlanCostVer1(taskType, option)
+	return BuiltinPlanCost(p, taskType, option)
 }
 
 // getPlanCostVer2 calculates the cost of the plan if it has not been calculated yet and returns the cost.
@@ -660,6 +661,8 @@ func (p *PhysicalApply) getPlanCostVer2(taskType property.TaskType, option *Plan
 
 // getPlanCostVer2 calculates the cost of the plan if it has not been calculated yet and returns the cost.
 // plan-cost = sum(child-cost) / concurrency
+// The children merged in order are read in a single thread:
+// plan-cost = sum(child-cost) + merge-cpu-cost
 func (p *PhysicalUnionAll) getPlanCostVer2(taskType property.TaskType, option *PlanCostOption) (costVer2, error) {
 	if p.planCostInit && !hasCostFlag(option.CostFlag, CostFlagRecalculate) {
 		return p.planCostVer2, nil
@@ -674,6 +677,17 @@ func (p *PhysicalUnionAll) getPlanCostVer2(taskType property.TaskType, option *P
 		}
 		childCosts = append(childCosts, childCost)
 	}
+	if len(p.SortItems) > 0 {
+		rows := getCardinality(p, option.CostFlag)
+		cpuFactor := getTaskCPUFactorVer2(p, taskType)
+		numChildren := float64(len(p.children))
+		mergeCost := newCostVer2(option, cpuFactor,
+			rows*math.Log2(numChildren)*cpuFactor.Value,
+			func() string { return fmt.Sprintf("mergeCPU(%v*log(%v)*%v)", rows, numChildren, cpuFactor) })
+		p.planCostVer2 = sumCostVer2(sumCostVer2(childCosts...), mergeCost)
+		p.planCostInit = true
+		return p.planCostVer2, nil
+	}
 	p.planCostVer2 = divCostVer2(sumCostVer2(childCosts...), concurrency)
 	p.planCostInit = true
 	return p.planCostVer2, nil
@@ -309,3 +309,51 @@ func BenchmarkGetPlanCost(b *testing.B) {
 		_, _ = core.GetPlanCost(phyPlan, property.RootTaskType, core.NewDefaultPlanCostOption().WithCostFlag(core.CostFlagRecalculate))
 	}
 }
+
+type indexPenaltyCostModel struct{}
+
+func (indexPenaltyCostModel) PlanCost(p core.PhysicalPlan, taskType property.TaskType, option *core.PlanCostOption) (float64, error) {
+	cost, err := core.BuiltinPlanCost(p, taskType, option)
+	if err != nil {
+		return 0, err
+	}
+	if hasIndexAccess(p) {
+		cost *= 1e6
+	}
+	return cost, nil
+}
+
+func hasIndexAccess(p core.PhysicalPlan) bool {
+	switch p.(type) {
+	case *core.PhysicalIndexScan, *core.PhysicalIndexReader, *core.PhysicalIndexLookUpReader, *core.PhysicalIndexMergeReader:
+		return true
+	}
+	for _, child := range p.Children() {
+		if hasIndexAccess(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRegisteredCostModel(t *testing.T) {
+	core.RegisterCostModel("Index_Penalty", indexPenaltyCostModel{})
+	require.Panics(t, func() { core.RegisterCostModel("index_penalty", indexPenaltyCostModel{}) })
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, key(a))")
+
+	tk.MustQuery("select @@tidb_cost_model").Check(testkit.Rows(""))
+	require.True(t, tk.HasPlan("select a from t where a = 1", "IndexRangeScan"))
+
+	tk.MustExec("set @@tidb_cost_model = 'INDEX_PENALTY'")
+	tk.MustQuery("select @@tidb_cost_model").Check(testkit.Rows("index_penalty"))
+	require.False(t, tk.HasPlan("select a from t where a = 1", "IndexRangeScan"))
+	require.True(t, tk.HasPlan("select a from t where a = 1", "TableFullScan"))
+
+	tk.MustGetErrMsg("set @@tidb_cost_model = 'unknown'", "[variable:1231]Variable 'tidb_cost_model' can't be set to the value of 'unknown'")
+	tk.MustExec("set @@tidb_cost_model = ''")
+	require.True(t, tk.HasPlan("select a from t where a = 1", "IndexRangeScan"))
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/auth"
 	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/format"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/opcode"
@@ -68,6 +69,7 @@ import (
 	"github.com/pingcap/tidb/util/stmtsummary"
 	"github.com/tikv/client-go/v2/tikv"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 type visitInfo struct {
@@ -102,12 +104,15 @@ type tableHintInfo struct {
 	limitHints          limitHintInfo
 	MergeHints          MergeHintInfo
 	leadingJoinOrder    []hintTableInfo
+	leadingList         *ast.LeadingList
 	hjBuildTables       []hintTableInfo
 	hjProbeTables       []hintTableInfo
 }
 
 type limitHintInfo struct {
-	preferLimitToCop bool
+	preferLimitToCop  bool
+	preferLimitToRoot bool
+	preferLimitToMPP  bool
 }
 
 // MergeHintInfo ...one bool flag for cte
@@ -161,8 +166,10 @@ func (hint *indexHintInfo) indexString() string {
 }
 
 type aggHintInfo struct {
-	preferAggType  uint
-	preferAggToCop bool
+	preferAggType   uint
+	preferAggToCop  bool
+	preferAggToRoot bool
+	preferAggToMPP  bool
 }
 
 // QueryTimeRange represents a time range specified by TIME_RANGE hint
@@ -483,6 +490,10 @@ const (
 	HintFlagNoDecorrelate
 )
 
+// maxWorkloadSimulatorConcurrency is the max number of the workers of ADMIN WORKLOAD SIMULATOR, each of them takes a
+// system session during the simulation.
+const maxWorkloadSimulatorConcurrency = 256
+
 // PlanBuilder builds Plan from an ast.Node.
 // It just builds the ast node straightforwardly.
 type PlanBuilder struct {
@@ -836,7 +847,7 @@ func (b *PlanBuilder) Build(ctx context.Context, node ast.Node) (Plan, error) {
 		*ast.GrantStmt, *ast.DropUserStmt, *ast.AlterUserStmt, *ast.RevokeStmt, *ast.KillStmt, *ast.DropStatsStmt,
 		*ast.GrantRoleStmt, *ast.RevokeRoleStmt, *ast.SetRoleStmt, *ast.SetDefaultRoleStmt, *ast.ShutdownStmt,
 		*ast.RenameUserStmt, *ast.NonTransactionalDMLStmt, *ast.SetSessionStatesStmt, *ast.SetResourceGroupStmt,
-		*ast.LoadDataActionStmt, *ast.CalibrateResourceStmt:
+		*ast.LoadDataActionStmt, *ast.CalibrateResourceStmt, *ast.CreateMaintenanceWindowStmt, *ast.DropMaintenanceWindowStmt:
 		return b.buildSimple(ctx, node.(ast.StmtNode))
 	case ast.DDLNode:
 		return b.buildDDL(ctx, x)
@@ -1339,6 +1350,12 @@ func getPossibleAccessPaths(ctx sessionctx.Context, tableHints *tableHintInfo, i
 			if !optimizerUseInvisibleIndexes && index.Invisible {
 				continue
 			}
+			// The entries of fulltext indexes are the tokens of the column values, so they can't be used
+			// as access paths. They are only read by MATCH ... AGAINST, see generateIndexMerge4FulltextIndex.
+			// The columnar indexes have no entries in TiKV, they are read by the TiFlash paths.
+			if index.Tp == model.IndexTypeFulltext || index.Tp == model.IndexTypeColumnar {
+				continue
+			}
 			if tblInfo.IsCommonHandle && index.Primary {
 				continue
 			}
@@ -1356,6 +1373,10 @@ func getPossibleAccessPaths(ctx sessionctx.Context, tableHints *tableHintInfo, i
 			publicPaths = append(publicPaths, &util.AccessPath{Index: index})
 		}
 	}
+	// The hypothetical indexes of EXPLAIN are considered as if they were public.
+	for _, index := range ctx.GetSessionVars().StmtCtx.HypoIndexes[tblInfo.ID] {
+		publicPaths = append(publicPaths, &util.AccessPath{Index: index})
+	}
 
 	hasScanHint, hasUseOrForce := false, false
 	available := make([]*util.AccessPath, 0, len(publicPaths))
@@ -1600,6 +1621,30 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (Plan,
 		p := &CleanupIndex{Table: as.Tables[0], IndexName: as.Index}
 		p.setSchemaAndNames(buildCleanupIndexFields())
 		ret = p
+	case ast.AdminVerifyIndex:
+		p := &VerifyIndex{Table: as.Tables[0], IndexName: as.Index, Rate: as.VerifyRate, Repair: as.VerifyRepair}
+		p.setSchemaAndNames(buildVerifyIndexFields())
+		ret = p
+	case ast.AdminWorkloadSimulator:
+		if err := b.checkWorkloadSimulatorDDL(ctx, as.Tables[0], as.WorkloadDDL); err != nil {
+			return nil, err
+		}
+		if as.WorkloadConcurrency > maxWorkloadSimulatorConcurrency {
+			return nil, errors.Errorf("the CONCURRENCY of ADMIN WORKLOAD SIMULATOR should be no more than %d", maxWorkloadSimulatorConcurrency)
+		}
+		p := &WorkloadSimulator{Table: as.Tables[0], DDL: as.WorkloadDDL, Concurrency: int(as.WorkloadConcurrency), Rate: int(as.WorkloadRate)}
+		p.setSchemaAndNames(buildWorkloadSimulatorFields())
+		ret = p
+	case ast.AdminCheckGlobalIndex:
+		tblInfo := as.Tables[0].TableInfo
+		idxInfo := tblInfo.FindIndexByName(strings.ToLower(as.Index))
+		if idxInfo == nil || !idxInfo.Global || tblInfo.GetPartitionInfo() == nil {
+			return nil, errors.Errorf("global index %s does not exist", as.Index)
+		}
+		if idxInfo.State != model.StatePublic {
+			return nil, errors.Errorf("index %s state %s isn't public", as.Index, idxInfo.State)
+		}
+		ret = &CheckGlobalIndex{Table: as.Tables[0], IndexName: as.Index}
 	case ast.AdminChecksumTable:
 		p := &ChecksumTable{Tables: as.Tables}
 		p.setSchemaAndNames(buildChecksumTableSchema())
@@ -1612,6 +1657,14 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (Plan,
 		p := &ShowDDL{}
 		p.setSchemaAndNames(buildShowDDLFields())
 		ret = p
+	case ast.AdminShowDDLDependencies:
+		p := &ShowDDLDependencies{}
+		p.setSchemaAndNames(buildShowDDLDependenciesFields())
+		ret = p
+	case ast.AdminExplainRollbackDDLJob:
+		p := &ExplainRollbackDDLJob{JobID: as.JobIDs[0]}
+		p.setSchemaAndNames(buildExplainRollbackDDLJobFields())
+		ret = p
 	case ast.AdminShowDDLJobs:
 		p := LogicalShowDDLJobs{JobNumber: as.JobNumber}.Init(b.ctx)
 		p.setSchemaAndNames(buildShowDDLJobsFields())
@@ -1654,6 +1707,8 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (Plan,
 		return &ReloadExprPushdownBlacklist{}, nil
 	case ast.AdminReloadOptRuleBlacklist:
 		return &ReloadOptRuleBlacklist{}, nil
+	case ast.AdminReloadQueryRewriteRules:
+		return &ReloadQueryRewriteRules{}, nil
 	case ast.AdminPluginEnable:
 		return &AdminPlugins{Action: Enable, Plugins: as.Plugins}, nil
 	case ast.AdminPluginDisable:
@@ -1675,12 +1730,18 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (Plan,
 		p := &AdminShowTelemetry{}
 		p.setSchemaAndNames(buildShowTelemetrySchema())
 		ret = p
+	case ast.AdminShowUnusedIndexes:
+		p := &AdminShowUnusedIndexes{}
+		p.setSchemaAndNames(buildShowUnusedIndexesSchema())
+		ret = p
 	case ast.AdminResetTelemetryID:
 		return &AdminResetTelemetryID{}, nil
 	case ast.AdminReloadStatistics:
 		return &Simple{Statement: as}, nil
 	case ast.AdminFlushPlanCache:
 		return &Simple{Statement: as}, nil
+	case ast.AdminWaitDDLJob:
+		ret = &Simple{Statement: as}
 	default:
 		return nil, ErrUnsupportedType.GenWithStack("Unsupported ast.AdminStmt(%T) for buildAdmin", as)
 	}
@@ -1690,6 +1751,50 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (Plan,
 	return ret, nil
 }
 
+// checkWorkloadSimulatorDDL checks the DDL run by ADMIN WORKLOAD SIMULATOR only changes the simulated table, and
+// collects the privileges needed by the DDL and the simulated load, since both of them are run by internal sessions.
+// The unqualified table name in the DDL refers to the database of the simulated table, where the DDL is run.
+func (b *PlanBuilder) checkWorkloadSimulatorDDL(ctx context.Context, tn *ast.TableName, ddl string) error {
+	stmt, err := parser.New().ParseOneStmt(ddl, "", "")
+	if err != nil {
+		return err
+	}
+	var target *ast.TableName
+	switch v := stmt.(type) {
+	case *ast.AlterTableStmt:
+		for _, spec := range v.Specs {
+			if spec.Tp == ast.AlterTableRenameTable || spec.Tp == ast.AlterTableExchangePartition ||
+				spec.Tp == ast.AlterTableDetachPartition || spec.Tp == ast.AlterTableAttachPartition {
+				return errors.Errorf("ADMIN WORKLOAD SIMULATOR doesn't run the DDL changing other tables")
+			}
+		}
+		target = v.Table
+	case *ast.CreateIndexStmt:
+		target = v.Table
+	case *ast.DropIndexStmt:
+		target = v.Table
+	default:
+		return errors.Errorf("ADMIN WORKLOAD SIMULATOR only runs ALTER TABLE, CREATE INDEX and DROP INDEX statements")
+	}
+	if target.Schema.L == "" {
+		target.Schema = tn.Schema
+	}
+	if target.Schema.L != tn.Schema.L || target.Name.L != tn.Name.L {
+		return errors.Errorf("the DDL of ADMIN WORKLOAD SIMULATOR should change the table %s.%s", tn.Schema.O, tn.Name.O)
+	}
+	if _, err := b.buildDDL(ctx, stmt.(ast.DDLNode)); err != nil {
+		return err
+	}
+	var selectErr, updateErr error
+	if user := b.ctx.GetSessionVars().User; user != nil {
+		selectErr = ErrTableaccessDenied.GenWithStackByArgs("SELECT", user.AuthUsername, user.AuthHostname, tn.Name.L)
+		updateErr = ErrTableaccessDenied.GenWithStackByArgs("UPDATE", user.AuthUsername, user.AuthHostname, tn.Name.L)
+	}
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SelectPriv, tn.Schema.L, tn.Name.L, "", selectErr)
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.UpdatePriv, tn.Schema.L, tn.Name.L, "", updateErr)
+	return nil
+}
+
 func (b *PlanBuilder) buildPhysicalIndexLookUpReader(_ context.Context, dbName model.CIStr, tbl table.Table, idx *model.IndexInfo) (Plan, error) {
 	tblInfo := tbl.Meta()
 	physicalID, isPartition := getPhysicalID(tbl)
@@ -1864,6 +1969,10 @@ func (b *PlanBuilder) buildPhysicalIndexLookUpReaders(ctx context.Context, dbNam
 			// Skip checking clustered index.
 			continue
 		}
+		if idxInfo.Tp == model.IndexTypeFulltext {
+			// Skip checking fulltext index, its entries can't be compared with the rows one by one.
+			continue
+		}
 		if idxInfo.State != model.StatePublic {
 			logutil.Logger(ctx).Info("build physical index lookup reader, the index isn't public",
 				zap.String("index", idxInfo.Name.O),
@@ -1942,11 +2051,17 @@ func (b *PlanBuilder) buildAdminCheckTable(ctx context.Context, as *ast.AdminStm
 			}
 		}
 		if idx == nil {
+			if idxInfo := tbl.Meta().FindIndexByName(idxName); idxInfo != nil && idxInfo.Tp == model.IndexTypeColumnar {
+				return nil, errors.Errorf("checking columnar index %s is not supported", as.Index)
+			}
 			return nil, errors.Errorf("secondary index %s does not exist", as.Index)
 		}
 		if idx.Meta().State != model.StatePublic {
 			return nil, errors.Errorf("index %s state %s isn't public", as.Index, idx.Meta().State)
 		}
+		if idx.Meta().Tp == model.IndexTypeFulltext {
+			return nil, errors.Errorf("checking fulltext index %s is not supported", as.Index)
+		}
 		p.CheckIndex = true
 		readerPlans, indexInfos, err = b.buildPhysicalIndexLookUpReaders(ctx, tblName.Schema, tbl, []table.Index{idx})
 	} else {
@@ -2329,6 +2444,14 @@ func getModifiedIndexesInfoForAnalyze(sctx sessionctx.Context, tblInfo *model.Ta
 			sctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", originIdx.Name.L))
 			continue
 		}
+		if originIdx.Tp == model.IndexTypeFulltext {
+			sctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing fulltext indexes is not supported, skip %s", originIdx.Name.L))
+			continue
+		}
+		if originIdx.Tp == model.IndexTypeColumnar {
+			sctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing columnar indexes is not supported, skip %s", originIdx.Name.L))
+			continue
+		}
 		if allColumns {
 			// If all the columns need to be analyzed, we don't need to modify IndexColumn.Offset.
 			idxsInfo = append(idxsInfo, originIdx)
@@ -2638,6 +2761,14 @@ func (b *PlanBuilder) buildAnalyzeTable(as *ast.AnalyzeTableStmt, opts map[ast.A
 				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", idx.Name.L))
 				continue
 			}
+			if idx.Tp == model.IndexTypeFulltext {
+				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing fulltext indexes is not supported, skip %s", idx.Name.L))
+				continue
+			}
+			if idx.Tp == model.IndexTypeColumnar {
+				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing columnar indexes is not supported, skip %s", idx.Name.L))
+				continue
+			}
 			for i, id := range physicalIDs {
 				if id == tbl.TableInfo.ID {
 					id = -1
@@ -2735,6 +2866,14 @@ func (b *PlanBuilder) buildAnalyzeIndex(as *ast.AnalyzeTableStmt, opts map[ast.A
 			b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", idx.Name.L))
 			continue
 		}
+		if idx.Tp == model.IndexTypeFulltext {
+			b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing fulltext indexes is not supported, skip %s", idx.Name.L))
+			continue
+		}
+		if idx.Tp == model.IndexTypeColumnar {
+			b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing columnar indexes is not supported, skip %s", idx.Name.L))
+			continue
+		}
 		for i, id := range physicalIDs {
 			if id == tblInfo.ID {
 				id = -1
@@ -2781,6 +2920,14 @@ func (b *PlanBuilder) buildAnalyzeAllIndex(as *ast.AnalyzeTableStmt, opts map[as
 				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing multi-valued indexes is not supported, skip %s", idx.Name.L))
 				continue
 			}
+			if idx.Tp == model.IndexTypeFulltext {
+				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing fulltext indexes is not supported, skip %s", idx.Name.L))
+				continue
+			}
+			if idx.Tp == model.IndexTypeColumnar {
+				b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.Errorf("analyzing columnar indexes is not supported, skip %s", idx.Name.L))
+				continue
+			}
 
 			for i, id := range physicalIDs {
 				if id == tblInfo.ID {
@@ -2980,6 +3127,9 @@ func (b *PlanBuilder) buildAnalyze(as *ast.AnalyzeTableStmt) (Plan, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(as.Exprs) > 0 {
+		return b.buildAnalyzeExprs(as, opts, statsVersion)
+	}
 	if as.IndexFlag {
 		if len(as.IndexNames) == 0 {
 			return b.buildAnalyzeAllIndex(as, opts, statsVersion)
@@ -2989,6 +3139,77 @@ func (b *PlanBuilder) buildAnalyze(as *ast.AnalyzeTableStmt) (Plan, error) {
 	return b.buildAnalyzeTable(as, opts, statsVersion)
 }
 
+// buildAnalyzeExprs builds the plan for `ANALYZE TABLE ... UPDATE/DROP HISTOGRAM ON (expr)`. The histograms on the
+// expressions are registered as the extended stats, and they are built from the row samples when analyzing the table.
+func (b *PlanBuilder) buildAnalyzeExprs(as *ast.AnalyzeTableStmt, opts map[ast.AnalyzeOptionType]uint64, version int) (Plan, error) {
+	if !b.ctx.GetSessionVars().EnableExtendedStats {
+		return nil, errors.New("Extended statistics feature is not generally available now, and tidb_enable_extended_stats is OFF")
+	}
+	tbl := as.TableNames[0]
+	if tbl.TableInfo.IsView() || tbl.TableInfo.IsSequence() || tbl.TableInfo.GetPartitionInfo() != nil {
+		return nil, errors.Errorf("histograms on expressions are only supported for non-partitioned tables")
+	}
+	exprStats := make([]AnalyzeExprStats, 0, len(as.Exprs))
+	for _, exprNode := range as.Exprs {
+		// Remove the qualifiers of the columns so that the expression can be matched with any alias of the table.
+		exprNode.Accept(&columnQualifierRemover{})
+		var sb strings.Builder
+		if err := exprNode.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+			return nil, errors.Trace(err)
+		}
+		exprStr := sb.String()
+		expr, err := expression.RewriteSimpleExprWithTableInfo(b.ctx, tbl.TableInfo, exprNode, false)
+		if err != nil {
+			return nil, err
+		}
+		if expression.IsMutableEffectsExpr(expr) {
+			return nil, errors.Errorf("histograms on non-deterministic expression %s are not supported", exprStr)
+		}
+		cols := expression.ExtractColumns(expr)
+		if len(cols) == 0 {
+			return nil, errors.Errorf("histograms on expression %s which references no column are not supported", exprStr)
+		}
+		colIDs := make([]int64, 0, len(cols))
+		for _, col := range cols {
+			if !slices.Contains(colIDs, col.ID) {
+				colIDs = append(colIDs, col.ID)
+			}
+		}
+		exprStats = append(exprStats, AnalyzeExprStats{
+			TableID: tbl.TableInfo.ID,
+			Name:    statistics.ExprStatsName(exprStr),
+			Expr:    exprStr,
+			ColIDs:  colIDs,
+		})
+	}
+	if as.HistogramOperation == ast.HistogramOperationDrop {
+		return &Analyze{ExprStats: exprStats, DropExprStats: true}, nil
+	}
+	if version < statistics.Version2 {
+		return nil, errors.Errorf("Only the analyze version 2 supports histograms on expressions")
+	}
+	p, err := b.buildAnalyzeTable(as, opts, version)
+	if err != nil {
+		return nil, err
+	}
+	p.(*Analyze).ExprStats = exprStats
+	return p, nil
+}
+
+type columnQualifierRemover struct{}
+
+func (*columnQualifierRemover) Enter(in ast.Node) (ast.Node, bool) {
+	if col, ok := in.(*ast.ColumnName); ok {
+		col.Schema = model.CIStr{}
+		col.Table = model.CIStr{}
+	}
+	return in, false
+}
+
+func (*columnQualifierRemover) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
 func buildShowNextRowID() (*expression.Schema, types.NameSlice) {
 	schema := newColumnsWithNames(4)
 	schema.Append(buildColumnWithName("", "DB_NAME", mysql.TypeVarchar, mysql.MaxDatabaseNameLength))
@@ -3024,6 +3245,30 @@ func buildCleanupIndexFields() (*expression.Schema, types.NameSlice) {
 	return schema.col2Schema(), schema.names
 }
 
+func buildVerifyIndexFields() (*expression.Schema, types.NameSlice) {
+	schema := newColumnsWithNames(6)
+	schema.Append(buildColumnWithName("", "TABLE_NAME", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "INDEX_NAME", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "CHECKED_ROWS", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "INCONSISTENT_ROWS", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "REPAIRED_ROWS", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "INCONSISTENT_HANDLES", mysql.TypeVarchar, 256))
+	return schema.col2Schema(), schema.names
+}
+
+func buildWorkloadSimulatorFields() (*expression.Schema, types.NameSlice) {
+	schema := newColumnsWithNames(8)
+	schema.Append(buildColumnWithName("", "PHASE", mysql.TypeVarchar, 16))
+	schema.Append(buildColumnWithName("", "OPERATIONS", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "ERRORS", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "DURATION", mysql.TypeVarchar, 32))
+	schema.Append(buildColumnWithName("", "P50_LATENCY", mysql.TypeVarchar, 32))
+	schema.Append(buildColumnWithName("", "P90_LATENCY", mysql.TypeVarchar, 32))
+	schema.Append(buildColumnWithName("", "P99_LATENCY", mysql.TypeVarchar, 32))
+	schema.Append(buildColumnWithName("", "MAX_LATENCY", mysql.TypeVarchar, 32))
+	return schema.col2Schema(), schema.names
+}
+
 func buildShowDDLJobsFields() (*expression.Schema, types.NameSlice) {
 	schema := newColumnsWithNames(12)
 	schema.Append(buildColumnWithName("", "JOB_ID", mysql.TypeLonglong, 4))
@@ -3041,6 +3286,30 @@ func buildShowDDLJobsFields() (*expression.Schema, types.NameSlice) {
 	return schema.col2Schema(), schema.names
 }
 
+func buildShowDDLDependenciesFields() (*expression.Schema, types.NameSlice) {
+	schema := newColumnsWithNames(6)
+	schema.Append(buildColumnWithName("", "JOB_ID", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "JOB_TYPE", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "SCHEMA_IDS", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "TABLE_IDS", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "STATE", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "DEPENDS_ON", mysql.TypeVarchar, 256))
+	return schema.col2Schema(), schema.names
+}
+
+func buildExplainRollbackDDLJobFields() (*expression.Schema, types.NameSlice) {
+	schema := newColumnsWithNames(8)
+	schema.Append(buildColumnWithName("", "JOB_ID", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "JOB_TYPE", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "SCHEMA_STATE", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "ROLLBACKABLE", mysql.TypeVarchar, 4))
+	schema.Append(buildColumnWithName("", "ROLLBACK_ACTION", mysql.TypeVarchar, 256))
+	schema.Append(buildColumnWithName("", "KEYS_TO_CLEAN", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "RANGES_TO_DELETE", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "ESTIMATED_DURATION", mysql.TypeVarchar, 64))
+	return schema.col2Schema(), schema.names
+}
+
 func buildTableRegionsSchema() (*expression.Schema, types.NameSlice) {
 	schema := newColumnsWithNames(13)
 	schema.Append(buildColumnWithName("", "REGION_ID", mysql.TypeLonglong, 4))
@@ -3079,6 +3348,19 @@ func buildShowDDLJobQueriesWithRangeFields() (*expression.Schema, types.NameSlic
 	return schema.col2Schema(), schema.names
 }
 
+func buildShowUnusedIndexesSchema() (*expression.Schema, types.NameSlice) {
+	longlongSize, _ := mysql.GetDefaultFieldLengthAndDecimal(mysql.TypeLonglong)
+	tinySize, _ := mysql.GetDefaultFieldLengthAndDecimal(mysql.TypeTiny)
+
+	schema := newColumnsWithNames(5)
+	schema.Append(buildColumnWithName("", "DB_NAME", mysql.TypeVarchar, mysql.MaxDatabaseNameLength))
+	schema.Append(buildColumnWithName("", "TABLE_NAME", mysql.TypeVarchar, mysql.MaxTableNameLength))
+	schema.Append(buildColumnWithName("", "INDEX_NAME", mysql.TypeVarchar, mysql.MaxIndexIdentifierLen))
+	schema.Append(buildColumnWithName("", "INDEX_ID", mysql.TypeLonglong, longlongSize))
+	schema.Append(buildColumnWithName("", "IS_UNIQUE", mysql.TypeTiny, tinySize))
+	return schema.col2Schema(), schema.names
+}
+
 func buildShowSlowSchema() (*expression.Schema, types.NameSlice) {
 	longlongSize, _ := mysql.GetDefaultFieldLengthAndDecimal(mysql.TypeLonglong)
 	tinySize, _ := mysql.GetDefaultFieldLengthAndDecimal(mysql.TypeTiny)
@@ -3379,6 +3661,9 @@ func (b *PlanBuilder) buildSimple(ctx context.Context, node ast.StmtNode) (Plan,
 	case *ast.AlterInstanceStmt:
 		err := ErrSpecificAccessDenied.GenWithStack("SUPER")
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", err)
+	case *ast.CreateMaintenanceWindowStmt, *ast.DropMaintenanceWindowStmt:
+		err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER")
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", err)
 	case *ast.RenameUserStmt:
 		err := ErrSpecificAccessDenied.GenWithStackByArgs("CREATE USER")
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreateUserPriv, "", "", "", err)
@@ -3423,15 +3708,20 @@ func (b *PlanBuilder) buildSimple(ctx context.Context, node ast.StmtNode) (Plan,
 		// In which case you require RESTRICTED_CONNECTION_ADMIN to kill connections that belong to RESTRICTED_USER_ADMIN users.
 		sm := b.ctx.GetSessionManager()
 		if sm != nil {
-			if pi, ok := sm.GetProcessInfo(raw.ConnectionID); ok {
+			if pi, ok := sm.GetProcessInfo(raw.ConnectionID); ok && pi.Internal {
+				// Only the users with SUPER or CONNECTION_ADMIN privilege can kill the internal sessions.
+				err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or CONNECTION_ADMIN")
+				b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "CONNECTION_ADMIN", false, err)
+			} else if ok {
 				loginUser := b.ctx.GetSessionVars().User
 				if pi.User != loginUser.Username {
 					err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or CONNECTION_ADMIN")
 					b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "CONNECTION_ADMIN", false, err)
 					b.visitInfo = appendVisitInfoIsRestrictedUser(b.visitInfo, b.ctx, &auth.UserIdentity{Username: pi.User, Hostname: pi.Host}, "RESTRICTED_CONNECTION_ADMIN")
 				}
-			} else if raw.ConnectionID == util2.GetAutoAnalyzeProcID(domain.GetDomain(b.ctx).ServerID) {
-				// Only the users with SUPER or CONNECTION_ADMIN privilege can kill auto analyze.
+			} else if util2.IsReservedProcID(raw.ConnectionID) {
+				// Only the users with SUPER or CONNECTION_ADMIN privilege can kill auto analyze and the internal
+				// sessions, which may be running on the other TiDB servers.
 				err := ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or CONNECTION_ADMIN")
 				b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "CONNECTION_ADMIN", false, err)
 			}
@@ -4156,7 +4446,7 @@ func (b *PlanBuilder) buildSelectPlanOfInsert(ctx context.Context, insert *ast.I
 	}
 
 	names := selectPlan.OutputNames()
-	insertPlan.SelectPlan, _, err = DoOptimize(ctx, b.ctx, b.optFlag, selectPlan.(LogicalPlan))
+	insertPlan.SelectPlan, _, err = doOptimizeDMLSource(ctx, b.ctx, b.optFlag, selectPlan.(LogicalPlan))
 	if err != nil {
 		return err
 	}
@@ -4553,7 +4843,8 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, dbName,
 			v.Table.Name.L, "", authErr)
 		for _, spec := range v.Specs {
-			if spec.Tp == ast.AlterTableRenameTable || spec.Tp == ast.AlterTableExchangePartition {
+			if spec.Tp == ast.AlterTableRenameTable || spec.Tp == ast.AlterTableExchangePartition ||
+				spec.Tp == ast.AlterTableDetachPartition || spec.Tp == ast.AlterTableAttachPartition {
 				if b.ctx.GetSessionVars().User != nil {
 					authErr = ErrTableaccessDenied.GenWithStackByArgs("DROP", b.ctx.GetSessionVars().User.AuthUsername,
 						b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
@@ -4664,6 +4955,19 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 		}
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreatePriv, v.Table.Schema.L,
 			v.Table.Name.L, "", authErr)
+		if v.Select != nil {
+			if v.TemporaryKeyword != ast.TemporaryNone {
+				return nil, dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("CREATE TEMPORARY TABLE ... SELECT")
+			}
+			// Building the SELECT checks its privileges, and the columns of the new table are derived from its output.
+			plan, err := b.Build(ctx, v.Select)
+			if err != nil {
+				return nil, err
+			}
+			if err = buildCreateTableSelectColumns(v, plan.(LogicalPlan)); err != nil {
+				return nil, err
+			}
+		}
 		if v.ReferTable != nil {
 			if b.ctx.GetSessionVars().User != nil {
 				authErr = ErrTableaccessDenied.GenWithStackByArgs("CREATE", b.ctx.GetSessionVars().User.AuthUsername,
@@ -4730,6 +5034,19 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 		}
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.DropPriv, v.Name.L,
 			"", "", authErr)
+	case *ast.RenameDatabaseStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = ErrDBaccessDenied.GenWithStackByArgs(b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.OldName)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.OldName.L, "", "", authErr)
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.DropPriv, v.OldName.L, "", "", authErr)
+
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = ErrDBaccessDenied.GenWithStackByArgs(b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.NewName)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreatePriv, v.NewName.L, "", "", authErr)
 	case *ast.DropIndexStmt:
 		if b.ctx.GetSessionVars().User != nil {
 			authErr = ErrTableaccessDenied.GenWithStackByArgs("INDEX", b.ctx.GetSessionVars().User.AuthUsername,
@@ -4737,6 +5054,13 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 		}
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.IndexPriv, v.Table.Schema.L,
 			v.Table.Name.L, "", authErr)
+	case *ast.UndropIndexStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = ErrTableaccessDenied.GenWithStackByArgs("INDEX", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.IndexPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
 	case *ast.DropTableStmt:
 		for _, tableVal := range v.Tables {
 			if b.ctx.GetSessionVars().User != nil {
@@ -4968,6 +5292,22 @@ func (b *PlanBuilder) buildExplain(ctx context.Context, explain *ast.ExplainStmt
 	if show, ok := explain.Stmt.(*ast.ShowStmt); ok {
 		return b.buildShow(ctx, show)
 	}
+	if len(explain.HypoIndexes) > 0 {
+		if strings.ToLower(explain.Format) == types.ExplainFormatPlanCache {
+			return nil, ErrNotSupportedYet.GenWithStackByArgs("hypothetical index with the plan_cache format")
+		}
+		hypoIndexes, err := b.buildHypoIndexes(explain.HypoIndexes)
+		if err != nil {
+			return nil, err
+		}
+		sc := b.ctx.GetSessionVars().StmtCtx
+		// The plan with the hypothetical indexes can't be executed.
+		sc.SetSkipPlanCache(errors.New("query with hypothetical indexes is un-cacheable"))
+		sc.HypoIndexes = hypoIndexes
+		defer func() {
+			sc.HypoIndexes = nil
+		}()
+	}
 	targetPlan, _, err := OptimizeAstNode(ctx, b.ctx, explain.Stmt, b.is)
 	if err != nil {
 		return nil, err
@@ -5301,6 +5641,66 @@ func adjustOverlongViewColname(plan LogicalPlan) {
 	}
 }
 
+// buildCreateTableSelectColumns appends the output columns of the SELECT in 'CREATE TABLE ... SELECT' to the
+// column definitions, the output columns which have the same names as the defined columns are filled into them.
+// It's idempotent since the statement may be planned more than once.
+func buildCreateTableSelectColumns(stmt *ast.CreateTableStmt, plan LogicalPlan) error {
+	defined := make(map[string]struct{}, len(stmt.Cols))
+	for _, col := range stmt.Cols {
+		defined[col.Name.Name.L] = struct{}{}
+	}
+	outputs := make(map[string]struct{}, plan.Schema().Len())
+	cols := make([]*ast.ColumnDef, 0, plan.Schema().Len())
+	for i, name := range plan.OutputNames() {
+		if _, ok := outputs[name.ColName.L]; ok {
+			return infoschema.ErrColumnExists.GenWithStackByArgs(name.ColName.O)
+		}
+		outputs[name.ColName.L] = struct{}{}
+		if _, ok := defined[name.ColName.L]; ok {
+			continue
+		}
+		cols = append(cols, &ast.ColumnDef{
+			Name: &ast.ColumnName{Name: name.ColName},
+			Tp:   buildCreateTableSelectColumnType(plan.Schema().Columns[i].RetType),
+		})
+	}
+	stmt.Cols = append(stmt.Cols, cols...)
+	return nil
+}
+
+// buildCreateTableSelectColumnType converts the type of an output column of the SELECT to a column type.
+func buildCreateTableSelectColumnType(retType *types.FieldType) *types.FieldType {
+	tp := retType.Clone()
+	tp.SetFlag(tp.GetFlag() & (mysql.NotNullFlag | mysql.UnsignedFlag | mysql.BinaryFlag))
+	switch tp.GetType() {
+	case mysql.TypeNull:
+		// The column of NULL is BINARY(0) as MySQL.
+		tp = types.NewFieldType(mysql.TypeString)
+		tp.SetFlen(0)
+		tp.SetCharset(charset.CharsetBin)
+		tp.SetCollate(charset.CollationBin)
+		tp.AddFlag(mysql.BinaryFlag)
+	case mysql.TypeVarString, mysql.TypeVarchar:
+		tp.SetType(mysql.TypeVarchar)
+		// The overlong or unknown length string is stored as TEXT or BLOB.
+		chs, err := charset.GetCharsetInfo(tp.GetCharset())
+		if err != nil || tp.GetFlen() == types.UnspecifiedLength || tp.GetFlen()*chs.Maxlen > mysql.MaxFieldVarCharLength {
+			tp.SetType(mysql.TypeLongBlob)
+			tp.SetFlen(types.UnspecifiedLength)
+		}
+	case mysql.TypeNewDecimal:
+		if tp.GetFlen() == types.UnspecifiedLength || tp.GetDecimal() == types.UnspecifiedLength {
+			tp.SetFlen(mysql.MaxDecimalWidth)
+			tp.SetDecimal(mysql.MaxDecimalScale)
+		}
+	case mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+		if tp.GetDecimal() == types.UnspecifiedLength {
+			tp.SetDecimal(types.MaxFsp)
+		}
+	}
+	return tp
+}
+
 // findStmtAsViewSchema finds the first SelectStmt as the schema for the view
 func findStmtAsViewSchema(stmt ast.Node) *ast.SelectStmt {
 	switch x := stmt.(type) {
@@ -285,6 +285,9 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 	case *ast.DropDatabaseStmt:
 		p.stmtTp = TypeDrop
 		p.checkDropDatabaseGrammar(node)
+	case *ast.RenameDatabaseStmt:
+		p.stmtTp = TypeRename
+		p.checkRenameDatabaseGrammar(node)
 	case *ast.ShowStmt:
 		p.stmtTp = TypeShow
 		p.showTp = node.Tp
@@ -796,6 +799,16 @@ func (p *preprocessor) checkDropDatabaseGrammar(stmt *ast.DropDatabaseStmt) {
 	}
 }
 
+func (p *preprocessor) checkRenameDatabaseGrammar(stmt *ast.RenameDatabaseStmt) {
+	if isIncorrectName(stmt.OldName.L) {
+		p.err = dbterror.ErrWrongDBName.GenWithStackByArgs(stmt.OldName)
+		return
+	}
+	if isIncorrectName(stmt.NewName.L) {
+		p.err = dbterror.ErrWrongDBName.GenWithStackByArgs(stmt.NewName)
+	}
+}
+
 func (p *preprocessor) checkFlashbackTableGrammar(stmt *ast.FlashBackTableStmt) {
 	if isIncorrectName(stmt.NewName) {
 		p.err = dbterror.ErrWrongTableName.GenWithStackByArgs(stmt.NewName)
@@ -816,9 +829,12 @@ func (p *preprocessor) checkAdminCheckTableGrammar(stmt *ast.AdminStmt) {
 			return
 		}
 		tempTableType := tableInfo.Meta().TempTableType
-		if (stmt.Tp == ast.AdminCheckTable || stmt.Tp == ast.AdminChecksumTable || stmt.Tp == ast.AdminCheckIndex) && tempTableType != model.TempTableNone {
+		if (stmt.Tp == ast.AdminCheckTable || stmt.Tp == ast.AdminChecksumTable || stmt.Tp == ast.AdminCheckIndex ||
+			stmt.Tp == ast.AdminVerifyIndex) && tempTableType != model.TempTableNone {
 			if stmt.Tp == ast.AdminChecksumTable {
 				p.err = ErrOptOnTemporaryTable.GenWithStackByArgs("admin checksum table")
+			} else if stmt.Tp == ast.AdminVerifyIndex {
+				p.err = ErrOptOnTemporaryTable.GenWithStackByArgs("admin verify index")
 			} else if stmt.Tp == ast.AdminCheckTable {
 				p.err = ErrOptOnTemporaryTable.GenWithStackByArgs("admin check table")
 			} else {
@@ -920,9 +936,10 @@ func (p *preprocessor) checkCreateTableGrammar(stmt *ast.CreateTableStmt) {
 		return
 	}
 	if stmt.Select != nil {
-		// FIXME: a temp error noticing 'not implemented' (issue 4754)
-		p.err = errors.New("'CREATE TABLE ... SELECT' is not implemented yet")
-		return
+		if stmt.OnDuplicate != ast.OnDuplicateKeyHandlingError {
+			p.err = errors.New("'CREATE TABLE ... IGNORE/REPLACE SELECT' is not implemented yet")
+			return
+		}
 	} else if len(stmt.Cols) == 0 && stmt.ReferTable == nil {
 		p.err = dbterror.ErrTableMustHaveColumns
 		return
@@ -1074,6 +1091,8 @@ func isTableAliasDuplicate(node ast.ResultSetNode, tableAliases map[string]inter
 				} else {
 					tabName = tableNode.Name
 				}
+			} else if tf, ok := ts.Source.(*ast.TableFunction); ok {
+				tabName = tf.FnName
 			}
 		}
 		_, exists := tableAliases[tabName.L]
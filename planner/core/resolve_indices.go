@@ -136,6 +136,11 @@ func (p *PhysicalHashJoin) ResolveIndices() (err error) {
 	if err != nil {
 		return err
 	}
+	if p.AdaptiveIndexJoin != nil {
+		if err = p.AdaptiveIndexJoin.ResolveIndices(); err != nil {
+			return err
+		}
+	}
 	return p.ResolveIndicesItself()
 }
 
@@ -743,6 +748,24 @@ func (p *Insert) ResolveIndices() (err error) {
 	return
 }
 
+// ResolveIndices implements Plan interface.
+func (p *PhysicalUnionAll) ResolveIndices() (err error) {
+	err = p.physicalSchemaProducer.ResolveIndices()
+	if err != nil {
+		return err
+	}
+	// The children output the columns in the same order as the union all, so the sort items are resolved on its
+	// own schema.
+	for i, item := range p.SortItems {
+		newCol, err := item.Col.ResolveIndices(p.schema)
+		if err != nil {
+			return err
+		}
+		p.SortItems[i].Col = newCol.(*expression.Column)
+	}
+	return
+}
+
 func (p *physicalSchemaProducer) ResolveIndices() (err error) {
 	err = p.basePhysicalPlan.ResolveIndices()
 	return err
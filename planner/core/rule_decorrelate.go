@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/expression/aggregation"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/planner/property"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/plancodec"
 )
@@ -55,6 +56,36 @@ func (la *LogicalAggregation) canPullUp() bool {
 	return true
 }
 
+// canRemoveAgg checks if the aggregation under a semi apply can be removed. The aggregation with group by items only
+// decides whether the inner rows exist, so it doesn't affect the semi join if the join conditions don't reference it,
+// e.g. `exists (select 1 from t where t.a < t1.a group by t.b limit 1)`.
+func (la *LogicalApply) canRemoveAgg(agg *LogicalAggregation) bool {
+	if !la.JoinType.IsSemiJoin() || len(agg.GroupByItems) == 0 {
+		return false
+	}
+	conds := make([]expression.Expression, 0, len(la.EqualConditions)+len(la.NAEQConditions)+len(la.RightConditions)+len(la.OtherConditions))
+	conds = append(conds, expression.ScalarFuncs2Exprs(la.EqualConditions)...)
+	conds = append(conds, expression.ScalarFuncs2Exprs(la.NAEQConditions)...)
+	conds = append(conds, la.RightConditions...)
+	conds = append(conds, la.OtherConditions...)
+	for _, col := range expression.ExtractColumnsFromExpressions(nil, conds, nil) {
+		if agg.Schema().Contains(col) {
+			return false
+		}
+	}
+	return true
+}
+
+// canPullUpCorEqConds checks if the equal conditions below the aggregation, which contain the correlated columns, can
+// be pulled up as the join keys of the apply. The aggregation groups the inner rows by the join keys after that, so
+// the aggregation without group by items can't be decorrelated for the semi joins, since it always outputs one row.
+func (la *LogicalApply) canPullUpCorEqConds(agg *LogicalAggregation) bool {
+	if la.JoinType == LeftOuterJoin {
+		return true
+	}
+	return la.JoinType.IsSemiJoin() && len(agg.GroupByItems) > 0 && !la.isNAAJ()
+}
+
 // deCorColFromEqExpr checks whether it's an equal condition of form `col = correlated col`. If so we will change the decorrelated
 // column to normal column to make a new equal condition.
 func (la *LogicalApply) deCorColFromEqExpr(expr expression.Expression) expression.Expression {
@@ -85,6 +116,143 @@ func (la *LogicalApply) deCorColFromEqExpr(expr expression.Expression) expressio
 	return nil
 }
 
+// rewriteLimitToWindow rewrites the limit under the semi apply to the row_number() partitioned by the inner columns
+// which equal to the correlated columns, and returns the equal conditions to be pulled up as the join keys, e.g.
+// `t1.a in (select t2.a from t2 where t2.b = t1.b order by t2.c limit 2)` is rewritten to
+// `t1.a in (select t2.a from (select t2.a, t2.b, row_number() over (partition by t2.b order by t2.c) rn from t2) t2 where rn <= 2)`
+// with the join key `t1.b = t2.b`. The plan under the limit must be [Sort] -> [Projection...] -> Selection, and only the
+// equal conditions in the selection can contain the correlated columns. It returns nil if the limit can't be rewritten.
+func (la *LogicalApply) rewriteLimitToWindow(li *LogicalLimit) (LogicalPlan, []expression.Expression, error) {
+	if la.isNAAJ() || li.Count > math.MaxInt64-li.Offset {
+		return nil, nil, nil
+	}
+	outerSchema := la.children[0].Schema()
+	hasCorCols := func(exprs ...expression.Expression) bool {
+		for _, expr := range exprs {
+			if len(ExtractCorColumnsBySchema(expression.ExtractCorColumns(expr), outerSchema, false)) > 0 {
+				return true
+			}
+		}
+		return false
+	}
+	windowChild := li.children[0]
+	var orderBy []property.SortItem
+	if sort, ok := windowChild.(*LogicalSort); ok {
+		orderBy = make([]property.SortItem, 0, len(sort.ByItems))
+		for _, item := range sort.ByItems {
+			col, ok := item.Expr.(*expression.Column)
+			if !ok {
+				return nil, nil, nil
+			}
+			orderBy = append(orderBy, property.SortItem{Col: col, Desc: item.Desc})
+		}
+		windowChild = sort.children[0]
+	}
+	var projs []*LogicalProjection
+	p := windowChild
+	for {
+		proj, ok := p.(*LogicalProjection)
+		if !ok {
+			break
+		}
+		if hasCorCols(proj.Exprs...) {
+			return nil, nil, nil
+		}
+		projs = append(projs, proj)
+		p = proj.children[0]
+	}
+	sel, ok := p.(*LogicalSelection)
+	if !ok || len(extractCorColumnsBySchema4LogicalPlan(sel.children[0], outerSchema)) > 0 {
+		return nil, nil, nil
+	}
+	var (
+		joinConds    []expression.Expression
+		remainConds  []expression.Expression
+		partitionBy  []property.SortItem
+		partitionSet = make(map[int64]struct{})
+	)
+	for _, cond := range sel.Conditions {
+		if !hasCorCols(cond) {
+			remainConds = append(remainConds, cond)
+			continue
+		}
+		joinCond := la.deCorColFromEqExpr(cond)
+		if joinCond == nil {
+			return nil, nil, nil
+		}
+		// The rows of a partition must be the ones which equal to the same outer row.
+		args := cond.(*expression.ScalarFunction).GetArgs()
+		innerCol, ok := args[0].(*expression.Column)
+		if !ok {
+			innerCol = args[1].(*expression.Column)
+		}
+		lTp, rTp := args[0].GetType(), args[1].GetType()
+		if lTp.EvalType() != rTp.EvalType() || lTp.GetCollate() != rTp.GetCollate() {
+			return nil, nil, nil
+		}
+		joinConds = append(joinConds, joinCond)
+		if _, ok := partitionSet[innerCol.UniqueID]; !ok {
+			partitionSet[innerCol.UniqueID] = struct{}{}
+			partitionBy = append(partitionBy, property.SortItem{Col: innerCol})
+		}
+	}
+	if len(joinConds) == 0 {
+		return nil, nil, nil
+	}
+
+	if len(remainConds) > 0 {
+		sel.Conditions = remainConds
+	} else if len(projs) > 0 {
+		projs[len(projs)-1].SetChildren(sel.children[0])
+	} else {
+		windowChild = sel.children[0]
+	}
+	// The partition columns are the join keys, so the projections must output them.
+	for i := len(projs) - 1; i >= 0; i-- {
+		proj := projs[i]
+		for _, item := range partitionBy {
+			if proj.schema.Contains(item.Col) {
+				continue
+			}
+			proj.Exprs = append(proj.Exprs, item.Col)
+			proj.schema.Append(item.Col.Clone().(*expression.Column))
+			if len(proj.names) > 0 {
+				proj.names = append(proj.names, types.EmptyName)
+			}
+		}
+	}
+
+	desc, err := aggregation.NewWindowFuncDesc(la.ctx, ast.WindowFuncRowNumber, nil, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	window := LogicalWindow{
+		WindowFuncDescs: []*aggregation.WindowFuncDesc{desc},
+		PartitionBy:     partitionBy,
+		OrderBy:         orderBy,
+	}.Init(la.ctx, li.blockOffset)
+	if la.ctx.GetSessionVars().EnablePipelinedWindowExec {
+		window.Frame = &WindowFrame{Type: ast.Rows, Start: &FrameBound{Type: ast.CurrentRow}, End: &FrameBound{Type: ast.CurrentRow}}
+	}
+	schema := windowChild.Schema().Clone()
+	rowNumber := &expression.Column{
+		UniqueID: la.ctx.GetSessionVars().AllocPlanColumnID(),
+		RetType:  desc.RetTp,
+	}
+	schema.Append(rowNumber)
+	window.SetChildren(windowChild)
+	window.SetSchema(schema)
+
+	conds := make([]expression.Expression, 0, 2)
+	if li.Offset > 0 {
+		conds = append(conds, expression.NewFunctionInternal(la.ctx, ast.GT, types.NewFieldType(mysql.TypeTiny), rowNumber, expression.NewUInt64Const(int(li.Offset))))
+	}
+	conds = append(conds, expression.NewFunctionInternal(la.ctx, ast.LE, types.NewFieldType(mysql.TypeTiny), rowNumber, expression.NewUInt64Const(int(li.Offset+li.Count))))
+	topSel := LogicalSelection{Conditions: conds}.Init(la.ctx, li.blockOffset)
+	topSel.SetChildren(window)
+	return topSel, joinConds, nil
+}
+
 // ExtractCorrelatedCols4LogicalPlan recursively extracts all of the correlated columns
 // from a plan tree by calling LogicalPlan.ExtractCorrelatedCols.
 func ExtractCorrelatedCols4LogicalPlan(p LogicalPlan) []*expression.CorrelatedColumn {
@@ -218,18 +386,34 @@ func (s *decorrelateSolver) optimize(ctx context.Context, p LogicalPlan, opt *lo
 			if apply.JoinType != SemiJoin && apply.JoinType != LeftOuterSemiJoin && apply.JoinType != AntiSemiJoin && apply.JoinType != AntiLeftOuterSemiJoin {
 				goto NoOptimize
 			}
-			// If subquery has some filter condition, we will not optimize limit.
-			if len(apply.LeftConditions) > 0 || len(apply.RightConditions) > 0 || len(apply.OtherConditions) > 0 || len(apply.EqualConditions) > 0 {
-				goto NoOptimize
-			}
+			// If subquery has some filter condition, we will not remove limit.
+			hasConds := len(apply.LeftConditions) > 0 || len(apply.RightConditions) > 0 || len(apply.OtherConditions) > 0 || len(apply.EqualConditions) > 0
 			// Limit with non-0 offset will conduct an impact of itself on the final result set from its sub-child, consequently determining the bool value of the exist subquery.
-			if li.Offset == 0 {
+			if !hasConds && li.Offset == 0 {
 				innerPlan = li.children[0]
 				apply.SetChildren(outerPlan, innerPlan)
 				appendRemoveLimitTraceStep(li, opt)
 				return s.optimize(ctx, p, opt)
 			}
+			// Otherwise, we try to rewrite the limit to the row_number() partitioned by the correlated inner columns,
+			// so the limit is applied to the inner rows of each outer row without the apply.
+			np, joinConds, err := apply.rewriteLimitToWindow(li)
+			if err != nil {
+				return nil, err
+			}
+			if np != nil {
+				apply.AttachOnConds(joinConds)
+				apply.SetChildren(outerPlan, np)
+				appendRewriteLimitTraceStep(li, np, opt)
+				return s.optimize(ctx, p, opt)
+			}
 		} else if agg, ok := innerPlan.(*LogicalAggregation); ok {
+			if apply.canRemoveAgg(agg) {
+				innerPlan = agg.children[0]
+				apply.SetChildren(outerPlan, innerPlan)
+				appendRemoveAggTraceStep(apply, agg, opt)
+				return s.optimize(ctx, p, opt)
+			}
 			if apply.canPullUpAgg() && agg.canPullUp() {
 				innerPlan = agg.children[0]
 				apply.JoinType = LeftOuterJoin
@@ -289,7 +473,7 @@ func (s *decorrelateSolver) optimize(ctx context.Context, p LogicalPlan, opt *lo
 			}
 			// We can pull up the equal conditions below the aggregation as the join key of the apply, if only
 			// the equal conditions contain the correlated column of this apply.
-			if sel, ok := agg.children[0].(*LogicalSelection); ok && apply.JoinType == LeftOuterJoin {
+			if sel, ok := agg.children[0].(*LogicalSelection); ok && apply.canPullUpCorEqConds(agg) {
 				var (
 					eqCondWithCorCol []*expression.ScalarFunction
 					remainedExpr     []expression.Expression
@@ -341,7 +525,8 @@ func (s *decorrelateSolver) optimize(ctx context.Context, p LogicalPlan, opt *lo
 						}
 						defaultValueMap := s.aggDefaultValueMap(agg)
 						// We should use it directly, rather than building a projection.
-						if len(defaultValueMap) > 0 {
+						// The semi joins don't output the aggregation, so the default values are only needed by the outer join.
+						if len(defaultValueMap) > 0 && apply.JoinType == LeftOuterJoin {
 							proj := LogicalProjection{}.Init(agg.ctx, agg.blockOffset)
 							proj.SetSchema(apply.schema)
 							proj.Exprs = expression.Column2Exprs(apply.schema.Columns)
@@ -427,6 +612,16 @@ func appendRemoveLimitTraceStep(limit *LogicalLimit, opt *logicalOptimizeOp) {
 	opt.appendStepToCurrent(limit.ID(), limit.TP(), reason, action)
 }
 
+func appendRewriteLimitTraceStep(limit *LogicalLimit, np LogicalPlan, opt *logicalOptimizeOp) {
+	action := func() string {
+		return fmt.Sprintf("%v_%v is rewritten to %v_%v with row_number()", limit.TP(), limit.ID(), np.TP(), np.ID())
+	}
+	reason := func() string {
+		return fmt.Sprintf("%v_%v in the semi join subquery is applied to the inner rows of each outer row by the row_number() partitioned by the correlated columns", limit.TP(), limit.ID())
+	}
+	opt.appendStepToCurrent(limit.ID(), limit.TP(), reason, action)
+}
+
 func appendRemoveProjTraceStep(p *LogicalApply, proj *LogicalProjection, opt *logicalOptimizeOp) {
 	action := func() string {
 		return fmt.Sprintf("%v_%v removed from plan tree", proj.TP(), proj.ID())
@@ -447,6 +642,17 @@ func appendMoveProjTraceStep(p *LogicalApply, np LogicalPlan, proj *LogicalProje
 	opt.appendStepToCurrent(proj.ID(), proj.TP(), reason, action)
 }
 
+func appendRemoveAggTraceStep(p *LogicalApply, agg *LogicalAggregation, opt *logicalOptimizeOp) {
+	action := func() string {
+		return fmt.Sprintf("%v_%v removed from plan tree", agg.TP(), agg.ID())
+	}
+	reason := func() string {
+		return fmt.Sprintf("%v_%v's join type is %v, and its conditions don't reference %v_%v's output",
+			p.TP(), p.ID(), p.JoinType.String(), agg.TP(), agg.ID())
+	}
+	opt.appendStepToCurrent(agg.ID(), agg.TP(), reason, action)
+}
+
 func appendRemoveSortTraceStep(sort *LogicalSort, opt *logicalOptimizeOp) {
 	action := func() string {
 		return fmt.Sprintf("%v_%v removed from plan tree", sort.TP(), sort.ID())
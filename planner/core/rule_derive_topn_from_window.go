@@ -108,7 +108,7 @@ func windowIsTopN(p *LogicalSelection) (bool, uint64) {
 		}
 	}
 
-	if len(child.WindowFuncDescs) == 1 && child.WindowFuncDescs[0].Name == "row_number" &&
+	if len(child.WindowFuncDescs) == 1 && child.WindowFuncDescs[0].Name == "row_number" && child.Frame != nil &&
 		child.Frame.Type == ast.Rows && child.Frame.Start.Type == ast.CurrentRow && child.Frame.End.Type == ast.CurrentRow &&
 		checkPartitionBy(child, dataSource) {
 		return true, uint64(limitValue)
@@ -0,0 +1,197 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/ranger"
+)
+
+// tryEnableIndexSkipScan lets the index readers skip the duplicated prefixes of the index, the skipping is done by
+// seeking the next prefix in the coprocessor ranges instead of reading all the entries. It's applied on two patterns:
+//
+//  1. Agg(group by the first k index columns, only firstrow) <- IndexReader
+//     The reader only returns one entry for each distinct prefix, e.g. `select distinct a from t` with index (a, b).
+//     If a partial aggregation is pushed down to the reader, it's replaced by a projection above the reader.
+//  2. IndexReader <- Selection <- IndexFullScan
+//     The leading index column has no filter, but the filters can build the ranges of the rest columns, e.g.
+//     `select b from t where b = 1` with index (a, b). The ranges are scanned for each distinct leading value.
+//
+// The aggregation is kept above the reader, so the results are the same no matter how many entries are read.
+func tryEnableIndexSkipScan(sctx sessionctx.Context, plan PhysicalPlan) error {
+	if !sctx.GetSessionVars().EnableIndexSkipScan {
+		return nil
+	}
+	return enableIndexSkipScan(sctx, plan)
+}
+
+func enableIndexSkipScan(sctx sessionctx.Context, plan PhysicalPlan) error {
+	for _, child := range plan.Children() {
+		if err := enableIndexSkipScan(sctx, child); err != nil {
+			return err
+		}
+	}
+	switch x := plan.(type) {
+	case *PhysicalHashAgg:
+		return skipScanIndexForDistinct(sctx, x.getPointer())
+	case *PhysicalStreamAgg:
+		return skipScanIndexForDistinct(sctx, x.getPointer())
+	case *PhysicalIndexReader:
+		skipScanIndexForFilters(sctx, x)
+	}
+	return nil
+}
+
+// canSkipScanIndex checks whether the first prefixLen columns of the index can be skip scanned.
+func canSkipScanIndex(is *PhysicalIndexScan, prefixLen int) bool {
+	if is.SkipScanPrefixLen > 0 || is.Desc || is.Index.Global || is.Index.MVIndex {
+		return false
+	}
+	if prefixLen > len(is.Index.Columns) || prefixLen > len(is.IdxCols) {
+		return false
+	}
+	// The prefix is read from the index, so it can't be truncated.
+	for i := 0; i < prefixLen; i++ {
+		if is.IdxColLens[i] != types.UnspecifiedLength {
+			return false
+		}
+		// The prefix read from the index is encoded to seek the entries after it, which only works if the string
+		// is the same as its sort key in the index.
+		if tp := is.IdxCols[i].RetType; types.IsString(tp.GetType()) && !collate.IsBinCollation(tp.GetCollate()) {
+			return false
+		}
+	}
+	return true
+}
+
+func skipScanIndexForDistinct(sctx sessionctx.Context, agg *basePhysicalAgg) error {
+	reader, ok := agg.children[0].(*PhysicalIndexReader)
+	if !ok || len(agg.GroupByItems) == 0 || !onlyFirstRowAggFuncs(agg) {
+		return nil
+	}
+	is := reader.IndexPlans[0].(*PhysicalIndexScan)
+	var partialAgg *basePhysicalAgg
+	switch x := reader.indexPlan.(type) {
+	case *PhysicalHashAgg:
+		partialAgg = x.getPointer()
+	case *PhysicalStreamAgg:
+		partialAgg = x.getPointer()
+	}
+	groupByItems := agg.GroupByItems
+	if partialAgg != nil {
+		if !onlyFirstRowAggFuncs(partialAgg) || partialAgg.schema.Len() != len(partialAgg.AggFuncs)+len(partialAgg.GroupByItems) {
+			return nil
+		}
+		groupByItems = partialAgg.GroupByItems
+	}
+	// Only the scan and the selection can be pushed down below the aggregation.
+	for _, p := range reader.IndexPlans[1:] {
+		if _, ok := p.(*PhysicalSelection); !ok && (partialAgg == nil || p != reader.indexPlan) {
+			return nil
+		}
+	}
+
+	// The group by items must be the prefix of the index.
+	groupByCols := make(map[int64]struct{}, len(groupByItems))
+	for _, item := range groupByItems {
+		col, ok := item.(*expression.Column)
+		if !ok {
+			return nil
+		}
+		groupByCols[col.UniqueID] = struct{}{}
+	}
+	prefixLen := len(groupByCols)
+	if !canSkipScanIndex(is, prefixLen) {
+		return nil
+	}
+	for _, col := range is.IdxCols[:prefixLen] {
+		if _, ok := groupByCols[col.UniqueID]; !ok {
+			return nil
+		}
+	}
+
+	if partialAgg != nil {
+		// The partial result of firstrow is the value itself, so the partial aggregation is replaced by a projection
+		// which outputs the same schema.
+		child := partialAgg.children[0]
+		for _, col := range is.dataSourceSchema.Columns {
+			if !child.Schema().Contains(col) {
+				return nil
+			}
+		}
+		exprs := make([]expression.Expression, 0, partialAgg.schema.Len())
+		for _, aggFunc := range partialAgg.AggFuncs {
+			exprs = append(exprs, aggFunc.Args[0])
+		}
+		exprs = append(exprs, partialAgg.GroupByItems...)
+		for i, expr := range exprs {
+			newExpr, err := expr.ResolveIndices(is.dataSourceSchema)
+			if err != nil {
+				return nil
+			}
+			exprs[i] = newExpr
+		}
+		reader.SetChildren(child)
+		if err := reader.ResolveIndices(); err != nil {
+			return errors.Trace(err)
+		}
+		proj := PhysicalProjection{Exprs: exprs}.Init(sctx, partialAgg.stats, partialAgg.blockOffset)
+		proj.SetSchema(partialAgg.schema)
+		proj.SetChildren(reader)
+		agg.children[0] = proj
+	}
+	is.SkipScanPrefixLen = prefixLen
+	return nil
+}
+
+func skipScanIndexForFilters(sctx sessionctx.Context, reader *PhysicalIndexReader) {
+	// The ranges are built from the filters, so they can't be reused by the plan cache.
+	if len(reader.IndexPlans) < 2 || sctx.GetSessionVars().StmtCtx.UseCache {
+		return
+	}
+	is := reader.IndexPlans[0].(*PhysicalIndexScan)
+	sel, ok := reader.IndexPlans[1].(*PhysicalSelection)
+	if !ok || len(is.AccessCondition) > 0 || !is.isFullScan() || !canSkipScanIndex(is, 1) {
+		return
+	}
+	colNum := len(is.Index.Columns)
+	if colNum > len(is.IdxCols) {
+		colNum = len(is.IdxCols)
+	}
+	if colNum < 2 {
+		return
+	}
+	res, err := ranger.DetachCondAndBuildRangeForIndex(sctx, sel.Conditions, is.IdxCols[1:colNum], is.IdxColLens[1:colNum], sctx.GetSessionVars().RangeMaxSize)
+	if err != nil || len(res.AccessConds) == 0 {
+		return
+	}
+	// The filters are kept in the selection, the ranges only reduce the entries to read.
+	is.SkipScanPrefixLen = 1
+	is.SkipScanRanges = res.Ranges
+}
+
+func onlyFirstRowAggFuncs(agg *basePhysicalAgg) bool {
+	for _, aggFunc := range agg.AggFuncs {
+		if aggFunc.Name != ast.AggFuncFirstRow || aggFunc.HasDistinct || len(aggFunc.Args) != 1 {
+			return false
+		}
+	}
+	return true
+}
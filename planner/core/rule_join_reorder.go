@@ -269,15 +269,15 @@ func (s *joinReOrderSolver) optimizeRecursive(ctx sessionctx.Context, p LogicalP
 		}
 
 		if leadingHintInfo != nil && leadingHintInfo.leadingJoinOrder != nil {
-			if useGreedy {
-				ok, leftJoinGroup := baseGroupSolver.generateLeadingJoinGroup(curJoinGroup, leadingHintInfo, hasOuterJoin)
-				if !ok {
-					ctx.GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("leading hint is inapplicable, check if the leading hint table is valid"))
-				} else {
-					curJoinGroup = leftJoinGroup
-				}
+			ok, leftJoinGroup := baseGroupSolver.generateLeadingJoinGroup(curJoinGroup, leadingHintInfo, hasOuterJoin)
+			if !ok {
+				ctx.GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("leading hint is inapplicable, check if the leading hint table is valid"))
+			} else if useGreedy {
+				curJoinGroup = leftJoinGroup
 			} else {
-				ctx.GetSessionVars().StmtCtx.AppendWarning(ErrInternal.GenWithStack("leading hint is inapplicable for the DP join reorder algorithm"))
+				// The DP algorithm takes the leading join as a single node and reorders it with the other nodes.
+				curJoinGroup = append([]LogicalPlan{baseGroupSolver.leadingJoinGroup}, leftJoinGroup...)
+				baseGroupSolver.leadingJoinGroup = nil
 			}
 		}
 
@@ -410,21 +410,58 @@ func (s *baseSingleGroupJoinOrderSolver) generateLeadingJoinGroup(curJoinGroup [
 	if len(leadingJoinGroup) != len(hintInfo.leadingJoinOrder) || leadingJoinGroup == nil {
 		return false, nil
 	}
-	leadingJoin := leadingJoinGroup[0]
-	leadingJoinGroup = leadingJoinGroup[1:]
-	for len(leadingJoinGroup) > 0 {
+	var leadingJoin LogicalPlan
+	ok := false
+	if hintInfo.leadingList != nil {
+		leadingJoin, _, ok = s.makeLeadingJoinTree(hintInfo.leadingList, leadingJoinGroup, hasOuterJoin)
+	} else {
+		leadingJoin, ok = s.makeLeadingJoin(leadingJoinGroup, hasOuterJoin)
+	}
+	if !ok {
+		return false, nil
+	}
+	s.leadingJoinGroup = leadingJoin
+	return true, leftJoinGroup
+}
+
+// makeLeadingJoin joins the plans in order as a left-deep tree.
+func (s *baseSingleGroupJoinOrderSolver) makeLeadingJoin(plans []LogicalPlan, hasOuterJoin bool) (LogicalPlan, bool) {
+	leadingJoin := plans[0]
+	for _, plan := range plans[1:] {
 		var usedEdges []*expression.ScalarFunction
 		var joinType *joinTypeWithExtMsg
-		leadingJoin, leadingJoinGroup[0], usedEdges, joinType = s.checkConnection(leadingJoin, leadingJoinGroup[0])
+		leadingJoin, plan, usedEdges, joinType = s.checkConnection(leadingJoin, plan)
 		if hasOuterJoin && usedEdges == nil {
 			// If the joinGroups contain the outer join, we disable the cartesian product.
-			return false, nil
+			return nil, false
 		}
-		leadingJoin, s.otherConds = s.makeJoin(leadingJoin, leadingJoinGroup[0], usedEdges, joinType)
-		leadingJoinGroup = leadingJoinGroup[1:]
+		leadingJoin, s.otherConds = s.makeJoin(leadingJoin, plan, usedEdges, joinType)
 	}
-	s.leadingJoinGroup = leadingJoin
-	return true, leftJoinGroup
+	return leadingJoin, true
+}
+
+// makeLeadingJoinTree joins the plans by the nested LEADING hint like `LEADING(t1, (t2, t3))`, the plans are in the
+// order of the tables in the list. Each nested list is joined as a whole before it's joined with the other items of
+// its list, so the result can be a bushy tree. The plans not used by the list are returned.
+func (s *baseSingleGroupJoinOrderSolver) makeLeadingJoinTree(list *ast.LeadingList, plans []LogicalPlan, hasOuterJoin bool) (LogicalPlan, []LogicalPlan, bool) {
+	items := make([]LogicalPlan, 0, len(list.Items))
+	for _, item := range list.Items {
+		nested, isList := item.(*ast.LeadingList)
+		if !isList {
+			items = append(items, plans[0])
+			plans = plans[1:]
+			continue
+		}
+		var join LogicalPlan
+		var ok bool
+		join, plans, ok = s.makeLeadingJoinTree(nested, plans, hasOuterJoin)
+		if !ok {
+			return nil, nil, false
+		}
+		items = append(items, join)
+	}
+	join, ok := s.makeLeadingJoin(items, hasOuterJoin)
+	return join, plans, ok
 }
 
 // generateJoinOrderNode used to derive the stats for the joinNodePlans and generate the jrNode groups based on the cost.
@@ -158,26 +158,103 @@ func (s *joinReorderDPSolver) bfsGraph(startNode int, visited []bool, adjacents
 	return visitID2NodeID
 }
 
+// dpMaxExhaustiveNodes is the max number of the nodes whose subsets are all enumerated by the DP. The larger sub
+// graphs are reordered by the iterative DP, see dpGraph.
+const dpMaxExhaustiveNodes = 8
+
+// dpUnit is a node or a set of the nodes joined already in the iterative DP. mask is the visit IDs of the nodes.
+type dpUnit struct {
+	mask uint
+	node *jrNode
+}
+
 // dpGraph is the core part of this algorithm.
 // It implements the traditional join reorder algorithm: DP by subset using the following formula:
 //
 //	bestPlan[S:set of node] = the best one among Join(bestPlan[S1:subset of S], bestPlan[S2: S/S1])
+//
+// The enumeration grows exponentially, so the graph with more than dpMaxExhaustiveNodes nodes is reordered by the
+// iterative DP: a connected block of dpMaxExhaustiveNodes units starting from the smallest one is reordered by the DP,
+// and the best plan of the block becomes a single unit, until the rest of the units can be reordered by the DP at once.
+// The plans of the blocks can be joined with each other, so the result is still a bushy tree.
 func (s *joinReorderDPSolver) dpGraph(visitID2NodeID, nodeID2VisitID []int, _ []LogicalPlan,
 	totalEqEdges []joinGroupEqEdge, totalNonEqEdges []joinGroupNonEqEdge, tracer *joinReorderTrace) (LogicalPlan, error) {
-	nodeCnt := uint(len(visitID2NodeID))
-	bestPlan := make([]*jrNode, 1<<nodeCnt)
+	units := make([]*dpUnit, 0, len(visitID2NodeID))
+	for i, nodeID := range visitID2NodeID {
+		units = append(units, &dpUnit{mask: 1 << uint(i), node: s.curJoinGroup[nodeID]})
+	}
+	for len(units) > dpMaxExhaustiveNodes {
+		block, rest := s.pickDPBlock(units, nodeID2VisitID, totalEqEdges)
+		best, err := s.dpUnits(block, nodeID2VisitID, totalEqEdges, totalNonEqEdges, tracer)
+		if err != nil {
+			return nil, err
+		}
+		units = append(rest, best)
+	}
+	best, err := s.dpUnits(units, nodeID2VisitID, totalEqEdges, totalNonEqEdges, tracer)
+	if err != nil {
+		return nil, err
+	}
+	return best.node.p, nil
+}
+
+// pickDPBlock picks a connected block of dpMaxExhaustiveNodes units for the iterative DP. It starts from the unit with
+// the least rows and adds the adjacent unit with the least rows each time, so the small tables are joined first.
+func (s *joinReorderDPSolver) pickDPBlock(units []*dpUnit, nodeID2VisitID []int, totalEqEdges []joinGroupEqEdge) (block, rest []*dpUnit) {
+	rest = make([]*dpUnit, len(units))
+	copy(rest, units)
+	pick := func(i int) {
+		block = append(block, rest[i])
+		rest = append(rest[:i], rest[i+1:]...)
+	}
+	smallest := 0
+	for i, unit := range rest {
+		if unit.node.p.statsInfo().RowCount < rest[smallest].node.p.statsInfo().RowCount {
+			smallest = i
+		}
+	}
+	pick(smallest)
+	blockMask := block[0].mask
+	for len(block) < dpMaxExhaustiveNodes {
+		next := -1
+		for i, unit := range rest {
+			if usedEdges, _ := s.nodesAreConnected(blockMask, unit.mask, nodeID2VisitID, totalEqEdges, nil); len(usedEdges) == 0 {
+				continue
+			}
+			if next == -1 || unit.node.p.statsInfo().RowCount < rest[next].node.p.statsInfo().RowCount {
+				next = i
+			}
+		}
+		if next == -1 {
+			break
+		}
+		blockMask |= rest[next].mask
+		pick(next)
+	}
+	return block, rest
+}
+
+// dpUnits finds the best plan to join the units by DP, the units must be connected.
+func (s *joinReorderDPSolver) dpUnits(units []*dpUnit, nodeID2VisitID []int,
+	totalEqEdges []joinGroupEqEdge, totalNonEqEdges []joinGroupNonEqEdge, tracer *joinReorderTrace) (*dpUnit, error) {
+	unitCnt := uint(len(units))
+	bestPlan := make([]*jrNode, 1<<unitCnt)
+	// nodeMasks[s] is the visit IDs of the nodes in the set of units s.
+	nodeMasks := make([]uint, 1<<unitCnt)
 	// bestPlan[s] is nil can be treated as bestCost[s] = +inf.
-	for i := uint(0); i < nodeCnt; i++ {
-		bestPlan[1<<i] = s.curJoinGroup[visitID2NodeID[i]]
+	for i := uint(0); i < unitCnt; i++ {
+		bestPlan[1<<i] = units[i].node
 	}
-	// Enumerate the nodeBitmap from small to big, make sure that S1 must be enumerated before S2 if S1 belongs to S2.
-	for nodeBitmap := uint(1); nodeBitmap < (1 << nodeCnt); nodeBitmap++ {
-		if bits.OnesCount(nodeBitmap) == 1 {
+	// Enumerate the unitBitmap from small to big, make sure that S1 must be enumerated before S2 if S1 belongs to S2.
+	for unitBitmap := uint(1); unitBitmap < (1 << unitCnt); unitBitmap++ {
+		lowest := uint(bits.TrailingZeros(unitBitmap))
+		nodeMasks[unitBitmap] = nodeMasks[unitBitmap&^(1<<lowest)] | units[lowest].mask
+		if bits.OnesCount(unitBitmap) == 1 {
 			continue
 		}
 		// This loop can iterate all its subset.
-		for sub := (nodeBitmap - 1) & nodeBitmap; sub > 0; sub = (sub - 1) & nodeBitmap {
-			remain := nodeBitmap ^ sub
+		for sub := (unitBitmap - 1) & unitBitmap; sub > 0; sub = (sub - 1) & unitBitmap {
+			remain := unitBitmap ^ sub
 			if sub > remain {
 				continue
 			}
@@ -186,7 +263,7 @@ func (s *joinReorderDPSolver) dpGraph(visitID2NodeID, nodeID2VisitID []int, _ []
 				continue
 			}
 			// Get the edge connecting the two parts.
-			usedEdges, otherConds := s.nodesAreConnected(sub, remain, nodeID2VisitID, totalEqEdges, totalNonEqEdges)
+			usedEdges, otherConds := s.nodesAreConnected(nodeMasks[sub], nodeMasks[remain], nodeID2VisitID, totalEqEdges, totalNonEqEdges)
 			// Here we only check equal condition currently.
 			if len(usedEdges) == 0 {
 				continue
@@ -197,18 +274,19 @@ func (s *joinReorderDPSolver) dpGraph(visitID2NodeID, nodeID2VisitID []int, _ []
 			}
 			curCost := s.calcJoinCumCost(join, bestPlan[sub], bestPlan[remain])
 			tracer.appendLogicalJoinCost(join, curCost)
-			if bestPlan[nodeBitmap] == nil {
-				bestPlan[nodeBitmap] = &jrNode{
+			if bestPlan[unitBitmap] == nil {
+				bestPlan[unitBitmap] = &jrNode{
 					p:       join,
 					cumCost: curCost,
 				}
-			} else if bestPlan[nodeBitmap].cumCost > curCost {
-				bestPlan[nodeBitmap].p = join
-				bestPlan[nodeBitmap].cumCost = curCost
+			} else if bestPlan[unitBitmap].cumCost > curCost {
+				bestPlan[unitBitmap].p = join
+				bestPlan[unitBitmap].cumCost = curCost
 			}
 		}
 	}
-	return bestPlan[(1<<nodeCnt)-1].p, nil
+	all := uint(1<<unitCnt) - 1
+	return &dpUnit{mask: nodeMasks[all], node: bestPlan[all]}, nil
 }
 
 func (s *joinReorderDPSolver) nodesAreConnected(leftMask, rightMask uint, oldPos2NewPos []int,
@@ -16,6 +16,8 @@ package core
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/expression"
@@ -227,3 +229,38 @@ func TestDPReorderAllCartesian(t *testing.T) {
 	expected := "MockJoin{MockJoin{a, b}, MockJoin{c, d}}"
 	require.Equal(t, expected, planToString(result))
 }
+
+func TestDPReorderIterative(t *testing.T) {
+	ctx := MockContext()
+	ctx.GetSessionVars().PlanID.Store(-1)
+
+	// The tables are joined as a chain t0 - t1 - ... - t9, which has more nodes than dpMaxExhaustiveNodes.
+	joinGroup := make([]LogicalPlan, 0, 10)
+	for i := 0; i < 10; i++ {
+		joinGroup = append(joinGroup, newDataSource(ctx, fmt.Sprintf("t%d", i), (i+1)*100))
+	}
+	eqEdges := make([]*expression.ScalarFunction, 0, len(joinGroup)-1)
+	for i := 0; i+1 < len(joinGroup); i++ {
+		eqEdges = append(eqEdges, expression.NewFunctionInternal(ctx, ast.EQ, types.NewFieldType(mysql.TypeTiny), joinGroup[i].Schema().Columns[0], joinGroup[i+1].Schema().Columns[0]).(*expression.ScalarFunction))
+	}
+	mockJoin := newMockJoin(ctx, nil)
+	solver := &joinReorderDPSolver{
+		baseSingleGroupJoinOrderSolver: &baseSingleGroupJoinOrderSolver{
+			ctx:                ctx,
+			basicJoinGroupInfo: &basicJoinGroupInfo{eqEdges: eqEdges},
+		},
+		newJoin: func(lChild, rChild LogicalPlan, eqConds []*expression.ScalarFunction, otherConds, leftConds, rightConds []expression.Expression, joinType JoinType) LogicalPlan {
+			join := mockJoin(lChild, rChild, eqConds, otherConds, leftConds, rightConds, joinType).(*mockLogicalJoin)
+			join.stats = &property.StatsInfo{RowCount: math.Max(lChild.statsInfo().RowCount, rChild.statsInfo().RowCount)}
+			return join
+		},
+	}
+	result, err := solver.solve(joinGroup, nil)
+	require.NoError(t, err)
+
+	plan := planToString(result)
+	require.Equal(t, len(joinGroup)-1, strings.Count(plan, "MockJoin"))
+	for i := range joinGroup {
+		require.Contains(t, plan, fmt.Sprintf("t%d", i))
+	}
+}
@@ -15,7 +15,9 @@
 package core_test
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/pingcap/tidb/testkit"
 	"github.com/stretchr/testify/require"
@@ -119,3 +121,43 @@ FROM   t3 tt
 		"      └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo",
 	))
 }
+
+func TestLeadingHintWithBushyTree(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int)")
+	tk.MustExec("create table t3(a int, b int)")
+	tk.MustExec("create table t4(a int, b int)")
+
+	// childJoins returns the number of the joins which are the children of the topmost join.
+	childJoins := func(hint string) int {
+		rows := tk.MustQuery("explain format = 'brief' select /*+ " + hint + " */ * from t1 join t2 on t1.a = t2.a join t3 on t2.b = t3.b join t4 on t3.a = t4.a").Rows()
+		tk.MustQuery("show warnings").Check(testkit.Rows())
+		topDepth, cnt := -1, 0
+		for _, row := range rows {
+			id := row[0].(string)
+			name := strings.TrimLeft(id, " │├└─")
+			if !strings.Contains(name, "Join") {
+				continue
+			}
+			depth := utf8.RuneCountInString(id[:len(id)-len(name)]) / 2
+			if topDepth == -1 {
+				topDepth = depth
+			} else if depth == topDepth+1 {
+				cnt++
+			}
+		}
+		return cnt
+	}
+	for _, threshold := range []string{"0", "10"} {
+		// The greedy and the DP join reorder algorithms both honor the leading hint.
+		tk.MustExec("set @@tidb_opt_join_reorder_threshold = " + threshold)
+		require.Equal(t, 1, childJoins("leading(t4, t3, t2, t1)"))
+		require.Equal(t, 2, childJoins("leading((t1, t2), (t3, t4))"))
+		require.Equal(t, 2, childJoins("leading((t2, t1), (t4, t3))"))
+		require.Equal(t, 1, childJoins("leading(t2, (t3, t4))"))
+	}
+}
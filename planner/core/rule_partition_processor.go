@@ -65,6 +65,16 @@ func (s *partitionProcessor) optimize(_ context.Context, lp LogicalPlan, opt *lo
 	return p, err
 }
 
+// RewritePartitionedDataSources rewrites the DataSources of the partitioned tables into the unions of their partitions
+// in the static partition prune mode. It's public for cascades planner, which doesn't run the logical optimization
+// rules, so only the partitions specified by `table partition (p0, p1)` are pruned.
+func RewritePartitionedDataSources(ctx context.Context, lp LogicalPlan) (LogicalPlan, error) {
+	if lp.SCtx().GetSessionVars().StmtCtx.UseDynamicPartitionPrune() {
+		return lp, nil
+	}
+	return (&partitionProcessor{}).optimize(ctx, lp, &logicalOptimizeOp{})
+}
+
 func (s *partitionProcessor) rewriteDataSource(lp LogicalPlan, opt *logicalOptimizeOp) (LogicalPlan, error) {
 	// Assert there will not be sel -> sel in the ast.
 	switch p := lp.(type) {
@@ -768,8 +768,20 @@ func (p *LogicalWindow) PredicatePushDown(predicates []expression.Expression, op
 		// extractedCols are part of partitionBy columns.
 		if expression.ExprFromSchema(cond, partitionCols) {
 			canBePushed = append(canBePushed, cond)
-		} else {
-			canNotBePushed = append(canNotBePushed, cond)
+			continue
+		}
+		// The predicates pushed into a CTE are composed into one condition, so the CNF items of it are checked
+		// separately, e.g. `c = 1` of `c = 1 and row_number() <= 10` is still pushed.
+		for _, item := range expression.SplitCNFItems(cond) {
+			if expression.ExprFromSchema(item, partitionCols) {
+				canBePushed = append(canBePushed, item)
+				continue
+			}
+			canNotBePushed = append(canNotBePushed, item)
+			// The relaxed filter of a DNF item only selects whole partitions, and the item is still kept above.
+			if relaxed := expression.DeriveRelaxedFiltersFromDNF(item, partitionCols); relaxed != nil {
+				canBePushed = append(canBePushed, relaxed)
+			}
 		}
 	}
 	p.baseLogicalPlan.PredicatePushDown(canBePushed, opt)
@@ -22,12 +22,17 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/planner/property"
 	"github.com/pingcap/tidb/planner/util"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/statistics"
 	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/mathutil"
 	"github.com/pingcap/tidb/util/ranger"
@@ -105,6 +110,16 @@ func (p *LogicalShowDDLJobs) DeriveStats(_ []*property.StatsInfo, selfSchema *ex
 	return p.stats, nil
 }
 
+// DeriveStats implement LogicalPlan DeriveStats interface.
+func (p *LogicalTableFunction) DeriveStats(_ []*property.StatsInfo, selfSchema *expression.Schema, _ []*expression.Schema, _ [][]*expression.Column) (*property.StatsInfo, error) {
+	if p.stats != nil {
+		return p.stats, nil
+	}
+	// The rows of a table function are unknown before it's executed.
+	p.stats = getFakeStats(selfSchema)
+	return p.stats, nil
+}
+
 // RecursiveDeriveStats4Test is a exporter just for test.
 func RecursiveDeriveStats4Test(p LogicalPlan) (*property.StatsInfo, error) {
 	return p.recursiveDeriveStats(nil)
@@ -244,18 +259,214 @@ func (ds *DataSource) initStats(colGroups [][]*expression.Column) {
 }
 
 func (ds *DataSource) deriveStatsByFilter(conds expression.CNFExprs, filledPaths []*util.AccessPath) *property.StatsInfo {
+	exprSelectivity, conds := ds.exprStatsSelectivity(conds)
+	sketchSelectivity, conds := ds.multiColumnSketchSelectivity(conds)
 	selectivity, nodes, err := ds.tableStats.HistColl.Selectivity(ds.ctx, conds, filledPaths)
 	if err != nil {
 		logutil.BgLogger().Debug("something wrong happened, use the default selectivity", zap.Error(err))
 		selectivity = SelectionFactor
 	}
-	stats := ds.tableStats.Scale(selectivity)
+	stats := ds.tableStats.Scale(selectivity * exprSelectivity * sketchSelectivity)
 	if ds.ctx.GetSessionVars().OptimizerSelectivityLevel >= 1 {
 		stats.HistColl = stats.HistColl.NewHistCollBySelectivity(ds.ctx, nodes)
 	}
 	return stats
 }
 
+// exprStatsSelectivity estimates the selectivity of the conditions on the expressions which have histograms built by
+// `ANALYZE TABLE ... UPDATE HISTOGRAM ON (expr)`. The conditions estimated by the histograms are removed from the
+// returned conditions, and the others are left to be estimated by the column and index stats.
+func (ds *DataSource) exprStatsSelectivity(conds expression.CNFExprs) (float64, expression.CNFExprs) {
+	tbl := ds.statisticTable
+	if len(conds) == 0 || !ds.ctx.GetSessionVars().EnableExtendedStats || tbl == nil || tbl.Pseudo || tbl.Count <= 0 ||
+		tbl.ExtendedStats == nil || len(tbl.ExtendedStats.Stats) == 0 {
+		return 1, conds
+	}
+	selectivity := 1.0
+	for _, item := range tbl.ExtendedStats.Stats {
+		if item.Tp != ast.StatsTypeExpression || item.ExprStats == nil || item.ExprStats.Hist == nil {
+			continue
+		}
+		exprs, err := expression.ParseSimpleExprsWithNames(ds.ctx, item.ExprStats.Expr, ds.schema, ds.names)
+		if err != nil || len(exprs) != 1 {
+			continue
+		}
+		col := &expression.Column{UniqueID: ds.ctx.GetSessionVars().AllocPlanColumnID(), RetType: exprs[0].GetType()}
+		substituted := make([]expression.Expression, 0, len(conds))
+		origins := make(map[expression.Expression]expression.Expression, len(conds))
+		for _, cond := range conds {
+			if newCond := substituteExprInCond(ds.ctx, cond, exprs[0], col); newCond != nil {
+				substituted = append(substituted, newCond)
+				origins[newCond] = cond
+			}
+		}
+		if len(substituted) == 0 {
+			continue
+		}
+		accessConds, _ := ranger.DetachCondsForColumn(ds.ctx, substituted, col)
+		if len(accessConds) == 0 {
+			continue
+		}
+		ranges, accessConds, _, err := ranger.BuildColumnRange(accessConds, ds.ctx, col.RetType, types.UnspecifiedLength, 0)
+		if err != nil || len(accessConds) == 0 {
+			continue
+		}
+		count, err := item.ExprStats.GetRowCount(ds.ctx, ranges, tbl.Count, tbl.ModifyCount)
+		if err != nil {
+			logutil.BgLogger().Debug("something wrong happened when estimating by the histogram on the expression",
+				zap.String("expression", item.ExprStats.Expr), zap.Error(err))
+			continue
+		}
+		selectivity *= mathutil.Clamp(count/float64(tbl.Count), 0, 1)
+		used := make(map[expression.Expression]struct{}, len(accessConds))
+		for _, cond := range accessConds {
+			used[origins[cond]] = struct{}{}
+		}
+		remained := make(expression.CNFExprs, 0, len(conds)-len(used))
+		for _, cond := range conds {
+			if _, ok := used[cond]; !ok {
+				remained = append(remained, cond)
+			}
+		}
+		conds = remained
+	}
+	return selectivity, conds
+}
+
+// substituteExprInCond replaces expr in the comparison cond with col, so the ranges on expr can be built like on the column.
+// It returns nil if cond is not a comparison between expr and the constants.
+func substituteExprInCond(ctx sessionctx.Context, cond, expr expression.Expression, col *expression.Column) expression.Expression {
+	sf, ok := cond.(*expression.ScalarFunction)
+	if !ok {
+		return nil
+	}
+	args := sf.GetArgs()
+	newArgs := make([]expression.Expression, len(args))
+	switch sf.FuncName.L {
+	case ast.EQ, ast.NullEQ, ast.LT, ast.LE, ast.GT, ast.GE, ast.NE, ast.In:
+		matched := false
+		for i, arg := range args {
+			if _, isConst := arg.(*expression.Constant); isConst {
+				newArgs[i] = arg
+			} else if (i == 0 || sf.FuncName.L != ast.In) && !matched && arg.Equal(ctx, expr) {
+				newArgs[i] = col
+				matched = true
+			} else {
+				return nil
+			}
+		}
+		if !matched {
+			return nil
+		}
+	case ast.IsNull:
+		if !args[0].Equal(ctx, expr) {
+			return nil
+		}
+		newArgs[0] = col
+	default:
+		return nil
+	}
+	newCond, err := expression.NewFunction(ctx, sf.FuncName.L, sf.RetType, newArgs...)
+	if err != nil {
+		return nil
+	}
+	return newCond
+}
+
+// multiColumnSketchSelectivity estimates the selectivity of the conjunctive equal conditions on the columns which have
+// the sketches built by ANALYZE for the extended stats of the sketch type. The sketch is used only if every column of it
+// is compared with a constant, and the conditions estimated by the sketch are removed from the returned conditions, so
+// the correlated columns are not regarded as independent.
+func (ds *DataSource) multiColumnSketchSelectivity(conds expression.CNFExprs) (float64, expression.CNFExprs) {
+	tbl := ds.statisticTable
+	if len(conds) == 0 || !ds.ctx.GetSessionVars().EnableExtendedStats || tbl == nil || tbl.Pseudo || tbl.Count <= 0 ||
+		tbl.ExtendedStats == nil || len(tbl.ExtendedStats.Stats) == 0 {
+		return 1, conds
+	}
+	sc := ds.ctx.GetSessionVars().StmtCtx
+	selectivity := 1.0
+	for _, item := range tbl.ExtendedStats.Stats {
+		if item.Tp != ast.StatsTypeSketch || item.Sketch == nil || item.Sketch.Count <= 0 {
+			continue
+		}
+		vals := make([]types.Datum, 0, len(item.ColIDs))
+		used := make(map[int]struct{}, len(item.ColIDs))
+		for _, colID := range item.ColIDs {
+			offset := slices.IndexFunc(ds.schema.Columns, func(c *expression.Column) bool { return c.ID == colID })
+			if offset < 0 {
+				break
+			}
+			matched := false
+			for i, cond := range conds {
+				if _, ok := used[i]; ok {
+					continue
+				}
+				if val, ok := equalConstantOnColumn(ds.ctx, cond, ds.schema.Columns[offset]); ok {
+					vals = append(vals, val)
+					used[i] = struct{}{}
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				break
+			}
+		}
+		if len(vals) != len(item.ColIDs) {
+			continue
+		}
+		count, err := item.Sketch.GetRowCount(sc, vals)
+		if err != nil {
+			logutil.BgLogger().Debug("something wrong happened when estimating by the sketch on multiple columns", zap.Error(err))
+			continue
+		}
+		selectivity *= mathutil.Clamp(count/float64(item.Sketch.Count), 0, 1)
+		remained := make(expression.CNFExprs, 0, len(conds)-len(used))
+		for i, cond := range conds {
+			if _, ok := used[i]; !ok {
+				remained = append(remained, cond)
+			}
+		}
+		conds = remained
+	}
+	return selectivity, conds
+}
+
+// equalConstantOnColumn returns the value of the constant if cond is `col = constant` or `constant = col`, and the
+// value can be converted to the type of the column without changing it.
+func equalConstantOnColumn(ctx sessionctx.Context, cond expression.Expression, col *expression.Column) (types.Datum, bool) {
+	sf, ok := cond.(*expression.ScalarFunction)
+	if !ok || sf.FuncName.L != ast.EQ {
+		return types.Datum{}, false
+	}
+	args := sf.GetArgs()
+	var con *expression.Constant
+	if c, ok := args[0].(*expression.Column); ok && c.Equal(ctx, col) {
+		con, _ = args[1].(*expression.Constant)
+	} else if c, ok := args[1].(*expression.Column); ok && c.Equal(ctx, col) {
+		con, _ = args[0].(*expression.Constant)
+	}
+	if con == nil {
+		return types.Datum{}, false
+	}
+	sc := ctx.GetSessionVars().StmtCtx
+	val, err := con.Eval(chunk.Row{})
+	if err != nil || val.IsNull() {
+		return types.Datum{}, false
+	}
+	converted, err := val.ConvertTo(sc, col.RetType)
+	if err != nil {
+		return types.Datum{}, false
+	}
+	if cmp, err := val.Compare(sc, &converted, collate.GetCollator(col.RetType.GetCollate())); err != nil || cmp != 0 {
+		return types.Datum{}, false
+	}
+	if converted.Kind() == types.KindString {
+		converted.SetString(converted.GetString(), col.RetType.GetCollate())
+	}
+	return converted, true
+}
+
 // We bind logic of derivePathStats and tryHeuristics together. When some path matches the heuristic rule, we don't need
 // to derive stats of subsequent paths. In this way we can save unnecessary computation of derivePathStats.
 func (ds *DataSource) derivePathStatsAndTryHeuristics() error {
@@ -391,6 +602,7 @@ func (ds *DataSource) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema,
 	for i, expr := range ds.pushedDownConds {
 		ds.pushedDownConds[i] = expression.PushDownNot(ds.ctx, expr)
 	}
+	ds.routeToColumnarIndex()
 	for _, path := range ds.possibleAccessPaths {
 		if path.IsTablePath() {
 			continue
@@ -417,6 +629,57 @@ func (ds *DataSource) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema,
 	return ds.stats, nil
 }
 
+// routeToColumnarIndex makes the data source prefer TiFlash if a public columnar index covers all the columns it reads.
+// The columnar index is maintained by the TiFlash replicas from its snapshot on, so the history reads and the reads
+// of the write statements aren't routed, and neither are the reads whose storage is hinted.
+func (ds *DataSource) routeToColumnarIndex() {
+	if ds.preferStoreType != 0 || ds.isForUpdateRead {
+		return
+	}
+	sessVars := ds.ctx.GetSessionVars()
+	if sessVars.SnapshotTS != 0 || sessVars.StmtCtx.IsStaleness {
+		return
+	}
+	hasTiFlashPath := false
+	for _, path := range ds.possibleAccessPaths {
+		if path.StoreType == kv.TiFlash {
+			hasTiFlashPath = true
+			break
+		}
+	}
+	if !hasTiFlashPath {
+		return
+	}
+	cols := expression.ExtractColumnsFromExpressions(nil, ds.pushedDownConds, nil)
+	cols = append(cols, ds.schema.Columns...)
+	for _, idx := range ds.tableInfo.Indices {
+		if idx.Tp != model.IndexTypeColumnar || idx.State != model.StatePublic || idx.Invisible {
+			continue
+		}
+		if columnarIndexCoversColumns(ds.tableInfo, idx, cols) {
+			ds.preferStoreType |= preferTiFlash
+			return
+		}
+	}
+}
+
+// columnarIndexCoversColumns checks whether the columnar index contains all the columns, the handle and the extra
+// columns are always read along with the index.
+func columnarIndexCoversColumns(tblInfo *model.TableInfo, idx *model.IndexInfo, cols []*expression.Column) bool {
+	for _, col := range cols {
+		if col.ID < 0 {
+			continue
+		}
+		if tblInfo.PKIsHandle && mysql.HasPriKeyFlag(col.RetType.GetFlag()) {
+			continue
+		}
+		if !idx.HasColumnInIndexColumns(tblInfo, col.ID) {
+			return false
+		}
+	}
+	return true
+}
+
 func getMinSelectivityFromPaths(paths []*util.AccessPath, totalRowCount float64) float64 {
 	minSelectivity := 1.0
 	if totalRowCount <= 0 {
@@ -1022,6 +1285,9 @@ func (p *LogicalCTE) DeriveStats(_ []*property.StatsInfo, selfSchema *expression
 			newSel := LogicalSelection{Conditions: []expression.Expression{newCond}}.Init(p.SCtx(), p.cte.seedPartLogicalPlan.SelectBlockOffset())
 			newSel.SetChildren(p.cte.seedPartLogicalPlan)
 			p.cte.seedPartLogicalPlan = newSel
+			// The seed part may have no selection itself, push the new selection down into it, e.g. below the
+			// window functions on the partition by columns, rather than filtering the materialized rows.
+			p.cte.optFlag |= flagPredicatePushDown | flagDeriveTopNFromWindow
 		}
 		p.cte.seedPartPhysicalPlan, _, err = DoOptimize(context.TODO(), p.ctx, p.cte.optFlag, p.cte.seedPartLogicalPlan)
 		if err != nil {
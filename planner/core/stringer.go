@@ -176,6 +176,10 @@ func toString(in Plan, strs []string, idxs []int) ([]string, []int) {
 		}
 	case *LogicalShowDDLJobs, *PhysicalShowDDLJobs:
 		str = "ShowDDLJobs"
+	case *LogicalTableFunction:
+		str = "TableFunction(" + x.FuncName.L + ")"
+	case *PhysicalTableFunction:
+		str = "TableFunction(" + x.FuncName.L + ")"
 	case *LogicalSort, *PhysicalSort:
 		str = "Sort"
 	case *LogicalJoin:
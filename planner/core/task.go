@@ -503,6 +503,114 @@ func (p *PhysicalHashJoin) convertPartitionKeysIfNeed(lTask, rTask *mppTask) (*m
 	return lTask, rTask
 }
 
+// saltSkewedJoinKeys spreads the rows of the skewed join keys of the shuffle join to multiple nodes. A salt in
+// [1, mppSkewSaltNum] is computed for each row of the probe side by the hash of its non-key columns, and the build
+// side is replicated once for each salt by the Expand operator, whose grouping ID is used as the salt. Then both
+// sides are partitioned by the join keys and the salt, so each probe row still meets all the build rows of the same
+// join key, while the rows of a heavy-hitter key are received by different nodes. The returned salt columns are
+// the ones of the left and right child. The tasks are returned unchanged if the salt can't be pushed down.
+func (p *PhysicalHashJoin) saltSkewedJoinKeys(lTask, rTask *mppTask) (*mppTask, *mppTask, []*expression.Column) {
+	probeTask, buildTask := lTask, rTask
+	if p.InnerChildIdx == 0 {
+		probeTask, buildTask = rTask, lTask
+	}
+	probe := probeTask.p
+	if _, ok := probe.(*PhysicalExchangeReceiver); ok {
+		probe = probe.Children()[0].Children()[0]
+	}
+	build := buildTask.p
+	if _, ok := build.(*PhysicalExchangeReceiver); ok {
+		build = build.Children()[0].Children()[0]
+	}
+
+	// salt = crc32(concat_ws(',', non-key columns)) % mppSkewSaltNum + 1
+	keyCols := make(map[int64]struct{}, len(probeTask.hashCols))
+	for _, key := range probeTask.hashCols {
+		keyCols[key.Col.UniqueID] = struct{}{}
+	}
+	args := []expression.Expression{&expression.Constant{Value: types.NewStringDatum(","), RetType: types.NewFieldType(mysql.TypeVarString)}}
+	for _, col := range probe.Schema().Columns {
+		if _, ok := keyCols[col.UniqueID]; !ok {
+			args = append(args, col)
+		}
+	}
+	if len(args) == 1 {
+		// All the columns are join keys, the rows of the same join key can't be told apart.
+		return lTask, rTask, nil
+	}
+	saltTp := types.NewFieldType(mysql.TypeLonglong)
+	saltTp.SetFlag(mysql.UnsignedFlag)
+	salt, err := expression.NewFunction(p.ctx, ast.ConcatWS, types.NewFieldType(mysql.TypeVarString), args...)
+	if err == nil {
+		salt, err = expression.NewFunction(p.ctx, ast.CRC32, saltTp.Clone(), salt)
+	}
+	if err == nil {
+		salt, err = expression.NewFunction(p.ctx, ast.Mod, saltTp.Clone(), salt, expression.NewUInt64Const(p.mppSkewSaltNum))
+	}
+	if err == nil {
+		salt, err = expression.NewFunction(p.ctx, ast.Plus, saltTp.Clone(), salt, expression.NewUInt64Const(1))
+	}
+	if err != nil || !expression.CanExprsPushDown(p.ctx.GetSessionVars().StmtCtx, []expression.Expression{salt}, p.ctx.GetClient(), kv.TiFlash) {
+		return lTask, rTask, nil
+	}
+	probeProj := getProj(p.ctx, probe)
+	probeSalt := appendExpr(probeProj, salt)
+
+	groupingExprs := make(expression.GroupingExprs, 0, len(buildTask.hashCols))
+	for _, key := range buildTask.hashCols {
+		groupingExprs = append(groupingExprs, key.Col)
+	}
+	groupingSets := make(expression.GroupingSets, 0, p.mppSkewSaltNum)
+	for i := 0; i < p.mppSkewSaltNum; i++ {
+		groupingSets = append(groupingSets, expression.GroupingSet{groupingExprs})
+	}
+	stats := build.statsInfo().Scale(1)
+	stats.RowCount *= float64(p.mppSkewSaltNum)
+	expand := PhysicalExpand{GroupingSets: groupingSets}.Init(p.ctx, stats, build.SelectBlockOffset())
+	gidTp := types.NewFieldType(mysql.TypeLonglong)
+	gidTp.SetFlag(mysql.UnsignedFlag | mysql.NotNullFlag)
+	buildSalt := &expression.Column{
+		UniqueID: p.ctx.GetSessionVars().AllocPlanColumnID(),
+		RetType:  gidTp,
+	}
+	expand.SetSchema(build.Schema().Clone())
+	expand.schema.Append(buildSalt)
+	expand.GroupingIDCol = buildSalt
+	expand.SetChildren(build)
+
+	newProbeTask := probeTask.copy().(*mppTask)
+	newProbeTask.p = probeProj
+	newProbeTask = newProbeTask.enforceExchangerImpl(&property.PhysicalProperty{
+		TaskTp:           property.MppTaskType,
+		MPPPartitionTp:   property.HashType,
+		MPPPartitionCols: append(append([]*property.MPPPartitionColumn{}, probeTask.hashCols...), &property.MPPPartitionColumn{Col: probeSalt}),
+	})
+	newBuildTask := buildTask.copy().(*mppTask)
+	newBuildTask.p = expand
+	newBuildTask = newBuildTask.enforceExchangerImpl(&property.PhysicalProperty{
+		TaskTp:           property.MppTaskType,
+		MPPPartitionTp:   property.HashType,
+		MPPPartitionCols: append(append([]*property.MPPPartitionColumn{}, buildTask.hashCols...), &property.MPPPartitionColumn{Col: buildSalt}),
+	})
+	if newProbeTask.invalid() || newBuildTask.invalid() {
+		return lTask, rTask, nil
+	}
+
+	lSalt, rSalt := probeSalt, buildSalt
+	lTask, rTask = newProbeTask, newBuildTask
+	if p.InnerChildIdx == 0 {
+		lSalt, rSalt = buildSalt, probeSalt
+		lTask, rTask = newBuildTask, newProbeTask
+	}
+	p.LeftJoinKeys = append(append(make([]*expression.Column, 0, len(p.LeftJoinKeys)+1), p.LeftJoinKeys...), lSalt)
+	p.RightJoinKeys = append(append(make([]*expression.Column, 0, len(p.RightJoinKeys)+1), p.RightJoinKeys...), rSalt)
+	eq := expression.NewFunctionInternal(p.ctx, ast.EQ, types.NewFieldType(mysql.TypeTiny), lSalt, rSalt).(*expression.ScalarFunction)
+	p.EqualConditions = append(append(make([]*expression.ScalarFunction, 0, len(p.EqualConditions)+1), p.EqualConditions...), eq)
+	// The join keys are salted only once even if the join is attached to the tasks again.
+	p.mppSkewSaltNum = 0
+	return lTask, rTask, []*expression.Column{lSalt, rSalt}
+}
+
 func (p *PhysicalHashJoin) attach2TaskForMpp(tasks ...task) task {
 	lTask, lok := tasks[0].(*mppTask)
 	rTask, rok := tasks[1].(*mppTask)
@@ -516,8 +624,31 @@ func (p *PhysicalHashJoin) attach2TaskForMpp(tasks ...task) task {
 		}
 		lTask, rTask = p.convertPartitionKeysIfNeed(lTask, rTask)
 	}
+	var saltCols []*expression.Column
+	if p.mppShuffleJoin && p.mppSkewSaltNum > 1 {
+		lTask, rTask, saltCols = p.saltSkewedJoinKeys(lTask, rTask)
+	}
 	p.SetChildren(lTask.plan(), rTask.plan())
 	p.schema = BuildPhysicalJoinSchema(p.JoinType, p)
+	if len(saltCols) > 0 {
+		// The salt columns are only used by the join, project them away. The join result is partitioned by the
+		// join keys and the salts, which can't be used by the parent.
+		proj := PhysicalProjection{}.Init(p.ctx, p.statsInfo(), p.SelectBlockOffset())
+		schema := expression.NewSchema()
+		for _, col := range p.schema.Columns {
+			if col.UniqueID == saltCols[0].UniqueID || col.UniqueID == saltCols[1].UniqueID {
+				continue
+			}
+			proj.Exprs = append(proj.Exprs, col)
+			schema.Append(col)
+		}
+		proj.SetSchema(schema)
+		proj.SetChildren(p)
+		return &mppTask{
+			p:      proj,
+			partTp: property.AnyType,
+		}
+	}
 
 	// outer task is the task that will pass its MPPPartitionType to the join result
 	// for broadcast inner join, it should be the non-broadcast side, since broadcast side is always the build side, so
@@ -269,3 +269,35 @@ func (impl *WindowImpl) CalcCost(_ float64, children ...memo.Implementation) flo
 	impl.cost = children[0].GetCost()
 	return impl.cost
 }
+
+// LockImpl is the implementation of PhysicalLock.
+type LockImpl struct {
+	baseImpl
+}
+
+// NewLockImpl creates a new LockImpl.
+func NewLockImpl(lock *plannercore.PhysicalLock) *LockImpl {
+	return &LockImpl{baseImpl{plan: lock}}
+}
+
+// CalcCost implements Implementation CalcCost interface.
+func (impl *LockImpl) CalcCost(_ float64, children ...memo.Implementation) float64 {
+	impl.cost = children[0].GetCost()
+	return impl.cost
+}
+
+// UnionScanImpl is the implementation of PhysicalUnionScan.
+type UnionScanImpl struct {
+	baseImpl
+}
+
+// NewUnionScanImpl creates a new UnionScanImpl.
+func NewUnionScanImpl(us *plannercore.PhysicalUnionScan) *UnionScanImpl {
+	return &UnionScanImpl{baseImpl{plan: us}}
+}
+
+// CalcCost implements Implementation CalcCost interface.
+func (impl *UnionScanImpl) CalcCost(_ float64, children ...memo.Implementation) float64 {
+	impl.cost = children[0].GetCost()
+	return impl.cost
+}
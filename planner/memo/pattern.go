@@ -46,7 +46,7 @@ const (
 	OperandDataSource
 	// OperandUnionScan is the operand for LogicalUnionScan.
 	OperandUnionScan
-	// OperandUnionAll is the operand for LogicalUnionAll.
+	// OperandUnionAll is the operand for LogicalUnionAll and LogicalPartitionUnionAll.
 	OperandUnionAll
 	// OperandSort is the operand for LogicalSort.
 	OperandSort
@@ -93,7 +93,7 @@ func GetOperand(p plannercore.LogicalPlan) Operand {
 		return OperandDataSource
 	case *plannercore.LogicalUnionScan:
 		return OperandUnionScan
-	case *plannercore.LogicalUnionAll:
+	case *plannercore.LogicalUnionAll, *plannercore.LogicalPartitionUnionAll:
 		return OperandUnionAll
 	case *plannercore.LogicalSort:
 		return OperandSort
@@ -32,6 +32,7 @@ func TestGetOperand(t *testing.T) {
 	require.Equal(t, OperandDataSource, GetOperand(&plannercore.DataSource{}))
 	require.Equal(t, OperandUnionScan, GetOperand(&plannercore.LogicalUnionScan{}))
 	require.Equal(t, OperandUnionAll, GetOperand(&plannercore.LogicalUnionAll{}))
+	require.Equal(t, OperandUnionAll, GetOperand(&plannercore.LogicalPartitionUnionAll{}))
 	require.Equal(t, OperandSort, GetOperand(&plannercore.LogicalSort{}))
 	require.Equal(t, OperandTopN, GetOperand(&plannercore.LogicalTopN{}))
 	require.Equal(t, OperandLock, GetOperand(&plannercore.LogicalLock{}))
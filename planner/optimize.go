@@ -429,11 +429,17 @@ func optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 	builder := planBuilderPool.Get().(*core.PlanBuilder)
 	defer planBuilderPool.Put(builder.ResetForReuse())
 	builder.Init(sctx, is, hintProcessor)
+	warnCnt := len(sctx.GetSessionVars().StmtCtx.GetWarnings())
 	p, err := buildLogicalPlan(ctx, sctx, node, builder)
 	if err != nil {
 		return nil, nil, 0, err
 	}
 
+	if sctx.GetSessionVars().RefreshActiveRoles == variable.RefreshActiveRolesImmediate {
+		if pm := privilege.GetPrivilegeManager(sctx); pm != nil {
+			pm.RefreshActiveRoles(sctx)
+		}
+	}
 	activeRoles := sctx.GetSessionVars().ActiveRoles
 	// Check privilege. Maybe it's better to move this to the Preprocess, but
 	// we need the table information to check privilege, which is collected
@@ -462,6 +468,27 @@ func optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 		finalPlan, cost, err := cascades.DefaultOptimizer.FindBestPlan(sctx, logic)
 		return finalPlan, names, cost, err
 	}
+	// The query shapes where the default optimizer is known to be weak are optimized by the cascades planner, and
+	// fall back to the default optimizer if it times out or fails.
+	if shape := cascades.MatchQueryShape(logic, sctx.GetSessionVars().CascadesPlannerQueryShapes); shape != "" {
+		deadline := time.Now().Add(sctx.GetSessionVars().CascadesPlannerTimeout)
+		finalPlan, cost, cascadesErr := cascades.DefaultOptimizer.FindBestPlanWithDeadline(sctx, logic, deadline)
+		if cascadesErr == nil {
+			return finalPlan, names, cost, nil
+		}
+		// The logical plan may have been changed by the cascades planner, so it's rebuilt, without the warnings of
+		// the first build.
+		sc := sctx.GetSessionVars().StmtCtx
+		sc.TruncateWarnings(warnCnt)
+		fallbackBuilder := planBuilderPool.Get().(*core.PlanBuilder)
+		defer planBuilderPool.Put(fallbackBuilder.ResetForReuse())
+		fallbackBuilder.Init(sctx, is, hintProcessor)
+		if p, err = buildLogicalPlan(ctx, sctx, node, fallbackBuilder); err != nil {
+			return nil, nil, 0, err
+		}
+		sc.AppendWarning(errors.Errorf("the cascades planner is skipped for the %s query: %v", shape, cascadesErr))
+		builder, logic, names = fallbackBuilder, p.(core.LogicalPlan), p.OutputNames()
+	}
 
 	beginOpt := time.Now()
 	finalPlan, cost, err := core.DoOptimize(ctx, sctx, builder.GetOptFlag(), logic)
@@ -804,6 +831,7 @@ func handleStmtHints(hints []*ast.TableOptimizerHint) (stmtHints stmtctx.StmtHin
 
 func init() {
 	core.OptimizeAstNode = Optimize
+	core.CascadesOptimize = cascades.DefaultOptimizer.FindBestPlan
 	core.IsReadOnly = IsReadOnly
 	core.ExtractSelectAndNormalizeDigest = ExtractSelectAndNormalizeDigest
 }
@@ -100,6 +100,10 @@ type Manager interface {
 	// The first illegal role will be returned.
 	ActiveRoles(ctx sessionctx.Context, roleList []*auth.RoleIdentity) (bool, string)
 
+	// RefreshActiveRoles evaluates the active roles of current session again if the privileges have changed
+	// since they were evaluated.
+	RefreshActiveRoles(ctx sessionctx.Context)
+
 	// FindEdge find if there is an edge between role and user.
 	FindEdge(ctx sessionctx.Context, role *auth.RoleIdentity, user *auth.UserIdentity) bool
 
@@ -29,6 +29,7 @@ import (
 	"github.com/lestrrat-go/jwx/v2/jwt/openid"
 	"github.com/pingcap/tidb/extension"
 	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/auth"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/privilege"
@@ -74,6 +75,8 @@ type UserPrivileges struct {
 	user string
 	host string
 	*Handle
+	// rolesPriv is the privileges which the active roles are evaluated with.
+	rolesPriv                 *MySQLPrivilege
 	extensionAccessCheckFuncs []extension.AccessCheckFunc
 }
 
@@ -852,9 +855,60 @@ func (p *UserPrivileges) ActiveRoles(ctx sessionctx.Context, roleList []*auth.Ro
 		}
 	}
 	ctx.GetSessionVars().ActiveRoles = roleList
+	p.rolesPriv = mysqlPrivilege
 	return true, ""
 }
 
+// RefreshActiveRoles implements privilege.Manager RefreshActiveRoles interface.
+func (p *UserPrivileges) RefreshActiveRoles(ctx sessionctx.Context) {
+	if SkipWithGrant || p.user == "" {
+		return
+	}
+	mysqlPrivilege := p.Handle.Get()
+	if mysqlPrivilege == p.rolesPriv {
+		return
+	}
+	p.rolesPriv = mysqlPrivilege
+	sessVars := ctx.GetSessionVars()
+	spec := sessVars.ActiveRolesSpec
+	if spec == nil || spec.SetRoleOpt == ast.SetRoleDefault {
+		sessVars.ActiveRoles = mysqlPrivilege.getDefaultRoles(p.user, p.host)
+		return
+	}
+	var roles []*auth.RoleIdentity
+	switch spec.SetRoleOpt {
+	case ast.SetRoleAll:
+		roles = mysqlPrivilege.getAllRoles(p.user, p.host)
+	case ast.SetRoleAllExcept:
+		for _, r := range mysqlPrivilege.getAllRoles(p.user, p.host) {
+			if !containsRole(spec.RoleList, r) {
+				roles = append(roles, r)
+			}
+		}
+	case ast.SetRoleRegular:
+		// The roles which are revoked from the user are deactivated, and they are activated again once they
+		// are granted back.
+		for _, r := range spec.RoleList {
+			if mysqlPrivilege.FindRole(p.user, p.host, r) && !containsRole(roles, r) {
+				roles = append(roles, r)
+			}
+		}
+	}
+	if roles == nil {
+		roles = make([]*auth.RoleIdentity, 0)
+	}
+	sessVars.ActiveRoles = roles
+}
+
+func containsRole(roles []*auth.RoleIdentity, role *auth.RoleIdentity) bool {
+	for _, r := range roles {
+		if r.Username == role.Username && r.Hostname == role.Hostname {
+			return true
+		}
+	}
+	return false
+}
+
 // FindEdge implements privilege.Manager FindRelationship interface.
 func (p *UserPrivileges) FindEdge(ctx sessionctx.Context, role *auth.RoleIdentity, user *auth.UserIdentity) bool {
 	if SkipWithGrant {
@@ -241,6 +241,47 @@ func TestCheckPrivilegeWithRoles(t *testing.T) {
 	require.Equal(t, 1, len(tk.Session().GetSessionVars().ActiveRoles))
 }
 
+func TestRefreshActiveRoles(t *testing.T) {
+	store := createStoreAndPrepareDB(t)
+	rootTk := testkit.NewTestKit(t, store)
+	rootTk.MustExec(`CREATE USER 'refresh_role'@'localhost';`)
+	rootTk.MustExec(`CREATE ROLE r_1, r_2;`)
+	rootTk.MustExec(`GRANT SELECT ON test.* TO r_1;`)
+	rootTk.MustExec(`GRANT INSERT ON test.* TO r_2;`)
+	rootTk.MustExec(`CREATE TABLE test.t_refresh (a int);`)
+
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "refresh_role", Hostname: "localhost"}, nil, nil))
+	tk.MustGetErrCode(`SELECT * FROM test.t_refresh`, errno.ErrTableaccessDenied)
+
+	// The default roles are activated without reconnecting.
+	rootTk.MustExec(`GRANT r_1, r_2 TO 'refresh_role'@'localhost';`)
+	rootTk.MustExec(`SET DEFAULT ROLE r_1 TO 'refresh_role'@'localhost';`)
+	tk.MustQuery(`SELECT * FROM test.t_refresh`).Check(testkit.Rows())
+	tk.MustGetErrCode(`INSERT INTO test.t_refresh VALUES (1)`, errno.ErrTableaccessDenied)
+
+	// The roles specified by SET ROLE are evaluated again.
+	tk.MustExec(`SET ROLE ALL;`)
+	tk.MustExec(`INSERT INTO test.t_refresh VALUES (1)`)
+	rootTk.MustExec(`REVOKE r_2 FROM 'refresh_role'@'localhost';`)
+	tk.MustGetErrCode(`INSERT INTO test.t_refresh VALUES (1)`, errno.ErrTableaccessDenied)
+	require.Equal(t, []*auth.RoleIdentity{{Username: "r_1", Hostname: "%"}}, tk.Session().GetSessionVars().ActiveRoles)
+
+	tk.MustExec(`SET ROLE r_1;`)
+	rootTk.MustExec(`REVOKE r_1 FROM 'refresh_role'@'localhost';`)
+	tk.MustGetErrCode(`SELECT * FROM test.t_refresh`, errno.ErrTableaccessDenied)
+	rootTk.MustExec(`GRANT r_1 TO 'refresh_role'@'localhost';`)
+	tk.MustQuery(`SELECT * FROM test.t_refresh`).Check(testkit.Rows("1"))
+
+	// The granted roles are not activated if the active roles are not refreshed.
+	tk.MustExec(`SET ROLE ALL;`)
+	tk.MustExec(`SET tidb_refresh_active_roles = 'OFF';`)
+	rootTk.MustExec(`GRANT r_2 TO 'refresh_role'@'localhost';`)
+	tk.MustGetErrCode(`INSERT INTO test.t_refresh VALUES (2)`, errno.ErrTableaccessDenied)
+	tk.MustExec(`SET tidb_refresh_active_roles = 'IMMEDIATE';`)
+	tk.MustExec(`INSERT INTO test.t_refresh VALUES (2)`)
+}
+
 func TestShowGrants(t *testing.T) {
 	store := createStoreAndPrepareDB(t)
 
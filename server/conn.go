@@ -1462,10 +1462,20 @@ func (cc *clientConn) writeOkWith(ctx context.Context, header byte, flush bool,
 	lastInsertID := cc.ctx.LastInsertID()
 	warnCnt := cc.ctx.WarningCount()
 
+	var sessionState []byte
+	if cc.capability&mysql.ClientSessionTrack > 0 && cc.ctx.GetSessionVars().TrackResourceUsage {
+		details := cc.ctx.GetSessionVars().StmtCtx.GetExecDetails()
+		sessionState = dumpResourceUsageSessionState(nil, &details)
+		status |= mysql.ServerSessionStateChanged
+	}
+
 	enclen := 0
-	if len(msg) > 0 {
+	if len(msg) > 0 || len(sessionState) > 0 {
 		enclen = lengthEncodedIntSize(uint64(len(msg))) + len(msg)
 	}
+	if len(sessionState) > 0 {
+		enclen += lengthEncodedIntSize(uint64(len(sessionState))) + len(sessionState)
+	}
 
 	data := cc.alloc.AllocWithLen(4, 32+enclen)
 	data = append(data, header)
@@ -1480,6 +1490,9 @@ func (cc *clientConn) writeOkWith(ctx context.Context, header byte, flush bool,
 		// it is actually string<lenenc>
 		data = dumpLengthEncodedString(data, []byte(msg))
 	}
+	if len(sessionState) > 0 {
+		data = dumpLengthEncodedString(data, sessionState)
+	}
 
 	err := cc.writePacket(data)
 	if err != nil {
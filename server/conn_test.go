@@ -1780,6 +1780,74 @@ func TestOkEof(t *testing.T) {
 	require.Equal(t, []byte{0x7, 0x0, 0x0, 0x1, 0xfe, 0x0, 0x0, 0x2, 0x0, 0x0, 0x0}, outBuffer.Bytes())
 }
 
+func TestOkWithResourceUsage(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	var outBuffer bytes.Buffer
+	cfg := newTestConfig()
+	cfg.Port, cfg.Status.StatusPort = 0, 0
+	cfg.Status.ReportStatus = false
+	server, err := NewServer(cfg, NewTiDBDriver(store))
+	require.NoError(t, err)
+	defer server.Close()
+	require.Zero(t, server.capability&mysql.ClientSessionTrack)
+
+	cfg.EnableSessionTrack = true
+	server2, err := NewServer(cfg, NewTiDBDriver(store))
+	require.NoError(t, err)
+	defer server2.Close()
+	require.NotZero(t, server2.capability&mysql.ClientSessionTrack)
+
+	cc := &clientConn{
+		connectionID: 1,
+		server:       server2,
+		pkt: &packetIO{
+			bufWriter: bufio.NewWriter(&outBuffer),
+		},
+		collation:  mysql.DefaultCollationID,
+		peerHost:   "localhost",
+		alloc:      arena.NewAllocator(512),
+		chunkAlloc: chunk.NewAllocator(),
+		capability: mysql.ClientProtocol41 | mysql.ClientSessionTrack,
+	}
+	tk := testkit.NewTestKit(t, store)
+	cc.setCtx(&TiDBContext{Session: tk.Session()})
+
+	// The resource usage isn't reported unless the session enables it.
+	require.NoError(t, cc.writeOK(context.Background()))
+	require.Equal(t, []byte{0x7, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2, 0x0, 0x0, 0x0}, outBuffer.Bytes())
+
+	tk.MustExec("set @@tidb_session_track_resource_usage = on")
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (1), (2)")
+	outBuffer.Reset()
+	require.NoError(t, cc.writeOK(context.Background()))
+	data := outBuffer.Bytes()[4:]
+	require.Equal(t, mysql.OKHeader, data[0])
+	// affected rows, last insert id
+	require.Equal(t, []byte{0x2, 0x0}, data[1:3])
+	status := binary.LittleEndian.Uint16(data[3:5])
+	require.NotZero(t, status&mysql.ServerSessionStateChanged)
+	data = data[7:]
+	// The info message is empty.
+	msg, _, n, err := parseLengthEncodedBytes(data)
+	require.NoError(t, err)
+	require.Empty(t, msg)
+	sessionState, _, n2, err := parseLengthEncodedBytes(data[n:])
+	require.NoError(t, err)
+	require.Len(t, data, n+n2)
+	usage := parseResourceUsageSessionState(t, sessionState)
+	require.Contains(t, usage, trackStmtKVCPUTime)
+	require.Contains(t, usage, trackStmtRU)
+
+	// The resource usage isn't reported if the client doesn't support the session tracker.
+	cc.capability = mysql.ClientProtocol41
+	outBuffer.Reset()
+	require.NoError(t, cc.writeOK(context.Background()))
+	require.Zero(t, binary.LittleEndian.Uint16(outBuffer.Bytes()[7:9])&mysql.ServerSessionStateChanged)
+}
+
 func TestExtensionChangeUser(t *testing.T) {
 	defer extension.Reset()
 	extension.Reset()
@@ -108,6 +108,8 @@ const (
 	qTableID   = "table_id"
 	qLimit     = "limit"
 	qJobID     = "start_job_id"
+	qEventID   = "event_id"
+	qStartID   = "start_event_id"
 	qOperation = "op"
 	qSeconds   = "seconds"
 )
@@ -390,6 +392,12 @@ type ddlHistoryJobHandler struct {
 	*tikvHandlerTool
 }
 
+// ddlNotifierHandler is the handler for polling the events of the finished DDL jobs, or acking them.
+type ddlNotifierHandler struct {
+	*tikvHandlerTool
+	ack bool
+}
+
 // ddlResignOwnerHandler is the handler for resigning ddl owner.
 type ddlResignOwnerHandler struct {
 	store kv.Storage
@@ -1348,6 +1356,61 @@ func (h ddlHistoryJobHandler) getHistoryDDL(jobID, limit int) (jobs []*model.Job
 	return jobs, nil
 }
 
+// ServeHTTP handles request of polling or acking the events of the finished DDL jobs.
+func (h ddlNotifierHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if h.ack && req.Method != http.MethodPost {
+		writeError(w, errors.Errorf("This api only support POST method"))
+		return
+	}
+	s, err := session.CreateSession(h.Store)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer s.Close()
+
+	if h.ack {
+		eventID, err := strconv.ParseUint(req.FormValue(qEventID), 10, 64)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if err = ddl.AckDDLEvents(s, eventID); err != nil {
+			writeError(w, err)
+			return
+		}
+		writeData(w, "success!")
+		return
+	}
+
+	var startID uint64
+	limit := 100
+	if startValue := req.FormValue(qStartID); len(startValue) > 0 {
+		startID, err = strconv.ParseUint(startValue, 10, 64)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	if limitValue := req.FormValue(qLimit); len(limitValue) > 0 {
+		limit, err = strconv.Atoi(limitValue)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if limit < 1 {
+			writeError(w, errors.New("ddl notifier limit must be greater than 0"))
+			return
+		}
+	}
+	events, err := ddl.GetDDLEvents(s, startID, limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeData(w, events)
+}
+
 func (h ddlResignOwnerHandler) resignDDLOwner() error {
 	dom, err := session.GetDomain(h.store)
 	if err != nil {
@@ -223,6 +223,8 @@ func (s *Server) startHTTPServer() {
 	router.Handle("/schema_storage/{db}/{table}", schemaStorageHandler{tikvHandlerTool})
 
 	router.Handle("/ddl/history", ddlHistoryJobHandler{tikvHandlerTool}).Name("DDL_History")
+	router.Handle("/ddl/notifier", ddlNotifierHandler{tikvHandlerTool, false}).Name("DDL_Notifier")
+	router.Handle("/ddl/notifier/ack", ddlNotifierHandler{tikvHandlerTool, true}).Name("DDL_Notifier_Ack")
 	router.Handle("/ddl/owner/resign", ddlResignOwnerHandler{tikvHandlerTool.Store.(kv.Storage)}).Name("DDL_Owner_Resign")
 
 	// HTTP path for get the TiDB config
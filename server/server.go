@@ -130,6 +130,7 @@ type Server struct {
 	driver            IDriver
 	listener          net.Listener
 	socket            net.Listener
+	xListener         net.Listener // the listener of the X Protocol
 	concurrentLimiter *TokenLimiter
 
 	rwlock  sync.RWMutex
@@ -256,6 +257,9 @@ func NewServer(cfg *config.Config, driver IDriver) (*Server, error) {
 	if s.tlsConfig != nil {
 		s.capability |= mysql.ClientSSL
 	}
+	if s.cfg.EnableSessionTrack {
+		s.capability |= mysql.ClientSessionTrack
+	}
 
 	if s.cfg.Host != "" && (s.cfg.Port != 0 || RunInGoTest) {
 		addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(int(s.cfg.Port)))
@@ -283,6 +287,14 @@ func NewServer(cfg *config.Config, driver IDriver) (*Server, error) {
 		logutil.BgLogger().Info("server is running MySQL protocol", zap.String("socket", s.cfg.Socket))
 	}
 
+	if s.cfg.Host != "" && s.cfg.XProtocolPort != 0 {
+		addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(int(s.cfg.XProtocolPort)))
+		if s.xListener, err = net.Listen("tcp", addr); err != nil {
+			return nil, errors.Trace(err)
+		}
+		logutil.BgLogger().Info("server is running X Protocol", zap.String("addr", addr))
+	}
+
 	if s.socket == nil && s.listener == nil {
 		err = errors.New("Server not configured to listen on either -socket or -host and -port")
 		return nil, errors.Trace(err)
@@ -401,6 +413,9 @@ func (s *Server) Run() error {
 	// If error should be reported and exit the server it can be sent on this
 	// channel. Otherwise, end with sending a nil error to signal "done"
 	errChan := make(chan error, 2)
+	if s.xListener != nil {
+		go s.startXProtocolListener(s.xListener)
+	}
 	go s.startNetworkListener(s.listener, false, errChan)
 	go s.startNetworkListener(s.socket, true, errChan)
 	err := <-errChan
@@ -535,6 +550,11 @@ func (s *Server) closeListener() {
 		terror.Log(errors.Trace(err))
 		s.socket = nil
 	}
+	if s.xListener != nil {
+		err := s.xListener.Close()
+		terror.Log(errors.Trace(err))
+		s.xListener = nil
+	}
 	if s.statusServer != nil {
 		err := s.statusServer.Close()
 		terror.Log(errors.Trace(err))
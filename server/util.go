@@ -49,6 +49,7 @@ import (
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/hack"
 	"github.com/pingcap/tidb/util/logutil"
 	"go.uber.org/zap"
@@ -148,6 +149,62 @@ func dumpUint16(buffer []byte, n uint16) []byte {
 	return buffer
 }
 
+// The names of the resource usage reported by the resource usage session state tracker.
+const (
+	trackStmtKVCPUTime     = "kv_cpu_time_us"
+	trackStmtWaitTime      = "kv_wait_time_us"
+	trackStmtRequestCount  = "request_count"
+	trackStmtProcessedKeys = "processed_keys"
+	trackStmtReadBytes     = "read_bytes"
+	trackStmtWriteBytes    = "write_bytes"
+	trackStmtRU            = "ru"
+)
+
+// The default request unit coefficients of the resource manager, they are used to estimate the RU of a statement.
+const (
+	ruReadBaseCost     = 0.25
+	ruReadCostPerByte  = 1.0 / (64 * 1024)
+	ruWriteBaseCost    = 1.0
+	ruWriteCostPerByte = 1.0 / 1024
+	ruCPUMsCost        = 1.0 / 3
+)
+
+// dumpResourceUsageSessionState dumps the resource usage of a statement as the session state info in the OK packet,
+// so the clients can account the cost of each statement by themselves. The data of the tracker is a list of
+// name-value pairs, and both of them are length encoded strings.
+func dumpResourceUsageSessionState(buffer []byte, details *execdetails.ExecDetails) []byte {
+	var processedKeys, readBytes, writeBytes, writeCount int64
+	if details.ScanDetail != nil {
+		processedKeys = details.ScanDetail.ProcessedKeys
+		readBytes = int64(details.ScanDetail.RocksdbBlockReadByte)
+	}
+	if details.CommitDetail != nil {
+		writeBytes = int64(details.CommitDetail.WriteSize)
+		writeCount = int64(details.CommitDetail.PrewriteRegionNum)
+	}
+	// The processing time of TiKV is taken as the CPU time, which is the same as the resource manager does.
+	kvCPUTime := details.TimeDetail.ProcessTime
+	ru := ruReadBaseCost*float64(details.RequestCount) + ruReadCostPerByte*float64(readBytes) +
+		ruWriteBaseCost*float64(writeCount) + ruWriteCostPerByte*float64(writeBytes) +
+		ruCPUMsCost*float64(kvCPUTime.Microseconds())/1000
+
+	var data []byte
+	data = dumpResourceUsage(data, trackStmtKVCPUTime, strconv.AppendInt(nil, kvCPUTime.Microseconds(), 10))
+	data = dumpResourceUsage(data, trackStmtWaitTime, strconv.AppendInt(nil, details.TimeDetail.WaitTime.Microseconds(), 10))
+	data = dumpResourceUsage(data, trackStmtRequestCount, strconv.AppendInt(nil, int64(details.RequestCount), 10))
+	data = dumpResourceUsage(data, trackStmtProcessedKeys, strconv.AppendInt(nil, processedKeys, 10))
+	data = dumpResourceUsage(data, trackStmtReadBytes, strconv.AppendInt(nil, readBytes, 10))
+	data = dumpResourceUsage(data, trackStmtWriteBytes, strconv.AppendInt(nil, writeBytes, 10))
+	data = dumpResourceUsage(data, trackStmtRU, strconv.AppendFloat(nil, ru, 'f', 2, 64))
+	buffer = append(buffer, mysql.SessionTrackResourceUsage)
+	return dumpLengthEncodedString(buffer, data)
+}
+
+func dumpResourceUsage(buffer []byte, name string, value []byte) []byte {
+	buffer = dumpLengthEncodedString(buffer, hack.Slice(name))
+	return dumpLengthEncodedString(buffer, value)
+}
+
 func dumpUint32(buffer []byte, n uint32) []byte {
 	buffer = append(buffer, byte(n))
 	buffer = append(buffer, byte(n>>8))
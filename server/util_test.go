@@ -25,8 +25,10 @@ import (
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/mock"
 	"github.com/stretchr/testify/require"
+	tikvutil "github.com/tikv/client-go/v2/util"
 )
 
 func TestDumpBinaryTime(t *testing.T) {
@@ -589,3 +591,49 @@ func newTestConfig() *config.Config {
 	cfg.Socket = ""
 	return cfg
 }
+
+func TestDumpResourceUsageSessionState(t *testing.T) {
+	details := &execdetails.ExecDetails{
+		DetailsNeedP90: execdetails.DetailsNeedP90{
+			TimeDetail: tikvutil.TimeDetail{ProcessTime: 3 * time.Millisecond, WaitTime: time.Millisecond},
+		},
+		ScanDetail:   &tikvutil.ScanDetail{ProcessedKeys: 10, RocksdbBlockReadByte: 64 * 1024},
+		CommitDetail: &tikvutil.CommitDetails{WriteSize: 1024, PrewriteRegionNum: 1},
+		RequestCount: 4,
+	}
+	data := dumpResourceUsageSessionState(nil, details)
+	require.Equal(t, map[string]string{
+		trackStmtKVCPUTime:     "3000",
+		trackStmtWaitTime:      "1000",
+		trackStmtRequestCount:  "4",
+		trackStmtProcessedKeys: "10",
+		trackStmtReadBytes:     "65536",
+		trackStmtWriteBytes:    "1024",
+		// 4 * 0.25 + 1 + 1 + 1 + 3 / 3
+		trackStmtRU: "5.00",
+	}, parseResourceUsageSessionState(t, data))
+
+	// The statement without any coprocessor or commit details.
+	data = dumpResourceUsageSessionState(nil, &execdetails.ExecDetails{})
+	require.Equal(t, "0.00", parseResourceUsageSessionState(t, data)[trackStmtRU])
+}
+
+func parseResourceUsageSessionState(t *testing.T, data []byte) map[string]string {
+	require.Equal(t, mysql.SessionTrackResourceUsage, data[0])
+	entries, isNull, n, err := parseLengthEncodedBytes(data[1:])
+	require.NoError(t, err)
+	require.False(t, isNull)
+	require.Len(t, data, 1+n)
+
+	usage := make(map[string]string)
+	for len(entries) > 0 {
+		name, _, n, err := parseLengthEncodedBytes(entries)
+		require.NoError(t, err)
+		entries = entries[n:]
+		value, _, n, err := parseLengthEncodedBytes(entries)
+		require.NoError(t, err)
+		entries = entries[n:]
+		usage[string(name)] = string(value)
+	}
+	return usage
+}
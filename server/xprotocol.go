@@ -0,0 +1,703 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/hack"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The X Protocol (mysqlx) frames a message as a 4 bytes little-endian length, a 1 byte message type and the
+// protobuf encoded payload. Only the messages of the document store subset are handled, the others are answered
+// with an error. The protobuf messages are encoded and decoded by hand, see the mysqlx_*.proto files of MySQL.
+
+// The message types sent by the client.
+const (
+	xClientConCapabilitiesGet       = 1
+	xClientConCapabilitiesSet       = 2
+	xClientConClose                 = 3
+	xClientSessAuthenticateStart    = 4
+	xClientSessAuthenticateContinue = 5
+	xClientSessReset                = 6
+	xClientSessClose                = 7
+	xClientSQLStmtExecute           = 12
+	xClientCrudFind                 = 17
+	xClientCrudInsert               = 18
+	xClientCrudUpdate               = 19
+	xClientCrudDelete               = 20
+)
+
+// The message types sent by the server.
+const (
+	xServerOk                       = 0
+	xServerError                    = 1
+	xServerConnCapabilities         = 2
+	xServerSessAuthenticateContinue = 3
+	xServerSessAuthenticateOk       = 4
+	xServerNotice                   = 11
+	xServerResultsetColumnMetaData  = 12
+	xServerResultsetRow             = 13
+	xServerResultsetFetchDone       = 14
+	xServerSQLStmtExecuteOk         = 17
+)
+
+// The types of Mysqlx.Datatypes.Scalar.
+const (
+	xScalarSInt   = 1
+	xScalarUInt   = 2
+	xScalarNull   = 3
+	xScalarOctets = 4
+	xScalarDouble = 5
+	xScalarFloat  = 6
+	xScalarBool   = 7
+	xScalarString = 8
+)
+
+// The types of Mysqlx.Datatypes.Any.
+const (
+	xAnyScalar = 1
+	xAnyObject = 2
+	xAnyArray  = 3
+)
+
+// The types of Mysqlx.Resultset.ColumnMetaData.
+const (
+	xFieldSInt   = 1
+	xFieldUInt   = 2
+	xFieldDouble = 5
+	xFieldFloat  = 6
+	xFieldBytes  = 7
+)
+
+const (
+	// xAuthMechMySQL41 is the challenge-response authentication mechanism of the X Protocol.
+	xAuthMechMySQL41 = "MYSQL41"
+	// xContentTypeJSON is the content type of the JSON values in Octets and ColumnMetaData.
+	xContentTypeJSON = 2
+	// xMaxMessageSize is the max size of the messages read from the client.
+	xMaxMessageSize = 64 * 1024 * 1024
+)
+
+var errXProtocolMalformed = errors.New("malformed X Protocol message")
+
+// xField is a field of a protobuf message.
+type xField struct {
+	num protowire.Number
+	v   uint64
+	b   []byte
+}
+
+// xMessage is a decoded protobuf message. Since the messages are decoded without their descriptors, the fields
+// keep the raw wire values and are interpreted by the getters.
+type xMessage []xField
+
+func parseXMessage(data []byte) (xMessage, error) {
+	var msg xMessage
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, errXProtocolMalformed
+		}
+		data = data[n:]
+		f := xField{num: num}
+		switch typ {
+		case protowire.VarintType:
+			f.v, n = protowire.ConsumeVarint(data)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(data)
+			f.v = uint64(v)
+		case protowire.Fixed64Type:
+			f.v, n = protowire.ConsumeFixed64(data)
+		case protowire.BytesType:
+			f.b, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return nil, errXProtocolMalformed
+		}
+		data = data[n:]
+		msg = append(msg, f)
+	}
+	return msg, nil
+}
+
+func (m xMessage) has(num protowire.Number) bool {
+	for _, f := range m {
+		if f.num == num {
+			return true
+		}
+	}
+	return false
+}
+
+// uint returns the last value of the field, the same as the protobuf decoders.
+func (m xMessage) uint(num protowire.Number) uint64 {
+	var v uint64
+	for _, f := range m {
+		if f.num == num {
+			v = f.v
+		}
+	}
+	return v
+}
+
+func (m xMessage) bytes(num protowire.Number) []byte {
+	var b []byte
+	for _, f := range m {
+		if f.num == num {
+			b = f.b
+		}
+	}
+	return b
+}
+
+func (m xMessage) str(num protowire.Number) string {
+	return string(m.bytes(num))
+}
+
+func (m xMessage) msg(num protowire.Number) (xMessage, error) {
+	return parseXMessage(m.bytes(num))
+}
+
+func (m xMessage) repeated(num protowire.Number) ([]xMessage, error) {
+	var msgs []xMessage
+	for _, f := range m {
+		if f.num == num {
+			sub, err := parseXMessage(f.b)
+			if err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, sub)
+		}
+	}
+	return msgs, nil
+}
+
+// decodeXScalar decodes a Mysqlx.Datatypes.Scalar to a value which can be used as an argument of sqlexec.EscapeSQL.
+func decodeXScalar(m xMessage) (interface{}, error) {
+	switch m.uint(1) {
+	case xScalarSInt:
+		return protowire.DecodeZigZag(m.uint(2)), nil
+	case xScalarUInt:
+		return m.uint(3), nil
+	case xScalarNull:
+		return nil, nil
+	case xScalarOctets:
+		octets, err := m.msg(5)
+		if err != nil {
+			return nil, err
+		}
+		return string(octets.bytes(1)), nil
+	case xScalarDouble:
+		return math.Float64frombits(m.uint(6)), nil
+	case xScalarFloat:
+		return math.Float32frombits(uint32(m.uint(7))), nil
+	case xScalarBool:
+		return m.uint(8) != 0, nil
+	case xScalarString:
+		str, err := m.msg(9)
+		if err != nil {
+			return nil, err
+		}
+		return str.str(1), nil
+	}
+	return nil, errors.Errorf("unknown X Protocol scalar type %d", m.uint(1))
+}
+
+func appendXString(b []byte, num protowire.Number, s string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendXBytes(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func appendXUint(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// encodeXStringScalarAny encodes a string as a Mysqlx.Datatypes.Any.
+func encodeXStringScalarAny(s string) []byte {
+	str := appendXString(nil, 1, s)
+	scalar := appendXUint(nil, 1, xScalarString)
+	scalar = appendXBytes(scalar, 9, str)
+	v := appendXUint(nil, 1, xAnyScalar)
+	return appendXBytes(v, 2, scalar)
+}
+
+// xConn is a connection of the X Protocol. Its clientConn is registered to the server once it's authenticated,
+// so it's limited, listed, killed and drained the same as the connections of the MySQL protocol.
+type xConn struct {
+	cc *clientConn
+	// authMech is the mechanism of the running authentication.
+	authMech string
+}
+
+func (s *Server) startXProtocolListener(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !s.inShutdownMode.Load() {
+				logutil.BgLogger().Error("X Protocol accept failed", zap.Error(err))
+			}
+			return
+		}
+		xc := &xConn{cc: s.newConn(conn)}
+		go xc.run()
+	}
+}
+
+func (xc *xConn) run() {
+	cc := xc.cc
+	ctx := logutil.WithConnID(context.Background(), cc.connectionID)
+	defer func() {
+		if atomic.LoadInt32(&cc.status) != connStatusShutdown {
+			terror.Log(cc.Close())
+		}
+		close(cc.quit)
+	}()
+	for {
+		// Close the connection between transactions when the server is shutting down.
+		if cc.server.inShutdownMode.Load() {
+			if tc := cc.getCtx(); tc == nil || !tc.GetSessionVars().InTxn() {
+				return
+			}
+		}
+		if !atomic.CompareAndSwapInt32(&cc.status, connStatusDispatching, connStatusReading) {
+			return
+		}
+		tp, payload, err := xc.readMessage()
+		if err != nil {
+			if errors.Cause(err) != io.EOF && atomic.LoadInt32(&cc.status) != connStatusWaitShutdown {
+				logutil.Logger(ctx).Info("read X Protocol message failed", zap.Error(err))
+			}
+			return
+		}
+		if !atomic.CompareAndSwapInt32(&cc.status, connStatusReading, connStatusDispatching) {
+			return
+		}
+		if tp == xClientConClose {
+			terror.Log(xc.writeMessage(xServerOk, nil))
+			return
+		}
+		if err = xc.dispatch(ctx, tp, payload); err != nil {
+			if err = xc.writeError(err); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (xc *xConn) readMessage() (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(xc.cc.bufReadConn, header[:]); err != nil {
+		return 0, nil, errors.Trace(err)
+	}
+	length := binary.LittleEndian.Uint32(header[:4])
+	if length == 0 || length > xMaxMessageSize {
+		return 0, nil, errNetPacketTooLarge
+	}
+	payload := make([]byte, length-1)
+	if _, err := io.ReadFull(xc.cc.bufReadConn, payload); err != nil {
+		return 0, nil, errors.Trace(err)
+	}
+	return header[4], payload, nil
+}
+
+func (xc *xConn) writeMessage(tp byte, payload []byte) error {
+	buf := make([]byte, 5, 5+len(payload))
+	binary.LittleEndian.PutUint32(buf, uint32(len(payload)+1))
+	buf[4] = tp
+	buf = append(buf, payload...)
+	_, err := xc.cc.bufReadConn.Write(buf)
+	return errors.Trace(err)
+}
+
+func (xc *xConn) writeError(e error) error {
+	m := toSQLError(e)
+	payload := appendXUint(nil, 1, 0)
+	payload = appendXUint(payload, 2, uint64(m.Code))
+	payload = appendXString(payload, 3, m.Message)
+	payload = appendXString(payload, 4, m.State)
+	return xc.writeMessage(xServerError, payload)
+}
+
+func toSQLError(e error) *mysql.SQLError {
+	originErr := errors.Cause(e)
+	if te, ok := originErr.(*terror.Error); ok {
+		return terror.ToSQLError(te)
+	}
+	return mysql.NewErrf(mysql.ErrUnknown, "%s", nil, originErr.Error())
+}
+
+func (xc *xConn) dispatch(ctx context.Context, tp byte, payload []byte) error {
+	switch tp {
+	case xClientConCapabilitiesGet:
+		return xc.writeCapabilities()
+	case xClientConCapabilitiesSet:
+		// None of the capabilities can be changed, TLS isn't supported by the X Protocol server.
+		return errors.New("X Protocol capabilities can't be set")
+	case xClientSessAuthenticateStart:
+		return xc.authenticateStart(payload)
+	case xClientSessAuthenticateContinue:
+		return xc.authenticateContinue(ctx, payload)
+	case xClientSessReset, xClientSessClose:
+		xc.closeSession()
+		return xc.writeMessage(xServerOk, nil)
+	}
+	tc := xc.cc.getCtx()
+	if tc == nil {
+		return errAccessDeniedNoPassword.FastGenByArgs("", xc.cc.bufReadConn.RemoteAddr().String())
+	}
+	msg, err := parseXMessage(payload)
+	if err != nil {
+		return err
+	}
+	var sql string
+	switch tp {
+	case xClientSQLStmtExecute:
+		sql, err = buildXStmtExecute(msg)
+	case xClientCrudFind:
+		sql, err = buildXFind(msg)
+	case xClientCrudInsert:
+		sql, err = buildXInsert(msg, xc.serverID())
+	case xClientCrudUpdate:
+		sql, err = buildXUpdate(msg)
+	case xClientCrudDelete:
+		sql, err = buildXDelete(msg)
+	default:
+		return errors.Errorf("X Protocol message type %d isn't supported", tp)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Let the statement be killed, and limit the concurrent statements, the same as clientConn.dispatch.
+	ctx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+	xc.cc.mu.Lock()
+	xc.cc.mu.cancelFunc = cancelFunc
+	xc.cc.mu.Unlock()
+	token := xc.cc.server.getToken()
+	defer func() {
+		tc.SetProcessInfo("", time.Now(), mysql.ComSleep, 0)
+		xc.cc.server.releaseToken(token)
+	}()
+	atomic.StoreUint32(&tc.GetSessionVars().Killed, 0)
+	return xc.execute(ctx, tc, sql)
+}
+
+// serverID returns the ID of the server, which is the prefix of the generated document IDs.
+func (xc *xConn) serverID() uint64 {
+	g := &xc.cc.server.globalConnID
+	if g.ServerIDGetter != nil {
+		return g.ServerIDGetter()
+	}
+	return g.ServerID
+}
+
+func (xc *xConn) writeCapabilities() error {
+	mechanisms := appendXBytes(nil, 1, encodeXStringScalarAny(xAuthMechMySQL41))
+	array := appendXUint(nil, 1, xAnyArray)
+	array = appendXBytes(array, 4, mechanisms)
+	var payload []byte
+	for _, c := range []struct {
+		name  string
+		value []byte
+	}{
+		{"authentication.mechanisms", array},
+		{"doc.formats", encodeXStringScalarAny("text")},
+		{"node_type", encodeXStringScalarAny("mysql")},
+	} {
+		capability := appendXString(nil, 1, c.name)
+		capability = appendXBytes(capability, 2, c.value)
+		payload = appendXBytes(payload, 1, capability)
+	}
+	return xc.writeMessage(xServerConnCapabilities, payload)
+}
+
+// authenticateStart starts the authentication by the MYSQL41 mechanism, which is challenged by the salt.
+// The PLAIN mechanism sends the password in clear text, so it's refused since TLS isn't supported.
+func (xc *xConn) authenticateStart(payload []byte) error {
+	msg, err := parseXMessage(payload)
+	if err != nil {
+		return err
+	}
+	if mech := msg.str(1); mech != xAuthMechMySQL41 {
+		return errNotSupportedAuthMode.GenWithStackByArgs()
+	}
+	xc.authMech = xAuthMechMySQL41
+	return xc.writeMessage(xServerSessAuthenticateContinue, appendXBytes(nil, 1, xc.cc.salt))
+}
+
+// authenticateContinue authenticates the client by the response of MYSQL41, whose auth data is
+// "schema\0user\0*scramble", the scramble is in hex and is the same as the one of mysql_native_password.
+func (xc *xConn) authenticateContinue(ctx context.Context, payload []byte) error {
+	if xc.authMech != xAuthMechMySQL41 {
+		return errXProtocolMalformed
+	}
+	xc.authMech = ""
+	msg, err := parseXMessage(payload)
+	if err != nil {
+		return err
+	}
+	parts := bytes.SplitN(msg.bytes(1), []byte{0}, 3)
+	if len(parts) != 3 {
+		return errXProtocolMalformed
+	}
+	dbname, user := string(parts[0]), string(parts[1])
+	var scramble []byte
+	if len(parts[2]) > 0 {
+		if parts[2][0] != '*' {
+			return errXProtocolMalformed
+		}
+		if scramble, err = hex.DecodeString(string(parts[2][1:])); err != nil {
+			return errXProtocolMalformed
+		}
+	}
+
+	cc := xc.cc
+	xc.closeSession()
+	if err = cc.server.checkConnectionCount(); err != nil {
+		return err
+	}
+	tc, err := cc.server.driver.OpenCtx(cc.connectionID, defaultCapability, mysql.DefaultCollationID, dbname, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err = xc.doAuth(ctx, tc, user, dbname, scramble); err != nil {
+		terror.Log(tc.Close())
+		return err
+	}
+	cc.user, cc.dbname = user, dbname
+	cc.setCtx(tc)
+	if !cc.server.registerConn(cc) {
+		return errors.Trace(io.EOF)
+	}
+	tc.GetSessionVars().ConnectionInfo = cc.connectInfo()
+	return xc.writeMessage(xServerSessAuthenticateOk, nil)
+}
+
+// closeSession closes the session of the connection, and unregisters the connection from the server.
+func (xc *xConn) closeSession() {
+	cc := xc.cc
+	tc := cc.getCtx()
+	if tc == nil {
+		return
+	}
+	cc.server.rwlock.Lock()
+	delete(cc.server.clients, cc.connectionID)
+	metrics.ConnGauge.Set(float64(len(cc.server.clients)))
+	cc.server.rwlock.Unlock()
+	cc.setCtx(nil)
+	terror.Log(tc.Close())
+}
+
+func (xc *xConn) doAuth(ctx context.Context, tc *TiDBContext, user, dbname string, scramble []byte) error {
+	host, port, err := net.SplitHostPort(xc.cc.bufReadConn.RemoteAddr().String())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	userIdentity := &auth.UserIdentity{Username: user, Hostname: host, AuthPlugin: mysql.AuthNativePassword}
+	plugin, err := tc.AuthPluginForUser(userIdentity)
+	if err != nil {
+		return err
+	}
+	// The scramble of MYSQL41 can only be checked against the password of mysql_native_password.
+	if plugin != mysql.AuthNativePassword && plugin != "" {
+		hasPassword := "YES"
+		if len(scramble) == 0 {
+			hasPassword = "NO"
+		}
+		return errAccessDenied.FastGenByArgs(user, host, hasPassword)
+	}
+	if err = tc.Auth(userIdentity, scramble, xc.cc.salt); err != nil {
+		return err
+	}
+	tc.SetPort(port)
+	tc.SetSessionManager(xc.cc.server)
+	if dbname != "" {
+		stmts, err := tc.Parse(ctx, sqlexec.MustEscapeSQL("USE %n", dbname))
+		if err != nil {
+			return err
+		}
+		if _, err = tc.ExecuteStmt(ctx, stmts[0]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execute executes the sql built from a message, and writes its result set, the rows affected notice and
+// StmtExecuteOk.
+func (xc *xConn) execute(ctx context.Context, tc *TiDBContext, sql string) error {
+	stmts, err := tc.Parse(ctx, sql)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		rs, err := tc.ExecuteStmt(ctx, stmt)
+		if err != nil {
+			return err
+		}
+		if rs != nil {
+			err = xc.writeResultSet(ctx, rs)
+			terror.Log(rs.Close())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if err = xc.writeRowsAffected(tc.GetSessionVars().StmtCtx.AffectedRows()); err != nil {
+		return err
+	}
+	return xc.writeMessage(xServerSQLStmtExecuteOk, nil)
+}
+
+func (xc *xConn) writeResultSet(ctx context.Context, rs ResultSet) error {
+	columns := rs.Columns()
+	for _, col := range columns {
+		if err := xc.writeMessage(xServerResultsetColumnMetaData, encodeXColumnMetaData(col)); err != nil {
+			return err
+		}
+	}
+	chk := rs.NewChunk(nil)
+	for {
+		if err := rs.Next(ctx, chk); err != nil {
+			return err
+		}
+		if chk.NumRows() == 0 {
+			break
+		}
+		for i := 0; i < chk.NumRows(); i++ {
+			if err := xc.writeMessage(xServerResultsetRow, encodeXRow(columns, chk.GetRow(i))); err != nil {
+				return err
+			}
+		}
+	}
+	return xc.writeMessage(xServerResultsetFetchDone, nil)
+}
+
+// writeRowsAffected writes the SessionStateChanged notice of ROWS_AFFECTED.
+func (xc *xConn) writeRowsAffected(rows uint64) error {
+	scalar := appendXUint(nil, 1, xScalarUInt)
+	scalar = appendXUint(scalar, 3, rows)
+	changed := appendXUint(nil, 1, 4) // ROWS_AFFECTED
+	changed = appendXBytes(changed, 2, scalar)
+	frame := appendXUint(nil, 1, 3)  // SESSION_STATE_CHANGED
+	frame = appendXUint(frame, 2, 2) // LOCAL
+	frame = appendXBytes(frame, 3, changed)
+	return xc.writeMessage(xServerNotice, frame)
+}
+
+func xFieldType(col *ColumnInfo) uint64 {
+	switch col.Type {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeYear:
+		if mysql.HasUnsignedFlag(uint(col.Flag)) {
+			return xFieldUInt
+		}
+		return xFieldSInt
+	case mysql.TypeFloat:
+		return xFieldFloat
+	case mysql.TypeDouble:
+		return xFieldDouble
+	}
+	// The other values are sent as their text representations.
+	return xFieldBytes
+}
+
+func encodeXColumnMetaData(col *ColumnInfo) []byte {
+	b := appendXUint(nil, 1, xFieldType(col))
+	b = appendXString(b, 2, col.Name)
+	b = appendXString(b, 3, col.OrgName)
+	b = appendXString(b, 4, col.Table)
+	b = appendXString(b, 5, col.OrgTable)
+	b = appendXString(b, 6, col.Schema)
+	b = appendXString(b, 7, "def")
+	b = appendXUint(b, 8, uint64(col.Charset))
+	b = appendXUint(b, 10, uint64(col.ColumnLength))
+	b = appendXUint(b, 11, uint64(col.Flag))
+	if col.Type == mysql.TypeJSON {
+		b = appendXUint(b, 12, xContentTypeJSON)
+	}
+	return b
+}
+
+// encodeXRow encodes a row as Mysqlx.Resultset.Row. A NULL is an empty field, an integer is a varint, a float
+// is a little-endian fixed value, and the others are the text values followed by a '\0'.
+func encodeXRow(columns []*ColumnInfo, row chunk.Row) []byte {
+	var b []byte
+	for i, col := range columns {
+		var field []byte
+		if !row.IsNull(i) {
+			switch xFieldType(col) {
+			case xFieldSInt:
+				field = protowire.AppendVarint(nil, protowire.EncodeZigZag(row.GetInt64(i)))
+			case xFieldUInt:
+				field = protowire.AppendVarint(nil, row.GetUint64(i))
+			case xFieldFloat:
+				field = binary.LittleEndian.AppendUint32(nil, math.Float32bits(row.GetFloat32(i)))
+			case xFieldDouble:
+				field = binary.LittleEndian.AppendUint64(nil, math.Float64bits(row.GetFloat64(i)))
+			default:
+				field = append(xTextValue(col, row, i), 0)
+			}
+		}
+		b = appendXBytes(b, 1, field)
+	}
+	return b
+}
+
+func xTextValue(col *ColumnInfo, row chunk.Row, i int) []byte {
+	switch col.Type {
+	case mysql.TypeNewDecimal:
+		return hack.Slice(row.GetMyDecimal(i).String())
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		return hack.Slice(row.GetTime(i).String())
+	case mysql.TypeDuration:
+		return hack.Slice(row.GetDuration(i, int(col.Decimal)).String())
+	case mysql.TypeEnum:
+		return hack.Slice(row.GetEnum(i).String())
+	case mysql.TypeSet:
+		return hack.Slice(row.GetSet(i).String())
+	case mysql.TypeJSON:
+		return hack.Slice(row.GetJSON(i).String())
+	}
+	return append([]byte(nil), row.GetBytes(i)...)
+}
@@ -0,0 +1,784 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// A collection of the document store is a table with a JSON column `doc`, and the `_id` member of the documents
+// is the primary key of the table. The CRUD messages are translated to the SQL statements on the collections.
+
+// xDataModelDocument is the document data model of the CRUD messages, the other one is the table data model.
+const xDataModelDocument = 1
+
+// The types of Mysqlx.Expr.Expr.
+const (
+	xExprIdent       = 1
+	xExprLiteral     = 2
+	xExprFuncCall    = 4
+	xExprOperator    = 5
+	xExprPlaceholder = 6
+	xExprObject      = 7
+	xExprArray       = 8
+)
+
+// The types of Mysqlx.Crud.UpdateOperation.
+const (
+	xUpdateSet         = 1
+	xUpdateItemRemove  = 2
+	xUpdateItemSet     = 3
+	xUpdateItemReplace = 4
+	xUpdateItemMerge   = 5
+	xUpdateArrayInsert = 6
+	xUpdateArrayAppend = 7
+	xUpdateMergePatch  = 8
+)
+
+// xCollectionDefinition is the definition of the table of a collection.
+const xCollectionDefinition = "%n.%n (`doc` JSON, " +
+	"`_id` VARBINARY(32) GENERATED ALWAYS AS (JSON_UNQUOTE(JSON_EXTRACT(`doc`, '$._id'))) STORED NOT NULL, " +
+	"PRIMARY KEY (`_id`))"
+
+// xBinaryOperators maps the binary operators of the X Protocol to SQL.
+var xBinaryOperators = map[string]string{
+	"==": "=", "!=": "!=", "<>": "!=", ">": ">", ">=": ">=", "<": "<", "<=": "<=",
+	"&&": "AND", "||": "OR", "xor": "XOR",
+	"+": "+", "-": "-", "*": "*", "/": "/", "div": "DIV", "%": "%",
+	"&": "&", "|": "|", "^": "^", "<<": "<<", ">>": ">>",
+	"is": "IS", "is_not": "IS NOT", "like": "LIKE", "not_like": "NOT LIKE",
+	"regexp": "REGEXP", "not_regexp": "NOT REGEXP",
+}
+
+// xUnaryOperators maps the unary operators of the X Protocol to SQL.
+var xUnaryOperators = map[string]string{
+	"!": "NOT ", "not": "NOT ", "sign_minus": "-", "sign_plus": "+", "~": "~",
+}
+
+var xIdentifierRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var (
+	xDocumentIDStartTime = uint64(time.Now().Unix())
+	xDocumentIDCounter   atomic.Uint64
+)
+
+// genXDocumentID generates the `_id` for the inserted documents which don't have one. Like MySQL, the ID is made of
+// a prefix, the start time of the server and a serial number. The prefix is the server ID, so that the servers of
+// a cluster don't generate the same ID.
+func genXDocumentID(serverID uint64) string {
+	return fmt.Sprintf("%08x%08x%016x", serverID, xDocumentIDStartTime, xDocumentIDCounter.Add(1))
+}
+
+// xSQLBuilder builds the SQL statement of a CRUD message.
+type xSQLBuilder struct {
+	sb    strings.Builder
+	args  []interface{}
+	isDoc bool
+}
+
+func newXSQLBuilder(msg xMessage, dataModelNum, argsNum protowire.Number) (*xSQLBuilder, error) {
+	b := &xSQLBuilder{isDoc: !msg.has(dataModelNum) || msg.uint(dataModelNum) == xDataModelDocument}
+	scalars, err := msg.repeated(argsNum)
+	if err != nil {
+		return nil, err
+	}
+	for _, scalar := range scalars {
+		arg, err := decodeXScalar(scalar)
+		if err != nil {
+			return nil, err
+		}
+		b.args = append(b.args, arg)
+	}
+	return b, nil
+}
+
+func (b *xSQLBuilder) format(sql string, args ...interface{}) {
+	sqlexec.MustFormatSQL(&b.sb, sql, args...)
+}
+
+func (b *xSQLBuilder) collection(msg xMessage, num protowire.Number) error {
+	coll, err := msg.msg(num)
+	if err != nil {
+		return err
+	}
+	if schema := coll.str(2); schema != "" {
+		b.format("%n.", schema)
+	}
+	b.format("%n", coll.str(1))
+	return nil
+}
+
+func (b *xSQLBuilder) criteria(msg xMessage, num protowire.Number) error {
+	if !msg.has(num) {
+		return nil
+	}
+	crit, err := msg.msg(num)
+	if err != nil {
+		return err
+	}
+	b.sb.WriteString(" WHERE ")
+	return b.expr(crit)
+}
+
+func (b *xSQLBuilder) order(msg xMessage, num protowire.Number) error {
+	orders, err := msg.repeated(num)
+	if err != nil {
+		return err
+	}
+	for i, order := range orders {
+		if i == 0 {
+			b.sb.WriteString(" ORDER BY ")
+		} else {
+			b.sb.WriteString(", ")
+		}
+		e, err := order.msg(1)
+		if err != nil {
+			return err
+		}
+		if err = b.expr(e); err != nil {
+			return err
+		}
+		if order.uint(2) == 2 {
+			b.sb.WriteString(" DESC")
+		}
+	}
+	return nil
+}
+
+// limit writes the limit clause, the offset is only allowed by Find.
+func (b *xSQLBuilder) limit(msg xMessage, num protowire.Number, allowOffset bool) error {
+	if !msg.has(num) {
+		return nil
+	}
+	limit, err := msg.msg(num)
+	if err != nil {
+		return err
+	}
+	if limit.uint(2) != 0 {
+		if !allowOffset {
+			return errors.New("X Protocol limit offset isn't supported by the message")
+		}
+		b.format(" LIMIT %?, %?", limit.uint(2), limit.uint(1))
+		return nil
+	}
+	b.format(" LIMIT %?", limit.uint(1))
+	return nil
+}
+
+// buildXDocumentPath builds the JSON path of the repeated Mysqlx.Expr.DocumentPathItem.
+func buildXDocumentPath(items []xMessage) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("$")
+	for _, item := range items {
+		switch item.uint(1) {
+		case 1: // MEMBER
+			sb.WriteString(".")
+			if member := item.str(2); xIdentifierRe.MatchString(member) {
+				sb.WriteString(member)
+			} else {
+				sb.WriteString(strconv.Quote(member))
+			}
+		case 2: // MEMBER_ASTERISK
+			sb.WriteString(".*")
+		case 3: // ARRAY_INDEX
+			sb.WriteString("[")
+			sb.WriteString(strconv.FormatUint(item.uint(3), 10))
+			sb.WriteString("]")
+		case 4: // ARRAY_INDEX_ASTERISK
+			sb.WriteString("[*]")
+		case 5: // DOUBLE_ASTERISK
+			sb.WriteString("**")
+		default:
+			return "", errors.Errorf("unknown X Protocol document path item type %d", item.uint(1))
+		}
+	}
+	return sb.String(), nil
+}
+
+// ident writes a Mysqlx.Expr.ColumnIdentifier. The document path is extracted from the `doc` column for the
+// document model, or from the named column for the table model.
+func (b *xSQLBuilder) ident(id xMessage) error {
+	items, err := id.repeated(1)
+	if err != nil {
+		return err
+	}
+	column := id.str(2)
+	if b.isDoc || column == "" {
+		column = "doc"
+	}
+	if len(items) == 0 {
+		b.format("%n", column)
+		return nil
+	}
+	path, err := buildXDocumentPath(items)
+	if err != nil {
+		return err
+	}
+	b.format("JSON_EXTRACT(%n, %?)", column, path)
+	return nil
+}
+
+func (b *xSQLBuilder) exprList(exprs []xMessage) error {
+	for i, e := range exprs {
+		if i > 0 {
+			b.sb.WriteString(", ")
+		}
+		if err := b.expr(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expr writes a Mysqlx.Expr.Expr.
+func (b *xSQLBuilder) expr(e xMessage) error {
+	switch e.uint(1) {
+	case xExprIdent:
+		id, err := e.msg(2)
+		if err != nil {
+			return err
+		}
+		return b.ident(id)
+	case xExprLiteral:
+		scalar, err := e.msg(4)
+		if err != nil {
+			return err
+		}
+		v, err := decodeXScalar(scalar)
+		if err != nil {
+			return err
+		}
+		b.format("%?", v)
+	case xExprPlaceholder:
+		pos := e.uint(7)
+		if pos >= uint64(len(b.args)) {
+			return errors.Errorf("X Protocol placeholder %d is out of the %d args", pos, len(b.args))
+		}
+		b.format("%?", b.args[pos])
+	case xExprFuncCall:
+		call, err := e.msg(5)
+		if err != nil {
+			return err
+		}
+		name, err := call.msg(1)
+		if err != nil {
+			return err
+		}
+		if !xIdentifierRe.MatchString(name.str(1)) {
+			return errors.Errorf("invalid X Protocol function name %s", name.str(1))
+		}
+		if schema := name.str(2); schema != "" {
+			b.format("%n.", schema)
+		}
+		params, err := call.repeated(2)
+		if err != nil {
+			return err
+		}
+		b.sb.WriteString(name.str(1))
+		b.sb.WriteString("(")
+		if err = b.exprList(params); err != nil {
+			return err
+		}
+		b.sb.WriteString(")")
+	case xExprOperator:
+		op, err := e.msg(6)
+		if err != nil {
+			return err
+		}
+		return b.operator(op)
+	case xExprObject:
+		obj, err := e.msg(8)
+		if err != nil {
+			return err
+		}
+		fields, err := obj.repeated(1)
+		if err != nil {
+			return err
+		}
+		b.sb.WriteString("JSON_OBJECT(")
+		for i, field := range fields {
+			if i > 0 {
+				b.sb.WriteString(", ")
+			}
+			b.format("%?, ", field.str(1))
+			v, err := field.msg(2)
+			if err != nil {
+				return err
+			}
+			if err = b.expr(v); err != nil {
+				return err
+			}
+		}
+		b.sb.WriteString(")")
+	case xExprArray:
+		arr, err := e.msg(9)
+		if err != nil {
+			return err
+		}
+		values, err := arr.repeated(1)
+		if err != nil {
+			return err
+		}
+		b.sb.WriteString("JSON_ARRAY(")
+		if err = b.exprList(values); err != nil {
+			return err
+		}
+		b.sb.WriteString(")")
+	default:
+		return errors.Errorf("X Protocol expression type %d isn't supported", e.uint(1))
+	}
+	return nil
+}
+
+// operator writes a Mysqlx.Expr.Operator.
+func (b *xSQLBuilder) operator(op xMessage) error {
+	name := op.str(1)
+	params, err := op.repeated(2)
+	if err != nil {
+		return err
+	}
+	b.sb.WriteString("(")
+	switch {
+	case xBinaryOperators[name] != "" && len(params) == 2:
+		if err = b.expr(params[0]); err != nil {
+			return err
+		}
+		b.sb.WriteString(" " + xBinaryOperators[name] + " ")
+		if err = b.expr(params[1]); err != nil {
+			return err
+		}
+	case xUnaryOperators[name] != "" && len(params) == 1:
+		b.sb.WriteString(xUnaryOperators[name])
+		if err = b.expr(params[0]); err != nil {
+			return err
+		}
+	case (name == "in" || name == "not_in") && len(params) >= 2:
+		if err = b.expr(params[0]); err != nil {
+			return err
+		}
+		if name == "in" {
+			b.sb.WriteString(" IN (")
+		} else {
+			b.sb.WriteString(" NOT IN (")
+		}
+		if err = b.exprList(params[1:]); err != nil {
+			return err
+		}
+		b.sb.WriteString(")")
+	case (name == "between" || name == "not_between") && len(params) == 3:
+		if err = b.expr(params[0]); err != nil {
+			return err
+		}
+		if name == "between" {
+			b.sb.WriteString(" BETWEEN ")
+		} else {
+			b.sb.WriteString(" NOT BETWEEN ")
+		}
+		if err = b.expr(params[1]); err != nil {
+			return err
+		}
+		b.sb.WriteString(" AND ")
+		if err = b.expr(params[2]); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("X Protocol operator %s with %d params isn't supported", name, len(params))
+	}
+	b.sb.WriteString(")")
+	return nil
+}
+
+// buildXFind builds the SELECT statement of Mysqlx.Crud.Find.
+func buildXFind(msg xMessage) (string, error) {
+	b, err := newXSQLBuilder(msg, 3, 11)
+	if err != nil {
+		return "", err
+	}
+	if msg.has(8) {
+		return "", errors.New("X Protocol grouping isn't supported")
+	}
+	projections, err := msg.repeated(4)
+	if err != nil {
+		return "", err
+	}
+	b.sb.WriteString("SELECT ")
+	switch {
+	case len(projections) == 0 && b.isDoc:
+		b.sb.WriteString("`doc`")
+	case len(projections) == 0:
+		b.sb.WriteString("*")
+	case b.isDoc:
+		// The projected members are returned as a new document.
+		b.sb.WriteString("JSON_OBJECT(")
+		for i, projection := range projections {
+			if i > 0 {
+				b.sb.WriteString(", ")
+			}
+			b.format("%?, ", projection.str(2))
+			source, err := projection.msg(1)
+			if err != nil {
+				return "", err
+			}
+			if err = b.expr(source); err != nil {
+				return "", err
+			}
+		}
+		b.sb.WriteString(") AS `doc`")
+	default:
+		for i, projection := range projections {
+			if i > 0 {
+				b.sb.WriteString(", ")
+			}
+			source, err := projection.msg(1)
+			if err != nil {
+				return "", err
+			}
+			if err = b.expr(source); err != nil {
+				return "", err
+			}
+			if alias := projection.str(2); alias != "" {
+				b.format(" AS %n", alias)
+			}
+		}
+	}
+	b.sb.WriteString(" FROM ")
+	if err = b.collection(msg, 2); err != nil {
+		return "", err
+	}
+	if err = b.criteria(msg, 5); err != nil {
+		return "", err
+	}
+	if err = b.order(msg, 7); err != nil {
+		return "", err
+	}
+	if err = b.limit(msg, 6, true); err != nil {
+		return "", err
+	}
+	return b.sb.String(), nil
+}
+
+// buildXInsert builds the INSERT statement of Mysqlx.Crud.Insert. The documents without `_id` get a generated one.
+func buildXInsert(msg xMessage, serverID uint64) (string, error) {
+	b, err := newXSQLBuilder(msg, 2, 5)
+	if err != nil {
+		return "", err
+	}
+	if msg.uint(6) != 0 {
+		b.sb.WriteString("REPLACE INTO ")
+	} else {
+		b.sb.WriteString("INSERT INTO ")
+	}
+	if err = b.collection(msg, 1); err != nil {
+		return "", err
+	}
+	columns, err := msg.repeated(3)
+	if err != nil {
+		return "", err
+	}
+	if b.isDoc {
+		b.sb.WriteString(" (`doc`)")
+	} else if len(columns) > 0 {
+		b.sb.WriteString(" (")
+		for i, col := range columns {
+			if i > 0 {
+				b.sb.WriteString(", ")
+			}
+			b.format("%n", col.str(1))
+		}
+		b.sb.WriteString(")")
+	}
+	rows, err := msg.repeated(4)
+	if err != nil {
+		return "", err
+	}
+	if len(rows) == 0 {
+		return "", errors.New("X Protocol insert has no rows")
+	}
+	b.sb.WriteString(" VALUES ")
+	for i, row := range rows {
+		if i > 0 {
+			b.sb.WriteString(", ")
+		}
+		fields, err := row.repeated(1)
+		if err != nil {
+			return "", err
+		}
+		b.sb.WriteString("(")
+		if b.isDoc {
+			if len(fields) != 1 {
+				return "", errors.New("X Protocol insert of documents must have one field in a row")
+			}
+			// The `_id` of the document overrides the generated one.
+			b.format("JSON_MERGE_PATCH(JSON_OBJECT('_id', %?), ", genXDocumentID(serverID))
+			if err = b.expr(fields[0]); err != nil {
+				return "", err
+			}
+			b.sb.WriteString(")")
+		} else if err = b.exprList(fields); err != nil {
+			return "", err
+		}
+		b.sb.WriteString(")")
+	}
+	return b.sb.String(), nil
+}
+
+// buildXUpdate builds the UPDATE statement of Mysqlx.Crud.Update. The operations on the documents are applied to
+// the `doc` column in order by the JSON functions.
+func buildXUpdate(msg xMessage) (string, error) {
+	b, err := newXSQLBuilder(msg, 3, 8)
+	if err != nil {
+		return "", err
+	}
+	b.sb.WriteString("UPDATE ")
+	if err = b.collection(msg, 2); err != nil {
+		return "", err
+	}
+	ops, err := msg.repeated(7)
+	if err != nil {
+		return "", err
+	}
+	if len(ops) == 0 {
+		return "", errors.New("X Protocol update has no operations")
+	}
+	b.sb.WriteString(" SET ")
+	if b.isDoc {
+		if err = b.documentUpdate(ops); err != nil {
+			return "", err
+		}
+	} else {
+		for i, op := range ops {
+			if op.uint(2) != xUpdateSet {
+				return "", errors.Errorf("X Protocol update operation %d isn't supported by the table model", op.uint(2))
+			}
+			if i > 0 {
+				b.sb.WriteString(", ")
+			}
+			source, err := op.msg(1)
+			if err != nil {
+				return "", err
+			}
+			b.format("%n = ", source.str(2))
+			value, err := op.msg(3)
+			if err != nil {
+				return "", err
+			}
+			if err = b.expr(value); err != nil {
+				return "", err
+			}
+		}
+	}
+	if err = b.criteria(msg, 4); err != nil {
+		return "", err
+	}
+	if err = b.order(msg, 6); err != nil {
+		return "", err
+	}
+	if err = b.limit(msg, 5, false); err != nil {
+		return "", err
+	}
+	return b.sb.String(), nil
+}
+
+func (b *xSQLBuilder) documentUpdate(ops []xMessage) error {
+	var funcs []string
+	for _, op := range ops {
+		switch op.uint(2) {
+		case xUpdateItemRemove:
+			funcs = append(funcs, "JSON_REMOVE")
+		case xUpdateItemSet:
+			funcs = append(funcs, "JSON_SET")
+		case xUpdateItemReplace:
+			funcs = append(funcs, "JSON_REPLACE")
+		case xUpdateItemMerge:
+			funcs = append(funcs, "JSON_MERGE_PRESERVE")
+		case xUpdateArrayInsert:
+			funcs = append(funcs, "JSON_ARRAY_INSERT")
+		case xUpdateArrayAppend:
+			funcs = append(funcs, "JSON_ARRAY_APPEND")
+		case xUpdateMergePatch:
+			funcs = append(funcs, "JSON_MERGE_PATCH")
+		default:
+			return errors.Errorf("X Protocol update operation %d isn't supported by the document model", op.uint(2))
+		}
+	}
+	b.sb.WriteString("`doc` = ")
+	for i := len(funcs) - 1; i >= 0; i-- {
+		b.sb.WriteString(funcs[i])
+		b.sb.WriteString("(")
+	}
+	b.sb.WriteString("`doc`")
+	for _, op := range ops {
+		tp := op.uint(2)
+		if tp != xUpdateItemMerge && tp != xUpdateMergePatch {
+			source, err := op.msg(1)
+			if err != nil {
+				return err
+			}
+			items, err := source.repeated(1)
+			if err != nil {
+				return err
+			}
+			path, err := buildXDocumentPath(items)
+			if err != nil {
+				return err
+			}
+			if path == "$._id" {
+				return errors.New("the _id of a document can't be changed")
+			}
+			b.format(", %?", path)
+		}
+		if tp != xUpdateItemRemove {
+			value, err := op.msg(3)
+			if err != nil {
+				return err
+			}
+			b.sb.WriteString(", ")
+			if err = b.expr(value); err != nil {
+				return err
+			}
+		}
+		b.sb.WriteString(")")
+	}
+	return nil
+}
+
+// buildXDelete builds the DELETE statement of Mysqlx.Crud.Delete.
+func buildXDelete(msg xMessage) (string, error) {
+	b, err := newXSQLBuilder(msg, 2, 6)
+	if err != nil {
+		return "", err
+	}
+	b.sb.WriteString("DELETE FROM ")
+	if err = b.collection(msg, 1); err != nil {
+		return "", err
+	}
+	if err = b.criteria(msg, 3); err != nil {
+		return "", err
+	}
+	if err = b.order(msg, 5); err != nil {
+		return "", err
+	}
+	if err = b.limit(msg, 4, false); err != nil {
+		return "", err
+	}
+	return b.sb.String(), nil
+}
+
+// buildXStmtExecute builds the statement of Mysqlx.Sql.StmtExecute. The "sql" namespace executes the statement
+// directly, and the "mysqlx" namespace supports the admin commands to manage the collections.
+func buildXStmtExecute(msg xMessage) (string, error) {
+	namespace := "sql"
+	if msg.has(3) {
+		namespace = msg.str(3)
+	}
+	switch namespace {
+	case "sql":
+		if msg.has(2) {
+			return "", errors.New("X Protocol statement args aren't supported")
+		}
+		return msg.str(1), nil
+	case "mysqlx", "xplugin":
+	default:
+		return "", errors.Errorf("unknown X Protocol namespace %s", namespace)
+	}
+	args, err := msg.repeated(2)
+	if err != nil {
+		return "", err
+	}
+	schema, name, err := decodeXCollectionArgs(args)
+	if err != nil {
+		return "", err
+	}
+	switch cmd := msg.str(1); cmd {
+	case "create_collection":
+		return sqlexec.EscapeSQL("CREATE TABLE "+xCollectionDefinition, schema, name)
+	case "ensure_collection":
+		return sqlexec.EscapeSQL("CREATE TABLE IF NOT EXISTS "+xCollectionDefinition, schema, name)
+	case "drop_collection":
+		return sqlexec.EscapeSQL("DROP TABLE %n.%n", schema, name)
+	default:
+		return "", errors.Errorf("X Protocol admin command %s isn't supported", cmd)
+	}
+}
+
+// decodeXCollectionArgs decodes the schema and name of the collection from the args of an admin command, which
+// are either an object of {"schema", "name"} or two strings.
+func decodeXCollectionArgs(args []xMessage) (schema, name string, err error) {
+	if len(args) == 1 && args[0].uint(1) == xAnyObject {
+		obj, err := args[0].msg(3)
+		if err != nil {
+			return "", "", err
+		}
+		fields, err := obj.repeated(1)
+		if err != nil {
+			return "", "", err
+		}
+		for _, field := range fields {
+			v, err := field.msg(2)
+			if err != nil {
+				return "", "", err
+			}
+			switch field.str(1) {
+			case "schema":
+				schema, err = decodeXAnyString(v)
+			case "name":
+				name, err = decodeXAnyString(v)
+			}
+			if err != nil {
+				return "", "", err
+			}
+		}
+	} else if len(args) == 2 {
+		if schema, err = decodeXAnyString(args[0]); err != nil {
+			return "", "", err
+		}
+		if name, err = decodeXAnyString(args[1]); err != nil {
+			return "", "", err
+		}
+	}
+	if schema == "" || name == "" {
+		return "", "", errors.New("X Protocol admin command needs the schema and name of the collection")
+	}
+	return schema, name, nil
+}
+
+// decodeXAnyString decodes a Mysqlx.Datatypes.Any of a string scalar.
+func decodeXAnyString(v xMessage) (string, error) {
+	if v.uint(1) != xAnyScalar {
+		return "", errors.New("X Protocol argument isn't a scalar")
+	}
+	scalar, err := v.msg(2)
+	if err != nil {
+		return "", err
+	}
+	d, err := decodeXScalar(scalar)
+	if err != nil {
+		return "", err
+	}
+	str, ok := d.(string)
+	if !ok {
+		return "", errors.New("X Protocol argument isn't a string")
+	}
+	return str, nil
+}
@@ -0,0 +1,271 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/sha1" // #nosec G505
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/testkit"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func xCollection(schema, name string) []byte {
+	return appendXString(appendXString(nil, 1, name), 2, schema)
+}
+
+func xMemberIdent(member string) []byte {
+	item := appendXUint(nil, 1, 1)
+	item = appendXString(item, 2, member)
+	id := appendXBytes(nil, 1, item)
+	e := appendXUint(nil, 1, xExprIdent)
+	return appendXBytes(e, 2, id)
+}
+
+func xSIntLiteral(v int64) []byte {
+	scalar := appendXUint(nil, 1, xScalarSInt)
+	scalar = appendXUint(scalar, 2, protowire.EncodeZigZag(v))
+	e := appendXUint(nil, 1, xExprLiteral)
+	return appendXBytes(e, 4, scalar)
+}
+
+func xStringLiteral(s string) []byte {
+	scalar := appendXUint(nil, 1, xScalarString)
+	scalar = appendXBytes(scalar, 9, appendXString(nil, 1, s))
+	e := appendXUint(nil, 1, xExprLiteral)
+	return appendXBytes(e, 4, scalar)
+}
+
+func xPlaceholder(pos uint64) []byte {
+	e := appendXUint(nil, 1, xExprPlaceholder)
+	return appendXUint(e, 7, pos)
+}
+
+func xOperator(name string, params ...[]byte) []byte {
+	op := appendXString(nil, 1, name)
+	for _, p := range params {
+		op = appendXBytes(op, 2, p)
+	}
+	e := appendXUint(nil, 1, xExprOperator)
+	return appendXBytes(e, 6, op)
+}
+
+func mustParseXMessage(t *testing.T, b []byte) xMessage {
+	msg, err := parseXMessage(b)
+	require.NoError(t, err)
+	return msg
+}
+
+func TestXProtocolFind(t *testing.T) {
+	find := appendXBytes(nil, 2, xCollection("test", "c"))
+	find = appendXBytes(find, 5, xOperator("&&",
+		xOperator(">", xMemberIdent("age"), xPlaceholder(0)),
+		xOperator("in", xMemberIdent("name"), xStringLiteral("a"), xStringLiteral("b'c"))))
+	scalar := appendXUint(nil, 1, xScalarSInt)
+	scalar = appendXUint(scalar, 2, protowire.EncodeZigZag(18))
+	find = appendXBytes(find, 11, scalar)
+	order := appendXBytes(nil, 1, xMemberIdent("age"))
+	order = appendXUint(order, 2, 2)
+	find = appendXBytes(find, 7, order)
+	limit := appendXUint(nil, 1, 10)
+	limit = appendXUint(limit, 2, 5)
+	find = appendXBytes(find, 6, limit)
+
+	sql, err := buildXFind(mustParseXMessage(t, find))
+	require.NoError(t, err)
+	require.Equal(t, "SELECT `doc` FROM `test`.`c` WHERE ((JSON_EXTRACT(`doc`, '$.age') > 18) AND "+
+		"(JSON_EXTRACT(`doc`, '$.name') IN ('a', 'b\\'c'))) ORDER BY JSON_EXTRACT(`doc`, '$.age') DESC LIMIT 5, 10", sql)
+
+	// The placeholder out of the args is an error.
+	find = appendXBytes(nil, 2, xCollection("test", "c"))
+	find = appendXBytes(find, 5, xOperator("==", xMemberIdent("a"), xPlaceholder(1)))
+	_, err = buildXFind(mustParseXMessage(t, find))
+	require.Error(t, err)
+}
+
+func TestXProtocolModify(t *testing.T) {
+	doc := appendXUint(nil, 1, xScalarOctets)
+	doc = appendXBytes(doc, 5, appendXBytes(nil, 1, []byte(`{"a": 1}`)))
+	docExpr := appendXBytes(appendXUint(nil, 1, xExprLiteral), 4, doc)
+	insert := appendXBytes(nil, 1, xCollection("test", "c"))
+	insert = appendXBytes(insert, 4, appendXBytes(nil, 1, docExpr))
+	sql, err := buildXInsert(mustParseXMessage(t, insert), 1)
+	require.NoError(t, err)
+	require.Regexp(t, "^INSERT INTO `test`.`c` \\(`doc`\\) VALUES \\(JSON_MERGE_PATCH\\(JSON_OBJECT\\('_id', '00000001[0-9a-f]{24}'\\), "+
+		"'\\{\\\\\"a\\\\\": 1\\}'\\)\\)$", sql)
+
+	source := appendXBytes(nil, 1, appendXString(appendXUint(nil, 1, 1), 2, "a"))
+	set := appendXBytes(nil, 1, source)
+	set = appendXUint(set, 2, xUpdateItemSet)
+	set = appendXBytes(set, 3, xSIntLiteral(2))
+	remove := appendXBytes(nil, 1, appendXBytes(nil, 1, appendXString(appendXUint(nil, 1, 1), 2, "b c")))
+	remove = appendXUint(remove, 2, xUpdateItemRemove)
+	update := appendXBytes(nil, 2, xCollection("test", "c"))
+	update = appendXBytes(update, 7, set)
+	update = appendXBytes(update, 7, remove)
+	update = appendXBytes(update, 4, xOperator("==", xMemberIdent("_id"), xStringLiteral("1")))
+	sql, err = buildXUpdate(mustParseXMessage(t, update))
+	require.NoError(t, err)
+	require.Equal(t, "UPDATE `test`.`c` SET `doc` = JSON_REMOVE(JSON_SET(`doc`, '$.a', 2), '$.\\\"b c\\\"') "+
+		"WHERE (JSON_EXTRACT(`doc`, '$._id') = '1')", sql)
+
+	del := appendXBytes(nil, 1, xCollection("test", "c"))
+	del = appendXBytes(del, 4, appendXUint(nil, 1, 1))
+	sql, err = buildXDelete(mustParseXMessage(t, del))
+	require.NoError(t, err)
+	require.Equal(t, "DELETE FROM `test`.`c` LIMIT 1", sql)
+}
+
+func TestXProtocolStmtExecute(t *testing.T) {
+	stmt := appendXBytes(nil, 1, []byte("select 1"))
+	sql, err := buildXStmtExecute(mustParseXMessage(t, stmt))
+	require.NoError(t, err)
+	require.Equal(t, "select 1", sql)
+
+	field := func(key, value string) []byte {
+		return appendXBytes(appendXString(nil, 1, key), 2, encodeXStringScalarAny(value))
+	}
+	obj := appendXBytes(nil, 1, field("schema", "test"))
+	obj = appendXBytes(obj, 1, field("name", "c"))
+	args := appendXBytes(appendXUint(nil, 1, xAnyObject), 3, obj)
+	stmt = appendXBytes(nil, 1, []byte("drop_collection"))
+	stmt = appendXString(stmt, 3, "mysqlx")
+	stmt = appendXBytes(stmt, 2, args)
+	sql, err = buildXStmtExecute(mustParseXMessage(t, stmt))
+	require.NoError(t, err)
+	require.Equal(t, "DROP TABLE `test`.`c`", sql)
+
+	stmt = appendXBytes(nil, 1, []byte("ensure_collection"))
+	stmt = appendXString(stmt, 3, "mysqlx")
+	stmt = appendXBytes(stmt, 2, encodeXStringScalarAny("test"))
+	stmt = appendXBytes(stmt, 2, encodeXStringScalarAny("c"))
+	sql, err = buildXStmtExecute(mustParseXMessage(t, stmt))
+	require.NoError(t, err)
+	require.Contains(t, sql, "CREATE TABLE IF NOT EXISTS `test`.`c`")
+}
+
+func writeXMessage(t *testing.T, conn net.Conn, tp byte, payload []byte) {
+	buf := binary.LittleEndian.AppendUint32(nil, uint32(len(payload)+1))
+	buf = append(buf, tp)
+	_, err := conn.Write(append(buf, payload...))
+	require.NoError(t, err)
+}
+
+func readXMessage(conn net.Conn) (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(conn, header[:]); err != nil {
+		return 0, nil, err
+	}
+	payload := make([]byte, binary.LittleEndian.Uint32(header[:4])-1)
+	_, err := io.ReadFull(conn, payload)
+	return header[4], payload, err
+}
+
+// xMySQL41AuthData builds the auth data of MYSQL41 as the clients do.
+func xMySQL41AuthData(salt []byte, schema, user, password string) []byte {
+	data := []byte(schema + "\x00" + user + "\x00")
+	if password == "" {
+		return data
+	}
+	stage1 := auth.Sha1Hash([]byte(password))
+	// #nosec G401
+	crypt := sha1.New()
+	crypt.Write(salt)
+	crypt.Write(auth.Sha1Hash(stage1))
+	scramble := crypt.Sum(nil)
+	for i := range scramble {
+		scramble[i] ^= stage1[i]
+	}
+	return append(append(data, '*'), hex.EncodeToString(scramble)...)
+}
+
+func TestXProtocolConn(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create user 'xuser'@'%' identified by 'xpass'")
+	tk.MustExec("grant select on test.* to 'xuser'@'%'")
+
+	cfg := newTestConfig()
+	cfg.Port, cfg.Status.StatusPort = 0, 0
+	cfg.Status.ReportStatus = false
+	srv, err := NewServer(cfg, NewTiDBDriver(store))
+	require.NoError(t, err)
+	defer srv.Close()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, listener.Close())
+	}()
+	go srv.startXProtocolListener(listener)
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+
+	// PLAIN isn't allowed without TLS.
+	writeXMessage(t, conn, xClientSessAuthenticateStart, appendXString(nil, 1, "PLAIN"))
+	tp, _, err := readXMessage(conn)
+	require.NoError(t, err)
+	require.Equal(t, byte(xServerError), tp)
+
+	authenticate := func(password string) byte {
+		writeXMessage(t, conn, xClientSessAuthenticateStart, appendXString(nil, 1, xAuthMechMySQL41))
+		tp, payload, err := readXMessage(conn)
+		require.NoError(t, err)
+		require.Equal(t, byte(xServerSessAuthenticateContinue), tp)
+		salt := mustParseXMessage(t, payload).bytes(1)
+		require.Len(t, salt, 20)
+		writeXMessage(t, conn, xClientSessAuthenticateContinue, appendXBytes(nil, 1, xMySQL41AuthData(salt, "test", "xuser", password)))
+		tp, _, err = readXMessage(conn)
+		require.NoError(t, err)
+		return tp
+	}
+	require.Equal(t, byte(xServerError), authenticate("wrong"))
+	require.Equal(t, 0, srv.ConnectionCount())
+	require.Equal(t, byte(xServerSessAuthenticateOk), authenticate("xpass"))
+
+	// The authenticated connection is listed in the processlist.
+	require.Equal(t, 1, srv.ConnectionCount())
+	var connID uint64
+	for id, pi := range srv.ShowProcessList() {
+		connID = id
+		require.Equal(t, "xuser", pi.User)
+		require.Equal(t, "test", pi.DB)
+	}
+
+	writeXMessage(t, conn, xClientSQLStmtExecute, appendXBytes(nil, 1, []byte("select 1")))
+	var tps []byte
+	for len(tps) == 0 || tps[len(tps)-1] != xServerSQLStmtExecuteOk {
+		tp, _, err := readXMessage(conn)
+		require.NoError(t, err)
+		tps = append(tps, tp)
+	}
+	require.Equal(t, []byte{xServerResultsetColumnMetaData, xServerResultsetRow, xServerResultsetFetchDone, xServerNotice, xServerSQLStmtExecuteOk}, tps)
+
+	// The connection can be killed.
+	srv.Kill(connID, false)
+	_, _, err = readXMessage(conn)
+	require.Error(t, err)
+	require.Eventually(t, func() bool { return srv.ConnectionCount() == 0 }, time.Second, 10*time.Millisecond)
+}
@@ -583,6 +583,62 @@ const (
        PRIMARY KEY (job_id),
        KEY (create_time),
        KEY (create_user));`
+
+	// CreateMaintenanceWindows is a table about the maintenance windows of DDL jobs.
+	CreateMaintenanceWindows = `CREATE TABLE IF NOT EXISTS mysql.tidb_maintenance_windows (
+		name varchar(64) NOT NULL,
+		schedule varchar(64) NOT NULL,
+		max_reorg_rate bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		PRIMARY KEY (name));`
+
+	// CreateDDLNotifier is the outbox table of the events of the finished DDL jobs.
+	CreateDDLNotifier = `CREATE TABLE IF NOT EXISTS mysql.ddl_notifier (
+		job_id bigint(64) NOT NULL,
+		event_id bigint(64) UNSIGNED DEFAULT NULL,
+		job_type varchar(64) NOT NULL,
+		schema_name varchar(64) NOT NULL DEFAULT '',
+		table_name varchar(64) NOT NULL DEFAULT '',
+		query text NOT NULL,
+		before_tables json DEFAULT NULL,
+		after_tables json DEFAULT NULL,
+		finish_time timestamp NULL DEFAULT NULL,
+		ack_time timestamp NULL DEFAULT NULL,
+		PRIMARY KEY (job_id),
+		UNIQUE KEY (event_id),
+		KEY (finish_time));`
+
+	// CreatePlanRegressions is the audit table of the plan regressions which the bindings are captured for,
+	// the latencies are in nanoseconds.
+	CreatePlanRegressions = `CREATE TABLE IF NOT EXISTS mysql.plan_regressions (
+		id bigint(64) NOT NULL AUTO_INCREMENT,
+		sql_digest varchar(64) NOT NULL,
+		original_sql text NOT NULL,
+		default_db varchar(64) NOT NULL DEFAULT '',
+		regressed_plan_digest varchar(64) NOT NULL,
+		regressed_avg_latency bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		regressed_avg_est_rows double NOT NULL DEFAULT 0,
+		regressed_avg_act_rows double NOT NULL DEFAULT 0,
+		baseline_plan_digest varchar(64) NOT NULL,
+		baseline_avg_latency bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		baseline_avg_est_rows double NOT NULL DEFAULT 0,
+		baseline_avg_act_rows double NOT NULL DEFAULT 0,
+		bind_sql text NOT NULL,
+		capture_time timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (id),
+		KEY (sql_digest),
+		KEY (capture_time));`
+
+	// CreateQueryRewriteRules stores the query rewrite rules. The statements whose digest is the same as the pattern
+	// are rewritten to the replacement, the rules are reloaded by ADMIN RELOAD QUERY_REWRITE_RULES.
+	CreateQueryRewriteRules = `CREATE TABLE IF NOT EXISTS mysql.query_rewrite_rules (
+		id bigint(64) NOT NULL AUTO_INCREMENT,
+		pattern text NOT NULL,
+		pattern_database varchar(64) DEFAULT NULL,
+		replacement text NOT NULL,
+		enabled enum('YES', 'NO') NOT NULL DEFAULT 'YES',
+		comment varchar(1024) NOT NULL DEFAULT '',
+		create_time timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (id));`
 )
 
 // bootstrap initiates system DB for a store.
@@ -850,11 +906,19 @@ const (
 	version138 = 138
 	// version 139 creates mysql.load_data_jobs table for LOAD DATA statement
 	version139 = 139
+	// version 140 creates mysql.tidb_maintenance_windows table for the maintenance windows of DDL jobs
+	version140 = 140
+	// version 141 creates mysql.ddl_notifier table for the events of the finished DDL jobs
+	version141 = 141
+	// version 142 creates mysql.plan_regressions table for the bindings captured on plan regressions
+	version142 = 142
+	// version 143 creates mysql.query_rewrite_rules table for the query rewrite rules
+	version143 = 143
 )
 
 // currentBootstrapVersion is defined as a variable, so we can modify its value for testing.
 // please make sure this is the largest version
-var currentBootstrapVersion int64 = version139
+var currentBootstrapVersion int64 = version143
 
 // DDL owner key's expired time is ManagerSessionTTL seconds, we should wait the time and give more time to have a chance to finish it.
 var internalSQLTimeout = owner.ManagerSessionTTL + 15
@@ -982,6 +1046,10 @@ var (
 		upgradeToVer137,
 		upgradeToVer138,
 		upgradeToVer139,
+		upgradeToVer140,
+		upgradeToVer141,
+		upgradeToVer142,
+		upgradeToVer143,
 	}
 )
 
@@ -2426,6 +2494,34 @@ func upgradeToVer139(s Session, ver int64) {
 	mustExecute(s, CreateLoadDataJobs)
 }
 
+func upgradeToVer140(s Session, ver int64) {
+	if ver >= version140 {
+		return
+	}
+	mustExecute(s, CreateMaintenanceWindows)
+}
+
+func upgradeToVer141(s Session, ver int64) {
+	if ver >= version141 {
+		return
+	}
+	mustExecute(s, CreateDDLNotifier)
+}
+
+func upgradeToVer142(s Session, ver int64) {
+	if ver >= version142 {
+		return
+	}
+	mustExecute(s, CreatePlanRegressions)
+}
+
+func upgradeToVer143(s Session, ver int64) {
+	if ver >= version143 {
+		return
+	}
+	mustExecute(s, CreateQueryRewriteRules)
+}
+
 func writeOOMAction(s Session) {
 	comment := "oom-action is `log` by default in v3.0.x, `cancel` by default in v4.0.11+"
 	mustExecute(s, `INSERT HIGH_PRIORITY INTO %n.%n VALUES (%?, %?, %?) ON DUPLICATE KEY UPDATE VARIABLE_VALUE= %?`,
@@ -2540,6 +2636,14 @@ func doDDLWorks(s Session) {
 	mustExecute(s, CreateGlobalTask)
 	// Create load_data_jobs
 	mustExecute(s, CreateLoadDataJobs)
+	// Create tidb_maintenance_windows
+	mustExecute(s, CreateMaintenanceWindows)
+	// Create ddl_notifier
+	mustExecute(s, CreateDDLNotifier)
+	// Create plan_regressions
+	mustExecute(s, CreatePlanRegressions)
+	// Create query_rewrite_rules
+	mustExecute(s, CreateQueryRewriteRules)
 }
 
 // doBootstrapSQLFile executes SQL commands in a file as the last stage of bootstrap.
@@ -44,6 +44,10 @@ var (
 	TransactionRetryInternal                        prometheus.Observer
 	TransactionRetryGeneral                         prometheus.Observer
 
+	StatementRetryCount         prometheus.Counter
+	StatementRetryOKCount       prometheus.Counter
+	StatementRetryReachMaxCount prometheus.Counter
+
 	SessionExecuteCompileDurationInternal prometheus.Observer
 	SessionExecuteCompileDurationGeneral  prometheus.Observer
 	SessionExecuteParseDurationInternal   prometheus.Observer
@@ -109,6 +113,10 @@ func InitMetricsVars() {
 	TransactionRetryInternal = metrics.SessionRetry.WithLabelValues(metrics.LblInternal)
 	TransactionRetryGeneral = metrics.SessionRetry.WithLabelValues(metrics.LblGeneral)
 
+	StatementRetryCount = metrics.StatementRetryCounter.WithLabelValues("retry")
+	StatementRetryOKCount = metrics.StatementRetryCounter.WithLabelValues(metrics.LblOK)
+	StatementRetryReachMaxCount = metrics.StatementRetryCounter.WithLabelValues(metrics.LblReachMax)
+
 	SessionExecuteCompileDurationInternal = metrics.SessionExecuteCompileDuration.WithLabelValues(metrics.LblInternal)
 	SessionExecuteCompileDurationGeneral = metrics.SessionExecuteCompileDuration.WithLabelValues(metrics.LblGeneral)
 	SessionExecuteParseDurationInternal = metrics.SessionExecuteParseDuration.WithLabelValues(metrics.LblInternal)
@@ -20,7 +20,9 @@ import (
 	"sync/atomic"
 	"testing"
 
+	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/errno"
 	"github.com/pingcap/tidb/metrics"
 	"github.com/pingcap/tidb/session"
 	"github.com/pingcap/tidb/tablecodec"
@@ -138,3 +140,54 @@ func TestRecordTTLRows(t *testing.T) {
 	tk.MustExec("commit")
 	require.Equal(t, 7.0, MustReadCounter(t, metrics.TTLInsertRowsCount))
 }
+
+func TestAutoStmtRetry(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int primary key, b int)")
+	tk.MustQuery("select @@tidb_auto_stmt_retry_limit, @@tidb_last_stmt_retry_info").Check(testkit.Rows("0 "))
+
+	fpName := "github.com/pingcap/tidb/session/mockCommitServerBusy"
+	defer func() {
+		require.NoError(t, failpoint.Disable(fpName))
+	}()
+	retryOK := metrics.StatementRetryCounter.WithLabelValues(metrics.LblOK)
+	retryReachMax := metrics.StatementRetryCounter.WithLabelValues(metrics.LblReachMax)
+
+	// The statements aren't retried by default.
+	require.NoError(t, failpoint.Enable(fpName, "1*return(true)"))
+	tk.MustGetErrCode("insert into t values (1, 1)", errno.ErrTiKVServerBusy)
+	tk.MustQuery("select * from t").Check(testkit.Rows())
+
+	// The autocommit statement is retried until it succeeds.
+	tk.MustExec("set @@tidb_auto_stmt_retry_limit = 3")
+	okCnt := MustReadCounter(t, retryOK)
+	require.NoError(t, failpoint.Enable(fpName, "2*return(true)"))
+	tk.MustExec("insert into t values (1, 1)")
+	require.Len(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings(), 1)
+	require.Contains(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings()[0].Err.Error(), "succeeded after 2 automatic retries")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1"))
+	tk.MustQuery("select json_extract(@@tidb_last_stmt_retry_info, '$.retry_count', '$.succeeded')").Check(testkit.Rows("[2, true]"))
+	require.Equal(t, okCnt+1, MustReadCounter(t, retryOK))
+
+	require.NoError(t, failpoint.Enable(fpName, "1*return(true)"))
+	tk.MustExec("update t set b = b + 1 where a = 1")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 2"))
+
+	// The retry is bounded.
+	reachMaxCnt := MustReadCounter(t, retryReachMax)
+	require.NoError(t, failpoint.Enable(fpName, "return(true)"))
+	tk.MustGetErrCode("delete from t where a = 1", errno.ErrTiKVServerBusy)
+	require.NoError(t, failpoint.Disable(fpName))
+	tk.MustQuery("select json_extract(@@tidb_last_stmt_retry_info, '$.retry_count', '$.succeeded')").Check(testkit.Rows("[3, false]"))
+	require.Equal(t, reachMaxCnt+1, MustReadCounter(t, retryReachMax))
+
+	// The statements in explicit transactions aren't retried.
+	require.NoError(t, failpoint.Enable(fpName, "1*return(true)"))
+	tk.MustExec("begin")
+	tk.MustExec("delete from t where a = 1")
+	tk.MustGetErrCode("commit", errno.ErrTiKVServerBusy)
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 2"))
+	require.NoError(t, failpoint.Disable(fpName))
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/terror"
+	plannercore "github.com/pingcap/tidb/planner/core"
+	session_metrics "github.com/pingcap/tidb/session/metrics"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	storeerr "github.com/pingcap/tidb/store/driver/error"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+const maxStmtRetryBackoff = time.Second
+
+// autoRetryableStmtErrs are the errors of the region and lock issues, which are likely to disappear if the
+// statement is executed again.
+var autoRetryableStmtErrs = []*terror.Error{
+	storeerr.ErrRegionUnavailable,
+	storeerr.ErrTiKVServerBusy,
+	storeerr.ErrTiKVServerTimeout,
+	storeerr.ErrTiKVStaleCommand,
+	storeerr.ErrPDServerTimeout,
+	storeerr.ErrResolveLockTimeout,
+	storeerr.ErrLockWaitTimeout,
+	kv.ErrWriteConflict,
+	kv.ErrTxnRetryable,
+}
+
+func isAutoRetryableStmtErr(err error) bool {
+	for _, retryableErr := range autoRetryableStmtErrs {
+		if retryableErr.Equal(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAutoRetryableStmt checks whether the statement can be retried automatically if it fails. Only the INSERT, UPDATE
+// and DELETE statements in autocommit mode are retried, since their transactions are rolled back on failure, and no
+// results have been returned to the client.
+func (s *session) isAutoRetryableStmt(stmtNode ast.StmtNode) bool {
+	sessVars := s.sessionVars
+	if sessVars.AutoStmtRetryLimit <= 0 || s.isInternal() {
+		return false
+	}
+	// The batched DMLs commit the rows in several transactions, they can't be retried as a whole.
+	if sessVars.InTxn() || !sessVars.IsAutocommit() || sessVars.BatchInsert || sessVars.BatchDelete || sessVars.BatchCommit {
+		return false
+	}
+	if execStmt, ok := stmtNode.(*ast.ExecuteStmt); ok {
+		prepareStmt, err := plannercore.GetPreparedStmt(execStmt, sessVars)
+		if err != nil {
+			return false
+		}
+		stmtNode = prepareStmt.PreparedAst.Stmt
+	}
+	switch stmtNode.(type) {
+	case *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		return true
+	}
+	return false
+}
+
+// executeStmtWithRetry executes the autocommit statement, and executes it again in a new transaction if it fails
+// with a retryable error, until it succeeds or tidb_auto_stmt_retry_limit is reached.
+func (s *session) executeStmtWithRetry(ctx context.Context, stmtNode ast.StmtNode) (sqlexec.RecordSet, error) {
+	sessVars := s.sessionVars
+	retryLimit := int(sessVars.AutoStmtRetryLimit)
+	var lastErr error
+	for retryCnt := 0; ; retryCnt++ {
+		rs, err := s.executeStmt(ctx, stmtNode)
+		if err == nil {
+			if retryCnt > 0 {
+				session_metrics.StatementRetryOKCount.Inc()
+				s.recordStmtRetry(retryCnt, lastErr, true)
+				sessVars.StmtCtx.AppendNote(errors.Errorf("The statement succeeded after %d automatic retries, the last error: %s", retryCnt, lastErr.Error()))
+			}
+			return rs, nil
+		}
+		// The statement can't be retried if the results are returned or the transaction is not rolled back.
+		if rs != nil || sessVars.InTxn() || !isAutoRetryableStmtErr(err) {
+			if retryCnt > 0 {
+				s.recordStmtRetry(retryCnt, err, false)
+			}
+			return rs, err
+		}
+		if retryCnt >= retryLimit {
+			session_metrics.StatementRetryReachMaxCount.Inc()
+			s.recordStmtRetry(retryCnt, err, false)
+			return rs, err
+		}
+
+		lastErr = err
+		session_metrics.StatementRetryCount.Inc()
+		logutil.Logger(ctx).Info("retry the autocommit statement",
+			zap.Int("retryCnt", retryCnt+1),
+			zap.Int("retryLimit", retryLimit),
+			zap.String("session", s.String()),
+			zap.Error(err))
+		if !s.waitStmtRetryBackoff(ctx, retryCnt) {
+			return nil, err
+		}
+	}
+}
+
+// waitStmtRetryBackoff waits before the next retry. It returns false if the statement is canceled or killed.
+func (s *session) waitStmtRetryBackoff(ctx context.Context, retryCnt int) bool {
+	backoff := maxStmtRetryBackoff
+	if retryCnt < 7 {
+		backoff = mathutil.Min(10*time.Millisecond<<retryCnt, maxStmtRetryBackoff)
+	}
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+	}
+	return atomic.LoadUint32(&s.sessionVars.Killed) != 1
+}
+
+func (s *session) recordStmtRetry(retryCnt int, err error, succeeded bool) {
+	_, digest := s.sessionVars.StmtCtx.SQLDigest()
+	info := variable.StmtRetryInfo{
+		RetryCount: retryCnt,
+		Succeeded:  succeeded,
+	}
+	if digest != nil {
+		info.SQLDigest = digest.String()
+	}
+	if err != nil {
+		info.LastError = err.Error()
+	}
+	s.sessionVars.LastStmtRetryInfo = info
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/binloginfo"
 	"github.com/pingcap/tidb/sessiontxn"
+	storeerr "github.com/pingcap/tidb/store/driver/error"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/sli"
@@ -407,6 +408,15 @@ func (txn *LazyTxn) Commit(ctx context.Context) error {
 		}
 	})
 
+	// mockCommitServerBusy is used to mock a retryable error in committing the user transactions.
+	if internal := txn.GetOption(kv.RequestSourceInternal); internal == nil || !internal.(bool) {
+		failpoint.Inject("mockCommitServerBusy", func(val failpoint.Value) {
+			if val.(bool) {
+				failpoint.Return(storeerr.ErrTiKVServerBusy)
+			}
+		})
+	}
+
 	// mockCommitRetryForAutoIncID is used to mock an commit retry for adjustAutoIncrementDatum.
 	failpoint.Inject("mockCommitRetryForAutoIncID", func(val failpoint.Value) {
 		if val.(bool) && !mockAutoIncIDRetry() {
@@ -264,6 +264,9 @@ func ValidateStaleReadTS(ctx context.Context, sctx Context, readTS uint64) error
 // SysProcTracker is used to track background sys processes
 type SysProcTracker interface {
 	Track(id uint64, proc Context) error
+	// TrackInternal tracks the internal session of a background worker with an ID allocated from the reserved IDs,
+	// the operation, the job ID and the info are shown in the processlist if the session isn't executing statements.
+	TrackInternal(proc Context, operation, jobID, info string) (uint64, error)
 	UnTrack(id uint64)
 	GetSysProcessList() map[uint64]*util.ProcessInfo
 	KillSysProcess(id uint64)
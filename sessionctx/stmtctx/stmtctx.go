@@ -179,6 +179,11 @@ type StatementContext struct {
 	SkipASCIICheck                bool
 	SkipUTF8MB4Check              bool
 	MultiSchemaInfo               *model.MultiSchemaInfo
+	// MaintenanceWindow is the maintenance window the DDL jobs of the statement run in.
+	MaintenanceWindow *model.MaintenanceWindow
+	// HypoIndexes are the hypothetical indexes by the table IDs in `EXPLAIN ... WITH HYPOTHETICAL INDEX`, which
+	// are considered by the optimizer as if they existed.
+	HypoIndexes map[int64][]*model.IndexInfo
 	// If the select statement was like 'select * from t as of timestamp ...' or in a stale read transaction
 	// or is affected by the tidb_read_staleness session variable, then the statement will be makred as isStaleness
 	// in stmtCtx
@@ -331,6 +336,9 @@ type StatementContext struct {
 
 	// WeakConsistency is true when read consistency is weak and in a read statement and not in a transaction.
 	WeakConsistency bool
+	// SnapshotRefreshInterval is the interval to refresh the snapshot of the coprocessor requests. It's only set for
+	// the autocommit read-only statements which don't read a historical snapshot.
+	SnapshotRefreshInterval time.Duration
 
 	StatsLoad struct {
 		// Timeout to wait for sync-load
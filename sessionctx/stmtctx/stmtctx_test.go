@@ -149,6 +149,76 @@ func TestWeakConsistencyRead(t *testing.T) {
 	tk.MustExec("rollback")
 }
 
+func TestSnapshotRefreshRead(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(id int primary key, c int, index i(c))")
+	tk.MustExec("split table t between (0) and (100) regions 4")
+	tk.MustExec("insert into t values(1, 1), (30, 30), (60, 60), (90, 90)")
+
+	execAndCheck := func(sql string, rows [][]interface{}, interval time.Duration) {
+		ctx := context.WithValue(context.Background(), "CheckSelectRequestHook", func(req *kv.Request) {
+			require.Equal(t, interval, req.SnapshotRefreshInterval)
+		})
+		rss, err := tk.Session().Execute(ctx, sql)
+		require.Nil(t, err)
+		for _, rs := range rss {
+			rs.Close()
+		}
+		if rows != nil {
+			tk.MustQuery(sql).Check(rows)
+		}
+		require.Equal(t, interval, tk.Session().GetSessionVars().StmtCtx.SnapshotRefreshInterval)
+	}
+
+	execAndCheck("select * from t where id > 50 order by id", testkit.Rows("60 60", "90 90"), 0)
+	tk.MustExec("set tidb_snapshot_refresh_interval = '1ns'")
+	// The snapshot of each cop task is refreshed.
+	execAndCheck("select * from t where id > 50 order by id", testkit.Rows("60 60", "90 90"), time.Nanosecond)
+	execAndCheck("select c from t use index(i) where c < 50 union all select c from t where id > 80", nil, time.Nanosecond)
+	tk.MustQuery("select c from t use index(i) where c < 50 union all select c from t where id > 80").Sort().Check(testkit.Rows("1", "30", "90"))
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("4"))
+	// non-read-only queries should read a consistent snapshot
+	execAndCheck("select * from t where id > 50 for update", nil, 0)
+	execAndCheck("update t set c = c + 1 where id > 50", nil, 0)
+	// in-transaction queries should read a consistent snapshot
+	tk.MustExec("begin")
+	execAndCheck("select * from t where id > 50 order by id", testkit.Rows("60 61", "90 91"), 0)
+	tk.MustExec("rollback")
+
+	// The index and the rows of an index lookup are read at the same snapshot, otherwise the rows of the handles read
+	// from a refreshed snapshot may be missing.
+	tk.MustExec("create table t2(id int primary key, c int, d int, index i(c))")
+	tk.MustExec("split table t2 between (0) and (1000) regions 4")
+	var reqCnt atomic.Int32
+	ctx := context.WithValue(context.Background(), "CheckSelectRequestHook", func(req *kv.Request) {
+		reqCnt.Inc()
+		require.Zero(t, req.SnapshotRefreshInterval)
+	})
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec("use test")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			tk2.MustExec("insert into t2 values(?, ?, ?)", i, i, i)
+		}
+	}()
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		tk.MustQueryWithContext(ctx, "select /*+ use_index(t2, i) */ count(d) from t2 where c >= 0")
+	}
+	require.Greater(t, reqCnt.Load(), int32(0))
+	tk.MustQuery("select /*+ use_index(t2, i) */ count(d) from t2 where c >= 0").Check(testkit.Rows("1000"))
+	tk.MustExec("admin check table t2")
+}
+
 func TestMarshalSQLWarn(t *testing.T) {
 	warns := []stmtctx.SQLWarn{
 		{
@@ -637,7 +637,9 @@ type SessionVars struct {
 	DMLBatchSize        int
 	RetryLimit          int64
 	DisableTxnAutoRetry bool
-	userVars            struct {
+	// AutoStmtRetryLimit is the maximum number of automatic retries of an autocommit statement.
+	AutoStmtRetryLimit int64
+	userVars           struct {
 		// lock is for user defined variables. values and types is read/write protected.
 		lock sync.RWMutex
 		// values stores the Datum for user variables
@@ -667,6 +669,9 @@ type SessionVars struct {
 
 	// ActiveRoles stores active roles for current user
 	ActiveRoles []*auth.RoleIdentity
+	// ActiveRolesSpec is the SET ROLE statement which specifies the active roles, nil means the default roles.
+	// The active roles are evaluated from it again when they are refreshed.
+	ActiveRolesSpec *ast.SetRoleStmt
 
 	RetryInfo *RetryInfo
 	//  TxnCtx Should be reset on transaction finished.
@@ -770,6 +775,14 @@ type SessionVars struct {
 	// MPPOuterJoinFixedBuildSide means in MPP plan, always use right(left) table as build side for left(right) out join
 	MPPOuterJoinFixedBuildSide bool
 
+	// MPPJoinSkewRatio is the threshold to regard the join keys as skewed in MPP mode. The rows of a skewed
+	// join key are all sent to one TiFlash node by the shuffle join, so the broadcast join is preferred.
+	MPPJoinSkewRatio float64
+
+	// MPPJoinSkewSaltNum is the number of salts to spread the rows of the skewed join keys in the shuffle join when
+	// the broadcast join isn't preferred. The build side is replicated once for each salt.
+	MPPJoinSkewSaltNum int
+
 	// AllowDistinctAggPushDown can be set true to allow agg with distinct push down to tikv/tiflash.
 	AllowDistinctAggPushDown bool
 
@@ -945,6 +958,13 @@ type SessionVars struct {
 	// EnableCascadesPlanner enables the cascades planner.
 	EnableCascadesPlanner bool
 
+	// CascadesPlannerQueryShapes are the query shapes optimized by the cascades planner even if it's not enabled.
+	CascadesPlannerQueryShapes []string
+
+	// CascadesPlannerTimeout is the max time of the exploration of the cascades planner for the query shapes in
+	// CascadesPlannerQueryShapes.
+	CascadesPlannerTimeout time.Duration
+
 	// EnableWindowFunction enables the window function.
 	EnableWindowFunction bool
 
@@ -963,6 +983,12 @@ type SessionVars struct {
 	// DDLReorgPriority is the operation priority of adding indices.
 	DDLReorgPriority int
 
+	// DDLJobLabels is the labels attached to the DDL jobs submitted by the session.
+	DDLJobLabels string
+
+	// AlterDatabaseCharsetCascade indicates whether and how `ALTER DATABASE ... CHARACTER SET` converts the tables.
+	AlterDatabaseCharsetCascade string
+
 	// EnableAutoIncrementInGenerated is used to control whether to allow auto incremented columns in generated columns.
 	EnableAutoIncrementInGenerated bool
 
@@ -1137,6 +1163,21 @@ type SessionVars struct {
 	// EnableParallelApply indicates that thether to use parallel apply.
 	EnableParallelApply bool
 
+	// EnablePartitionWiseJoin indicates whether to join the corresponding partitions of the hash join children
+	// independently and in parallel.
+	EnablePartitionWiseJoin bool
+
+	// EnableQueryRewrite indicates whether to rewrite the statements by the query rewrite rules.
+	EnableQueryRewrite bool
+
+	// EnableRuntimeFilter indicates whether to push the filter generated from the build side keys of the hash join
+	// to the table scan of the probe side.
+	EnableRuntimeFilter bool
+
+	// EnableAdaptiveJoin indicates whether the hash join switches to the index join during the execution when its
+	// build side has far more rows than estimated.
+	EnableAdaptiveJoin bool
+
 	// EnableRedactLog indicates that whether redact log.
 	EnableRedactLog bool
 
@@ -1149,6 +1190,9 @@ type SessionVars struct {
 	// LastQueryInfo keeps track the info of last query.
 	LastQueryInfo sessionstates.QueryInfo
 
+	// LastStmtRetryInfo keeps track the info of last automatically retried statement.
+	LastStmtRetryInfo StmtRetryInfo
+
 	// LastDDLInfo keeps track the info of last DDL.
 	LastDDLInfo sessionstates.LastDDLInfo
 
@@ -1246,6 +1290,9 @@ type SessionVars struct {
 	EnableNewCostInterface bool
 	// CostModelVersion is a internal switch to indicates the Cost Model Version.
 	CostModelVersion int
+	// CostModel is the name of the alternative cost model registered in the planner, the built-in cost model of
+	// CostModelVersion is used if it's empty.
+	CostModel string
 	// IndexJoinDoubleReadPenaltyCostRate indicates whether to add some penalty cost to IndexJoin and how much of it.
 	IndexJoinDoubleReadPenaltyCostRate float64
 
@@ -1280,6 +1327,10 @@ type SessionVars struct {
 	// When it is true, ANALYZE reads data on the snapshot at the beginning of ANALYZE.
 	EnableAnalyzeSnapshot bool
 
+	// AnalyzeSkewAwareBuckets indicates whether ANALYZE chooses the bucket count and the TopN size of each column by
+	// the skew of its samples, instead of using the same ones for all the columns.
+	AnalyzeSkewAwareBuckets bool
+
 	// DefaultStrMatchSelectivity adjust the estimation strategy for string matching expressions that can't be estimated by building into range.
 	// when > 0: it's the selectivity for the expression.
 	// when = 0: try to use TopN to evaluate the like expression to estimate the selectivity.
@@ -1341,6 +1392,24 @@ type SessionVars struct {
 	// EnableExternalTSRead indicates whether to enable read through external ts
 	EnableExternalTSRead bool
 
+	// EnableDDLDependencyCheck indicates whether to check the objects which depend on the dropped or renamed column.
+	EnableDDLDependencyCheck bool
+
+	// DDLCharsetConvertPolicy is the policy of the invalid characters when MODIFY COLUMN converts the charset of a column.
+	DDLCharsetConvertPolicy string
+
+	// RefreshActiveRoles decides when the active roles are refreshed after the privileges change.
+	RefreshActiveRoles string
+
+	// TrackResourceUsage indicates whether to return the resource usage of each statement to the client
+	// through the session state tracker.
+	TrackResourceUsage bool
+
+	// EnableOptimizerTrace indicates whether to keep the optimizer traces of the latest statements in OptimizerTraces.
+	EnableOptimizerTrace bool
+	// OptimizerTraces is the optimizer traces of the latest statements, the oldest one is the first.
+	OptimizerTraces []*OptimizerTraceRecord
+
 	HookContext
 
 	// MemTracker indicates the memory tracker of current session.
@@ -1371,6 +1440,11 @@ type SessionVars struct {
 	// EnablePlanReplayedContinuesCapture indicates whether enabled plan replayer continues capture
 	EnablePlanReplayedContinuesCapture bool
 
+	// PlanReplayerCaptureRegressionRatio indicates the ratio of the average latency of a plan to the fastest other plan
+	// of the same SQL digest, above which the plan is regarded as regressed and captured by plan replayer.
+	// 0 means the regressed plans are not captured.
+	PlanReplayerCaptureRegressionRatio float64
+
 	// PlanReplayerFinishedTaskKey used to record the finished plan replayer task key in order not to record the
 	// duplicate task in plan replayer continues capture
 	PlanReplayerFinishedTaskKey map[replayer.PlanReplayerTaskKey]struct{}
@@ -1395,6 +1469,9 @@ type SessionVars struct {
 	// Enable late materialization: push down some selection condition to tablescan.
 	EnableLateMaterialization bool
 
+	// EnableIndexSkipScan indicates whether the index reader skips the duplicated prefixes of the index.
+	EnableIndexSkipScan bool
+
 	// TiFlashComputeDispatchPolicy indicates how to dipatch task to tiflash_compute nodes.
 	// Only for disaggregated-tiflash mode.
 	TiFlashComputeDispatchPolicy tiflashcompute.DispatchPolicy
@@ -1406,6 +1483,15 @@ type SessionVars struct {
 	// If exceeding the threshold, try other stores using replica read.
 	LoadBasedReplicaReadThreshold time.Duration
 
+	// DistSQLAdaptiveConcurrency indicates whether the in-flight coprocessor tasks sent to each store are limited by
+	// the latency of the store.
+	DistSQLAdaptiveConcurrency bool
+
+	// SnapshotRefreshInterval is the interval to refresh the snapshot read by the coprocessor requests of the
+	// autocommit read-only statements. The results of such a statement may come from different snapshots, which
+	// makes the long-running analytic queries survive the GC safe point advancing.
+	SnapshotRefreshInterval time.Duration
+
 	// OptOrderingIdxSelThresh is the threshold for optimizer to consider the ordering index.
 	// If there exists an index whose estimated selectivity is smaller than this threshold, the optimizer won't
 	// use the ExpectedCnt to adjust the estimated row count for index scan.
@@ -1732,11 +1818,14 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		AllowAggPushDown:              false,
 		AllowCartesianBCJ:             DefOptCartesianBCJ,
 		MPPOuterJoinFixedBuildSide:    DefOptMPPOuterJoinFixedBuildSide,
+		MPPJoinSkewRatio:              DefOptMPPJoinSkewRatio,
+		MPPJoinSkewSaltNum:            DefOptMPPJoinSkewSaltNum,
 		BroadcastJoinThresholdSize:    DefBroadcastJoinThresholdSize,
 		BroadcastJoinThresholdCount:   DefBroadcastJoinThresholdSize,
 		OptimizerSelectivityLevel:     DefTiDBOptimizerSelectivityLevel,
 		EnableOuterJoinReorder:        DefTiDBEnableOuterJoinReorder,
 		RetryLimit:                    DefTiDBRetryLimit,
+		AutoStmtRetryLimit:            DefTiDBAutoStmtRetryLimit,
 		DisableTxnAutoRetry:           DefTiDBDisableTxnAutoRetry,
 		DDLReorgPriority:              kv.PriorityLow,
 		allowInSubqToJoinAndAgg:       DefOptInSubqToJoinAndAgg,
@@ -1783,6 +1872,11 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		AllowAutoRandExplicitInsert:   DefTiDBAllowAutoRandExplicitInsert,
 		EnableClusteredIndex:          DefTiDBEnableClusteredIndex,
 		EnableParallelApply:           DefTiDBEnableParallelApply,
+		EnablePartitionWiseJoin:       DefTiDBEnablePartitionWiseJoin,
+		EnableQueryRewrite:            DefTiDBEnableQueryRewrite,
+		EnableRuntimeFilter:           DefTiDBEnableRuntimeFilter,
+		EnableAdaptiveJoin:            DefTiDBEnableAdaptiveJoin,
+		CascadesPlannerTimeout:        DefTiDBCascadesPlannerTimeout,
 		ShardAllocateStep:             DefTiDBShardAllocateStep,
 		PartitionPruneMode:            *atomic2.NewString(DefTiDBPartitionPruneMode),
 		TxnScope:                      kv.NewDefaultTxnScopeVar(),
@@ -1808,6 +1902,8 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		ForeignKeyChecks:              DefTiDBForeignKeyChecks,
 		HookContext:                   hctx,
 		EnableReuseCheck:              DefTiDBEnableReusechunk,
+		DDLCharsetConvertPolicy:       DefTiDBDDLCharsetConvertPolicy,
+		RefreshActiveRoles:            DefTiDBRefreshActiveRoles,
 		preUseChunkAlloc:              DefTiDBUseAlloc,
 		ChunkPool:                     ReuseChunkPool{Alloc: nil},
 		mppExchangeCompressionMode:    DefaultExchangeCompressionMode,
@@ -2466,6 +2562,42 @@ func (s *SessionVars) DecodeSessionStates(_ context.Context, sessionStates *sess
 	return
 }
 
+// StmtRetryInfo is the info of an automatically retried autocommit statement.
+type StmtRetryInfo struct {
+	SQLDigest  string `json:"sql_digest"`
+	RetryCount int    `json:"retry_count"`
+	LastError  string `json:"last_error"`
+	Succeeded  bool   `json:"succeeded"`
+}
+
+// MaxOptimizerTraces is the max number of the optimizer traces kept in the session.
+const MaxOptimizerTraces = 8
+
+// MaxOptimizerTraceSize is the max size of an optimizer trace kept in the session, the bytes beyond it are truncated.
+const MaxOptimizerTraceSize = 1 << 20
+
+// OptimizerTraceRecord is the optimizer trace of a statement, which is shown in INFORMATION_SCHEMA.OPTIMIZER_TRACE.
+type OptimizerTraceRecord struct {
+	Query string
+	// Trace is the JSON of the logical transformations, the physical candidates with their costs and the final plan.
+	Trace string
+	// MissingBytes is the number of the bytes truncated from Trace.
+	MissingBytes int
+}
+
+// AppendOptimizerTrace keeps the optimizer trace of the statement, the oldest one is dropped if there are too many.
+func (s *SessionVars) AppendOptimizerTrace(query, trace string) {
+	record := &OptimizerTraceRecord{Query: query, Trace: trace}
+	if len(trace) > MaxOptimizerTraceSize {
+		record.Trace = trace[:MaxOptimizerTraceSize]
+		record.MissingBytes = len(trace) - MaxOptimizerTraceSize
+	}
+	if len(s.OptimizerTraces) >= MaxOptimizerTraces {
+		s.OptimizerTraces = append(s.OptimizerTraces[:0], s.OptimizerTraces[len(s.OptimizerTraces)-MaxOptimizerTraces+1:]...)
+	}
+	s.OptimizerTraces = append(s.OptimizerTraces, record)
+}
+
 // TableDelta stands for the changed count for one table or partition.
 type TableDelta struct {
 	Delta    int64
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/types"
 	_ "github.com/pingcap/tidb/types/parser_driver" // for parser driver
+	"github.com/pingcap/tidb/util/bgworker"
 	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/gctuner"
 	"github.com/pingcap/tidb/util/logutil"
@@ -233,6 +234,16 @@ var defaultSysVars = []*SysVar{
 		}
 		return string(info), nil
 	}},
+	{Scope: ScopeSession, Name: TiDBLastStmtRetryInfo, Value: "", ReadOnly: true, GetSession: func(s *SessionVars) (string, error) {
+		if s.LastStmtRetryInfo.RetryCount == 0 {
+			return "", nil
+		}
+		info, err := json.Marshal(s.LastStmtRetryInfo)
+		if err != nil {
+			return "", err
+		}
+		return string(info), nil
+	}},
 	{Scope: ScopeSession, Name: TiDBEnableChunkRPC, Value: BoolToOnOff(config.GetGlobalConfig().TiKVClient.EnableChunkRPC), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.EnableChunkRPC = TiDBOptOn(val)
 		return nil
@@ -265,6 +276,28 @@ var defaultSysVars = []*SysVar{
 		s.setDDLReorgPriority(val)
 		return nil
 	}},
+	{Scope: ScopeSession, Name: TiDBDDLJobLabels, Value: DefTiDBDDLJobLabels, Type: TypeStr, Validation: func(_ *SessionVars, normalizedValue string, originalValue string, _ ScopeFlag) (string, error) {
+		if normalizedValue == "" {
+			return normalizedValue, nil
+		}
+		labels := strings.Split(normalizedValue, ",")
+		for i, label := range labels {
+			key, value, ok := strings.Cut(label, "=")
+			key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+			if !ok || key == "" || value == "" || strings.ContainsAny(key+value, "={}") {
+				return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(TiDBDDLJobLabels, originalValue)
+			}
+			labels[i] = key + "=" + value
+		}
+		return strings.Join(labels, ","), nil
+	}, SetSession: func(s *SessionVars, val string) error {
+		s.DDLJobLabels = val
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBAlterDatabaseCharsetCascade, Value: Off, Type: TypeEnum, PossibleValues: []string{Off, CharsetCascadeStopOnError, CharsetCascadeContinueOnError}, SetSession: func(s *SessionVars, val string) error {
+		s.AlterDatabaseCharsetCascade = val
+		return nil
+	}},
 	{Scope: ScopeSession, Name: TiDBSlowQueryFile, Value: "", skipInit: true, SetSession: func(s *SessionVars, val string) error {
 		s.SlowQueryFile = val
 		return nil
@@ -817,6 +850,11 @@ var defaultSysVars = []*SysVar{
 	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
 		return setTiDBTableValue(s, "tikv_gc_scan_lock_mode", val, "Mode of scanning locks, \"physical\" or \"legacy\"")
 	}},
+	{Scope: ScopeGlobal, Name: TiDBGCDistResolveLocks, Value: Off, Type: TypeBool, GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+		return getTiDBTableValue(s, "tikv_gc_dist_resolve_locks", Off)
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		return setTiDBTableValue(s, "tikv_gc_dist_resolve_locks", val, "Let all TiDB servers resolve the locks in the key ranges split by the GC leader")
+	}},
 	{Scope: ScopeGlobal, Name: TiDBGCMaxWaitTime, Value: strconv.Itoa(DefTiDBGCMaxWaitTime), Type: TypeInt, MinValue: 600, MaxValue: 31536000, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
 		GCMaxWaitTime.Store(TidbOptInt64(val, DefTiDBGCMaxWaitTime))
 		return nil
@@ -1259,6 +1297,12 @@ var defaultSysVars = []*SysVar{
 			return s, nil
 		},
 	},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBPlanReplayerCaptureRegressionRatio, Value: strconv.Itoa(0), Type: TypeFloat, MinValue: 0, MaxValue: math.MaxUint64,
+		SetSession: func(s *SessionVars, val string) error {
+			s.PlanReplayerCaptureRegressionRatio = tidbOptFloat64(val, 0)
+			return nil
+		},
+	},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnablePlanReplayerCapture, Value: BoolToOnOff(true), Type: TypeBool,
 		SetSession: func(s *SessionVars, val string) error {
 			s.EnablePlanReplayerCapture = TiDBOptOn(val)
@@ -1524,6 +1568,14 @@ var defaultSysVars = []*SysVar{
 		s.MPPOuterJoinFixedBuildSide = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptMPPJoinSkewRatio, Value: strconv.FormatFloat(DefOptMPPJoinSkewRatio, 'f', -1, 64), Type: TypeFloat, MinValue: 0, MaxValue: 1, SetSession: func(s *SessionVars, val string) error {
+		s.MPPJoinSkewRatio = tidbOptFloat64(val, DefOptMPPJoinSkewRatio)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptMPPJoinSkewSaltNum, Value: strconv.Itoa(DefOptMPPJoinSkewSaltNum), Type: TypeUnsigned, MinValue: 0, MaxValue: 1024, SetSession: func(s *SessionVars, val string) error {
+		s.MPPJoinSkewSaltNum = TidbOptInt(val, DefOptMPPJoinSkewSaltNum)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBExecutorConcurrency, Value: strconv.Itoa(DefExecutorConcurrency), Type: TypeUnsigned, MinValue: 1, MaxValue: MaxConfigurableConcurrency, SetSession: func(s *SessionVars, val string) error {
 		s.ExecutorConcurrency = tidbOptPositiveInt32(val, DefExecutorConcurrency)
 		return nil
@@ -1532,6 +1584,10 @@ var defaultSysVars = []*SysVar{
 		s.distSQLScanConcurrency = tidbOptPositiveInt32(val, DefDistSQLScanConcurrency)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBDistSQLAdaptiveConcurrency, Value: BoolToOnOff(DefDistSQLAdaptiveConcurrency), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.DistSQLAdaptiveConcurrency = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptInSubqToJoinAndAgg, Value: BoolToOnOff(DefOptInSubqToJoinAndAgg), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.SetAllowInSubqToJoinAndAgg(TiDBOptOn(val))
 		return nil
@@ -1658,6 +1714,34 @@ var defaultSysVars = []*SysVar{
 		s.SetEnableCascadesPlanner(TiDBOptOn(val))
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBCascadesPlannerQueryShapes, Value: DefTiDBCascadesPlannerQueryShapes, Validation: func(vars *SessionVars, normalizedValue string, originalValue string, scope ScopeFlag) (string, error) {
+		if strings.TrimSpace(normalizedValue) == "" {
+			return "", nil
+		}
+		shapes := strings.Split(normalizedValue, ",")
+		for i, shape := range shapes {
+			shape = strings.ToLower(strings.TrimSpace(shape))
+			if shape != CascadesQueryShapeStarJoin && shape != CascadesQueryShapeAggOverJoin {
+				return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(TiDBCascadesPlannerQueryShapes, originalValue)
+			}
+			shapes[i] = shape
+		}
+		return strings.Join(shapes, ","), nil
+	}, SetSession: func(s *SessionVars, val string) error {
+		s.CascadesPlannerQueryShapes = nil
+		if val != "" {
+			s.CascadesPlannerQueryShapes = strings.Split(val, ",")
+		}
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBCascadesPlannerTimeout, Value: DefTiDBCascadesPlannerTimeout.String(), Type: TypeDuration, MinValue: int64(time.Millisecond), MaxValue: uint64(time.Hour), SetSession: func(s *SessionVars, val string) error {
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return err
+		}
+		s.CascadesPlannerTimeout = d
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableIndexMerge, Value: BoolToOnOff(DefTiDBEnableIndexMerge), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.SetEnableIndexMerge(TiDBOptOn(val))
 		return nil
@@ -1730,6 +1814,22 @@ var defaultSysVars = []*SysVar{
 		s.EnableParallelApply = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnablePartitionWiseJoin, Value: BoolToOnOff(DefTiDBEnablePartitionWiseJoin), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnablePartitionWiseJoin = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableQueryRewrite, Value: BoolToOnOff(DefTiDBEnableQueryRewrite), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableQueryRewrite = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableRuntimeFilter, Value: BoolToOnOff(DefTiDBEnableRuntimeFilter), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableRuntimeFilter = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableAdaptiveJoin, Value: BoolToOnOff(DefTiDBEnableAdaptiveJoin), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableAdaptiveJoin = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBMemQuotaApplyCache, Value: strconv.Itoa(DefTiDBMemQuotaApplyCache), Type: TypeUnsigned, MaxValue: math.MaxInt64, SetSession: func(s *SessionVars, val string) error {
 		s.MemQuotaApplyCache = TidbOptInt64(val, DefTiDBMemQuotaApplyCache)
 		return nil
@@ -1746,6 +1846,10 @@ var defaultSysVars = []*SysVar{
 		s.RetryLimit = TidbOptInt64(val, DefTiDBRetryLimit)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBAutoStmtRetryLimit, Value: strconv.Itoa(DefTiDBAutoStmtRetryLimit), Type: TypeUnsigned, MinValue: 0, MaxValue: 100, SetSession: func(s *SessionVars, val string) error {
+		s.AutoStmtRetryLimit = TidbOptInt64(val, DefTiDBAutoStmtRetryLimit)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBDisableTxnAutoRetry, Value: BoolToOnOff(DefTiDBDisableTxnAutoRetry), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.DisableTxnAutoRetry = TiDBOptOn(val)
 		return nil
@@ -2078,6 +2182,19 @@ var defaultSysVars = []*SysVar{
 			return nil
 		},
 	},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBCostModel, Value: "", Type: TypeStr,
+		Validation: func(vars *SessionVars, normalizedValue string, originalValue string, scope ScopeFlag) (string, error) {
+			name := strings.ToLower(normalizedValue)
+			if name != "" && (CostModelRegistered == nil || !CostModelRegistered(name)) {
+				return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(TiDBCostModel, originalValue)
+			}
+			return name, nil
+		},
+		SetSession: func(vars *SessionVars, s string) error {
+			vars.CostModel = s
+			return nil
+		},
+	},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBIndexJoinDoubleReadPenaltyCostRate, Value: strconv.Itoa(0), Hidden: false, Type: TypeFloat, MinValue: 0, MaxValue: math.MaxUint64,
 		SetSession: func(vars *SessionVars, s string) error {
 			vars.IndexJoinDoubleReadPenaltyCostRate = tidbOptFloat64(s, 0)
@@ -2149,6 +2266,10 @@ var defaultSysVars = []*SysVar{
 		s.EnableAnalyzeSnapshot = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBAnalyzeSkewAwareBuckets, Value: BoolToOnOff(DefTiDBAnalyzeSkewAwareBuckets), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.AnalyzeSkewAwareBuckets = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal, Name: TiDBGenerateBinaryPlan, Value: BoolToOnOff(DefTiDBGenerateBinaryPlan), Type: TypeBool, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
 		GenerateBinaryPlan.Store(TiDBOptOn(val))
 		return nil
@@ -2164,6 +2285,18 @@ var defaultSysVars = []*SysVar{
 		EnableFastReorg.Store(TiDBOptOn(val))
 		return nil
 	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLEnableReorgPreSplit, Value: BoolToOnOff(DefTiDBDDLEnableReorgPreSplit), Type: TypeBool, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return BoolToOnOff(EnableDDLReorgPreSplit.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		EnableDDLReorgPreSplit.Store(TiDBOptOn(val))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLReorgDeferLockedRanges, Value: BoolToOnOff(DefTiDBDDLReorgDeferLockedRanges), Type: TypeBool, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return BoolToOnOff(DDLReorgDeferLockedRanges.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLReorgDeferLockedRanges.Store(TiDBOptOn(val))
+		return nil
+	}},
 	// This system var is set disk quota for lightning sort dir, from 100 GB to 1PB.
 	{Scope: ScopeGlobal, Name: TiDBDDLDiskQuota, Value: strconv.Itoa(DefTiDBDDLDiskQuota), Type: TypeInt, MinValue: DefTiDBDDLDiskQuota, MaxValue: 1024 * 1024 * DefTiDBDDLDiskQuota / 100, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
 		return strconv.FormatUint(DDLDiskQuota.Load(), 10), nil
@@ -2171,6 +2304,136 @@ var defaultSysVars = []*SysVar{
 		DDLDiskQuota.Store(TidbOptUint64(val, DefTiDBDDLDiskQuota))
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableDDLDependencyCheck, Value: BoolToOnOff(DefTiDBEnableDDLDependencyCheck), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableDDLDependencyCheck = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLReorgMaxMemory, Value: strconv.Itoa(DefTiDBDDLReorgMaxMemory), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt64, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return strconv.FormatInt(DDLReorgMaxMemory.Load(), 10), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLReorgMaxMemory.Store(TidbOptInt64(val, DefTiDBDDLReorgMaxMemory))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLReorgVerifyChecksum, Value: BoolToOnOff(DefTiDBDDLReorgVerifyChecksum), Type: TypeBool, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return BoolToOnOff(DDLReorgVerifyChecksum.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLReorgVerifyChecksum.Store(TiDBOptOn(val))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLReorgPartitionConcurrency, Value: strconv.Itoa(DefTiDBDDLReorgPartitionConcurrency), Type: TypeUnsigned, MinValue: 1, MaxValue: MaxConfigurableConcurrency, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return strconv.Itoa(int(DDLReorgPartitionConcurrency.Load())), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLReorgPartitionConcurrency.Store(int32(TidbOptInt(val, DefTiDBDDLReorgPartitionConcurrency)))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLIngestFallbackThreshold, Value: strconv.Itoa(DefTiDBDDLIngestFallbackThreshold), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt32, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return strconv.Itoa(int(DDLIngestFallbackThreshold.Load())), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLIngestFallbackThreshold.Store(int32(TidbOptInt(val, DefTiDBDDLIngestFallbackThreshold)))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLIngestWriteBandwidth, Value: strconv.Itoa(DefTiDBDDLIngestWriteBandwidth), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt64, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return strconv.FormatUint(DDLIngestWriteBandwidth.Load(), 10), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLIngestWriteBandwidth.Store(TidbOptUint64(val, DefTiDBDDLIngestWriteBandwidth))
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBDDLCharsetConvertPolicy, Value: DefTiDBDDLCharsetConvertPolicy, Type: TypeEnum, PossibleValues: []string{CharsetConvertPolicyAbort, CharsetConvertPolicyReplace}, SetSession: func(s *SessionVars, val string) error {
+		s.DDLCharsetConvertPolicy = val
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLEnableRollingModifyColumn, Value: BoolToOnOff(DefTiDBDDLEnableRollingModifyColumn), Type: TypeBool, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return BoolToOnOff(DDLEnableRollingModifyColumn.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLEnableRollingModifyColumn.Store(TiDBOptOn(val))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLReorgWorkerLabel, Value: DefTiDBDDLReorgWorkerLabel, Type: TypeStr, Validation: func(_ *SessionVars, normalizedValue string, originalValue string, _ ScopeFlag) (string, error) {
+		if normalizedValue == "" {
+			return normalizedValue, nil
+		}
+		if key, value, ok := strings.Cut(normalizedValue, "="); !ok || strings.TrimSpace(key) == "" || strings.TrimSpace(value) == "" {
+			return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(TiDBDDLReorgWorkerLabel, originalValue)
+		}
+		return normalizedValue, nil
+	}, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return DDLReorgWorkerLabel.Load(), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLReorgWorkerLabel.Store(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBEnableCheckConstraint, Value: BoolToOnOff(DefTiDBEnableCheckConstraint), Type: TypeBool, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return BoolToOnOff(EnableCheckConstraint.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		EnableCheckConstraint.Store(TiDBOptOn(val))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBEnableDDLNotifier, Value: BoolToOnOff(DefTiDBEnableDDLNotifier), Type: TypeBool, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return BoolToOnOff(EnableDDLNotifier.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		EnableDDLNotifier.Store(TiDBOptOn(val))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLNotifierRetention, Value: DefTiDBDDLNotifierRetention.String(), Type: TypeDuration, MinValue: int64(time.Minute), MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+			return DDLNotifierRetention.Load().String(), nil
+		}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return err
+			}
+			DDLNotifierRetention.Store(d)
+			return nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBDeferredDropIndexGracePeriod, Value: DefTiDBDeferredDropIndexGracePeriod.String(), Type: TypeDuration, MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+			return DeferredDropIndexGracePeriod.Load().String(), nil
+		}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return err
+			}
+			DeferredDropIndexGracePeriod.Store(d)
+			return nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBDDLEnableTiFlashReplicaProgress, Value: BoolToOnOff(DefTiDBDDLEnableTiFlashReplicaProgress), Type: TypeBool, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return BoolToOnOff(DDLEnableTiFlashReplicaProgress.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLEnableTiFlashReplicaProgress.Store(TiDBOptOn(val))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLTiFlashReplicaConcurrency, Value: strconv.Itoa(DefTiDBDDLTiFlashReplicaConcurrency), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt32, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return strconv.Itoa(int(DDLTiFlashReplicaConcurrency.Load())), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLTiFlashReplicaConcurrency.Store(int32(TidbOptInt(val, DefTiDBDDLTiFlashReplicaConcurrency)))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBCapturePlanBaselinesOnRegression, Value: BoolToOnOff(DefTiDBCapturePlanBaselinesOnRegression), Type: TypeBool,
+		GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+			return BoolToOnOff(CapturePlanBaselinesOnRegression.Load()), nil
+		}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			CapturePlanBaselinesOnRegression.Store(TiDBOptOn(val))
+			return nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBPlanRegressionThreshold, Value: strconv.FormatFloat(DefTiDBPlanRegressionThreshold, 'f', -1, 64), Type: TypeFloat, MinValue: 1, MaxValue: math.MaxUint64,
+		GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+			return fmt.Sprintf("%g", PlanRegressionThreshold.Load()), nil
+		}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+			PlanRegressionThreshold.Store(tidbOptFloat64(val, DefTiDBPlanRegressionThreshold))
+			return nil
+		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBRefreshActiveRoles, Value: DefTiDBRefreshActiveRoles, Type: TypeEnum, PossibleValues: []string{RefreshActiveRolesOff, RefreshActiveRolesNextStatement, RefreshActiveRolesImmediate}, SetSession: func(s *SessionVars, val string) error {
+		s.RefreshActiveRoles = val
+		return nil
+	}},
+	{Scope: ScopeSession, Name: TiDBSessionTrackResourceUsage, Value: BoolToOnOff(DefTiDBSessionTrackResourceUsage), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.TrackResourceUsage = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeSession, Name: TiDBEnableOptimizerTrace, Value: BoolToOnOff(DefTiDBEnableOptimizerTrace), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableOptimizerTrace = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeSession, Name: TiDBConstraintCheckInPlacePessimistic, Value: BoolToOnOff(config.GetGlobalConfig().PessimisticTxn.ConstraintCheckInPlacePessimistic), Type: TypeBool,
 		SetSession: func(s *SessionVars, val string) error {
 			s.ConstraintCheckInPlacePessimistic = TiDBOptOn(val)
@@ -2378,6 +2641,37 @@ var defaultSysVars = []*SysVar{
 			return strconv.Itoa(int(TTLDeleteWorkerCount.Load())), nil
 		},
 	},
+	{
+		Scope: ScopeGlobal, Name: TiDBBackgroundWorkerConcurrency, Value: strconv.Itoa(DefTiDBBackgroundWorkerConcurrency), Type: TypeUnsigned, MinValue: 0, MaxValue: MaxConfigurableConcurrency, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			val, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return err
+			}
+			bgworker.GlobalQuota.SetConcurrency(int(val))
+			return nil
+		}, GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			return strconv.Itoa(bgworker.GlobalQuota.Concurrency()), nil
+		},
+	},
+	{
+		Scope: ScopeGlobal, Name: TiDBBackgroundWorkerMemQuota, Value: strconv.Itoa(DefTiDBBackgroundWorkerMemQuota), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt64, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+			val, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return err
+			}
+			if val == 0 {
+				val = -1
+			}
+			bgworker.GlobalQuota.MemTracker().SetBytesLimit(val)
+			return nil
+		}, GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+			limit := bgworker.GlobalQuota.MemTracker().GetBytesLimit()
+			if limit < 0 {
+				limit = 0
+			}
+			return strconv.FormatInt(limit, 10), nil
+		},
+	},
 	{Scope: ScopeGlobal, Name: TiDBEnableResourceControl, Value: BoolToOnOff(DefTiDBEnableResourceControl), Type: TypeBool, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
 		if TiDBOptOn(s) != EnableResourceControl.Load() {
 			EnableResourceControl.Store(TiDBOptOn(s))
@@ -2418,6 +2712,10 @@ var defaultSysVars = []*SysVar{
 		s.EnableLateMaterialization = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptEnableIndexSkipScan, Value: BoolToOnOff(DefTiDBOptEnableIndexSkipScan), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableIndexSkipScan = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBLoadBasedReplicaReadThreshold, Value: time.Duration(DefTiDBLoadBasedReplicaReadThreshold).String(), Type: TypeDuration, MaxValue: uint64(time.Hour), SetSession: func(s *SessionVars, val string) error {
 		d, err := time.ParseDuration(val)
 		if err != nil {
@@ -2426,6 +2724,14 @@ var defaultSysVars = []*SysVar{
 		s.LoadBasedReplicaReadThreshold = d
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBSnapshotRefreshInterval, Value: time.Duration(DefTiDBSnapshotRefreshInterval).String(), Type: TypeDuration, MaxValue: uint64(time.Hour * 24 * 365), SetSession: func(s *SessionVars, val string) error {
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return err
+		}
+		s.SnapshotRefreshInterval = d
+		return nil
+	}},
 	{Scope: ScopeGlobal, Name: TiDBTTLRunningTasks, Value: strconv.Itoa(DefTiDBTTLRunningTasks), Type: TypeInt, MinValue: 1, MaxValue: MaxConfigurableConcurrency, AllowAutoValue: true, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
 		val, err := strconv.ParseInt(s, 10, 64)
 		if err != nil {
@@ -2436,11 +2742,21 @@ var defaultSysVars = []*SysVar{
 	}, GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
 		return strconv.Itoa(int(TTLRunningTasks.Load())), nil
 	}},
+	{Scope: ScopeGlobal, Name: TiDBTTLDropExpiredPartition, Value: BoolToOnOff(DefTiDBTTLDropExpiredPartition), Type: TypeBool, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+		TTLDropExpiredPartition.Store(TiDBOptOn(s))
+		return nil
+	}, GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+		return BoolToOnOff(TTLDropExpiredPartition.Load()), nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptOrderingIdxSelThresh, Value: strconv.FormatFloat(DefTiDBOptOrderingIdxSelThresh, 'f', -1, 64), Type: TypeFloat, MinValue: 0, MaxValue: 1,
 		SetSession: func(s *SessionVars, val string) error {
 			s.OptOrderingIdxSelThresh = tidbOptFloat64(val, DefTiDBOptOrderingIdxSelThresh)
 			return nil
 		}},
+	{Scope: ScopeSession, Name: TiDBOptUseInvisibleIndexes, Value: BoolToOnOff(DefTiDBOptUseInvisibleIndexes), Type: TypeBool, IsHintUpdatable: true, SetSession: func(s *SessionVars, val string) error {
+		s.OptimizerUseInvisibleIndexes = TiDBOptOn(val)
+		return nil
+	}},
 }
 
 func setTiFlashComputeDispatchPolicy(s *SessionVars, val string) error {
@@ -547,6 +547,24 @@ func TestDDLWorkers(t *testing.T) {
 	require.Equal(t, val, "100") // unchanged
 }
 
+func TestDDLJobLabels(t *testing.T) {
+	sv := GetSysVar(TiDBDDLJobLabels)
+	vars := NewSessionVars(nil)
+
+	val, err := sv.Validate(vars, "", ScopeSession)
+	require.NoError(t, err)
+	require.Equal(t, "", val)
+	val, err = sv.Validate(vars, " ticket = DB-1234 ,team=payment", ScopeSession)
+	require.NoError(t, err)
+	require.Equal(t, "ticket=DB-1234,team=payment", val)
+	for _, invalid := range []string{"ticket", "ticket=", "=DB-1234", "ticket=DB-1234,", "ticket=a=b", "ticket={DB-1234}"} {
+		_, err = sv.Validate(vars, invalid, ScopeSession)
+		require.Error(t, err, invalid)
+	}
+	require.NoError(t, sv.SetSessionFromHook(vars, "ticket=DB-1234"))
+	require.Equal(t, "ticket=DB-1234", vars.DDLJobLabels)
+}
+
 func TestDefaultCharsetAndCollation(t *testing.T) {
 	vars := NewSessionVars(nil)
 	val, err := vars.GetSessionOrGlobalSystemVar(context.Background(), CharacterSetConnection)
@@ -1211,3 +1229,18 @@ func TestTiDBEnableResourceControl(t *testing.T) {
 	require.Equal(t, On, val)
 	require.Equal(t, enable, true)
 }
+
+func TestSetMPPJoinSkewRatio(t *testing.T) {
+	vars := NewSessionVars(nil)
+	require.Equal(t, DefOptMPPJoinSkewRatio, vars.MPPJoinSkewRatio)
+
+	sv := GetSysVar(TiDBOptMPPJoinSkewRatio)
+	val, err := sv.Validate(vars, "1.5", ScopeSession)
+	require.NoError(t, err)
+	require.Equal(t, "1", val)
+	_, err = sv.Validate(vars, "abc", ScopeSession)
+	require.Error(t, err)
+
+	require.NoError(t, vars.SetSystemVar(TiDBOptMPPJoinSkewRatio, "0.3"))
+	require.Equal(t, 0.3, vars.MPPJoinSkewRatio)
+}
@@ -58,6 +58,15 @@ const (
 
 	TiDBOptMPPOuterJoinFixedBuildSide = "tidb_opt_mpp_outer_join_fixed_build_side"
 
+	// TiDBOptMPPJoinSkewRatio is the threshold of the ratio of a single join key value to all the rows of the probe side.
+	// If the join keys are skewed beyond it, the optimizer prefers the broadcast join to the shuffle join in MPP mode.
+	// 0 means the skew of join keys is not considered.
+	TiDBOptMPPJoinSkewRatio = "tidb_opt_mpp_join_skew_ratio"
+
+	// TiDBOptMPPJoinSkewSaltNum is the number of salts to spread the rows of the skewed join keys in the shuffle join.
+	// 0 or 1 means the skewed join keys are not salted.
+	TiDBOptMPPJoinSkewSaltNum = "tidb_opt_mpp_join_skew_salt_num"
+
 	// TiDBOptDistinctAggPushDown is used to decide whether agg with distinct should be pushed to tikv/tiflash.
 	TiDBOptDistinctAggPushDown = "tidb_opt_distinct_agg_push_down"
 
@@ -106,12 +115,23 @@ const (
 	// TiDBLastQueryInfo is used to get the last query info within the current session.
 	TiDBLastQueryInfo = "tidb_last_query_info"
 
+	// TiDBLastStmtRetryInfo is used to get the info of the last automatically retried statement within the current session.
+	TiDBLastStmtRetryInfo = "tidb_last_stmt_retry_info"
+
 	// TiDBLastDDLInfo is used to get the last ddl info within the current session.
 	TiDBLastDDLInfo = "tidb_last_ddl_info"
 
 	// TiDBLastPlanReplayerToken is used to get the last plan replayer token within the current session
 	TiDBLastPlanReplayerToken = "tidb_last_plan_replayer_token"
 
+	// TiDBSessionTrackResourceUsage indicates whether to return the resource usage of each statement
+	// through the session state tracker in the OK packet.
+	TiDBSessionTrackResourceUsage = "tidb_session_track_resource_usage"
+
+	// TiDBEnableOptimizerTrace indicates whether to keep the optimizer traces of the latest statements of the session,
+	// which are shown in INFORMATION_SCHEMA.OPTIMIZER_TRACE.
+	TiDBEnableOptimizerTrace = "tidb_enable_optimizer_trace"
+
 	// TiDBConfig is a read-only variable that shows the config of the current server.
 	TiDBConfig = "tidb_config"
 
@@ -152,6 +172,10 @@ const (
 	// TiDBRetryLimit is the maximum number of retries when committing a transaction.
 	TiDBRetryLimit = "tidb_retry_limit"
 
+	// TiDBAutoStmtRetryLimit is the maximum number of automatic retries of an autocommit statement failed with a
+	// retryable region or lock error. 0 disables the retry.
+	TiDBAutoStmtRetryLimit = "tidb_auto_stmt_retry_limit"
+
 	// TiDBDisableTxnAutoRetry disables transaction auto retry.
 	TiDBDisableTxnAutoRetry = "tidb_disable_txn_auto_retry"
 
@@ -285,6 +309,11 @@ const (
 	// If the query has a LIMIT clause, high concurrency makes the system do much more work than needed.
 	TiDBDistSQLScanConcurrency = "tidb_distsql_scan_concurrency"
 
+	// TiDBDistSQLAdaptiveConcurrency indicates whether the in-flight coprocessor tasks sent to each TiKV store are
+	// limited by a controller driven by the latency of the store, instead of only by tidb_distsql_scan_concurrency.
+	// The controller allows more tasks in flight while the latency keeps stable and fewer ones when it rises.
+	TiDBDistSQLAdaptiveConcurrency = "tidb_distsql_adaptive_concurrency"
+
 	// TiDBOptInSubqToJoinAndAgg is used to enable/disable the optimizer rule of rewriting IN subquery.
 	TiDBOptInSubqToJoinAndAgg = "tidb_opt_insubq_to_join_and_agg"
 
@@ -411,6 +440,14 @@ const (
 	// TiDBEnableCascadesPlanner is used to control whether to enable the cascades planner.
 	TiDBEnableCascadesPlanner = "tidb_enable_cascades_planner"
 
+	// TiDBCascadesPlannerQueryShapes is the comma-separated query shapes optimized by the cascades planner even if
+	// it's not enabled, such as star_join and agg_over_join.
+	TiDBCascadesPlannerQueryShapes = "tidb_cascades_planner_query_shapes"
+
+	// TiDBCascadesPlannerTimeout is the max time of the exploration of the cascades planner for the query shapes in
+	// tidb_cascades_planner_query_shapes, the default optimizer is used after it times out.
+	TiDBCascadesPlannerTimeout = "tidb_cascades_planner_timeout"
+
 	// TiDBSkipUTF8Check skips the UTF8 validate process, validate UTF8 has performance cost, if we can make sure
 	// the input string values are valid, we can skip the check.
 	TiDBSkipUTF8Check = "tidb_skip_utf8_check"
@@ -457,6 +494,21 @@ const (
 	// TiDBEnableParallelApply is used for parallel apply.
 	TiDBEnableParallelApply = "tidb_enable_parallel_apply"
 
+	// TiDBEnablePartitionWiseJoin indicates whether to join the corresponding partitions of the tables independently
+	// and in parallel, when both sides of the hash join are partitioned on the join key with the same partition scheme.
+	TiDBEnablePartitionWiseJoin = "tidb_enable_partition_wise_join"
+
+	// TiDBEnableQueryRewrite indicates whether to rewrite the statements by the rules in mysql.query_rewrite_rules.
+	TiDBEnableQueryRewrite = "tidb_enable_query_rewrite"
+
+	// TiDBEnableRuntimeFilter indicates whether to push the filter generated from the build side keys of the hash join
+	// to the table scan of the probe side.
+	TiDBEnableRuntimeFilter = "tidb_enable_runtime_filter"
+
+	// TiDBEnableAdaptiveJoin indicates whether the hash join switches to the index join during the execution when its
+	// build side has far more rows than estimated.
+	TiDBEnableAdaptiveJoin = "tidb_enable_adaptive_join"
+
 	// TiDBBackoffLockFast is used for tikv backoff base time in milliseconds.
 	TiDBBackoffLockFast = "tidb_backoff_lock_fast"
 
@@ -482,6 +534,15 @@ const (
 	// It can be: PRIORITY_LOW, PRIORITY_NORMAL, PRIORITY_HIGH
 	TiDBDDLReorgPriority = "tidb_ddl_reorg_priority"
 
+	// TiDBDDLJobLabels is the labels of the DDL jobs submitted by the session in the "key=value,key=value" format,
+	// such as "ticket=DB-1234,team=payment". The labels are attached to the request source of the jobs and their
+	// backfill, so the load on the cluster can be attributed to the change request that caused it.
+	TiDBDDLJobLabels = "tidb_ddl_job_labels"
+
+	// TiDBAlterDatabaseCharsetCascade defines whether `ALTER DATABASE ... CHARACTER SET` converts the tables of the database too.
+	// It can be: OFF, STOP_ON_ERROR, CONTINUE_ON_ERROR
+	TiDBAlterDatabaseCharsetCascade = "tidb_alter_database_charset_cascade"
+
 	// TiDBEnableAutoIncrementInGenerated disables the mysql compatibility check on using auto-incremented columns in
 	// expression indexes and generated columns described here https://dev.mysql.com/doc/refman/5.7/en/create-table-generated-columns.html for details.
 	TiDBEnableAutoIncrementInGenerated = "tidb_enable_auto_increment_in_generated"
@@ -527,6 +588,7 @@ const (
 
 	// TiDBOptJoinReorderThreshold defines the threshold less than which
 	// we'll choose a rather time-consuming algorithm to calculate the join order.
+	// The join groups with more than 8 tables are reordered by the iterative DP, which builds bushy trees too.
 	TiDBOptJoinReorderThreshold = "tidb_opt_join_reorder_threshold"
 
 	// TiDBSlowQueryFile indicates which slow query log file for SLOW_QUERY table to parse.
@@ -721,6 +783,10 @@ const (
 	// TiDBCostModelVersion is a internal switch to indicates the cost model version.
 	TiDBCostModelVersion = "tidb_cost_model_version"
 
+	// TiDBCostModel indicates the alternative cost model registered in the planner, which is used instead of the
+	// built-in cost model of tidb_cost_model_version.
+	TiDBCostModel = "tidb_cost_model"
+
 	// TiDBIndexJoinDoubleReadPenaltyCostRate indicates whether to add some penalty cost to IndexJoin and how much of it.
 	// IndexJoin can cause plenty of extra double read tasks, which consume lots of resources and take a long time.
 	// Since the number of double read tasks is hard to estimated accurately, we leave this variable to let us can adjust this
@@ -763,6 +829,10 @@ const (
 	// When set to true, ANALYZE reads data on the snapshot at the beginning of ANALYZE.
 	TiDBEnableAnalyzeSnapshot = "tidb_enable_analyze_snapshot"
 
+	// TiDBAnalyzeSkewAwareBuckets indicates whether ANALYZE chooses the bucket count and the TopN size of each column
+	// by the skew of its samples. The bucket count and the TopN size of ANALYZE are used as the baseline.
+	TiDBAnalyzeSkewAwareBuckets = "tidb_analyze_skew_aware_buckets"
+
 	// TiDBDefaultStrMatchSelectivity controls some special cardinality estimation strategy for string match functions (like and regexp).
 	// When set to 0, Selectivity() will try to evaluate those functions with TopN and NULL in the stats to estimate,
 	// and the default selectivity and the selectivity for the histogram part will be 0.1.
@@ -814,6 +884,9 @@ const (
 
 	// TiDBEnablePlanReplayerContinuousCapture indicates whether to enable continuous capture
 	TiDBEnablePlanReplayerContinuousCapture = "tidb_enable_plan_replayer_continuous_capture"
+
+	// TiDBPlanReplayerCaptureRegressionRatio indicates the latency ratio of the regressed plans captured by plan replayer
+	TiDBPlanReplayerCaptureRegressionRatio = "tidb_plan_replayer_capture_regression_ratio"
 	// TiDBEnableReusechunk indicates whether to enable chunk alloc
 	TiDBEnableReusechunk = "tidb_enable_reuse_chunk"
 
@@ -844,11 +917,21 @@ const (
 
 	// TiDBOptEnableLateMaterialization indicates whether to enable late materialization
 	TiDBOptEnableLateMaterialization = "tidb_opt_enable_late_materialization"
+	// TiDBOptEnableIndexSkipScan indicates whether to skip the duplicated prefixes of an index when only its distinct
+	// prefixes are needed, or when the leading column of the index has no filter.
+	TiDBOptEnableIndexSkipScan = "tidb_opt_enable_index_skip_scan"
 	// TiDBLoadBasedReplicaReadThreshold is the wait duration threshold to enable replica read automatically.
 	TiDBLoadBasedReplicaReadThreshold = "tidb_load_based_replica_read_threshold"
+	// TiDBSnapshotRefreshInterval is the interval to refresh the snapshot read by the coprocessor requests of the
+	// autocommit read-only statements. 0 means the snapshot is never refreshed.
+	TiDBSnapshotRefreshInterval = "tidb_snapshot_refresh_interval"
 
 	// TiDBOptOrderingIdxSelThresh is the threshold for optimizer to consider the ordering index.
 	TiDBOptOrderingIdxSelThresh = "tidb_opt_ordering_index_selectivity_threshold"
+
+	// TiDBOptUseInvisibleIndexes indicates whether the optimizer can use the invisible indexes, which lets the plans
+	// be checked before making an index visible, or before dropping an index by making it invisible first.
+	TiDBOptUseInvisibleIndexes = "tidb_opt_use_invisible_indexes"
 )
 
 // TiDB vars that have only global scope
@@ -864,6 +947,8 @@ const (
 	TiDBGCConcurrency = "tidb_gc_concurrency"
 	// TiDBGCScanLockMode enables the green GC feature (default)
 	TiDBGCScanLockMode = "tidb_gc_scan_lock_mode"
+	// TiDBGCDistResolveLocks lets all the TiDB servers resolve the locks for GC in the key ranges split by the GC leader
+	TiDBGCDistResolveLocks = "tidb_gc_dist_resolve_locks"
 	// TiDBGCMaxWaitTime sets max time for gc advances the safepoint delayed by active transactions
 	TiDBGCMaxWaitTime = "tidb_gc_max_wait_time"
 	// TiDBEnableEnhancedSecurity restricts SUPER users from certain operations.
@@ -915,8 +1000,69 @@ const (
 	TiDBEnableTmpStorageOnOOM = "tidb_enable_tmp_storage_on_oom"
 	// TiDBDDLEnableFastReorg indicates whether to use lighting backfill process for adding index.
 	TiDBDDLEnableFastReorg = "tidb_ddl_enable_fast_reorg"
+	// TiDBDDLEnableReorgPreSplit indicates whether to pre-split the table regions by sampled handles before backfilling
+	// if the table has too few regions to run the backfill workers in parallel.
+	TiDBDDLEnableReorgPreSplit = "tidb_ddl_enable_reorg_pre_split"
+	// TiDBDDLReorgDeferLockedRanges indicates whether to defer backfilling the ranges with many pessimistic locks to
+	// the later rounds, so the backfill doesn't keep retrying on the lock conflicts of a few hot rows. It's off by
+	// default, since the locks are probed by a ScanLock request per region for each backfill task.
+	TiDBDDLReorgDeferLockedRanges = "tidb_ddl_reorg_defer_locked_ranges"
 	// TiDBDDLDiskQuota used to set disk quota for lightning add index.
 	TiDBDDLDiskQuota = "tidb_ddl_disk_quota"
+	// TiDBEnableDDLDependencyCheck indicates whether to check the views, foreign keys, expression indexes and SQL bindings
+	// which depend on the dropped or renamed column.
+	TiDBEnableDDLDependencyCheck = "tidb_enable_ddl_dependency_check"
+	// TiDBDDLReorgMaxMemory is the memory quota of the DDL backfill workers in each TiDB node. 0 means unlimited.
+	TiDBDDLReorgMaxMemory = "tidb_ddl_reorg_max_memory"
+	// TiDBDDLReorgVerifyChecksum indicates whether to verify the index records written by each backfill batch
+	// with a coprocessor checksum.
+	TiDBDDLReorgVerifyChecksum = "tidb_ddl_reorg_verify_checksum"
+	// TiDBDDLReorgPartitionConcurrency is the number of partitions backfilled concurrently when adding an index to
+	// a partitioned table.
+	TiDBDDLReorgPartitionConcurrency = "tidb_ddl_reorg_partition_concurrency"
+	// TiDBDDLIngestFallbackThreshold is the number of the ingest environment errors, like the disk is full or the
+	// ingest backend cannot be created, that an add index job can meet before it falls back to the txn-merge
+	// backfill permanently. 0 means the job never falls back permanently.
+	TiDBDDLIngestFallbackThreshold = "tidb_ddl_ingest_fallback_threshold"
+	// TiDBDDLIngestWriteBandwidth is the bytes per second that the ingest of a DDL job can write into each TiKV store.
+	// The bandwidth is shared by the engines of the partitions imported concurrently. 0 means unlimited.
+	TiDBDDLIngestWriteBandwidth = "tidb_ddl_ingest_write_bandwidth"
+	// TiDBDDLCharsetConvertPolicy is the policy of the invalid characters when MODIFY COLUMN converts the charset of
+	// a column, "ABORT" cancels the DDL job and "REPLACE" replaces the invalid characters with '?'.
+	TiDBDDLCharsetConvertPolicy = "tidb_ddl_charset_convert_policy"
+	// TiDBDDLEnableRollingModifyColumn indicates whether MODIFY COLUMN can change the data of a partitioned table,
+	// the column and the indexes are reorganized one partition at a time.
+	TiDBDDLEnableRollingModifyColumn = "tidb_ddl_enable_rolling_modify_column"
+	// TiDBDDLReorgWorkerLabel is the server label in the "key=value" format. When it's set, the distributed backfill
+	// jobs of the new add index jobs are only run by the TiDB servers with the label, so the huge backfills can be
+	// placed on a dedicated pool of servers instead of the DDL owner. Empty means all the servers can run them.
+	TiDBDDLReorgWorkerLabel = "tidb_ddl_reorg_worker_label"
+	// TiDBEnableCheckConstraint indicates whether the CHECK constraints are created and enforced. When it's off, the
+	// CHECK constraints in the DDL statements are ignored with a warning, and the existing ones aren't enforced.
+	TiDBEnableCheckConstraint = "tidb_enable_check_constraint"
+	// TiDBEnableDDLNotifier indicates whether the finished DDL jobs write their events into mysql.ddl_notifier, which
+	// the external systems like schema registries can poll.
+	TiDBEnableDDLNotifier = "tidb_enable_ddl_notifier"
+	// TiDBDDLNotifierRetention is how long the events are kept in mysql.ddl_notifier whether they are acked or not.
+	TiDBDDLNotifierRetention = "tidb_ddl_notifier_retention"
+	// TiDBDeferredDropIndexGracePeriod is how long the index dropped by `DROP INDEX ... DEFERRED` can be restored by
+	// UNDROP INDEX, it's purged by the DDL owner after the period.
+	TiDBDeferredDropIndexGracePeriod = "tidb_deferred_drop_index_grace_period"
+	// TiDBDDLEnableTiFlashReplicaProgress indicates whether `ALTER TABLE ... SET TIFLASH REPLICA` keeps running until
+	// the replicas are available, the progress of each partition is tracked in the DDL job and shown by ADMIN SHOW DDL JOBS.
+	TiDBDDLEnableTiFlashReplicaProgress = "tidb_ddl_enable_tiflash_replica_progress"
+	// TiDBDDLTiFlashReplicaConcurrency is the max number of partitions whose TiFlash replicas are being created at the
+	// same time by a tracked `ALTER TABLE ... SET TIFLASH REPLICA` job. 0 means unlimited.
+	TiDBDDLTiFlashReplicaConcurrency = "tidb_ddl_tiflash_replica_concurrency"
+	// TiDBCapturePlanBaselinesOnRegression indicates whether to capture the binding of the previous plan automatically
+	// when the plan of a statement regresses, the regressions are recorded in mysql.plan_regressions.
+	TiDBCapturePlanBaselinesOnRegression = "tidb_capture_plan_baselines_on_regression"
+	// TiDBPlanRegressionThreshold is the ratio of the average latency of a plan to the fastest other plan of the same
+	// statement, above which the plan is regarded as regressed.
+	TiDBPlanRegressionThreshold = "tidb_plan_regression_threshold"
+	// TiDBRefreshActiveRoles decides when the active roles of the existing sessions are refreshed after GRANT, REVOKE
+	// or SET DEFAULT ROLE changes the privileges, so the sessions don't need to reconnect to see the changes.
+	TiDBRefreshActiveRoles = "tidb_refresh_active_roles"
 	// TiDBAutoBuildStatsConcurrency is used to set the build concurrency of auto-analyze.
 	TiDBAutoBuildStatsConcurrency = "tidb_auto_build_stats_concurrency"
 	// TiDBSysProcScanConcurrency is used to set the scan concurrency of for backend system processes, like auto-analyze.
@@ -949,6 +1095,11 @@ const (
 	TiDBTTLScanWorkerCount = "tidb_ttl_scan_worker_count"
 	// TiDBTTLDeleteWorkerCount indicates the count of the delete workers in each TiDB node
 	TiDBTTLDeleteWorkerCount = "tidb_ttl_delete_worker_count"
+	// TiDBBackgroundWorkerConcurrency indicates the max count of the background workers (DDL backfill workers,
+	// TTL scan/delete workers) in each TiDB node. 0 means unlimited.
+	TiDBBackgroundWorkerConcurrency = "tidb_background_worker_concurrency"
+	// TiDBBackgroundWorkerMemQuota indicates the memory quota of the background workers in each TiDB node. 0 means unlimited.
+	TiDBBackgroundWorkerMemQuota = "tidb_background_worker_mem_quota"
 	// PasswordReuseHistory limit a few passwords to reuse.
 	PasswordReuseHistory = "password_history"
 	// PasswordReuseTime limit how long passwords can be reused.
@@ -972,6 +1123,9 @@ const (
 	// TiDBTTLRunningTasks limits the count of running ttl tasks. Default to 0, means 3 times the count of TiKV (or no
 	// limitation, if the storage is not TiKV).
 	TiDBTTLRunningTasks = "tidb_ttl_running_tasks"
+	// TiDBTTLDropExpiredPartition indicates whether the ttl job drops the partition whose rows are all expired instead of
+	// deleting the rows one by one. It only works for the tables partitioned by RANGE COLUMNS on the ttl time column.
+	TiDBTTLDropExpiredPartition = "tidb_ttl_drop_expired_partition"
 )
 
 // TiDB intentional limits
@@ -992,6 +1146,7 @@ const (
 	DefIndexJoinBatchSize                          = 25000
 	DefIndexLookupSize                             = 20000
 	DefDistSQLScanConcurrency                      = 15
+	DefDistSQLAdaptiveConcurrency                  = false
 	DefBuildStatsConcurrency                       = 4
 	DefAutoAnalyzeRatio                            = 0.5
 	DefAutoAnalyzeStartTime                        = "00:00 +0000"
@@ -1005,6 +1160,8 @@ const (
 	DefOptDeriveTopN                               = false
 	DefOptCartesianBCJ                             = 1
 	DefOptMPPOuterJoinFixedBuildSide               = false
+	DefOptMPPJoinSkewRatio                         = 0.0
+	DefOptMPPJoinSkewSaltNum                       = 8
 	DefOptWriteRowID                               = false
 	DefOptEnableCorrelationAdjustment              = true
 	DefOptLimitPushDownThreshold                   = 100
@@ -1039,6 +1196,7 @@ const (
 	DefTiDBGeneralLog                              = false
 	DefTiDBPProfSQLCPU                             = 0
 	DefTiDBRetryLimit                              = 10
+	DefTiDBAutoStmtRetryLimit                      = 0
 	DefTiDBDisableTxnAutoRetry                     = true
 	DefTiDBConstraintCheckInPlace                  = false
 	DefTiDBHashJoinConcurrency                     = ConcurrencyUnset
@@ -1110,6 +1268,12 @@ const (
 	DefTiDBShardAllocateStep                       = math.MaxInt64
 	DefTiDBEnableTelemetry                         = false
 	DefTiDBEnableParallelApply                     = false
+	DefTiDBEnablePartitionWiseJoin                 = false
+	DefTiDBEnableQueryRewrite                      = true
+	DefTiDBEnableRuntimeFilter                     = false
+	DefTiDBEnableAdaptiveJoin                      = false
+	DefTiDBCascadesPlannerQueryShapes              = ""
+	DefTiDBCascadesPlannerTimeout                  = 200 * time.Millisecond
 	DefTiDBPartitionPruneMode                      = "dynamic"
 	DefTiDBEnableRateLimitAction                   = false
 	DefTiDBEnableAsyncCommit                       = false
@@ -1182,6 +1346,7 @@ const (
 	DefTiFlashFineGrainedShuffleBatchSize          = 8192
 	DefAdaptiveClosestReadThreshold                = 4096
 	DefTiDBEnableAnalyzeSnapshot                   = false
+	DefTiDBAnalyzeSkewAwareBuckets                 = false
 	DefTiDBGenerateBinaryPlan                      = true
 	DefEnableTiDBGCAwareMemoryTrack                = false
 	DefTiDBDefaultStrMatchSelectivity              = 0.8
@@ -1191,7 +1356,28 @@ const (
 	DefMemoryUsageAlarmRatio                       = 0.7
 	DefMemoryUsageAlarmKeepRecordNum               = 5
 	DefTiDBEnableFastReorg                         = true
+	DefTiDBDDLEnableReorgPreSplit                  = true
+	DefTiDBDDLReorgDeferLockedRanges               = false
 	DefTiDBDDLDiskQuota                            = 100 * 1024 * 1024 * 1024 // 100GB
+	DefTiDBDDLReorgMaxMemory                       = 0
+	DefTiDBDDLReorgVerifyChecksum                  = false
+	DefTiDBDDLReorgPartitionConcurrency            = 1
+	DefTiDBDDLIngestFallbackThreshold              = 3
+	DefTiDBDDLIngestWriteBandwidth                 = 0
+	DefTiDBDDLCharsetConvertPolicy                 = CharsetConvertPolicyAbort
+	DefTiDBDDLJobLabels                            = ""
+	DefTiDBDDLEnableRollingModifyColumn            = false
+	DefTiDBDDLReorgWorkerLabel                     = ""
+	DefTiDBEnableCheckConstraint                   = false
+	DefTiDBEnableDDLNotifier                       = false
+	DefTiDBDDLNotifierRetention                    = 7 * 24 * time.Hour
+	DefTiDBDeferredDropIndexGracePeriod            = 24 * time.Hour
+	DefTiDBDDLEnableTiFlashReplicaProgress         = false
+	DefTiDBDDLTiFlashReplicaConcurrency            = 0
+	DefTiDBCapturePlanBaselinesOnRegression        = false
+	DefTiDBPlanRegressionThreshold                 = 2.0
+	DefTiDBRefreshActiveRoles                      = RefreshActiveRolesNextStatement
+	DefTiDBEnableDDLDependencyCheck                = false
 	DefExecutorConcurrency                         = 5
 	DefTiDBEnableNonPreparedPlanCache              = false
 	DefTiDBNonPreparedPlanCacheSize                = 100
@@ -1230,6 +1416,7 @@ const (
 	DefTiDBTTLDeleteBatchMinSize                     = 1
 	DefTiDBTTLDeleteRateLimit                        = 0
 	DefTiDBTTLRunningTasks                           = -1
+	DefTiDBTTLDropExpiredPartition                   = false
 	DefPasswordReuseHistory                          = 0
 	DefPasswordReuseTime                             = 0
 	DefTiDBStoreBatchSize                            = 4
@@ -1239,6 +1426,10 @@ const (
 	DefTiDBTTLJobScheduleWindowEndTime               = "23:59 +0000"
 	DefTiDBTTLScanWorkerCount                        = 4
 	DefTiDBTTLDeleteWorkerCount                      = 4
+	DefTiDBBackgroundWorkerConcurrency               = 0
+	DefTiDBBackgroundWorkerMemQuota                  = 0
+	DefTiDBSessionTrackResourceUsage                 = false
+	DefTiDBEnableOptimizerTrace                      = false
 	DefaultExchangeCompressionMode                   = kv.ExchangeCompressionModeUnspecified
 	DefTiDBEnableResourceControl                     = true
 	DefTiDBPessimisticTransactionFairLocking         = false
@@ -1246,8 +1437,11 @@ const (
 	DefTiFlashComputeDispatchPolicy                  = tiflashcompute.DispatchPolicyConsistentHashStr
 	DefTiDBEnablePlanCacheForSubquery                = true
 	DefTiDBLoadBasedReplicaReadThreshold             = 0
+	DefTiDBSnapshotRefreshInterval                   = 0
 	DefTiDBOptEnableLateMaterialization              = false
+	DefTiDBOptEnableIndexSkipScan                    = false
 	DefTiDBOptOrderingIdxSelThresh                   = 0.0
+	DefTiDBOptUseInvisibleIndexes                    = false
 )
 
 // Process global variables.
@@ -1296,8 +1490,42 @@ var (
 	AutoAnalyzePartitionBatchSize     = atomic.NewInt64(DefTiDBAutoAnalyzePartitionBatchSize)
 	// EnableFastReorg indicates whether to use lightning to enhance DDL reorg performance.
 	EnableFastReorg = atomic.NewBool(DefTiDBEnableFastReorg)
+	// EnableDDLReorgPreSplit indicates whether to pre-split the table regions before backfilling.
+	EnableDDLReorgPreSplit = atomic.NewBool(DefTiDBDDLEnableReorgPreSplit)
+	// DDLReorgDeferLockedRanges indicates whether to defer backfilling the ranges with many pessimistic locks.
+	DDLReorgDeferLockedRanges = atomic.NewBool(DefTiDBDDLReorgDeferLockedRanges)
 	// DDLDiskQuota is the temporary variable for set disk quota for lightning
 	DDLDiskQuota = atomic.NewUint64(DefTiDBDDLDiskQuota)
+	// DDLReorgMaxMemory is the memory quota of the DDL backfill workers.
+	DDLReorgMaxMemory = atomic.NewInt64(DefTiDBDDLReorgMaxMemory)
+	// DDLReorgVerifyChecksum indicates whether to verify the backfilled index records with a coprocessor checksum.
+	DDLReorgVerifyChecksum = atomic.NewBool(DefTiDBDDLReorgVerifyChecksum)
+	// DDLReorgPartitionConcurrency is the number of partitions backfilled concurrently.
+	DDLReorgPartitionConcurrency = atomic.NewInt32(DefTiDBDDLReorgPartitionConcurrency)
+	// DDLIngestFallbackThreshold is the number of the ingest environment errors before falling back to txn-merge.
+	DDLIngestFallbackThreshold = atomic.NewInt32(DefTiDBDDLIngestFallbackThreshold)
+	// DDLIngestWriteBandwidth is the bytes per second that the ingest of a DDL job can write into each TiKV store.
+	DDLIngestWriteBandwidth = atomic.NewUint64(DefTiDBDDLIngestWriteBandwidth)
+	// DDLEnableRollingModifyColumn indicates whether MODIFY COLUMN can change the data of a partitioned table.
+	DDLEnableRollingModifyColumn = atomic.NewBool(DefTiDBDDLEnableRollingModifyColumn)
+	// DDLReorgWorkerLabel is the server label of the TiDB servers which run the distributed backfill jobs.
+	DDLReorgWorkerLabel = atomic.NewString(DefTiDBDDLReorgWorkerLabel)
+	// EnableCheckConstraint indicates whether the CHECK constraints are created and enforced.
+	EnableCheckConstraint = atomic.NewBool(DefTiDBEnableCheckConstraint)
+	// EnableDDLNotifier indicates whether the finished DDL jobs write their events into mysql.ddl_notifier.
+	EnableDDLNotifier = atomic.NewBool(DefTiDBEnableDDLNotifier)
+	// DDLNotifierRetention is how long the events are kept in mysql.ddl_notifier.
+	DDLNotifierRetention = atomic.NewDuration(DefTiDBDDLNotifierRetention)
+	// DeferredDropIndexGracePeriod is how long the index dropped by `DROP INDEX ... DEFERRED` can be restored.
+	DeferredDropIndexGracePeriod = atomic.NewDuration(DefTiDBDeferredDropIndexGracePeriod)
+	// DDLEnableTiFlashReplicaProgress indicates whether the creation of the TiFlash replicas is tracked by the DDL job.
+	DDLEnableTiFlashReplicaProgress = atomic.NewBool(DefTiDBDDLEnableTiFlashReplicaProgress)
+	// DDLTiFlashReplicaConcurrency is the max number of partitions whose TiFlash replicas are created at the same time.
+	DDLTiFlashReplicaConcurrency = atomic.NewInt32(DefTiDBDDLTiFlashReplicaConcurrency)
+	// CapturePlanBaselinesOnRegression indicates whether to capture the binding automatically when a plan regresses.
+	CapturePlanBaselinesOnRegression = atomic.NewBool(DefTiDBCapturePlanBaselinesOnRegression)
+	// PlanRegressionThreshold is the latency ratio above which a plan is regarded as regressed.
+	PlanRegressionThreshold = atomic.NewFloat64(DefTiDBPlanRegressionThreshold)
 	// EnableForeignKey indicates whether to enable foreign key feature.
 	EnableForeignKey    = atomic.NewBool(true)
 	EnableRCReadCheckTS = atomic.NewBool(false)
@@ -1325,6 +1553,7 @@ var (
 	HistoricalStatsDuration            = atomic.NewDuration(DefTiDBHistoricalStatsDuration)
 	EnableHistoricalStatsForCapture    = atomic.NewBool(DefTiDBEnableHistoricalStatsForCapture)
 	TTLRunningTasks                    = atomic.NewInt32(DefTiDBTTLRunningTasks)
+	TTLDropExpiredPartition            = atomic.NewBool(DefTiDBTTLDropExpiredPartition)
 	// always set the default value to false because the resource control in kv-client is not inited
 	// It will be initialized to the right value after the first call of `rebuildSysVarCache`
 	EnableResourceControl = atomic.NewBool(false)
@@ -1351,6 +1580,16 @@ var (
 	GetExternalTimestamp func(ctx context.Context) (uint64, error)
 	// SetGlobalResourceControl is the func registered by domain to set cluster resource control.
 	SetGlobalResourceControl atomic.Pointer[func(bool)]
+	// CostModelRegistered is the func registered by the planner to check whether the cost model is registered.
+	CostModelRegistered func(name string) bool = nil
+)
+
+// The query shapes in tidb_cascades_planner_query_shapes.
+const (
+	// CascadesQueryShapeStarJoin is the inner join of a table with at least two other tables by equal conditions.
+	CascadesQueryShapeStarJoin = "star_join"
+	// CascadesQueryShapeAggOverJoin is the aggregation over a join.
+	CascadesQueryShapeAggOverJoin = "agg_over_join"
 )
 
 // Hooks functions for Cluster Resource Control.
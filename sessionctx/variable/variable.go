@@ -80,6 +80,27 @@ const (
 	OOMActionCancel = "CANCEL"
 	// OOMActionLog constants represents the valid action configurations for OOMAction "LOG".
 	OOMActionLog = "LOG"
+	// CharsetConvertPolicyAbort is a choice of variable TiDBDDLCharsetConvertPolicy that means the DDL job is cancelled
+	// when meeting the invalid characters.
+	CharsetConvertPolicyAbort = "ABORT"
+	// CharsetConvertPolicyReplace is a choice of variable TiDBDDLCharsetConvertPolicy that means the invalid characters
+	// are replaced with '?'.
+	CharsetConvertPolicyReplace = "REPLACE"
+	// CharsetCascadeStopOnError is a choice of variable TiDBAlterDatabaseCharsetCascade that means the tables are
+	// converted one by one, and the remaining tables are not converted once a table fails.
+	CharsetCascadeStopOnError = "STOP_ON_ERROR"
+	// CharsetCascadeContinueOnError is a choice of variable TiDBAlterDatabaseCharsetCascade that means the remaining
+	// tables are still converted when a table fails.
+	CharsetCascadeContinueOnError = "CONTINUE_ON_ERROR"
+	// RefreshActiveRolesOff is a choice of variable TiDBRefreshActiveRoles that means the active roles are only
+	// evaluated when the session logs in or runs SET ROLE.
+	RefreshActiveRolesOff = "OFF"
+	// RefreshActiveRolesNextStatement is a choice of variable TiDBRefreshActiveRoles that means the active roles are
+	// refreshed before the next statement of the session runs.
+	RefreshActiveRolesNextStatement = "NEXT_STATEMENT"
+	// RefreshActiveRolesImmediate is a choice of variable TiDBRefreshActiveRoles that means the active roles are also
+	// refreshed when the running statement builds the plans of its inner statements, like the foreign key cascades.
+	RefreshActiveRolesImmediate = "IMMEDIATE"
 )
 
 // Global config name list.
@@ -555,7 +576,7 @@ func (sv *SysVar) SkipInit() bool {
 func (sv *SysVar) SkipSysvarCache() bool {
 	switch sv.Name {
 	case TiDBGCEnable, TiDBGCRunInterval, TiDBGCLifetime,
-		TiDBGCConcurrency, TiDBGCScanLockMode, TiDBExternalTS:
+		TiDBGCConcurrency, TiDBGCScanLockMode, TiDBGCDistResolveLocks, TiDBExternalTS:
 		return true
 	}
 	return false
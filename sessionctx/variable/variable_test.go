@@ -667,6 +667,7 @@ func TestSkipSysvarCache(t *testing.T) {
 	require.True(t, GetSysVar(TiDBGCLifetime).SkipSysvarCache())
 	require.True(t, GetSysVar(TiDBGCConcurrency).SkipSysvarCache())
 	require.True(t, GetSysVar(TiDBGCScanLockMode).SkipSysvarCache())
+	require.True(t, GetSysVar(TiDBGCDistResolveLocks).SkipSysvarCache())
 	require.False(t, GetSysVar(TiDBEnableAsyncCommit).SkipSysvarCache())
 }
 
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/codec"
 	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/mathutil"
 	"github.com/pingcap/tidb/util/memory"
 )
 
@@ -279,6 +280,12 @@ func BuildHistAndTopN(
 	if err != nil {
 		return nil, nil, err
 	}
+	if ctx.GetSessionVars().AnalyzeSkewAwareBuckets {
+		numBuckets, numTopN, err = tuneBucketsAndTopN(samples, getComparedBytes, numBuckets)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 	hg := NewHistogram(id, ndv, nullCount, 0, tp, numBuckets, collector.TotalSize)
 
 	sampleNum := int64(len(samples))
@@ -404,6 +411,53 @@ func BuildHistAndTopN(
 	return hg, topn, nil
 }
 
+const (
+	// maxSkewAwareBuckets and maxSkewAwareTopN are the max bucket count and TopN size chosen by tuneBucketsAndTopN,
+	// which are the limits of the ANALYZE options.
+	maxSkewAwareBuckets = 1024
+	maxSkewAwareTopN    = 1024
+)
+
+// tuneBucketsAndTopN chooses the bucket count and the TopN size by the skew of the sorted samples. The values sampled
+// at least twice as often as the average are kept in the TopN, so a heavy-tailed column gets a large TopN, and a
+// uniform one gets a small one. The other values are put into the buckets, whose count grows from numBuckets with the
+// coefficient of variation of their frequencies, since the estimation error of a bucket grows with it.
+func tuneBucketsAndTopN(samples []*SampleItem, getComparedBytes func(types.Datum) ([]byte, error), numBuckets int) (int, int, error) {
+	freqs := make([]float64, 0, 16)
+	var cur []byte
+	for i, sample := range samples {
+		sampleBytes, err := getComparedBytes(sample.Value)
+		if err != nil {
+			return 0, 0, errors.Trace(err)
+		}
+		if i > 0 && bytes.Equal(cur, sampleBytes) {
+			freqs[len(freqs)-1]++
+			continue
+		}
+		cur = sampleBytes
+		freqs = append(freqs, 1)
+	}
+	avg := float64(len(samples)) / float64(len(freqs))
+	topN, rest := 0, 0
+	var sum, squareSum float64
+	for _, freq := range freqs {
+		if freq >= 2*avg {
+			topN++
+			continue
+		}
+		rest++
+		sum += freq
+		squareSum += freq * freq
+	}
+	buckets := numBuckets
+	if rest > 0 {
+		mean := sum / float64(rest)
+		cv := math.Sqrt(math.Max(squareSum/float64(rest)-mean*mean, 0)) / mean
+		buckets = int(float64(numBuckets) * (1 + cv))
+	}
+	return mathutil.Min(buckets, maxSkewAwareBuckets), mathutil.Min(topN, maxSkewAwareTopN), nil
+}
+
 // pruneTopNItem tries to prune the least common values in the top-n list if it is not significantly more common than the values not in the list.
 //
 //	We assume that the ones not in the top-n list's selectivity is 1/remained_ndv which is the internal implementation of EqualRowCount
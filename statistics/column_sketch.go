@@ -0,0 +1,151 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"encoding/json"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+const (
+	// The count-min sketch on multiple columns is narrower than the one on a single column, because it is stored in
+	// the blob column `stats` of mysql.stats_extended.
+	multiColumnSketchDepth = 5
+	multiColumnSketchWidth = 512
+	// MaxMultiColumnSketchTopN is the max number of the TopN of the sketch on multiple columns.
+	MaxMultiColumnSketchTopN = 100
+	// maxMultiColumnSketchSize is the max size of the encoded sketch, which is limited by the blob column `stats`.
+	maxMultiColumnSketchSize = 65535
+)
+
+// MultiColumnSketch is the count-min sketch and TopN on the combined values of multiple columns, which is built by
+// ANALYZE for the extended stats of ast.StatsTypeSketch. The combined values are encoded like the keys of a
+// multi-column index, so the row count of the conjunctive equal conditions on the correlated columns is estimated
+// directly instead of multiplying the selectivities of the columns.
+type MultiColumnSketch struct {
+	// CMSketch and TopN are nil if all the sampled rows have null values on the columns.
+	CMSketch *CMSketch
+	TopN     *TopN
+	// NDV is the estimated number of the distinct combined values.
+	NDV int64
+	// Count is the row count of the table when the sketch is built, including the rows with null values.
+	Count int64
+}
+
+type jsonMultiColumnSketch struct {
+	Sketch []byte `json:"sketch,omitempty"`
+	NDV    int64  `json:"ndv"`
+	Count  int64  `json:"count"`
+}
+
+// EncodeMultiColumnSketch encodes the MultiColumnSketch to the string stored in mysql.stats_extended.
+func EncodeMultiColumnSketch(s *MultiColumnSketch) (string, error) {
+	js := jsonMultiColumnSketch{NDV: s.NDV, Count: s.Count}
+	if s.CMSketch != nil {
+		data, err := CMSketchToProto(s.CMSketch, s.TopN).Marshal()
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		js.Sketch = data
+	}
+	data, err := json.Marshal(js)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(data) > maxMultiColumnSketchSize {
+		return "", errors.Errorf("the sketch on multiple columns is too large, please use less topn")
+	}
+	return string(data), nil
+}
+
+// DecodeMultiColumnSketch decodes the MultiColumnSketch from the string stored in mysql.stats_extended.
+func DecodeMultiColumnSketch(str string) (*MultiColumnSketch, error) {
+	js := jsonMultiColumnSketch{}
+	if err := json.Unmarshal([]byte(str), &js); err != nil {
+		return nil, errors.Trace(err)
+	}
+	s := &MultiColumnSketch{NDV: js.NDV, Count: js.Count}
+	if js.Sketch != nil {
+		protoSketch := &tipb.CMSketch{}
+		if err := protoSketch.Unmarshal(js.Sketch); err != nil {
+			return nil, errors.Trace(err)
+		}
+		s.CMSketch, s.TopN = CMSketchAndTopNFromProto(protoSketch)
+	}
+	return s, nil
+}
+
+// BuildMultiColumnSketch builds the sketch on the columns at colOffsets of the row samples, whose columns are in the
+// same order as cols. count is the row count of the table.
+func BuildMultiColumnSketch(sc *stmtctx.StatementContext, cols []*model.ColumnInfo, colOffsets []int, samples []*ReservoirRowSampleItem, count int64, numTopN int) (*MultiColumnSketch, error) {
+	numTopN = mathutil.Min(numTopN, MaxMultiColumnSketchTopN)
+	encoded := make([][]byte, 0, len(samples))
+	vals := make([]types.Datum, len(colOffsets))
+	for _, sample := range samples {
+		hasNull := false
+		for i, offset := range colOffsets {
+			d := sample.Columns[offset]
+			if d.IsNull() {
+				hasNull = true
+				break
+			}
+			// The strings are encoded by their collation keys, so are the values of the equal conditions.
+			if d.Kind() == types.KindString {
+				d.SetString(d.GetString(), cols[offset].GetCollate())
+			}
+			vals[i] = d
+		}
+		// The rows with null values never match the equal conditions, so they are excluded from the sketch.
+		if hasNull {
+			continue
+		}
+		b, err := codec.EncodeKey(sc, nil, vals...)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, b)
+	}
+	s := &MultiColumnSketch{Count: count}
+	if len(encoded) == 0 {
+		return s, nil
+	}
+	notNullCount := uint64(float64(count) * float64(len(encoded)) / float64(len(samples)))
+	cms, topN, ndv, _ := NewCMSketchAndTopN(multiColumnSketchDepth, multiColumnSketchWidth, encoded, uint32(numTopN), notNullCount)
+	s.CMSketch, s.TopN, s.NDV = cms, topN, int64(ndv)
+	return s, nil
+}
+
+// GetRowCount estimates the row count of the rows whose columns equal to vals when the sketch is built. The values
+// should have been converted to the types of the columns.
+func (s *MultiColumnSketch) GetRowCount(sc *stmtctx.StatementContext, vals []types.Datum) (float64, error) {
+	if s.CMSketch == nil {
+		return 0, nil
+	}
+	b, err := codec.EncodeKey(sc, nil, vals...)
+	if err != nil {
+		return 0, err
+	}
+	if count, ok := s.TopN.QueryTopN(b); ok {
+		return float64(count), nil
+	}
+	return float64(s.CMSketch.QueryBytes(b)), nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tidb/util/ranger"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+const (
+	// MaxExprStatsBuckets is the max number of the buckets of the histogram on an expression.
+	// The histogram is stored in the blob column `stats` of mysql.stats_extended, so its size is limited.
+	MaxExprStatsBuckets = 256
+	// MaxExprStatsTopN is the max number of the TopN on an expression.
+	MaxExprStatsTopN = 100
+	// maxExprStatsSize is the max size of the encoded stats, which is limited by the blob column `stats`.
+	maxExprStatsSize = 65535
+)
+
+// ExprStats is the histogram and TopN on an expression of the table, which is built by
+// `ANALYZE TABLE ... UPDATE HISTOGRAM ON (expr)` and stored as the extended stats of ast.StatsTypeExpression.
+// The values of the expression are encoded like the keys of a single-column index, so the row count of the
+// ranges on the expression is estimated like the index.
+type ExprStats struct {
+	// Expr is the restored expression whose columns are not qualified by the table name.
+	Expr string
+	// Hist and TopN are nil if the expression has been registered but not analyzed yet.
+	Hist *Histogram
+	TopN *TopN
+}
+
+type jsonExprStats struct {
+	Expr      string     `json:"expr"`
+	Hist      []byte     `json:"hist,omitempty"`
+	NullCount int64      `json:"null_count,omitempty"`
+	TopN      []TopNMeta `json:"topn,omitempty"`
+}
+
+// ExprStatsName returns the name of the extended stats for the histogram on the expression.
+func ExprStatsName(expr string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(expr))
+	return fmt.Sprintf("expr_%016x", h.Sum64())
+}
+
+// EncodeExprStats encodes the ExprStats to the string stored in mysql.stats_extended.
+func EncodeExprStats(s *ExprStats) (string, error) {
+	js := jsonExprStats{Expr: s.Expr}
+	if s.Hist != nil {
+		data, err := HistogramToProto(s.Hist).Marshal()
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		js.Hist = data
+		js.NullCount = s.Hist.NullCount
+	}
+	if s.TopN != nil {
+		js.TopN = s.TopN.TopN
+	}
+	data, err := json.Marshal(js)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(data) > maxExprStatsSize {
+		return "", errors.Errorf("the statistics of the expression %s is too large, please use less buckets or topn", s.Expr)
+	}
+	return string(data), nil
+}
+
+// DecodeExprStats decodes the ExprStats from the string stored in mysql.stats_extended.
+func DecodeExprStats(str string) (*ExprStats, error) {
+	js := jsonExprStats{}
+	if err := json.Unmarshal([]byte(str), &js); err != nil {
+		return nil, errors.Trace(err)
+	}
+	s := &ExprStats{Expr: js.Expr}
+	if js.Hist != nil {
+		protoHg := &tipb.Histogram{}
+		if err := protoHg.Unmarshal(js.Hist); err != nil {
+			return nil, errors.Trace(err)
+		}
+		s.Hist = HistogramFromProto(protoHg)
+		s.Hist.NullCount = js.NullCount
+		s.Hist.PreCalculateScalar()
+	}
+	if len(js.TopN) > 0 {
+		s.TopN = &TopN{TopN: js.TopN}
+		s.TopN.Sort()
+	}
+	return s, nil
+}
+
+// BuildExprStats builds the histogram and TopN on the expression from the row samples, whose columns are in the
+// same order as the columns used to build expr. count is the row count of the table.
+func BuildExprStats(sctx sessionctx.Context, exprStr string, expr expression.Expression, samples []*ReservoirRowSampleItem, count int64, numBuckets, numTopN int) (*ExprStats, error) {
+	sc := sctx.GetSessionVars().StmtCtx
+	numBuckets = mathutil.Min(numBuckets, MaxExprStatsBuckets)
+	numTopN = mathutil.Min(numTopN, MaxExprStatsTopN)
+	collation := expr.GetType().GetCollate()
+	fms := NewFMSketch(10000)
+	items := make([]*SampleItem, 0, len(samples))
+	encoded := make([][]byte, 0, len(samples))
+	nullSamples := 0
+	for _, sample := range samples {
+		d, err := expr.Eval(chunk.MutRowFromDatums(sample.Columns).ToRow())
+		if err != nil {
+			return nil, err
+		}
+		if d.IsNull() {
+			nullSamples++
+			continue
+		}
+		// The strings are encoded by their collation keys, which is the same as the ranges built on the expression.
+		if d.Kind() == types.KindString {
+			d.SetString(d.GetString(), collation)
+		}
+		b, err := codec.EncodeKey(sc, nil, d)
+		if err != nil {
+			return nil, err
+		}
+		if err := fms.InsertValue(sc, types.NewBytesDatum(b)); err != nil {
+			return nil, err
+		}
+		items = append(items, &SampleItem{Value: types.NewBytesDatum(b)})
+		encoded = append(encoded, b)
+	}
+	var nullCount int64
+	if len(samples) > 0 {
+		nullCount = int64(float64(nullSamples) * float64(count) / float64(len(samples)))
+	}
+	collector := &SampleCollector{
+		Samples:   items,
+		NullCount: nullCount,
+		Count:     count - nullCount,
+		FMSketch:  fms,
+	}
+	hist, topN, err := BuildHistAndTopN(sctx, numBuckets, numTopN, 0, collector, types.NewFieldType(mysql.TypeBlob), false, nil)
+	if err != nil {
+		return nil, err
+	}
+	// The FMSketch only sees the samples, so the NDV of the whole table is estimated from the samples.
+	if len(encoded) > 0 {
+		ndv, _ := calculateEstimateNDV(newTopNHelper(encoded, uint32(numTopN)), uint64(collector.Count))
+		hist.NDV = mathutil.Max(hist.NDV, int64(ndv))
+	}
+	return &ExprStats{Expr: exprStr, Hist: hist, TopN: topN}, nil
+}
+
+var exprStatsIndexInfo = &model.IndexInfo{Columns: []*model.IndexColumn{{Length: types.UnspecifiedLength}}}
+
+// GetRowCount estimates the row count of the ranges on the expression.
+func (s *ExprStats) GetRowCount(sctx sessionctx.Context, ranges []*ranger.Range, realtimeRowCount, modifyCount int64) (float64, error) {
+	idx := &Index{
+		Histogram:         *s.Hist,
+		TopN:              s.TopN,
+		StatsVer:          Version2,
+		Info:              exprStatsIndexInfo,
+		StatsLoadedStatus: NewStatsFullLoadStatus(),
+	}
+	return idx.GetRowCount(sctx, nil, ranges, realtimeRowCount, modifyCount)
+}
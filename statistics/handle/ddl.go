@@ -76,8 +76,17 @@ func (h *Handle) HandleDDLEvent(t *util.Event) error {
 				return err
 			}
 		}
+	case model.ActionAlterTablePartitioning:
+		// The stats of the table are kept as the global stats, and the new partitions start with empty stats.
+		for _, def := range t.TableInfo.Partition.Definitions {
+			if err := h.insertTableStats2KV(t.TableInfo, def.ID); err != nil {
+				return err
+			}
+		}
 	case model.ActionFlashbackCluster:
 		return h.updateStatsVersion()
+	case model.ActionAddIndex:
+		return h.insertNewIndexAnalyzeJob(t.SchemaName.O, t.TableInfo.Name.O, t.IndexInfo.Name.O)
 	}
 	return nil
 }
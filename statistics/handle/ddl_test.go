@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/statistics/handle"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/mock"
@@ -263,3 +264,72 @@ PARTITION BY RANGE ( a ) (
 		}
 	})
 }
+
+func TestAnalyzeNewExpressionIndex(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	h := do.StatsHandle()
+
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b varchar(10))")
+	<-h.DDLEventCh()
+	testKit.MustExec("insert into t values (1, 'A'), (2, 'a'), (3, 'B')")
+
+	// The normal index doesn't need to be analyzed immediately.
+	testKit.MustExec("alter table t add index idx_a(a)")
+	require.Len(t, h.DDLEventCh(), 0)
+
+	testKit.MustExec("alter table t add index idx_b((lower(b)))")
+	event := <-h.DDLEventCh()
+	require.Equal(t, model.ActionAddIndex, event.Tp)
+	require.Equal(t, "idx_b", event.IndexInfo.Name.L)
+	require.NoError(t, h.HandleDDLEvent(event))
+	is := do.InfoSchema()
+	h.HandleNewIndexAnalyze(is)
+	require.NoError(t, h.Update(is))
+
+	tbl, err := is.TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	tableInfo := tbl.Meta()
+	statsTbl := h.GetTableStats(tableInfo)
+	idxStats, ok := statsTbl.Indices[event.IndexInfo.ID]
+	require.True(t, ok)
+	require.Equal(t, int64(2), idxStats.NDV)
+	_, ok = statsTbl.Indices[tableInfo.Indices[0].ID]
+	require.False(t, ok)
+}
+
+func TestAnalyzeNewExpressionIndexOnAnotherOwner(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	ddlOwnerHandle := do.StatsHandle()
+
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t (a int, b varchar(10))")
+	<-ddlOwnerHandle.DDLEventCh()
+	testKit.MustExec("insert into t values (1, 'A'), (2, 'a'), (3, 'B')")
+	testKit.MustExec("alter table t add index idx_b((lower(b)))")
+	event := <-ddlOwnerHandle.DDLEventCh()
+	require.Equal(t, model.ActionAddIndex, event.Tp)
+	require.NoError(t, ddlOwnerHandle.HandleDDLEvent(event))
+	testKit.MustQuery("select table_schema, table_name, job_info, state from mysql.analyze_jobs where instance = ''").
+		Check(testkit.Rows("test t analyze index idx_b pending"))
+
+	// The stats owner runs on another instance, or the DDL owner is restarted, so the pending analyze can only be
+	// found in the storage.
+	testKit2 := testkit.NewTestKit(t, store)
+	testKit3 := testkit.NewTestKit(t, store)
+	statsOwnerHandle, err := handle.NewHandle(testKit2.Session(), testKit3.Session(), do.StatsHandle().Lease(), do.SysSessionPool(), do.SysProcTracker(), do.ServerID)
+	require.NoError(t, err)
+	is := do.InfoSchema()
+	statsOwnerHandle.HandleNewIndexAnalyze(is)
+	testKit.MustQuery("select count(*) from mysql.analyze_jobs where instance = ''").Check(testkit.Rows("0"))
+	require.NoError(t, ddlOwnerHandle.Update(is))
+
+	tbl, err := is.TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	statsTbl := ddlOwnerHandle.GetTableStats(tbl.Meta())
+	idxStats, ok := statsTbl.Indices[event.IndexInfo.ID]
+	require.True(t, ok)
+	require.Equal(t, int64(2), idxStats.NDV)
+}
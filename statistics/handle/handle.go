@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/config"
 	ddlUtil "github.com/pingcap/tidb/ddl/util"
+	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/ast"
@@ -128,6 +129,9 @@ type Handle struct {
 	serverIDGetter func() uint64
 	// tableLocked used to store locked tables
 	tableLocked []int64
+	// newIndexRetries records how many times the pending analyze jobs of the new expression indexes have waited
+	// for the information schema. It's only accessed by the auto analyze worker.
+	newIndexRetries map[uint64]int
 }
 
 // GetTableLockedAndClearForTest for unit test only
@@ -1516,7 +1520,7 @@ func SaveTableStatsToStorage(sctx sessionctx.Context, results *statistics.Analyz
 		switch item.Tp {
 		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation:
 			statsStr = fmt.Sprintf("%f", item.ScalarVals)
-		case ast.StatsTypeDependency:
+		case ast.StatsTypeDependency, ast.StatsTypeExpression, ast.StatsTypeSketch:
 			statsStr = item.StringVals
 		}
 		if _, err = exec.ExecuteInternal(ctx, "replace into mysql.stats_extended values (%?, %?, %?, %?, %?, %?, %?)", name, item.Tp, tableID, strColIDs, statsStr, version, statistics.ExtendedStatsAnalyzed); err != nil {
@@ -1678,6 +1682,21 @@ func (h *Handle) releaseGlobalStatsReader(reader *statistics.StatsReader) error
 
 // InsertExtendedStats inserts a record into mysql.stats_extended and update version in mysql.stats_meta.
 func (h *Handle) InsertExtendedStats(statsName string, colIDs []int64, tp int, tableID int64, ifNotExists bool) (err error) {
+	return h.insertExtendedStats(statsName, colIDs, tp, tableID, ifNotExists, nil)
+}
+
+// InsertExprStats registers the histogram on the expression into mysql.stats_extended, which would be built by ANALYZE.
+// The expression is stored in the `stats` column because it is needed to build the histogram.
+func (h *Handle) InsertExprStats(statsName string, colIDs []int64, expr string, tableID int64) error {
+	stats, err := statistics.EncodeExprStats(&statistics.ExprStats{Expr: expr})
+	if err != nil {
+		return err
+	}
+	return h.insertExtendedStats(statsName, colIDs, int(ast.StatsTypeExpression), tableID, true, stats)
+}
+
+// insertExtendedStats inserts a record into mysql.stats_extended, stats is nil or the initial string value of `stats`.
+func (h *Handle) insertExtendedStats(statsName string, colIDs []int64, tp int, tableID int64, ifNotExists bool, stats interface{}) (err error) {
 	statsVer := uint64(0)
 	defer func() {
 		if err == nil && statsVer != 0 {
@@ -1716,7 +1735,8 @@ func (h *Handle) InsertExtendedStats(statsName string, colIDs []int64, tp int, t
 			}
 			return errors.Errorf("extended statistics '%s' for the specified table already exists", statsName)
 		}
-		if tp == int(currTp) && currStrColIDs == strColIDs {
+		// The histograms on different expressions may reference the same columns.
+		if tp == int(currTp) && currStrColIDs == strColIDs && tp != int(ast.StatsTypeExpression) {
 			return errors.Errorf("extended statistics '%s' with same type on same columns already exists", statsName)
 		}
 	}
@@ -1740,8 +1760,8 @@ func (h *Handle) InsertExtendedStats(statsName string, colIDs []int64, tp int, t
 	// the record from the table, tidb-b should delete the cached item synchronously. While for tidb-c, it has to wait for
 	// next `Update()` to remove the cached item then.
 	h.removeExtendedStatsItem(tableID, statsName)
-	const sql = "INSERT INTO mysql.stats_extended(name, type, table_id, column_ids, version, status) VALUES (%?, %?, %?, %?, %?, %?)"
-	if _, err = exec.ExecuteInternal(ctx, sql, statsName, tp, tableID, strColIDs, version, statistics.ExtendedStatsInited); err != nil {
+	const sql = "INSERT INTO mysql.stats_extended(name, type, table_id, column_ids, stats, version, status) VALUES (%?, %?, %?, %?, %?, %?, %?)"
+	if _, err = exec.ExecuteInternal(ctx, sql, statsName, tp, tableID, strColIDs, stats, version, statistics.ExtendedStatsInited); err != nil {
 		return err
 	}
 	return
@@ -1884,7 +1904,7 @@ func (h *Handle) BuildExtendedStats(tableID int64, cols []*model.ColumnInfo, col
 
 func (h *Handle) fillExtendedStatsItemVals(item *statistics.ExtendedStatsItem, cols []*model.ColumnInfo, collectors []*statistics.SampleCollector) *statistics.ExtendedStatsItem {
 	switch item.Tp {
-	case ast.StatsTypeCardinality, ast.StatsTypeDependency:
+	case ast.StatsTypeCardinality, ast.StatsTypeDependency, ast.StatsTypeExpression, ast.StatsTypeSketch:
 		return nil
 	case ast.StatsTypeCorrelation:
 		return h.fillExtStatsCorrVals(item, cols, collectors)
@@ -1892,6 +1912,100 @@ func (h *Handle) fillExtendedStatsItemVals(item *statistics.ExtendedStatsItem, c
 	return nil
 }
 
+// BuildExprStats builds the histograms on the expressions registered in mysql.stats_extended from the row samples and
+// puts them into statsColl. The expressions referencing the columns which are not analyzed are skipped.
+func (h *Handle) BuildExprStats(sctx sessionctx.Context, tableID int64, cols []*model.ColumnInfo, samples []*statistics.ReservoirRowSampleItem,
+	count int64, numBuckets, numTopN int, statsColl *statistics.ExtendedStatsColl) (*statistics.ExtendedStatsColl, error) {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	const sql = "SELECT name, column_ids, stats FROM mysql.stats_extended WHERE table_id = %? and type = %? and status in (%?, %?)"
+	rows, _, err := h.execRestrictedSQL(ctx, sql, tableID, ast.StatsTypeExpression, statistics.ExtendedStatsAnalyzed, statistics.ExtendedStatsInited)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	tblInfo := &model.TableInfo{Columns: cols}
+	for _, row := range rows {
+		name := row.GetString(0)
+		item := &statistics.ExtendedStatsItem{Tp: ast.StatsTypeExpression}
+		colIDs := row.GetString(1)
+		err := json.Unmarshal([]byte(colIDs), &item.ColIDs)
+		if err != nil {
+			logutil.BgLogger().Error("invalid column_ids in mysql.stats_extended, skip collecting extended stats for this row", zap.String("column_ids", colIDs), zap.Error(err))
+			continue
+		}
+		exprStats, err := statistics.DecodeExprStats(row.GetString(2))
+		if err != nil {
+			logutil.BgLogger().Error("invalid stats in mysql.stats_extended, skip collecting extended stats for this row", zap.String("name", name), zap.Error(err))
+			continue
+		}
+		expr, err := expression.ParseSimpleExprWithTableInfo(sctx, exprStats.Expr, tblInfo)
+		if err != nil {
+			logutil.BgLogger().Info("columns of the expression are not analyzed, skip collecting extended stats for this row", zap.String("name", name), zap.Error(err))
+			continue
+		}
+		item.ExprStats, err = statistics.BuildExprStats(sctx, exprStats.Expr, expr, samples, count, numBuckets, numTopN)
+		if err != nil {
+			return nil, err
+		}
+		item.StringVals, err = statistics.EncodeExprStats(item.ExprStats)
+		if err != nil {
+			return nil, err
+		}
+		if statsColl == nil {
+			statsColl = statistics.NewExtendedStatsColl()
+		}
+		statsColl.Stats[name] = item
+	}
+	return statsColl, nil
+}
+
+// BuildMultiColumnSketches builds the sketches on multiple columns registered in mysql.stats_extended from the row
+// samples and puts them into statsColl. The sketches referencing the columns which are not analyzed are skipped.
+func (h *Handle) BuildMultiColumnSketches(sctx sessionctx.Context, tableID int64, cols []*model.ColumnInfo, samples []*statistics.ReservoirRowSampleItem,
+	count int64, numTopN int, statsColl *statistics.ExtendedStatsColl) (*statistics.ExtendedStatsColl, error) {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	const sql = "SELECT name, column_ids FROM mysql.stats_extended WHERE table_id = %? and type = %? and status in (%?, %?)"
+	rows, _, err := h.execRestrictedSQL(ctx, sql, tableID, ast.StatsTypeSketch, statistics.ExtendedStatsAnalyzed, statistics.ExtendedStatsInited)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, row := range rows {
+		name := row.GetString(0)
+		item := &statistics.ExtendedStatsItem{Tp: ast.StatsTypeSketch}
+		colIDs := row.GetString(1)
+		err := json.Unmarshal([]byte(colIDs), &item.ColIDs)
+		if err != nil {
+			logutil.BgLogger().Error("invalid column_ids in mysql.stats_extended, skip collecting extended stats for this row", zap.String("column_ids", colIDs), zap.Error(err))
+			continue
+		}
+		colOffsets := make([]int, 0, len(item.ColIDs))
+		for _, id := range item.ColIDs {
+			for i, col := range cols {
+				if col.ID == id {
+					colOffsets = append(colOffsets, i)
+					break
+				}
+			}
+		}
+		if len(colOffsets) != len(item.ColIDs) {
+			logutil.BgLogger().Info("columns of the sketch are not analyzed, skip collecting extended stats for this row", zap.String("name", name))
+			continue
+		}
+		item.Sketch, err = statistics.BuildMultiColumnSketch(sctx.GetSessionVars().StmtCtx, cols, colOffsets, samples, count, numTopN)
+		if err != nil {
+			return nil, err
+		}
+		item.StringVals, err = statistics.EncodeMultiColumnSketch(item.Sketch)
+		if err != nil {
+			return nil, err
+		}
+		if statsColl == nil {
+			statsColl = statistics.NewExtendedStatsColl()
+		}
+		statsColl.Stats[name] = item
+	}
+	return statsColl, nil
+}
+
 func (h *Handle) fillExtStatsCorrVals(item *statistics.ExtendedStatsItem, cols []*model.ColumnInfo, collectors []*statistics.SampleCollector) *statistics.ExtendedStatsItem {
 	colOffsets := make([]int, 0, 2)
 	for _, id := range item.ColIDs {
@@ -1997,7 +2111,7 @@ func (h *Handle) SaveExtendedStatsToStorage(tableID int64, extStats *statistics.
 		switch item.Tp {
 		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation:
 			statsStr = fmt.Sprintf("%f", item.ScalarVals)
-		case ast.StatsTypeDependency:
+		case ast.StatsTypeDependency, ast.StatsTypeExpression, ast.StatsTypeSketch:
 			statsStr = item.StringVals
 		}
 		// If isLoad is true, it's INSERT; otherwise, it's UPDATE.
@@ -2236,6 +2236,29 @@ func TestIndexUsageInformation(t *testing.T) {
 	))
 }
 
+func TestAdminShowUnusedIndexes(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	session.SetIndexUsageSyncLease(1)
+	defer session.SetIndexUsageSyncLease(0)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t_idx(id int primary key, a int, b int, c int, unique index idx_a(a), index idx_b(b), index idx_c(c))")
+	tk.MustExec("insert into t_idx values(1, 1, 1, 1), (2, 2, 2, 2)")
+	tk.MustQuery("admin show unused indexes").Check(testkit.Rows(
+		"test t_idx idx_a 1 1",
+		"test t_idx idx_b 2 0",
+		"test t_idx idx_c 3 0",
+	))
+
+	tk.MustQuery("select a from t_idx where a = 1").Check(testkit.Rows("1"))
+	tk.MustQuery("select b from t_idx use index(idx_b) where b > 0").Sort().Check(testkit.Rows("1", "2"))
+	tk.MustQuery("admin show unused indexes").Check(testkit.Rows(
+		"test t_idx idx_c 3 0",
+	))
+	tk.MustQuery("select * from t_idx use index(idx_c) where c > 1").Check(testkit.Rows("2 2 2 2"))
+	tk.MustQuery("admin show unused indexes").Check(testkit.Rows())
+}
+
 // Functional Test:test batch insert
 func TestIndexUsageInformationMultiIndex(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
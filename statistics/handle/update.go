@@ -1169,6 +1169,89 @@ func (h *Handle) HandleAutoAnalyze(is infoschema.InfoSchema) (analyzed bool) {
 	return false
 }
 
+// maxNewIndexAnalyzeRetry is the max times to wait for the new index to be loaded by the information schema.
+const maxNewIndexAnalyzeRetry = 10
+
+// newIndexAnalyzeJobInfo is the prefix of the job info of the pending analyze jobs of the new expression indexes.
+const newIndexAnalyzeJobInfo = "analyze index "
+
+// insertNewIndexAnalyzeJob inserts a pending analyze job without instance into mysql.analyze_jobs for the new
+// expression index. The job is inserted by the DDL owner and picked up by the stats owner, which may run on
+// another TiDB instance, so it must be persisted instead of kept in memory.
+func (h *Handle) insertNewIndexAnalyzeJob(dbName, tblName, idxName string) error {
+	const sql = "INSERT INTO mysql.analyze_jobs (table_schema, table_name, job_info, state, instance) VALUES (%?, %?, %?, %?, '')"
+	_, _, err := h.execRestrictedSQL(context.Background(), sql, dbName, tblName, newIndexAnalyzeJobInfo+idxName, statistics.AnalyzePending)
+	return errors.Trace(err)
+}
+
+// finishNewIndexAnalyzeJob removes the pending analyze job of the new expression index, the analyze statement
+// executed for it records its own job.
+func (h *Handle) finishNewIndexAnalyzeJob(id uint64) {
+	delete(h.newIndexRetries, id)
+	if _, _, err := h.execRestrictedSQL(context.Background(), "DELETE FROM mysql.analyze_jobs WHERE id = %?", id); err != nil {
+		logutil.BgLogger().Error("[stats] remove the pending analyze job of new index failed", zap.Uint64("id", id), zap.Error(err))
+	}
+}
+
+// HandleNewIndexAnalyze analyzes the new expression indexes notified by DDL. The statistics of the hidden
+// expression columns can only be collected by analyzing the index, so the auto analyze worker of the stats owner
+// analyzes the new index without waiting for the modify ratio, then the optimizer can estimate the expressions
+// as soon as possible. The new indexes are read from the pending analyze jobs without instance in
+// mysql.analyze_jobs, which are inserted when the DDL owner handles the DDL event.
+func (h *Handle) HandleNewIndexAnalyze(is infoschema.InfoSchema) {
+	const sql = "SELECT id, table_schema, table_name, job_info FROM mysql.analyze_jobs WHERE state = %? AND instance = '' AND job_info LIKE %? ORDER BY id"
+	rows, _, err := h.execRestrictedSQL(context.Background(), sql, statistics.AnalyzePending, newIndexAnalyzeJobInfo+"%")
+	if err != nil {
+		logutil.BgLogger().Error("[stats] load the pending analyze jobs of new indexes failed", zap.Error(err))
+		return
+	}
+	if h.newIndexRetries == nil {
+		h.newIndexRetries = make(map[uint64]int)
+	}
+	for _, row := range rows {
+		id := row.GetUint64(0)
+		dbName, tblName := model.NewCIStr(row.GetString(1)), model.NewCIStr(row.GetString(2))
+		idxName := model.NewCIStr(strings.TrimPrefix(row.GetString(3), newIndexAnalyzeJobInfo))
+		tbl, err := is.TableByName(dbName, tblName)
+		if err != nil {
+			h.finishNewIndexAnalyzeJob(id)
+			continue
+		}
+		tblInfo := tbl.Meta()
+		idxInfo := tblInfo.FindIndexByName(idxName.L)
+		if idxInfo == nil || idxInfo.State != model.StatePublic {
+			// The information schema may be not updated yet, retry it next time.
+			if h.newIndexRetries[id] < maxNewIndexAnalyzeRetry {
+				h.newIndexRetries[id]++
+			} else {
+				h.finishNewIndexAnalyzeJob(id)
+			}
+			continue
+		}
+		// Remove the job before analyzing, a failed analyze is retried by the normal auto analyze.
+		h.finishNewIndexAnalyzeJob(id)
+		if !variable.RunAutoAnalyze.Load() || h.IsTableLocked(tblInfo.ID) {
+			continue
+		}
+		if err := h.UpdateSessionVar(); err != nil {
+			logutil.BgLogger().Error("[stats] update analyze version for analyzing new index failed", zap.Error(err))
+			continue
+		}
+		analyzeSnapshot, err := h.getAnalyzeSnapshot()
+		if err != nil {
+			logutil.BgLogger().Error("[stats] load tidb_enable_analyze_snapshot for analyzing new index failed", zap.Error(err))
+			continue
+		}
+		h.mu.RLock()
+		statsVer := h.mu.ctx.GetSessionVars().AnalyzeVersion
+		h.mu.RUnlock()
+		statistics.CheckAnalyzeVerOnTable(h.GetTableStats(tblInfo), &statsVer)
+		logutil.BgLogger().Info("[stats] analyze new expression index",
+			zap.String("table", tblInfo.Name.String()), zap.String("index", idxInfo.Name.String()))
+		h.execAutoAnalyze(statsVer, analyzeSnapshot, "analyze table %n.%n index %n", dbName.O, tblInfo.Name.O, idxInfo.Name.O)
+	}
+}
+
 func (h *Handle) autoAnalyzeTable(tblInfo *model.TableInfo, statsTbl *statistics.Table, ratio float64, analyzeSnapshot bool, sql string, params ...interface{}) bool {
 	if statsTbl.Pseudo || statsTbl.Count < AutoAnalyzeMinCnt {
 		return false
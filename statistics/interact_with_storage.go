@@ -246,6 +246,20 @@ func ExtendedStatsFromStorage(reader *StatsReader, table *Table, physicalID int6
 			} else {
 				item.StringVals = statsStr
 			}
+			if item.Tp == ast.StatsTypeExpression {
+				item.ExprStats, err = DecodeExprStats(statsStr)
+				if err != nil {
+					logutil.BgLogger().Error("[stats] decode expression stats failed", zap.String("stats", statsStr), zap.Error(err))
+					return nil, err
+				}
+			}
+			if item.Tp == ast.StatsTypeSketch {
+				item.Sketch, err = DecodeMultiColumnSketch(statsStr)
+				if err != nil {
+					logutil.BgLogger().Error("[stats] decode multi-column sketch failed", zap.String("stats", statsStr), zap.Error(err))
+					return nil, err
+				}
+			}
 			table.ExtendedStats.Stats[name] = item
 		}
 	}
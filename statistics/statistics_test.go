@@ -714,3 +714,43 @@ func TestPruneTopN(t *testing.T) {
 	topnOut = pruneTopNItem(topnIn, totalNDV, nullCnt, sampleRows, totalRows)
 	require.Equal(t, topnIn, topnOut)
 }
+
+func TestTuneBucketsAndTopN(t *testing.T) {
+	getComparedBytes := func(d types.Datum) ([]byte, error) {
+		return codec.EncodeInt(nil, d.GetInt64()), nil
+	}
+	var samples []*SampleItem
+	appendSamples := func(value int64, count int) {
+		for i := 0; i < count; i++ {
+			samples = append(samples, &SampleItem{Value: types.NewIntDatum(value)})
+		}
+	}
+
+	// The uniform column keeps the bucket count and has no TopN.
+	for i := int64(1); i <= 100; i++ {
+		appendSamples(i, 2)
+	}
+	buckets, topN, err := tuneBucketsAndTopN(samples, getComparedBytes, 16)
+	require.NoError(t, err)
+	require.Equal(t, 16, buckets)
+	require.Equal(t, 0, topN)
+
+	// The frequent value is kept in TopN, and the skew of the others increases the bucket count.
+	samples = samples[:0]
+	appendSamples(0, 100)
+	for i := int64(1); i <= 50; i++ {
+		appendSamples(i, 2)
+	}
+	for i := int64(51); i <= 100; i++ {
+		appendSamples(i, 1)
+	}
+	buckets, topN, err = tuneBucketsAndTopN(samples, getComparedBytes, 16)
+	require.NoError(t, err)
+	require.Equal(t, 21, buckets)
+	require.Equal(t, 1, topN)
+
+	// The bucket count is limited.
+	buckets, _, err = tuneBucketsAndTopN(samples, getComparedBytes, 1000)
+	require.NoError(t, err)
+	require.Equal(t, maxSkewAwareBuckets, buckets)
+}
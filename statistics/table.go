@@ -81,6 +81,10 @@ type ExtendedStatsItem struct {
 	Tp         uint8
 	ScalarVals float64
 	StringVals string
+	// ExprStats is decoded from StringVals for the extended stats of ast.StatsTypeExpression.
+	ExprStats *ExprStats
+	// Sketch is decoded from StringVals for the extended stats of ast.StatsTypeSketch.
+	Sketch *MultiColumnSketch
 }
 
 // ExtendedStatsColl is a collection of cached items for mysql.stats_extended records.
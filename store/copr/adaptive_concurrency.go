@@ -0,0 +1,143 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package copr
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/store/driver/options"
+	"github.com/tikv/client-go/v2/tikv"
+)
+
+const (
+	// adaptiveConcurrencyWorkerRatio is the ratio of the workers of a request to its concurrency when the adaptive
+	// concurrency is enabled, the extra workers let the limits of the stores grow above the concurrency.
+	adaptiveConcurrencyWorkerRatio = 2
+	// adaptiveInitLimit is the initial limit of the in-flight tasks of a store.
+	adaptiveInitLimit = 16
+	// adaptiveMinLimit and adaptiveMaxLimit are the bounds of the limit of the in-flight tasks of a store.
+	adaptiveMinLimit = 2
+	adaptiveMaxLimit = 512
+	// adaptiveLongRTTFactor is the weight of a sample in the long-term average latency, which is about the average
+	// of the latest 100 samples.
+	adaptiveLongRTTFactor = 2.0 / 101
+	// adaptiveSmoothing is the weight of the new limit when the limit is updated.
+	adaptiveSmoothing = 0.2
+	// adaptiveBackoffRatio is the ratio the limit is cut to when a task fails.
+	adaptiveBackoffRatio = 0.9
+)
+
+// storeConcurrencyLimiter limits the in-flight coprocessor tasks sent to a store by the gradient of its latency.
+// The gradient is the ratio of the long-term average latency to the latest one. While the latency keeps stable,
+// the gradient is 1 and the limit grows by its square root, which probes for more throughput; when the store
+// starts queueing, the latency rises above the average and the limit shrinks proportionally, so the store is
+// kept away from the queueing collapse.
+type storeConcurrencyLimiter struct {
+	mu       sync.Mutex
+	limit    float64
+	inflight int
+	// longRTT is the long-term average latency in nanoseconds.
+	longRTT float64
+	// waitCh is closed and reset when a task is released, to wake up the waiting tasks.
+	waitCh chan struct{}
+}
+
+func newStoreConcurrencyLimiter() *storeConcurrencyLimiter {
+	return &storeConcurrencyLimiter{limit: adaptiveInitLimit}
+}
+
+// acquire waits until the number of the in-flight tasks is less than the limit, it returns true if finishCh is
+// closed during the waiting.
+func (l *storeConcurrencyLimiter) acquire(finishCh <-chan struct{}) (exit bool) {
+	for {
+		l.mu.Lock()
+		if l.inflight < int(l.limit) {
+			l.inflight++
+			l.mu.Unlock()
+			return false
+		}
+		if l.waitCh == nil {
+			l.waitCh = make(chan struct{})
+		}
+		waitCh := l.waitCh
+		l.mu.Unlock()
+
+		select {
+		case <-waitCh:
+		case <-finishCh:
+			return true
+		}
+	}
+}
+
+// release finishes an in-flight task and updates the limit with its latency.
+func (l *storeConcurrencyLimiter) release(rtt time.Duration, failed bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// The limit is only raised when it's nearly used up, otherwise the low load can't tell whether the store can
+	// take more tasks.
+	appLimited := float64(l.inflight) < l.limit/2
+	l.inflight--
+	if failed {
+		l.limit = math.Max(adaptiveMinLimit, l.limit*adaptiveBackoffRatio)
+	} else if rtt > 0 {
+		l.update(float64(rtt), appLimited)
+	}
+	if l.waitCh != nil {
+		close(l.waitCh)
+		l.waitCh = nil
+	}
+}
+
+func (l *storeConcurrencyLimiter) update(rtt float64, appLimited bool) {
+	if l.longRTT == 0 {
+		l.longRTT = rtt
+	} else {
+		l.longRTT = l.longRTT*(1-adaptiveLongRTTFactor) + rtt*adaptiveLongRTTFactor
+	}
+	// The average drifts up when the store is overloaded for a long time, decay it faster so the limit can recover
+	// as soon as the latency goes down.
+	if l.longRTT/rtt > 2 {
+		l.longRTT *= 0.95
+	}
+	gradient := math.Max(0.5, math.Min(1, l.longRTT/rtt))
+	newLimit := l.limit*gradient + math.Sqrt(l.limit)
+	if appLimited {
+		newLimit = math.Min(newLimit, l.limit)
+	}
+	newLimit = l.limit*(1-adaptiveSmoothing) + newLimit*adaptiveSmoothing
+	l.limit = math.Max(adaptiveMinLimit, math.Min(adaptiveMaxLimit, newLimit))
+}
+
+// getLimit returns the current limit of the in-flight tasks.
+func (l *storeConcurrencyLimiter) getLimit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// getStoreLimiter returns the limiter of the leader store of the region, it returns nil if the store is unknown.
+func (s *Store) getStoreLimiter(bo *Backoffer, region tikv.RegionVerID) *storeConcurrencyLimiter {
+	rpcCtx, err := s.GetRegionCache().GetTiKVRPCContext(bo.TiKVBackoffer(), region, options.GetTiKVReplicaReadType(kv.ReplicaReadLeader), 0)
+	if err != nil || rpcCtx == nil {
+		// The task is sent without the limit, the errors are handled by the sending.
+		return nil
+	}
+	limiter, _ := s.storeLimiters.LoadOrStore(rpcCtx.Addr, newStoreConcurrencyLimiter())
+	return limiter.(*storeConcurrencyLimiter)
+}
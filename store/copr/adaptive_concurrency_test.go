@@ -0,0 +1,86 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package copr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreConcurrencyLimiterGradient(t *testing.T) {
+	l := newStoreConcurrencyLimiter()
+	finishCh := make(chan struct{})
+
+	// The limit grows while the latency keeps stable and the limit is used up.
+	for i := 0; i < 50; i++ {
+		limit := l.getLimit()
+		for j := 0; j < limit; j++ {
+			require.False(t, l.acquire(finishCh))
+		}
+		for j := 0; j < limit; j++ {
+			l.release(10*time.Millisecond, false)
+		}
+	}
+	grown := l.getLimit()
+	require.Greater(t, grown, adaptiveInitLimit)
+
+	// The limit shrinks when the latency rises.
+	for i := 0; i < 20; i++ {
+		require.False(t, l.acquire(finishCh))
+		l.release(100*time.Millisecond, false)
+	}
+	require.Less(t, l.getLimit(), grown)
+
+	// The failures cut the limit down to the lower bound at most.
+	for i := 0; i < 100; i++ {
+		require.False(t, l.acquire(finishCh))
+		l.release(0, true)
+	}
+	require.Equal(t, adaptiveMinLimit, l.getLimit())
+}
+
+func TestStoreConcurrencyLimiterWait(t *testing.T) {
+	l := newStoreConcurrencyLimiter()
+	finishCh := make(chan struct{})
+	for i := 0; i < adaptiveInitLimit; i++ {
+		require.False(t, l.acquire(finishCh))
+	}
+
+	// The task waits for a released one.
+	acquired := make(chan bool)
+	go func() {
+		acquired <- l.acquire(finishCh)
+	}()
+	select {
+	case <-acquired:
+		require.FailNow(t, "the task shouldn't be acquired above the limit")
+	case <-time.After(50 * time.Millisecond):
+	}
+	l.release(time.Millisecond, false)
+	require.False(t, <-acquired)
+
+	// The waiting task exits when the request is finished.
+	for l.getLimit() > adaptiveInitLimit {
+		l.release(0, true)
+		require.False(t, l.acquire(finishCh))
+	}
+	go func() {
+		acquired <- l.acquire(finishCh)
+	}()
+	close(finishCh)
+	require.True(t, <-acquired)
+}
@@ -50,6 +50,7 @@ import (
 	"github.com/pingcap/tidb/util/trxevents"
 	"github.com/pingcap/tipb/go-tipb"
 	"github.com/tikv/client-go/v2/metrics"
+	"github.com/tikv/client-go/v2/oracle"
 	"github.com/tikv/client-go/v2/tikv"
 	"github.com/tikv/client-go/v2/tikvrpc"
 	"github.com/tikv/client-go/v2/txnkv/txnlock"
@@ -183,7 +184,13 @@ func (c *CopClient) BuildCopIterator(ctx context.Context, req *kv.Request, vars
 		rpcCancel:        tikv.NewRPCanceller(),
 		buildTaskElapsed: *buildOpt.elapsed,
 	}
+	it.snapshot.Store(&copSnapshot{startTS: req.StartTs, resolvedLocks: &it.resolvedLocks, committedLocks: &it.committedLocks})
 	it.tasks = tasks
+	if req.AdaptiveConcurrency && !req.KeepOrder && req.StoreType == kv.TiKV {
+		// The in-flight tasks are limited by the stores, start more workers so the limits can grow above the
+		// concurrency when the stores are not busy.
+		it.concurrency *= adaptiveConcurrencyWorkerRatio
+	}
 	if it.concurrency > len(tasks) {
 		it.concurrency = len(tasks)
 	}
@@ -674,6 +681,8 @@ type copIterator struct {
 
 	resolvedLocks  util.TSSet
 	committedLocks util.TSSet
+	// snapshot is the latest snapshot read by the workers, it's refreshed if req.SnapshotRefreshInterval is set.
+	snapshot atomic.Pointer[copSnapshot]
 
 	actionOnExceed *rateLimitAction
 	pagingTaskIdx  uint32
@@ -703,6 +712,18 @@ type copIteratorWorker struct {
 
 	storeBatchedNum         *atomic.Uint64
 	storeBatchedFallbackNum *atomic.Uint64
+
+	// snapshot is shared by the workers of the iterator, curSnapshot is the one read by the current task.
+	snapshot    *atomic.Pointer[copSnapshot]
+	curSnapshot *copSnapshot
+}
+
+// copSnapshot is the snapshot read by the coprocessor tasks. The locks resolved for a snapshot can't be reused by
+// the newer ones, because the transactions committed after the old snapshot may be visible to the new snapshot.
+type copSnapshot struct {
+	startTS        uint64
+	resolvedLocks  *util.TSSet
+	committedLocks *util.TSSet
 }
 
 // copIteratorTaskSender sends tasks to taskCh then wait for the workers to exit.
@@ -815,6 +836,7 @@ func (it *copIterator) open(ctx context.Context, enabledRateLimitAction, enableC
 	smallTaskCh := make(chan *copTask, 1)
 	it.wg.Add(it.concurrency + it.smallTaskConcurrency)
 	// Start it.concurrency number of workers to handle cop requests.
+	snapshot := it.snapshot.Load()
 	for i := 0; i < it.concurrency+it.smallTaskConcurrency; i++ {
 		var ch chan *copTask
 		if i < it.concurrency {
@@ -830,13 +852,15 @@ func (it *copIterator) open(ctx context.Context, enabledRateLimitAction, enableC
 			respChan:                   it.respChan,
 			finishCh:                   it.finishCh,
 			vars:                       it.vars,
-			kvclient:                   txnsnapshot.NewClientHelper(it.store.store, &it.resolvedLocks, &it.committedLocks, false),
+			kvclient:                   txnsnapshot.NewClientHelper(it.store.store, snapshot.resolvedLocks, snapshot.committedLocks, false),
 			memTracker:                 it.memTracker,
 			replicaReadSeed:            it.replicaReadSeed,
 			enableCollectExecutionInfo: enableCollectExecutionInfo,
 			pagingTaskIdx:              &it.pagingTaskIdx,
 			storeBatchedNum:            &it.storeBatchedNum,
 			storeBatchedFallbackNum:    &it.storeBatchedFallbackNum,
+			snapshot:                   &it.snapshot,
+			curSnapshot:                snapshot,
 		}
 		go worker.run(ctx)
 	}
@@ -1064,7 +1088,7 @@ func (it *copIterator) Next(ctx context.Context) (kv.ResultSubset, error) {
 		return nil, errors.Trace(resp.err)
 	}
 
-	err := it.store.CheckVisibility(it.req.StartTs)
+	err := it.store.CheckVisibility(it.snapshot.Load().startTS)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -1101,6 +1125,12 @@ func (worker *copIteratorWorker) handleTask(ctx context.Context, task *copTask,
 	for len(remainTasks) > 0 {
 		curTask := remainTasks[0]
 		bo := chooseBackoffer(ctx, backoffermap, curTask, worker)
+		err := worker.refreshSnapshot(bo)
+		if err != nil {
+			resp := &copResponse{err: errors.Trace(err)}
+			worker.sendToRespCh(resp, respCh, true)
+			return
+		}
 		tasks, err := worker.handleTaskOnce(bo, curTask, respCh)
 		if err != nil {
 			resp := &copResponse{err: errors.Trace(err)}
@@ -1121,6 +1151,33 @@ func (worker *copIteratorWorker) handleTask(ctx context.Context, task *copTask,
 	}
 }
 
+// refreshSnapshot refreshes the snapshot read by the following tasks if the snapshot is older than
+// req.SnapshotRefreshInterval, so the long-running request isn't broken by the GC safe point advancing.
+func (worker *copIteratorWorker) refreshSnapshot(bo *Backoffer) error {
+	interval := worker.req.SnapshotRefreshInterval
+	if interval <= 0 || worker.req.IsStaleness {
+		return nil
+	}
+	latest := worker.snapshot.Load()
+	if time.Since(oracle.GetTimeFromTS(latest.startTS)) >= interval {
+		ts, err := worker.store.store.GetOracle().GetTimestamp(bo.GetCtx(), &oracle.Option{TxnScope: oracle.GlobalTxnScope})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		refreshed := &copSnapshot{startTS: ts, resolvedLocks: &util.TSSet{}, committedLocks: &util.TSSet{}}
+		if worker.snapshot.CompareAndSwap(latest, refreshed) {
+			logutil.Logger(bo.GetCtx()).Info("refresh the snapshot of the coprocessor request",
+				zap.Uint64("txnStartTS", worker.req.StartTs), zap.Uint64("oldTS", latest.startTS), zap.Uint64("newTS", ts))
+		}
+		latest = worker.snapshot.Load()
+	}
+	if latest != worker.curSnapshot {
+		worker.curSnapshot = latest
+		worker.kvclient = txnsnapshot.NewClientHelper(worker.store.store, latest.resolvedLocks, latest.committedLocks, false)
+	}
+	return nil
+}
+
 // handleTaskOnce handles single copTask, successful results are send to channel.
 // If error happened, returns error. If region split or meet lock, returns the remain tasks.
 func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch chan<- *copResponse) ([]*copTask, error) {
@@ -1136,7 +1193,7 @@ func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch
 
 	copReq := coprocessor.Request{
 		Tp:         worker.req.Tp,
-		StartTs:    worker.req.StartTs,
+		StartTs:    worker.curSnapshot.startTS,
 		Data:       worker.req.Data,
 		Ranges:     task.ranges.ToPBRanges(),
 		SchemaVer:  worker.req.SchemaVar,
@@ -1162,6 +1219,13 @@ func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch
 		worker.req.ResourceGroupTagger(req)
 	}
 	req.StoreTp = getEndPointType(task.storeType)
+	var limiter *storeConcurrencyLimiter
+	if worker.req.AdaptiveConcurrency && task.storeType == kv.TiKV && task.redirect2Replica == nil && !worker.req.ReplicaRead.IsFollowerRead() {
+		limiter = worker.store.getStoreLimiter(bo, task.region)
+		if limiter != nil && limiter.acquire(worker.finishCh) {
+			return nil, nil
+		}
+	}
 	startTime := time.Now()
 	if worker.kvclient.Stats == nil {
 		worker.kvclient.Stats = make(map[tikvrpc.CmdType]*tikv.RPCRuntimeStats)
@@ -1181,6 +1245,9 @@ func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch
 		ops = append(ops, tikv.WithMatchStores([]uint64{*task.redirect2Replica}))
 	}
 	resp, rpcCtx, storeAddr, err := worker.kvclient.SendReqCtx(bo.TiKVBackoffer(), req, task.region, tikv.ReadTimeoutMedium, getEndPointType(task.storeType), task.storeAddr, ops...)
+	if limiter != nil {
+		limiter.release(time.Since(startTime), err != nil)
+	}
 	err = derr.ToTiDBErr(err)
 	if err != nil {
 		if task.storeType == kv.TiDB {
@@ -1541,7 +1608,7 @@ func (worker *copIteratorWorker) handleLockErr(bo *Backoffer, lockErr *kvrpcpb.L
 			zap.Stringer("lock", lockErr))
 	}
 	resolveLocksOpts := txnlock.ResolveLocksOptions{
-		CallerStartTS: worker.req.StartTs,
+		CallerStartTS: worker.curSnapshot.startTS,
 		Locks:         []*txnlock.Lock{txnlock.NewLock(lockErr)},
 		Detail:        resolveLockDetail,
 	}
@@ -1569,7 +1636,7 @@ func (worker *copIteratorWorker) buildCacheKey(task *copTask, copReq *coprocesso
 			cValue := worker.store.coprCache.Get(cKey)
 			copReq.IsCacheEnabled = true
 
-			if cValue != nil && cValue.RegionID == task.region.GetID() && cValue.TimeStamp <= worker.req.StartTs {
+			if cValue != nil && cValue.RegionID == task.region.GetID() && cValue.TimeStamp <= worker.curSnapshot.startTS {
 				// Append cache version to the request to skip Coprocessor computation if possible
 				// when request result is cached
 				copReq.CacheIfMatchVersion = cValue.RegionDataVersion
@@ -1627,7 +1694,7 @@ func (worker *copIteratorWorker) handleCopCache(task *copTask, resp *copResponse
 
 				newCacheValue := coprCacheValue{
 					Data:              data,
-					TimeStamp:         worker.req.StartTs,
+					TimeStamp:         worker.curSnapshot.startTS,
 					RegionID:          task.region.GetID(),
 					RegionDataVersion: resp.pbResp.CacheLastVersion,
 				}
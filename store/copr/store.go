@@ -18,6 +18,7 @@ import (
 	"context"
 	"math/rand"
 	"runtime"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -79,6 +80,9 @@ type Store struct {
 	coprCache       *coprCache
 	replicaReadSeed uint32
 	numcpu          int
+	// storeLimiters maps the address of a TiKV store to its *storeConcurrencyLimiter, it's used by the requests
+	// with the adaptive concurrency.
+	storeLimiters sync.Map
 }
 
 // NewStore creates a new store instance.
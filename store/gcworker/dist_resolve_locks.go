@@ -0,0 +1,421 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcworker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/metrics"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/session"
+	tidbutil "github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"github.com/tikv/client-go/v2/tikv"
+	"go.uber.org/zap"
+)
+
+// The locks are resolved by the GC workers of all the TiDB servers when tikv_gc_dist_resolve_locks is enabled. The GC
+// leader splits the whole key space into the subtasks by the regions, and writes them into tidb_background_subtask
+// like the distributed backfill jobs. The GC workers claim the subtasks with leases, so the subtasks of a failed
+// server are taken over by the others after the leases expire. A finished subtask is deleted, and the leader waits
+// until all the subtasks are finished, or any of them fails.
+const (
+	gcDistResolveLocksKey     = "tikv_gc_dist_resolve_locks"
+	gcDefaultDistResolveLocks = false
+
+	// resolveLocksTaskRegions is the number of the regions whose locks are resolved by a subtask.
+	resolveLocksTaskRegions = 256
+	// resolveLocksTaskInsertBatch is the number of the subtasks inserted by a statement.
+	resolveLocksTaskInsertBatch = 128
+
+	resolveLocksTaskPollInterval  = 5 * time.Second
+	resolveLocksTaskCheckInterval = time.Second
+	resolveLocksTaskRenewInterval = 20 * time.Second
+
+	resolveLocksTaskStatePending = "pending"
+	resolveLocksTaskStateFailed  = "failed"
+)
+
+const (
+	selectResolveLocksTaskSQL = `SELECT id, meta FROM mysql.tidb_background_subtask
+		WHERE namespace = %? AND state = %? AND (exec_id = '' OR exec_expired < %?) ORDER BY id LIMIT 1 FOR UPDATE`
+	claimResolveLocksTaskSQL   = "UPDATE mysql.tidb_background_subtask SET exec_id = %?, exec_expired = %? WHERE id = %?"
+	renewResolveLocksTaskSQL   = "UPDATE mysql.tidb_background_subtask SET exec_expired = %? WHERE id = %? AND exec_id = %?"
+	failResolveLocksTaskSQL    = "UPDATE mysql.tidb_background_subtask SET state = %?, meta = %? WHERE id = %? AND exec_id = %?"
+	finishResolveLocksTaskSQL  = "DELETE FROM mysql.tidb_background_subtask WHERE id = %? AND exec_id = %?"
+	deleteResolveLocksTasksSQL = "DELETE FROM mysql.tidb_background_subtask WHERE namespace = %?"
+)
+
+// resolveLocksTaskMeta is the meta of a subtask, which resolves the locks in the key range.
+type resolveLocksTaskMeta struct {
+	StartKey          []byte `json:"start_key"`
+	EndKey            []byte `json:"end_key"`
+	SafePoint         uint64 `json:"safe_point"`
+	TryResolveLocksTS uint64 `json:"try_resolve_locks_ts"`
+	// Error is the error message of the failed subtask.
+	Error string `json:"error,omitempty"`
+}
+
+type resolveLocksTask struct {
+	id   int64
+	meta resolveLocksTaskMeta
+}
+
+func (w *GCWorker) checkUseDistResolveLocks() (bool, error) {
+	return w.loadBooleanWithDefault(gcDistResolveLocksKey, gcDefaultDistResolveLocks)
+}
+
+func execResolveLocksTaskSQL(ctx context.Context, se session.Session, sql string, args ...interface{}) ([]chunk.Row, error) {
+	rs, err := se.ExecuteInternal(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if rs == nil {
+		return nil, nil
+	}
+	defer terror.Call(rs.Close)
+	rows, err := sqlexec.DrainRecordSet(ctx, rs, 8)
+	return rows, errors.Trace(err)
+}
+
+// distResolveLocks resolves the locks of the whole TiKV cluster with the GC workers of all the TiDB servers.
+func (w *GCWorker) distResolveLocks(ctx context.Context, safePoint, tryResolveLocksTS uint64, concurrency int) error {
+	metrics.GCWorkerCounter.WithLabelValues("resolve_locks").Inc()
+	logutil.Logger(ctx).Info("[gc worker] start distributed resolve locks",
+		zap.String("uuid", w.uuid),
+		zap.Uint64("safePoint", safePoint),
+		zap.Uint64("try-resolve-locks-ts", tryResolveLocksTS),
+		zap.Int("concurrency", concurrency))
+	startTime := time.Now()
+
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnGC)
+	se := createSession(w.store)
+	defer se.Close()
+	// The subtasks left by the previous rounds are useless, since they resolve the locks before the old safe points.
+	if _, err := execResolveLocksTaskSQL(ctx, se, deleteResolveLocksTasksSQL, ddl.BackgroundSubtaskGCNamespace); err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		// The unfinished subtasks are deleted if the leader fails, so that the workers holding them quit.
+		cleanCtx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnGC)
+		if _, err := execResolveLocksTaskSQL(cleanCtx, se, deleteResolveLocksTasksSQL, ddl.BackgroundSubtaskGCNamespace); err != nil {
+			logutil.Logger(ctx).Warn("[gc worker] failed to delete the resolve locks subtasks", zap.String("uuid", w.uuid), zap.Error(err))
+		}
+	}()
+
+	taskCnt, err := w.splitResolveLocksTasks(ctx, se, safePoint, tryResolveLocksTS)
+	if err != nil {
+		logutil.Logger(ctx).Error("[gc worker] failed to split the resolve locks subtasks",
+			zap.String("uuid", w.uuid),
+			zap.Uint64("safePoint", safePoint),
+			zap.Error(err))
+		return errors.Trace(err)
+	}
+
+	// The leader runs the subtasks as well.
+	workerCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.runResolveLocksTasks(workerCtx, concurrency)
+	}()
+	err = w.waitResolveLocksTasks(ctx, se)
+	cancel()
+	wg.Wait()
+	if err != nil {
+		logutil.Logger(ctx).Error("[gc worker] distributed resolve locks failed",
+			zap.String("uuid", w.uuid),
+			zap.Uint64("safePoint", safePoint),
+			zap.Error(err))
+		return errors.Trace(err)
+	}
+
+	logutil.Logger(ctx).Info("[gc worker] finish distributed resolve locks",
+		zap.String("uuid", w.uuid),
+		zap.Uint64("safePoint", safePoint),
+		zap.Uint64("try-resolve-locks-ts", tryResolveLocksTS),
+		zap.Int("subtasks", taskCnt))
+	metrics.GCHistogram.WithLabelValues("resolve_locks").Observe(time.Since(startTime).Seconds())
+	return nil
+}
+
+// splitResolveLocksTasks splits the whole key space into the subtasks by the regions, and returns the number of the
+// subtasks.
+func (w *GCWorker) splitResolveLocksTasks(ctx context.Context, se session.Session, safePoint, tryResolveLocksTS uint64) (int, error) {
+	var (
+		taskCnt int
+		sql     strings.Builder
+		args    []interface{}
+	)
+	insertTasks := func() error {
+		if len(args) == 0 {
+			return nil
+		}
+		_, err := execResolveLocksTaskSQL(ctx, se, sql.String(), args...)
+		sql.Reset()
+		args = args[:0]
+		return errors.Trace(err)
+	}
+
+	bo := tikv.NewGcResolveLockMaxBackoffer(ctx)
+	var startKey []byte
+	for {
+		endKey, err := w.tikvStore.GetRegionCache().BatchLoadRegionsFromKey(bo, startKey, resolveLocksTaskRegions)
+		if err != nil {
+			return taskCnt, errors.Trace(err)
+		}
+		meta, err := json.Marshal(&resolveLocksTaskMeta{
+			StartKey:          startKey,
+			EndKey:            endKey,
+			SafePoint:         safePoint,
+			TryResolveLocksTS: tryResolveLocksTS,
+		})
+		if err != nil {
+			return taskCnt, errors.Trace(err)
+		}
+		if len(args) == 0 {
+			sql.WriteString("INSERT INTO mysql.tidb_background_subtask (namespace, task_key, type, exec_id, state, checkpoint, meta) VALUES ")
+		} else {
+			sql.WriteString(", ")
+		}
+		sql.WriteString("(%?, %?, 0, '', %?, '', %?)")
+		args = append(args, ddl.BackgroundSubtaskGCNamespace, fmt.Sprintf("gc_%d_%d", safePoint, taskCnt), resolveLocksTaskStatePending, meta)
+		taskCnt++
+		if taskCnt%resolveLocksTaskInsertBatch == 0 {
+			if err = insertTasks(); err != nil {
+				return taskCnt, err
+			}
+		}
+		// Empty end key means the last region is loaded.
+		if len(endKey) == 0 {
+			break
+		}
+		startKey = endKey
+	}
+	return taskCnt, insertTasks()
+}
+
+// waitResolveLocksTasks waits until all the subtasks are finished, it returns the error of the failed subtask.
+func (w *GCWorker) waitResolveLocksTasks(ctx context.Context, se session.Session) error {
+	ticker := time.NewTicker(resolveLocksTaskCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.New("[gc worker] gc job canceled")
+		case <-ticker.C:
+		}
+		rows, err := execResolveLocksTaskSQL(ctx, se, "SELECT meta FROM mysql.tidb_background_subtask WHERE namespace = %? AND state = %? LIMIT 1",
+			ddl.BackgroundSubtaskGCNamespace, resolveLocksTaskStateFailed)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(rows) > 0 {
+			var meta resolveLocksTaskMeta
+			if err = json.Unmarshal(rows[0].GetBytes(0), &meta); err != nil {
+				return errors.Trace(err)
+			}
+			return errors.Errorf("[gc worker] resolve locks in [%x, %x) failed: %s", meta.StartKey, meta.EndKey, meta.Error)
+		}
+		rows, err = execResolveLocksTaskSQL(ctx, se, "SELECT COUNT(1) FROM mysql.tidb_background_subtask WHERE namespace = %?",
+			ddl.BackgroundSubtaskGCNamespace)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if rows[0].GetInt64(0) == 0 {
+			return nil
+		}
+	}
+}
+
+// resolveLocksTaskLoop runs the subtasks split by the GC leader on every TiDB server.
+func (w *GCWorker) resolveLocksTaskLoop(ctx context.Context) {
+	defer tidbutil.Recover(metrics.LabelGCWorker, "resolveLocksTaskLoop", nil, false)
+
+	ticker := time.NewTicker(resolveLocksTaskPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		se := createSession(w.store)
+		rows, err := execResolveLocksTaskSQL(ctx, se, "SELECT 1 FROM mysql.tidb_background_subtask WHERE namespace = %? AND state = %? LIMIT 1",
+			ddl.BackgroundSubtaskGCNamespace, resolveLocksTaskStatePending)
+		se.Close()
+		if err != nil {
+			logutil.Logger(ctx).Warn("[gc worker] failed to check the resolve locks subtasks", zap.String("uuid", w.uuid), zap.Error(err))
+			continue
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		concurrency, err := w.getGCConcurrency(ctx)
+		if err != nil {
+			logutil.Logger(ctx).Warn("[gc worker] failed to get gc concurrency", zap.String("uuid", w.uuid), zap.Error(err))
+			continue
+		}
+		w.runResolveLocksTasks(ctx, concurrency)
+	}
+}
+
+// runResolveLocksTasks runs the subtasks with the workers until there is no subtask to claim.
+func (w *GCWorker) runResolveLocksTasks(ctx context.Context, concurrency int) {
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			se := createSession(w.store)
+			defer se.Close()
+			for ctx.Err() == nil {
+				task, err := w.claimResolveLocksTask(ctx, se)
+				if err != nil {
+					logutil.Logger(ctx).Warn("[gc worker] failed to claim a resolve locks subtask", zap.String("uuid", w.uuid), zap.Error(err))
+					return
+				}
+				if task == nil {
+					return
+				}
+				if err = w.runResolveLocksTask(ctx, se, task); err != nil {
+					logutil.Logger(ctx).Warn("[gc worker] failed to run the resolve locks subtask",
+						zap.String("uuid", w.uuid), zap.Int64("id", task.id), zap.Error(err))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// claimResolveLocksTask claims a pending subtask which isn't run by any worker, or whose lease is expired.
+func (w *GCWorker) claimResolveLocksTask(ctx context.Context, se session.Session) (task *resolveLocksTask, err error) {
+	now, err := ddl.GetOracleTime(w.store)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if _, err = se.ExecuteInternal(ctx, "BEGIN PESSIMISTIC"); err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() {
+		if err != nil {
+			_, err1 := se.ExecuteInternal(ctx, "ROLLBACK")
+			if err1 != nil {
+				logutil.Logger(ctx).Warn("[gc worker] failed to rollback", zap.Error(err1))
+			}
+			return
+		}
+		_, err = se.ExecuteInternal(ctx, "COMMIT")
+		if err != nil {
+			task = nil
+		}
+	}()
+
+	rows, err := execResolveLocksTaskSQL(ctx, se, selectResolveLocksTaskSQL, ddl.BackgroundSubtaskGCNamespace,
+		resolveLocksTaskStatePending, ddl.GetLeaseGoTime(now, 0).String())
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	task = &resolveLocksTask{id: rows[0].GetInt64(0)}
+	if err = json.Unmarshal(rows[0].GetBytes(1), &task.meta); err != nil {
+		return nil, errors.Trace(err)
+	}
+	_, err = execResolveLocksTaskSQL(ctx, se, claimResolveLocksTaskSQL, w.uuid, ddl.GetLeaseGoTime(now, ddl.InstanceLease).String(), task.id)
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// runResolveLocksTask resolves the locks in the key range of the subtask, and renews its lease periodically. The
+// subtask is deleted when it's finished, or marked as failed with the error.
+func (w *GCWorker) runResolveLocksTask(ctx context.Context, se session.Session, task *resolveLocksTask) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	renewDone := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.renewResolveLocksTaskLease(runCtx, cancel, task, renewDone)
+	}()
+	_, err := w.resolveLocksForRange(runCtx, task.meta.SafePoint, task.meta.TryResolveLocksTS, task.meta.StartKey, task.meta.EndKey)
+	close(renewDone)
+	wg.Wait()
+
+	if err == nil {
+		_, err = execResolveLocksTaskSQL(ctx, se, finishResolveLocksTaskSQL, task.id, w.uuid)
+		return errors.Trace(err)
+	}
+	if ctx.Err() != nil || runCtx.Err() != nil {
+		// The worker is closed, or the subtask is taken over by another worker or deleted by the leader.
+		return errors.Trace(err)
+	}
+	task.meta.Error = err.Error()
+	meta, err1 := json.Marshal(&task.meta)
+	if err1 != nil {
+		return errors.Trace(err1)
+	}
+	_, err1 = execResolveLocksTaskSQL(ctx, se, failResolveLocksTaskSQL, resolveLocksTaskStateFailed, meta, task.id, w.uuid)
+	if err1 != nil {
+		logutil.Logger(ctx).Warn("[gc worker] failed to mark the resolve locks subtask as failed",
+			zap.String("uuid", w.uuid), zap.Int64("id", task.id), zap.Error(err1))
+	}
+	return errors.Trace(err)
+}
+
+// renewResolveLocksTaskLease renews the lease of the running subtask until done is closed. It cancels the subtask if
+// the lease is lost.
+func (w *GCWorker) renewResolveLocksTaskLease(ctx context.Context, cancel context.CancelFunc, task *resolveLocksTask, done <-chan struct{}) {
+	se := createSession(w.store)
+	defer se.Close()
+	ticker := time.NewTicker(resolveLocksTaskRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		}
+		now, err := ddl.GetOracleTime(w.store)
+		if err == nil {
+			_, err = execResolveLocksTaskSQL(ctx, se, renewResolveLocksTaskSQL, ddl.GetLeaseGoTime(now, ddl.InstanceLease).String(), task.id, w.uuid)
+		}
+		if err != nil {
+			logutil.Logger(ctx).Warn("[gc worker] failed to renew the lease of the resolve locks subtask",
+				zap.String("uuid", w.uuid), zap.Int64("id", task.id), zap.Error(err))
+			continue
+		}
+		if se.GetSessionVars().StmtCtx.AffectedRows() == 0 {
+			logutil.Logger(ctx).Info("[gc worker] the resolve locks subtask is lost",
+				zap.String("uuid", w.uuid), zap.Int64("id", task.id))
+			cancel()
+			return
+		}
+	}
+}
@@ -119,6 +119,7 @@ func (w *GCWorker) Start() {
 	wg.Add(1)
 	go w.start(ctx, &wg)
 	wg.Wait() // Wait create session finish in worker, some test code depend on this to avoid race.
+	go w.resolveLocksTaskLoop(ctx)
 }
 
 // Close stops background goroutines.
@@ -185,18 +186,19 @@ const (
 var gcSafePointCacheInterval = tikv.GcSafePointCacheInterval
 
 var gcVariableComments = map[string]string{
-	gcLeaderUUIDKey:      "Current GC worker leader UUID. (DO NOT EDIT)",
-	gcLeaderDescKey:      "Host name and pid of current GC leader. (DO NOT EDIT)",
-	gcLeaderLeaseKey:     "Current GC worker leader lease. (DO NOT EDIT)",
-	gcLastRunTimeKey:     "The time when last GC starts. (DO NOT EDIT)",
-	gcRunIntervalKey:     "GC run interval, at least 10m, in Go format.",
-	gcLifeTimeKey:        "All versions within life time will not be collected by GC, at least 10m, in Go format.",
-	gcSafePointKey:       "All versions after safe point can be accessed. (DO NOT EDIT)",
-	gcConcurrencyKey:     "How many goroutines used to do GC parallel, [1, 128], default 2",
-	gcEnableKey:          "Current GC enable status",
-	gcModeKey:            "Mode of GC, \"central\" or \"distributed\"",
-	gcAutoConcurrencyKey: "Let TiDB pick the concurrency automatically. If set false, tikv_gc_concurrency will be used",
-	gcScanLockModeKey:    "Mode of scanning locks, \"physical\" or \"legacy\"",
+	gcLeaderUUIDKey:       "Current GC worker leader UUID. (DO NOT EDIT)",
+	gcLeaderDescKey:       "Host name and pid of current GC leader. (DO NOT EDIT)",
+	gcLeaderLeaseKey:      "Current GC worker leader lease. (DO NOT EDIT)",
+	gcLastRunTimeKey:      "The time when last GC starts. (DO NOT EDIT)",
+	gcRunIntervalKey:      "GC run interval, at least 10m, in Go format.",
+	gcLifeTimeKey:         "All versions within life time will not be collected by GC, at least 10m, in Go format.",
+	gcSafePointKey:        "All versions after safe point can be accessed. (DO NOT EDIT)",
+	gcConcurrencyKey:      "How many goroutines used to do GC parallel, [1, 128], default 2",
+	gcEnableKey:           "Current GC enable status",
+	gcModeKey:             "Mode of GC, \"central\" or \"distributed\"",
+	gcAutoConcurrencyKey:  "Let TiDB pick the concurrency automatically. If set false, tikv_gc_concurrency will be used",
+	gcScanLockModeKey:     "Mode of scanning locks, \"physical\" or \"legacy\"",
+	gcDistResolveLocksKey: "Let all TiDB servers resolve the locks in the key ranges split by the GC leader",
 }
 
 const (
@@ -376,6 +378,7 @@ func (w *GCWorker) runKeyspaceDeleteRange(ctx context.Context, concurrency int)
 		metrics.GCJobFailureCounter.WithLabelValues("redo_delete_range").Inc()
 		return errors.Trace(err)
 	}
+	w.updateDeleteRangeMetrics(ctx, safePoint)
 
 	return nil
 }
@@ -801,6 +804,7 @@ func (w *GCWorker) runGCJob(ctx context.Context, safePoint uint64, concurrency i
 		metrics.GCJobFailureCounter.WithLabelValues("redo_delete_range").Inc()
 		return errors.Trace(err)
 	}
+	w.updateDeleteRangeMetrics(ctx, safePoint)
 
 	if w.checkUseDistributedGC() {
 		err = w.uploadSafePointToPD(ctx, safePoint)
@@ -947,6 +951,23 @@ func (w *GCWorker) redoDeleteRanges(ctx context.Context, safePoint uint64, concu
 	return nil
 }
 
+// updateDeleteRangeMetrics updates the gauge of the delete range tasks left after deleteRanges and redoDeleteRanges,
+// so the tasks which hold the disk space for a long time can be found.
+func (w *GCWorker) updateDeleteRangeMetrics(ctx context.Context, safePoint uint64) {
+	se := createSession(w.store)
+	counts, err := util.CountDeleteRanges(ctx, se, safePoint)
+	se.Close()
+	if err != nil {
+		logutil.Logger(ctx).Warn("[gc worker] failed to count delete ranges",
+			zap.String("uuid", w.uuid),
+			zap.Error(err))
+		return
+	}
+	for state, count := range counts {
+		metrics.GCDeleteRangeGauge.WithLabelValues(state).Set(float64(count))
+	}
+}
+
 func (w *GCWorker) doUnsafeDestroyRangeRequest(ctx context.Context, startKey []byte, endKey []byte, concurrency int) error {
 	// Get all stores every time deleting a region. So the store list is less probably to be stale.
 	stores, err := w.getStoresForGC(ctx)
@@ -1171,6 +1192,13 @@ func (w *GCWorker) resolveLocks(ctx context.Context, safePoint uint64, concurren
 	}
 
 	if !usePhysical {
+		useDist, err := w.checkUseDistResolveLocks()
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		if useDist {
+			return false, w.distResolveLocks(ctx, safePoint, tryResolveLocksTS, concurrency)
+		}
 		return false, w.legacyResolveLocks(ctx, safePoint, tryResolveLocksTS, concurrency)
 	}
 
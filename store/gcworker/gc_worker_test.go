@@ -1102,6 +1102,53 @@ func TestResolveLockRangeMeetRegionEnlargeCausedByRegionMerge(t *testing.T) {
 	}
 }
 
+func TestDistResolveLocks(t *testing.T) {
+	s := createGCWorkerSuite(t)
+	region2 := s.cluster.AllocID()
+	newPeers := []uint64{s.cluster.AllocID(), s.cluster.AllocID(), s.cluster.AllocID()}
+	s.cluster.Split(s.initRegion.regionID, region2, []byte("m"), newPeers, newPeers[0])
+	require.NoError(t, s.gcWorker.saveValueToSysTable(gcDistResolveLocksKey, booleanTrue))
+
+	var mu sync.Mutex
+	resolvedRegions := make(map[uint64]int)
+	s.gcWorker.testingKnobs.scanLocks = func(key []byte, regionID uint64, maxVersion uint64) []*txnlock.Lock {
+		return []*txnlock.Lock{{Key: append(append([]byte{}, key...), 'a'), TxnID: 1}}
+	}
+	s.gcWorker.testingKnobs.batchResolveLocks = func(locks []*txnlock.Lock, regionID tikv.RegionVerID, safepoint uint64) (bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		resolvedRegions[regionID.GetID()] += len(locks)
+		return true, nil
+	}
+	s.gcWorker.testingKnobs.resolveLocks = func(locks []*txnlock.Lock, lowResolutionTS uint64) (int64, error) {
+		return 0, nil
+	}
+	countTasks := func() int64 {
+		se := createSession(s.store)
+		defer se.Close()
+		rows, err := execResolveLocksTaskSQL(gcContext(), se, "select count(1) from mysql.tidb_background_subtask")
+		require.NoError(t, err)
+		return rows[0].GetInt64(0)
+	}
+
+	_, err := s.gcWorker.resolveLocks(gcContext(), s.mustAllocTs(t), 2, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, resolvedRegions[s.initRegion.regionID])
+	require.Equal(t, 1, resolvedRegions[region2])
+	require.Equal(t, int64(0), countTasks())
+
+	// The error of a subtask fails the whole resolve locks, and the unfinished subtasks are deleted.
+	s.gcWorker.testingKnobs.batchResolveLocks = func(locks []*txnlock.Lock, regionID tikv.RegionVerID, safepoint uint64) (bool, error) {
+		if regionID.GetID() == region2 {
+			return false, errors.New("mock resolve locks error")
+		}
+		return true, nil
+	}
+	_, err = s.gcWorker.resolveLocks(gcContext(), s.mustAllocTs(t), 2, false)
+	require.ErrorContains(t, err, "mock resolve locks error")
+	require.Equal(t, int64(0), countTasks())
+}
+
 func TestRunGCJob(t *testing.T) {
 	s := createGCWorkerSuite(t)
 
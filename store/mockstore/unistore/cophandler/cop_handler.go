@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"hash/crc64"
+	"math"
 	"strings"
 	"time"
 
@@ -617,16 +619,39 @@ func fieldTypeFromPBColumn(col *tipb.ColumnInfo) *types.FieldType {
 	return ft
 }
 
-// handleCopChecksumRequest handles coprocessor check sum request.
+// handleCopChecksumRequest handles coprocessor check sum request. Like TiKV, the checksum is the xor of the crc64
+// of the key-value pairs in the ranges.
 func handleCopChecksumRequest(dbReader *dbreader.DBReader, req *coprocessor.Request) *coprocessor.Response {
-	resp := &tipb.ChecksumResponse{
-		Checksum:   1,
-		TotalKvs:   1,
-		TotalBytes: 1,
+	proc := &checksumProcessor{}
+	for _, ran := range req.Ranges {
+		if err := dbReader.Scan(ran.Start, ran.End, math.MaxInt64, req.StartTs, proc); err != nil {
+			return &coprocessor.Response{OtherError: fmt.Sprintf("scan for checksum error: %v", err)}
+		}
 	}
-	data, err := resp.Marshal()
+	data, err := proc.resp.Marshal()
 	if err != nil {
 		return &coprocessor.Response{OtherError: fmt.Sprintf("marshal checksum response error: %v", err)}
 	}
 	return &coprocessor.Response{Data: data}
 }
+
+var crc64Table = crc64.MakeTable(crc64.ECMA)
+
+type checksumProcessor struct {
+	resp tipb.ChecksumResponse
+}
+
+// Process implements the dbreader.ScanProcessor interface.
+func (p *checksumProcessor) Process(key, value []byte) error {
+	sum := crc64.Update(0, crc64Table, key)
+	sum = crc64.Update(sum, crc64Table, value)
+	p.resp.Checksum ^= sum
+	p.resp.TotalKvs++
+	p.resp.TotalBytes += uint64(len(key) + len(value))
+	return nil
+}
+
+// SkipValue implements the dbreader.ScanProcessor interface.
+func (*checksumProcessor) SkipValue() bool {
+	return false
+}
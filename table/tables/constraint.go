@@ -0,0 +1,92 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/mock"
+)
+
+// checkConstraint is a check constraint enforced on the writes of the table.
+type checkConstraint struct {
+	*model.ConstraintInfo
+	expr expression.Expression
+	// jsonSchema validates the document directly if the constraint is `CHECK (JSON_SCHEMA_VALID('{...}', doc))`.
+	jsonSchema *expression.JSONSchemaCheck
+}
+
+// loadCheckConstraints builds the check constraints enforced on the writes of the table. The constraints being
+// added are enforced from the write only state, and the not enforced constraints are skipped.
+func loadCheckConstraints(tblInfo *model.TableInfo) ([]*checkConstraint, error) {
+	if len(tblInfo.Constraints) == 0 {
+		return nil, nil
+	}
+	// The constraints can't rely on the session context, use a default one like the partition expression.
+	ctx := mock.NewContext()
+	constraints := make([]*checkConstraint, 0, len(tblInfo.Constraints))
+	for _, constraintInfo := range tblInfo.Constraints {
+		if !constraintInfo.Enforced || constraintInfo.State == model.StateNone || constraintInfo.State == model.StateDeleteOnly {
+			continue
+		}
+		expr, err := expression.ParseSimpleExprWithTableInfo(ctx, constraintInfo.ExprString, tblInfo)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		constraints = append(constraints, &checkConstraint{
+			ConstraintInfo: constraintInfo,
+			expr:           expr,
+			jsonSchema:     expression.NewJSONSchemaCheck(expr),
+		})
+	}
+	return constraints, nil
+}
+
+// checkRowConstraints returns an error if the row violates a check constraint of the table. Like MySQL, the row
+// satisfies the constraint unless it's evaluated to false.
+func (t *TableCommon) checkRowConstraints(sctx sessionctx.Context, r []types.Datum) error {
+	if len(t.constraints) == 0 || !variable.EnableCheckConstraint.Load() {
+		return nil
+	}
+	row := chunk.MutRowFromDatums(r).ToRow()
+	for _, constraint := range t.constraints {
+		if constraint.jsonSchema != nil {
+			report, err := constraint.jsonSchema.Check(sctx, row)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if report != nil {
+				// Like MySQL, the reason of the violation is reported as a warning.
+				sctx.GetSessionVars().StmtCtx.AppendWarning(types.ErrJSONSchemaValidationReport.GenWithStackByArgs(report.String()))
+				return table.ErrCheckConstraintViolated.GenWithStackByArgs(constraint.Name.O)
+			}
+			continue
+		}
+		val, isNull, err := constraint.expr.EvalInt(sctx, row)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !isNull && val == 0 {
+			return table.ErrCheckConstraintViolated.GenWithStackByArgs(constraint.Name.O)
+		}
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/fulltext"
 	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tidb/util/tracing"
 )
@@ -96,6 +97,10 @@ func (c *index) GenIndexKey(sc *stmtctx.StatementContext, indexedValues []types.
 
 // GenIndexValue generates the index value.
 func (c *index) GenIndexValue(sc *stmtctx.StatementContext, distinct bool, indexedValues []types.Datum, h kv.Handle, restoredData []types.Datum) ([]byte, error) {
+	if c.idxInfo.Tp == model.IndexTypeFulltext {
+		// The tokens in a fulltext index are never restored to the column values.
+		return tablecodec.GenIndexValuePortal(sc, c.tblInfo, c.idxInfo, false, distinct, false, indexedValues, h, c.phyTblID, nil)
+	}
 	c.initNeedRestoreData.Do(func() {
 		c.needRestoredData = NeedRestoredData(c.idxInfo.Columns, c.tblInfo.Columns)
 	})
@@ -107,7 +112,11 @@ func (c *index) GenIndexValue(sc *stmtctx.StatementContext, distinct bool, index
 // 2. (i1, [m1,m2], i2, ...) ==> [(i1, m1, i2, ...), (i1, m2, i2, ...)]
 // 3. (i1, null, i2, ...) ==> [(i1, null, i2, ...)]
 // 4. (i1, [], i2, ...) ==> nothing.
+// 5. For fulltext index, see getFulltextIndexedValue.
 func (c *index) getIndexedValue(indexedValues []types.Datum) [][]types.Datum {
+	if c.idxInfo.Tp == model.IndexTypeFulltext {
+		return getFulltextIndexedValue(indexedValues)
+	}
 	if !c.idxInfo.MVIndex {
 		return [][]types.Datum{indexedValues}
 	}
@@ -154,6 +163,23 @@ out:
 	return vals
 }
 
+// getFulltextIndexedValue produces one value for each distinct token in the
+// indexed columns, e.g. ("hello world", "hello tidb") ==> [("hello"), ("world"), ("tidb")].
+func getFulltextIndexedValue(indexedValues []types.Datum) [][]types.Datum {
+	texts := make([]string, 0, len(indexedValues))
+	for _, v := range indexedValues {
+		if !v.IsNull() {
+			texts = append(texts, v.GetString())
+		}
+	}
+	tokens := fulltext.UniqueTokens(texts...)
+	vals := make([][]types.Datum, 0, len(tokens))
+	for _, token := range tokens {
+		vals = append(vals, []types.Datum{types.NewStringDatum(token)})
+	}
+	return vals
+}
+
 // Create creates a new entry in the kvIndex data.
 // If the index is unique and there is an existing entry with the same key,
 // Create will return the existing entry's handle as the first return value, ErrKeyExists as the second return value.
@@ -146,6 +146,10 @@ func checkHandleConsistency(rowInsertion mutation, indexMutations []mutation, in
 		if !ok {
 			return errors.New("index not found")
 		}
+		if indexInfo.Tp == model.IndexTypeFulltext {
+			// The keys of fulltext indexes hold the tokens rather than the column values.
+			continue
+		}
 
 		// If this is the temporary index data, need to remove the last byte of index data(version about when it is written).
 		var (
@@ -215,6 +219,10 @@ func checkIndexKeys(
 		if !ok {
 			return errors.New("index not found")
 		}
+		if indexInfo.Tp == model.IndexTypeFulltext {
+			// The keys of fulltext indexes hold the tokens rather than the column values.
+			continue
+		}
 		rowColInfos, ok := indexIDToRowColInfos[idxID]
 		if !ok {
 			return errors.New("index not found")
@@ -133,6 +133,8 @@ func newPartitionedTable(tbl *TableCommon, tblInfo *model.TableInfo) (table.Part
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		t.constraints = tbl.constraints
+		t.addingStoredCols = tbl.addingStoredCols
 		t.table = ret
 		partitions[p.ID] = &t
 	}
@@ -147,7 +149,7 @@ func newPartitionedTable(tbl *TableCommon, tblInfo *model.TableInfo) (table.Part
 	if pi.DDLState == model.StateDeleteReorganization {
 		origIdx := setIndexesState(ret, pi.DDLState)
 		defer unsetIndexesState(ret, origIdx)
-		ret.reorgPartitionExpr, err = newPartitionExpr(tblInfo, pi.DroppingDefinitions)
+		ret.reorgPartitionExpr, err = newPartitionExpr(getReorgTableInfo(tblInfo), pi.DroppingDefinitions)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -168,7 +170,7 @@ func newPartitionedTable(tbl *TableCommon, tblInfo *model.TableInfo) (table.Part
 		if len(pi.AddingDefinitions) > 0 {
 			origIdx := setIndexesState(ret, pi.DDLState)
 			defer unsetIndexesState(ret, origIdx)
-			ret.reorgPartitionExpr, err = newPartitionExpr(tblInfo, pi.AddingDefinitions)
+			ret.reorgPartitionExpr, err = newPartitionExpr(getReorgTableInfo(tblInfo), pi.AddingDefinitions)
 			if err != nil {
 				return nil, errors.Trace(err)
 			}
@@ -223,6 +225,8 @@ func initPartition(t *partitionedTable, def model.PartitionDefinition) (*partiti
 	if err != nil {
 		return nil, err
 	}
+	newPart.constraints = t.constraints
+	newPart.addingStoredCols = t.addingStoredCols
 	newPart.table = t
 	return &newPart, nil
 }
@@ -237,6 +241,9 @@ func newPartitionExpr(tblInfo *model.TableInfo, defs []model.PartitionDefinition
 	}
 	pi := tblInfo.GetPartitionInfo()
 	switch pi.Type {
+	case model.PartitionTypeNone:
+		// The whole table is in the only partition, no expression is needed to locate it.
+		return &PartitionExpr{}, nil
 	case model.PartitionTypeRange:
 		return generateRangePartitionExpr(ctx, pi, defs, columns, names)
 	case model.PartitionTypeHash:
@@ -249,6 +256,20 @@ func newPartitionExpr(tblInfo *model.TableInfo, defs []model.PartitionDefinition
 	panic("cannot reach here")
 }
 
+// getReorgTableInfo returns the table info with the partitioning of the partitions which are reorganized with
+// the Definitions, it differs from the partitioning of the Definitions when ALTER TABLE ... PARTITION BY changes
+// the partitioning of the table.
+func getReorgTableInfo(tblInfo *model.TableInfo) *model.TableInfo {
+	pi := tblInfo.Partition
+	if pi.DDLAction != model.ActionAlterTablePartitioning {
+		return tblInfo
+	}
+	reorgTblInfo := tblInfo.Clone()
+	reorgPi := reorgTblInfo.Partition
+	reorgPi.Type, reorgPi.Expr, reorgPi.Columns = pi.DDLType, pi.DDLExpr, pi.DDLColumns
+	return reorgTblInfo
+}
+
 // PartitionExpr is the partition definition expressions.
 type PartitionExpr struct {
 	// UpperBounds: (x < y1); (x < y2); (x < y3), used by locatePartition.
@@ -1210,7 +1231,7 @@ func (t *partitionedTable) CheckForExchangePartition(ctx sessionctx.Context, pi
 func (t *partitionedTable) locatePartitionCommon(ctx sessionctx.Context, pi *model.PartitionInfo, partitionExpr *PartitionExpr, r []types.Datum) (int, error) {
 	var err error
 	var idx int
-	switch t.meta.Partition.Type {
+	switch pi.Type {
 	case model.PartitionTypeRange:
 		if len(pi.Columns) == 0 {
 			idx, err = t.locateRangePartition(ctx, partitionExpr, r)
@@ -1243,7 +1264,7 @@ func (t *partitionedTable) locatePartition(ctx sessionctx.Context, r []types.Dat
 
 func (t *partitionedTable) locateReorgPartition(ctx sessionctx.Context, r []types.Datum) (int64, error) {
 	pi := t.Meta().GetPartitionInfo()
-	idx, err := t.locatePartitionCommon(ctx, pi, t.reorgPartitionExpr, r)
+	idx, err := t.locatePartitionCommon(ctx, getReorgTableInfo(t.Meta()).Partition, t.reorgPartitionExpr, r)
 	if err != nil {
 		return 0, errors.Trace(err)
 	}
@@ -1310,7 +1331,7 @@ func (t *partitionedTable) locateRangePartition(ctx sessionctx.Context, partitio
 		isNull bool
 		err    error
 	)
-	if col, ok := t.partitionExpr.Expr.(*expression.Column); ok {
+	if col, ok := partitionExpr.Expr.(*expression.Column); ok {
 		if r[col.Index].IsNull() {
 			isNull = true
 		}
@@ -1319,13 +1340,13 @@ func (t *partitionedTable) locateRangePartition(ctx sessionctx.Context, partitio
 		evalBuffer := t.evalBufferPool.Get().(*chunk.MutRow)
 		defer t.evalBufferPool.Put(evalBuffer)
 		evalBuffer.SetDatums(r...)
-		val, isNull, err = t.partitionExpr.Expr.EvalInt(ctx, evalBuffer.ToRow())
+		val, isNull, err = partitionExpr.Expr.EvalInt(ctx, evalBuffer.ToRow())
 		if err != nil {
 			return 0, err
 		}
 		ret = val
 	}
-	unsigned := mysql.HasUnsignedFlag(t.partitionExpr.Expr.GetType().GetFlag())
+	unsigned := mysql.HasUnsignedFlag(partitionExpr.Expr.GetType().GetFlag())
 	ranges := partitionExpr.ForRangePruning
 	length := len(ranges.LessThan)
 	pos := sort.Search(length, func(i int) bool {
@@ -1422,7 +1443,7 @@ func GetReorganizedPartitionedTable(t table.Table) (table.PartitionedTable, erro
 	if t.GetPartitionedTable() == nil {
 		return nil, dbterror.ErrUnsupportedReorganizePartition.GenWithStackByArgs()
 	}
-	tblInfo := t.Meta().Clone()
+	tblInfo := getReorgTableInfo(t.Meta()).Clone()
 	tblInfo.Partition.Definitions = tblInfo.Partition.AddingDefinitions
 	tblInfo.Partition.AddingDefinitions = nil
 	tblInfo.Partition.DroppingDefinitions = nil
@@ -0,0 +1,61 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/mock"
+)
+
+// loadAddingStoredColumns builds the generation expressions of the stored generated columns being added. The
+// executors only generate the public columns, so the columns are generated by the table from the write only state.
+func loadAddingStoredColumns(tblInfo *model.TableInfo) (map[int64]expression.Expression, error) {
+	var exprs map[int64]expression.Expression
+	for _, col := range tblInfo.Columns {
+		if !col.IsGenerated() || !col.GeneratedStored || col.ChangeStateInfo != nil ||
+			(col.State != model.StateWriteOnly && col.State != model.StateWriteReorganization) {
+			continue
+		}
+		if exprs == nil {
+			exprs = make(map[int64]expression.Expression)
+		}
+		// The expression only refers to the public columns, use a default context like the check constraints.
+		expr, err := expression.ParseSimpleExprWithTableInfo(mock.NewContext(), col.GeneratedExprString, tblInfo)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		exprs[col.ID] = expr
+	}
+	return exprs, nil
+}
+
+// generateAddingStoredColumn generates the value of a stored generated column being added from the row.
+func generateAddingStoredColumn(sctx sessionctx.Context, col *table.Column, expr expression.Expression, r []types.Datum) (types.Datum, error) {
+	val, err := expr.Eval(chunk.MutRowFromDatums(r).ToRow())
+	if err != nil {
+		return types.Datum{}, errors.Trace(err)
+	}
+	val, err = table.CastValue(sctx, val, col.ColumnInfo, false, false)
+	if err != nil {
+		return types.Datum{}, errors.Trace(err)
+	}
+	return val, errors.Trace(col.CheckNotNull(&val, 0))
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/meta"
 	"github.com/pingcap/tidb/meta/autoid"
@@ -47,6 +48,7 @@ import (
 	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/generatedexpr"
 	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tidb/util/stringutil"
 	"github.com/pingcap/tidb/util/tableutil"
 	"github.com/pingcap/tidb/util/tracing"
@@ -71,6 +73,9 @@ type TableCommon struct {
 	meta                            *model.TableInfo
 	allocs                          autoid.Allocators
 	sequence                        *sequenceCommon
+	constraints                     []*checkConstraint
+	// addingStoredCols are the generation expressions of the stored generated columns being added.
+	addingStoredCols map[int64]expression.Expression
 
 	// recordPrefix and indexPrefix are generated using physicalTableID.
 	recordPrefix kv.Key
@@ -151,6 +156,16 @@ func TableFromMeta(allocs autoid.Allocators, tblInfo *model.TableInfo) (table.Ta
 
 	var t TableCommon
 	initTableCommon(&t, tblInfo, tblInfo.ID, columns, allocs)
+	constraints, err := loadCheckConstraints(tblInfo)
+	if err != nil {
+		return nil, err
+	}
+	t.constraints = constraints
+	addingStoredCols, err := loadAddingStoredColumns(tblInfo)
+	if err != nil {
+		return nil, err
+	}
+	t.addingStoredCols = addingStoredCols
 	if tblInfo.GetPartitionInfo() == nil {
 		if err := initTableIndices(&t); err != nil {
 			return nil, err
@@ -189,6 +204,10 @@ func initTableIndices(t *TableCommon) error {
 		if idxInfo.State == model.StateNone {
 			return table.ErrIndexStateCantNone.GenWithStackByArgs(idxInfo.Name)
 		}
+		if idxInfo.Tp == model.IndexTypeColumnar {
+			// The columnar index is maintained by the TiFlash replicas, it has no entries written by the transactions.
+			continue
+		}
 
 		// Use partition ID for index, because TableCommon may be table or partition.
 		idx := NewIndex(t.physicalTableID, tblInfo, idxInfo)
@@ -371,6 +390,9 @@ func (t *TableCommon) UpdateRecord(ctx context.Context, sctx sessionctx.Context,
 	if err != nil {
 		return err
 	}
+	if err := t.checkRowConstraints(sctx, newData); err != nil {
+		return err
+	}
 
 	memBuffer := txn.GetMemBuffer()
 	sh := memBuffer.Staging()
@@ -425,13 +447,21 @@ func (t *TableCommon) UpdateRecord(ctx context.Context, sctx sessionctx.Context,
 				}
 				newData[col.Offset] = value
 				touched[col.Offset] = touched[col.DependencyColumnOffset]
+			} else if expr, ok := t.addingStoredCols[col.ID]; ok {
+				// The stored generated column being added is generated from the new data.
+				value, err = generateAddingStoredColumn(sctx, col, expr, newData)
+				if err != nil {
+					return err
+				}
+				newData[col.Offset] = value
+				touched[col.Offset] = true
 			}
 		} else {
 			value = newData[col.Offset]
 		}
 		if !t.canSkip(col, &value) {
 			colIDs = append(colIDs, col.ID)
-			row = append(row, value)
+			row = append(row, CompressColumnValue(sctx, col, value))
 		}
 		if shouldWriteBinlog(sctx, t.meta) && !t.canSkipUpdateBinlog(col, value) {
 			binlogColIDs = append(binlogColIDs, col.ID)
@@ -722,6 +752,10 @@ func (t *TableCommon) AddRecord(sctx sessionctx.Context, r []types.Datum, opts .
 		fn.ApplyOn(&opt)
 	}
 
+	if err := t.checkRowConstraints(sctx, r); err != nil {
+		return nil, err
+	}
+
 	if m := t.Meta(); m.TempTableType != model.TempTableNone {
 		if tmpTable := addTemporaryTable(sctx, m); tmpTable != nil {
 			if err := checkTempTableSize(sctx, tmpTable, m); err != nil {
@@ -827,7 +861,18 @@ func (t *TableCommon) AddRecord(sctx sessionctx.Context, r []types.Datum, opts .
 			colIDs = append(colIDs, col.ID)
 			continue
 		}
-		if col.State != model.StatePublic &&
+		if expr, ok := t.addingStoredCols[col.ID]; ok && col.State != model.StatePublic {
+			// The stored generated column being added is generated from the row, the updated one included.
+			value, err = generateAddingStoredColumn(sctx, col, expr, r)
+			if err != nil {
+				return nil, err
+			}
+			if col.Offset < len(r) {
+				r[col.Offset] = value
+			} else {
+				r = append(r, value)
+			}
+		} else if col.State != model.StatePublic &&
 			// Update call `AddRecord` will already handle the write only column default value.
 			// Only insert should add default value for write only column.
 			!opt.IsUpdate {
@@ -848,7 +893,7 @@ func (t *TableCommon) AddRecord(sctx sessionctx.Context, r []types.Datum, opts .
 		}
 		if !t.canSkip(col, &value) {
 			colIDs = append(colIDs, col.ID)
-			row = append(row, value)
+			row = append(row, CompressColumnValue(sctx, col, value))
 		}
 	}
 
@@ -1676,6 +1721,15 @@ func CanSkip(info *model.TableInfo, col *table.Column, value *types.Datum) bool
 	return false
 }
 
+// CompressColumnValue compresses the value of the compressed column to be encoded in the row. The value isn't
+// compressed if the row is encoded in the old row format, whose values are never decompressed.
+func CompressColumnValue(sctx sessionctx.Context, col *table.Column, value types.Datum) types.Datum {
+	if !mysql.HasCompressedFlag(col.GetFlag()) || value.IsNull() || !sctx.GetSessionVars().RowEncoder.Enable {
+		return value
+	}
+	return types.NewBytesDatum(rowcodec.CompressValue(value.GetBytes()))
+}
+
 // canSkipUpdateBinlog checks whether the column can be skipped or not.
 func (t *TableCommon) canSkipUpdateBinlog(col *table.Column, value types.Datum) bool {
 	if col.IsGenerated() && !col.GeneratedStored {
@@ -15,8 +15,8 @@
 package temptable
 
 import (
-	"bytes"
 	"context"
+	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/infoschema"
@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
 	"github.com/tikv/client-go/v2/tikv"
 )
 
@@ -38,6 +39,8 @@ type TemporaryTableDDL interface {
 	CreateLocalTemporaryTable(db *model.DBInfo, info *model.TableInfo) error
 	DropLocalTemporaryTable(schema model.CIStr, tblName model.CIStr) error
 	TruncateLocalTemporaryTable(schema model.CIStr, tblName model.CIStr) error
+	CreateLocalTemporaryTableIndex(schema model.CIStr, tblName model.CIStr, tblInfo *model.TableInfo, indexInfo *model.IndexInfo) error
+	DropLocalTemporaryTableIndex(schema model.CIStr, tblName model.CIStr, tblInfo *model.TableInfo, indexInfo *model.IndexInfo) error
 }
 
 // temporaryTableDDL implements temptable.TemporaryTableDDL
@@ -80,32 +83,148 @@ func (d *temporaryTableDDL) TruncateLocalTemporaryTable(schema model.CIStr, tblN
 		return err
 	}
 
-	localTempTables := getLocalTemporaryTables(d.sctx)
-	db, _ := localTempTables.SchemaByTable(oldTblInfo)
-	localTempTables.RemoveTable(schema, tblName)
-	if err = localTempTables.AddTable(db, newTbl); err != nil {
+	if err = replaceLocalTemporaryTable(d.sctx, schema, tblName, oldTbl, newTbl); err != nil {
 		return err
 	}
 
 	return d.clearTemporaryTableRecords(oldTblInfo.ID)
 }
 
+// CreateLocalTemporaryTableIndex replaces the table with the new table info which has the index, and builds the index
+// from the committed rows of the table.
+func (d *temporaryTableDDL) CreateLocalTemporaryTableIndex(schema model.CIStr, tblName model.CIStr, tblInfo *model.TableInfo, indexInfo *model.IndexInfo) error {
+	oldTbl, err := checkLocalTemporaryExistsAndReturn(d.sctx, schema, tblName)
+	if err != nil {
+		return err
+	}
+
+	newTbl, err := tables.TableFromMeta(oldTbl.Allocators(nil), tblInfo)
+	if err != nil {
+		return err
+	}
+
+	if sessionData := getSessionData(d.sctx); sessionData != nil {
+		stage := sessionData.Staging()
+		defer sessionData.Cleanup(stage)
+		if err = d.backfillTemporaryTableIndex(sessionData, newTbl, indexInfo); err != nil {
+			return err
+		}
+		sessionData.Release(stage)
+	}
+
+	return replaceLocalTemporaryTable(d.sctx, schema, tblName, oldTbl, newTbl)
+}
+
+// DropLocalTemporaryTableIndex replaces the table with the new table info which doesn't have the index, and removes
+// the index records.
+func (d *temporaryTableDDL) DropLocalTemporaryTableIndex(schema model.CIStr, tblName model.CIStr, tblInfo *model.TableInfo, indexInfo *model.IndexInfo) error {
+	oldTbl, err := checkLocalTemporaryExistsAndReturn(d.sctx, schema, tblName)
+	if err != nil {
+		return err
+	}
+
+	newTbl, err := tables.TableFromMeta(oldTbl.Allocators(nil), tblInfo)
+	if err != nil {
+		return err
+	}
+
+	if err = replaceLocalTemporaryTable(d.sctx, schema, tblName, oldTbl, newTbl); err != nil {
+		return err
+	}
+
+	idxPrefix := tablecodec.EncodeTableIndexPrefix(tblInfo.ID, indexInfo.ID)
+	return d.clearTemporaryTableKeys(tblInfo.ID, idxPrefix, idxPrefix.PrefixNext())
+}
+
+func (d *temporaryTableDDL) backfillTemporaryTableIndex(sessionData variable.TemporaryTableData, tbl table.Table, indexInfo *model.IndexInfo) error {
+	tblInfo := tbl.Meta()
+	idx := tables.NewIndex(tblInfo.ID, tblInfo, indexInfo)
+	sc := d.sctx.GetSessionVars().StmtCtx
+	recordPrefix := tablecodec.GenTableRecordPrefix(tblInfo.ID)
+	iter, err := sessionData.Iter(recordPrefix, recordPrefix.PrefixNext())
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); err = iter.Next() {
+		if err != nil {
+			return err
+		}
+		// The deleted rows are kept as the empty values.
+		if len(iter.Value()) == 0 {
+			continue
+		}
+		handle, err := tablecodec.DecodeRowKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		row, _, err := tables.DecodeRawRowData(d.sctx, tblInfo, handle, tbl.Cols(), iter.Value())
+		if err != nil {
+			return err
+		}
+		idxVals, err := idx.FetchValues(row, nil)
+		if err != nil {
+			return err
+		}
+		restoreData := tables.TryGetHandleRestoredDataWrapper(tblInfo, row, nil, indexInfo)
+		kvIter := idx.GenIndexKVIter(sc, idxVals, handle, restoreData)
+		for kvIter.Valid() {
+			key, val, distinct, err := kvIter.Next(nil)
+			if err != nil {
+				return err
+			}
+			if distinct {
+				// The deleted keys are kept as the empty values too.
+				oldVal, err := sessionData.Get(context.Background(), key)
+				if err != nil && !kv.ErrNotExist.Equal(err) {
+					return err
+				}
+				if len(oldVal) > 0 {
+					return genTemporaryIndexKeyExistsErr(idxVals, tblInfo, indexInfo)
+				}
+			}
+			if err = sessionData.SetTableKey(tblInfo.ID, key, val); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+func genTemporaryIndexKeyExistsErr(idxVals []types.Datum, tblInfo *model.TableInfo, indexInfo *model.IndexInfo) error {
+	strVals := make([]string, 0, len(idxVals))
+	for _, val := range idxVals {
+		str := "NULL"
+		if !val.IsNull() {
+			var err error
+			if str, err = val.ToString(); err != nil {
+				return err
+			}
+		}
+		strVals = append(strVals, str)
+	}
+	return kv.ErrKeyExists.FastGenByArgs(strings.Join(strVals, "-"), tblInfo.Name.String()+"."+indexInfo.Name.String())
+}
+
 func (d *temporaryTableDDL) clearTemporaryTableRecords(tblID int64) error {
+	return d.clearTemporaryTableKeys(tblID, tablecodec.EncodeTablePrefix(tblID), tablecodec.EncodeTablePrefix(tblID+1))
+}
+
+func (d *temporaryTableDDL) clearTemporaryTableKeys(tblID int64, startKey, endKey kv.Key) error {
 	sessionData := getSessionData(d.sctx)
 	if sessionData == nil {
 		return nil
 	}
 
-	tblPrefix := tablecodec.EncodeTablePrefix(tblID)
-	endKey := tablecodec.EncodeTablePrefix(tblID + 1)
-	iter, err := sessionData.Iter(tblPrefix, endKey)
+	iter, err := sessionData.Iter(startKey, endKey)
 	if err != nil {
 		return err
 	}
 
 	for iter.Valid() {
 		key := iter.Key()
-		if !bytes.HasPrefix(key, tblPrefix) {
+		if key.Cmp(endKey) >= 0 {
 			break
 		}
 
@@ -123,6 +242,13 @@ func (d *temporaryTableDDL) clearTemporaryTableRecords(tblID int64) error {
 	return nil
 }
 
+func replaceLocalTemporaryTable(sctx sessionctx.Context, schema model.CIStr, tblName model.CIStr, oldTbl, newTbl table.Table) error {
+	localTempTables := getLocalTemporaryTables(sctx)
+	db, _ := localTempTables.SchemaByTable(oldTbl.Meta())
+	localTempTables.RemoveTable(schema, tblName)
+	return localTempTables.AddTable(db, newTbl)
+}
+
 func checkLocalTemporaryExistsAndReturn(sctx sessionctx.Context, schema model.CIStr, tblName model.CIStr) (table.Table, error) {
 	ident := ast.Ident{Schema: schema, Name: tblName}
 	localTemporaryTables := getLocalTemporaryTables(sctx)
@@ -864,6 +864,10 @@ func buildRestoredColumn(allCols []rowcodec.ColInfo) []rowcodec.ColInfo {
 		if collate.IsBinCollation(col.Ft.GetCollate()) {
 			// Change the fieldType from string to uint since we store the number of the truncated spaces.
 			copyColInfo.Ft = types.NewFieldType(mysql.TypeLonglong)
+		} else if mysql.HasCompressedFlag(col.Ft.GetFlag()) {
+			// The restored data in the indexes is not compressed.
+			copyColInfo.Ft = col.Ft.Clone()
+			copyColInfo.Ft.DelFlag(mysql.CompressedFlag)
 		} else {
 			copyColInfo.Ft = allCols[i].Ft
 		}
@@ -1669,6 +1673,28 @@ func DecodeHandleInUniqueIndexValue(data []byte, isCommonHandle bool) (kv.Handle
 	return h, nil
 }
 
+// DecodePartitionIDInIndexValue decodes the partition ID in the value of a global index.
+// The returned bool is false if the value doesn't carry a partition ID.
+func DecodePartitionIDInIndexValue(value []byte) (int64, bool, error) {
+	if len(value) <= MaxOldEncodeValueLen {
+		return 0, false, nil
+	}
+	var segs IndexValueSegments
+	if getIndexVersion(value) == 1 {
+		segs = SplitIndexValueForClusteredIndexVersion1(value)
+	} else {
+		segs = SplitIndexValue(value)
+	}
+	if segs.PartitionID == nil {
+		return 0, false, nil
+	}
+	_, pid, err := codec.DecodeInt(segs.PartitionID)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	return pid, true, nil
+}
+
 func encodePartitionID(idxVal []byte, partitionID int64) []byte {
 	idxVal = append(idxVal, PartitionIDFlag)
 	idxVal = codec.EncodeInt(idxVal, partitionID)
@@ -23,6 +23,7 @@ import (
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
@@ -597,6 +598,28 @@ func TestUntouchedIndexKValue(t *testing.T) {
 	require.False(t, IsUntouchedIndexKValue(untouchedIndexKey, tmpIdxVal))
 }
 
+func TestDecodePartitionIDInIndexValue(t *testing.T) {
+	sc := &stmtctx.StatementContext{TimeZone: time.UTC}
+	tblInfo := &model.TableInfo{ID: 1}
+	indexedValues := []types.Datum{types.NewIntDatum(1)}
+	for _, distinct := range []bool{true, false} {
+		idxInfo := &model.IndexInfo{ID: 2, Global: true}
+		val, err := GenIndexValuePortal(sc, tblInfo, idxInfo, false, distinct, false, indexedValues, kv.IntHandle(3), 100, nil)
+		require.NoError(t, err)
+		pid, ok, err := DecodePartitionIDInIndexValue(val)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, int64(100), pid)
+
+		idxInfo.Global = false
+		val, err = GenIndexValuePortal(sc, tblInfo, idxInfo, false, distinct, false, indexedValues, kv.IntHandle(3), 100, nil)
+		require.NoError(t, err)
+		_, ok, err = DecodePartitionIDInIndexValue(val)
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+}
+
 func TestTempIndexKey(t *testing.T) {
 	values := []types.Datum{types.NewIntDatum(1), types.NewBytesDatum([]byte("abc")), types.NewFloat64Datum(5.5)}
 	encodedValue, err := codec.EncodeKey(&stmtctx.StatementContext{TimeZone: time.UTC}, nil, values...)
@@ -60,6 +60,7 @@ import (
 	"github.com/pingcap/tidb/store/mockstore"
 	pumpcli "github.com/pingcap/tidb/tidb-binlog/pump_client"
 	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/bgworker"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/cpuprofile"
 	"github.com/pingcap/tidb/util/deadlockhistory"
@@ -204,6 +205,8 @@ func main() {
 		terror.MustNil(err)
 		checkTempStorageQuota()
 	}
+	err = disk.InitTempPaths(config.GetGlobalConfig().TempPaths)
+	terror.MustNil(err)
 	setupLog()
 	setupExtensions()
 	setupStmtSummary()
@@ -764,6 +767,8 @@ func setGlobalVars() {
 		executor.GlobalMemoryUsageTracker.SetBytesLimit(int64(cfg.Performance.ServerMemoryQuota))
 	}
 	kvcache.GlobalLRUMemUsageTracker.AttachToGlobalTracker(executor.GlobalMemoryUsageTracker)
+	// The memory of the background workers, e.g. the DDL backfill workers, is also a part of the server memory.
+	bgworker.GlobalQuota.MemTracker().AttachToGlobalTracker(executor.GlobalMemoryUsageTracker)
 
 	t, err := time.ParseDuration(cfg.TiKVClient.StoreLivenessTimeout)
 	if err != nil || t < 0 {
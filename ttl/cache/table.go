@@ -19,6 +19,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -196,6 +197,94 @@ func (t *PhysicalTable) EvalExpireTime(ctx context.Context, se session.Session,
 	return tm.CoreTime().GoTime(tz)
 }
 
+// PartitionExpireState is the state of the rows in a partition compared with the expire time
+type PartitionExpireState int
+
+const (
+	// PartitionMaybeExpired means the partition may contain both expired and unexpired rows, so it should be scanned
+	PartitionMaybeExpired PartitionExpireState = iota
+	// PartitionFullyExpired means all the rows in the partition are expired
+	PartitionFullyExpired
+	// PartitionNotExpired means none of the rows in the partition is expired
+	PartitionNotExpired
+)
+
+// rangePartitionBounds returns the bounds of the partition [lower, upper) if the table is partitioned by RANGE COLUMNS
+// on the time column. The lower bound is empty for the first partition, and the upper bound may be MAXVALUE.
+func (t *PhysicalTable) rangePartitionBounds() (lower string, upper string, ok bool) {
+	pi := t.TableInfo.Partition
+	if pi == nil || t.PartitionDef == nil || pi.Type != model.PartitionTypeRange ||
+		len(pi.Columns) != 1 || pi.Columns[0].L != t.TimeColumn.Name.L {
+		return "", "", false
+	}
+
+	for i := range pi.Definitions {
+		if pi.Definitions[i].ID != t.ID {
+			continue
+		}
+		if len(pi.Definitions[i].LessThan) != 1 {
+			return "", "", false
+		}
+		if i > 0 {
+			lower = pi.Definitions[i-1].LessThan[0]
+		}
+		return lower, pi.Definitions[i].LessThan[0], true
+	}
+	return "", "", false
+}
+
+func isMaxValueBound(bound string) bool {
+	return strings.EqualFold(strings.TrimSpace(bound), "MAXVALUE")
+}
+
+func (t *PhysicalTable) evalPartitionBound(ctx context.Context, se session.Session, bound string) (time.Time, error) {
+	rows, err := se.ExecuteSQL(ctx, fmt.Sprintf("SELECT CAST(%s AS DATETIME(6))", bound))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(rows) == 0 || rows[0].IsNull(0) {
+		return time.Time{}, errors.Errorf("invalid bound '%s' of partition '%s'", bound, t.Partition.O)
+	}
+	return rows[0].GetTime(0).CoreTime().GoTime(se.GetSessionVars().Location())
+}
+
+// EvalPartitionExpireState checks the bounds of the partition against the expire time. A row is expired if its time
+// column is less than the expire time, so the partition [lower, upper) is fully expired if `upper <= expire`, and has
+// no expired row if `lower >= expire`. Only the partitions of a table partitioned by RANGE COLUMNS on the time column
+// could be pruned, and the others are always PartitionMaybeExpired.
+func (t *PhysicalTable) EvalPartitionExpireState(ctx context.Context, se session.Session, expire time.Time) (PartitionExpireState, error) {
+	lower, upper, ok := t.rangePartitionBounds()
+	if !ok {
+		return PartitionMaybeExpired, nil
+	}
+
+	if lower != "" {
+		lowerTime, err := t.evalPartitionBound(ctx, se, lower)
+		if err != nil {
+			return PartitionMaybeExpired, err
+		}
+		if !lowerTime.Before(expire) {
+			return PartitionNotExpired, nil
+		}
+	}
+
+	// The rows with NULL time column are never expired and they are stored in the first partition
+	if lower == "" && !mysql.HasNotNullFlag(t.TimeColumn.GetFlag()) {
+		return PartitionMaybeExpired, nil
+	}
+
+	if !isMaxValueBound(upper) {
+		upperTime, err := t.evalPartitionBound(ctx, se, upper)
+		if err != nil {
+			return PartitionMaybeExpired, err
+		}
+		if !upperTime.After(expire) {
+			return PartitionFullyExpired, nil
+		}
+	}
+	return PartitionMaybeExpired, nil
+}
+
 // SplitScanRanges split ranges for TTL scan
 func (t *PhysicalTable) SplitScanRanges(ctx context.Context, store kv.Storage, splitCnt int) ([]ScanRange, error) {
 	if len(t.KeyColumns) < 1 || splitCnt <= 1 {
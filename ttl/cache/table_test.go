@@ -214,3 +214,46 @@ func TestEvalTTLExpireTime(t *testing.T) {
 	require.Equal(t, "1969-10-01 01:00:00", tm.Format("2006-01-02 15:04:05"))
 	require.Equal(t, tz2.String(), tm.Location().String())
 }
+
+func TestEvalPartitionExpireState(t *testing.T) {
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create table test.t(a int, t datetime not null) ttl = `t` + interval 1 day partition by range columns(t) (" +
+		"partition p0 values less than ('2023-01-01'), " +
+		"partition p1 values less than ('2023-02-01'), " +
+		"partition p2 values less than (maxvalue))")
+	tk.MustExec("create table test.t2(a int, t datetime) ttl = `t` + interval 1 day partition by range columns(t) (" +
+		"partition p0 values less than ('2023-01-01'), " +
+		"partition p1 values less than (maxvalue))")
+	tk.MustExec("create table test.t3(a int, t datetime not null) ttl = `t` + interval 1 day partition by hash(a) partitions 2")
+
+	se := session.NewSession(tk.Session(), tk.Session(), nil)
+	se.GetSessionVars().TimeZone = time.UTC
+
+	getState := func(tblName, partition string, expire time.Time) cache.PartitionExpireState {
+		tb, err := do.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr(tblName))
+		require.NoError(t, err)
+		ttlTbl, err := cache.NewPhysicalTable(model.NewCIStr("test"), tb.Meta(), model.NewCIStr(partition))
+		require.NoError(t, err)
+		state, err := ttlTbl.EvalPartitionExpireState(context.TODO(), se, expire)
+		require.NoError(t, err)
+		return state
+	}
+
+	expire := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, cache.PartitionFullyExpired, getState("t", "p0", expire))
+	require.Equal(t, cache.PartitionMaybeExpired, getState("t", "p1", expire))
+	require.Equal(t, cache.PartitionNotExpired, getState("t", "p2", expire))
+
+	// the upper bound is exclusive, and the lower bound is inclusive
+	expire = time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, cache.PartitionFullyExpired, getState("t", "p1", expire))
+	require.Equal(t, cache.PartitionNotExpired, getState("t", "p2", expire))
+
+	// the first partition contains the NULL values of a nullable time column
+	require.Equal(t, cache.PartitionMaybeExpired, getState("t2", "p0", expire))
+	require.Equal(t, cache.PartitionMaybeExpired, getState("t2", "p1", expire))
+
+	// only the RANGE COLUMNS partitions are pruned
+	require.Equal(t, cache.PartitionMaybeExpired, getState("t3", "p0", expire))
+}
@@ -28,7 +28,9 @@ import (
 	"github.com/pingcap/tidb/ttl/session"
 	"github.com/pingcap/tidb/ttl/sqlbuilder"
 	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/bgworker"
 	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/memory"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
 )
@@ -269,6 +271,11 @@ func (w *ttlDeleteWorker) loop() error {
 
 	ctx := metrics.CtxWithPhaseTracer(w.baseWorker.ctx, tracer)
 
+	// The rows being deleted are counted in the memory budget shared with the other background workers.
+	memTracker := memory.NewTracker(memory.LabelForBackgroundWorker, -1)
+	memTracker.AttachTo(bgworker.GlobalQuota.MemTracker())
+	defer memTracker.Detach()
+
 	doRetry := func(task *ttlDeleteTask) [][]types.Datum {
 		return task.doDelete(ctx, se)
 	}
@@ -290,8 +297,14 @@ func (w *ttlDeleteWorker) loop() error {
 			if !ok {
 				return nil
 			}
+			var memUsage int64
+			if len(task.rows) > 0 {
+				memUsage = types.EstimatedMemUsage(task.rows[0], len(task.rows))
+			}
+			memTracker.Consume(memUsage)
 			retryRows := task.doDelete(ctx, se)
 			w.retryBuffer.RecordTaskResult(task, retryRows)
+			memTracker.Consume(-memUsage)
 		}
 	}
 	return nil
@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/ttl/cache"
 	"github.com/pingcap/tidb/ttl/client"
@@ -52,6 +53,7 @@ const setTableStatusOwnerTemplate = `UPDATE mysql.tidb_ttl_table_status
 		current_job_ttl_expire = %?,
 		current_job_owner_hb_time = %?
 	WHERE table_id = %?`
+const dropPartitionTemplate = "ALTER TABLE %n.%n DROP PARTITION %n"
 const updateHeartBeatTemplate = "UPDATE mysql.tidb_ttl_table_status SET current_job_owner_hb_time = %? WHERE table_id = %? AND current_job_owner_id = %?"
 const taskGCTemplate = `DELETE task FROM
 		mysql.tidb_ttl_task task
@@ -134,6 +136,12 @@ func NewJobManager(id string, sessPool sessionPool, store kv.Storage, etcdCli *c
 	return
 }
 
+// SetSysProcTracker sets the tracker of the sessions of the TTL workers. It should be called before the job manager
+// starts.
+func (m *JobManager) SetSysProcTracker(tracker sessionctx.SysProcTracker) {
+	m.taskManager.sysProcTracker = tracker
+}
+
 func (m *JobManager) jobLoop() error {
 	se, err := getSession(m.sessPool)
 	if err != nil {
@@ -142,6 +150,7 @@ func (m *JobManager) jobLoop() error {
 
 	defer func() {
 		err = multierr.Combine(err, multierr.Combine(m.taskManager.resizeScanWorkers(0), m.taskManager.resizeDelWorkers(0)))
+		m.taskManager.releaseWorkersQuota()
 		se.Close()
 		logutil.Logger(m.ctx).Info("ttlJobManager loop exited.")
 	}()
@@ -549,6 +558,7 @@ func (m *JobManager) couldTrySchedule(tableStatus *cache.TableStatus, table *cac
 func (m *JobManager) lockNewJob(ctx context.Context, se session.Session, table *cache.PhysicalTable, now time.Time, ignoreScheduleInterval bool) (*ttlJob, error) {
 	var expireTime time.Time
 	var jobID string
+	var dropPartition bool
 
 	err := se.RunInTxn(ctx, func() error {
 		sql, args := cache.SelectFromTTLTableStatusWithID(table.ID)
@@ -618,6 +628,22 @@ func (m *JobManager) lockNewJob(ctx context.Context, se session.Session, table *
 			return errors.Wrapf(err, "execute sql: %s", sql)
 		}
 
+		partitionState, err := table.EvalPartitionExpireState(ctx, se, expireTime)
+		if err != nil {
+			return errors.Wrap(err, "eval partition expire state")
+		}
+		switch partitionState {
+		case cache.PartitionNotExpired:
+			// none of the rows is expired, the job finishes without scan tasks
+			return nil
+		case cache.PartitionFullyExpired:
+			// a table must have at least one partition, so the last one is always scanned
+			if variable.TTLDropExpiredPartition.Load() && len(table.TableInfo.Partition.Definitions) > 1 {
+				dropPartition = true
+				return nil
+			}
+		}
+
 		ranges, err := table.SplitScanRanges(ctx, m.store, splitScanCount)
 		if err != nil {
 			return errors.Wrap(err, "split scan ranges")
@@ -649,6 +675,19 @@ func (m *JobManager) lockNewJob(ctx context.Context, se session.Session, table *
 		return nil, err
 	}
 
+	if dropPartition {
+		// all the rows in the partition are expired, drop it rather than deleting the rows one by one. The job has no
+		// scan task, so it finishes soon, and the partition will be scanned by the next job if it fails to be dropped.
+		_, err = se.ExecuteSQL(ctx, dropPartitionTemplate, table.Schema.O, table.Name.O, table.Partition.O)
+		if err != nil {
+			logutil.Logger(m.ctx).Warn("fail to drop the expired partition", zap.String("table", table.Name.O),
+				zap.String("partition", table.Partition.O), zap.Error(err))
+		} else {
+			logutil.Logger(m.ctx).Info("drop the expired partition", zap.String("table", table.Name.O),
+				zap.String("partition", table.Partition.O), zap.Time("expireTime", expireTime))
+		}
+	}
+
 	job := m.createNewJob(jobID, expireTime, now, table)
 
 	// job is created, notify every scan managers to fetch new tasks
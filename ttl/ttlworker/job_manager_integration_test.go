@@ -272,6 +272,43 @@ func TestTriggerTTLJob(t *testing.T) {
 	tk.MustQuery("select id from t order by id asc").Check(testkit.Rows("2", "4"))
 }
 
+func TestTTLDropExpiredPartition(t *testing.T) {
+	failpoint.Enable("github.com/pingcap/tidb/ttl/ttlworker/task-manager-loop-interval", fmt.Sprintf("return(%d)", time.Second))
+	defer failpoint.Disable("github.com/pingcap/tidb/ttl/ttlworker/task-manager-loop-interval")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
+	defer cancel()
+
+	store, do := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@global.tidb_ttl_drop_expired_partition = 1")
+	defer tk.MustExec("set @@global.tidb_ttl_drop_expired_partition = default")
+	tk.MustExec("create table t(id int, t datetime not null) TTL=`t` + INTERVAL 1 DAY partition by range columns(t) (" +
+		"partition p0 values less than ('2000-01-01'), partition p1 values less than (maxvalue))")
+	tbl, err := do.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	defs := tbl.Meta().Partition.Definitions
+
+	now := time.Now()
+	expire := now.Add(-time.Hour * 25)
+	tk.MustExec("insert into t values(1, '1999-01-01 00:00:00')")
+	tk.MustExec("insert into t values(2, ?)", now.Format("2006-01-02 15:04:05.999999"))
+	tk.MustExec("insert into t values(3, ?)", expire.Format("2006-01-02 15:04:05.999999"))
+
+	cli := do.TTLJobManager().GetCommandCli()
+	res, err := client.TriggerNewTTLJob(ctx, cli, "test", "t")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.TableResult))
+	for _, def := range defs {
+		waitTTLJobFinished(t, tk, def.ID)
+	}
+
+	// p0 is dropped since all its rows are expired, and the expired row in p1 is deleted
+	tk.MustQuery("select partition_name from information_schema.partitions where table_schema = 'test' and table_name = 't'").Check(testkit.Rows("p1"))
+	tk.MustQuery("select id from t order by id asc").Check(testkit.Rows("2"))
+}
+
 func TestTTLDeleteWithTimeZoneChange(t *testing.T) {
 	failpoint.Enable("github.com/pingcap/tidb/ttl/ttlworker/task-manager-loop-interval", fmt.Sprintf("return(%d)", time.Second))
 	defer failpoint.Disable("github.com/pingcap/tidb/ttl/ttlworker/task-manager-loop-interval")
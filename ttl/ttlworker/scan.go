@@ -23,6 +23,7 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/ttl/cache"
 	"github.com/pingcap/tidb/ttl/metrics"
@@ -241,6 +242,8 @@ type ttlScanWorker struct {
 	delCh         chan<- *ttlDeleteTask
 	notifyStateCh chan<- interface{}
 	sessionPool   sessionPool
+	// sysProcTracker tracks the sessions of the scan tasks, it's nil if the sessions aren't tracked.
+	sysProcTracker sessionctx.SysProcTracker
 }
 
 func newScanWorker(delCh chan<- *ttlDeleteTask, notifyStateCh chan<- interface{}, sessPool sessionPool) *ttlScanWorker {
@@ -335,7 +338,8 @@ func (w *ttlScanWorker) loop() error {
 
 func (w *ttlScanWorker) handleScanTask(tracer *metrics.PhaseTracer, task *ttlScanTask) {
 	ctx := metrics.CtxWithPhaseTracer(w.ctx, tracer)
-	result := task.doScan(ctx, w.delCh, w.sessionPool)
+	sessPool := newTrackedSessionPool(w.sessionPool, w.sysProcTracker, "ttl scan", task.JobID)
+	result := task.doScan(ctx, w.delCh, sessPool)
 	if result == nil {
 		result = task.result(nil)
 	}
@@ -17,6 +17,7 @@ package ttlworker
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ngaut/pools"
@@ -59,6 +60,64 @@ type sessionPool interface {
 	Put(pools.Resource)
 }
 
+// trackedSessionPool tracks the sessions got from the pool as the internal sessions, so the sessions of the TTL
+// workers are shown in the processlist with their operation and job ID, and can be killed.
+type trackedSessionPool struct {
+	sessionPool
+	tracker   sessionctx.SysProcTracker
+	operation string
+	jobID     string
+
+	mu  sync.Mutex
+	ids map[pools.Resource]uint64
+}
+
+func newTrackedSessionPool(pool sessionPool, tracker sessionctx.SysProcTracker, operation string, jobID string) sessionPool {
+	if tracker == nil {
+		return pool
+	}
+	return &trackedSessionPool{
+		sessionPool: pool,
+		tracker:     tracker,
+		operation:   operation,
+		jobID:       jobID,
+		ids:         make(map[pools.Resource]uint64),
+	}
+}
+
+// Get implements the sessionPool interface
+func (p *trackedSessionPool) Get() (pools.Resource, error) {
+	resource, err := p.sessionPool.Get()
+	if err != nil {
+		return nil, err
+	}
+	sctx, ok := resource.(sessionctx.Context)
+	if !ok {
+		return resource, nil
+	}
+	id, err := p.tracker.TrackInternal(sctx, p.operation, p.jobID, "")
+	if err != nil {
+		logutil.BgLogger().Warn("fail to track the session of ttl worker", zap.String("operation", p.operation), zap.Error(err))
+		return resource, nil
+	}
+	p.mu.Lock()
+	p.ids[resource] = id
+	p.mu.Unlock()
+	return resource, nil
+}
+
+// Put implements the sessionPool interface
+func (p *trackedSessionPool) Put(resource pools.Resource) {
+	p.mu.Lock()
+	id, ok := p.ids[resource]
+	delete(p.ids, resource)
+	p.mu.Unlock()
+	if ok {
+		p.tracker.UnTrack(id)
+	}
+	p.sessionPool.Put(resource)
+}
+
 func getSession(pool sessionPool) (session.Session, error) {
 	resource, err := pool.Get()
 	if err != nil {
@@ -21,14 +21,15 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	storeerr "github.com/pingcap/tidb/store/driver/error"
 	"github.com/pingcap/tidb/ttl/cache"
 	"github.com/pingcap/tidb/ttl/metrics"
 	"github.com/pingcap/tidb/ttl/session"
+	"github.com/pingcap/tidb/util/bgworker"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/tikv/client-go/v2/tikv"
-	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -92,6 +93,9 @@ type taskManager struct {
 
 	delCh         chan *ttlDeleteTask
 	notifyStateCh chan interface{}
+
+	// sysProcTracker tracks the sessions of the scan and delete workers, so they're shown in the processlist.
+	sysProcTracker sessionctx.SysProcTracker
 }
 
 func newTaskManager(ctx context.Context, sessPool sessionPool, infoSchemaCache *cache.InfoSchemaCache, id string, store kv.Storage) *taskManager {
@@ -114,6 +118,11 @@ func newTaskManager(ctx context.Context, sessPool sessionPool, infoSchemaCache *
 	}
 }
 
+const (
+	scanWorkersQuotaOwner = "ttl-scan"
+	delWorkersQuotaOwner  = "ttl-delete"
+)
+
 func (m *taskManager) resizeWorkersWithSysVar() {
 	err := m.resizeScanWorkers(int(variable.TTLScanWorkerCount.Load()))
 	if err != nil {
@@ -125,11 +134,19 @@ func (m *taskManager) resizeWorkersWithSysVar() {
 	}
 }
 
+// releaseWorkersQuota releases the worker slots held by the TTL workers in bgworker.GlobalQuota.
+func (m *taskManager) releaseWorkersQuota() {
+	bgworker.GlobalQuota.Release(scanWorkersQuotaOwner)
+	bgworker.GlobalQuota.Release(delWorkersQuotaOwner)
+}
+
 func (m *taskManager) resizeScanWorkers(count int) error {
 	var err error
 	var canceledWorkers []worker
-	m.scanWorkers, canceledWorkers, err = m.resizeWorkers(m.scanWorkers, count, func() worker {
-		return newScanWorker(m.delCh, m.notifyStateCh, m.sessPool)
+	m.scanWorkers, canceledWorkers, err = m.resizeWorkers(scanWorkersQuotaOwner, m.scanWorkers, count, func() worker {
+		w := newScanWorker(m.delCh, m.notifyStateCh, m.sessPool)
+		w.sysProcTracker = m.sysProcTracker
+		return w
 	})
 	for _, w := range canceledWorkers {
 		s := w.(scanWorker)
@@ -179,50 +196,40 @@ func findTaskWithID(tasks []*runningScanTask, jobID string, scanID int64) *runni
 
 func (m *taskManager) resizeDelWorkers(count int) error {
 	var err error
-	m.delWorkers, _, err = m.resizeWorkers(m.delWorkers, count, func() worker {
-		return newDeleteWorker(m.delCh, m.sessPool)
+	m.delWorkers, _, err = m.resizeWorkers(delWorkersQuotaOwner, m.delWorkers, count, func() worker {
+		return newDeleteWorker(m.delCh, newTrackedSessionPool(m.sessPool, m.sysProcTracker, "ttl delete", ""))
 	})
 	return err
 }
 
 // resizeWorkers scales the worker, and returns the full set of workers as the first return value. If there are workers
-// stopped, return the stopped worker in the second return value
-func (m *taskManager) resizeWorkers(workers []worker, count int, factory func() worker) ([]worker, []worker, error) {
-	if count < len(workers) {
-		logutil.Logger(m.ctx).Info("shrink ttl worker", zap.Int("originalCount", len(workers)), zap.Int("newCount", count))
-
-		for _, w := range workers[count:] {
-			w.Stop()
-		}
-
-		var errs error
-		// don't use `m.ctx` here, because when shutdown the server, `m.ctx` has already been cancelled
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		for _, w := range workers[count:] {
-			err := w.WaitStopped(ctx, 30*time.Second)
-			if err != nil {
-				logutil.Logger(m.ctx).Warn("fail to stop ttl worker", zap.Error(err))
-				errs = multierr.Append(errs, err)
-			}
-		}
-		cancel()
-
-		// remove the existing workers, and keep the left workers
-		return workers[:count], workers[count:], errs
+// stopped, return the stopped worker in the second return value. The TTL workers share the concurrency quota with the
+// other background workers, such as the DDL backfill workers, so the count may be limited by the quota.
+func (m *taskManager) resizeWorkers(owner string, workers []worker, count int, factory func() worker) ([]worker, []worker, error) {
+	originalCount := len(workers)
+	workers, stopped, err := bgworker.Resize(bgworker.GlobalQuota, owner, workers, count, func(int) (worker, bool, error) {
+		return factory(), true, nil
+	})
+	if err != nil {
+		return workers, stopped, err
 	}
-
-	if count > len(workers) {
-		logutil.Logger(m.ctx).Info("scale ttl worker", zap.Int("originalCount", len(workers)), zap.Int("newCount", count))
-
-		for i := len(workers); i < count; i++ {
-			w := factory()
-			w.Start()
-			workers = append(workers, w)
-		}
+	if len(workers) > originalCount {
+		logutil.Logger(m.ctx).Info("scale ttl worker", zap.Int("originalCount", originalCount), zap.Int("newCount", len(workers)))
+	}
+	if len(stopped) == 0 {
 		return workers, nil, nil
 	}
+	logutil.Logger(m.ctx).Info("shrink ttl worker", zap.Int("originalCount", originalCount), zap.Int("newCount", len(workers)))
 
-	return workers, nil, nil
+	// don't use `m.ctx` here, because when shutdown the server, `m.ctx` has already been cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	err = bgworker.WaitStopped(ctx, stopped, 30*time.Second)
+	if err != nil {
+		logutil.Logger(m.ctx).Warn("fail to stop ttl worker", zap.Error(err))
+	}
+	// remove the existing workers, and keep the left workers
+	return workers, stopped, err
 }
 
 // handleScanFinishedTask polls the result from scan worker and returns whether there are result polled
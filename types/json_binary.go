@@ -282,6 +282,11 @@ func (bj BinaryJSON) ArrayGetElem(idx int) BinaryJSON {
 	return bj.valEntryGet(headerSize + idx*valEntrySize)
 }
 
+// ObjectGetElem gets the key and the value of the `i`-th element of the object.
+func (bj BinaryJSON) ObjectGetElem(i int) ([]byte, BinaryJSON) {
+	return bj.objectGetKey(i), bj.objectGetVal(i)
+}
+
 func (bj BinaryJSON) objectGetKey(i int) []byte {
 	keyOff := int(jsonEndian.Uint32(bj.Value[headerSize+i*keyEntrySize:]))
 	keyLen := int(jsonEndian.Uint16(bj.Value[headerSize+i*keyEntrySize+keyLenOff:]))
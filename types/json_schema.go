@@ -0,0 +1,623 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const jsonSchemaValidFuncName = "json_schema_valid"
+
+// JSON Schema type names, see https://json-schema.org/draft-04/json-schema-validation.html#rfc.section.5.5.2.
+const (
+	jsonSchemaTypeNull uint8 = 1 << iota
+	jsonSchemaTypeBoolean
+	jsonSchemaTypeObject
+	jsonSchemaTypeArray
+	jsonSchemaTypeNumber
+	jsonSchemaTypeInteger
+	jsonSchemaTypeString
+)
+
+var jsonSchemaTypeNames = map[string]uint8{
+	"null":    jsonSchemaTypeNull,
+	"boolean": jsonSchemaTypeBoolean,
+	"object":  jsonSchemaTypeObject,
+	"array":   jsonSchemaTypeArray,
+	"number":  jsonSchemaTypeNumber | jsonSchemaTypeInteger,
+	"integer": jsonSchemaTypeInteger,
+	"string":  jsonSchemaTypeString,
+}
+
+// JSONSchemaValidator validates the JSON documents against a JSON Schema. It follows the draft 4 of JSON Schema like
+// MySQL, and the unknown keywords are ignored. The schema is compiled once, so the validator can be shared to
+// validate many documents, e.g. by a CHECK constraint.
+type JSONSchemaValidator struct {
+	root *jsonSchemaNode
+}
+
+// JSONSchemaValidationReport tells why a JSON document fails the validation.
+type JSONSchemaValidationReport struct {
+	// DocumentLocation is the JSON pointer of the value that fails the validation in the document.
+	DocumentLocation string
+	// SchemaLocation is the JSON pointer of the schema that the value fails.
+	SchemaLocation string
+	// Keyword is the keyword of the schema that the value fails.
+	Keyword string
+}
+
+// String implements the fmt.Stringer interface, the report is formatted like MySQL.
+func (r *JSONSchemaValidationReport) String() string {
+	return fmt.Sprintf("The JSON document location '%s' failed requirement '%s' at JSON Schema location '%s'",
+		r.DocumentLocation, r.Keyword, r.SchemaLocation)
+}
+
+type jsonSchemaPatternProperty struct {
+	pattern *regexp.Regexp
+	schema  *jsonSchemaNode
+}
+
+type jsonSchemaNode struct {
+	location string
+
+	types uint8
+	enum  []BinaryJSON
+
+	// The keywords for numbers.
+	minimum          *float64
+	maximum          *float64
+	exclusiveMinimum bool
+	exclusiveMaximum bool
+	multipleOf       float64
+
+	// The keywords for strings, the lengths are -1 if they are not specified.
+	minLength int
+	maxLength int
+	pattern   *regexp.Regexp
+
+	// The keywords for arrays.
+	minItems        int
+	maxItems        int
+	uniqueItems     bool
+	items           *jsonSchemaNode
+	tupleItems      []*jsonSchemaNode
+	additionalItems *jsonSchemaNode
+	noMoreItems     bool
+
+	// The keywords for objects.
+	minProperties        int
+	maxProperties        int
+	required             []string
+	properties           map[string]*jsonSchemaNode
+	patternProperties    []jsonSchemaPatternProperty
+	additionalProperties *jsonSchemaNode
+	noMoreProperties     bool
+	dependencies         map[string][]string
+	schemaDependencies   map[string]*jsonSchemaNode
+
+	allOf []*jsonSchemaNode
+	anyOf []*jsonSchemaNode
+	oneOf []*jsonSchemaNode
+	not   *jsonSchemaNode
+}
+
+// CompileJSONSchema compiles the JSON Schema into a validator.
+func CompileJSONSchema(schema BinaryJSON) (*JSONSchemaValidator, error) {
+	root, err := compileJSONSchemaNode(schema, "#")
+	if err != nil {
+		return nil, err
+	}
+	return &JSONSchemaValidator{root: root}, nil
+}
+
+func jsonSchemaError(format string, args ...interface{}) error {
+	return ErrInvalidJSONData.GenWithStackByArgs(jsonSchemaValidFuncName, fmt.Sprintf(format, args...))
+}
+
+func compileJSONSchemaNode(schema BinaryJSON, location string) (*jsonSchemaNode, error) {
+	if schema.TypeCode != JSONTypeCodeObject {
+		return nil, jsonSchemaError("The JSON Schema at '%s' must be an object", location)
+	}
+	node := &jsonSchemaNode{
+		location:      location,
+		minLength:     -1,
+		maxLength:     -1,
+		minItems:      -1,
+		maxItems:      -1,
+		minProperties: -1,
+		maxProperties: -1,
+	}
+	var err error
+	for i, cnt := 0, schema.GetElemCount(); i < cnt && err == nil; i++ {
+		key, val := schema.ObjectGetElem(i)
+		err = node.compileKeyword(string(key), val)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (n *jsonSchemaNode) compileKeyword(keyword string, val BinaryJSON) (err error) {
+	location := n.location + "/" + escapeJSONPointerToken(keyword)
+	switch keyword {
+	case "$ref":
+		return jsonSchemaError("The JSON Schema keyword '$ref' is not supported")
+	case "type":
+		n.types, err = compileJSONSchemaTypes(val)
+	case "enum":
+		if val.TypeCode != JSONTypeCodeArray || val.GetElemCount() == 0 {
+			return jsonSchemaError("The JSON Schema keyword 'enum' must be a non-empty array")
+		}
+		for i := 0; i < val.GetElemCount(); i++ {
+			n.enum = append(n.enum, val.ArrayGetElem(i))
+		}
+	case "minimum", "maximum", "multipleOf":
+		num, ok := jsonSchemaNumber(val)
+		if !ok || (keyword == "multipleOf" && num <= 0) {
+			return jsonSchemaError("The JSON Schema keyword '%s' must be a valid number", keyword)
+		}
+		switch keyword {
+		case "minimum":
+			n.minimum = &num
+		case "maximum":
+			n.maximum = &num
+		default:
+			n.multipleOf = num
+		}
+	case "exclusiveMinimum", "exclusiveMaximum":
+		if val.TypeCode != JSONTypeCodeLiteral || val.Value[0] == JSONLiteralNil {
+			return jsonSchemaError("The JSON Schema keyword '%s' must be a boolean", keyword)
+		}
+		if keyword == "exclusiveMinimum" {
+			n.exclusiveMinimum = val.Value[0] == JSONLiteralTrue
+		} else {
+			n.exclusiveMaximum = val.Value[0] == JSONLiteralTrue
+		}
+	case "minLength", "maxLength", "minItems", "maxItems", "minProperties", "maxProperties":
+		num, ok := jsonSchemaNumber(val)
+		if !ok || num < 0 || num != math.Trunc(num) || num > math.MaxInt32 {
+			return jsonSchemaError("The JSON Schema keyword '%s' must be a non-negative integer", keyword)
+		}
+		*n.limitOf(keyword) = int(num)
+	case "pattern":
+		n.pattern, err = compileJSONSchemaPattern(keyword, val)
+	case "uniqueItems":
+		if val.TypeCode != JSONTypeCodeLiteral || val.Value[0] == JSONLiteralNil {
+			return jsonSchemaError("The JSON Schema keyword 'uniqueItems' must be a boolean")
+		}
+		n.uniqueItems = val.Value[0] == JSONLiteralTrue
+	case "items":
+		if val.TypeCode == JSONTypeCodeArray {
+			n.tupleItems, err = compileJSONSchemaList(keyword, val, location)
+		} else {
+			n.items, err = compileJSONSchemaNode(val, location)
+		}
+	case "additionalItems":
+		n.additionalItems, n.noMoreItems, err = compileJSONSchemaOrBoolean(val, location)
+	case "required":
+		if val.TypeCode != JSONTypeCodeArray {
+			return jsonSchemaError("The JSON Schema keyword 'required' must be an array of strings")
+		}
+		n.required, err = jsonSchemaStrings(keyword, val)
+	case "properties", "patternProperties":
+		if val.TypeCode != JSONTypeCodeObject {
+			return jsonSchemaError("The JSON Schema keyword '%s' must be an object", keyword)
+		}
+		for i, cnt := 0, val.GetElemCount(); i < cnt; i++ {
+			key, subSchema := val.ObjectGetElem(i)
+			sub, err := compileJSONSchemaNode(subSchema, location+"/"+escapeJSONPointerToken(string(key)))
+			if err != nil {
+				return err
+			}
+			if keyword == "properties" {
+				if n.properties == nil {
+					n.properties = make(map[string]*jsonSchemaNode, cnt)
+				}
+				n.properties[string(key)] = sub
+				continue
+			}
+			re, err := regexp.Compile(string(key))
+			if err != nil {
+				return jsonSchemaError("The JSON Schema keyword 'patternProperties' has an invalid pattern '%s'", key)
+			}
+			n.patternProperties = append(n.patternProperties, jsonSchemaPatternProperty{pattern: re, schema: sub})
+		}
+	case "additionalProperties":
+		n.additionalProperties, n.noMoreProperties, err = compileJSONSchemaOrBoolean(val, location)
+	case "dependencies":
+		if val.TypeCode != JSONTypeCodeObject {
+			return jsonSchemaError("The JSON Schema keyword 'dependencies' must be an object")
+		}
+		for i, cnt := 0, val.GetElemCount(); i < cnt; i++ {
+			key, dep := val.ObjectGetElem(i)
+			if dep.TypeCode == JSONTypeCodeArray {
+				props, err := jsonSchemaStrings(keyword, dep)
+				if err != nil {
+					return err
+				}
+				if n.dependencies == nil {
+					n.dependencies = make(map[string][]string)
+				}
+				n.dependencies[string(key)] = props
+				continue
+			}
+			sub, err := compileJSONSchemaNode(dep, location+"/"+escapeJSONPointerToken(string(key)))
+			if err != nil {
+				return err
+			}
+			if n.schemaDependencies == nil {
+				n.schemaDependencies = make(map[string]*jsonSchemaNode)
+			}
+			n.schemaDependencies[string(key)] = sub
+		}
+	case "allOf":
+		n.allOf, err = compileJSONSchemaList(keyword, val, location)
+	case "anyOf":
+		n.anyOf, err = compileJSONSchemaList(keyword, val, location)
+	case "oneOf":
+		n.oneOf, err = compileJSONSchemaList(keyword, val, location)
+	case "not":
+		n.not, err = compileJSONSchemaNode(val, location)
+	}
+	return err
+}
+
+func (n *jsonSchemaNode) limitOf(keyword string) *int {
+	switch keyword {
+	case "minLength":
+		return &n.minLength
+	case "maxLength":
+		return &n.maxLength
+	case "minItems":
+		return &n.minItems
+	case "maxItems":
+		return &n.maxItems
+	case "minProperties":
+		return &n.minProperties
+	default:
+		return &n.maxProperties
+	}
+}
+
+func compileJSONSchemaTypes(val BinaryJSON) (uint8, error) {
+	names := []BinaryJSON{val}
+	if val.TypeCode == JSONTypeCodeArray {
+		names = names[:0]
+		for i := 0; i < val.GetElemCount(); i++ {
+			names = append(names, val.ArrayGetElem(i))
+		}
+	}
+	var types uint8
+	for _, name := range names {
+		if name.TypeCode != JSONTypeCodeString {
+			return 0, jsonSchemaError("The JSON Schema keyword 'type' must be a string or an array of strings")
+		}
+		tp, ok := jsonSchemaTypeNames[string(name.GetString())]
+		if !ok {
+			return 0, jsonSchemaError("The JSON Schema keyword 'type' has an unknown type '%s'", name.GetString())
+		}
+		types |= tp
+	}
+	return types, nil
+}
+
+func compileJSONSchemaPattern(keyword string, val BinaryJSON) (*regexp.Regexp, error) {
+	if val.TypeCode != JSONTypeCodeString {
+		return nil, jsonSchemaError("The JSON Schema keyword '%s' must be a string", keyword)
+	}
+	re, err := regexp.Compile(string(val.GetString()))
+	if err != nil {
+		return nil, jsonSchemaError("The JSON Schema keyword '%s' has an invalid pattern '%s'", keyword, val.GetString())
+	}
+	return re, nil
+}
+
+func compileJSONSchemaList(keyword string, val BinaryJSON, location string) ([]*jsonSchemaNode, error) {
+	if val.TypeCode != JSONTypeCodeArray || val.GetElemCount() == 0 {
+		return nil, jsonSchemaError("The JSON Schema keyword '%s' must be a non-empty array", keyword)
+	}
+	nodes := make([]*jsonSchemaNode, 0, val.GetElemCount())
+	for i := 0; i < val.GetElemCount(); i++ {
+		node, err := compileJSONSchemaNode(val.ArrayGetElem(i), location+"/"+strconv.Itoa(i))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// compileJSONSchemaOrBoolean compiles the value of additionalItems or additionalProperties, it's true if the value is
+// false, which means no more items or properties are allowed.
+func compileJSONSchemaOrBoolean(val BinaryJSON, location string) (*jsonSchemaNode, bool, error) {
+	if val.TypeCode == JSONTypeCodeLiteral && val.Value[0] != JSONLiteralNil {
+		return nil, val.Value[0] == JSONLiteralFalse, nil
+	}
+	node, err := compileJSONSchemaNode(val, location)
+	return node, false, err
+}
+
+func jsonSchemaStrings(keyword string, val BinaryJSON) ([]string, error) {
+	strs := make([]string, 0, val.GetElemCount())
+	for i := 0; i < val.GetElemCount(); i++ {
+		elem := val.ArrayGetElem(i)
+		if elem.TypeCode != JSONTypeCodeString {
+			return nil, jsonSchemaError("The JSON Schema keyword '%s' must be an array of strings", keyword)
+		}
+		strs = append(strs, string(elem.GetString()))
+	}
+	return strs, nil
+}
+
+func jsonSchemaNumber(val BinaryJSON) (float64, bool) {
+	switch val.TypeCode {
+	case JSONTypeCodeInt64:
+		return float64(val.GetInt64()), true
+	case JSONTypeCodeUint64:
+		return float64(val.GetUint64()), true
+	case JSONTypeCodeFloat64:
+		return val.GetFloat64(), true
+	}
+	return 0, false
+}
+
+func escapeJSONPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// Validate validates the document against the schema. It returns nil if the document is valid, otherwise it
+// returns the report of the first failure.
+func (v *JSONSchemaValidator) Validate(doc BinaryJSON) *JSONSchemaValidationReport {
+	return v.root.validate(doc, "#")
+}
+
+func (n *jsonSchemaNode) fail(location, keyword string) *JSONSchemaValidationReport {
+	return &JSONSchemaValidationReport{DocumentLocation: location, SchemaLocation: n.location, Keyword: keyword}
+}
+
+func (n *jsonSchemaNode) validate(doc BinaryJSON, location string) *JSONSchemaValidationReport {
+	if n.types != 0 && n.types&jsonSchemaTypeOf(doc) == 0 {
+		return n.fail(location, "type")
+	}
+	if len(n.enum) > 0 {
+		found := false
+		for _, val := range n.enum {
+			// The values of different JSON types are never equal, e.g. true and 1.
+			if CompareBinaryJSON(doc, val) == 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return n.fail(location, "enum")
+		}
+	}
+
+	var report *JSONSchemaValidationReport
+	switch doc.TypeCode {
+	case JSONTypeCodeInt64, JSONTypeCodeUint64, JSONTypeCodeFloat64:
+		num, _ := jsonSchemaNumber(doc)
+		report = n.validateNumber(num, location)
+	case JSONTypeCodeString:
+		report = n.validateString(doc.GetString(), location)
+	case JSONTypeCodeArray:
+		report = n.validateArray(doc, location)
+	case JSONTypeCodeObject:
+		report = n.validateObject(doc, location)
+	}
+	if report != nil {
+		return report
+	}
+
+	for _, sub := range n.allOf {
+		if sub.validate(doc, location) != nil {
+			return n.fail(location, "allOf")
+		}
+	}
+	if len(n.anyOf) > 0 {
+		matched := false
+		for _, sub := range n.anyOf {
+			if sub.validate(doc, location) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return n.fail(location, "anyOf")
+		}
+	}
+	if len(n.oneOf) > 0 {
+		matched := 0
+		for _, sub := range n.oneOf {
+			if sub.validate(doc, location) == nil {
+				matched++
+			}
+		}
+		if matched != 1 {
+			return n.fail(location, "oneOf")
+		}
+	}
+	if n.not != nil && n.not.validate(doc, location) == nil {
+		return n.fail(location, "not")
+	}
+	return nil
+}
+
+func jsonSchemaTypeOf(doc BinaryJSON) uint8 {
+	switch doc.TypeCode {
+	case JSONTypeCodeLiteral:
+		if doc.Value[0] == JSONLiteralNil {
+			return jsonSchemaTypeNull
+		}
+		return jsonSchemaTypeBoolean
+	case JSONTypeCodeObject:
+		return jsonSchemaTypeObject
+	case JSONTypeCodeArray:
+		return jsonSchemaTypeArray
+	case JSONTypeCodeInt64, JSONTypeCodeUint64:
+		return jsonSchemaTypeInteger
+	case JSONTypeCodeFloat64:
+		return jsonSchemaTypeNumber
+	case JSONTypeCodeString:
+		return jsonSchemaTypeString
+	}
+	// The opaque and temporal values don't match any type of JSON Schema.
+	return 0
+}
+
+func (n *jsonSchemaNode) validateNumber(num float64, location string) *JSONSchemaValidationReport {
+	if n.minimum != nil && (num < *n.minimum || (n.exclusiveMinimum && num == *n.minimum)) {
+		return n.fail(location, "minimum")
+	}
+	if n.maximum != nil && (num > *n.maximum || (n.exclusiveMaximum && num == *n.maximum)) {
+		return n.fail(location, "maximum")
+	}
+	if n.multipleOf > 0 {
+		if q := num / n.multipleOf; math.IsInf(q, 0) || q != math.Trunc(q) {
+			return n.fail(location, "multipleOf")
+		}
+	}
+	return nil
+}
+
+func (n *jsonSchemaNode) validateString(str []byte, location string) *JSONSchemaValidationReport {
+	if n.minLength >= 0 || n.maxLength >= 0 {
+		length := utf8.RuneCount(str)
+		if n.minLength >= 0 && length < n.minLength {
+			return n.fail(location, "minLength")
+		}
+		if n.maxLength >= 0 && length > n.maxLength {
+			return n.fail(location, "maxLength")
+		}
+	}
+	if n.pattern != nil && !n.pattern.Match(str) {
+		return n.fail(location, "pattern")
+	}
+	return nil
+}
+
+func (n *jsonSchemaNode) validateArray(doc BinaryJSON, location string) *JSONSchemaValidationReport {
+	cnt := doc.GetElemCount()
+	if n.minItems >= 0 && cnt < n.minItems {
+		return n.fail(location, "minItems")
+	}
+	if n.maxItems >= 0 && cnt > n.maxItems {
+		return n.fail(location, "maxItems")
+	}
+	if n.uniqueItems {
+		for i := 0; i < cnt; i++ {
+			for j := i + 1; j < cnt; j++ {
+				if CompareBinaryJSON(doc.ArrayGetElem(i), doc.ArrayGetElem(j)) == 0 {
+					return n.fail(location, "uniqueItems")
+				}
+			}
+		}
+	}
+	if n.tupleItems != nil && n.noMoreItems && cnt > len(n.tupleItems) {
+		return n.fail(location, "additionalItems")
+	}
+	for i := 0; i < cnt; i++ {
+		sub := n.items
+		if n.tupleItems != nil {
+			sub = n.additionalItems
+			if i < len(n.tupleItems) {
+				sub = n.tupleItems[i]
+			}
+		}
+		if sub == nil {
+			continue
+		}
+		if report := sub.validate(doc.ArrayGetElem(i), location+"/"+strconv.Itoa(i)); report != nil {
+			return report
+		}
+	}
+	return nil
+}
+
+func (n *jsonSchemaNode) validateObject(doc BinaryJSON, location string) *JSONSchemaValidationReport {
+	cnt := doc.GetElemCount()
+	if n.minProperties >= 0 && cnt < n.minProperties {
+		return n.fail(location, "minProperties")
+	}
+	if n.maxProperties >= 0 && cnt > n.maxProperties {
+		return n.fail(location, "maxProperties")
+	}
+	for _, name := range n.required {
+		if _, ok := jsonSchemaObjectGet(doc, name); !ok {
+			return n.fail(location, "required")
+		}
+	}
+	for i := 0; i < cnt; i++ {
+		key, val := doc.ObjectGetElem(i)
+		keyLocation := location + "/" + escapeJSONPointerToken(string(key))
+		matched := false
+		if sub, ok := n.properties[string(key)]; ok {
+			matched = true
+			if report := sub.validate(val, keyLocation); report != nil {
+				return report
+			}
+		}
+		for _, prop := range n.patternProperties {
+			if prop.pattern.Match(key) {
+				matched = true
+				if report := prop.schema.validate(val, keyLocation); report != nil {
+					return report
+				}
+			}
+		}
+		if matched {
+			continue
+		}
+		if n.noMoreProperties {
+			return n.fail(location, "additionalProperties")
+		}
+		if n.additionalProperties != nil {
+			if report := n.additionalProperties.validate(val, keyLocation); report != nil {
+				return report
+			}
+		}
+	}
+	for name, props := range n.dependencies {
+		if _, ok := jsonSchemaObjectGet(doc, name); !ok {
+			continue
+		}
+		for _, prop := range props {
+			if _, ok := jsonSchemaObjectGet(doc, prop); !ok {
+				return n.fail(location, "dependencies")
+			}
+		}
+	}
+	for name, sub := range n.schemaDependencies {
+		if _, ok := jsonSchemaObjectGet(doc, name); ok && sub.validate(doc, location) != nil {
+			return n.fail(location, "dependencies")
+		}
+	}
+	return nil
+}
+
+func jsonSchemaObjectGet(doc BinaryJSON, name string) (BinaryJSON, bool) {
+	for i, cnt := 0, doc.GetElemCount(); i < cnt; i++ {
+		if key, val := doc.ObjectGetElem(i); string(key) == name {
+			return val, true
+		}
+	}
+	return BinaryJSON{}, false
+}
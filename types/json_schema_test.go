@@ -0,0 +1,124 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchemaValidate(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"latitude": {"type": "number", "minimum": -90, "maximum": 90},
+			"name": {"type": "string", "minLength": 1, "maxLength": 4, "pattern": "^[a-z]+$"},
+			"tags": {"type": "array", "items": {"enum": ["a", "b", 1]}, "uniqueItems": true, "maxItems": 2},
+			"id": {"type": "integer", "multipleOf": 2}
+		},
+		"required": ["latitude"],
+		"additionalProperties": false
+	}`
+	bj, err := ParseBinaryJSONFromString(schema)
+	require.NoError(t, err)
+	validator, err := CompileJSONSchema(bj)
+	require.NoError(t, err)
+
+	tests := []struct {
+		doc      string
+		location string
+		schema   string
+		keyword  string
+	}{
+		{`{"latitude": 10}`, "", "", ""},
+		{`{"latitude": 10.5, "name": "ab", "tags": ["a", 1], "id": 4}`, "", "", ""},
+		{`[]`, "#", "#", "type"},
+		{`{"name": "ab"}`, "#", "#", "required"},
+		{`{"latitude": 91}`, "#/latitude", "#/properties/latitude", "maximum"},
+		{`{"latitude": "10"}`, "#/latitude", "#/properties/latitude", "type"},
+		{`{"latitude": 0, "name": ""}`, "#/name", "#/properties/name", "minLength"},
+		{`{"latitude": 0, "name": "abcde"}`, "#/name", "#/properties/name", "maxLength"},
+		{`{"latitude": 0, "name": "A"}`, "#/name", "#/properties/name", "pattern"},
+		{`{"latitude": 0, "tags": ["a", "a"]}`, "#/tags", "#/properties/tags", "uniqueItems"},
+		{`{"latitude": 0, "tags": ["c"]}`, "#/tags/0", "#/properties/tags/items", "enum"},
+		{`{"latitude": 0, "tags": [true]}`, "#/tags/0", "#/properties/tags/items", "enum"},
+		{`{"latitude": 0, "id": 3}`, "#/id", "#/properties/id", "multipleOf"},
+		{`{"latitude": 0, "id": 2.0}`, "#/id", "#/properties/id", "type"},
+		{`{"latitude": 0, "other": 1}`, "#", "#", "additionalProperties"},
+	}
+	for _, tt := range tests {
+		doc, err := ParseBinaryJSONFromString(tt.doc)
+		require.NoError(t, err)
+		report := validator.Validate(doc)
+		if tt.keyword == "" {
+			require.Nil(t, report, tt.doc)
+			continue
+		}
+		require.NotNil(t, report, tt.doc)
+		require.Equal(t, JSONSchemaValidationReport{DocumentLocation: tt.location, SchemaLocation: tt.schema, Keyword: tt.keyword}, *report, tt.doc)
+	}
+}
+
+func TestJSONSchemaCombinations(t *testing.T) {
+	schema := `{
+		"anyOf": [{"type": "string"}, {"type": "integer", "minimum": 0, "exclusiveMinimum": true}],
+		"not": {"enum": ["x"]},
+		"oneOf": [{"type": "string"}, {"type": "number", "maximum": 10}]
+	}`
+	bj, err := ParseBinaryJSONFromString(schema)
+	require.NoError(t, err)
+	validator, err := CompileJSONSchema(bj)
+	require.NoError(t, err)
+	for doc, keyword := range map[string]string{
+		`"a"`:  "",
+		`5`:    "",
+		`0`:    "anyOf",
+		`11`:   "oneOf",
+		`"x"`:  "not",
+		`null`: "anyOf",
+	} {
+		bj, err := ParseBinaryJSONFromString(doc)
+		require.NoError(t, err)
+		report := validator.Validate(bj)
+		if keyword == "" {
+			require.Nil(t, report, doc)
+		} else {
+			require.NotNil(t, report, doc)
+			require.Equal(t, keyword, report.Keyword, doc)
+		}
+	}
+}
+
+func TestCompileInvalidJSONSchema(t *testing.T) {
+	for _, schema := range []string{
+		`[]`,
+		`{"type": "int"}`,
+		`{"type": 1}`,
+		`{"minimum": "1"}`,
+		`{"minLength": -1}`,
+		`{"multipleOf": 0}`,
+		`{"pattern": "("}`,
+		`{"required": "a"}`,
+		`{"properties": {"a": 1}}`,
+		`{"anyOf": []}`,
+		`{"$ref": "#/definitions/a"}`,
+	} {
+		bj, err := ParseBinaryJSONFromString(schema)
+		require.NoError(t, err)
+		_, err = CompileJSONSchema(bj)
+		require.True(t, ErrInvalidJSONData.Equal(err), schema)
+	}
+}
@@ -15,6 +15,7 @@
 package admin
 
 import (
+	"bytes"
 	"context"
 	"math"
 	"strings"
@@ -70,9 +71,10 @@ const (
 // otherwise it returns an error and the corresponding index's offset.
 func CheckIndicesCount(ctx sessionctx.Context, dbName, tableName string, indices []string) (byte, int, error) {
 	// Here we need check all indexes, includes invisible index
+	useInvisibleIndexes := ctx.GetSessionVars().OptimizerUseInvisibleIndexes
 	ctx.GetSessionVars().OptimizerUseInvisibleIndexes = true
 	defer func() {
-		ctx.GetSessionVars().OptimizerUseInvisibleIndexes = false
+		ctx.GetSessionVars().OptimizerUseInvisibleIndexes = useInvisibleIndexes
 	}()
 
 	var snapshot uint64
@@ -153,19 +155,8 @@ func CheckRecordAndIndex(ctx context.Context, sessCtx sessionctx.Context, txn kv
 
 	startKey := tablecodec.EncodeRecordKey(t.RecordPrefix(), kv.IntHandle(math.MinInt64))
 	filterFunc := func(h1 kv.Handle, vals1 []types.Datum, cols []*table.Column) (bool, error) {
-		for i, val := range vals1 {
-			col := cols[i]
-			if val.IsNull() {
-				if mysql.HasNotNullFlag(col.GetFlag()) && col.ToInfo().GetOriginDefaultValue() == nil {
-					return false, errors.Errorf("Column %v define as not null, but can't find the value where handle is %v", col.Name, h1)
-				}
-				// NULL value is regarded as its default value.
-				colDefVal, err := table.GetColOriginDefaultValue(sessCtx, col.ToInfo())
-				if err != nil {
-					return false, errors.Trace(err)
-				}
-				vals1[i] = colDefVal
-			}
+		if err := fillOriginDefaultValues(sessCtx, h1, vals1, cols); err != nil {
+			return false, err
 		}
 		isExist, h2, err := idx.Exist(sc, txn, vals1, h1)
 		if kv.ErrKeyExists.Equal(err) {
@@ -191,6 +182,154 @@ func CheckRecordAndIndex(ctx context.Context, sessCtx sessionctx.Context, txn kv
 	return nil
 }
 
+// fillOriginDefaultValues regards the NULL values of the record as the origin default values of the columns.
+func fillOriginDefaultValues(sessCtx sessionctx.Context, h kv.Handle, vals []types.Datum, cols []*table.Column) error {
+	for i, val := range vals {
+		col := cols[i]
+		if val.IsNull() {
+			if mysql.HasNotNullFlag(col.GetFlag()) && col.ToInfo().GetOriginDefaultValue() == nil {
+				return errors.Errorf("Column %v define as not null, but can't find the value where handle is %v", col.Name, h)
+			}
+			// NULL value is regarded as its default value.
+			colDefVal, err := table.GetColOriginDefaultValue(sessCtx, col.ToInfo())
+			if err != nil {
+				return errors.Trace(err)
+			}
+			vals[i] = colDefVal
+		}
+	}
+	return nil
+}
+
+// CheckGlobalIndex checks the global index of a partitioned table. Every record of the partitions must have an
+// index entry pointing to the partition of the record, and every index entry must point to an existing record
+// of a partition with the same indexed values. The entries left by the dropped, truncated or exchanged partitions
+// are reported as inconsistent.
+func CheckGlobalIndex(ctx context.Context, sessCtx sessionctx.Context, txn kv.Transaction, t table.PartitionedTable, idx table.Index) error {
+	sc := sessCtx.GetSessionVars().StmtCtx
+	idxInfo := idx.Meta()
+	tblInfo := t.Meta()
+	cols := make([]*table.Column, len(idxInfo.Columns))
+	for i, col := range idxInfo.Columns {
+		cols[i] = t.Cols()[col.Offset]
+	}
+
+	// Check that every record has its index entry.
+	var recordCnt int64
+	for _, def := range tblInfo.GetPartitionInfo().Definitions {
+		pid := def.ID
+		p := t.GetPartition(pid)
+		if p == nil {
+			return errors.Errorf("can not find partition id %d for table %s", pid, tblInfo.Name.O)
+		}
+		startKey := tablecodec.EncodeRecordKey(p.RecordPrefix(), kv.IntHandle(math.MinInt64))
+		err := iterRecords(sessCtx, txn, p, startKey, cols, func(h kv.Handle, vals []types.Datum, cols []*table.Column) (bool, error) {
+			if err := fillOriginDefaultValues(sessCtx, h, vals, cols); err != nil {
+				return false, err
+			}
+			recordCnt++
+			key, distinct, err := idx.GenIndexKey(sc, vals, h, nil)
+			if err != nil {
+				return false, errors.Trace(err)
+			}
+			val, err := txn.Get(ctx, key)
+			if kv.IsErrNotFound(err) {
+				return false, ErrAdminCheckTable.GenWithStack("global index %s has no entry for handle %v of partition %d", idxInfo.Name.O, h, pid)
+			}
+			if err != nil {
+				return false, errors.Trace(err)
+			}
+			idxPID, ok, err := tablecodec.DecodePartitionIDInIndexValue(val)
+			if err != nil {
+				return false, errors.Trace(err)
+			}
+			if !ok || idxPID != pid {
+				return false, ErrAdminCheckTable.GenWithStack("global index %s entry of handle %v points to partition %d, but the record is in partition %d", idxInfo.Name.O, h, idxPID, pid)
+			}
+			if distinct {
+				idxHandle, err := tablecodec.DecodeHandleInUniqueIndexValue(val, tblInfo.IsCommonHandle)
+				if err != nil {
+					return false, errors.Trace(err)
+				}
+				if !idxHandle.Equal(h) {
+					return false, ErrAdminCheckTable.GenWithStack("global index %s entry points to handle %v, but the record of partition %d is handle %v", idxInfo.Name.O, idxHandle, pid, h)
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	// Check that every index entry points to a record of a partition.
+	prefix := tablecodec.EncodeTableIndexPrefix(tblInfo.ID, idxInfo.ID)
+	it, err := txn.Iter(prefix, prefix.PrefixNext())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer it.Close()
+	rowDecoders := make(map[int64]*decoder.RowDecoder)
+	var entryCnt int64
+	for it.Valid() && it.Key().HasPrefix(prefix) {
+		entryCnt++
+		pid, ok, err := tablecodec.DecodePartitionIDInIndexValue(it.Value())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !ok {
+			return ErrAdminCheckTable.GenWithStack("global index %s entry %s has no partition ID", idxInfo.Name.O, it.Key())
+		}
+		p := t.GetPartition(pid)
+		if p == nil {
+			return ErrAdminCheckTable.GenWithStack("global index %s entry %s points to partition %d which doesn't exist", idxInfo.Name.O, it.Key(), pid)
+		}
+		h, err := tablecodec.DecodeIndexHandle(it.Key(), it.Value(), len(idxInfo.Columns))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		rowVal, err := txn.Get(ctx, tablecodec.EncodeRecordKey(p.RecordPrefix(), h))
+		if kv.IsErrNotFound(err) {
+			return ErrAdminCheckTable.GenWithStack("global index %s entry %s points to handle %v of partition %d which doesn't exist", idxInfo.Name.O, it.Key(), h, pid)
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+		rowDecoder, ok := rowDecoders[pid]
+		if !ok {
+			if rowDecoder, err = makeRowDecoder(p, sessCtx); err != nil {
+				return errors.Trace(err)
+			}
+			rowDecoders[pid] = rowDecoder
+		}
+		rowMap, err := rowDecoder.DecodeAndEvalRowWithMap(sessCtx, h, rowVal, sessCtx.GetSessionVars().Location(), nil)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		vals := make([]types.Datum, 0, len(cols))
+		for _, col := range cols {
+			vals = append(vals, rowMap[col.ID])
+		}
+		if err := fillOriginDefaultValues(sessCtx, h, vals, cols); err != nil {
+			return err
+		}
+		key, _, err := idx.GenIndexKey(sc, vals, h, nil)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !bytes.Equal(key, it.Key()) {
+			return ErrAdminCheckTable.GenWithStack("global index %s entry %s doesn't match the record of handle %v in partition %d", idxInfo.Name.O, it.Key(), h, pid)
+		}
+		if err := it.Next(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if recordCnt != entryCnt {
+		return ErrAdminCheckTable.GenWithStack("table count %d != global index(%s) count %d", recordCnt, idxInfo.Name.O, entryCnt)
+	}
+	return nil
+}
+
 func makeRowDecoder(t table.Table, sctx sessionctx.Context) (*decoder.RowDecoder, error) {
 	dbName := model.NewCIStr(sctx.GetSessionVars().CurrentDB)
 	exprCols, _, err := expression.ColumnInfos2ColumnsAndNames(sctx, dbName, t.Meta().Name, t.Meta().Cols(), t.Meta())
@@ -0,0 +1,76 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgworker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/multierr"
+)
+
+// Worker is a background worker whose lifetime is managed by Resize.
+type Worker interface {
+	// Start starts the worker in a new goroutine.
+	Start()
+	// Stop notifies the worker to exit, it doesn't wait for the worker to exit.
+	Stop()
+}
+
+// StoppableWorker is a Worker which can be waited until it exits.
+type StoppableWorker interface {
+	Worker
+	// WaitStopped waits for the worker to exit.
+	WaitStopped(ctx context.Context, timeout time.Duration) error
+}
+
+// Resize scales the workers of the owner to the expected number, which is limited by the quota shared with the
+// workers of the other owners. The new workers are created by newWorker and started, and the workers beyond the
+// granted number are stopped. It returns the workers kept and the ones stopped, the caller may wait for the stopped
+// workers to exit or collect the results left in them.
+//
+// newWorker returns false if no more worker is needed. If it returns false or an error, the workers created so far
+// are kept and the slots of the owner are shrunk to the number of them.
+func Resize[T Worker](q *Quota, owner string, workers []T, expected int,
+	newWorker func(id int) (T, bool, error)) (kept []T, stopped []T, err error) {
+	granted := q.Resize(owner, expected)
+	for i := len(workers); i < granted; i++ {
+		w, ok, err := newWorker(i)
+		if err != nil || !ok {
+			q.Resize(owner, len(workers))
+			return workers, nil, err
+		}
+		w.Start()
+		workers = append(workers, w)
+	}
+	if granted >= len(workers) {
+		return workers, nil, nil
+	}
+	stopped = make([]T, len(workers)-granted)
+	copy(stopped, workers[granted:])
+	for _, w := range stopped {
+		w.Stop()
+	}
+	return workers[:granted], stopped, nil
+}
+
+// WaitStopped waits for all the stopped workers to exit, each of them is waited for the timeout at most.
+func WaitStopped[T StoppableWorker](ctx context.Context, workers []T, timeout time.Duration) error {
+	var errs error
+	for _, w := range workers {
+		errs = multierr.Append(errs, w.WaitStopped(ctx, timeout))
+	}
+	return errs
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgworker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockWorker struct {
+	id      int
+	started bool
+	stopped bool
+}
+
+func (w *mockWorker) Start() { w.started = true }
+
+func (w *mockWorker) Stop() { w.stopped = true }
+
+func TestResize(t *testing.T) {
+	q := NewQuota()
+	q.SetConcurrency(4)
+	newWorker := func(id int) (*mockWorker, bool, error) {
+		return &mockWorker{id: id}, true, nil
+	}
+
+	workers, stopped, err := Resize(q, "ddl", nil, 3, newWorker)
+	require.NoError(t, err)
+	require.Len(t, workers, 3)
+	require.Empty(t, stopped)
+	for i, w := range workers {
+		require.Equal(t, i, w.id)
+		require.True(t, w.started)
+	}
+
+	// The workers of another owner are limited by the quota.
+	ttlWorkers, _, err := Resize(q, "ttl", nil, 4, newWorker)
+	require.NoError(t, err)
+	require.Len(t, ttlWorkers, 1)
+
+	workers, stopped, err = Resize(q, "ddl", workers, 1, newWorker)
+	require.NoError(t, err)
+	require.Len(t, workers, 1)
+	require.Len(t, stopped, 2)
+	require.False(t, workers[0].stopped)
+	for _, w := range stopped {
+		require.True(t, w.stopped)
+	}
+	require.Equal(t, 2, q.Used())
+
+	// The slots are shrunk to the created workers if no more worker can be created.
+	workers, _, err = Resize(q, "ddl", workers, 3, func(id int) (*mockWorker, bool, error) {
+		return nil, false, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, workers, 1)
+	require.Equal(t, 2, q.Used())
+	_, _, err = Resize(q, "ddl", workers, 3, func(id int) (*mockWorker, bool, error) {
+		return nil, false, errors.New("mock error")
+	})
+	require.Error(t, err)
+	require.Equal(t, 2, q.Used())
+}
@@ -0,0 +1,149 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgworker
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/memory"
+	"go.uber.org/zap"
+)
+
+// GlobalQuota is the quota shared by all the background workers in this TiDB instance,
+// such as the backfill workers of DDL and the scan/delete workers of TTL.
+var GlobalQuota = NewQuota()
+
+// Quota limits the total number of the background workers and tracks their memory usage.
+// Each owner (e.g. a DDL job or the TTL task manager) holds a number of worker slots, the
+// sum of which can't exceed the concurrency limit unless an owner needs its first worker.
+// When the memory usage of the workers exceeds the memory quota, the slots of each owner are
+// halved on its next resizing until the memory usage falls below the quota.
+type Quota struct {
+	mu    sync.Mutex
+	limit int
+	total int
+	held  map[string]int
+
+	memTracker  *memory.Tracker
+	memExceeded atomic.Bool
+}
+
+// NewQuota creates a new Quota without limitation.
+func NewQuota() *Quota {
+	q := &Quota{
+		held:       make(map[string]int),
+		memTracker: memory.NewTracker(memory.LabelForBackgroundWorker, -1),
+	}
+	q.memTracker.SetActionOnExceed(&memExceedAction{q: q})
+	return q
+}
+
+// memExceedAction marks the quota as exceeded when the memory usage of the workers exceeds the memory quota.
+type memExceedAction struct {
+	memory.BaseOOMAction
+	q *Quota
+}
+
+// Action implements the memory.ActionOnExceed interface.
+func (a *memExceedAction) Action(t *memory.Tracker) {
+	if a.q.memExceeded.CompareAndSwap(false, true) {
+		logutil.BgLogger().Warn("memory usage of background workers exceeds the quota, shrink the workers",
+			zap.Int64("consumed", t.BytesConsumed()), zap.Int64("quota", t.GetBytesLimit()))
+	}
+}
+
+// GetPriority implements the memory.ActionOnExceed interface.
+func (*memExceedAction) GetPriority() int64 {
+	return memory.DefRateLimitPriority
+}
+
+// isMemExceeded checks whether the memory usage of the workers still exceeds the memory quota.
+func (q *Quota) isMemExceeded() bool {
+	if !q.memExceeded.Load() {
+		return false
+	}
+	limit := q.memTracker.GetBytesLimit()
+	if limit <= 0 || q.memTracker.BytesConsumed() < limit {
+		q.memExceeded.Store(false)
+		return false
+	}
+	return true
+}
+
+// SetConcurrency sets the max number of the background workers, 0 means unlimited.
+func (q *Quota) SetConcurrency(limit int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.limit = limit
+}
+
+// Concurrency returns the max number of the background workers.
+func (q *Quota) Concurrency() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.limit
+}
+
+// Resize changes the number of worker slots held by the owner to the expected number, and
+// returns the number actually granted. The result is limited by the slots left by the other
+// owners, but at least one slot is granted if expected > 0, so that every owner can make progress.
+func (q *Quota) Resize(owner string, expected int) int {
+	memExceeded := q.isMemExceeded()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	held := q.held[owner]
+	granted := expected
+	if memExceeded && held > 0 && granted > held/2 {
+		granted = held / 2
+	}
+	if q.limit > 0 {
+		available := q.limit - (q.total - held)
+		if granted > available {
+			granted = available
+		}
+	}
+	if granted < 1 && expected > 0 {
+		granted = 1
+	}
+	if granted < 0 {
+		granted = 0
+	}
+	q.total += granted - held
+	if granted == 0 {
+		delete(q.held, owner)
+	} else {
+		q.held[owner] = granted
+	}
+	return granted
+}
+
+// Release releases all the worker slots held by the owner.
+func (q *Quota) Release(owner string) {
+	q.Resize(owner, 0)
+}
+
+// Used returns the number of worker slots held by all the owners.
+func (q *Quota) Used() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.total
+}
+
+// MemTracker returns the memory tracker which is the ancestor of all the background workers' memory trackers.
+func (q *Quota) MemTracker() *memory.Tracker {
+	return q.memTracker
+}
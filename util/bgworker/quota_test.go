@@ -0,0 +1,66 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgworker
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/util/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaResize(t *testing.T) {
+	q := NewQuota()
+	// No limitation by default.
+	require.Equal(t, 16, q.Resize("ddl", 16))
+	require.Equal(t, 8, q.Resize("ttl", 8))
+	require.Equal(t, 24, q.Used())
+
+	q.SetConcurrency(20)
+	require.Equal(t, 20, q.Concurrency())
+	// The slots held by others are not revoked, but new slots are limited.
+	require.Equal(t, 4, q.Resize("ttl", 8))
+	require.Equal(t, 20, q.Used())
+	require.Equal(t, 16, q.Resize("ddl", 32))
+	// Every owner can get at least one worker.
+	require.Equal(t, 1, q.Resize("ddl-2", 4))
+	require.Equal(t, 21, q.Used())
+
+	q.Release("ddl")
+	require.Equal(t, 5, q.Used())
+	require.Equal(t, 16, q.Resize("ddl-2", 16))
+	require.Equal(t, 0, q.Resize("ttl", 0))
+	require.Equal(t, 16, q.Used())
+	q.Release("not-exist")
+	require.Equal(t, 16, q.Used())
+}
+
+func TestQuotaMemExceeded(t *testing.T) {
+	q := NewQuota()
+	require.Equal(t, 8, q.Resize("ddl", 8))
+	q.MemTracker().SetBytesLimit(100)
+	tracker := memory.NewTracker(memory.LabelForBackgroundWorker, -1)
+	tracker.AttachTo(q.MemTracker())
+	defer tracker.Detach()
+
+	tracker.Consume(200)
+	// The slots are halved on each resizing until the memory usage falls below the quota.
+	require.Equal(t, 4, q.Resize("ddl", 8))
+	require.Equal(t, 2, q.Resize("ddl", 8))
+	require.Equal(t, 1, q.Resize("ddl", 8))
+	require.Equal(t, 1, q.Resize("ddl", 8))
+	tracker.Consume(-150)
+	require.Equal(t, 8, q.Resize("ddl", 8))
+}
@@ -53,10 +53,16 @@ type diskFileReaderWriter struct {
 
 	// ctrCipher stores the key and nonce using by aes encrypt io layer
 	ctrCipher *encrypt.CtrCipher
+	// tempPath is the dedicated temporary path of the file, it's nil if the file is in the TempStoragePath.
+	tempPath *disk.TempPath
 }
 
 func (l *diskFileReaderWriter) initWithFileName(fileName string) (err error) {
-	l.disk, err = os.CreateTemp(config.GetGlobalConfig().TempStoragePath, fileName)
+	dir := config.GetGlobalConfig().TempStoragePath
+	if l.tempPath = disk.PickTempPath(config.TempPathUsageSpill); l.tempPath != nil {
+		dir = l.tempPath.Path
+	}
+	l.disk, err = os.CreateTemp(dir, fileName)
 	if err != nil {
 		return errors2.Trace(err)
 	}
@@ -75,6 +81,23 @@ func (l *diskFileReaderWriter) initWithFileName(fileName string) (err error) {
 	return
 }
 
+// written records the bytes written to the file.
+func (l *diskFileReaderWriter) written(n int64) {
+	l.offWrite += n
+	if l.tempPath != nil {
+		l.tempPath.Consume(n)
+	}
+}
+
+// close closes and removes the file.
+func (l *diskFileReaderWriter) close() {
+	terror.Call(l.disk.Close)
+	terror.Log(os.Remove(l.disk.Name()))
+	if l.tempPath != nil {
+		l.tempPath.Consume(-l.offWrite)
+	}
+}
+
 func (l *diskFileReaderWriter) getReader() io.ReaderAt {
 	var underlying io.ReaderAt = l.disk
 	if l.ctrCipher != nil {
@@ -148,7 +171,7 @@ func (l *ListInDisk) Add(chk *Chunk) (err error) {
 	// Append data
 	chkInDisk := chunkInDisk{Chunk: chk, offWrite: l.dataFile.offWrite}
 	n, err := chkInDisk.WriteTo(l.dataFile.getWriter())
-	l.dataFile.offWrite += n
+	l.dataFile.written(n)
 	if err != nil {
 		return
 	}
@@ -158,7 +181,7 @@ func (l *ListInDisk) Add(chk *Chunk) (err error) {
 	l.numRowsOfEachChunk = append(l.numRowsOfEachChunk, len(offsetsOfRows))
 	l.rowNumOfEachChunkFirstRow = append(l.rowNumOfEachChunkFirstRow, l.totalNumRows)
 	n2, err := offsetsOfRows.WriteTo(l.offsetFile.getWriter())
-	l.offsetFile.offWrite += n2
+	l.offsetFile.written(n2)
 	if err != nil {
 		return
 	}
@@ -231,12 +254,10 @@ func (l *ListInDisk) NumChunks() int {
 func (l *ListInDisk) Close() error {
 	if l.dataFile.disk != nil {
 		l.diskTracker.Consume(-l.diskTracker.BytesConsumed())
-		terror.Call(l.dataFile.disk.Close)
-		terror.Log(os.Remove(l.dataFile.disk.Name()))
+		l.dataFile.close()
 	}
 	if l.offsetFile.disk != nil {
-		terror.Call(l.offsetFile.disk.Close)
-		terror.Log(os.Remove(l.offsetFile.disk.Name()))
+		l.offsetFile.close()
 	}
 	return nil
 }
@@ -173,14 +173,19 @@ func (c *RowContainer) alreadySpilled() bool {
 	return c.m.records.inDisk != nil
 }
 
-// AlreadySpilledSafeForTest indicates that records have spilled out into disk. It's thread-safe.
-// The function is only used for test.
-func (c *RowContainer) AlreadySpilledSafeForTest() bool {
+// AlreadySpilled indicates that records have spilled out into disk. It's thread-safe.
+func (c *RowContainer) AlreadySpilled() bool {
 	c.m.RLock()
 	defer c.m.RUnlock()
 	return c.m.records.inDisk != nil
 }
 
+// AlreadySpilledSafeForTest indicates that records have spilled out into disk. It's thread-safe.
+// The function is only used for test.
+func (c *RowContainer) AlreadySpilledSafeForTest() bool {
+	return c.AlreadySpilled()
+}
+
 // NumRow returns the number of rows in the container
 func (c *RowContainer) NumRow() int {
 	c.m.RLock()
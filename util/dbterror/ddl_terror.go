@@ -263,6 +263,8 @@ var (
 	ErrWrongObject = ClassDDL.NewStd(mysql.ErrWrongObject)
 	// ErrTableCantHandleFt returns FULLTEXT keys are not supported by table type
 	ErrTableCantHandleFt = ClassDDL.NewStd(mysql.ErrTableCantHandleFt)
+	// ErrBadFtColumn returns when a column can't be part of a FULLTEXT index.
+	ErrBadFtColumn = ClassDDL.NewStd(mysql.ErrBadFtColumn)
 	// ErrFieldNotFoundPart returns an error when 'partition by columns' are not found in table columns.
 	ErrFieldNotFoundPart = ClassDDL.NewStd(mysql.ErrFieldNotFoundPart)
 	// ErrWrongTypeColumnValue returns 'Partition column values of incorrect type'
@@ -277,6 +279,12 @@ var (
 	ErrInvalidAutoRandom = ClassDDL.NewStd(mysql.ErrInvalidAutoRandom)
 	// ErrUnsupportedConstraintCheck returns when use ADD CONSTRAINT CHECK
 	ErrUnsupportedConstraintCheck = ClassDDL.NewStd(mysql.ErrUnsupportedConstraintCheck)
+	// ErrCheckConstraintDupName returns when the name of the added check constraint is already used.
+	ErrCheckConstraintDupName = ClassDDL.NewStd(mysql.ErrCheckConstraintDupName)
+	// ErrConstraintNotFound returns when the dropped or altered constraint doesn't exist.
+	ErrConstraintNotFound = ClassDDL.NewStd(mysql.ErrConstraintNotFound)
+	// ErrDependentByCheckConstraint returns when the dropped or renamed column is used by a check constraint.
+	ErrDependentByCheckConstraint = ClassDDL.NewStd(mysql.ErrDependentByCheckConstraint)
 	// ErrDerivedMustHaveAlias returns when a sub select statement does not have a table alias.
 	ErrDerivedMustHaveAlias = ClassDDL.NewStd(mysql.ErrDerivedMustHaveAlias)
 
@@ -395,6 +403,17 @@ var (
 	ErrDDLSetting = ClassDDL.NewStd(mysql.ErrDDLSetting)
 	// ErrIngestFailed returns when the DDL ingest job is failed.
 	ErrIngestFailed = ClassDDL.NewStd(mysql.ErrIngestFailed)
+	// ErrColumnHasDependents returns when the dropped or renamed column is referenced by other objects.
+	ErrColumnHasDependents = ClassDDL.NewStd(mysql.ErrColumnHasDependents)
+	// ErrBackfillChecksumMismatch returns when the checksum of the backfilled index records doesn't match the one
+	// computed by the coprocessor.
+	ErrBackfillChecksumMismatch = ClassDDL.NewStd(mysql.ErrBackfillChecksumMismatch)
+	// ErrMaintenanceWindowExists returns when the created maintenance window already exists.
+	ErrMaintenanceWindowExists = ClassDDL.NewStd(mysql.ErrMaintenanceWindowExists)
+	// ErrMaintenanceWindowNotExists returns when the maintenance window doesn't exist.
+	ErrMaintenanceWindowNotExists = ClassDDL.NewStd(mysql.ErrMaintenanceWindowNotExists)
+	// ErrMaintenanceWindowClosed returns when the reorganization is paused by the end of its maintenance window.
+	ErrMaintenanceWindowClosed = ClassDDL.NewStd(mysql.ErrMaintenanceWindowClosed)
 
 	// ErrColumnInChange indicates there is modification on the column in parallel.
 	ErrColumnInChange = ClassDDL.NewStd(mysql.ErrColumnInChange)
@@ -433,6 +452,8 @@ var (
 	ErrUnsupportedTTLReferencedByFK = ClassDDL.NewStd(mysql.ErrUnsupportedTTLReferencedByFK)
 	// ErrUnsupportedPrimaryKeyTypeWithTTL returns when create or alter a table with TTL options but the primary key is not supported
 	ErrUnsupportedPrimaryKeyTypeWithTTL = ClassDDL.NewStd(mysql.ErrUnsupportedPrimaryKeyTypeWithTTL)
+	// ErrUndropIndex returns when the index dropped by `DROP INDEX ... DEFERRED` can't be restored.
+	ErrUndropIndex = ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message("Can't undrop index '%s': %s", nil))
 
 	// ErrNotSupportedYet returns when tidb does not support this feature.
 	ErrNotSupportedYet = ClassDDL.NewStd(mysql.ErrNotSupportedYet)
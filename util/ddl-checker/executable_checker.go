@@ -130,6 +130,8 @@ func GetTablesNeededExist(stmt ast.StmtNode) ([]string, error) {
 		return tablesName, nil
 	case *ast.DropIndexStmt:
 		return []string{x.Table.Name.String()}, nil
+	case *ast.UndropIndexStmt:
+		return []string{x.Table.Name.String()}, nil
 	case *ast.AlterTableStmt:
 		return []string{x.Table.Name.String()}, nil
 	case *ast.RenameTableStmt:
@@ -0,0 +1,100 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/config"
+)
+
+// TempPath is a temporary directory configured by [[temp-paths]], it tracks the bytes used in the path.
+type TempPath struct {
+	Path string
+	// Usage is the purpose of the path, empty means it's shared by all the purposes.
+	Usage string
+	// Quota is the max bytes used in the path, 0 means no limitation.
+	Quota int64
+
+	used atomic.Int64
+}
+
+// Used returns the bytes used in the path.
+func (p *TempPath) Used() int64 {
+	return p.used.Load()
+}
+
+// Consume adds the bytes used in the path, the bytes can be negative to release the usage.
+func (p *TempPath) Consume(bytes int64) {
+	p.used.Add(bytes)
+}
+
+// available returns the bytes can be used in the path.
+func (p *TempPath) available() int64 {
+	if p.Quota == 0 {
+		return math.MaxInt64
+	}
+	return p.Quota - p.used.Load()
+}
+
+var tempPaths struct {
+	sync.RWMutex
+	paths []*TempPath
+}
+
+// InitTempPaths creates the directories of the configured temporary paths, and replaces the paths used before.
+func InitTempPaths(cfgs []config.TempPath) error {
+	paths := make([]*TempPath, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		if err := CheckAndCreateDir(cfg.Path); err != nil {
+			return errors.Annotatef(err, "create temp path %s", cfg.Path)
+		}
+		paths = append(paths, &TempPath{Path: cfg.Path, Usage: cfg.Usage, Quota: cfg.Quota})
+	}
+	tempPaths.Lock()
+	tempPaths.paths = paths
+	tempPaths.Unlock()
+	return nil
+}
+
+// PickTempPath returns the temporary path of the usage with the most available quota. It returns nil if there is
+// no path configured for the usage or all of them run out of quota, the caller should use the default path then.
+func PickTempPath(usage string) *TempPath {
+	tempPaths.RLock()
+	defer tempPaths.RUnlock()
+	var picked *TempPath
+	for _, p := range tempPaths.paths {
+		if p.Usage != "" && p.Usage != usage {
+			continue
+		}
+		if p.available() <= 0 {
+			continue
+		}
+		if picked == nil || p.available() > picked.available() {
+			picked = p
+		}
+	}
+	return picked
+}
+
+// GetTempPaths returns all the configured temporary paths.
+func GetTempPaths() []*TempPath {
+	tempPaths.RLock()
+	defer tempPaths.RUnlock()
+	return tempPaths.paths
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tidb/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickTempPath(t *testing.T) {
+	dir := t.TempDir()
+	ingest, spill, shared := filepath.Join(dir, "ingest"), filepath.Join(dir, "spill"), filepath.Join(dir, "shared")
+	require.NoError(t, InitTempPaths([]config.TempPath{
+		{Path: ingest, Quota: 100, Usage: config.TempPathUsageIngest},
+		{Path: spill, Usage: config.TempPathUsageSpill},
+		{Path: shared, Quota: 50},
+	}))
+	defer func() {
+		require.NoError(t, InitTempPaths(nil))
+	}()
+	for _, path := range []string{ingest, spill, shared} {
+		_, err := os.Stat(path)
+		require.NoError(t, err)
+	}
+	require.Len(t, GetTempPaths(), 3)
+
+	// The path without quota is preferred.
+	require.Equal(t, spill, PickTempPath(config.TempPathUsageSpill).Path)
+	// The path with the most available quota is preferred.
+	p := PickTempPath(config.TempPathUsageIngest)
+	require.Equal(t, ingest, p.Path)
+	p.Consume(60)
+	require.Equal(t, int64(60), p.Used())
+	p = PickTempPath(config.TempPathUsageIngest)
+	require.Equal(t, shared, p.Path)
+	p.Consume(50)
+	p = PickTempPath(config.TempPathUsageIngest)
+	require.Equal(t, ingest, p.Path)
+	p.Consume(40)
+	// All the paths run out of quota.
+	require.Nil(t, PickTempPath(config.TempPathUsageIngest))
+	p.Consume(-40)
+	require.Equal(t, ingest, PickTempPath(config.TempPathUsageIngest).Path)
+
+	require.NoError(t, InitTempPaths(nil))
+	require.Nil(t, PickTempPath(config.TempPathUsageSpill))
+}
@@ -24,6 +24,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/pingcap/tidb/util/memory"
 	"github.com/pingcap/tipb/go-tipb"
 	"github.com/tikv/client-go/v2/util"
 	"go.uber.org/zap"
@@ -529,6 +530,8 @@ const (
 	TpFKCascadeRuntimeStats
 	// TpRURuntimeStats is the tp for RURuntimeStats
 	TpRURuntimeStats
+	// TpSpillRuntimeStats is the tp for SpillRuntimeStats
+	TpSpillRuntimeStats
 )
 
 // RuntimeStats is used to express the executor runtime information.
@@ -653,6 +656,16 @@ func (e *RootRuntimeStats) GetActRows() int64 {
 	return e.basic.rows.Load()
 }
 
+// GetSpillStats returns the spill stats of the executor, it returns nil if the executor never spills.
+func (e *RootRuntimeStats) GetSpillStats() *SpillRuntimeStats {
+	for _, rss := range e.groupRss {
+		if stats, ok := rss.(*SpillRuntimeStats); ok {
+			return stats
+		}
+	}
+	return nil
+}
+
 // MergeStats merges stats in the RootRuntimeStats and return the stats suitable for display directly.
 func (e *RootRuntimeStats) MergeStats() (basic *BasicRuntimeStats, groups []RuntimeStats) {
 	return e.basic, e.groupRss
@@ -918,6 +931,44 @@ func (e *RuntimeStatsWithConcurrencyInfo) String() string {
 // Merge implements the RuntimeStats interface.
 func (*RuntimeStatsWithConcurrencyInfo) Merge(RuntimeStats) {}
 
+// SpillRuntimeStats is the runtime stats of the executors which spill their data to disk when the memory quota is
+// exceeded, such as hash join, sort and hash aggregation.
+type SpillRuntimeStats struct {
+	// Rounds is the number of times the executor spills.
+	Rounds int64
+	// Bytes is the peak bytes of the spilled data on disk.
+	Bytes int64
+}
+
+// Tp implements the RuntimeStats interface.
+func (*SpillRuntimeStats) Tp() int {
+	return TpSpillRuntimeStats
+}
+
+// Clone implements the RuntimeStats interface.
+func (e *SpillRuntimeStats) Clone() RuntimeStats {
+	newRs := *e
+	return &newRs
+}
+
+// Merge implements the RuntimeStats interface.
+func (e *SpillRuntimeStats) Merge(rs RuntimeStats) {
+	tmp, ok := rs.(*SpillRuntimeStats)
+	if !ok {
+		return
+	}
+	e.Rounds += tmp.Rounds
+	e.Bytes += tmp.Bytes
+}
+
+// String implements the RuntimeStats interface.
+func (e *SpillRuntimeStats) String() string {
+	if e.Rounds == 0 {
+		return ""
+	}
+	return fmt.Sprintf("spill:{rounds:%d, bytes:%s}", e.Rounds, memory.FormatBytes(e.Bytes))
+}
+
 // RuntimeStatsWithCommit is the RuntimeStats with commit detail.
 type RuntimeStatsWithCommit struct {
 	Commit   *util.CommitDetails
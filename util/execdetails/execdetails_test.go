@@ -413,6 +413,14 @@ func TestRootRuntimeStats(t *testing.T) {
 	stats := stmtStats.GetRootStats(1)
 	expect := "time:3s, loops:2, worker:15, commit_txn: {prewrite:1s, get_commit_ts:1s, commit:1s, region_num:5, write_keys:3, write_byte:66, txn_retry:2}"
 	require.Equal(t, expect, stats.String())
+	require.Nil(t, stats.GetSpillStats())
+
+	stmtStats.RegisterStats(pid, &SpillRuntimeStats{Rounds: 1, Bytes: 1024})
+	stmtStats.RegisterStats(pid, &SpillRuntimeStats{Rounds: 2, Bytes: 2048})
+	spillStats := stats.GetSpillStats()
+	require.Equal(t, int64(3), spillStats.Rounds)
+	require.Equal(t, int64(3072), spillStats.Bytes)
+	require.Equal(t, expect+", spill:{rounds:3, bytes:3 KB}", stats.String())
 }
 
 func TestFormatDurationForExplain(t *testing.T) {
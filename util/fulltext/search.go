@@ -0,0 +1,234 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+)
+
+// Document is the tokenized text of the columns in a MATCH expression.
+type Document struct {
+	tokens []string
+	counts map[string]int
+}
+
+// NewDocument tokenizes the texts into a document.
+func NewDocument(texts ...string) *Document {
+	d := &Document{counts: make(map[string]int)}
+	for _, text := range texts {
+		for _, token := range Tokenize(text) {
+			d.tokens = append(d.tokens, token)
+			d.counts[token]++
+		}
+	}
+	return d
+}
+
+func (d *Document) countPrefix(prefix string) int {
+	cnt := 0
+	for token, c := range d.counts {
+		if strings.HasPrefix(token, prefix) {
+			cnt += c
+		}
+	}
+	return cnt
+}
+
+func (d *Document) containsPhrase(phrase []string) bool {
+	if len(phrase) == 0 {
+		return false
+	}
+	for i := 0; i+len(phrase) <= len(d.tokens); i++ {
+		matched := true
+		for j, word := range phrase {
+			if d.tokens[i+j] != word {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// NaturalLanguageScore returns the relevance of the document to the query in
+// natural language mode, which is the number of occurrences of the query words
+// in the document. 0 means the document doesn't match the query.
+func NaturalLanguageScore(d *Document, query string) float64 {
+	score := 0
+	for _, token := range UniqueTokens(query) {
+		score += d.counts[token]
+	}
+	return float64(score)
+}
+
+// BooleanOp is the operator of a term in a boolean mode query.
+type BooleanOp byte
+
+// The operators of terms in boolean mode queries.
+const (
+	// BooleanOpOptional means the term is optional, but the rows containing it are rated higher.
+	BooleanOpOptional BooleanOp = iota
+	// BooleanOpRequired means the term must be present in each row that is returned, it's the `+` operator.
+	BooleanOpRequired
+	// BooleanOpExcluded means the term must not be present in any of the rows that are returned, it's the `-` operator.
+	BooleanOpExcluded
+)
+
+// BooleanTerm is a term in a boolean mode query.
+type BooleanTerm struct {
+	Op BooleanOp
+	// Words are the words of the term, there are more than one word only if the term is a quoted phrase.
+	Words []string
+	// Prefix means the term is a prefix of words, it's the `*` operator.
+	Prefix bool
+}
+
+// ParseBooleanQuery parses a boolean mode query. The `+`, `-` and `*` operators
+// and the double quoted phrases are supported, other operators are ignored.
+func ParseBooleanQuery(query string) []BooleanTerm {
+	var terms []BooleanTerm
+	runes := []rune(strings.ToLower(query))
+	for i := 0; i < len(runes); {
+		op := BooleanOpOptional
+		switch runes[i] {
+		case '+':
+			op = BooleanOpRequired
+			i++
+		case '-':
+			op = BooleanOpExcluded
+			i++
+		}
+		if i >= len(runes) {
+			break
+		}
+		switch {
+		case runes[i] == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if words := Tokenize(string(runes[i+1 : end])); len(words) > 0 {
+				terms = append(terms, BooleanTerm{Op: op, Words: words})
+			}
+			i = end + 1
+		case isWordChar(runes[i]):
+			end := i
+			for end < len(runes) && isWordChar(runes[end]) {
+				end++
+			}
+			word := string(runes[i:end])
+			prefix := end < len(runes) && runes[end] == '*'
+			// The prefix terms are not limited by the token size.
+			if prefix || isIndexable(word) {
+				terms = append(terms, BooleanTerm{Op: op, Words: []string{word}, Prefix: prefix})
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+	return terms
+}
+
+func (d *Document) countTerm(term BooleanTerm) int {
+	if len(term.Words) > 1 {
+		if d.containsPhrase(term.Words) {
+			return 1
+		}
+		return 0
+	}
+	if term.Prefix {
+		return d.countPrefix(term.Words[0])
+	}
+	return d.counts[term.Words[0]]
+}
+
+// BooleanScore returns the relevance of the document to the terms of a boolean
+// mode query, which is the number of the matched terms. 0 means the document
+// doesn't match the query.
+func BooleanScore(d *Document, terms []BooleanTerm) float64 {
+	score := 0
+	for _, term := range terms {
+		matched := d.countTerm(term) > 0
+		switch term.Op {
+		case BooleanOpRequired:
+			if !matched {
+				return 0
+			}
+		case BooleanOpExcluded:
+			if matched {
+				return 0
+			}
+			continue
+		}
+		if matched {
+			score++
+		}
+	}
+	return float64(score)
+}
+
+// AccessTokens returns the tokens to look up in a FULLTEXT index for the rows
+// which may match the query. If intersection is true, the matched rows contain
+// all the tokens, otherwise they contain at least one of them. ok is false if
+// the matched rows can't be located by the tokens, e.g. the query only has
+// prefix terms or excluded terms.
+func AccessTokens(query string, booleanMode bool) (tokens []string, intersection bool, ok bool) {
+	if !booleanMode {
+		tokens = UniqueTokens(query)
+		return tokens, false, len(tokens) > 0
+	}
+	var required, optional []string
+	hasOptionalPrefix := false
+	for _, term := range ParseBooleanQuery(query) {
+		switch term.Op {
+		case BooleanOpRequired:
+			// All the words of a required phrase are in the matched rows.
+			if !term.Prefix {
+				required = append(required, term.Words...)
+			}
+		case BooleanOpOptional:
+			if term.Prefix {
+				hasOptionalPrefix = true
+			} else {
+				// Any word of an optional phrase is enough to locate the rows containing the phrase.
+				optional = append(optional, term.Words[0])
+			}
+		}
+	}
+	if len(required) > 0 {
+		return dedup(required), true, true
+	}
+	if hasOptionalPrefix || len(optional) == 0 {
+		return nil, false, false
+	}
+	return dedup(optional), false, true
+}
+
+func dedup(words []string) []string {
+	seen := make(map[string]struct{}, len(words))
+	res := words[:0]
+	for _, word := range words {
+		if _, ok := seen[word]; ok {
+			continue
+		}
+		seen[word] = struct{}{}
+		res = append(res, word)
+	}
+	return res
+}
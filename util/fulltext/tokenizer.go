@@ -0,0 +1,92 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MinTokenSize is the min length in characters of the words stored in a FULLTEXT index,
+	// it's the same as the default value of innodb_ft_min_token_size.
+	MinTokenSize = 3
+	// MaxTokenSize is the max length in characters of the words stored in a FULLTEXT index,
+	// it's the same as the default value of innodb_ft_max_token_size.
+	MaxTokenSize = 84
+)
+
+// stopwords is the default stopword list of InnoDB, see INFORMATION_SCHEMA.INNODB_FT_DEFAULT_STOPWORD.
+var stopwords = map[string]struct{}{
+	"a": {}, "about": {}, "an": {}, "are": {}, "as": {}, "at": {}, "be": {}, "by": {}, "com": {}, "de": {},
+	"en": {}, "for": {}, "from": {}, "how": {}, "i": {}, "in": {}, "is": {}, "it": {}, "la": {}, "of": {},
+	"on": {}, "or": {}, "that": {}, "the": {}, "this": {}, "to": {}, "was": {}, "what": {}, "when": {}, "where": {},
+	"who": {}, "will": {}, "with": {}, "und": {}, "www": {},
+}
+
+// IsStopword returns whether the word is ignored by FULLTEXT indexes.
+func IsStopword(word string) bool {
+	_, ok := stopwords[word]
+	return ok
+}
+
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func isIndexable(word string) bool {
+	n := utf8.RuneCountInString(word)
+	return n >= MinTokenSize && n <= MaxTokenSize && !IsStopword(word)
+}
+
+// splitWords splits the text into lower case words, the words are the sequences
+// of letters, digits and underscores.
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !isWordChar(r)
+	})
+}
+
+// Tokenize splits the text into the tokens stored in a FULLTEXT index. The
+// tokens are in lower case and in the order they appear in the text, words
+// which are too short, too long or stopwords are skipped.
+func Tokenize(text string) []string {
+	words := splitWords(text)
+	tokens := words[:0]
+	for _, word := range words {
+		if isIndexable(word) {
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+// UniqueTokens returns the distinct tokens of the texts, which are the entries
+// written to a FULLTEXT index for a row.
+func UniqueTokens(texts ...string) []string {
+	var tokens []string
+	seen := make(map[string]struct{})
+	for _, text := range texts {
+		for _, token := range Tokenize(text) {
+			if _, ok := seen[token]; ok {
+				continue
+			}
+			seen[token] = struct{}{}
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
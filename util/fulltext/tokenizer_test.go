@@ -0,0 +1,80 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t, []string{"quick", "brown", "fox", "jumps", "over", "lazy", "dog"},
+		Tokenize("The quick brown fox, jumps over the lazy DOG!"))
+	require.Equal(t, []string{"tidb_server", "v7_0"}, Tokenize("tidb_server v7_0 ok"))
+	require.Equal(t, []string{"数据库"}, Tokenize("数据库 数据"))
+	require.Empty(t, Tokenize("a an of it"))
+	require.Empty(t, Tokenize(strings.Repeat("x", MaxTokenSize+1)))
+
+	require.Equal(t, []string{"foo", "bar", "baz"}, UniqueTokens("foo bar foo", "bar baz"))
+}
+
+func TestNaturalLanguageScore(t *testing.T) {
+	doc := NewDocument("MySQL Tutorial", "DBMS stands for DataBase, mysql is a DBMS")
+	require.Equal(t, float64(2), NaturalLanguageScore(doc, "mysql"))
+	require.Equal(t, float64(4), NaturalLanguageScore(doc, "MySQL dbms"))
+	require.Equal(t, float64(0), NaturalLanguageScore(doc, "oracle"))
+	require.Equal(t, float64(0), NaturalLanguageScore(doc, "is the"))
+}
+
+func TestBooleanScore(t *testing.T) {
+	require.Equal(t, []BooleanTerm{
+		{Op: BooleanOpRequired, Words: []string{"mysql"}},
+		{Op: BooleanOpExcluded, Words: []string{"yoursql"}},
+		{Op: BooleanOpOptional, Words: []string{"data"}, Prefix: true},
+		{Op: BooleanOpOptional, Words: []string{"some", "words"}},
+	}, ParseBooleanQuery(`+MySQL -YourSQL data* "some words" the ~`))
+
+	doc := NewDocument("MySQL Tutorial", "some words about the database")
+	require.Equal(t, float64(1), BooleanScore(doc, ParseBooleanQuery("+mysql")))
+	require.Equal(t, float64(3), BooleanScore(doc, ParseBooleanQuery(`+mysql data* "some words"`)))
+	require.Equal(t, float64(0), BooleanScore(doc, ParseBooleanQuery(`+mysql -tutorial`)))
+	require.Equal(t, float64(0), BooleanScore(doc, ParseBooleanQuery(`+oracle tutorial`)))
+	require.Equal(t, float64(0), BooleanScore(doc, ParseBooleanQuery(`"words some"`)))
+	require.Equal(t, float64(0), BooleanScore(doc, ParseBooleanQuery(`-oracle`)))
+}
+
+func TestAccessTokens(t *testing.T) {
+	tokens, intersection, ok := AccessTokens("MySQL tutorial for the mysql users", false)
+	require.True(t, ok)
+	require.False(t, intersection)
+	require.Equal(t, []string{"mysql", "tutorial", "users"}, tokens)
+	_, _, ok = AccessTokens("is the", false)
+	require.False(t, ok)
+
+	tokens, intersection, ok = AccessTokens(`+mysql +"some words" -oracle tutorial data*`, true)
+	require.True(t, ok)
+	require.True(t, intersection)
+	require.Equal(t, []string{"mysql", "some", "words"}, tokens)
+	tokens, intersection, ok = AccessTokens(`mysql "some words" -oracle`, true)
+	require.True(t, ok)
+	require.False(t, intersection)
+	require.Equal(t, []string{"mysql", "some"}, tokens)
+	_, _, ok = AccessTokens(`mysql data*`, true)
+	require.False(t, ok)
+	_, _, ok = AccessTokens(`-oracle`, true)
+	require.False(t, ok)
+}
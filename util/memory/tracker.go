@@ -851,6 +851,10 @@ const (
 	LabelForSession int = -27
 	// LabelForMemDB represents the label of the MemDB
 	LabelForMemDB int = -28
+	// LabelForBackgroundWorker represents the label of the global memory of all background workers
+	LabelForBackgroundWorker int = -29
+	// LabelForDDLBackfill represents the label of the memory of all DDL backfill workers
+	LabelForDDLBackfill int = -30
 )
 
 // MetricsTypes is used to get label for metrics
@@ -423,6 +423,8 @@ func ColumnToProto(c *model.ColumnInfo, forIndex bool) *tipb.ColumnInfo {
 	if forIndex {
 		// Use array type for read the multi-valued index.
 		pc.Tp = int32(c.FieldType.ArrayType().GetType())
+		// The values in the indexes are not compressed.
+		pc.Flag &^= int32(mysql.CompressedFlag)
 	} else {
 		pc.Tp = int32(c.GetType())
 	}
@@ -144,6 +144,17 @@ func TestBasicFuncProcessInfo(t *testing.T) {
 	row3 := pi.ToRow(time.UTC)
 	assert.Equal(t, row, row3[:8])
 	assert.Equal(t, int64(0), row3[9])
+	assert.Equal(t, false, row3[13])
+	assert.Nil(t, row3[14])
+	assert.Nil(t, row3[15])
+
+	pi.Internal = true
+	pi.Operation = "backfill"
+	pi.JobID = "100"
+	row3 = pi.ToRow(time.UTC)
+	assert.Equal(t, true, row3[13])
+	assert.Equal(t, "backfill", row3[14])
+	assert.Equal(t, "100", row3[15])
 }
 
 func TestBasicFuncRandomBuf(t *testing.T) {
@@ -133,6 +133,8 @@ const (
 	TypeForeignKeyCheck = "Foreign_Key_Check"
 	// TypeForeignKeyCascade is the type of FKCascade
 	TypeForeignKeyCascade = "Foreign_Key_Cascade"
+	// TypeTableFunction is the type of table function.
+	TypeTableFunction = "TableFunction"
 )
 
 // plan id.
@@ -196,6 +198,7 @@ const (
 	typeForeignKeyCheck       int = 56
 	typeForeignKeyCascade     int = 57
 	typeExpandID              int = 58
+	typeTableFunctionID       int = 59
 )
 
 // TypeStringToPhysicalID converts the plan type string to plan id.
@@ -317,6 +320,8 @@ func TypeStringToPhysicalID(tp string) int {
 		return typeForeignKeyCascade
 	case TypeExpand:
 		return typeExpandID
+	case TypeTableFunction:
+		return typeTableFunctionID
 	}
 	// Should never reach here.
 	return 0
@@ -441,6 +446,8 @@ func PhysicalIDToTypeString(id int) string {
 		return TypeForeignKeyCascade
 	case typeExpandID:
 		return TypeExpand
+	case typeTableFunctionID:
+		return TypeTableFunction
 	}
 
 	// Should never reach here.
@@ -56,6 +56,8 @@ type ProcessInfo struct {
 	Info                  string
 	Port                  string
 	ResourceGroupName     string
+	Operation             string
+	JobID                 string
 	PlanExplainRows       [][]string
 	OOMAlarmVariablesInfo OOMAlarmVariablesInfo
 	ID                    uint64
@@ -66,6 +68,9 @@ type ProcessInfo struct {
 	State            uint16
 	Command          byte
 	RedactSQL        bool
+	// Internal indicates the process is an internal session of the background workers instead of a client connection,
+	// whose Operation and JobID describe what the session is working for.
+	Internal bool
 }
 
 // ToRowForShow returns []interface{} for the row data of "SHOW [FULL] PROCESSLIST".
@@ -127,7 +132,15 @@ func (pi *ProcessInfo) ToRow(tz *time.Location) []interface{} {
 			diskConsumed = pi.DiskTracker.BytesConsumed()
 		}
 	}
-	return append(pi.ToRowForShow(true), pi.Digest, bytesConsumed, diskConsumed, pi.txnStartTs(tz), pi.ResourceGroupName)
+	var operation, jobID interface{}
+	if pi.Operation != "" {
+		operation = pi.Operation
+	}
+	if pi.JobID != "" {
+		jobID = pi.JobID
+	}
+	return append(pi.ToRowForShow(true), pi.Digest, bytesConsumed, diskConsumed, pi.txnStartTs(tz), pi.ResourceGroupName,
+		pi.Internal, operation, jobID)
 }
 
 // ascServerStatus is a slice of all defined server status in ascending order.
@@ -297,11 +310,34 @@ func ParseGlobalConnID(id uint64) (g GlobalConnID, isTruncated bool, err error)
 const (
 	reservedLocalConns  = 200
 	reservedConnAnalyze = 1
+	// reservedConnInternal is the first local connection ID reserved for the internal sessions of the background
+	// workers, such as the backfill workers and the TTL workers.
+	reservedConnInternal = 2
 )
 
+// MaxInternalProcs is the max number of the internal sessions of the background workers tracked at the same time.
+const MaxInternalProcs = reservedLocalConns - reservedConnInternal
+
 // GetAutoAnalyzeProcID returns processID for auto analyze
 // TODO support IDs for concurrent auto-analyze
 func GetAutoAnalyzeProcID(serverIDGetter func() uint64) uint64 {
 	globalConnID := NewGlobalConnIDWithGetter(serverIDGetter, true)
 	return globalConnID.makeID(reservedConnAnalyze)
 }
+
+// GetInternalProcID returns processID for the i-th internal session of the background workers, i is in
+// [0, MaxInternalProcs).
+func GetInternalProcID(serverIDGetter func() uint64, i int) uint64 {
+	globalConnID := NewGlobalConnIDWithGetter(serverIDGetter, true)
+	return globalConnID.makeID(reservedConnInternal + uint64(i))
+}
+
+// IsReservedProcID checks whether the processID is reserved for the sys processes of any TiDB server, such as the
+// auto analyze and the internal sessions of the background workers.
+func IsReservedProcID(id uint64) bool {
+	connID, isTruncated, err := ParseGlobalConnID(id)
+	if err != nil || isTruncated || !connID.Is64bits {
+		return false
+	}
+	return connID.LocalConnID >= reservedConnAnalyze && connID.LocalConnID < reservedLocalConns
+}
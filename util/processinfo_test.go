@@ -61,3 +61,25 @@ func TestGlobalConnID(t *testing.T) {
 	}
 	assert.Equal(t, (uint64(2002)<<41)|(uint64(123)<<1)|1, connID2.ID())
 }
+
+func TestReservedProcID(t *testing.T) {
+	serverIDGetter := func() uint64 { return 1001 }
+	analyzeID := util.GetAutoAnalyzeProcID(serverIDGetter)
+	assert.True(t, util.IsReservedProcID(analyzeID))
+	ids := make(map[uint64]struct{})
+	for i := 0; i < util.MaxInternalProcs; i++ {
+		id := util.GetInternalProcID(serverIDGetter, i)
+		assert.True(t, util.IsReservedProcID(id))
+		assert.NotEqual(t, analyzeID, id)
+		ids[id] = struct{}{}
+	}
+	assert.Len(t, ids, util.MaxInternalProcs)
+
+	// The reserved IDs of the other servers are also reserved.
+	assert.True(t, util.IsReservedProcID(util.GetInternalProcID(func() uint64 { return 1002 }, 0)))
+	connID := util.GlobalConnID{Is64bits: true, ServerID: 1001, LocalConnID: 200}
+	assert.False(t, util.IsReservedProcID(connID.ID()))
+	connID = util.GlobalConnID{Is64bits: false, ServerID: 1001, LocalConnID: 2}
+	assert.False(t, util.IsReservedProcID(connID.ID()))
+	assert.False(t, util.IsReservedProcID(2))
+}
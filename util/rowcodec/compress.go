@@ -0,0 +1,66 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowcodec
+
+import (
+	"github.com/klauspost/compress/zstd"
+	"github.com/pingcap/errors"
+)
+
+// The value of a compressed column is prefixed with a byte of its compression method. The small values and the
+// values which can't be compressed smaller are stored as they are.
+const (
+	compressionNone byte = 0
+	compressionZstd byte = 1
+
+	// compressThreshold is the minimum length of the values to be compressed.
+	compressThreshold = 256
+)
+
+var (
+	// The encoder and the decoder are safe to be used concurrently by EncodeAll and DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+)
+
+// CompressValue compresses the value of a compressed column, the compressed value is stored in the row as the value
+// of the column, and it's decompressed by the decoders if the column has the mysql.CompressedFlag.
+func CompressValue(b []byte) []byte {
+	if len(b) >= compressThreshold {
+		compressed := zstdEncoder.EncodeAll(b, []byte{compressionZstd})
+		if len(compressed) < len(b)+1 {
+			return compressed
+		}
+	}
+	compressed := make([]byte, 0, len(b)+1)
+	compressed = append(compressed, compressionNone)
+	return append(compressed, b...)
+}
+
+// DecompressValue decompresses the value of a compressed column.
+func DecompressValue(b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return nil, errors.New("invalid compressed value")
+	}
+	switch b[0] {
+	case compressionNone:
+		return b[1:], nil
+	case compressionZstd:
+		decompressed, err := zstdDecoder.DecodeAll(b[1:], nil)
+		return decompressed, errors.Trace(err)
+	default:
+		return nil, errors.Errorf("unknown compression method %d", b[0])
+	}
+}
@@ -53,6 +53,14 @@ type ColInfo struct {
 	Ft            *types.FieldType
 }
 
+// getColData returns the data of the column in the row, the value of the compressed column is decompressed.
+func getColData(col *ColInfo, data []byte) ([]byte, error) {
+	if mysql.HasCompressedFlag(col.Ft.GetFlag()) {
+		return DecompressValue(data)
+	}
+	return data, nil
+}
+
 // DatumMapDecoder decodes the row to datum map.
 type DatumMapDecoder struct {
 	decoder
@@ -79,7 +87,10 @@ func (decoder *DatumMapDecoder) DecodeToDatumMap(rowData []byte, row map[int64]t
 		col := &decoder.columns[i]
 		idx, isNil, notFound := decoder.row.findColID(col.ID)
 		if !notFound && !isNil {
-			colData := decoder.getData(idx)
+			colData, err := getColData(col, decoder.getData(idx))
+			if err != nil {
+				return nil, err
+			}
 			d, err := decoder.decodeColDatum(col, colData)
 			if err != nil {
 				return nil, err
@@ -213,8 +224,11 @@ func (decoder *ChunkDecoder) DecodeToChunk(rowData []byte, handle kv.Handle, chk
 
 		idx, isNil, notFound := decoder.row.findColID(col.ID)
 		if !notFound && !isNil {
-			colData := decoder.getData(idx)
-			err := decoder.decodeColToChunk(colIdx, col, colData, chk)
+			colData, err := getColData(col, decoder.getData(idx))
+			if err != nil {
+				return err
+			}
+			err = decoder.decodeColToChunk(colIdx, col, colData, chk)
 			if err != nil {
 				return err
 			}
@@ -386,7 +400,10 @@ func (decoder *BytesDecoder) decodeToBytesInternal(outputOffset map[int64]int, h
 		offset := outputOffset[colID]
 		idx, isNil, notFound := r.findColID(colID)
 		if !notFound && !isNil {
-			val := r.getData(idx)
+			val, err := getColData(col, r.getData(idx))
+			if err != nil {
+				return nil, err
+			}
 			values[offset] = decoder.encodeOldDatum(tp, val)
 			continue
 		}
@@ -243,6 +243,37 @@ func TestEncodeKindNullDatum(t *testing.T) {
 	require.Equal(t, int64(2), cdt[1].GetInt64())
 }
 
+func TestCompressedColumn(t *testing.T) {
+	var encoder rowcodec.Encoder
+	sc := new(stmtctx.StatementContext)
+	sc.TimeZone = time.UTC
+	ft := types.NewFieldType(mysql.TypeBlob)
+	ft.AddFlag(mysql.CompressedFlag)
+	large, small := strings.Repeat("abc", 1000), "abc"
+	compressed := rowcodec.CompressValue([]byte(large))
+	require.Less(t, len(compressed), len(large))
+	dts := []types.Datum{types.NewBytesDatum(compressed), types.NewBytesDatum(rowcodec.CompressValue([]byte(small)))}
+	newRow, err := encoder.Encode(sc, []int64{1, 2}, dts, nil)
+	require.NoError(t, err)
+
+	cols := []rowcodec.ColInfo{{ID: 1, Ft: ft}, {ID: 2, Ft: ft}}
+	m, err := rowcodec.NewDatumMapDecoder(cols, sc.TimeZone).DecodeToDatumMap(newRow, nil)
+	require.NoError(t, err)
+	d1, d2 := m[1], m[2]
+	require.Equal(t, large, d1.GetString())
+	require.Equal(t, small, d2.GetString())
+
+	fts := []*types.FieldType{ft, ft}
+	chk := chunk.New(fts, 1, 1)
+	err = rowcodec.NewChunkDecoder(cols, nil, nil, sc.TimeZone).DecodeToChunk(newRow, nil, chk)
+	require.NoError(t, err)
+	require.Equal(t, large, chk.GetRow(0).GetString(0))
+	require.Equal(t, small, chk.GetRow(0).GetString(1))
+
+	_, err = rowcodec.DecompressValue([]byte{2, 1})
+	require.Error(t, err)
+}
+
 func TestDecodeDecimalFspNotMatch(t *testing.T) {
 	var encoder rowcodec.Encoder
 	sc := new(stmtctx.StatementContext)